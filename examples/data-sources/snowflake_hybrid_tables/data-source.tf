@@ -0,0 +1,85 @@
+# Simple usage
+data "snowflake_hybrid_tables" "simple" {
+}
+
+output "simple_output" {
+  value = data.snowflake_hybrid_tables.simple.hybrid_tables
+}
+
+# Filtering (like)
+data "snowflake_hybrid_tables" "like" {
+  like = "hybrid-table-name"
+}
+
+output "like_output" {
+  value = data.snowflake_hybrid_tables.like.hybrid_tables
+}
+
+# Filtering by prefix (like)
+data "snowflake_hybrid_tables" "like_prefix" {
+  like = "prefix%"
+}
+
+output "like_prefix_output" {
+  value = data.snowflake_hybrid_tables.like_prefix.hybrid_tables
+}
+
+# Filtering (starts_with)
+data "snowflake_hybrid_tables" "starts_with" {
+  starts_with = "prefix-"
+}
+
+output "starts_with_output" {
+  value = data.snowflake_hybrid_tables.starts_with.hybrid_tables
+}
+
+# Filtering (limit)
+data "snowflake_hybrid_tables" "limit" {
+  limit {
+    rows = 10
+    from = "prefix-"
+  }
+}
+
+output "limit_output" {
+  value = data.snowflake_hybrid_tables.limit.hybrid_tables
+}
+
+# Filtering (in)
+data "snowflake_hybrid_tables" "in_account" {
+  in {
+    account = true
+  }
+}
+
+data "snowflake_hybrid_tables" "in_database" {
+  in {
+    database = "<database_name>"
+  }
+}
+
+data "snowflake_hybrid_tables" "in_schema" {
+  in {
+    schema = "<database_name>.<schema_name>"
+  }
+}
+
+output "in_output" {
+  value = {
+    "account" : data.snowflake_hybrid_tables.in_account.hybrid_tables,
+    "database" : data.snowflake_hybrid_tables.in_database.hybrid_tables,
+    "schema" : data.snowflake_hybrid_tables.in_schema.hybrid_tables,
+  }
+}
+
+# Ensure the number of hybrid tables is equal to at exactly one element (with the use of check block)
+check "hybrid_table_check" {
+  data "snowflake_hybrid_tables" "assert_with_check_block" {
+    like = "hybrid-table-name"
+  }
+
+  assert {
+    condition     = length(data.snowflake_hybrid_tables.assert_with_check_block.hybrid_tables) == 1
+    error_message = "hybrid tables filtered by '${data.snowflake_hybrid_tables.assert_with_check_block.like}' returned ${length(data.snowflake_hybrid_tables.assert_with_check_block.hybrid_tables)} hybrid tables where one was expected"
+  }
+}
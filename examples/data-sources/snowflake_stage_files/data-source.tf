@@ -0,0 +1,32 @@
+# Simple usage
+data "snowflake_stage_files" "simple" {
+  stage = snowflake_stage_internal.example.fully_qualified_name
+}
+
+output "simple_output" {
+  value = data.snowflake_stage_files.simple.files
+}
+
+# Filtering by path and pattern
+data "snowflake_stage_files" "filtered" {
+  stage   = snowflake_stage_internal.example.fully_qualified_name
+  path    = "data/2024"
+  pattern = ".*[.]csv"
+}
+
+output "filtered_output" {
+  value = data.snowflake_stage_files.filtered.files
+}
+
+# Ensure the jar file exists before creating a function importing it
+data "snowflake_stage_files" "jar" {
+  stage   = snowflake_stage_internal.example.fully_qualified_name
+  pattern = ".*/my_handler[.]jar"
+
+  lifecycle {
+    postcondition {
+      condition     = length(self.files) == 1
+      error_message = "The handler jar is missing on the stage."
+    }
+  }
+}
@@ -0,0 +1,18 @@
+# Tokens of the current user
+data "snowflake_user_programmatic_access_tokens" "current" {}
+
+output "current_output" {
+  value = data.snowflake_user_programmatic_access_tokens.current.user_programmatic_access_tokens
+}
+
+# Tokens of the given user with their expiration time
+data "snowflake_user_programmatic_access_tokens" "for_user" {
+  for_user = snowflake_service_user.example.name
+}
+
+output "expires_at" {
+  value = {
+    for token in data.snowflake_user_programmatic_access_tokens.for_user.user_programmatic_access_tokens :
+    token.show_output[0].name => token.show_output[0].expires_at
+  }
+}
@@ -49,6 +49,18 @@ output "only_show_output" {
   value = data.snowflake_users.only_show.users
 }
 
+# With enrolled MFA methods
+data "snowflake_users" "with_mfa_methods" {
+  like = "user-name"
+
+  # with_mfa_methods is turned off by default and it calls SHOW MFA METHODS FOR USER for every user found and attaches its output to users.*.mfa_methods_output field
+  with_mfa_methods = true
+}
+
+output "with_mfa_methods_output" {
+  value = data.snowflake_users.with_mfa_methods.users[*].mfa_methods_output
+}
+
 # Ensure the number of users is equal to at least one element (with the use of postcondition)
 data "snowflake_users" "assert_with_postcondition" {
   starts_with = "user-name"
@@ -20,6 +20,7 @@ resource "snowflake_account" "complete" {
   region               = "AWS_US_WEST_2"
   comment              = "some comment"
   is_org_admin         = "true"
+  save_old_url         = "false"
   grace_period_in_days = 3
 }
 
@@ -0,0 +1 @@
+terraform import snowflake_account_policies.example '"<organization_name>"."<account_name>"'
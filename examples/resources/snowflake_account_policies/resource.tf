@@ -0,0 +1,14 @@
+## Minimal
+resource "snowflake_account_policies" "minimal" {
+  password_policy = snowflake_password_policy.example.fully_qualified_name
+}
+
+## Complete
+resource "snowflake_account_policies" "complete" {
+  password_policy       = snowflake_password_policy.example.fully_qualified_name
+  session_policy        = "\"<database_name>\".\"<schema_name>\".\"<session_policy_name>\""
+  authentication_policy = snowflake_authentication_policy.example.fully_qualified_name
+  network_policy        = snowflake_network_policy.example.fully_qualified_name
+  packages_policy       = "\"<database_name>\".\"<schema_name>\".\"<packages_policy_name>\""
+  feature_policy        = "\"<database_name>\".\"<schema_name>\".\"<feature_policy_name>\""
+}
@@ -0,0 +1 @@
+terraform import snowflake_account_role_members.example '"<role_name>"'
@@ -0,0 +1,16 @@
+## Minimal
+resource "snowflake_account_role_members" "basic" {
+  role_name = snowflake_account_role.role.name
+  users     = [snowflake_user.user.name]
+}
+
+## Complete (with every optional set)
+resource "snowflake_account_role_members" "complete" {
+  role_name     = snowflake_account_role.role.name
+  users         = [snowflake_user.user.name, snowflake_service_user.service_user.name]
+  account_roles = [snowflake_account_role.parent_role.name]
+
+  # memberships granted by a SCIM integration are neither read nor revoked
+  ignore_scim_managed_members = true
+  scim_provisioner_roles      = [snowflake_account_role.custom_scim_provisioner.name]
+}
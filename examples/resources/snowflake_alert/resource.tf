@@ -1,13 +1,54 @@
+# Alert evaluated on a schedule using a warehouse
 resource "snowflake_alert" "alert" {
   database  = "database"
   schema    = "schema"
   name      = "alert"
   warehouse = "warehouse"
-  alert_schedule {
-    interval = 10
+  started   = true
+  schedule {
+    minutes = 10
   }
   condition = "select 1 as c"
   action    = "select 1 as c"
-  enabled   = true
   comment   = "my alert"
 }
+
+# Serverless alert evaluated with a cron schedule
+resource "snowflake_alert" "serverless_alert" {
+  database = "database"
+  schema   = "schema"
+  name     = "alert"
+  started  = true
+  schedule {
+    using_cron = "0 9 * * * UTC"
+  }
+  condition = "select * from database.schema.errors where created_at > current_timestamp() - interval '1 day'"
+  action    = "call system$send_email('integration', 'someone@example.com', 'Errors', 'New errors were found.')"
+
+  suspend_task_after_num_failures = 5
+}
+
+# Serverless alert on new data, evaluated each time new rows are inserted into the table
+resource "snowflake_alert" "alert_on_new_data" {
+  database  = "database"
+  schema    = "schema"
+  name      = "alert"
+  started   = true
+  condition = "select * from database.schema.errors"
+  action    = "call system$send_email('integration', 'someone@example.com', 'Errors', 'New errors were inserted.')"
+}
+
+# Alert executed right after every create or update
+resource "snowflake_alert" "executed_alert" {
+  database  = "database"
+  schema    = "schema"
+  name      = "alert"
+  warehouse = "warehouse"
+  started   = true
+  schedule {
+    minutes = 60
+  }
+  condition     = "select 1 as c"
+  action        = "select 1 as c"
+  execute_alert = true
+}
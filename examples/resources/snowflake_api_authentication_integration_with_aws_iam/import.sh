@@ -0,0 +1 @@
+terraform import snowflake_api_authentication_integration_with_aws_iam.example '"<integration_name>"'
@@ -0,0 +1,13 @@
+# basic resource
+resource "snowflake_api_authentication_integration_with_aws_iam" "test" {
+  enabled      = true
+  name         = "test"
+  aws_role_arn = "arn:aws:iam::123456789012:role/snowflake-api-access"
+}
+# resource with all fields set
+resource "snowflake_api_authentication_integration_with_aws_iam" "test" {
+  comment      = "comment"
+  enabled      = true
+  name         = "test"
+  aws_role_arn = "arn:aws:iam::123456789012:role/snowflake-api-access"
+}
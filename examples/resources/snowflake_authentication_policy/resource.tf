@@ -12,8 +12,13 @@ resource "snowflake_authentication_policy" "complete" {
   name                       = "network_policy_name"
   authentication_methods     = ["ALL"]
   mfa_authentication_methods = ["SAML", "PASSWORD"]
-  mfa_enrollment             = "OPTIONAL"
+  mfa_enrollment             = "REQUIRED_PASSWORD_ONLY"
   client_types               = ["ALL"]
   security_integrations      = ["ALL"]
   comment                    = "My authentication policy."
+
+  mfa_policy {
+    allowed_methods                        = ["PASSKEY", "TOTP"]
+    enforce_mfa_on_external_authentication = "ALL"
+  }
 }
\ No newline at end of file
@@ -61,3 +61,16 @@ resource "snowflake_database" "primary" {
     ignore_edition_check = true
   }
 }
+
+## Clone of another database
+resource "snowflake_database" "clone" {
+  name = "database_clone_name"
+
+  from_clone {
+    source = snowflake_database.primary.name
+    before {
+      offset = -3600
+    }
+    ignore_tables_with_insufficient_data_retention = true
+  }
+}
@@ -1,12 +1,39 @@
 # https://docs.snowflake.com/en/sql-reference/sql/create-dynamic-table#examples
-resource "snowflake_dynamic_table" "dt" {
+# basic resource
+resource "snowflake_dynamic_table" "basic" {
+  database = "database"
+  schema   = "schema"
   name     = "product"
-  database = "mydb"
-  schema   = "myschema"
   target_lag {
     maximum_duration = "20 minutes"
   }
-  warehouse = "mywh"
-  query     = "SELECT product_id, product_name FROM \"mydb\".\"myschema\".\"staging_table\""
-  comment   = "example comment"
+  warehouse = "warehouse"
+  query     = "SELECT product_id, product_name FROM \"database\".\"schema\".\"staging_table\""
+}
+
+# complete resource
+resource "snowflake_dynamic_table" "complete" {
+  database     = "database"
+  schema       = "schema"
+  name         = "product"
+  is_transient = true
+  started      = false
+  target_lag {
+    downstream = true
+  }
+  warehouse       = "warehouse"
+  query           = "SELECT product_id, product_name, created_at FROM \"database\".\"schema\".\"staging_table\""
+  refresh_mode    = "INCREMENTAL"
+  initialize      = "ON_SCHEDULE"
+  immutable_where = "created_at < '2025-01-01'"
+  backfill_from   = "\"database\".\"schema\".\"product_backup\""
+  comment         = "example comment"
+
+  data_retention_time_in_days     = 1
+  max_data_extension_time_in_days = 14
+
+  row_access_policy {
+    policy_name = "\"database\".\"schema\".\"row_access_policy\""
+    on          = ["product_id"]
+  }
 }
@@ -0,0 +1,25 @@
+## Basic
+resource "snowflake_network_rule" "example" {
+  name       = "example_egress_rule"
+  database   = "EXAMPLE_DB"
+  schema     = "EXAMPLE_SCHEMA"
+  type       = "HOST_PORT"
+  mode       = "EGRESS"
+  value_list = ["example.com", "company.com:443"]
+}
+
+resource "snowflake_external_access_integration" "basic" {
+  name                  = "example_integration"
+  allowed_network_rules = [snowflake_network_rule.example.fully_qualified_name]
+  enabled               = true
+}
+
+## Complete (with every optional set)
+resource "snowflake_external_access_integration" "complete" {
+  name                                    = "example_complete_integration"
+  allowed_network_rules                   = [snowflake_network_rule.example.fully_qualified_name]
+  allowed_api_authentication_integrations = [snowflake_api_authentication_integration_with_client_credentials.example.fully_qualified_name]
+  allowed_authentication_secrets          = [snowflake_secret_with_client_credentials.example.fully_qualified_name]
+  enabled                                 = true
+  comment                                 = "some comment"
+}
@@ -18,3 +18,18 @@ def some_function(x):
   return result
 EOT
 }
+
+# Definition loaded from a file
+resource "snowflake_function_python" "from_file" {
+  database        = snowflake_database.test.name
+  schema          = snowflake_schema.test.name
+  name            = "my_function_from_file"
+  runtime_version = "3.8"
+  arguments {
+    arg_data_type = "NUMBER(36, 2)"
+    arg_name      = "x"
+  }
+  return_type              = "NUMBER(36, 2)"
+  handler                  = "some_function"
+  function_definition_file = "${path.module}/handler.py"
+}
@@ -0,0 +1 @@
+terraform import snowflake_hybrid_table.example '"<database_name>"."<schema_name>"."<hybrid_table_name>"'
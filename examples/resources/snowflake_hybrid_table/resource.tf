@@ -0,0 +1,54 @@
+# basic resource
+resource "snowflake_hybrid_table" "basic" {
+  database = "EXAMPLE_DB"
+  schema   = "EXAMPLE_SCHEMA"
+  name     = "EXAMPLE_HYBRID_TABLE"
+
+  column {
+    name = "ID"
+    type = "NUMBER"
+  }
+  column {
+    name = "NAME"
+    type = "VARCHAR"
+  }
+
+  primary_key {
+    keys = ["ID"]
+  }
+}
+
+# resource with all fields set
+resource "snowflake_hybrid_table" "complete" {
+  database = "EXAMPLE_DB"
+  schema   = "EXAMPLE_SCHEMA"
+  name     = "EXAMPLE_HYBRID_TABLE"
+
+  column {
+    name     = "ID"
+    type     = "NUMBER(38,0)"
+    nullable = false
+    comment  = "identifier"
+  }
+  column {
+    name = "EMAIL"
+    type = "VARCHAR(256)"
+  }
+  column {
+    name = "NAME"
+    type = "VARCHAR"
+  }
+
+  primary_key {
+    name = "PK_ID"
+    keys = ["ID"]
+  }
+
+  index {
+    name            = "IDX_NAME"
+    columns         = ["NAME"]
+    include_columns = ["EMAIL"]
+  }
+
+  comment = "EXAMPLE_COMMENT"
+}
@@ -12,3 +12,11 @@ resource "snowflake_network_policy" "complete" {
   blocked_ip_list           = ["192.168.1.99"]
   comment                   = "my network policy"
 }
+
+## Reject overlapping allowed and blocked IP lists during plan
+resource "snowflake_network_policy" "disjoint" {
+  name                        = "network_policy_name"
+  allowed_ip_list             = ["192.168.1.0/24"]
+  blocked_ip_list             = ["192.168.2.0/24"]
+  reject_overlapping_ip_lists = true
+}
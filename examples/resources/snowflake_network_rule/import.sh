@@ -1 +1 @@
-terraform import snowflake_network_rule.example 'databaseName|schemaName|networkRuleName'
+terraform import snowflake_network_rule.example '"<database_name>"."<schema_name>"."<network_rule_name>"'
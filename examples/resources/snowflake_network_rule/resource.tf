@@ -1,5 +1,6 @@
-resource "snowflake_network_rule" "rule" {
-  name       = "rule"
+## Ingress rule with IPv4 addresses
+resource "snowflake_network_rule" "ipv4" {
+  name       = "ipv4_rule"
   database   = "EXAMPLE_DB"
   schema     = "EXAMPLE_SCHEMA"
   comment    = "A rule."
@@ -7,3 +8,23 @@ resource "snowflake_network_rule" "rule" {
   mode       = "INGRESS"
   value_list = ["192.168.0.100/24", "29.254.123.20"]
 }
+
+## Egress rule with host and port pairs
+resource "snowflake_network_rule" "host_port" {
+  name       = "host_port_rule"
+  database   = "EXAMPLE_DB"
+  schema     = "EXAMPLE_SCHEMA"
+  type       = "HOST_PORT"
+  mode       = "EGRESS"
+  value_list = ["example.com", "company.com:443"]
+}
+
+## Ingress rule with GCP Private Service Connect connection ids
+resource "snowflake_network_rule" "gcp_psc" {
+  name       = "gcp_psc_rule"
+  database   = "EXAMPLE_DB"
+  schema     = "EXAMPLE_SCHEMA"
+  type       = "GCPPSCID"
+  mode       = "INGRESS"
+  value_list = ["123456789012345678"]
+}
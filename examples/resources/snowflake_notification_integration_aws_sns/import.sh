@@ -0,0 +1 @@
+terraform import snowflake_notification_integration_aws_sns.example '"<notification_integration_name>"'
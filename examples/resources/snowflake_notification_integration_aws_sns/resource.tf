@@ -0,0 +1,16 @@
+## Basic
+resource "snowflake_notification_integration_aws_sns" "basic" {
+  name              = "example_aws_sns_integration"
+  enabled           = true
+  aws_sns_topic_arn = "arn:aws:sns:us-east-2:123456789012:MyTopic"
+  aws_sns_role_arn  = "arn:aws:iam::123456789012:role/my-sns-role"
+}
+
+## Complete (with every optional set)
+resource "snowflake_notification_integration_aws_sns" "complete" {
+  name              = "example_complete_aws_sns_integration"
+  enabled           = true
+  aws_sns_topic_arn = "arn:aws:sns:us-east-2:123456789012:MyTopic"
+  aws_sns_role_arn  = "arn:aws:iam::123456789012:role/my-sns-role"
+  comment           = "some comment"
+}
@@ -0,0 +1 @@
+terraform import snowflake_notification_integration_azure_event_grid.example '"<notification_integration_name>"'
@@ -0,0 +1,16 @@
+## Inbound (e.g. for automated data loads)
+resource "snowflake_notification_integration_azure_event_grid" "inbound" {
+  name                            = "example_azure_inbound_integration"
+  enabled                         = true
+  azure_storage_queue_primary_uri = "https://myaccount.queue.core.windows.net/myqueue"
+  azure_tenant_id                 = "00000000-0000-0000-0000-000000000000"
+}
+
+## Outbound (pushing notifications to an Event Grid topic)
+resource "snowflake_notification_integration_azure_event_grid" "outbound" {
+  name                            = "example_azure_outbound_integration"
+  enabled                         = true
+  azure_event_grid_topic_endpoint = "https://mytopic.westus-1.eventgrid.azure.net/api/events"
+  azure_tenant_id                 = "00000000-0000-0000-0000-000000000000"
+  comment                         = "some comment"
+}
@@ -0,0 +1 @@
+terraform import snowflake_notification_integration_gcp_pubsub.example '"<notification_integration_name>"'
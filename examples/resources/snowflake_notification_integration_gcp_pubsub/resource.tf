@@ -0,0 +1,14 @@
+## Inbound (e.g. for automated data loads)
+resource "snowflake_notification_integration_gcp_pubsub" "inbound" {
+  name                         = "example_gcp_inbound_integration"
+  enabled                      = true
+  gcp_pubsub_subscription_name = "projects/project-1234/subscriptions/sub2"
+}
+
+## Outbound (pushing notifications to a topic)
+resource "snowflake_notification_integration_gcp_pubsub" "outbound" {
+  name                  = "example_gcp_outbound_integration"
+  enabled               = true
+  gcp_pubsub_topic_name = "projects/project-1234/topics/top2"
+  comment               = "some comment"
+}
@@ -0,0 +1,40 @@
+## Minimal
+resource "snowflake_organization_account" "minimal" {
+  name           = "ORGANIZATION_ACCOUNT_NAME"
+  admin_name     = var.admin_name
+  admin_password = var.admin_password
+  email          = var.email
+  edition        = "ENTERPRISE"
+}
+
+## Complete (with SERVICE user type)
+resource "snowflake_organization_account" "complete" {
+  name                 = "ORGANIZATION_ACCOUNT_NAME"
+  save_old_url         = "true"
+  admin_name           = var.admin_name
+  admin_rsa_public_key = "<public_key>"
+  admin_user_type      = "SERVICE"
+  email                = var.email
+  edition              = "ENTERPRISE"
+  region_group         = "PUBLIC"
+  region               = "AWS_US_WEST_2"
+  comment              = "some comment"
+  resource_monitor     = snowflake_resource_monitor.example.fully_qualified_name
+  password_policy      = snowflake_password_policy.example.fully_qualified_name
+  session_policy       = "\"<database_name>\".\"<schema_name>\".\"<session_policy_name>\""
+}
+
+variable "admin_name" {
+  type      = string
+  sensitive = true
+}
+
+variable "email" {
+  type      = string
+  sensitive = true
+}
+
+variable "admin_password" {
+  type      = string
+  sensitive = true
+}
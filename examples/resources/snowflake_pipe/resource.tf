@@ -11,3 +11,17 @@ resource "snowflake_pipe" "pipe" {
   aws_sns_topic_arn    = "..."
   notification_channel = "..."
 }
+
+# refresh the pipe after (re)creation to load the already staged files
+resource "snowflake_pipe" "pipe_with_refresh" {
+  database = "db"
+  schema   = "schema"
+  name     = "pipe_with_refresh"
+
+  copy_statement = "copy into mytable from @mystage"
+  auto_ingest    = true
+
+  refresh_on_create = true
+  refresh_prefix    = "data/"
+  modified_after    = "2024-01-01T00:00:00Z"
+}
@@ -18,3 +18,19 @@ EOT
   runtime_version      = "3.8"
   snowpark_package     = "1.14.0"
 }
+
+# Definition loaded from a file
+resource "snowflake_procedure_python" "from_file" {
+  database = "Database"
+  schema   = "Schema"
+  name     = "NameFromFile"
+  arguments {
+    arg_data_type = "VARCHAR(100)"
+    arg_name      = "x"
+  }
+  return_type               = "VARCHAR(100)"
+  handler                   = "echoVarchar"
+  procedure_definition_file = "${path.module}/handler.py"
+  runtime_version           = "3.8"
+  snowpark_package          = "1.14.0"
+}
@@ -31,3 +31,16 @@ resource "snowflake_schema" "schema" {
   pipe_execution_paused                         = false
 
 }
+
+# clone of another schema
+resource "snowflake_schema" "clone" {
+  name     = "schema_clone_name"
+  database = "database_name"
+
+  from_clone {
+    source = snowflake_schema.schema.fully_qualified_name
+    at {
+      timestamp = "2024-06-01T12:00:00Z"
+    }
+  }
+}
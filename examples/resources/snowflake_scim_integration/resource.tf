@@ -17,3 +17,11 @@ resource "snowflake_scim_integration" "test" {
   run_as_role    = "GENERIC_SCIM_PROVISIONER"
   comment        = "foo"
 }
+
+# generic scim client with a custom run as role
+resource "snowflake_scim_integration" "test" {
+  name        = "test"
+  enabled     = true
+  scim_client = "GENERIC"
+  run_as_role = snowflake_account_role.scim_provisioner.name
+}
@@ -24,6 +24,30 @@ resource "snowflake_service_user" "service_user" {
   rsa_public_key_2 = "..."
 }
 
+# with workload identity (keyless authentication from AWS)
+resource "snowflake_service_user" "aws_workload" {
+  name = "Snowflake Service User - AWS workload"
+
+  workload_identity {
+    aws {
+      arn = "arn:aws:iam::123456789012:role/snowflake-access"
+    }
+  }
+}
+
+# with workload identity (keyless authentication from an OIDC provider)
+resource "snowflake_service_user" "oidc_workload" {
+  name = "Snowflake Service User - OIDC workload"
+
+  workload_identity {
+    oidc {
+      issuer             = "https://oidc.example.com"
+      subject            = "system:serviceaccount:default:snowflake"
+      oidc_audience_list = ["snowflakecomputing.com"]
+    }
+  }
+}
+
 # all parameters set on the resource level
 resource "snowflake_service_user" "u" {
   name = "Snowflake Service User with all parameters"
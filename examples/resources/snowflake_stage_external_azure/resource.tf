@@ -0,0 +1,36 @@
+# basic resource
+resource "snowflake_stage_external_azure" "basic" {
+  database            = "database"
+  schema              = "schema"
+  name                = "stage"
+  url                 = "azure://account.blob.core.windows.net/container/path/"
+  storage_integration = snowflake_storage_integration.example.name
+}
+
+# complete resource
+resource "snowflake_stage_external_azure" "complete" {
+  database = "database"
+  schema   = "schema"
+  name     = "stage"
+  url      = "azure://account.blob.core.windows.net/container/path/"
+  comment  = "external Azure stage"
+
+  credentials {
+    azure_sas_token = var.azure_sas_token
+  }
+
+  encryption {
+    type       = "AZURE_CSE"
+    master_key = var.azure_master_key
+  }
+
+  directory {
+    enable                   = true
+    auto_refresh             = true
+    notification_integration = snowflake_notification_integration.example.name
+  }
+
+  file_format {
+    format_name = snowflake_file_format.example.fully_qualified_name
+  }
+}
@@ -0,0 +1 @@
+terraform import snowflake_stage_external_gcs.example '"<database_name>"."<schema_name>"."<stage_name>"'
@@ -0,0 +1,32 @@
+# basic resource
+resource "snowflake_stage_external_gcs" "basic" {
+  database = "database"
+  schema   = "schema"
+  name     = "stage"
+  url      = "gcs://bucket/path/"
+}
+
+# complete resource
+resource "snowflake_stage_external_gcs" "complete" {
+  database            = "database"
+  schema              = "schema"
+  name                = "stage"
+  url                 = "gcs://bucket/path/"
+  storage_integration = snowflake_storage_integration.example.name
+  comment             = "external GCS stage"
+
+  encryption {
+    type       = "GCS_SSE_KMS"
+    kms_key_id = "key"
+  }
+
+  directory {
+    enable                   = true
+    auto_refresh             = true
+    notification_integration = snowflake_notification_integration.example.name
+  }
+
+  file_format {
+    type = "PARQUET"
+  }
+}
@@ -0,0 +1 @@
+terraform import snowflake_stage_external_s3.example '"<database_name>"."<schema_name>"."<stage_name>"'
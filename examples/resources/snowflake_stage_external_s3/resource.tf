@@ -0,0 +1,44 @@
+# basic resource
+resource "snowflake_stage_external_s3" "basic" {
+  database = "database"
+  schema   = "schema"
+  name     = "stage"
+  url      = "s3://bucket/path/"
+}
+
+# resource with a storage integration
+resource "snowflake_stage_external_s3" "with_storage_integration" {
+  database            = "database"
+  schema              = "schema"
+  name                = "stage"
+  url                 = "s3://bucket/path/"
+  storage_integration = snowflake_storage_integration.example.name
+}
+
+# complete resource
+resource "snowflake_stage_external_s3" "complete" {
+  database = "database"
+  schema   = "schema"
+  name     = "stage"
+  url      = "s3://bucket/path/"
+  comment  = "external S3 stage"
+
+  credentials {
+    aws_key_id     = var.aws_key_id
+    aws_secret_key = var.aws_secret_key
+  }
+
+  encryption {
+    type       = "AWS_SSE_KMS"
+    kms_key_id = "aws/key"
+  }
+
+  directory {
+    enable       = true
+    auto_refresh = false
+  }
+
+  file_format {
+    type = "JSON"
+  }
+}
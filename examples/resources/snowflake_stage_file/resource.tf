@@ -0,0 +1,25 @@
+# basic resource
+resource "snowflake_stage_file" "basic" {
+  stage  = snowflake_stage_internal.example.fully_qualified_name
+  source = "${path.module}/data/data.csv"
+}
+
+# file used by a function
+resource "snowflake_stage_file" "handler" {
+  stage  = snowflake_stage_internal.example.fully_qualified_name
+  source = "${path.module}/src/handler.py"
+  path   = "libs/python"
+}
+
+resource "snowflake_function_python" "example" {
+  database        = "database"
+  schema          = "schema"
+  name            = "function"
+  runtime_version = "3.11"
+  return_type     = "NUMBER(38, 0)"
+  handler         = "handler.handler"
+  imports {
+    stage_location = snowflake_stage_internal.example.fully_qualified_name
+    path_on_stage  = snowflake_stage_file.handler.file_name
+  }
+}
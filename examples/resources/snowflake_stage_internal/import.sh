@@ -0,0 +1 @@
+terraform import snowflake_stage_internal.example '"<database_name>"."<schema_name>"."<stage_name>"'
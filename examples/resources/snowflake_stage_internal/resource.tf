@@ -0,0 +1,27 @@
+# basic resource
+resource "snowflake_stage_internal" "basic" {
+  database = "database"
+  schema   = "schema"
+  name     = "stage"
+}
+
+# complete resource
+resource "snowflake_stage_internal" "complete" {
+  database = "database"
+  schema   = "schema"
+  name     = "stage"
+  comment  = "internal stage"
+
+  encryption {
+    type = "SNOWFLAKE_SSE"
+  }
+
+  directory {
+    enable            = true
+    refresh_on_create = true
+  }
+
+  file_format {
+    format_name = snowflake_file_format.example.fully_qualified_name
+  }
+}
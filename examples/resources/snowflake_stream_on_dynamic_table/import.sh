@@ -0,0 +1 @@
+terraform import snowflake_stream_on_dynamic_table.example '"<database_name>"."<schema_name>"."<stream_name>"'
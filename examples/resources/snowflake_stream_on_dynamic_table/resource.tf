@@ -0,0 +1,25 @@
+# basic resource
+resource "snowflake_stream_on_dynamic_table" "stream" {
+  name     = "stream"
+  schema   = "schema"
+  database = "database"
+
+  dynamic_table = snowflake_dynamic_table.example.fully_qualified_name
+}
+
+# resource with more fields set
+resource "snowflake_stream_on_dynamic_table" "stream" {
+  name     = "stream"
+  schema   = "schema"
+  database = "database"
+
+  copy_grants       = true
+  dynamic_table     = snowflake_dynamic_table.example.fully_qualified_name
+  show_initial_rows = "true"
+
+  at {
+    statement = "8e5d0ca9-005e-44e6-b858-a8f5b37c5726"
+  }
+
+  comment = "A stream."
+}
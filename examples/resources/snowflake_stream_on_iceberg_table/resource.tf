@@ -0,0 +1,26 @@
+# basic resource
+resource "snowflake_stream_on_iceberg_table" "stream" {
+  name     = "stream"
+  schema   = "schema"
+  database = "database"
+
+  iceberg_table = "\"database\".\"schema\".\"iceberg_table\""
+}
+
+# resource with more fields set
+resource "snowflake_stream_on_iceberg_table" "stream" {
+  name     = "stream"
+  schema   = "schema"
+  database = "database"
+
+  copy_grants       = true
+  iceberg_table     = "\"database\".\"schema\".\"iceberg_table\""
+  insert_only       = "true"
+  show_initial_rows = "true"
+
+  before {
+    offset = "-60"
+  }
+
+  comment = "A stream."
+}
@@ -20,3 +20,17 @@ resource "snowflake_streamlit" "streamlit" {
   title                        = "title"
   comment                      = "comment"
 }
+
+# resource with the files copied from a source location into versions managed by Snowflake
+resource "snowflake_streamlit" "streamlit" {
+  database        = "database"
+  schema          = "schema"
+  name            = "streamlit"
+  source_location = "@${snowflake_stage_internal.example.fully_qualified_name}/app"
+  main_file       = "streamlit_app.py"
+  imports         = ["@${snowflake_stage_internal.example.fully_qualified_name}/libs/lib.zip"]
+  query_warehouse = snowflake_warehouse.example.fully_qualified_name
+
+  # changing the revision commits a new version of the app, e.g. after new files are uploaded to the stage
+  source_revision = snowflake_stage_file.app.content_hash
+}
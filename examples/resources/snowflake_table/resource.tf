@@ -63,3 +63,31 @@ resource "snowflake_table" "table" {
     keys = ["data"]
   }
 }
+
+# zero-copy clone of another table as it was one hour ago
+resource "snowflake_table" "clone" {
+  database = snowflake_schema.schema.database
+  schema   = snowflake_schema.schema.name
+  name     = "table_clone"
+
+  from_clone {
+    source_table = snowflake_table.table.fully_qualified_name
+    at {
+      offset = -3600
+    }
+  }
+}
+
+# table with columns inferred from the Parquet files in a stage
+resource "snowflake_table" "inferred" {
+  database = snowflake_schema.schema.database
+  schema   = snowflake_schema.schema.name
+  name     = "table_inferred"
+
+  from_template {
+    infer_schema {
+      location    = "@\"${snowflake_schema.schema.database}\".\"${snowflake_schema.schema.name}\".\"parquet_stage\""
+      file_format = "\"${snowflake_schema.schema.database}\".\"${snowflake_schema.schema.name}\".\"parquet_format\""
+    }
+  }
+}
@@ -0,0 +1,25 @@
+# basic resource - executes the task once
+resource "snowflake_task_execution" "basic" {
+  task = snowflake_task.task.fully_qualified_name
+}
+
+# run the task graph every time its definition changes and wait for the run of the root task
+resource "snowflake_task_execution" "on_change" {
+  task                = snowflake_task_graph.graph.fully_qualified_name
+  wait_for_completion = true
+
+  triggers = {
+    root_sql_statement = snowflake_task_graph.graph.sql_statement
+    child_tasks        = jsonencode(snowflake_task_graph.graph.task)
+  }
+
+  timeouts {
+    create = "30m"
+  }
+}
+
+# retry the last failed run of the task graph
+resource "snowflake_task_execution" "retry" {
+  task       = snowflake_task_graph.graph.fully_qualified_name
+  retry_last = true
+}
@@ -0,0 +1,64 @@
+# basic resource
+resource "snowflake_task_graph" "basic" {
+  database      = "database"
+  schema        = "schema"
+  name          = "root_task"
+  started       = true
+  sql_statement = "SELECT 1"
+
+  schedule {
+    minutes = 10
+  }
+
+  task {
+    name          = "child_task"
+    after         = ["root_task"]
+    sql_statement = "SELECT 2"
+  }
+}
+
+# complete resource
+resource "snowflake_task_graph" "complete" {
+  database          = "database"
+  schema            = "schema"
+  name              = "root_task"
+  started           = true
+  warehouse         = "warehouse"
+  config            = "{\"key\":\"value\"}"
+  error_integration = "<error_integration_name>"
+  comment           = "root task of the graph"
+  when              = "SYSTEM$STREAM_HAS_DATA('<stream_name>')"
+  sql_statement     = "SELECT 1"
+
+  schedule {
+    using_cron = "0 * * * * UTC"
+  }
+
+  task {
+    name          = "load"
+    after         = ["root_task"]
+    warehouse     = "warehouse"
+    comment       = "loads the data"
+    sql_statement = "CALL load_data()"
+  }
+
+  task {
+    name          = "transform"
+    after         = ["root_task"]
+    sql_statement = "CALL transform_data()"
+  }
+
+  task {
+    name          = "publish"
+    after         = ["load", "transform"]
+    when          = "SYSTEM$GET_PREDECESSOR_RETURN_VALUE('LOAD') = 'OK'"
+    sql_statement = "CALL publish_data()"
+  }
+
+  finalizer {
+    name          = "cleanup"
+    warehouse     = "warehouse"
+    comment       = "releases the resources used by the graph"
+    sql_statement = "CALL cleanup()"
+  }
+}
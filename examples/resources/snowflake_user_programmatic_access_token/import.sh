@@ -0,0 +1 @@
+terraform import snowflake_user_programmatic_access_token.example '"<user_name>"|"<token_name>"'
@@ -0,0 +1,37 @@
+# basic resource
+resource "snowflake_user_programmatic_access_token" "basic" {
+  user = snowflake_user.example.name
+  name = "token"
+}
+
+# complete resource
+resource "snowflake_user_programmatic_access_token" "complete" {
+  user                                      = snowflake_service_user.example.name
+  name                                      = "token"
+  role_restriction                          = snowflake_account_role.example.name
+  days_to_expiry                            = 30
+  mins_to_bypass_network_policy_requirement = 10
+  disabled                                  = "false"
+  comment                                   = "Token used by the ingestion pipeline."
+}
+
+# token rotated periodically
+resource "time_rotating" "rotation" {
+  rotation_days = 7
+}
+
+resource "snowflake_user_programmatic_access_token" "rotated" {
+  user                             = snowflake_service_user.example.name
+  name                             = "rotated_token"
+  role_restriction                 = snowflake_account_role.example.name
+  expire_rotated_token_after_hours = 1
+
+  keepers = {
+    rotation = time_rotating.rotation.rotation_rfc3339
+  }
+}
+
+output "token" {
+  value     = snowflake_user_programmatic_access_token.rotated.token
+  sensitive = true
+}
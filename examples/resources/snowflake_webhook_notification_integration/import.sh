@@ -0,0 +1 @@
+terraform import snowflake_webhook_notification_integration.example '"<notification_integration_name>"'
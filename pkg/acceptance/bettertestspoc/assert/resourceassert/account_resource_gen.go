@@ -112,6 +112,11 @@ func (a *AccountResourceAssert) HasRegionGroupString(expected string) *AccountRe
 	return a
 }
 
+func (a *AccountResourceAssert) HasSaveOldUrlString(expected string) *AccountResourceAssert {
+	a.AddAssertion(assert.ValueSet("save_old_url", expected))
+	return a
+}
+
 ////////////////////////////
 // Attribute empty checks //
 ////////////////////////////
@@ -195,3 +200,8 @@ func (a *AccountResourceAssert) HasNoRegionGroup() *AccountResourceAssert {
 	a.AddAssertion(assert.ValueNotSet("region_group"))
 	return a
 }
+
+func (a *AccountResourceAssert) HasNoSaveOldUrl() *AccountResourceAssert {
+	a.AddAssertion(assert.ValueNotSet("save_old_url"))
+	return a
+}
@@ -67,6 +67,21 @@ func (f *FunctionJavaResourceAssert) HasFunctionDefinitionString(expected string
 	return f
 }
 
+func (f *FunctionJavaResourceAssert) HasFunctionDefinitionDiffString(expected string) *FunctionJavaResourceAssert {
+	f.AddAssertion(assert.ValueSet("function_definition_diff", expected))
+	return f
+}
+
+func (f *FunctionJavaResourceAssert) HasFunctionDefinitionFileString(expected string) *FunctionJavaResourceAssert {
+	f.AddAssertion(assert.ValueSet("function_definition_file", expected))
+	return f
+}
+
+func (f *FunctionJavaResourceAssert) HasFunctionDefinitionHashString(expected string) *FunctionJavaResourceAssert {
+	f.AddAssertion(assert.ValueSet("function_definition_hash", expected))
+	return f
+}
+
 func (f *FunctionJavaResourceAssert) HasFunctionLanguageString(expected string) *FunctionJavaResourceAssert {
 	f.AddAssertion(assert.ValueSet("function_language", expected))
 	return f
@@ -186,6 +201,21 @@ func (f *FunctionJavaResourceAssert) HasNoFunctionDefinition() *FunctionJavaReso
 	return f
 }
 
+func (f *FunctionJavaResourceAssert) HasNoFunctionDefinitionDiff() *FunctionJavaResourceAssert {
+	f.AddAssertion(assert.ValueNotSet("function_definition_diff"))
+	return f
+}
+
+func (f *FunctionJavaResourceAssert) HasNoFunctionDefinitionFile() *FunctionJavaResourceAssert {
+	f.AddAssertion(assert.ValueNotSet("function_definition_file"))
+	return f
+}
+
+func (f *FunctionJavaResourceAssert) HasNoFunctionDefinitionHash() *FunctionJavaResourceAssert {
+	f.AddAssertion(assert.ValueNotSet("function_definition_hash"))
+	return f
+}
+
 func (f *FunctionJavaResourceAssert) HasNoFunctionLanguage() *FunctionJavaResourceAssert {
 	f.AddAssertion(assert.ValueNotSet("function_language"))
 	return f
@@ -62,6 +62,21 @@ func (f *FunctionJavascriptResourceAssert) HasFunctionDefinitionString(expected
 	return f
 }
 
+func (f *FunctionJavascriptResourceAssert) HasFunctionDefinitionDiffString(expected string) *FunctionJavascriptResourceAssert {
+	f.AddAssertion(assert.ValueSet("function_definition_diff", expected))
+	return f
+}
+
+func (f *FunctionJavascriptResourceAssert) HasFunctionDefinitionFileString(expected string) *FunctionJavascriptResourceAssert {
+	f.AddAssertion(assert.ValueSet("function_definition_file", expected))
+	return f
+}
+
+func (f *FunctionJavascriptResourceAssert) HasFunctionDefinitionHashString(expected string) *FunctionJavascriptResourceAssert {
+	f.AddAssertion(assert.ValueSet("function_definition_hash", expected))
+	return f
+}
+
 func (f *FunctionJavascriptResourceAssert) HasFunctionLanguageString(expected string) *FunctionJavascriptResourceAssert {
 	f.AddAssertion(assert.ValueSet("function_language", expected))
 	return f
@@ -146,6 +161,21 @@ func (f *FunctionJavascriptResourceAssert) HasNoFunctionDefinition() *FunctionJa
 	return f
 }
 
+func (f *FunctionJavascriptResourceAssert) HasNoFunctionDefinitionDiff() *FunctionJavascriptResourceAssert {
+	f.AddAssertion(assert.ValueNotSet("function_definition_diff"))
+	return f
+}
+
+func (f *FunctionJavascriptResourceAssert) HasNoFunctionDefinitionFile() *FunctionJavascriptResourceAssert {
+	f.AddAssertion(assert.ValueNotSet("function_definition_file"))
+	return f
+}
+
+func (f *FunctionJavascriptResourceAssert) HasNoFunctionDefinitionHash() *FunctionJavascriptResourceAssert {
+	f.AddAssertion(assert.ValueNotSet("function_definition_hash"))
+	return f
+}
+
 func (f *FunctionJavascriptResourceAssert) HasNoFunctionLanguage() *FunctionJavascriptResourceAssert {
 	f.AddAssertion(assert.ValueNotSet("function_language"))
 	return f
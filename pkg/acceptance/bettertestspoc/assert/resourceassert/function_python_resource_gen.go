@@ -67,6 +67,21 @@ func (f *FunctionPythonResourceAssert) HasFunctionDefinitionString(expected stri
 	return f
 }
 
+func (f *FunctionPythonResourceAssert) HasFunctionDefinitionDiffString(expected string) *FunctionPythonResourceAssert {
+	f.AddAssertion(assert.ValueSet("function_definition_diff", expected))
+	return f
+}
+
+func (f *FunctionPythonResourceAssert) HasFunctionDefinitionFileString(expected string) *FunctionPythonResourceAssert {
+	f.AddAssertion(assert.ValueSet("function_definition_file", expected))
+	return f
+}
+
+func (f *FunctionPythonResourceAssert) HasFunctionDefinitionHashString(expected string) *FunctionPythonResourceAssert {
+	f.AddAssertion(assert.ValueSet("function_definition_hash", expected))
+	return f
+}
+
 func (f *FunctionPythonResourceAssert) HasFunctionLanguageString(expected string) *FunctionPythonResourceAssert {
 	f.AddAssertion(assert.ValueSet("function_language", expected))
 	return f
@@ -186,6 +201,21 @@ func (f *FunctionPythonResourceAssert) HasNoFunctionDefinition() *FunctionPython
 	return f
 }
 
+func (f *FunctionPythonResourceAssert) HasNoFunctionDefinitionDiff() *FunctionPythonResourceAssert {
+	f.AddAssertion(assert.ValueNotSet("function_definition_diff"))
+	return f
+}
+
+func (f *FunctionPythonResourceAssert) HasNoFunctionDefinitionFile() *FunctionPythonResourceAssert {
+	f.AddAssertion(assert.ValueNotSet("function_definition_file"))
+	return f
+}
+
+func (f *FunctionPythonResourceAssert) HasNoFunctionDefinitionHash() *FunctionPythonResourceAssert {
+	f.AddAssertion(assert.ValueNotSet("function_definition_hash"))
+	return f
+}
+
 func (f *FunctionPythonResourceAssert) HasNoFunctionLanguage() *FunctionPythonResourceAssert {
 	f.AddAssertion(assert.ValueNotSet("function_language"))
 	return f
@@ -67,6 +67,21 @@ func (f *FunctionScalaResourceAssert) HasFunctionDefinitionString(expected strin
 	return f
 }
 
+func (f *FunctionScalaResourceAssert) HasFunctionDefinitionDiffString(expected string) *FunctionScalaResourceAssert {
+	f.AddAssertion(assert.ValueSet("function_definition_diff", expected))
+	return f
+}
+
+func (f *FunctionScalaResourceAssert) HasFunctionDefinitionFileString(expected string) *FunctionScalaResourceAssert {
+	f.AddAssertion(assert.ValueSet("function_definition_file", expected))
+	return f
+}
+
+func (f *FunctionScalaResourceAssert) HasFunctionDefinitionHashString(expected string) *FunctionScalaResourceAssert {
+	f.AddAssertion(assert.ValueSet("function_definition_hash", expected))
+	return f
+}
+
 func (f *FunctionScalaResourceAssert) HasFunctionLanguageString(expected string) *FunctionScalaResourceAssert {
 	f.AddAssertion(assert.ValueSet("function_language", expected))
 	return f
@@ -186,6 +201,21 @@ func (f *FunctionScalaResourceAssert) HasNoFunctionDefinition() *FunctionScalaRe
 	return f
 }
 
+func (f *FunctionScalaResourceAssert) HasNoFunctionDefinitionDiff() *FunctionScalaResourceAssert {
+	f.AddAssertion(assert.ValueNotSet("function_definition_diff"))
+	return f
+}
+
+func (f *FunctionScalaResourceAssert) HasNoFunctionDefinitionFile() *FunctionScalaResourceAssert {
+	f.AddAssertion(assert.ValueNotSet("function_definition_file"))
+	return f
+}
+
+func (f *FunctionScalaResourceAssert) HasNoFunctionDefinitionHash() *FunctionScalaResourceAssert {
+	f.AddAssertion(assert.ValueNotSet("function_definition_hash"))
+	return f
+}
+
 func (f *FunctionScalaResourceAssert) HasNoFunctionLanguage() *FunctionScalaResourceAssert {
 	f.AddAssertion(assert.ValueNotSet("function_language"))
 	return f
@@ -62,6 +62,21 @@ func (f *FunctionSqlResourceAssert) HasFunctionDefinitionString(expected string)
 	return f
 }
 
+func (f *FunctionSqlResourceAssert) HasFunctionDefinitionDiffString(expected string) *FunctionSqlResourceAssert {
+	f.AddAssertion(assert.ValueSet("function_definition_diff", expected))
+	return f
+}
+
+func (f *FunctionSqlResourceAssert) HasFunctionDefinitionFileString(expected string) *FunctionSqlResourceAssert {
+	f.AddAssertion(assert.ValueSet("function_definition_file", expected))
+	return f
+}
+
+func (f *FunctionSqlResourceAssert) HasFunctionDefinitionHashString(expected string) *FunctionSqlResourceAssert {
+	f.AddAssertion(assert.ValueSet("function_definition_hash", expected))
+	return f
+}
+
 func (f *FunctionSqlResourceAssert) HasFunctionLanguageString(expected string) *FunctionSqlResourceAssert {
 	f.AddAssertion(assert.ValueSet("function_language", expected))
 	return f
@@ -146,6 +161,21 @@ func (f *FunctionSqlResourceAssert) HasNoFunctionDefinition() *FunctionSqlResour
 	return f
 }
 
+func (f *FunctionSqlResourceAssert) HasNoFunctionDefinitionDiff() *FunctionSqlResourceAssert {
+	f.AddAssertion(assert.ValueNotSet("function_definition_diff"))
+	return f
+}
+
+func (f *FunctionSqlResourceAssert) HasNoFunctionDefinitionFile() *FunctionSqlResourceAssert {
+	f.AddAssertion(assert.ValueNotSet("function_definition_file"))
+	return f
+}
+
+func (f *FunctionSqlResourceAssert) HasNoFunctionDefinitionHash() *FunctionSqlResourceAssert {
+	f.AddAssertion(assert.ValueNotSet("function_definition_hash"))
+	return f
+}
+
 func (f *FunctionSqlResourceAssert) HasNoFunctionLanguage() *FunctionSqlResourceAssert {
 	f.AddAssertion(assert.ValueNotSet("function_language"))
 	return f
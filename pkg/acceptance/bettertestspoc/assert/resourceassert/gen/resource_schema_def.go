@@ -121,6 +121,10 @@ var allResourceSchemaDefs = []ResourceSchemaDef{
 		name:   "Account",
 		schema: resources.Account().Schema,
 	},
+	{
+		name:   "AccountPolicies",
+		schema: resources.AccountPolicies().Schema,
+	},
 	{
 		name:   "AccountParameter",
 		schema: resources.AccountParameter().Schema,
@@ -177,6 +181,14 @@ var allResourceSchemaDefs = []ResourceSchemaDef{
 		name:   "ManagedAccount",
 		schema: resources.ManagedAccount().Schema,
 	},
+	{
+		name:   "OrganizationAccount",
+		schema: resources.OrganizationAccount().Schema,
+	},
+	{
+		name:   "ExternalAccessIntegration",
+		schema: resources.ExternalAccessIntegration().Schema,
+	},
 	{
 		name:   "NetworkPolicy",
 		schema: resources.NetworkPolicy().Schema,
@@ -189,6 +201,10 @@ var allResourceSchemaDefs = []ResourceSchemaDef{
 		name:   "Saml2SecurityIntegration",
 		schema: resources.SAML2Integration().Schema,
 	},
+	{
+		name:   "ApiAuthenticationIntegrationWithAwsIam",
+		schema: resources.ApiAuthenticationIntegrationWithAwsIam().Schema,
+	},
 	{
 		name:   "ApiAuthenticationIntegrationWithAuthorizationCodeGrant",
 		schema: resources.ApiAuthenticationIntegrationWithAuthorizationCodeGrant().Schema,
@@ -217,4 +233,20 @@ var allResourceSchemaDefs = []ResourceSchemaDef{
 		name:   "SharedDatabase",
 		schema: resources.SharedDatabase().Schema,
 	},
+	{
+		name:   "WebhookNotificationIntegration",
+		schema: resources.WebhookNotificationIntegration().Schema,
+	},
+	{
+		name:   "NotificationIntegrationAwsSns",
+		schema: resources.NotificationIntegrationAwsSns().Schema,
+	},
+	{
+		name:   "NotificationIntegrationAzureEventGrid",
+		schema: resources.NotificationIntegrationAzureEventGrid().Schema,
+	},
+	{
+		name:   "NotificationIntegrationGcpPubsub",
+		schema: resources.NotificationIntegrationGcpPubsub().Schema,
+	},
 }
@@ -112,6 +112,21 @@ func (p *ProcedureJavaResourceAssert) HasProcedureDefinitionString(expected stri
 	return p
 }
 
+func (p *ProcedureJavaResourceAssert) HasProcedureDefinitionDiffString(expected string) *ProcedureJavaResourceAssert {
+	p.AddAssertion(assert.ValueSet("procedure_definition_diff", expected))
+	return p
+}
+
+func (p *ProcedureJavaResourceAssert) HasProcedureDefinitionFileString(expected string) *ProcedureJavaResourceAssert {
+	p.AddAssertion(assert.ValueSet("procedure_definition_file", expected))
+	return p
+}
+
+func (p *ProcedureJavaResourceAssert) HasProcedureDefinitionHashString(expected string) *ProcedureJavaResourceAssert {
+	p.AddAssertion(assert.ValueSet("procedure_definition_hash", expected))
+	return p
+}
+
 func (p *ProcedureJavaResourceAssert) HasProcedureLanguageString(expected string) *ProcedureJavaResourceAssert {
 	p.AddAssertion(assert.ValueSet("procedure_language", expected))
 	return p
@@ -236,6 +251,21 @@ func (p *ProcedureJavaResourceAssert) HasNoProcedureDefinition() *ProcedureJavaR
 	return p
 }
 
+func (p *ProcedureJavaResourceAssert) HasNoProcedureDefinitionDiff() *ProcedureJavaResourceAssert {
+	p.AddAssertion(assert.ValueNotSet("procedure_definition_diff"))
+	return p
+}
+
+func (p *ProcedureJavaResourceAssert) HasNoProcedureDefinitionFile() *ProcedureJavaResourceAssert {
+	p.AddAssertion(assert.ValueNotSet("procedure_definition_file"))
+	return p
+}
+
+func (p *ProcedureJavaResourceAssert) HasNoProcedureDefinitionHash() *ProcedureJavaResourceAssert {
+	p.AddAssertion(assert.ValueNotSet("procedure_definition_hash"))
+	return p
+}
+
 func (p *ProcedureJavaResourceAssert) HasNoProcedureLanguage() *ProcedureJavaResourceAssert {
 	p.AddAssertion(assert.ValueNotSet("procedure_language"))
 	return p
@@ -92,6 +92,21 @@ func (p *ProcedureJavascriptResourceAssert) HasProcedureDefinitionString(expecte
 	return p
 }
 
+func (p *ProcedureJavascriptResourceAssert) HasProcedureDefinitionDiffString(expected string) *ProcedureJavascriptResourceAssert {
+	p.AddAssertion(assert.ValueSet("procedure_definition_diff", expected))
+	return p
+}
+
+func (p *ProcedureJavascriptResourceAssert) HasProcedureDefinitionFileString(expected string) *ProcedureJavascriptResourceAssert {
+	p.AddAssertion(assert.ValueSet("procedure_definition_file", expected))
+	return p
+}
+
+func (p *ProcedureJavascriptResourceAssert) HasProcedureDefinitionHashString(expected string) *ProcedureJavascriptResourceAssert {
+	p.AddAssertion(assert.ValueSet("procedure_definition_hash", expected))
+	return p
+}
+
 func (p *ProcedureJavascriptResourceAssert) HasProcedureLanguageString(expected string) *ProcedureJavascriptResourceAssert {
 	p.AddAssertion(assert.ValueSet("procedure_language", expected))
 	return p
@@ -176,6 +191,21 @@ func (p *ProcedureJavascriptResourceAssert) HasNoProcedureDefinition() *Procedur
 	return p
 }
 
+func (p *ProcedureJavascriptResourceAssert) HasNoProcedureDefinitionDiff() *ProcedureJavascriptResourceAssert {
+	p.AddAssertion(assert.ValueNotSet("procedure_definition_diff"))
+	return p
+}
+
+func (p *ProcedureJavascriptResourceAssert) HasNoProcedureDefinitionFile() *ProcedureJavascriptResourceAssert {
+	p.AddAssertion(assert.ValueNotSet("procedure_definition_file"))
+	return p
+}
+
+func (p *ProcedureJavascriptResourceAssert) HasNoProcedureDefinitionHash() *ProcedureJavascriptResourceAssert {
+	p.AddAssertion(assert.ValueNotSet("procedure_definition_hash"))
+	return p
+}
+
 func (p *ProcedureJavascriptResourceAssert) HasNoProcedureLanguage() *ProcedureJavascriptResourceAssert {
 	p.AddAssertion(assert.ValueNotSet("procedure_language"))
 	return p
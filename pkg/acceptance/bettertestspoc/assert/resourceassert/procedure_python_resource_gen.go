@@ -112,6 +112,21 @@ func (p *ProcedurePythonResourceAssert) HasProcedureDefinitionString(expected st
 	return p
 }
 
+func (p *ProcedurePythonResourceAssert) HasProcedureDefinitionDiffString(expected string) *ProcedurePythonResourceAssert {
+	p.AddAssertion(assert.ValueSet("procedure_definition_diff", expected))
+	return p
+}
+
+func (p *ProcedurePythonResourceAssert) HasProcedureDefinitionFileString(expected string) *ProcedurePythonResourceAssert {
+	p.AddAssertion(assert.ValueSet("procedure_definition_file", expected))
+	return p
+}
+
+func (p *ProcedurePythonResourceAssert) HasProcedureDefinitionHashString(expected string) *ProcedurePythonResourceAssert {
+	p.AddAssertion(assert.ValueSet("procedure_definition_hash", expected))
+	return p
+}
+
 func (p *ProcedurePythonResourceAssert) HasProcedureLanguageString(expected string) *ProcedurePythonResourceAssert {
 	p.AddAssertion(assert.ValueSet("procedure_language", expected))
 	return p
@@ -231,6 +246,21 @@ func (p *ProcedurePythonResourceAssert) HasNoProcedureDefinition() *ProcedurePyt
 	return p
 }
 
+func (p *ProcedurePythonResourceAssert) HasNoProcedureDefinitionDiff() *ProcedurePythonResourceAssert {
+	p.AddAssertion(assert.ValueNotSet("procedure_definition_diff"))
+	return p
+}
+
+func (p *ProcedurePythonResourceAssert) HasNoProcedureDefinitionFile() *ProcedurePythonResourceAssert {
+	p.AddAssertion(assert.ValueNotSet("procedure_definition_file"))
+	return p
+}
+
+func (p *ProcedurePythonResourceAssert) HasNoProcedureDefinitionHash() *ProcedurePythonResourceAssert {
+	p.AddAssertion(assert.ValueNotSet("procedure_definition_hash"))
+	return p
+}
+
 func (p *ProcedurePythonResourceAssert) HasNoProcedureLanguage() *ProcedurePythonResourceAssert {
 	p.AddAssertion(assert.ValueNotSet("procedure_language"))
 	return p
@@ -112,6 +112,21 @@ func (p *ProcedureScalaResourceAssert) HasProcedureDefinitionString(expected str
 	return p
 }
 
+func (p *ProcedureScalaResourceAssert) HasProcedureDefinitionDiffString(expected string) *ProcedureScalaResourceAssert {
+	p.AddAssertion(assert.ValueSet("procedure_definition_diff", expected))
+	return p
+}
+
+func (p *ProcedureScalaResourceAssert) HasProcedureDefinitionFileString(expected string) *ProcedureScalaResourceAssert {
+	p.AddAssertion(assert.ValueSet("procedure_definition_file", expected))
+	return p
+}
+
+func (p *ProcedureScalaResourceAssert) HasProcedureDefinitionHashString(expected string) *ProcedureScalaResourceAssert {
+	p.AddAssertion(assert.ValueSet("procedure_definition_hash", expected))
+	return p
+}
+
 func (p *ProcedureScalaResourceAssert) HasProcedureLanguageString(expected string) *ProcedureScalaResourceAssert {
 	p.AddAssertion(assert.ValueSet("procedure_language", expected))
 	return p
@@ -236,6 +251,21 @@ func (p *ProcedureScalaResourceAssert) HasNoProcedureDefinition() *ProcedureScal
 	return p
 }
 
+func (p *ProcedureScalaResourceAssert) HasNoProcedureDefinitionDiff() *ProcedureScalaResourceAssert {
+	p.AddAssertion(assert.ValueNotSet("procedure_definition_diff"))
+	return p
+}
+
+func (p *ProcedureScalaResourceAssert) HasNoProcedureDefinitionFile() *ProcedureScalaResourceAssert {
+	p.AddAssertion(assert.ValueNotSet("procedure_definition_file"))
+	return p
+}
+
+func (p *ProcedureScalaResourceAssert) HasNoProcedureDefinitionHash() *ProcedureScalaResourceAssert {
+	p.AddAssertion(assert.ValueNotSet("procedure_definition_hash"))
+	return p
+}
+
 func (p *ProcedureScalaResourceAssert) HasNoProcedureLanguage() *ProcedureScalaResourceAssert {
 	p.AddAssertion(assert.ValueNotSet("procedure_language"))
 	return p
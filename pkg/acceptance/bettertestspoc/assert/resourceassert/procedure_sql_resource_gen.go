@@ -92,6 +92,21 @@ func (p *ProcedureSqlResourceAssert) HasProcedureDefinitionString(expected strin
 	return p
 }
 
+func (p *ProcedureSqlResourceAssert) HasProcedureDefinitionDiffString(expected string) *ProcedureSqlResourceAssert {
+	p.AddAssertion(assert.ValueSet("procedure_definition_diff", expected))
+	return p
+}
+
+func (p *ProcedureSqlResourceAssert) HasProcedureDefinitionFileString(expected string) *ProcedureSqlResourceAssert {
+	p.AddAssertion(assert.ValueSet("procedure_definition_file", expected))
+	return p
+}
+
+func (p *ProcedureSqlResourceAssert) HasProcedureDefinitionHashString(expected string) *ProcedureSqlResourceAssert {
+	p.AddAssertion(assert.ValueSet("procedure_definition_hash", expected))
+	return p
+}
+
 func (p *ProcedureSqlResourceAssert) HasProcedureLanguageString(expected string) *ProcedureSqlResourceAssert {
 	p.AddAssertion(assert.ValueSet("procedure_language", expected))
 	return p
@@ -176,6 +191,21 @@ func (p *ProcedureSqlResourceAssert) HasNoProcedureDefinition() *ProcedureSqlRes
 	return p
 }
 
+func (p *ProcedureSqlResourceAssert) HasNoProcedureDefinitionDiff() *ProcedureSqlResourceAssert {
+	p.AddAssertion(assert.ValueNotSet("procedure_definition_diff"))
+	return p
+}
+
+func (p *ProcedureSqlResourceAssert) HasNoProcedureDefinitionFile() *ProcedureSqlResourceAssert {
+	p.AddAssertion(assert.ValueNotSet("procedure_definition_file"))
+	return p
+}
+
+func (p *ProcedureSqlResourceAssert) HasNoProcedureDefinitionHash() *ProcedureSqlResourceAssert {
+	p.AddAssertion(assert.ValueNotSet("procedure_definition_hash"))
+	return p
+}
+
 func (p *ProcedureSqlResourceAssert) HasNoProcedureLanguage() *ProcedureSqlResourceAssert {
 	p.AddAssertion(assert.ValueNotSet("procedure_language"))
 	return p
@@ -50,6 +50,10 @@ var allDatasourcesSchemaDefs = []DatasourceSchemaDef{
 		name:   "MaskingPolicies",
 		schema: datasources.MaskingPolicies().Schema,
 	},
+	{
+		name:   "ExternalAccessIntegrations",
+		schema: datasources.ExternalAccessIntegrations().Schema,
+	},
 	{
 		name:   "NetworkPolicies",
 		schema: datasources.NetworkPolicies().Schema,
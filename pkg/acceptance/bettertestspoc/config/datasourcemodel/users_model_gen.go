@@ -17,6 +17,7 @@ type UsersModel struct {
 	StartsWith     tfconfig.Variable `json:"starts_with,omitempty"`
 	Users          tfconfig.Variable `json:"users,omitempty"`
 	WithDescribe   tfconfig.Variable `json:"with_describe,omitempty"`
+	WithMfaMethods tfconfig.Variable `json:"with_mfa_methods,omitempty"`
 	WithParameters tfconfig.Variable `json:"with_parameters,omitempty"`
 
 	*config.DatasourceModelMeta
@@ -83,6 +84,11 @@ func (u *UsersModel) WithWithDescribe(withDescribe bool) *UsersModel {
 	return u
 }
 
+func (u *UsersModel) WithWithMfaMethods(withMfaMethods bool) *UsersModel {
+	u.WithMfaMethods = tfconfig.BoolVariable(withMfaMethods)
+	return u
+}
+
 func (u *UsersModel) WithWithParameters(withParameters bool) *UsersModel {
 	u.WithParameters = tfconfig.BoolVariable(withParameters)
 	return u
@@ -117,6 +123,11 @@ func (u *UsersModel) WithWithDescribeValue(value tfconfig.Variable) *UsersModel
 	return u
 }
 
+func (u *UsersModel) WithWithMfaMethodsValue(value tfconfig.Variable) *UsersModel {
+	u.WithMfaMethods = value
+	return u
+}
+
 func (u *UsersModel) WithWithParametersValue(value tfconfig.Variable) *UsersModel {
 	u.WithParameters = value
 	return u
@@ -28,6 +28,7 @@ type AccountModel struct {
 	Name               tfconfig.Variable `json:"name,omitempty"`
 	Region             tfconfig.Variable `json:"region,omitempty"`
 	RegionGroup        tfconfig.Variable `json:"region_group,omitempty"`
+	SaveOldUrl         tfconfig.Variable `json:"save_old_url,omitempty"`
 
 	*config.ResourceModelMeta
 }
@@ -173,6 +174,11 @@ func (a *AccountModel) WithRegionGroup(regionGroup string) *AccountModel {
 	return a
 }
 
+func (a *AccountModel) WithSaveOldUrl(saveOldUrl string) *AccountModel {
+	a.SaveOldUrl = tfconfig.StringVariable(saveOldUrl)
+	return a
+}
+
 //////////////////////////////////////////
 // below it's possible to set any value //
 //////////////////////////////////////////
@@ -256,3 +262,8 @@ func (a *AccountModel) WithRegionGroupValue(value tfconfig.Variable) *AccountMod
 	a.RegionGroup = value
 	return a
 }
+
+func (a *AccountModel) WithSaveOldUrlValue(value tfconfig.Variable) *AccountModel {
+	a.SaveOldUrl = value
+	return a
+}
@@ -0,0 +1,38 @@
+package model
+
+import (
+	tfconfig "github.com/hashicorp/terraform-plugin-testing/config"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+)
+
+func (e *ExternalAccessIntegrationModel) WithAllowedNetworkRules(rules ...sdk.SchemaObjectIdentifier) *ExternalAccessIntegrationModel {
+	return e.WithAllowedNetworkRulesValue(
+		tfconfig.SetVariable(
+			collections.Map(rules, func(rule sdk.SchemaObjectIdentifier) tfconfig.Variable {
+				return tfconfig.StringVariable(rule.FullyQualifiedName())
+			})...,
+		),
+	)
+}
+
+func (e *ExternalAccessIntegrationModel) WithAllowedApiAuthenticationIntegrations(integrations ...sdk.AccountObjectIdentifier) *ExternalAccessIntegrationModel {
+	return e.WithAllowedApiAuthenticationIntegrationsValue(
+		tfconfig.SetVariable(
+			collections.Map(integrations, func(integration sdk.AccountObjectIdentifier) tfconfig.Variable {
+				return tfconfig.StringVariable(integration.FullyQualifiedName())
+			})...,
+		),
+	)
+}
+
+func (e *ExternalAccessIntegrationModel) WithAllowedAuthenticationSecrets(secrets ...sdk.SchemaObjectIdentifier) *ExternalAccessIntegrationModel {
+	return e.WithAllowedAuthenticationSecretsValue(
+		tfconfig.SetVariable(
+			collections.Map(secrets, func(secret sdk.SchemaObjectIdentifier) tfconfig.Variable {
+				return tfconfig.StringVariable(secret.FullyQualifiedName())
+			})...,
+		),
+	)
+}
@@ -19,6 +19,9 @@ type FunctionJavaModel struct {
 	ExternalAccessIntegrations tfconfig.Variable `json:"external_access_integrations,omitempty"`
 	FullyQualifiedName         tfconfig.Variable `json:"fully_qualified_name,omitempty"`
 	FunctionDefinition         tfconfig.Variable `json:"function_definition,omitempty"`
+	FunctionDefinitionDiff     tfconfig.Variable `json:"function_definition_diff,omitempty"`
+	FunctionDefinitionFile     tfconfig.Variable `json:"function_definition_file,omitempty"`
+	FunctionDefinitionHash     tfconfig.Variable `json:"function_definition_hash,omitempty"`
 	FunctionLanguage           tfconfig.Variable `json:"function_language,omitempty"`
 	Handler                    tfconfig.Variable `json:"handler,omitempty"`
 	Imports                    tfconfig.Variable `json:"imports,omitempty"`
@@ -129,6 +132,21 @@ func (f *FunctionJavaModel) WithFunctionDefinition(functionDefinition string) *F
 	return f
 }
 
+func (f *FunctionJavaModel) WithFunctionDefinitionDiff(functionDefinitionDiff string) *FunctionJavaModel {
+	f.FunctionDefinitionDiff = tfconfig.StringVariable(functionDefinitionDiff)
+	return f
+}
+
+func (f *FunctionJavaModel) WithFunctionDefinitionFile(functionDefinitionFile string) *FunctionJavaModel {
+	f.FunctionDefinitionFile = tfconfig.StringVariable(functionDefinitionFile)
+	return f
+}
+
+func (f *FunctionJavaModel) WithFunctionDefinitionHash(functionDefinitionHash string) *FunctionJavaModel {
+	f.FunctionDefinitionHash = tfconfig.StringVariable(functionDefinitionHash)
+	return f
+}
+
 func (f *FunctionJavaModel) WithFunctionLanguage(functionLanguage string) *FunctionJavaModel {
 	f.FunctionLanguage = tfconfig.StringVariable(functionLanguage)
 	return f
@@ -236,6 +254,21 @@ func (f *FunctionJavaModel) WithFunctionDefinitionValue(value tfconfig.Variable)
 	return f
 }
 
+func (f *FunctionJavaModel) WithFunctionDefinitionDiffValue(value tfconfig.Variable) *FunctionJavaModel {
+	f.FunctionDefinitionDiff = value
+	return f
+}
+
+func (f *FunctionJavaModel) WithFunctionDefinitionFileValue(value tfconfig.Variable) *FunctionJavaModel {
+	f.FunctionDefinitionFile = value
+	return f
+}
+
+func (f *FunctionJavaModel) WithFunctionDefinitionHashValue(value tfconfig.Variable) *FunctionJavaModel {
+	f.FunctionDefinitionHash = value
+	return f
+}
+
 func (f *FunctionJavaModel) WithFunctionLanguageValue(value tfconfig.Variable) *FunctionJavaModel {
 	f.FunctionLanguage = value
 	return f
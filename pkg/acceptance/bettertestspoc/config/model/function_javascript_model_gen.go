@@ -12,22 +12,25 @@ import (
 )
 
 type FunctionJavascriptModel struct {
-	Arguments             tfconfig.Variable `json:"arguments,omitempty"`
-	Comment               tfconfig.Variable `json:"comment,omitempty"`
-	Database              tfconfig.Variable `json:"database,omitempty"`
-	EnableConsoleOutput   tfconfig.Variable `json:"enable_console_output,omitempty"`
-	FullyQualifiedName    tfconfig.Variable `json:"fully_qualified_name,omitempty"`
-	FunctionDefinition    tfconfig.Variable `json:"function_definition,omitempty"`
-	FunctionLanguage      tfconfig.Variable `json:"function_language,omitempty"`
-	IsSecure              tfconfig.Variable `json:"is_secure,omitempty"`
-	LogLevel              tfconfig.Variable `json:"log_level,omitempty"`
-	MetricLevel           tfconfig.Variable `json:"metric_level,omitempty"`
-	Name                  tfconfig.Variable `json:"name,omitempty"`
-	NullInputBehavior     tfconfig.Variable `json:"null_input_behavior,omitempty"`
-	ReturnResultsBehavior tfconfig.Variable `json:"return_results_behavior,omitempty"`
-	ReturnType            tfconfig.Variable `json:"return_type,omitempty"`
-	Schema                tfconfig.Variable `json:"schema,omitempty"`
-	TraceLevel            tfconfig.Variable `json:"trace_level,omitempty"`
+	Arguments              tfconfig.Variable `json:"arguments,omitempty"`
+	Comment                tfconfig.Variable `json:"comment,omitempty"`
+	Database               tfconfig.Variable `json:"database,omitempty"`
+	EnableConsoleOutput    tfconfig.Variable `json:"enable_console_output,omitempty"`
+	FullyQualifiedName     tfconfig.Variable `json:"fully_qualified_name,omitempty"`
+	FunctionDefinition     tfconfig.Variable `json:"function_definition,omitempty"`
+	FunctionDefinitionDiff tfconfig.Variable `json:"function_definition_diff,omitempty"`
+	FunctionDefinitionFile tfconfig.Variable `json:"function_definition_file,omitempty"`
+	FunctionDefinitionHash tfconfig.Variable `json:"function_definition_hash,omitempty"`
+	FunctionLanguage       tfconfig.Variable `json:"function_language,omitempty"`
+	IsSecure               tfconfig.Variable `json:"is_secure,omitempty"`
+	LogLevel               tfconfig.Variable `json:"log_level,omitempty"`
+	MetricLevel            tfconfig.Variable `json:"metric_level,omitempty"`
+	Name                   tfconfig.Variable `json:"name,omitempty"`
+	NullInputBehavior      tfconfig.Variable `json:"null_input_behavior,omitempty"`
+	ReturnResultsBehavior  tfconfig.Variable `json:"return_results_behavior,omitempty"`
+	ReturnType             tfconfig.Variable `json:"return_type,omitempty"`
+	Schema                 tfconfig.Variable `json:"schema,omitempty"`
+	TraceLevel             tfconfig.Variable `json:"trace_level,omitempty"`
 
 	*config.ResourceModelMeta
 }
@@ -39,14 +42,12 @@ type FunctionJavascriptModel struct {
 func FunctionJavascript(
 	resourceName string,
 	database string,
-	functionDefinition string,
 	name string,
 	returnType string,
 	schema string,
 ) *FunctionJavascriptModel {
 	f := &FunctionJavascriptModel{ResourceModelMeta: config.Meta(resourceName, resources.FunctionJavascript)}
 	f.WithDatabase(database)
-	f.WithFunctionDefinition(functionDefinition)
 	f.WithName(name)
 	f.WithReturnType(returnType)
 	f.WithSchema(schema)
@@ -55,14 +56,12 @@ func FunctionJavascript(
 
 func FunctionJavascriptWithDefaultMeta(
 	database string,
-	functionDefinition string,
 	name string,
 	returnType string,
 	schema string,
 ) *FunctionJavascriptModel {
 	f := &FunctionJavascriptModel{ResourceModelMeta: config.DefaultMeta(resources.FunctionJavascript)}
 	f.WithDatabase(database)
-	f.WithFunctionDefinition(functionDefinition)
 	f.WithName(name)
 	f.WithReturnType(returnType)
 	f.WithSchema(schema)
@@ -120,6 +119,21 @@ func (f *FunctionJavascriptModel) WithFunctionDefinition(functionDefinition stri
 	return f
 }
 
+func (f *FunctionJavascriptModel) WithFunctionDefinitionDiff(functionDefinitionDiff string) *FunctionJavascriptModel {
+	f.FunctionDefinitionDiff = tfconfig.StringVariable(functionDefinitionDiff)
+	return f
+}
+
+func (f *FunctionJavascriptModel) WithFunctionDefinitionFile(functionDefinitionFile string) *FunctionJavascriptModel {
+	f.FunctionDefinitionFile = tfconfig.StringVariable(functionDefinitionFile)
+	return f
+}
+
+func (f *FunctionJavascriptModel) WithFunctionDefinitionHash(functionDefinitionHash string) *FunctionJavascriptModel {
+	f.FunctionDefinitionHash = tfconfig.StringVariable(functionDefinitionHash)
+	return f
+}
+
 func (f *FunctionJavascriptModel) WithFunctionLanguage(functionLanguage string) *FunctionJavascriptModel {
 	f.FunctionLanguage = tfconfig.StringVariable(functionLanguage)
 	return f
@@ -204,6 +218,21 @@ func (f *FunctionJavascriptModel) WithFunctionDefinitionValue(value tfconfig.Var
 	return f
 }
 
+func (f *FunctionJavascriptModel) WithFunctionDefinitionDiffValue(value tfconfig.Variable) *FunctionJavascriptModel {
+	f.FunctionDefinitionDiff = value
+	return f
+}
+
+func (f *FunctionJavascriptModel) WithFunctionDefinitionFileValue(value tfconfig.Variable) *FunctionJavascriptModel {
+	f.FunctionDefinitionFile = value
+	return f
+}
+
+func (f *FunctionJavascriptModel) WithFunctionDefinitionHashValue(value tfconfig.Variable) *FunctionJavascriptModel {
+	f.FunctionDefinitionHash = value
+	return f
+}
+
 func (f *FunctionJavascriptModel) WithFunctionLanguageValue(value tfconfig.Variable) *FunctionJavascriptModel {
 	f.FunctionLanguage = value
 	return f
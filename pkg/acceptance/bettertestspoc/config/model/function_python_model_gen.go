@@ -19,6 +19,9 @@ type FunctionPythonModel struct {
 	ExternalAccessIntegrations tfconfig.Variable `json:"external_access_integrations,omitempty"`
 	FullyQualifiedName         tfconfig.Variable `json:"fully_qualified_name,omitempty"`
 	FunctionDefinition         tfconfig.Variable `json:"function_definition,omitempty"`
+	FunctionDefinitionDiff     tfconfig.Variable `json:"function_definition_diff,omitempty"`
+	FunctionDefinitionFile     tfconfig.Variable `json:"function_definition_file,omitempty"`
+	FunctionDefinitionHash     tfconfig.Variable `json:"function_definition_hash,omitempty"`
 	FunctionLanguage           tfconfig.Variable `json:"function_language,omitempty"`
 	Handler                    tfconfig.Variable `json:"handler,omitempty"`
 	Imports                    tfconfig.Variable `json:"imports,omitempty"`
@@ -133,6 +136,21 @@ func (f *FunctionPythonModel) WithFunctionDefinition(functionDefinition string)
 	return f
 }
 
+func (f *FunctionPythonModel) WithFunctionDefinitionDiff(functionDefinitionDiff string) *FunctionPythonModel {
+	f.FunctionDefinitionDiff = tfconfig.StringVariable(functionDefinitionDiff)
+	return f
+}
+
+func (f *FunctionPythonModel) WithFunctionDefinitionFile(functionDefinitionFile string) *FunctionPythonModel {
+	f.FunctionDefinitionFile = tfconfig.StringVariable(functionDefinitionFile)
+	return f
+}
+
+func (f *FunctionPythonModel) WithFunctionDefinitionHash(functionDefinitionHash string) *FunctionPythonModel {
+	f.FunctionDefinitionHash = tfconfig.StringVariable(functionDefinitionHash)
+	return f
+}
+
 func (f *FunctionPythonModel) WithFunctionLanguage(functionLanguage string) *FunctionPythonModel {
 	f.FunctionLanguage = tfconfig.StringVariable(functionLanguage)
 	return f
@@ -243,6 +261,21 @@ func (f *FunctionPythonModel) WithFunctionDefinitionValue(value tfconfig.Variabl
 	return f
 }
 
+func (f *FunctionPythonModel) WithFunctionDefinitionDiffValue(value tfconfig.Variable) *FunctionPythonModel {
+	f.FunctionDefinitionDiff = value
+	return f
+}
+
+func (f *FunctionPythonModel) WithFunctionDefinitionFileValue(value tfconfig.Variable) *FunctionPythonModel {
+	f.FunctionDefinitionFile = value
+	return f
+}
+
+func (f *FunctionPythonModel) WithFunctionDefinitionHashValue(value tfconfig.Variable) *FunctionPythonModel {
+	f.FunctionDefinitionHash = value
+	return f
+}
+
 func (f *FunctionPythonModel) WithFunctionLanguageValue(value tfconfig.Variable) *FunctionPythonModel {
 	f.FunctionLanguage = value
 	return f
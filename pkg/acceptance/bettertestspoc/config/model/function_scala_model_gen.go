@@ -19,6 +19,9 @@ type FunctionScalaModel struct {
 	ExternalAccessIntegrations tfconfig.Variable `json:"external_access_integrations,omitempty"`
 	FullyQualifiedName         tfconfig.Variable `json:"fully_qualified_name,omitempty"`
 	FunctionDefinition         tfconfig.Variable `json:"function_definition,omitempty"`
+	FunctionDefinitionDiff     tfconfig.Variable `json:"function_definition_diff,omitempty"`
+	FunctionDefinitionFile     tfconfig.Variable `json:"function_definition_file,omitempty"`
+	FunctionDefinitionHash     tfconfig.Variable `json:"function_definition_hash,omitempty"`
 	FunctionLanguage           tfconfig.Variable `json:"function_language,omitempty"`
 	Handler                    tfconfig.Variable `json:"handler,omitempty"`
 	Imports                    tfconfig.Variable `json:"imports,omitempty"`
@@ -133,6 +136,21 @@ func (f *FunctionScalaModel) WithFunctionDefinition(functionDefinition string) *
 	return f
 }
 
+func (f *FunctionScalaModel) WithFunctionDefinitionDiff(functionDefinitionDiff string) *FunctionScalaModel {
+	f.FunctionDefinitionDiff = tfconfig.StringVariable(functionDefinitionDiff)
+	return f
+}
+
+func (f *FunctionScalaModel) WithFunctionDefinitionFile(functionDefinitionFile string) *FunctionScalaModel {
+	f.FunctionDefinitionFile = tfconfig.StringVariable(functionDefinitionFile)
+	return f
+}
+
+func (f *FunctionScalaModel) WithFunctionDefinitionHash(functionDefinitionHash string) *FunctionScalaModel {
+	f.FunctionDefinitionHash = tfconfig.StringVariable(functionDefinitionHash)
+	return f
+}
+
 func (f *FunctionScalaModel) WithFunctionLanguage(functionLanguage string) *FunctionScalaModel {
 	f.FunctionLanguage = tfconfig.StringVariable(functionLanguage)
 	return f
@@ -240,6 +258,21 @@ func (f *FunctionScalaModel) WithFunctionDefinitionValue(value tfconfig.Variable
 	return f
 }
 
+func (f *FunctionScalaModel) WithFunctionDefinitionDiffValue(value tfconfig.Variable) *FunctionScalaModel {
+	f.FunctionDefinitionDiff = value
+	return f
+}
+
+func (f *FunctionScalaModel) WithFunctionDefinitionFileValue(value tfconfig.Variable) *FunctionScalaModel {
+	f.FunctionDefinitionFile = value
+	return f
+}
+
+func (f *FunctionScalaModel) WithFunctionDefinitionHashValue(value tfconfig.Variable) *FunctionScalaModel {
+	f.FunctionDefinitionHash = value
+	return f
+}
+
 func (f *FunctionScalaModel) WithFunctionLanguageValue(value tfconfig.Variable) *FunctionScalaModel {
 	f.FunctionLanguage = value
 	return f
@@ -12,21 +12,24 @@ import (
 )
 
 type FunctionSqlModel struct {
-	Arguments             tfconfig.Variable `json:"arguments,omitempty"`
-	Comment               tfconfig.Variable `json:"comment,omitempty"`
-	Database              tfconfig.Variable `json:"database,omitempty"`
-	EnableConsoleOutput   tfconfig.Variable `json:"enable_console_output,omitempty"`
-	FullyQualifiedName    tfconfig.Variable `json:"fully_qualified_name,omitempty"`
-	FunctionDefinition    tfconfig.Variable `json:"function_definition,omitempty"`
-	FunctionLanguage      tfconfig.Variable `json:"function_language,omitempty"`
-	IsSecure              tfconfig.Variable `json:"is_secure,omitempty"`
-	LogLevel              tfconfig.Variable `json:"log_level,omitempty"`
-	MetricLevel           tfconfig.Variable `json:"metric_level,omitempty"`
-	Name                  tfconfig.Variable `json:"name,omitempty"`
-	ReturnResultsBehavior tfconfig.Variable `json:"return_results_behavior,omitempty"`
-	ReturnType            tfconfig.Variable `json:"return_type,omitempty"`
-	Schema                tfconfig.Variable `json:"schema,omitempty"`
-	TraceLevel            tfconfig.Variable `json:"trace_level,omitempty"`
+	Arguments              tfconfig.Variable `json:"arguments,omitempty"`
+	Comment                tfconfig.Variable `json:"comment,omitempty"`
+	Database               tfconfig.Variable `json:"database,omitempty"`
+	EnableConsoleOutput    tfconfig.Variable `json:"enable_console_output,omitempty"`
+	FullyQualifiedName     tfconfig.Variable `json:"fully_qualified_name,omitempty"`
+	FunctionDefinition     tfconfig.Variable `json:"function_definition,omitempty"`
+	FunctionDefinitionDiff tfconfig.Variable `json:"function_definition_diff,omitempty"`
+	FunctionDefinitionFile tfconfig.Variable `json:"function_definition_file,omitempty"`
+	FunctionDefinitionHash tfconfig.Variable `json:"function_definition_hash,omitempty"`
+	FunctionLanguage       tfconfig.Variable `json:"function_language,omitempty"`
+	IsSecure               tfconfig.Variable `json:"is_secure,omitempty"`
+	LogLevel               tfconfig.Variable `json:"log_level,omitempty"`
+	MetricLevel            tfconfig.Variable `json:"metric_level,omitempty"`
+	Name                   tfconfig.Variable `json:"name,omitempty"`
+	ReturnResultsBehavior  tfconfig.Variable `json:"return_results_behavior,omitempty"`
+	ReturnType             tfconfig.Variable `json:"return_type,omitempty"`
+	Schema                 tfconfig.Variable `json:"schema,omitempty"`
+	TraceLevel             tfconfig.Variable `json:"trace_level,omitempty"`
 
 	*config.ResourceModelMeta
 }
@@ -38,14 +41,12 @@ type FunctionSqlModel struct {
 func FunctionSql(
 	resourceName string,
 	database string,
-	functionDefinition string,
 	name string,
 	returnType string,
 	schema string,
 ) *FunctionSqlModel {
 	f := &FunctionSqlModel{ResourceModelMeta: config.Meta(resourceName, resources.FunctionSql)}
 	f.WithDatabase(database)
-	f.WithFunctionDefinition(functionDefinition)
 	f.WithName(name)
 	f.WithReturnType(returnType)
 	f.WithSchema(schema)
@@ -54,14 +55,12 @@ func FunctionSql(
 
 func FunctionSqlWithDefaultMeta(
 	database string,
-	functionDefinition string,
 	name string,
 	returnType string,
 	schema string,
 ) *FunctionSqlModel {
 	f := &FunctionSqlModel{ResourceModelMeta: config.DefaultMeta(resources.FunctionSql)}
 	f.WithDatabase(database)
-	f.WithFunctionDefinition(functionDefinition)
 	f.WithName(name)
 	f.WithReturnType(returnType)
 	f.WithSchema(schema)
@@ -119,6 +118,21 @@ func (f *FunctionSqlModel) WithFunctionDefinition(functionDefinition string) *Fu
 	return f
 }
 
+func (f *FunctionSqlModel) WithFunctionDefinitionDiff(functionDefinitionDiff string) *FunctionSqlModel {
+	f.FunctionDefinitionDiff = tfconfig.StringVariable(functionDefinitionDiff)
+	return f
+}
+
+func (f *FunctionSqlModel) WithFunctionDefinitionFile(functionDefinitionFile string) *FunctionSqlModel {
+	f.FunctionDefinitionFile = tfconfig.StringVariable(functionDefinitionFile)
+	return f
+}
+
+func (f *FunctionSqlModel) WithFunctionDefinitionHash(functionDefinitionHash string) *FunctionSqlModel {
+	f.FunctionDefinitionHash = tfconfig.StringVariable(functionDefinitionHash)
+	return f
+}
+
 func (f *FunctionSqlModel) WithFunctionLanguage(functionLanguage string) *FunctionSqlModel {
 	f.FunctionLanguage = tfconfig.StringVariable(functionLanguage)
 	return f
@@ -198,6 +212,21 @@ func (f *FunctionSqlModel) WithFunctionDefinitionValue(value tfconfig.Variable)
 	return f
 }
 
+func (f *FunctionSqlModel) WithFunctionDefinitionDiffValue(value tfconfig.Variable) *FunctionSqlModel {
+	f.FunctionDefinitionDiff = value
+	return f
+}
+
+func (f *FunctionSqlModel) WithFunctionDefinitionFileValue(value tfconfig.Variable) *FunctionSqlModel {
+	f.FunctionDefinitionFile = value
+	return f
+}
+
+func (f *FunctionSqlModel) WithFunctionDefinitionHashValue(value tfconfig.Variable) *FunctionSqlModel {
+	f.FunctionDefinitionHash = value
+	return f
+}
+
 func (f *FunctionSqlModel) WithFunctionLanguageValue(value tfconfig.Variable) *FunctionSqlModel {
 	f.FunctionLanguage = value
 	return f
@@ -12,13 +12,14 @@ import (
 )
 
 type NetworkPolicyModel struct {
-	AllowedIpList          tfconfig.Variable `json:"allowed_ip_list,omitempty"`
-	AllowedNetworkRuleList tfconfig.Variable `json:"allowed_network_rule_list,omitempty"`
-	BlockedIpList          tfconfig.Variable `json:"blocked_ip_list,omitempty"`
-	BlockedNetworkRuleList tfconfig.Variable `json:"blocked_network_rule_list,omitempty"`
-	Comment                tfconfig.Variable `json:"comment,omitempty"`
-	FullyQualifiedName     tfconfig.Variable `json:"fully_qualified_name,omitempty"`
-	Name                   tfconfig.Variable `json:"name,omitempty"`
+	AllowedIpList            tfconfig.Variable `json:"allowed_ip_list,omitempty"`
+	AllowedNetworkRuleList   tfconfig.Variable `json:"allowed_network_rule_list,omitempty"`
+	BlockedIpList            tfconfig.Variable `json:"blocked_ip_list,omitempty"`
+	BlockedNetworkRuleList   tfconfig.Variable `json:"blocked_network_rule_list,omitempty"`
+	Comment                  tfconfig.Variable `json:"comment,omitempty"`
+	FullyQualifiedName       tfconfig.Variable `json:"fully_qualified_name,omitempty"`
+	Name                     tfconfig.Variable `json:"name,omitempty"`
+	RejectOverlappingIpLists tfconfig.Variable `json:"reject_overlapping_ip_lists,omitempty"`
 
 	*config.ResourceModelMeta
 }
@@ -91,6 +92,11 @@ func (n *NetworkPolicyModel) WithName(name string) *NetworkPolicyModel {
 	return n
 }
 
+func (n *NetworkPolicyModel) WithRejectOverlappingIpLists(rejectOverlappingIpLists bool) *NetworkPolicyModel {
+	n.RejectOverlappingIpLists = tfconfig.BoolVariable(rejectOverlappingIpLists)
+	return n
+}
+
 //////////////////////////////////////////
 // below it's possible to set any value //
 //////////////////////////////////////////
@@ -129,3 +135,8 @@ func (n *NetworkPolicyModel) WithNameValue(value tfconfig.Variable) *NetworkPoli
 	n.Name = value
 	return n
 }
+
+func (n *NetworkPolicyModel) WithRejectOverlappingIpListsValue(value tfconfig.Variable) *NetworkPolicyModel {
+	n.RejectOverlappingIpLists = value
+	return n
+}
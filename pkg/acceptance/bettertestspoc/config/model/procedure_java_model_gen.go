@@ -28,6 +28,9 @@ type ProcedureJavaModel struct {
 	NullInputBehavior          tfconfig.Variable `json:"null_input_behavior,omitempty"`
 	Packages                   tfconfig.Variable `json:"packages,omitempty"`
 	ProcedureDefinition        tfconfig.Variable `json:"procedure_definition,omitempty"`
+	ProcedureDefinitionDiff    tfconfig.Variable `json:"procedure_definition_diff,omitempty"`
+	ProcedureDefinitionFile    tfconfig.Variable `json:"procedure_definition_file,omitempty"`
+	ProcedureDefinitionHash    tfconfig.Variable `json:"procedure_definition_hash,omitempty"`
 	ProcedureLanguage          tfconfig.Variable `json:"procedure_language,omitempty"`
 	ReturnType                 tfconfig.Variable `json:"return_type,omitempty"`
 	RuntimeVersion             tfconfig.Variable `json:"runtime_version,omitempty"`
@@ -177,6 +180,21 @@ func (p *ProcedureJavaModel) WithProcedureDefinition(procedureDefinition string)
 	return p
 }
 
+func (p *ProcedureJavaModel) WithProcedureDefinitionDiff(procedureDefinitionDiff string) *ProcedureJavaModel {
+	p.ProcedureDefinitionDiff = tfconfig.StringVariable(procedureDefinitionDiff)
+	return p
+}
+
+func (p *ProcedureJavaModel) WithProcedureDefinitionFile(procedureDefinitionFile string) *ProcedureJavaModel {
+	p.ProcedureDefinitionFile = tfconfig.StringVariable(procedureDefinitionFile)
+	return p
+}
+
+func (p *ProcedureJavaModel) WithProcedureDefinitionHash(procedureDefinitionHash string) *ProcedureJavaModel {
+	p.ProcedureDefinitionHash = tfconfig.StringVariable(procedureDefinitionHash)
+	return p
+}
+
 func (p *ProcedureJavaModel) WithProcedureLanguage(procedureLanguage string) *ProcedureJavaModel {
 	p.ProcedureLanguage = tfconfig.StringVariable(procedureLanguage)
 	return p
@@ -295,6 +313,21 @@ func (p *ProcedureJavaModel) WithProcedureDefinitionValue(value tfconfig.Variabl
 	return p
 }
 
+func (p *ProcedureJavaModel) WithProcedureDefinitionDiffValue(value tfconfig.Variable) *ProcedureJavaModel {
+	p.ProcedureDefinitionDiff = value
+	return p
+}
+
+func (p *ProcedureJavaModel) WithProcedureDefinitionFileValue(value tfconfig.Variable) *ProcedureJavaModel {
+	p.ProcedureDefinitionFile = value
+	return p
+}
+
+func (p *ProcedureJavaModel) WithProcedureDefinitionHashValue(value tfconfig.Variable) *ProcedureJavaModel {
+	p.ProcedureDefinitionHash = value
+	return p
+}
+
 func (p *ProcedureJavaModel) WithProcedureLanguageValue(value tfconfig.Variable) *ProcedureJavaModel {
 	p.ProcedureLanguage = value
 	return p
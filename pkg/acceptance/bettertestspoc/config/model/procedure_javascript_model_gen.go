@@ -12,22 +12,25 @@ import (
 )
 
 type ProcedureJavascriptModel struct {
-	Arguments           tfconfig.Variable `json:"arguments,omitempty"`
-	Comment             tfconfig.Variable `json:"comment,omitempty"`
-	Database            tfconfig.Variable `json:"database,omitempty"`
-	EnableConsoleOutput tfconfig.Variable `json:"enable_console_output,omitempty"`
-	ExecuteAs           tfconfig.Variable `json:"execute_as,omitempty"`
-	FullyQualifiedName  tfconfig.Variable `json:"fully_qualified_name,omitempty"`
-	IsSecure            tfconfig.Variable `json:"is_secure,omitempty"`
-	LogLevel            tfconfig.Variable `json:"log_level,omitempty"`
-	MetricLevel         tfconfig.Variable `json:"metric_level,omitempty"`
-	Name                tfconfig.Variable `json:"name,omitempty"`
-	NullInputBehavior   tfconfig.Variable `json:"null_input_behavior,omitempty"`
-	ProcedureDefinition tfconfig.Variable `json:"procedure_definition,omitempty"`
-	ProcedureLanguage   tfconfig.Variable `json:"procedure_language,omitempty"`
-	ReturnType          tfconfig.Variable `json:"return_type,omitempty"`
-	Schema              tfconfig.Variable `json:"schema,omitempty"`
-	TraceLevel          tfconfig.Variable `json:"trace_level,omitempty"`
+	Arguments               tfconfig.Variable `json:"arguments,omitempty"`
+	Comment                 tfconfig.Variable `json:"comment,omitempty"`
+	Database                tfconfig.Variable `json:"database,omitempty"`
+	EnableConsoleOutput     tfconfig.Variable `json:"enable_console_output,omitempty"`
+	ExecuteAs               tfconfig.Variable `json:"execute_as,omitempty"`
+	FullyQualifiedName      tfconfig.Variable `json:"fully_qualified_name,omitempty"`
+	IsSecure                tfconfig.Variable `json:"is_secure,omitempty"`
+	LogLevel                tfconfig.Variable `json:"log_level,omitempty"`
+	MetricLevel             tfconfig.Variable `json:"metric_level,omitempty"`
+	Name                    tfconfig.Variable `json:"name,omitempty"`
+	NullInputBehavior       tfconfig.Variable `json:"null_input_behavior,omitempty"`
+	ProcedureDefinition     tfconfig.Variable `json:"procedure_definition,omitempty"`
+	ProcedureDefinitionDiff tfconfig.Variable `json:"procedure_definition_diff,omitempty"`
+	ProcedureDefinitionFile tfconfig.Variable `json:"procedure_definition_file,omitempty"`
+	ProcedureDefinitionHash tfconfig.Variable `json:"procedure_definition_hash,omitempty"`
+	ProcedureLanguage       tfconfig.Variable `json:"procedure_language,omitempty"`
+	ReturnType              tfconfig.Variable `json:"return_type,omitempty"`
+	Schema                  tfconfig.Variable `json:"schema,omitempty"`
+	TraceLevel              tfconfig.Variable `json:"trace_level,omitempty"`
 
 	*config.ResourceModelMeta
 }
@@ -40,14 +43,12 @@ func ProcedureJavascript(
 	resourceName string,
 	database string,
 	name string,
-	procedureDefinition string,
 	returnType string,
 	schema string,
 ) *ProcedureJavascriptModel {
 	p := &ProcedureJavascriptModel{ResourceModelMeta: config.Meta(resourceName, resources.ProcedureJavascript)}
 	p.WithDatabase(database)
 	p.WithName(name)
-	p.WithProcedureDefinition(procedureDefinition)
 	p.WithReturnType(returnType)
 	p.WithSchema(schema)
 	return p
@@ -56,14 +57,12 @@ func ProcedureJavascript(
 func ProcedureJavascriptWithDefaultMeta(
 	database string,
 	name string,
-	procedureDefinition string,
 	returnType string,
 	schema string,
 ) *ProcedureJavascriptModel {
 	p := &ProcedureJavascriptModel{ResourceModelMeta: config.DefaultMeta(resources.ProcedureJavascript)}
 	p.WithDatabase(database)
 	p.WithName(name)
-	p.WithProcedureDefinition(procedureDefinition)
 	p.WithReturnType(returnType)
 	p.WithSchema(schema)
 	return p
@@ -150,6 +149,21 @@ func (p *ProcedureJavascriptModel) WithProcedureDefinition(procedureDefinition s
 	return p
 }
 
+func (p *ProcedureJavascriptModel) WithProcedureDefinitionDiff(procedureDefinitionDiff string) *ProcedureJavascriptModel {
+	p.ProcedureDefinitionDiff = tfconfig.StringVariable(procedureDefinitionDiff)
+	return p
+}
+
+func (p *ProcedureJavascriptModel) WithProcedureDefinitionFile(procedureDefinitionFile string) *ProcedureJavascriptModel {
+	p.ProcedureDefinitionFile = tfconfig.StringVariable(procedureDefinitionFile)
+	return p
+}
+
+func (p *ProcedureJavascriptModel) WithProcedureDefinitionHash(procedureDefinitionHash string) *ProcedureJavascriptModel {
+	p.ProcedureDefinitionHash = tfconfig.StringVariable(procedureDefinitionHash)
+	return p
+}
+
 func (p *ProcedureJavascriptModel) WithProcedureLanguage(procedureLanguage string) *ProcedureJavascriptModel {
 	p.ProcedureLanguage = tfconfig.StringVariable(procedureLanguage)
 	return p
@@ -234,6 +248,21 @@ func (p *ProcedureJavascriptModel) WithProcedureDefinitionValue(value tfconfig.V
 	return p
 }
 
+func (p *ProcedureJavascriptModel) WithProcedureDefinitionDiffValue(value tfconfig.Variable) *ProcedureJavascriptModel {
+	p.ProcedureDefinitionDiff = value
+	return p
+}
+
+func (p *ProcedureJavascriptModel) WithProcedureDefinitionFileValue(value tfconfig.Variable) *ProcedureJavascriptModel {
+	p.ProcedureDefinitionFile = value
+	return p
+}
+
+func (p *ProcedureJavascriptModel) WithProcedureDefinitionHashValue(value tfconfig.Variable) *ProcedureJavascriptModel {
+	p.ProcedureDefinitionHash = value
+	return p
+}
+
 func (p *ProcedureJavascriptModel) WithProcedureLanguageValue(value tfconfig.Variable) *ProcedureJavascriptModel {
 	p.ProcedureLanguage = value
 	return p
@@ -28,6 +28,9 @@ type ProcedurePythonModel struct {
 	NullInputBehavior          tfconfig.Variable `json:"null_input_behavior,omitempty"`
 	Packages                   tfconfig.Variable `json:"packages,omitempty"`
 	ProcedureDefinition        tfconfig.Variable `json:"procedure_definition,omitempty"`
+	ProcedureDefinitionDiff    tfconfig.Variable `json:"procedure_definition_diff,omitempty"`
+	ProcedureDefinitionFile    tfconfig.Variable `json:"procedure_definition_file,omitempty"`
+	ProcedureDefinitionHash    tfconfig.Variable `json:"procedure_definition_hash,omitempty"`
 	ProcedureLanguage          tfconfig.Variable `json:"procedure_language,omitempty"`
 	ReturnType                 tfconfig.Variable `json:"return_type,omitempty"`
 	RuntimeVersion             tfconfig.Variable `json:"runtime_version,omitempty"`
@@ -176,6 +179,21 @@ func (p *ProcedurePythonModel) WithProcedureDefinition(procedureDefinition strin
 	return p
 }
 
+func (p *ProcedurePythonModel) WithProcedureDefinitionDiff(procedureDefinitionDiff string) *ProcedurePythonModel {
+	p.ProcedureDefinitionDiff = tfconfig.StringVariable(procedureDefinitionDiff)
+	return p
+}
+
+func (p *ProcedurePythonModel) WithProcedureDefinitionFile(procedureDefinitionFile string) *ProcedurePythonModel {
+	p.ProcedureDefinitionFile = tfconfig.StringVariable(procedureDefinitionFile)
+	return p
+}
+
+func (p *ProcedurePythonModel) WithProcedureDefinitionHash(procedureDefinitionHash string) *ProcedurePythonModel {
+	p.ProcedureDefinitionHash = tfconfig.StringVariable(procedureDefinitionHash)
+	return p
+}
+
 func (p *ProcedurePythonModel) WithProcedureLanguage(procedureLanguage string) *ProcedurePythonModel {
 	p.ProcedureLanguage = tfconfig.StringVariable(procedureLanguage)
 	return p
@@ -292,6 +310,21 @@ func (p *ProcedurePythonModel) WithProcedureDefinitionValue(value tfconfig.Varia
 	return p
 }
 
+func (p *ProcedurePythonModel) WithProcedureDefinitionDiffValue(value tfconfig.Variable) *ProcedurePythonModel {
+	p.ProcedureDefinitionDiff = value
+	return p
+}
+
+func (p *ProcedurePythonModel) WithProcedureDefinitionFileValue(value tfconfig.Variable) *ProcedurePythonModel {
+	p.ProcedureDefinitionFile = value
+	return p
+}
+
+func (p *ProcedurePythonModel) WithProcedureDefinitionHashValue(value tfconfig.Variable) *ProcedurePythonModel {
+	p.ProcedureDefinitionHash = value
+	return p
+}
+
 func (p *ProcedurePythonModel) WithProcedureLanguageValue(value tfconfig.Variable) *ProcedurePythonModel {
 	p.ProcedureLanguage = value
 	return p
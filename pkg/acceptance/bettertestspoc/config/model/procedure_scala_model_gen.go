@@ -28,6 +28,9 @@ type ProcedureScalaModel struct {
 	NullInputBehavior          tfconfig.Variable `json:"null_input_behavior,omitempty"`
 	Packages                   tfconfig.Variable `json:"packages,omitempty"`
 	ProcedureDefinition        tfconfig.Variable `json:"procedure_definition,omitempty"`
+	ProcedureDefinitionDiff    tfconfig.Variable `json:"procedure_definition_diff,omitempty"`
+	ProcedureDefinitionFile    tfconfig.Variable `json:"procedure_definition_file,omitempty"`
+	ProcedureDefinitionHash    tfconfig.Variable `json:"procedure_definition_hash,omitempty"`
 	ProcedureLanguage          tfconfig.Variable `json:"procedure_language,omitempty"`
 	ReturnType                 tfconfig.Variable `json:"return_type,omitempty"`
 	RuntimeVersion             tfconfig.Variable `json:"runtime_version,omitempty"`
@@ -177,6 +180,21 @@ func (p *ProcedureScalaModel) WithProcedureDefinition(procedureDefinition string
 	return p
 }
 
+func (p *ProcedureScalaModel) WithProcedureDefinitionDiff(procedureDefinitionDiff string) *ProcedureScalaModel {
+	p.ProcedureDefinitionDiff = tfconfig.StringVariable(procedureDefinitionDiff)
+	return p
+}
+
+func (p *ProcedureScalaModel) WithProcedureDefinitionFile(procedureDefinitionFile string) *ProcedureScalaModel {
+	p.ProcedureDefinitionFile = tfconfig.StringVariable(procedureDefinitionFile)
+	return p
+}
+
+func (p *ProcedureScalaModel) WithProcedureDefinitionHash(procedureDefinitionHash string) *ProcedureScalaModel {
+	p.ProcedureDefinitionHash = tfconfig.StringVariable(procedureDefinitionHash)
+	return p
+}
+
 func (p *ProcedureScalaModel) WithProcedureLanguage(procedureLanguage string) *ProcedureScalaModel {
 	p.ProcedureLanguage = tfconfig.StringVariable(procedureLanguage)
 	return p
@@ -295,6 +313,21 @@ func (p *ProcedureScalaModel) WithProcedureDefinitionValue(value tfconfig.Variab
 	return p
 }
 
+func (p *ProcedureScalaModel) WithProcedureDefinitionDiffValue(value tfconfig.Variable) *ProcedureScalaModel {
+	p.ProcedureDefinitionDiff = value
+	return p
+}
+
+func (p *ProcedureScalaModel) WithProcedureDefinitionFileValue(value tfconfig.Variable) *ProcedureScalaModel {
+	p.ProcedureDefinitionFile = value
+	return p
+}
+
+func (p *ProcedureScalaModel) WithProcedureDefinitionHashValue(value tfconfig.Variable) *ProcedureScalaModel {
+	p.ProcedureDefinitionHash = value
+	return p
+}
+
 func (p *ProcedureScalaModel) WithProcedureLanguageValue(value tfconfig.Variable) *ProcedureScalaModel {
 	p.ProcedureLanguage = value
 	return p
@@ -12,22 +12,25 @@ import (
 )
 
 type ProcedureSqlModel struct {
-	Arguments           tfconfig.Variable `json:"arguments,omitempty"`
-	Comment             tfconfig.Variable `json:"comment,omitempty"`
-	Database            tfconfig.Variable `json:"database,omitempty"`
-	EnableConsoleOutput tfconfig.Variable `json:"enable_console_output,omitempty"`
-	ExecuteAs           tfconfig.Variable `json:"execute_as,omitempty"`
-	FullyQualifiedName  tfconfig.Variable `json:"fully_qualified_name,omitempty"`
-	IsSecure            tfconfig.Variable `json:"is_secure,omitempty"`
-	LogLevel            tfconfig.Variable `json:"log_level,omitempty"`
-	MetricLevel         tfconfig.Variable `json:"metric_level,omitempty"`
-	Name                tfconfig.Variable `json:"name,omitempty"`
-	NullInputBehavior   tfconfig.Variable `json:"null_input_behavior,omitempty"`
-	ProcedureDefinition tfconfig.Variable `json:"procedure_definition,omitempty"`
-	ProcedureLanguage   tfconfig.Variable `json:"procedure_language,omitempty"`
-	ReturnType          tfconfig.Variable `json:"return_type,omitempty"`
-	Schema              tfconfig.Variable `json:"schema,omitempty"`
-	TraceLevel          tfconfig.Variable `json:"trace_level,omitempty"`
+	Arguments               tfconfig.Variable `json:"arguments,omitempty"`
+	Comment                 tfconfig.Variable `json:"comment,omitempty"`
+	Database                tfconfig.Variable `json:"database,omitempty"`
+	EnableConsoleOutput     tfconfig.Variable `json:"enable_console_output,omitempty"`
+	ExecuteAs               tfconfig.Variable `json:"execute_as,omitempty"`
+	FullyQualifiedName      tfconfig.Variable `json:"fully_qualified_name,omitempty"`
+	IsSecure                tfconfig.Variable `json:"is_secure,omitempty"`
+	LogLevel                tfconfig.Variable `json:"log_level,omitempty"`
+	MetricLevel             tfconfig.Variable `json:"metric_level,omitempty"`
+	Name                    tfconfig.Variable `json:"name,omitempty"`
+	NullInputBehavior       tfconfig.Variable `json:"null_input_behavior,omitempty"`
+	ProcedureDefinition     tfconfig.Variable `json:"procedure_definition,omitempty"`
+	ProcedureDefinitionDiff tfconfig.Variable `json:"procedure_definition_diff,omitempty"`
+	ProcedureDefinitionFile tfconfig.Variable `json:"procedure_definition_file,omitempty"`
+	ProcedureDefinitionHash tfconfig.Variable `json:"procedure_definition_hash,omitempty"`
+	ProcedureLanguage       tfconfig.Variable `json:"procedure_language,omitempty"`
+	ReturnType              tfconfig.Variable `json:"return_type,omitempty"`
+	Schema                  tfconfig.Variable `json:"schema,omitempty"`
+	TraceLevel              tfconfig.Variable `json:"trace_level,omitempty"`
 
 	*config.ResourceModelMeta
 }
@@ -40,14 +43,12 @@ func ProcedureSql(
 	resourceName string,
 	database string,
 	name string,
-	procedureDefinition string,
 	returnType string,
 	schema string,
 ) *ProcedureSqlModel {
 	p := &ProcedureSqlModel{ResourceModelMeta: config.Meta(resourceName, resources.ProcedureSql)}
 	p.WithDatabase(database)
 	p.WithName(name)
-	p.WithProcedureDefinition(procedureDefinition)
 	p.WithReturnType(returnType)
 	p.WithSchema(schema)
 	return p
@@ -56,14 +57,12 @@ func ProcedureSql(
 func ProcedureSqlWithDefaultMeta(
 	database string,
 	name string,
-	procedureDefinition string,
 	returnType string,
 	schema string,
 ) *ProcedureSqlModel {
 	p := &ProcedureSqlModel{ResourceModelMeta: config.DefaultMeta(resources.ProcedureSql)}
 	p.WithDatabase(database)
 	p.WithName(name)
-	p.WithProcedureDefinition(procedureDefinition)
 	p.WithReturnType(returnType)
 	p.WithSchema(schema)
 	return p
@@ -150,6 +149,21 @@ func (p *ProcedureSqlModel) WithProcedureDefinition(procedureDefinition string)
 	return p
 }
 
+func (p *ProcedureSqlModel) WithProcedureDefinitionDiff(procedureDefinitionDiff string) *ProcedureSqlModel {
+	p.ProcedureDefinitionDiff = tfconfig.StringVariable(procedureDefinitionDiff)
+	return p
+}
+
+func (p *ProcedureSqlModel) WithProcedureDefinitionFile(procedureDefinitionFile string) *ProcedureSqlModel {
+	p.ProcedureDefinitionFile = tfconfig.StringVariable(procedureDefinitionFile)
+	return p
+}
+
+func (p *ProcedureSqlModel) WithProcedureDefinitionHash(procedureDefinitionHash string) *ProcedureSqlModel {
+	p.ProcedureDefinitionHash = tfconfig.StringVariable(procedureDefinitionHash)
+	return p
+}
+
 func (p *ProcedureSqlModel) WithProcedureLanguage(procedureLanguage string) *ProcedureSqlModel {
 	p.ProcedureLanguage = tfconfig.StringVariable(procedureLanguage)
 	return p
@@ -234,6 +248,21 @@ func (p *ProcedureSqlModel) WithProcedureDefinitionValue(value tfconfig.Variable
 	return p
 }
 
+func (p *ProcedureSqlModel) WithProcedureDefinitionDiffValue(value tfconfig.Variable) *ProcedureSqlModel {
+	p.ProcedureDefinitionDiff = value
+	return p
+}
+
+func (p *ProcedureSqlModel) WithProcedureDefinitionFileValue(value tfconfig.Variable) *ProcedureSqlModel {
+	p.ProcedureDefinitionFile = value
+	return p
+}
+
+func (p *ProcedureSqlModel) WithProcedureDefinitionHashValue(value tfconfig.Variable) *ProcedureSqlModel {
+	p.ProcedureDefinitionHash = value
+	return p
+}
+
 func (p *ProcedureSqlModel) WithProcedureLanguageValue(value tfconfig.Variable) *ProcedureSqlModel {
 	p.ProcedureLanguage = value
 	return p
@@ -64,3 +64,40 @@ func (u *ServiceUserModel) WithNetworkPolicyId(networkPolicy sdk.AccountObjectId
 func (u *ServiceUserModel) WithDefaultSecondaryRolesOptionEnum(option sdk.SecondaryRolesOption) *ServiceUserModel {
 	return u.WithDefaultSecondaryRolesOption(string(option))
 }
+
+func (u *ServiceUserModel) WithWorkloadIdentityAws(arn string) *ServiceUserModel {
+	return u.withWorkloadIdentity("aws", map[string]tfconfig.Variable{
+		"arn": tfconfig.StringVariable(arn),
+	})
+}
+
+func (u *ServiceUserModel) WithWorkloadIdentityGcp(subject string) *ServiceUserModel {
+	return u.withWorkloadIdentity("gcp", map[string]tfconfig.Variable{
+		"subject": tfconfig.StringVariable(subject),
+	})
+}
+
+func (u *ServiceUserModel) WithWorkloadIdentityOidc(issuer string, subject string, audiences ...string) *ServiceUserModel {
+	workloadIdentity := map[string]tfconfig.Variable{
+		"issuer":  tfconfig.StringVariable(issuer),
+		"subject": tfconfig.StringVariable(subject),
+	}
+	if len(audiences) > 0 {
+		audienceVariables := make([]tfconfig.Variable, len(audiences))
+		for i, audience := range audiences {
+			audienceVariables[i] = tfconfig.StringVariable(audience)
+		}
+		workloadIdentity["oidc_audience_list"] = tfconfig.SetVariable(audienceVariables...)
+	}
+	return u.withWorkloadIdentity("oidc", workloadIdentity)
+}
+
+func (u *ServiceUserModel) withWorkloadIdentity(workloadIdentityType string, workloadIdentity map[string]tfconfig.Variable) *ServiceUserModel {
+	return u.WithWorkloadIdentityValue(
+		tfconfig.ObjectVariable(
+			map[string]tfconfig.Variable{
+				workloadIdentityType: tfconfig.ObjectVariable(workloadIdentity),
+			},
+		),
+	)
+}
@@ -86,6 +86,7 @@ type ServiceUserModel struct {
 	UserType                                 tfconfig.Variable `json:"user_type,omitempty"`
 	WeekOfYearPolicy                         tfconfig.Variable `json:"week_of_year_policy,omitempty"`
 	WeekStart                                tfconfig.Variable `json:"week_start,omitempty"`
+	WorkloadIdentity                         tfconfig.Variable `json:"workload_identity,omitempty"`
 
 	*config.ResourceModelMeta
 }
@@ -505,6 +506,8 @@ func (s *ServiceUserModel) WithWeekStart(weekStart int) *ServiceUserModel {
 	return s
 }
 
+// workload_identity attribute type is not yet supported, so WithWorkloadIdentity can't be generated
+
 //////////////////////////////////////////
 // below it's possible to set any value //
 //////////////////////////////////////////
@@ -878,3 +881,8 @@ func (s *ServiceUserModel) WithWeekStartValue(value tfconfig.Variable) *ServiceU
 	s.WeekStart = value
 	return s
 }
+
+func (s *ServiceUserModel) WithWorkloadIdentityValue(value tfconfig.Variable) *ServiceUserModel {
+	s.WorkloadIdentity = value
+	return s
+}
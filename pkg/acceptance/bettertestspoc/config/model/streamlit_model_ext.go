@@ -13,7 +13,16 @@ func StreamlitWithIds(
 	mainFile string,
 	stageId sdk.SchemaObjectIdentifier,
 ) *StreamlitModel {
-	return Streamlit(resourceName, id.DatabaseName(), mainFile, id.Name(), id.SchemaName(), stageId.FullyQualifiedName())
+	return Streamlit(resourceName, id.DatabaseName(), mainFile, id.Name(), id.SchemaName()).WithStage(stageId.FullyQualifiedName())
+}
+
+func (s *StreamlitModel) WithImports(imports ...string) *StreamlitModel {
+	s.Imports = tfconfig.SetVariable(
+		collections.Map(imports, func(i string) tfconfig.Variable {
+			return tfconfig.StringVariable(i)
+		})...,
+	)
+	return s
 }
 
 func (s *StreamlitModel) WithExternalAccessIntegrations(integrations ...sdk.AccountObjectIdentifier) *StreamlitModel {
@@ -17,10 +17,13 @@ type StreamlitModel struct {
 	DirectoryLocation          tfconfig.Variable `json:"directory_location,omitempty"`
 	ExternalAccessIntegrations tfconfig.Variable `json:"external_access_integrations,omitempty"`
 	FullyQualifiedName         tfconfig.Variable `json:"fully_qualified_name,omitempty"`
+	Imports                    tfconfig.Variable `json:"imports,omitempty"`
 	MainFile                   tfconfig.Variable `json:"main_file,omitempty"`
 	Name                       tfconfig.Variable `json:"name,omitempty"`
 	QueryWarehouse             tfconfig.Variable `json:"query_warehouse,omitempty"`
 	Schema                     tfconfig.Variable `json:"schema,omitempty"`
+	SourceLocation             tfconfig.Variable `json:"source_location,omitempty"`
+	SourceRevision             tfconfig.Variable `json:"source_revision,omitempty"`
 	Stage                      tfconfig.Variable `json:"stage,omitempty"`
 	Title                      tfconfig.Variable `json:"title,omitempty"`
 
@@ -37,14 +40,12 @@ func Streamlit(
 	mainFile string,
 	name string,
 	schema string,
-	stage string,
 ) *StreamlitModel {
 	s := &StreamlitModel{ResourceModelMeta: config.Meta(resourceName, resources.Streamlit)}
 	s.WithDatabase(database)
 	s.WithMainFile(mainFile)
 	s.WithName(name)
 	s.WithSchema(schema)
-	s.WithStage(stage)
 	return s
 }
 
@@ -53,14 +54,12 @@ func StreamlitWithDefaultMeta(
 	mainFile string,
 	name string,
 	schema string,
-	stage string,
 ) *StreamlitModel {
 	s := &StreamlitModel{ResourceModelMeta: config.DefaultMeta(resources.Streamlit)}
 	s.WithDatabase(database)
 	s.WithMainFile(mainFile)
 	s.WithName(name)
 	s.WithSchema(schema)
-	s.WithStage(stage)
 	return s
 }
 
@@ -110,6 +109,8 @@ func (s *StreamlitModel) WithFullyQualifiedName(fullyQualifiedName string) *Stre
 	return s
 }
 
+// imports attribute type is not yet supported, so WithImports can't be generated
+
 func (s *StreamlitModel) WithMainFile(mainFile string) *StreamlitModel {
 	s.MainFile = tfconfig.StringVariable(mainFile)
 	return s
@@ -130,6 +131,16 @@ func (s *StreamlitModel) WithSchema(schema string) *StreamlitModel {
 	return s
 }
 
+func (s *StreamlitModel) WithSourceLocation(sourceLocation string) *StreamlitModel {
+	s.SourceLocation = tfconfig.StringVariable(sourceLocation)
+	return s
+}
+
+func (s *StreamlitModel) WithSourceRevision(sourceRevision string) *StreamlitModel {
+	s.SourceRevision = tfconfig.StringVariable(sourceRevision)
+	return s
+}
+
 func (s *StreamlitModel) WithStage(stage string) *StreamlitModel {
 	s.Stage = tfconfig.StringVariable(stage)
 	return s
@@ -169,6 +180,11 @@ func (s *StreamlitModel) WithFullyQualifiedNameValue(value tfconfig.Variable) *S
 	return s
 }
 
+func (s *StreamlitModel) WithImportsValue(value tfconfig.Variable) *StreamlitModel {
+	s.Imports = value
+	return s
+}
+
 func (s *StreamlitModel) WithMainFileValue(value tfconfig.Variable) *StreamlitModel {
 	s.MainFile = value
 	return s
@@ -189,6 +205,16 @@ func (s *StreamlitModel) WithSchemaValue(value tfconfig.Variable) *StreamlitMode
 	return s
 }
 
+func (s *StreamlitModel) WithSourceLocationValue(value tfconfig.Variable) *StreamlitModel {
+	s.SourceLocation = value
+	return s
+}
+
+func (s *StreamlitModel) WithSourceRevisionValue(value tfconfig.Variable) *StreamlitModel {
+	s.SourceRevision = value
+	return s
+}
+
 func (s *StreamlitModel) WithStageValue(value tfconfig.Variable) *StreamlitModel {
 	s.Stage = value
 	return s
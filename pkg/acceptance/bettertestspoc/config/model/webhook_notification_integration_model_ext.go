@@ -0,0 +1,14 @@
+package model
+
+import (
+	tfconfig "github.com/hashicorp/terraform-plugin-testing/config"
+)
+
+func (w *WebhookNotificationIntegrationModel) WithWebhookHeaders(headers map[string]string) *WebhookNotificationIntegrationModel {
+	headersVariables := make(map[string]tfconfig.Variable, len(headers))
+	for name, value := range headers {
+		headersVariables[name] = tfconfig.StringVariable(value)
+	}
+	w.WebhookHeaders = tfconfig.MapVariable(headersVariables)
+	return w
+}
@@ -50,6 +50,8 @@ type SnowflakeModel struct {
 	User                               tfconfig.Variable `json:"user,omitempty"`
 	ValidateDefaultParameters          tfconfig.Variable `json:"validate_default_parameters,omitempty"`
 	Warehouse                          tfconfig.Variable `json:"warehouse,omitempty"`
+	WorkloadIdentityEntraResource      tfconfig.Variable `json:"workload_identity_entra_resource,omitempty"`
+	WorkloadIdentityProvider           tfconfig.Variable `json:"workload_identity_provider,omitempty"`
 
 	*config.ProviderModelMeta
 }
@@ -270,6 +272,16 @@ func (s *SnowflakeModel) WithWarehouse(warehouse string) *SnowflakeModel {
 	return s
 }
 
+func (s *SnowflakeModel) WithWorkloadIdentityEntraResource(workloadIdentityEntraResource string) *SnowflakeModel {
+	s.WorkloadIdentityEntraResource = tfconfig.StringVariable(workloadIdentityEntraResource)
+	return s
+}
+
+func (s *SnowflakeModel) WithWorkloadIdentityProvider(workloadIdentityProvider string) *SnowflakeModel {
+	s.WorkloadIdentityProvider = tfconfig.StringVariable(workloadIdentityProvider)
+	return s
+}
+
 //////////////////////////////////////////
 // below it's possible to set any value //
 //////////////////////////////////////////
@@ -478,3 +490,13 @@ func (s *SnowflakeModel) WithWarehouseValue(value tfconfig.Variable) *SnowflakeM
 	s.Warehouse = value
 	return s
 }
+
+func (s *SnowflakeModel) WithWorkloadIdentityEntraResourceValue(value tfconfig.Variable) *SnowflakeModel {
+	s.WorkloadIdentityEntraResource = value
+	return s
+}
+
+func (s *SnowflakeModel) WithWorkloadIdentityProviderValue(value tfconfig.Variable) *SnowflakeModel {
+	s.WorkloadIdentityProvider = value
+	return s
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"slices"
 	"strconv"
 	"strings"
 	"testing"
@@ -99,6 +100,9 @@ var showByIdFunctions = map[resources.Resource]showByIdFunc{
 	resources.ApiAuthenticationIntegrationWithAuthorizationCodeGrant: func(ctx context.Context, client *sdk.Client, id sdk.ObjectIdentifier) error {
 		return runShowById(ctx, id, client.SecurityIntegrations.ShowByID)
 	},
+	resources.ApiAuthenticationIntegrationWithAwsIam: func(ctx context.Context, client *sdk.Client, id sdk.ObjectIdentifier) error {
+		return runShowById(ctx, id, client.SecurityIntegrations.ShowByID)
+	},
 	resources.ApiAuthenticationIntegrationWithClientCredentials: func(ctx context.Context, client *sdk.Client, id sdk.ObjectIdentifier) error {
 		return runShowById(ctx, id, client.SecurityIntegrations.ShowByID)
 	},
@@ -129,6 +133,9 @@ var showByIdFunctions = map[resources.Resource]showByIdFunc{
 	resources.EmailNotificationIntegration: func(ctx context.Context, client *sdk.Client, id sdk.ObjectIdentifier) error {
 		return runShowById(ctx, id, client.NotificationIntegrations.ShowByID)
 	},
+	resources.ExternalAccessIntegration: func(ctx context.Context, client *sdk.Client, id sdk.ObjectIdentifier) error {
+		return runShowById(ctx, id, client.ExternalAccessIntegrations.ShowByID)
+	},
 	resources.ExternalFunction: func(ctx context.Context, client *sdk.Client, id sdk.ObjectIdentifier) error {
 		return runShowById(ctx, id, client.ExternalFunctions.ShowByID)
 	},
@@ -162,6 +169,9 @@ var showByIdFunctions = map[resources.Resource]showByIdFunc{
 	resources.FunctionSql: func(ctx context.Context, client *sdk.Client, id sdk.ObjectIdentifier) error {
 		return runShowById(ctx, id, client.Functions.ShowByID)
 	},
+	resources.HybridTable: func(ctx context.Context, client *sdk.Client, id sdk.ObjectIdentifier) error {
+		return runShowById(ctx, id, client.HybridTables.ShowByID)
+	},
 	resources.LegacyServiceUser: func(ctx context.Context, client *sdk.Client, id sdk.ObjectIdentifier) error {
 		return runShowById(ctx, id, client.Users.ShowByID)
 	},
@@ -183,6 +193,15 @@ var showByIdFunctions = map[resources.Resource]showByIdFunc{
 	resources.NotificationIntegration: func(ctx context.Context, client *sdk.Client, id sdk.ObjectIdentifier) error {
 		return runShowById(ctx, id, client.NotificationIntegrations.ShowByID)
 	},
+	resources.NotificationIntegrationAwsSns: func(ctx context.Context, client *sdk.Client, id sdk.ObjectIdentifier) error {
+		return runShowById(ctx, id, client.NotificationIntegrations.ShowByID)
+	},
+	resources.NotificationIntegrationAzureEventGrid: func(ctx context.Context, client *sdk.Client, id sdk.ObjectIdentifier) error {
+		return runShowById(ctx, id, client.NotificationIntegrations.ShowByID)
+	},
+	resources.NotificationIntegrationGcpPubsub: func(ctx context.Context, client *sdk.Client, id sdk.ObjectIdentifier) error {
+		return runShowById(ctx, id, client.NotificationIntegrations.ShowByID)
+	},
 	resources.OauthIntegrationForCustomClients: func(ctx context.Context, client *sdk.Client, id sdk.ObjectIdentifier) error {
 		return runShowById(ctx, id, client.SecurityIntegrations.ShowByID)
 	},
@@ -258,15 +277,36 @@ var showByIdFunctions = map[resources.Resource]showByIdFunc{
 	resources.Stage: func(ctx context.Context, client *sdk.Client, id sdk.ObjectIdentifier) error {
 		return runShowById(ctx, id, client.Stages.ShowByID)
 	},
+	resources.StageExternalAzure: func(ctx context.Context, client *sdk.Client, id sdk.ObjectIdentifier) error {
+		return runShowById(ctx, id, client.Stages.ShowByID)
+	},
+	resources.StageExternalGcs: func(ctx context.Context, client *sdk.Client, id sdk.ObjectIdentifier) error {
+		return runShowById(ctx, id, client.Stages.ShowByID)
+	},
+	resources.StageExternalS3: func(ctx context.Context, client *sdk.Client, id sdk.ObjectIdentifier) error {
+		return runShowById(ctx, id, client.Stages.ShowByID)
+	},
+	resources.StageInternal: func(ctx context.Context, client *sdk.Client, id sdk.ObjectIdentifier) error {
+		return runShowById(ctx, id, client.Stages.ShowByID)
+	},
 	resources.StorageIntegration: func(ctx context.Context, client *sdk.Client, id sdk.ObjectIdentifier) error {
 		return runShowById(ctx, id, client.StorageIntegrations.ShowByID)
 	},
 	resources.StreamOnDirectoryTable: func(ctx context.Context, client *sdk.Client, id sdk.ObjectIdentifier) error {
 		return runShowById(ctx, id, client.Streams.ShowByID)
 	},
+	resources.StreamOnDynamicTable: func(ctx context.Context, client *sdk.Client, id sdk.ObjectIdentifier) error {
+		return runShowById(ctx, id, client.Streams.ShowByID)
+	},
+	resources.StreamOnEventTable: func(ctx context.Context, client *sdk.Client, id sdk.ObjectIdentifier) error {
+		return runShowById(ctx, id, client.Streams.ShowByID)
+	},
 	resources.StreamOnExternalTable: func(ctx context.Context, client *sdk.Client, id sdk.ObjectIdentifier) error {
 		return runShowById(ctx, id, client.Streams.ShowByID)
 	},
+	resources.StreamOnIcebergTable: func(ctx context.Context, client *sdk.Client, id sdk.ObjectIdentifier) error {
+		return runShowById(ctx, id, client.Streams.ShowByID)
+	},
 	resources.StreamOnTable: func(ctx context.Context, client *sdk.Client, id sdk.ObjectIdentifier) error {
 		return runShowById(ctx, id, client.Streams.ShowByID)
 	},
@@ -285,6 +325,9 @@ var showByIdFunctions = map[resources.Resource]showByIdFunc{
 	resources.Task: func(ctx context.Context, client *sdk.Client, id sdk.ObjectIdentifier) error {
 		return runShowById(ctx, id, client.Tasks.ShowByID)
 	},
+	resources.TaskGraph: func(ctx context.Context, client *sdk.Client, id sdk.ObjectIdentifier) error {
+		return runShowById(ctx, id, client.Tasks.ShowByID)
+	},
 	resources.User: func(ctx context.Context, client *sdk.Client, id sdk.ObjectIdentifier) error {
 		return runShowById(ctx, id, client.Users.ShowByID)
 	},
@@ -294,6 +337,9 @@ var showByIdFunctions = map[resources.Resource]showByIdFunc{
 	resources.Warehouse: func(ctx context.Context, client *sdk.Client, id sdk.ObjectIdentifier) error {
 		return runShowById(ctx, id, client.Warehouses.ShowByID)
 	},
+	resources.WebhookNotificationIntegration: func(ctx context.Context, client *sdk.Client, id sdk.ObjectIdentifier) error {
+		return runShowById(ctx, id, client.NotificationIntegrations.ShowByID)
+	},
 }
 
 func runShowById[T any, U sdk.AccountObjectIdentifier | sdk.DatabaseObjectIdentifier | sdk.SchemaObjectIdentifier | sdk.TableColumnIdentifier | sdk.SchemaObjectIdentifierWithArguments](ctx context.Context, id sdk.ObjectIdentifier, show func(ctx context.Context, id U) (T, error)) error {
@@ -349,6 +395,51 @@ func CheckGrantAccountRoleDestroy(t *testing.T) func(*terraform.State) error {
 	}
 }
 
+// CheckAccountRoleMembersDestroy is a custom checks that should be later incorporated into generic CheckDestroy
+func CheckAccountRoleMembersDestroy(t *testing.T) func(*terraform.State) error {
+	t.Helper()
+
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != resources.AccountRoleMembers.String() {
+				continue
+			}
+			roleId, err := sdk.ParseAccountObjectIdentifier(rs.Primary.ID)
+			if err != nil {
+				return err
+			}
+			grants, err := TestClient().Grant.ShowGrantsOfAccountRole(t, roleId)
+			if err != nil {
+				// the role could have been dropped as well; in this case, ignore the error
+				continue
+			}
+			for _, grant := range grants {
+				switch grant.GrantedTo {
+				case sdk.ObjectTypeUser:
+					if slices.Contains(collectAttributeSetValues(rs.Primary.Attributes, "users"), grant.GranteeName.Name()) {
+						return fmt.Errorf("account role %s is still granted to user %s", roleId.FullyQualifiedName(), grant.GranteeName.Name())
+					}
+				case sdk.ObjectTypeRole:
+					if slices.Contains(collectAttributeSetValues(rs.Primary.Attributes, "account_roles"), grant.GranteeName.Name()) {
+						return fmt.Errorf("account role %s is still granted to account role %s", roleId.FullyQualifiedName(), grant.GranteeName.Name())
+					}
+				}
+			}
+		}
+		return nil
+	}
+}
+
+func collectAttributeSetValues(attributes map[string]string, key string) []string {
+	values := make([]string, 0)
+	for k, v := range attributes {
+		if strings.HasPrefix(k, key+".") && !strings.HasSuffix(k, ".#") {
+			values = append(values, v)
+		}
+	}
+	return values
+}
+
 // CheckGrantDatabaseRoleDestroy is a custom checks that should be later incorporated into generic CheckDestroy
 func CheckGrantDatabaseRoleDestroy(t *testing.T) func(*terraform.State) error {
 	t.Helper()
@@ -514,6 +605,68 @@ func CheckUserAuthenticationPolicyAttachmentDestroy(t *testing.T) func(*terrafor
 	}
 }
 
+// CheckStageFileDestroy is a custom checks that should be later incorporated into generic CheckDestroy
+func CheckStageFileDestroy(t *testing.T) func(*terraform.State) error {
+	t.Helper()
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != resources.StageFile.String() {
+				continue
+			}
+			stageId, err := sdk.ParseSchemaObjectIdentifier(rs.Primary.Attributes["stage"])
+			if err != nil {
+				return err
+			}
+			fileName := rs.Primary.Attributes["file_name"]
+			files, err := atc.client.Stages.List(context.Background(), sdk.NewListStageRequest(stageId).WithPath(fileName))
+			if err != nil {
+				if errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+					// Note: this can happen if the stage has been deleted as well; in this case, ignore the error
+					continue
+				}
+				return err
+			}
+			for _, file := range files {
+				if strings.HasSuffix(file.Name, "/"+fileName) {
+					return fmt.Errorf("stage file %s still exists on stage %s", fileName, stageId.FullyQualifiedName())
+				}
+			}
+		}
+		return nil
+	}
+}
+
+// CheckUserProgrammaticAccessTokenDestroy is a custom checks that should be later incorporated into generic CheckDestroy
+func CheckUserProgrammaticAccessTokenDestroy(t *testing.T) func(*terraform.State) error {
+	t.Helper()
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != resources.UserProgrammaticAccessToken.String() {
+				continue
+			}
+			userId, err := sdk.ParseAccountObjectIdentifier(rs.Primary.Attributes["user"])
+			if err != nil {
+				return err
+			}
+			tokenId, err := sdk.ParseAccountObjectIdentifier(rs.Primary.Attributes["name"])
+			if err != nil {
+				return err
+			}
+			_, err = atc.client.UserProgrammaticAccessTokens.ShowByID(context.Background(), userId, tokenId)
+			switch {
+			case err == nil:
+				return fmt.Errorf("programmatic access token %s of user %s still exists", tokenId.Name(), userId.Name())
+			case errors.Is(err, sdk.ErrObjectNotFound), errors.Is(err, sdk.ErrObjectNotExistOrAuthorized):
+				// Note: the user could have been dropped as well; in this case, ignore the error
+				continue
+			default:
+				return err
+			}
+		}
+		return nil
+	}
+}
+
 // CheckResourceTagUnset is a custom check that should be later incorporated into generic CheckDestroy
 func CheckResourceTagUnset(t *testing.T) func(*terraform.State) error {
 	t.Helper()
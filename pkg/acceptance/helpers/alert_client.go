@@ -29,16 +29,19 @@ func (c *AlertClient) CreateAlert(t *testing.T) (*sdk.Alert, func()) {
 	schedule := "USING CRON * * * * * UTC"
 	condition := "SELECT 1"
 	action := "SELECT 1"
-	return c.CreateAlertWithOptions(t, schedule, condition, action, &sdk.CreateAlertOptions{})
+	return c.CreateAlertWithOptions(t, condition, action, &sdk.CreateAlertOptions{
+		Warehouse: sdk.Pointer(c.ids.WarehouseId()),
+		Schedule:  sdk.String(schedule),
+	})
 }
 
-func (c *AlertClient) CreateAlertWithOptions(t *testing.T, schedule string, condition string, action string, opts *sdk.CreateAlertOptions) (*sdk.Alert, func()) {
+func (c *AlertClient) CreateAlertWithOptions(t *testing.T, condition string, action string, opts *sdk.CreateAlertOptions) (*sdk.Alert, func()) {
 	t.Helper()
 	ctx := context.Background()
 
 	id := c.ids.RandomSchemaObjectIdentifier()
 
-	err := c.client().Create(ctx, id, c.ids.WarehouseId(), schedule, condition, action, opts)
+	err := c.client().Create(ctx, id, condition, action, opts)
 	require.NoError(t, err)
 
 	alert, err := c.client().ShowByID(ctx, id)
@@ -56,3 +59,18 @@ func (c *AlertClient) DropAlertFunc(t *testing.T, id sdk.SchemaObjectIdentifier)
 		require.NoError(t, err)
 	}
 }
+
+func (c *AlertClient) Show(t *testing.T, id sdk.SchemaObjectIdentifier) (*sdk.Alert, error) {
+	t.Helper()
+	ctx := context.Background()
+
+	return c.client().ShowByID(ctx, id)
+}
+
+func (c *AlertClient) Alter(t *testing.T, id sdk.SchemaObjectIdentifier, opts *sdk.AlterAlertOptions) {
+	t.Helper()
+	ctx := context.Background()
+
+	err := c.client().Alter(ctx, id, opts)
+	require.NoError(t, err)
+}
@@ -43,6 +43,13 @@ func (c *AuthenticationPolicyClient) CreateWithOptions(t *testing.T, id sdk.Sche
 	return authenticationPolicy, c.DropFunc(t, id)
 }
 
+func (c *AuthenticationPolicyClient) Alter(t *testing.T, req *sdk.AlterAuthenticationPolicyRequest) {
+	t.Helper()
+	ctx := context.Background()
+	err := c.client().Alter(ctx, req)
+	require.NoError(t, err)
+}
+
 func (c *AuthenticationPolicyClient) DropFunc(t *testing.T, id sdk.SchemaObjectIdentifier) func() {
 	t.Helper()
 	ctx := context.Background()
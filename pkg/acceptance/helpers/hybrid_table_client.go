@@ -2,7 +2,6 @@ package helpers
 
 import (
 	"context"
-	"fmt"
 	"testing"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
@@ -21,31 +20,76 @@ func NewHybridTableClient(context *TestClientContext, idsGenerator *IdsGenerator
 	}
 }
 
-func (c *HybridTableClient) exec(sql string) error {
-	ctx := context.Background()
-	_, err := c.context.client.ExecForTests(ctx, sql)
-	return err
+func (c *HybridTableClient) client() sdk.HybridTablesExtended {
+	return c.context.client.HybridTables
 }
 
-// TODO(SNOW-999142): Use SDK implementation for Hybrid Table once it's available
 func (c *HybridTableClient) Create(t *testing.T) (sdk.SchemaObjectIdentifier, func()) {
 	t.Helper()
-	id := c.ids.RandomSchemaObjectIdentifier()
-	err := c.exec(fmt.Sprintf(`
-create hybrid table %s (
-  id INT AUTOINCREMENT PRIMARY KEY
-)
-`, id.FullyQualifiedName()))
+	hybridTable, cleanup := c.CreateWithRequest(t, sdk.NewCreateHybridTableRequest(c.ids.RandomSchemaObjectIdentifier(), c.DefaultColumnsAndConstraints()))
+	return hybridTable.ID(), cleanup
+}
+
+func (c *HybridTableClient) DefaultColumnsAndConstraints() sdk.HybridTableColumnsConstraintsAndIndexes {
+	return sdk.HybridTableColumnsConstraintsAndIndexes{
+		Columns: []sdk.TableColumn{
+			{
+				Name:             `"id"`,
+				Type:             sdk.DataTypeNumber,
+				InlineConstraint: &sdk.ColumnInlineConstraint{Type: sdk.ColumnConstraintTypePrimaryKey},
+			},
+			{
+				Name: `"name"`,
+				Type: sdk.DataTypeVARCHAR,
+			},
+		},
+	}
+}
+
+func (c *HybridTableClient) CreateWithRequest(t *testing.T, req *sdk.CreateHybridTableRequest) (*sdk.HybridTable, func()) {
+	t.Helper()
+	ctx := context.Background()
+
+	err := c.client().Create(ctx, req)
+	require.NoError(t, err)
+
+	hybridTable, err := c.client().ShowByID(ctx, req.GetName())
 	require.NoError(t, err)
 
-	return id, c.DropFunc(t, id)
+	return hybridTable, c.DropFunc(t, req.GetName())
+}
+
+func (c *HybridTableClient) CreateIndex(t *testing.T, tableId sdk.SchemaObjectIdentifier, name string, columns []string) {
+	t.Helper()
+	ctx := context.Background()
+
+	err := c.client().CreateIndex(ctx, sdk.NewCreateIndexHybridTableRequest(name, tableId, columns))
+	require.NoError(t, err)
 }
 
 func (c *HybridTableClient) DropFunc(t *testing.T, id sdk.SchemaObjectIdentifier) func() {
 	t.Helper()
+	ctx := context.Background()
 
 	return func() {
-		err := c.exec(fmt.Sprintf(`drop table if exists %s`, id.FullyQualifiedName()))
+		err := c.client().Drop(ctx, sdk.NewDropHybridTableRequest(id).WithIfExists(true))
 		require.NoError(t, err)
 	}
 }
+
+func (c *HybridTableClient) Show(t *testing.T, id sdk.SchemaObjectIdentifier) (*sdk.HybridTable, error) {
+	t.Helper()
+	ctx := context.Background()
+
+	return c.client().ShowByID(ctx, id)
+}
+
+func (c *HybridTableClient) ShowIndexes(t *testing.T, id sdk.SchemaObjectIdentifier) []sdk.HybridTableIndex {
+	t.Helper()
+	ctx := context.Background()
+
+	indexes, err := c.client().ShowIndexes(ctx, sdk.NewShowHybridTableIndexesRequest().WithIn(sdk.HybridTableIndexesIn{Table: id}))
+	require.NoError(t, err)
+
+	return indexes
+}
@@ -0,0 +1,62 @@
+package helpers
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/stretchr/testify/require"
+)
+
+// TODO(SNOW-1564954): change raw sqls to proper client
+type IcebergTableClient struct {
+	context *TestClientContext
+	ids     *IdsGenerator
+}
+
+func NewIcebergTableClient(context *TestClientContext, idsGenerator *IdsGenerator) *IcebergTableClient {
+	return &IcebergTableClient{
+		context: context,
+		ids:     idsGenerator,
+	}
+}
+
+func (c *IcebergTableClient) client() *sdk.Client {
+	return c.context.client
+}
+
+// Create creates a Snowflake-managed Iceberg table stored in the Snowflake storage (SNOWFLAKE_MANAGED external volume),
+// so that no cloud storage setup is needed.
+func (c *IcebergTableClient) Create(t *testing.T) (sdk.SchemaObjectIdentifier, func()) {
+	t.Helper()
+	return c.CreateInSchema(t, c.ids.SchemaId())
+}
+
+func (c *IcebergTableClient) CreateInSchema(t *testing.T, schemaId sdk.DatabaseObjectIdentifier) (sdk.SchemaObjectIdentifier, func()) {
+	t.Helper()
+	ctx := context.Background()
+
+	id := c.ids.RandomSchemaObjectIdentifierInSchema(schemaId)
+	_, err := c.client().ExecForTests(ctx, fmt.Sprintf(`CREATE ICEBERG TABLE %s (ID NUMBER(38, 0), SOME_TEXT_COLUMN STRING) CATALOG = 'SNOWFLAKE' EXTERNAL_VOLUME = 'SNOWFLAKE_MANAGED' BASE_LOCATION = '%s'`, id.FullyQualifiedName(), id.Name()))
+	require.NoError(t, err)
+	return id, c.DropFunc(t, id)
+}
+
+func (c *IcebergTableClient) InsertInt(t *testing.T, id sdk.SchemaObjectIdentifier) {
+	t.Helper()
+	ctx := context.Background()
+
+	_, err := c.client().ExecForTests(ctx, fmt.Sprintf(`INSERT INTO %s (ID) VALUES (1)`, id.FullyQualifiedName()))
+	require.NoError(t, err)
+}
+
+func (c *IcebergTableClient) DropFunc(t *testing.T, id sdk.SchemaObjectIdentifier) func() {
+	t.Helper()
+	ctx := context.Background()
+
+	return func() {
+		_, err := c.client().ExecForTests(ctx, fmt.Sprintf(`DROP ICEBERG TABLE IF EXISTS %s`, id.FullyQualifiedName()))
+		require.NoError(t, err)
+	}
+}
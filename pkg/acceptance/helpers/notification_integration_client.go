@@ -77,3 +77,11 @@ func (c *NotificationIntegrationClient) DropFunc(t *testing.T, id sdk.AccountObj
 		require.NoError(t, err)
 	}
 }
+
+func (c *NotificationIntegrationClient) Alter(t *testing.T, request *sdk.AlterNotificationIntegrationRequest) {
+	t.Helper()
+	ctx := context.Background()
+
+	err := c.client().Alter(ctx, request)
+	require.NoError(t, err)
+}
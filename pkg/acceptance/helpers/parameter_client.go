@@ -100,6 +100,17 @@ func (c *ParameterClient) ShowTaskParameters(t *testing.T, id sdk.SchemaObjectId
 	return params
 }
 
+func (c *ParameterClient) ShowAlertParameters(t *testing.T, id sdk.SchemaObjectIdentifier) []*sdk.Parameter {
+	t.Helper()
+	params, err := c.client().ShowParameters(context.Background(), &sdk.ShowParametersOptions{
+		In: &sdk.ParametersIn{
+			Alert: id,
+		},
+	})
+	require.NoError(t, err)
+	return params
+}
+
 func (c *ParameterClient) ShowFunctionParameters(t *testing.T, id sdk.SchemaObjectIdentifierWithArguments) []*sdk.Parameter {
 	t.Helper()
 	params, err := c.client().ShowParameters(context.Background(), &sdk.ShowParametersOptions{
@@ -94,6 +94,16 @@ func (c *RoleClient) GrantRoleToUser(t *testing.T, id sdk.AccountObjectIdentifie
 	require.NoError(t, err)
 }
 
+func (c *RoleClient) RevokeRoleFromUser(t *testing.T, id sdk.AccountObjectIdentifier, userId sdk.AccountObjectIdentifier) {
+	t.Helper()
+	ctx := context.Background()
+
+	err := c.client().Revoke(ctx, sdk.NewRevokeRoleRequest(id, sdk.RevokeRole{
+		User: sdk.Pointer(userId),
+	}))
+	require.NoError(t, err)
+}
+
 func (c *RoleClient) GrantRoleToCurrentRole(t *testing.T, id sdk.AccountObjectIdentifier) {
 	t.Helper()
 	ctx := context.Background()
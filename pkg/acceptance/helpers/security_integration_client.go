@@ -159,6 +159,14 @@ func (c *SecurityIntegrationClient) CreateScimWithRequest(t *testing.T, request
 	return si, c.DropSecurityIntegrationFunc(t, request.GetName())
 }
 
+func (c *SecurityIntegrationClient) UpdateApiAuthenticationWithAwsIam(t *testing.T, request *sdk.AlterApiAuthenticationWithAwsIamSecurityIntegrationRequest) {
+	t.Helper()
+	ctx := context.Background()
+
+	err := c.client().AlterApiAuthenticationWithAwsIam(ctx, request)
+	require.NoError(t, err)
+}
+
 func (c *SecurityIntegrationClient) UpdateExternalOauth(t *testing.T, request *sdk.AlterExternalOauthSecurityIntegrationRequest) {
 	t.Helper()
 	ctx := context.Background()
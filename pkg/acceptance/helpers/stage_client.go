@@ -27,7 +27,7 @@ func NewStageClient(context *TestClientContext, idsGenerator *IdsGenerator) *Sta
 	}
 }
 
-func (c *StageClient) client() sdk.Stages {
+func (c *StageClient) client() sdk.StagesExtended {
 	return c.context.client.Stages
 }
 
@@ -182,3 +182,10 @@ func (c *StageClient) Describe(t *testing.T, id sdk.SchemaObjectIdentifier) ([]s
 
 	return c.client().Describe(ctx, id)
 }
+
+func (c *StageClient) List(t *testing.T, id sdk.SchemaObjectIdentifier, path string) ([]sdk.StageFile, error) {
+	t.Helper()
+	ctx := context.Background()
+
+	return c.client().List(ctx, sdk.NewListStageRequest(id).WithPath(path))
+}
@@ -124,6 +124,14 @@ func (c *TableClient) Show(t *testing.T, id sdk.SchemaObjectIdentifier) (*sdk.Ta
 	return c.client().ShowByID(ctx, id)
 }
 
+func (c *TableClient) Alter(t *testing.T, req *sdk.AlterTableRequest) {
+	t.Helper()
+	ctx := context.Background()
+
+	err := c.client().Alter(ctx, req)
+	require.NoError(t, err)
+}
+
 func (c *TableClient) SetDataRetentionTime(t *testing.T, id sdk.SchemaObjectIdentifier, days int) {
 	t.Helper()
 	ctx := context.Background()
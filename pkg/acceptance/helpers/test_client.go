@@ -37,6 +37,7 @@ type TestClient struct {
 	Function                     *FunctionClient
 	Grant                        *GrantClient
 	HybridTable                  *HybridTableClient
+	IcebergTable                 *IcebergTableClient
 	InformationSchema            *InformationSchemaClient
 	MaskingPolicy                *MaskingPolicyClient
 	MaterializedView             *MaterializedViewClient
@@ -66,6 +67,7 @@ type TestClient struct {
 	Tag                          *TagClient
 	Task                         *TaskClient
 	User                         *UserClient
+	UserProgrammaticAccessToken  *UserProgrammaticAccessTokenClient
 	View                         *ViewClient
 	Warehouse                    *WarehouseClient
 }
@@ -112,6 +114,7 @@ func NewTestClient(c *sdk.Client, database string, schema string, warehouse stri
 		Function:                     NewFunctionClient(context, idsGenerator),
 		Grant:                        NewGrantClient(context, idsGenerator),
 		HybridTable:                  NewHybridTableClient(context, idsGenerator),
+		IcebergTable:                 NewIcebergTableClient(context, idsGenerator),
 		InformationSchema:            NewInformationSchemaClient(context, idsGenerator),
 		MaskingPolicy:                NewMaskingPolicyClient(context, idsGenerator),
 		MaterializedView:             NewMaterializedViewClient(context, idsGenerator),
@@ -141,6 +144,7 @@ func NewTestClient(c *sdk.Client, database string, schema string, warehouse stri
 		Tag:                          NewTagClient(context, idsGenerator),
 		Task:                         NewTaskClient(context, idsGenerator),
 		User:                         NewUserClient(context, idsGenerator),
+		UserProgrammaticAccessToken:  NewUserProgrammaticAccessTokenClient(context, idsGenerator),
 		View:                         NewViewClient(context, idsGenerator),
 		Warehouse:                    NewWarehouseClient(context, idsGenerator),
 	}
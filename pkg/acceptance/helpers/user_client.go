@@ -191,3 +191,17 @@ func (c *UserClient) UnsetDefaultSecondaryRoles(t *testing.T, id sdk.AccountObje
 	})
 	require.NoError(t, err)
 }
+
+func (c *UserClient) UnsetWorkloadIdentity(t *testing.T, id sdk.AccountObjectIdentifier) {
+	t.Helper()
+	ctx := context.Background()
+
+	err := c.client().Alter(ctx, id, &sdk.AlterUserOptions{
+		Unset: &sdk.UserUnset{
+			ObjectProperties: &sdk.UserObjectPropertiesUnset{
+				WorkloadIdentity: sdk.Bool(true),
+			},
+		},
+	})
+	require.NoError(t, err)
+}
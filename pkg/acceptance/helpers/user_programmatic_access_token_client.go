@@ -0,0 +1,64 @@
+package helpers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/stretchr/testify/require"
+)
+
+type UserProgrammaticAccessTokenClient struct {
+	context *TestClientContext
+	ids     *IdsGenerator
+}
+
+func NewUserProgrammaticAccessTokenClient(context *TestClientContext, idsGenerator *IdsGenerator) *UserProgrammaticAccessTokenClient {
+	return &UserProgrammaticAccessTokenClient{
+		context: context,
+		ids:     idsGenerator,
+	}
+}
+
+func (c *UserProgrammaticAccessTokenClient) client() sdk.UserProgrammaticAccessTokensExtended {
+	return c.context.client.UserProgrammaticAccessTokens
+}
+
+func (c *UserProgrammaticAccessTokenClient) Add(t *testing.T, userId sdk.AccountObjectIdentifier) (*sdk.AddProgrammaticAccessTokenResult, func()) {
+	t.Helper()
+	return c.AddWithRequest(t, sdk.NewAddProgrammaticAccessTokenRequest(userId, c.ids.RandomAccountObjectIdentifier()))
+}
+
+func (c *UserProgrammaticAccessTokenClient) AddWithRequest(t *testing.T, request *sdk.AddProgrammaticAccessTokenRequest) (*sdk.AddProgrammaticAccessTokenResult, func()) {
+	t.Helper()
+	ctx := context.Background()
+
+	result, err := c.client().Add(ctx, request)
+	require.NoError(t, err)
+	return result, c.RemoveFunc(t, request.UserName, sdk.NewAccountObjectIdentifier(result.TokenName))
+}
+
+func (c *UserProgrammaticAccessTokenClient) Modify(t *testing.T, request *sdk.ModifyProgrammaticAccessTokenRequest) {
+	t.Helper()
+	ctx := context.Background()
+
+	err := c.client().Modify(ctx, request)
+	require.NoError(t, err)
+}
+
+func (c *UserProgrammaticAccessTokenClient) RemoveFunc(t *testing.T, userId sdk.AccountObjectIdentifier, tokenId sdk.AccountObjectIdentifier) func() {
+	t.Helper()
+	ctx := context.Background()
+
+	return func() {
+		err := c.client().Remove(ctx, sdk.NewRemoveProgrammaticAccessTokenRequest(userId, tokenId).WithIfExists(true))
+		require.NoError(t, err)
+	}
+}
+
+func (c *UserProgrammaticAccessTokenClient) Show(t *testing.T, userId sdk.AccountObjectIdentifier, tokenId sdk.AccountObjectIdentifier) (*sdk.ProgrammaticAccessToken, error) {
+	t.Helper()
+	ctx := context.Background()
+
+	return c.client().ShowByID(ctx, userId, tokenId)
+}
@@ -0,0 +1,79 @@
+package datasources_test
+
+import (
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	accconfig "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/datasourcemodel"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/model"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/helpers/random"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/testenvs"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_ExternalAccessIntegrations_Complete(t *testing.T) {
+	_ = testenvs.GetOrSkipTest(t, testenvs.EnableAcceptance)
+	acc.TestAccPreCheck(t)
+
+	networkRule, networkRuleCleanup := acc.TestClient().NetworkRule.Create(t)
+	t.Cleanup(networkRuleCleanup)
+
+	id := acc.TestClient().Ids.RandomAccountObjectIdentifier()
+	id2 := acc.TestClient().Ids.RandomAccountObjectIdentifier()
+	comment := random.Comment()
+
+	integrationModel1 := model.ExternalAccessIntegration("test", []sdk.SchemaObjectIdentifier{networkRule.ID()}, true, id.Name()).
+		WithComment(comment)
+	integrationModel2 := model.ExternalAccessIntegration("test2", []sdk.SchemaObjectIdentifier{networkRule.ID()}, false, id2.Name())
+
+	integrationsModelWithDescribe := datasourcemodel.ExternalAccessIntegrations("test").
+		WithLike(id.Name()).
+		WithDependsOn(integrationModel1.ResourceReference(), integrationModel2.ResourceReference())
+	integrationsModelWithoutDescribe := datasourcemodel.ExternalAccessIntegrations("test").
+		WithWithDescribe(false).
+		WithLike(id2.Name()).
+		WithDependsOn(integrationModel1.ResourceReference(), integrationModel2.ResourceReference())
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: acc.CheckDestroy(t, resources.ExternalAccessIntegration),
+		Steps: []resource.TestStep{
+			{
+				Config: accconfig.FromModels(t, integrationModel1, integrationModel2, integrationsModelWithDescribe),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(integrationsModelWithDescribe.DatasourceReference(), "external_access_integrations.#", "1"),
+					resource.TestCheckResourceAttrSet(integrationsModelWithDescribe.DatasourceReference(), "external_access_integrations.0.show_output.0.created_on"),
+					resource.TestCheckResourceAttr(integrationsModelWithDescribe.DatasourceReference(), "external_access_integrations.0.show_output.0.name", id.Name()),
+					resource.TestCheckResourceAttr(integrationsModelWithDescribe.DatasourceReference(), "external_access_integrations.0.show_output.0.integration_type", "EXTERNAL_ACCESS"),
+					resource.TestCheckResourceAttr(integrationsModelWithDescribe.DatasourceReference(), "external_access_integrations.0.show_output.0.category", "SECURITY"),
+					resource.TestCheckResourceAttr(integrationsModelWithDescribe.DatasourceReference(), "external_access_integrations.0.show_output.0.enabled", "true"),
+					resource.TestCheckResourceAttr(integrationsModelWithDescribe.DatasourceReference(), "external_access_integrations.0.show_output.0.comment", comment),
+
+					resource.TestCheckResourceAttr(integrationsModelWithDescribe.DatasourceReference(), "external_access_integrations.0.describe_output.#", "1"),
+					resource.TestCheckResourceAttr(integrationsModelWithDescribe.DatasourceReference(), "external_access_integrations.0.describe_output.0.enabled", "true"),
+					resource.TestCheckResourceAttr(integrationsModelWithDescribe.DatasourceReference(), "external_access_integrations.0.describe_output.0.comment", comment),
+					resource.TestCheckResourceAttrSet(integrationsModelWithDescribe.DatasourceReference(), "external_access_integrations.0.describe_output.0.allowed_network_rules"),
+				),
+			},
+			{
+				Config: accconfig.FromModels(t, integrationModel1, integrationModel2, integrationsModelWithoutDescribe),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(integrationsModelWithoutDescribe.DatasourceReference(), "external_access_integrations.#", "1"),
+					resource.TestCheckResourceAttr(integrationsModelWithoutDescribe.DatasourceReference(), "external_access_integrations.0.show_output.0.name", id2.Name()),
+					resource.TestCheckResourceAttr(integrationsModelWithoutDescribe.DatasourceReference(), "external_access_integrations.0.show_output.0.enabled", "false"),
+					resource.TestCheckResourceAttr(integrationsModelWithoutDescribe.DatasourceReference(), "external_access_integrations.0.show_output.0.comment", ""),
+
+					resource.TestCheckResourceAttr(integrationsModelWithoutDescribe.DatasourceReference(), "external_access_integrations.0.describe_output.#", "0"),
+				),
+			},
+		},
+	})
+}
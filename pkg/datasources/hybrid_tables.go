@@ -0,0 +1,76 @@
+package datasources
+
+import (
+	"context"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/datasources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/schemas"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var hybridTablesSchema = map[string]*schema.Schema{
+	"like":        likeSchema,
+	"in":          inSchema,
+	"starts_with": startsWithSchema,
+	"limit":       limitFromSchema,
+	"hybrid_tables": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Holds the aggregated output of all hybrid tables details queries.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				resources.ShowOutputAttributeName: {
+					Type:        schema.TypeList,
+					Computed:    true,
+					Description: "Holds the output of SHOW HYBRID TABLES.",
+					Elem: &schema.Resource{
+						Schema: schemas.ShowHybridTableSchema,
+					},
+				},
+			},
+		},
+	},
+}
+
+func HybridTables() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: PreviewFeatureReadWrapper(string(previewfeatures.HybridTablesDatasource), TrackingReadWrapper(datasources.HybridTables, ReadHybridTables)),
+		Schema:      hybridTablesSchema,
+		Description: "Data source used to get details of filtered hybrid tables. Filtering is aligned with the current possibilities for [SHOW HYBRID TABLES](https://docs.snowflake.com/en/sql-reference/sql/show-hybrid-tables) query. The results of SHOW are encapsulated in one output collection `hybrid_tables`.",
+	}
+}
+
+func ReadHybridTables(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	req := sdk.ShowHybridTableRequest{}
+
+	handleLike(d, &req.Like)
+	handleLimitFrom(d, &req.Limit)
+	handleStartsWith(d, &req.StartsWith)
+	if err := handleIn(d, &req.In); err != nil {
+		return diag.FromErr(err)
+	}
+
+	hybridTables, err := client.HybridTables.Show(ctx, &req)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId("hybrid_tables_read")
+
+	flattenedHybridTables := make([]map[string]any, len(hybridTables))
+	for i, hybridTable := range hybridTables {
+		hybridTable := hybridTable
+		flattenedHybridTables[i] = map[string]any{
+			resources.ShowOutputAttributeName: []map[string]any{schemas.HybridTableToSchema(&hybridTable)},
+		}
+	}
+	if err := d.Set("hybrid_tables", flattenedHybridTables); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
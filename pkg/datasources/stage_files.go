@@ -0,0 +1,106 @@
+package datasources
+
+import (
+	"context"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/datasources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var stageFilesSchema = map[string]*schema.Schema{
+	"stage": {
+		Type:             schema.TypeString,
+		Required:         true,
+		Description:      "Fully qualified name of the stage from which the files are listed.",
+		ValidateDiagFunc: resources.IsValidIdentifier[sdk.SchemaObjectIdentifier](),
+	},
+	"path": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Lists only the files under the given path on the stage (e.g. `data/2024`).",
+	},
+	"pattern": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Filters the files with a regular expression (e.g. `.*[.]csv`). The pattern is matched against the whole file name returned by LIST.",
+	},
+	"files": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Holds the output of LIST.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Name of the file. For internal stages, it is prefixed with the stage name; for external stages, it is the full URL of the file.",
+				},
+				"size": {
+					Type:        schema.TypeInt,
+					Computed:    true,
+					Description: "Size of the file in bytes.",
+				},
+				"md5": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "MD5 hash of the file.",
+				},
+				"last_modified": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Timestamp of the last modification of the file.",
+				},
+			},
+		},
+	},
+}
+
+func StageFiles() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: PreviewFeatureReadWrapper(string(previewfeatures.StageFilesDatasource), TrackingReadWrapper(datasources.StageFiles, ReadStageFiles)),
+		Schema:      stageFilesSchema,
+		Description: "Data source used to list the files on a stage. It is based on the [LIST](https://docs.snowflake.com/en/sql-reference/sql/list) command.",
+	}
+}
+
+func ReadStageFiles(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	stageId, err := sdk.ParseSchemaObjectIdentifier(d.Get("stage").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	req := sdk.NewListStageRequest(stageId)
+	if v, ok := d.GetOk("path"); ok {
+		req.WithPath(v.(string))
+	}
+	if v, ok := d.GetOk("pattern"); ok {
+		req.WithPattern(v.(string))
+	}
+
+	files, err := client.Stages.List(ctx, req)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(helpers.EncodeResourceIdentifier(stageId))
+
+	flattenedFiles := make([]map[string]any, len(files))
+	for i, file := range files {
+		flattenedFiles[i] = map[string]any{
+			"name":          file.Name,
+			"size":          int(file.Size),
+			"md5":           file.Md5,
+			"last_modified": file.LastModified,
+		}
+	}
+	if err := d.Set("files", flattenedFiles); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
@@ -0,0 +1,67 @@
+package datasources_test
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/testenvs"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_StageFiles(t *testing.T) {
+	_ = testenvs.GetOrSkipTest(t, testenvs.EnableAcceptance)
+	acc.TestAccPreCheck(t)
+
+	stage, stageCleanup := acc.TestClient().Stage.CreateStage(t)
+	t.Cleanup(stageCleanup)
+
+	acc.TestClient().Stage.PutOnStageWithContent(t, stage.ID(), "data.csv", "1,2,3")
+	acc.TestClient().Stage.PutOnStageWithContent(t, stage.ID(), "data.json", `{"a": 1}`)
+
+	dataSourceReference := "data.snowflake_stage_files.test"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: stageFiles(stage.ID(), ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceReference, "files.#", "2"),
+				),
+			},
+			{
+				Config: stageFiles(stage.ID(), ".*[.]csv"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceReference, "files.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceReference, "files.0.name", strings.ToLower(stage.Name)+"/data.csv"),
+					resource.TestCheckResourceAttr(dataSourceReference, "files.0.size", "5"),
+					resource.TestCheckResourceAttrSet(dataSourceReference, "files.0.md5"),
+					resource.TestCheckResourceAttrSet(dataSourceReference, "files.0.last_modified"),
+				),
+			},
+		},
+	})
+}
+
+func stageFiles(stageId sdk.SchemaObjectIdentifier, pattern string) string {
+	patternConfig := ""
+	if pattern != "" {
+		patternConfig = fmt.Sprintf(`pattern = "%s"`, pattern)
+	}
+	return fmt.Sprintf(`
+data "snowflake_stage_files" "test" {
+  stage = %[1]s
+  %[2]s
+}
+`, strconv.Quote(stageId.FullyQualifiedName()), patternConfig)
+}
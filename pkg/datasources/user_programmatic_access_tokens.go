@@ -0,0 +1,79 @@
+package datasources
+
+import (
+	"context"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/datasources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/schemas"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var userProgrammaticAccessTokensSchema = map[string]*schema.Schema{
+	"for_user": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		Description:      "Returns the programmatic access tokens of the specified user. By default, the tokens of the current user are returned.",
+		ValidateDiagFunc: resources.IsValidIdentifier[sdk.AccountObjectIdentifier](),
+	},
+	"user_programmatic_access_tokens": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Holds the aggregated output of all programmatic access tokens details queries.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				resources.ShowOutputAttributeName: {
+					Type:        schema.TypeList,
+					Computed:    true,
+					Description: "Holds the output of SHOW USER PROGRAMMATIC ACCESS TOKENS.",
+					Elem: &schema.Resource{
+						Schema: schemas.ShowProgrammaticAccessTokenSchema,
+					},
+				},
+			},
+		},
+	},
+}
+
+func UserProgrammaticAccessTokens() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: PreviewFeatureReadWrapper(string(previewfeatures.UserProgrammaticAccessTokensDatasource), TrackingReadWrapper(datasources.UserProgrammaticAccessTokens, ReadUserProgrammaticAccessTokens)),
+		Schema:      userProgrammaticAccessTokensSchema,
+		Description: "Data source used to get details of programmatic access tokens of a user, e.g. their status and expiration time. The results of [SHOW USER PROGRAMMATIC ACCESS TOKENS](https://docs.snowflake.com/en/sql-reference/sql/show-user-programmatic-access-tokens) are encapsulated in one output collection `user_programmatic_access_tokens`. The token secrets are never returned.",
+	}
+}
+
+func ReadUserProgrammaticAccessTokens(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	req := sdk.NewShowProgrammaticAccessTokenRequest()
+
+	if v, ok := d.GetOk("for_user"); ok {
+		userId, err := sdk.ParseAccountObjectIdentifier(v.(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		req.WithUserName(userId)
+	}
+
+	tokens, err := client.UserProgrammaticAccessTokens.Show(ctx, req)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId("user_programmatic_access_tokens_read")
+
+	flattenedTokens := make([]map[string]any, len(tokens))
+	for i, token := range tokens {
+		token := token
+		flattenedTokens[i] = map[string]any{
+			resources.ShowOutputAttributeName: []map[string]any{schemas.ProgrammaticAccessTokenToSchema(&token)},
+		}
+	}
+	if err := d.Set("user_programmatic_access_tokens", flattenedTokens); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
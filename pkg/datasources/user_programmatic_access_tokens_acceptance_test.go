@@ -0,0 +1,61 @@
+package datasources_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/testenvs"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_UserProgrammaticAccessTokens(t *testing.T) {
+	_ = testenvs.GetOrSkipTest(t, testenvs.EnableAcceptance)
+	acc.TestAccPreCheck(t)
+
+	user, userCleanup := acc.TestClient().User.CreateUser(t)
+	t.Cleanup(userCleanup)
+
+	id := acc.TestClient().Ids.RandomAccountObjectIdentifier()
+	_, tokenCleanup := acc.TestClient().UserProgrammaticAccessToken.AddWithRequest(t, sdk.NewAddProgrammaticAccessTokenRequest(user.ID(), id).
+		WithDaysToExpiry(5).
+		WithComment("compliance"))
+	t.Cleanup(tokenCleanup)
+
+	dataSourceReference := "data.snowflake_user_programmatic_access_tokens.test"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: userProgrammaticAccessTokens(user.ID()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceReference, "user_programmatic_access_tokens.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceReference, "user_programmatic_access_tokens.0.show_output.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceReference, "user_programmatic_access_tokens.0.show_output.0.name", id.Name()),
+					resource.TestCheckResourceAttr(dataSourceReference, "user_programmatic_access_tokens.0.show_output.0.user_name", user.ID().Name()),
+					resource.TestCheckResourceAttr(dataSourceReference, "user_programmatic_access_tokens.0.show_output.0.status", string(sdk.ProgrammaticAccessTokenStatusActive)),
+					resource.TestCheckResourceAttr(dataSourceReference, "user_programmatic_access_tokens.0.show_output.0.comment", "compliance"),
+					resource.TestCheckResourceAttrSet(dataSourceReference, "user_programmatic_access_tokens.0.show_output.0.expires_at"),
+					resource.TestCheckResourceAttrSet(dataSourceReference, "user_programmatic_access_tokens.0.show_output.0.created_on"),
+					resource.TestCheckNoResourceAttr(dataSourceReference, "user_programmatic_access_tokens.0.show_output.0.token"),
+				),
+			},
+		},
+	})
+}
+
+func userProgrammaticAccessTokens(userId sdk.AccountObjectIdentifier) string {
+	return fmt.Sprintf(`
+data "snowflake_user_programmatic_access_tokens" "test" {
+  for_user = %s
+}
+`, userId.FullyQualifiedName())
+}
@@ -26,6 +26,12 @@ var usersSchema = map[string]*schema.Schema{
 		Default:     true,
 		Description: "Runs SHOW PARAMETERS FOR USER for each user returned by SHOW USERS. The output of describe is saved to the parameters field as a map. By default this value is set to true.",
 	},
+	"with_mfa_methods": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Runs SHOW MFA METHODS FOR USER for each user returned by SHOW USERS. The output is saved to the mfa_methods_output field. By default this value is set to false.",
+	},
 	"like": {
 		Type:        schema.TypeString,
 		Optional:    true,
@@ -86,6 +92,14 @@ var usersSchema = map[string]*schema.Schema{
 						Schema: schemas.ShowUserParametersSchema,
 					},
 				},
+				"mfa_methods_output": {
+					Type:        schema.TypeList,
+					Computed:    true,
+					Description: "Holds the output of SHOW MFA METHODS FOR USER.",
+					Elem: &schema.Resource{
+						Schema: schemas.ShowUserMfaMethodSchema,
+					},
+				},
 			},
 		},
 	},
@@ -95,7 +109,7 @@ func Users() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: TrackingReadWrapper(datasources.Users, ReadUsers),
 		Schema:      usersSchema,
-		Description: "Data source used to get details of filtered users. Filtering is aligned with the current possibilities for [SHOW USERS](https://docs.snowflake.com/en/sql-reference/sql/show-users) query. The results of SHOW, DESCRIBE, and SHOW PARAMETERS IN are encapsulated in one output collection. Important note is that when querying users you don't have permissions to, the querying options are limited. You won't get almost any field in `show_output` (only empty or default values), the DESCRIBE command will return error when called, so you have to set `with_describe = false`; the SHOW PARAMETERS command will return error when called too, so you have to set `with_parameters = false`.",
+		Description: "Data source used to get details of filtered users. Filtering is aligned with the current possibilities for [SHOW USERS](https://docs.snowflake.com/en/sql-reference/sql/show-users) query. The results of SHOW, DESCRIBE, SHOW PARAMETERS IN, and SHOW MFA METHODS FOR USER are encapsulated in one output collection. Important note is that when querying users you don't have permissions to, the querying options are limited. You won't get almost any field in `show_output` (only empty or default values), the DESCRIBE command will return error when called, so you have to set `with_describe = false`; the SHOW PARAMETERS command will return error when called too, so you have to set `with_parameters = false`.",
 	}
 }
 
@@ -152,10 +166,23 @@ func ReadUsers(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagn
 			userParameters = []map[string]any{schemas.UserParametersToSchema(parameters)}
 		}
 
+		var userMfaMethods []map[string]any
+		if d.Get("with_mfa_methods").(bool) {
+			mfaMethods, err := client.Users.ShowMfaMethods(ctx, user.ID())
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			userMfaMethods = make([]map[string]any, len(mfaMethods))
+			for j, mfaMethod := range mfaMethods {
+				userMfaMethods[j] = schemas.UserMfaMethodToSchema(&mfaMethod)
+			}
+		}
+
 		flattenedUsers[i] = map[string]any{
 			resources.ShowOutputAttributeName:     []map[string]any{schemas.UserToSchema(&user)},
 			resources.DescribeOutputAttributeName: userDescription,
 			resources.ParametersAttributeName:     userParameters,
+			"mfa_methods_output":                  userMfaMethods,
 		}
 	}
 
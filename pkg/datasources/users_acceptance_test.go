@@ -572,6 +572,48 @@ func TestAcc_Users_DifferentFiltering(t *testing.T) {
 	})
 }
 
+func TestAcc_Users_WithMfaMethods(t *testing.T) {
+	_ = testenvs.GetOrSkipTest(t, testenvs.EnableAcceptance)
+	acc.TestAccPreCheck(t)
+
+	id := acc.TestClient().Ids.RandomAccountObjectIdentifier()
+
+	userModel := model.User("u", id.Name())
+	usersModelWithoutMfaMethods := datasourcemodel.Users("test").
+		WithLike(id.Name()).
+		WithDependsOn(userModel.ResourceReference())
+	usersModelWithMfaMethods := datasourcemodel.Users("test").
+		WithLike(id.Name()).
+		WithWithMfaMethods(true).
+		WithDependsOn(userModel.ResourceReference())
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: acc.CheckDestroy(t, resources.User),
+		Steps: []resource.TestStep{
+			{
+				Config: config.FromModels(t, userModel, usersModelWithoutMfaMethods),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(usersModelWithoutMfaMethods.DatasourceReference(), "users.#", "1"),
+					resource.TestCheckResourceAttr(usersModelWithoutMfaMethods.DatasourceReference(), "users.0.mfa_methods_output.#", "0"),
+				),
+			},
+			{
+				Config: config.FromModels(t, userModel, usersModelWithMfaMethods),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(usersModelWithMfaMethods.DatasourceReference(), "users.#", "1"),
+					// a freshly created user has no enrolled MFA methods
+					resource.TestCheckResourceAttr(usersModelWithMfaMethods.DatasourceReference(), "users.0.mfa_methods_output.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func TestAcc_Users_UserNotFound_WithPostConditions(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
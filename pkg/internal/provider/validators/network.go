@@ -0,0 +1,129 @@
+package validators
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+)
+
+var (
+	hostnamePattern         = regexp.MustCompile(`^(\*\.)?([a-zA-Z0-9]([a-zA-Z0-9-]*[a-zA-Z0-9])?\.)*[a-zA-Z0-9]([a-zA-Z0-9-]*[a-zA-Z0-9])?$`)
+	awsVpcEndpointIdPattern = regexp.MustCompile(`^vpce-[0-9a-f]+$`)
+	numericIdPattern        = regexp.MustCompile(`^[0-9]+$`)
+)
+
+// ValidateNetworkRuleValueList checks that every value from the value list of a network rule matches the format
+// expected by the given network rule type (e.g. CIDRs for IPV4, host:port pairs for HOST_PORT).
+func ValidateNetworkRuleValueList(ruleType sdk.NetworkRuleType, values []string) error {
+	errs := make([]error, 0)
+	for _, value := range values {
+		if err := ValidateNetworkRuleValue(ruleType, value); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// ValidateNetworkRuleValue checks that a single value of a network rule matches the format expected by the given network rule type.
+// Values of the IDENTIFIER type are only checked for being non-empty, because their format depends on the referenced object.
+func ValidateNetworkRuleValue(ruleType sdk.NetworkRuleType, value string) error {
+	if strings.TrimSpace(value) == "" {
+		return fmt.Errorf("network rule value for type %s cannot be empty", ruleType)
+	}
+
+	switch ruleType {
+	case sdk.NetworkRuleTypeIpv4:
+		if _, err := ParseIpv4Network(value); err != nil {
+			return err
+		}
+	case sdk.NetworkRuleTypeHostPort, sdk.NetworkRuleTypePrivateHostPort:
+		if err := validateHostPort(value); err != nil {
+			return fmt.Errorf("invalid %s network rule value %s: %w", ruleType, value, err)
+		}
+	case sdk.NetworkRuleTypeAwsVpcEndpointId:
+		if !awsVpcEndpointIdPattern.MatchString(value) {
+			return fmt.Errorf("invalid %s network rule value %s: expected an AWS VPC endpoint id in the vpce-<hex> format", ruleType, value)
+		}
+	case sdk.NetworkRuleTypeAzureLinkId:
+		if !numericIdPattern.MatchString(value) {
+			return fmt.Errorf("invalid %s network rule value %s: expected a numeric Azure private endpoint LinkID", ruleType, value)
+		}
+	case sdk.NetworkRuleTypeGcpPscId:
+		if !numericIdPattern.MatchString(value) {
+			return fmt.Errorf("invalid %s network rule value %s: expected a numeric GCP Private Service Connect connection id", ruleType, value)
+		}
+	case sdk.NetworkRuleTypeIdentifier:
+		return nil
+	default:
+		return fmt.Errorf("unsupported network rule type: %s", ruleType)
+	}
+	return nil
+}
+
+// ParseIpv4Network parses an IPv4 address or an IPv4 CIDR. A single address is returned as a /32 network.
+func ParseIpv4Network(value string) (*net.IPNet, error) {
+	if strings.Contains(value, "/") {
+		ip, network, err := net.ParseCIDR(value)
+		if err != nil || ip.To4() == nil {
+			return nil, fmt.Errorf("invalid IPv4 CIDR: %s", value)
+		}
+		return network, nil
+	}
+	ip := net.ParseIP(value)
+	if ip == nil || ip.To4() == nil {
+		return nil, fmt.Errorf("invalid IPv4 address: %s", value)
+	}
+	return &net.IPNet{IP: ip.To4(), Mask: net.CIDRMask(32, 32)}, nil
+}
+
+func validateHostPort(value string) error {
+	host := value
+	if idx := strings.LastIndex(value, ":"); idx != -1 {
+		host = value[:idx]
+		port, err := strconv.Atoi(value[idx+1:])
+		if err != nil || port < 1 || port > 65535 {
+			return fmt.Errorf("expected a port number between 1 and 65535, got: %s", value[idx+1:])
+		}
+	}
+	if !hostnamePattern.MatchString(host) {
+		return fmt.Errorf("expected a host name optionally followed by a port (host[:port]), got: %s", value)
+	}
+	return nil
+}
+
+// ValidateNonOverlappingIpLists checks that none of the blocked IPv4 addresses or CIDRs overlaps with the allowed ones.
+// All the values have to be valid IPv4 addresses or CIDRs.
+func ValidateNonOverlappingIpLists(allowed []string, blocked []string) error {
+	errs := make([]error, 0)
+	allowedNetworks := make(map[string]*net.IPNet, len(allowed))
+	for _, value := range allowed {
+		network, err := ParseIpv4Network(value)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		allowedNetworks[value] = network
+	}
+	for _, blockedValue := range blocked {
+		blockedNetwork, err := ParseIpv4Network(blockedValue)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		for _, allowedValue := range allowed {
+			allowedNetwork, ok := allowedNetworks[allowedValue]
+			if !ok {
+				continue
+			}
+			if allowedNetwork.Contains(blockedNetwork.IP) || blockedNetwork.Contains(allowedNetwork.IP) {
+				errs = append(errs, fmt.Errorf("blocked IP range %s overlaps with allowed IP range %s", blockedValue, allowedValue))
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,101 @@
+package validators
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateNetworkRuleValue(t *testing.T) {
+	testCases := []struct {
+		Name  string
+		Type  sdk.NetworkRuleType
+		Value string
+		Error string
+	}{
+		{Name: "IPV4 - address", Type: sdk.NetworkRuleTypeIpv4, Value: "29.254.123.20"},
+		{Name: "IPV4 - CIDR", Type: sdk.NetworkRuleTypeIpv4, Value: "192.168.0.100/24"},
+		{Name: "IPV4 - invalid CIDR", Type: sdk.NetworkRuleTypeIpv4, Value: "192.168.0.0/33", Error: "invalid IPv4 CIDR: 192.168.0.0/33"},
+		{Name: "IPV4 - IPv6 address", Type: sdk.NetworkRuleTypeIpv4, Value: "2001:db8::1", Error: "invalid IPv4 address: 2001:db8::1"},
+		{Name: "IPV4 - host", Type: sdk.NetworkRuleTypeIpv4, Value: "example.com", Error: "invalid IPv4 address: example.com"},
+		{Name: "HOST_PORT - host", Type: sdk.NetworkRuleTypeHostPort, Value: "example.com"},
+		{Name: "HOST_PORT - host and port", Type: sdk.NetworkRuleTypeHostPort, Value: "company.com:443"},
+		{Name: "HOST_PORT - wildcard", Type: sdk.NetworkRuleTypeHostPort, Value: "*.company.com"},
+		{Name: "HOST_PORT - invalid port", Type: sdk.NetworkRuleTypeHostPort, Value: "company.com:99999", Error: "expected a port number between 1 and 65535, got: 99999"},
+		{Name: "HOST_PORT - missing port", Type: sdk.NetworkRuleTypeHostPort, Value: "company.com:", Error: "expected a port number between 1 and 65535"},
+		{Name: "HOST_PORT - invalid host", Type: sdk.NetworkRuleTypeHostPort, Value: "https://company.com", Error: "expected a port number between 1 and 65535"},
+		{Name: "HOST_PORT - host with path", Type: sdk.NetworkRuleTypeHostPort, Value: "company.com/path", Error: "expected a host name optionally followed by a port (host[:port])"},
+		{Name: "PRIVATE_HOST_PORT - host and port", Type: sdk.NetworkRuleTypePrivateHostPort, Value: "example.azure.net:443"},
+		{Name: "PRIVATE_HOST_PORT - invalid host", Type: sdk.NetworkRuleTypePrivateHostPort, Value: "exa mple.net", Error: "invalid PRIVATE_HOST_PORT network rule value"},
+		{Name: "AWSVPCEID - valid", Type: sdk.NetworkRuleTypeAwsVpcEndpointId, Value: "vpce-123abc3420c1931"},
+		{Name: "AWSVPCEID - invalid", Type: sdk.NetworkRuleTypeAwsVpcEndpointId, Value: "123abc3420c1931", Error: "expected an AWS VPC endpoint id in the vpce-<hex> format"},
+		{Name: "AZURELINKID - valid", Type: sdk.NetworkRuleTypeAzureLinkId, Value: "587893939"},
+		{Name: "AZURELINKID - invalid", Type: sdk.NetworkRuleTypeAzureLinkId, Value: "link-123", Error: "expected a numeric Azure private endpoint LinkID"},
+		{Name: "GCPPSCID - valid", Type: sdk.NetworkRuleTypeGcpPscId, Value: "123456789012345678"},
+		{Name: "GCPPSCID - invalid", Type: sdk.NetworkRuleTypeGcpPscId, Value: "psc-1", Error: "expected a numeric GCP Private Service Connect connection id"},
+		{Name: "IDENTIFIER - any value", Type: sdk.NetworkRuleTypeIdentifier, Value: "some_identifier"},
+		{Name: "empty value", Type: sdk.NetworkRuleTypeIdentifier, Value: " ", Error: "network rule value for type IDENTIFIER cannot be empty"},
+		{Name: "unsupported type", Type: sdk.NetworkRuleType("IPV6"), Value: "::1", Error: "unsupported network rule type: IPV6"},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			err := ValidateNetworkRuleValue(tt.Type, tt.Value)
+			if tt.Error != "" {
+				assert.ErrorContains(t, err, tt.Error)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateNetworkRuleValueList(t *testing.T) {
+	t.Run("all values valid", func(t *testing.T) {
+		assert.NoError(t, ValidateNetworkRuleValueList(sdk.NetworkRuleTypeIpv4, []string{"192.168.0.100/24", "29.254.123.20"}))
+	})
+
+	t.Run("empty list", func(t *testing.T) {
+		assert.NoError(t, ValidateNetworkRuleValueList(sdk.NetworkRuleTypeHostPort, []string{}))
+	})
+
+	t.Run("all invalid values are reported", func(t *testing.T) {
+		err := ValidateNetworkRuleValueList(sdk.NetworkRuleTypeIpv4, []string{"example.com", "29.254.123.20", "1.1.1.1/40"})
+		assert.ErrorContains(t, err, "invalid IPv4 address: example.com")
+		assert.ErrorContains(t, err, "invalid IPv4 CIDR: 1.1.1.1/40")
+	})
+}
+
+func TestValidateNonOverlappingIpLists(t *testing.T) {
+	testCases := []struct {
+		Name    string
+		Allowed []string
+		Blocked []string
+		Errors  []string
+	}{
+		{Name: "empty lists", Allowed: []string{}, Blocked: []string{}},
+		{Name: "disjoint ranges", Allowed: []string{"192.168.1.0/24", "10.0.0.1"}, Blocked: []string{"192.168.2.0/24", "10.0.0.2"}},
+		{Name: "blocked address inside allowed range", Allowed: []string{"192.168.1.0/24"}, Blocked: []string{"192.168.1.99"}, Errors: []string{"blocked IP range 192.168.1.99 overlaps with allowed IP range 192.168.1.0/24"}},
+		{Name: "allowed address inside blocked range", Allowed: []string{"10.0.0.1"}, Blocked: []string{"10.0.0.0/8"}, Errors: []string{"blocked IP range 10.0.0.0/8 overlaps with allowed IP range 10.0.0.1"}},
+		{Name: "the same address", Allowed: []string{"10.0.0.1"}, Blocked: []string{"10.0.0.1/32"}, Errors: []string{"blocked IP range 10.0.0.1/32 overlaps with allowed IP range 10.0.0.1"}},
+		{Name: "allow all", Allowed: []string{"0.0.0.0/0"}, Blocked: []string{"1.2.3.4", "5.6.7.0/24"}, Errors: []string{
+			"blocked IP range 1.2.3.4 overlaps with allowed IP range 0.0.0.0/0",
+			"blocked IP range 5.6.7.0/24 overlaps with allowed IP range 0.0.0.0/0",
+		}},
+		{Name: "invalid values", Allowed: []string{"abc"}, Blocked: []string{"1.2.3.4/99"}, Errors: []string{"invalid IPv4 address: abc", "invalid IPv4 CIDR: 1.2.3.4/99"}},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			err := ValidateNonOverlappingIpLists(tt.Allowed, tt.Blocked)
+			if len(tt.Errors) > 0 {
+				for _, expectedError := range tt.Errors {
+					assert.ErrorContains(t, err, expectedError)
+				}
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -1,29 +1,31 @@
 package snowflakeenvs
 
 const (
-	AccountName               = "SNOWFLAKE_ACCOUNT_NAME"
-	OrganizationName          = "SNOWFLAKE_ORGANIZATION_NAME"
-	User                      = "SNOWFLAKE_USER"
-	Password                  = "SNOWFLAKE_PASSWORD"
-	Warehouse                 = "SNOWFLAKE_WAREHOUSE"
-	Role                      = "SNOWFLAKE_ROLE"
-	ValidateDefaultParameters = "SNOWFLAKE_VALIDATE_DEFAULT_PARAMETERS"
-	ClientIp                  = "SNOWFLAKE_CLIENT_IP"
-	Protocol                  = "SNOWFLAKE_PROTOCOL"
-	Host                      = "SNOWFLAKE_HOST"
-	Port                      = "SNOWFLAKE_PORT"
-	Authenticator             = "SNOWFLAKE_AUTHENTICATOR"
-	Passcode                  = "SNOWFLAKE_PASSCODE"
-	PasscodeInPassword        = "SNOWFLAKE_PASSCODE_IN_PASSWORD"
-	OktaUrl                   = "SNOWFLAKE_OKTA_URL"
-	LoginTimeout              = "SNOWFLAKE_LOGIN_TIMEOUT"
-	RequestTimeout            = "SNOWFLAKE_REQUEST_TIMEOUT"
-	JwtExpireTimeout          = "SNOWFLAKE_JWT_EXPIRE_TIMEOUT"
-	ClientTimeout             = "SNOWFLAKE_CLIENT_TIMEOUT"
-	JwtClientTimeout          = "SNOWFLAKE_JWT_CLIENT_TIMEOUT"
-	ExternalBrowserTimeout    = "SNOWFLAKE_EXTERNAL_BROWSER_TIMEOUT"
-	InsecureMode              = "SNOWFLAKE_INSECURE_MODE"
-	OcspFailOpen              = "SNOWFLAKE_OCSP_FAIL_OPEN"
+	AccountName                   = "SNOWFLAKE_ACCOUNT_NAME"
+	OrganizationName              = "SNOWFLAKE_ORGANIZATION_NAME"
+	User                          = "SNOWFLAKE_USER"
+	Password                      = "SNOWFLAKE_PASSWORD"
+	Warehouse                     = "SNOWFLAKE_WAREHOUSE"
+	Role                          = "SNOWFLAKE_ROLE"
+	ValidateDefaultParameters     = "SNOWFLAKE_VALIDATE_DEFAULT_PARAMETERS"
+	ClientIp                      = "SNOWFLAKE_CLIENT_IP"
+	Protocol                      = "SNOWFLAKE_PROTOCOL"
+	Host                          = "SNOWFLAKE_HOST"
+	Port                          = "SNOWFLAKE_PORT"
+	Authenticator                 = "SNOWFLAKE_AUTHENTICATOR"
+	Passcode                      = "SNOWFLAKE_PASSCODE"
+	PasscodeInPassword            = "SNOWFLAKE_PASSCODE_IN_PASSWORD"
+	OktaUrl                       = "SNOWFLAKE_OKTA_URL"
+	WorkloadIdentityProvider      = "SNOWFLAKE_WORKLOAD_IDENTITY_PROVIDER"
+	WorkloadIdentityEntraResource = "SNOWFLAKE_WORKLOAD_IDENTITY_ENTRA_RESOURCE"
+	LoginTimeout                  = "SNOWFLAKE_LOGIN_TIMEOUT"
+	RequestTimeout                = "SNOWFLAKE_REQUEST_TIMEOUT"
+	JwtExpireTimeout              = "SNOWFLAKE_JWT_EXPIRE_TIMEOUT"
+	ClientTimeout                 = "SNOWFLAKE_CLIENT_TIMEOUT"
+	JwtClientTimeout              = "SNOWFLAKE_JWT_CLIENT_TIMEOUT"
+	ExternalBrowserTimeout        = "SNOWFLAKE_EXTERNAL_BROWSER_TIMEOUT"
+	InsecureMode                  = "SNOWFLAKE_INSECURE_MODE"
+	OcspFailOpen                  = "SNOWFLAKE_OCSP_FAIL_OPEN"
 
 	Token                      = "SNOWFLAKE_TOKEN"
 	TokenAccessorTokenEndpoint = "SNOWFLAKE_TOKEN_ACCESSOR_TOKEN_ENDPOINT"
@@ -4,6 +4,7 @@ import "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 
 var (
 	Orgadmin       = sdk.NewAccountObjectIdentifier("ORGADMIN")
+	GlobalOrgadmin = sdk.NewAccountObjectIdentifier("GLOBALORGADMIN")
 	Accountadmin   = sdk.NewAccountObjectIdentifier("ACCOUNTADMIN")
 	SecurityAdmin  = sdk.NewAccountObjectIdentifier("SECURITYADMIN")
 	PentestingRole = sdk.NewAccountObjectIdentifier("PENTESTING_ROLE")
@@ -15,12 +15,14 @@ const (
 	DatabaseRoles                  datasource = "snowflake_database_roles"
 	Databases                      datasource = "snowflake_databases"
 	DynamicTables                  datasource = "snowflake_dynamic_tables"
+	ExternalAccessIntegrations     datasource = "snowflake_external_access_integrations"
 	ExternalFunctions              datasource = "snowflake_external_functions"
 	ExternalTables                 datasource = "snowflake_external_tables"
 	FailoverGroups                 datasource = "snowflake_failover_groups"
 	FileFormats                    datasource = "snowflake_file_formats"
 	Functions                      datasource = "snowflake_functions"
 	Grants                         datasource = "snowflake_grants"
+	HybridTables                   datasource = "snowflake_hybrid_tables"
 	MaskingPolicies                datasource = "snowflake_masking_policies"
 	MaterializedViews              datasource = "snowflake_materialized_views"
 	NetworkPolicies                datasource = "snowflake_network_policies"
@@ -34,6 +36,7 @@ const (
 	SecurityIntegrations           datasource = "snowflake_security_integrations"
 	Sequences                      datasource = "snowflake_sequences"
 	Shares                         datasource = "snowflake_shares"
+	StageFiles                     datasource = "snowflake_stage_files"
 	Stages                         datasource = "snowflake_stages"
 	StorageIntegrations            datasource = "snowflake_storage_integrations"
 	Streams                        datasource = "snowflake_streams"
@@ -46,6 +49,7 @@ const (
 	Tags                           datasource = "snowflake_tags"
 	Tasks                          datasource = "snowflake_tasks"
 	Users                          datasource = "snowflake_users"
+	UserProgrammaticAccessTokens   datasource = "snowflake_user_programmatic_access_tokens"
 	Views                          datasource = "snowflake_views"
 	Warehouses                     datasource = "snowflake_warehouses"
 )
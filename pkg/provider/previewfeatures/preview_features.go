@@ -9,80 +9,108 @@ import (
 type feature string
 
 const (
-	CurrentAccountDatasource                      feature = "snowflake_current_account_datasource"
-	AccountAuthenticationPolicyAttachmentResource feature = "snowflake_account_authentication_policy_attachment_resource"
-	AccountPasswordPolicyAttachmentResource       feature = "snowflake_account_password_policy_attachment_resource"
-	AlertResource                                 feature = "snowflake_alert_resource"
-	AlertsDatasource                              feature = "snowflake_alerts_datasource"
-	ApiIntegrationResource                        feature = "snowflake_api_integration_resource"
-	AuthenticationPolicyResource                  feature = "snowflake_authentication_policy_resource"
-	CortexSearchServiceResource                   feature = "snowflake_cortex_search_service_resource"
-	CortexSearchServicesDatasource                feature = "snowflake_cortex_search_services_datasource"
-	DatabaseDatasource                            feature = "snowflake_database_datasource"
-	DatabaseRoleDatasource                        feature = "snowflake_database_role_datasource"
-	DynamicTableResource                          feature = "snowflake_dynamic_table_resource"
-	DynamicTablesDatasource                       feature = "snowflake_dynamic_tables_datasource"
-	ExternalFunctionResource                      feature = "snowflake_external_function_resource"
-	ExternalFunctionsDatasource                   feature = "snowflake_external_functions_datasource"
-	ExternalTableResource                         feature = "snowflake_external_table_resource"
-	ExternalTablesDatasource                      feature = "snowflake_external_tables_datasource"
-	ExternalVolumeResource                        feature = "snowflake_external_volume_resource"
-	FailoverGroupResource                         feature = "snowflake_failover_group_resource"
-	FailoverGroupsDatasource                      feature = "snowflake_failover_groups_datasource"
-	FileFormatResource                            feature = "snowflake_file_format_resource"
-	FileFormatsDatasource                         feature = "snowflake_file_formats_datasource"
-	FunctionJavaResource                          feature = "snowflake_function_java_resource"
-	FunctionJavascriptResource                    feature = "snowflake_function_javascript_resource"
-	FunctionPythonResource                        feature = "snowflake_function_python_resource"
-	FunctionScalaResource                         feature = "snowflake_function_scala_resource"
-	FunctionSqlResource                           feature = "snowflake_function_sql_resource"
-	FunctionsDatasource                           feature = "snowflake_functions_datasource"
-	ManagedAccountResource                        feature = "snowflake_managed_account_resource"
-	MaterializedViewResource                      feature = "snowflake_materialized_view_resource"
-	MaterializedViewsDatasource                   feature = "snowflake_materialized_views_datasource"
-	NetworkPolicyAttachmentResource               feature = "snowflake_network_policy_attachment_resource"
-	NetworkRuleResource                           feature = "snowflake_network_rule_resource"
-	EmailNotificationIntegrationResource          feature = "snowflake_email_notification_integration_resource"
-	NotificationIntegrationResource               feature = "snowflake_notification_integration_resource"
-	ObjectParameterResource                       feature = "snowflake_object_parameter_resource"
-	PasswordPolicyResource                        feature = "snowflake_password_policy_resource"
-	PipeResource                                  feature = "snowflake_pipe_resource"
-	PipesDatasource                               feature = "snowflake_pipes_datasource"
-	ProcedureJavaResource                         feature = "snowflake_procedure_java_resource"
-	ProcedureJavascriptResource                   feature = "snowflake_procedure_javascript_resource"
-	ProcedurePythonResource                       feature = "snowflake_procedure_python_resource"
-	ProcedureScalaResource                        feature = "snowflake_procedure_scala_resource"
-	ProcedureSqlResource                          feature = "snowflake_procedure_sql_resource"
-	ProceduresDatasource                          feature = "snowflake_procedures_datasource"
-	CurrentRoleDatasource                         feature = "snowflake_current_role_datasource"
-	SequenceResource                              feature = "snowflake_sequence_resource"
-	SequencesDatasource                           feature = "snowflake_sequences_datasource"
-	ShareResource                                 feature = "snowflake_share_resource"
-	SharesDatasource                              feature = "snowflake_shares_datasource"
-	ParametersDatasource                          feature = "snowflake_parameters_datasource"
-	StageResource                                 feature = "snowflake_stage_resource"
-	StagesDatasource                              feature = "snowflake_stages_datasource"
-	StorageIntegrationResource                    feature = "snowflake_storage_integration_resource"
-	StorageIntegrationsDatasource                 feature = "snowflake_storage_integrations_datasource"
-	SystemGenerateSCIMAccessTokenDatasource       feature = "snowflake_system_generate_scim_access_token_datasource"
-	SystemGetAWSSNSIAMPolicyDatasource            feature = "snowflake_system_get_aws_sns_iam_policy_datasource"
-	SystemGetPrivateLinkConfigDatasource          feature = "snowflake_system_get_privatelink_config_datasource"
-	SystemGetSnowflakePlatformInfoDatasource      feature = "snowflake_system_get_snowflake_platform_info_datasource"
-	TableResource                                 feature = "snowflake_table_resource"
-	TablesDatasource                              feature = "snowflake_tables_datasource"
-	TableColumnMaskingPolicyApplicationResource   feature = "snowflake_table_column_masking_policy_application_resource"
-	TableConstraintResource                       feature = "snowflake_table_constraint_resource"
-	UserAuthenticationPolicyAttachmentResource    feature = "snowflake_user_authentication_policy_attachment_resource"
-	UserPublicKeysResource                        feature = "snowflake_user_public_keys_resource"
-	UserPasswordPolicyAttachmentResource          feature = "snowflake_user_password_policy_attachment_resource"
+	CurrentAccountDatasource                       feature = "snowflake_current_account_datasource"
+	AccountAuthenticationPolicyAttachmentResource  feature = "snowflake_account_authentication_policy_attachment_resource"
+	AccountPasswordPolicyAttachmentResource        feature = "snowflake_account_password_policy_attachment_resource"
+	AccountPoliciesResource                        feature = "snowflake_account_policies_resource"
+	AccountRoleMembersResource                     feature = "snowflake_account_role_members_resource"
+	AlertResource                                  feature = "snowflake_alert_resource"
+	AlertsDatasource                               feature = "snowflake_alerts_datasource"
+	ApiAuthenticationIntegrationWithAwsIamResource feature = "snowflake_api_authentication_integration_with_aws_iam_resource"
+	ApiIntegrationResource                         feature = "snowflake_api_integration_resource"
+	AuthenticationPolicyResource                   feature = "snowflake_authentication_policy_resource"
+	CortexSearchServiceResource                    feature = "snowflake_cortex_search_service_resource"
+	CortexSearchServicesDatasource                 feature = "snowflake_cortex_search_services_datasource"
+	DatabaseDatasource                             feature = "snowflake_database_datasource"
+	DatabaseRoleDatasource                         feature = "snowflake_database_role_datasource"
+	DynamicTableResource                           feature = "snowflake_dynamic_table_resource"
+	DynamicTablesDatasource                        feature = "snowflake_dynamic_tables_datasource"
+	ExternalAccessIntegrationResource              feature = "snowflake_external_access_integration_resource"
+	ExternalAccessIntegrationsDatasource           feature = "snowflake_external_access_integrations_datasource"
+	ExternalFunctionResource                       feature = "snowflake_external_function_resource"
+	ExternalFunctionsDatasource                    feature = "snowflake_external_functions_datasource"
+	ExternalTableResource                          feature = "snowflake_external_table_resource"
+	ExternalTablesDatasource                       feature = "snowflake_external_tables_datasource"
+	ExternalVolumeResource                         feature = "snowflake_external_volume_resource"
+	FailoverGroupResource                          feature = "snowflake_failover_group_resource"
+	FailoverGroupsDatasource                       feature = "snowflake_failover_groups_datasource"
+	FileFormatResource                             feature = "snowflake_file_format_resource"
+	FileFormatsDatasource                          feature = "snowflake_file_formats_datasource"
+	FunctionJavaResource                           feature = "snowflake_function_java_resource"
+	FunctionJavascriptResource                     feature = "snowflake_function_javascript_resource"
+	FunctionPythonResource                         feature = "snowflake_function_python_resource"
+	FunctionScalaResource                          feature = "snowflake_function_scala_resource"
+	FunctionSqlResource                            feature = "snowflake_function_sql_resource"
+	FunctionsDatasource                            feature = "snowflake_functions_datasource"
+	HybridTableResource                            feature = "snowflake_hybrid_table_resource"
+	HybridTablesDatasource                         feature = "snowflake_hybrid_tables_datasource"
+	ManagedAccountResource                         feature = "snowflake_managed_account_resource"
+	MaterializedViewResource                       feature = "snowflake_materialized_view_resource"
+	MaterializedViewsDatasource                    feature = "snowflake_materialized_views_datasource"
+	NetworkPolicyAttachmentResource                feature = "snowflake_network_policy_attachment_resource"
+	NetworkRuleResource                            feature = "snowflake_network_rule_resource"
+	EmailNotificationIntegrationResource           feature = "snowflake_email_notification_integration_resource"
+	NotificationIntegrationResource                feature = "snowflake_notification_integration_resource"
+	NotificationIntegrationAwsSnsResource          feature = "snowflake_notification_integration_aws_sns_resource"
+	NotificationIntegrationAzureEventGridResource  feature = "snowflake_notification_integration_azure_event_grid_resource"
+	NotificationIntegrationGcpPubsubResource       feature = "snowflake_notification_integration_gcp_pubsub_resource"
+	ObjectParameterResource                        feature = "snowflake_object_parameter_resource"
+	OrganizationAccountResource                    feature = "snowflake_organization_account_resource"
+	PasswordPolicyResource                         feature = "snowflake_password_policy_resource"
+	PipeResource                                   feature = "snowflake_pipe_resource"
+	PipesDatasource                                feature = "snowflake_pipes_datasource"
+	ProcedureJavaResource                          feature = "snowflake_procedure_java_resource"
+	ProcedureJavascriptResource                    feature = "snowflake_procedure_javascript_resource"
+	ProcedurePythonResource                        feature = "snowflake_procedure_python_resource"
+	ProcedureScalaResource                         feature = "snowflake_procedure_scala_resource"
+	ProcedureSqlResource                           feature = "snowflake_procedure_sql_resource"
+	ProceduresDatasource                           feature = "snowflake_procedures_datasource"
+	CurrentRoleDatasource                          feature = "snowflake_current_role_datasource"
+	SequenceResource                               feature = "snowflake_sequence_resource"
+	SequencesDatasource                            feature = "snowflake_sequences_datasource"
+	ShareResource                                  feature = "snowflake_share_resource"
+	SharesDatasource                               feature = "snowflake_shares_datasource"
+	ParametersDatasource                           feature = "snowflake_parameters_datasource"
+	StageResource                                  feature = "snowflake_stage_resource"
+	StageExternalAzureResource                     feature = "snowflake_stage_external_azure_resource"
+	StageExternalGcsResource                       feature = "snowflake_stage_external_gcs_resource"
+	StageExternalS3Resource                        feature = "snowflake_stage_external_s3_resource"
+	StageFileResource                              feature = "snowflake_stage_file_resource"
+	StageInternalResource                          feature = "snowflake_stage_internal_resource"
+	StageFilesDatasource                           feature = "snowflake_stage_files_datasource"
+	StagesDatasource                               feature = "snowflake_stages_datasource"
+	StorageIntegrationResource                     feature = "snowflake_storage_integration_resource"
+	StorageIntegrationsDatasource                  feature = "snowflake_storage_integrations_datasource"
+	StreamOnDynamicTableResource                   feature = "snowflake_stream_on_dynamic_table_resource"
+	StreamOnEventTableResource                     feature = "snowflake_stream_on_event_table_resource"
+	StreamOnIcebergTableResource                   feature = "snowflake_stream_on_iceberg_table_resource"
+	SystemGenerateSCIMAccessTokenDatasource        feature = "snowflake_system_generate_scim_access_token_datasource"
+	SystemGetAWSSNSIAMPolicyDatasource             feature = "snowflake_system_get_aws_sns_iam_policy_datasource"
+	SystemGetPrivateLinkConfigDatasource           feature = "snowflake_system_get_privatelink_config_datasource"
+	SystemGetSnowflakePlatformInfoDatasource       feature = "snowflake_system_get_snowflake_platform_info_datasource"
+	TableResource                                  feature = "snowflake_table_resource"
+	TablesDatasource                               feature = "snowflake_tables_datasource"
+	TableColumnMaskingPolicyApplicationResource    feature = "snowflake_table_column_masking_policy_application_resource"
+	TableConstraintResource                        feature = "snowflake_table_constraint_resource"
+	TaskExecutionResource                          feature = "snowflake_task_execution_resource"
+	TaskGraphResource                              feature = "snowflake_task_graph_resource"
+	UserAuthenticationPolicyAttachmentResource     feature = "snowflake_user_authentication_policy_attachment_resource"
+	UserPublicKeysResource                         feature = "snowflake_user_public_keys_resource"
+	UserPasswordPolicyAttachmentResource           feature = "snowflake_user_password_policy_attachment_resource"
+	UserProgrammaticAccessTokenResource            feature = "snowflake_user_programmatic_access_token_resource"
+	UserProgrammaticAccessTokensDatasource         feature = "snowflake_user_programmatic_access_tokens_datasource"
+	WebhookNotificationIntegrationResource         feature = "snowflake_webhook_notification_integration_resource"
 )
 
 var allPreviewFeatures = []feature{
 	CurrentAccountDatasource,
 	AccountAuthenticationPolicyAttachmentResource,
 	AccountPasswordPolicyAttachmentResource,
+	AccountPoliciesResource,
+	AccountRoleMembersResource,
 	AlertResource,
 	AlertsDatasource,
+	ApiAuthenticationIntegrationWithAwsIamResource,
 	ApiIntegrationResource,
 	AuthenticationPolicyResource,
 	CortexSearchServiceResource,
@@ -91,6 +119,8 @@ var allPreviewFeatures = []feature{
 	DatabaseRoleDatasource,
 	DynamicTableResource,
 	DynamicTablesDatasource,
+	ExternalAccessIntegrationResource,
+	ExternalAccessIntegrationsDatasource,
 	ExternalFunctionResource,
 	ExternalFunctionsDatasource,
 	ExternalTableResource,
@@ -106,6 +136,8 @@ var allPreviewFeatures = []feature{
 	FunctionScalaResource,
 	FunctionSqlResource,
 	FunctionsDatasource,
+	HybridTableResource,
+	HybridTablesDatasource,
 	ManagedAccountResource,
 	MaterializedViewResource,
 	MaterializedViewsDatasource,
@@ -113,7 +145,11 @@ var allPreviewFeatures = []feature{
 	NetworkRuleResource,
 	EmailNotificationIntegrationResource,
 	NotificationIntegrationResource,
+	NotificationIntegrationAwsSnsResource,
+	NotificationIntegrationAzureEventGridResource,
+	NotificationIntegrationGcpPubsubResource,
 	ObjectParameterResource,
+	OrganizationAccountResource,
 	PasswordPolicyResource,
 	PipeResource,
 	PipesDatasource,
@@ -130,9 +166,18 @@ var allPreviewFeatures = []feature{
 	ProcedureSqlResource,
 	ProceduresDatasource,
 	StageResource,
+	StageExternalAzureResource,
+	StageExternalGcsResource,
+	StageExternalS3Resource,
+	StageFileResource,
+	StageInternalResource,
+	StageFilesDatasource,
 	StagesDatasource,
 	StorageIntegrationResource,
 	StorageIntegrationsDatasource,
+	StreamOnDynamicTableResource,
+	StreamOnEventTableResource,
+	StreamOnIcebergTableResource,
 	SystemGenerateSCIMAccessTokenDatasource,
 	SystemGetAWSSNSIAMPolicyDatasource,
 	SystemGetPrivateLinkConfigDatasource,
@@ -141,9 +186,14 @@ var allPreviewFeatures = []feature{
 	TableConstraintResource,
 	TableResource,
 	TablesDatasource,
+	TaskExecutionResource,
+	TaskGraphResource,
 	UserAuthenticationPolicyAttachmentResource,
 	UserPublicKeysResource,
 	UserPasswordPolicyAttachmentResource,
+	UserProgrammaticAccessTokenResource,
+	UserProgrammaticAccessTokensDatasource,
+	WebhookNotificationIntegrationResource,
 }
 var AllPreviewFeatures = make([]string, len(allPreviewFeatures))
 
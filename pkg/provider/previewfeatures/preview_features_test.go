@@ -19,8 +19,11 @@ func Test_StringToFeature(t *testing.T) {
 		// Supported Values.
 		{input: "snowflake_current_account_datasource", want: CurrentAccountDatasource},
 		{input: "snowflake_account_password_policy_attachment_resource", want: AccountPasswordPolicyAttachmentResource},
+		{input: "snowflake_account_policies_resource", want: AccountPoliciesResource},
+		{input: "snowflake_account_role_members_resource", want: AccountRoleMembersResource},
 		{input: "snowflake_alert_resource", want: AlertResource},
 		{input: "snowflake_alerts_datasource", want: AlertsDatasource},
+		{input: "snowflake_api_authentication_integration_with_aws_iam_resource", want: ApiAuthenticationIntegrationWithAwsIamResource},
 		{input: "snowflake_api_integration_resource", want: ApiIntegrationResource},
 		{input: "snowflake_cortex_search_service_resource", want: CortexSearchServiceResource},
 		{input: "snowflake_cortex_search_services_datasource", want: CortexSearchServicesDatasource},
@@ -28,6 +31,8 @@ func Test_StringToFeature(t *testing.T) {
 		{input: "snowflake_database_role_datasource", want: DatabaseRoleDatasource},
 		{input: "snowflake_dynamic_table_resource", want: DynamicTableResource},
 		{input: "snowflake_dynamic_tables_datasource", want: DynamicTablesDatasource},
+		{input: "snowflake_external_access_integration_resource", want: ExternalAccessIntegrationResource},
+		{input: "snowflake_external_access_integrations_datasource", want: ExternalAccessIntegrationsDatasource},
 		{input: "snowflake_external_function_resource", want: ExternalFunctionResource},
 		{input: "snowflake_external_functions_datasource", want: ExternalFunctionsDatasource},
 		{input: "snowflake_external_table_resource", want: ExternalTableResource},
@@ -37,6 +42,8 @@ func Test_StringToFeature(t *testing.T) {
 		{input: "snowflake_failover_groups_datasource", want: FailoverGroupsDatasource},
 		{input: "snowflake_file_format_resource", want: FileFormatResource},
 		{input: "snowflake_file_formats_datasource", want: FileFormatsDatasource},
+		{input: "snowflake_hybrid_table_resource", want: HybridTableResource},
+		{input: "snowflake_hybrid_tables_datasource", want: HybridTablesDatasource},
 		{input: "snowflake_managed_account_resource", want: ManagedAccountResource},
 		{input: "snowflake_materialized_view_resource", want: MaterializedViewResource},
 		{input: "snowflake_materialized_views_datasource", want: MaterializedViewsDatasource},
@@ -44,7 +51,11 @@ func Test_StringToFeature(t *testing.T) {
 		{input: "snowflake_network_rule_resource", want: NetworkRuleResource},
 		{input: "snowflake_email_notification_integration_resource", want: EmailNotificationIntegrationResource},
 		{input: "snowflake_notification_integration_resource", want: NotificationIntegrationResource},
+		{input: "snowflake_notification_integration_aws_sns_resource", want: NotificationIntegrationAwsSnsResource},
+		{input: "snowflake_notification_integration_azure_event_grid_resource", want: NotificationIntegrationAzureEventGridResource},
+		{input: "snowflake_notification_integration_gcp_pubsub_resource", want: NotificationIntegrationGcpPubsubResource},
 		{input: "snowflake_object_parameter_resource", want: ObjectParameterResource},
+		{input: "snowflake_organization_account_resource", want: OrganizationAccountResource},
 		{input: "snowflake_password_policy_resource", want: PasswordPolicyResource},
 		{input: "snowflake_pipe_resource", want: PipeResource},
 		{input: "snowflake_pipes_datasource", want: PipesDatasource},
@@ -55,18 +66,32 @@ func Test_StringToFeature(t *testing.T) {
 		{input: "snowflake_shares_datasource", want: SharesDatasource},
 		{input: "snowflake_parameters_datasource", want: ParametersDatasource},
 		{input: "snowflake_stage_resource", want: StageResource},
+		{input: "snowflake_stage_external_azure_resource", want: StageExternalAzureResource},
+		{input: "snowflake_stage_external_gcs_resource", want: StageExternalGcsResource},
+		{input: "snowflake_stage_external_s3_resource", want: StageExternalS3Resource},
+		{input: "snowflake_stage_file_resource", want: StageFileResource},
+		{input: "snowflake_stage_internal_resource", want: StageInternalResource},
+		{input: "snowflake_stage_files_datasource", want: StageFilesDatasource},
 		{input: "snowflake_stages_datasource", want: StagesDatasource},
 		{input: "snowflake_storage_integration_resource", want: StorageIntegrationResource},
 		{input: "snowflake_storage_integrations_datasource", want: StorageIntegrationsDatasource},
+		{input: "snowflake_stream_on_dynamic_table_resource", want: StreamOnDynamicTableResource},
+		{input: "snowflake_stream_on_event_table_resource", want: StreamOnEventTableResource},
+		{input: "snowflake_stream_on_iceberg_table_resource", want: StreamOnIcebergTableResource},
 		{input: "snowflake_system_generate_scim_access_token_datasource", want: SystemGenerateSCIMAccessTokenDatasource},
 		{input: "snowflake_system_get_aws_sns_iam_policy_datasource", want: SystemGetAWSSNSIAMPolicyDatasource},
 		{input: "snowflake_system_get_privatelink_config_datasource", want: SystemGetPrivateLinkConfigDatasource},
 		{input: "snowflake_system_get_snowflake_platform_info_datasource", want: SystemGetSnowflakePlatformInfoDatasource},
 		{input: "snowflake_table_column_masking_policy_application_resource", want: TableColumnMaskingPolicyApplicationResource},
 		{input: "snowflake_table_constraint_resource", want: TableConstraintResource},
+		{input: "snowflake_task_execution_resource", want: TaskExecutionResource},
+		{input: "snowflake_task_graph_resource", want: TaskGraphResource},
 		{input: "snowflake_user_authentication_policy_attachment_resource", want: UserAuthenticationPolicyAttachmentResource},
 		{input: "snowflake_user_public_keys_resource", want: UserPublicKeysResource},
 		{input: "snowflake_user_password_policy_attachment_resource", want: UserPasswordPolicyAttachmentResource},
+		{input: "snowflake_user_programmatic_access_token_resource", want: UserProgrammaticAccessTokenResource},
+		{input: "snowflake_user_programmatic_access_tokens_datasource", want: UserProgrammaticAccessTokensDatasource},
+		{input: "snowflake_webhook_notification_integration_resource", want: WebhookNotificationIntegrationResource},
 	}
 
 	invalid := []test{
@@ -174,6 +174,19 @@ func Provider() *schema.Provider {
 				DefaultFunc:      schema.EnvDefaultFunc(snowflakeenvs.OktaUrl, nil),
 				ValidateDiagFunc: validation.ToDiagFunc(validation.IsURLWithHTTPorHTTPS),
 			},
+			"workload_identity_provider": {
+				Type:             schema.TypeString,
+				Description:      envNameFieldDescription(fmt.Sprintf("The workload identity provider used with the `WORKLOAD_IDENTITY` authenticator. Valid options are: %v. Read more in Snowflake [docs](https://docs.snowflake.com/en/user-guide/workload-identity-federation).", docs.PossibleValuesListed(sdk.AllWorkloadIdentityProviders)), snowflakeenvs.WorkloadIdentityProvider),
+				Optional:         true,
+				DefaultFunc:      schema.EnvDefaultFunc(snowflakeenvs.WorkloadIdentityProvider, nil),
+				ValidateDiagFunc: validators.NormalizeValidation(sdk.ToWorkloadIdentityProvider),
+			},
+			"workload_identity_entra_resource": {
+				Type:        schema.TypeString,
+				Description: envNameFieldDescription("The resource used to obtain the token from Microsoft Entra ID when the `WORKLOAD_IDENTITY` authenticator is used with the `AZURE` workload identity provider.", snowflakeenvs.WorkloadIdentityEntraResource),
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc(snowflakeenvs.WorkloadIdentityEntraResource, nil),
+			},
 			"login_timeout": {
 				Type:             schema.TypeInt,
 				Description:      envNameFieldDescription("Login retry timeout in seconds EXCLUDING network roundtrip and read out http response.", snowflakeenvs.LoginTimeout),
@@ -400,10 +413,13 @@ func getResources() map[string]*schema.Resource {
 		"snowflake_account": resources.Account(),
 		"snowflake_account_authentication_policy_attachment":                     resources.AccountAuthenticationPolicyAttachment(),
 		"snowflake_account_role":                                                 resources.AccountRole(),
+		"snowflake_account_role_members":                                         resources.AccountRoleMembers(),
 		"snowflake_account_password_policy_attachment":                           resources.AccountPasswordPolicyAttachment(),
+		"snowflake_account_policies":                                             resources.AccountPolicies(),
 		"snowflake_account_parameter":                                            resources.AccountParameter(),
 		"snowflake_alert":                                                        resources.Alert(),
 		"snowflake_api_authentication_integration_with_authorization_code_grant": resources.ApiAuthenticationIntegrationWithAuthorizationCodeGrant(),
+		"snowflake_api_authentication_integration_with_aws_iam":                  resources.ApiAuthenticationIntegrationWithAwsIam(),
 		"snowflake_api_authentication_integration_with_client_credentials":       resources.ApiAuthenticationIntegrationWithClientCredentials(),
 		"snowflake_api_authentication_integration_with_jwt_bearer":               resources.ApiAuthenticationIntegrationWithJwtBearer(),
 		"snowflake_api_integration":                                              resources.APIIntegration(),
@@ -414,6 +430,7 @@ func getResources() map[string]*schema.Resource {
 		"snowflake_dynamic_table":                                                resources.DynamicTable(),
 		"snowflake_email_notification_integration":                               resources.EmailNotificationIntegration(),
 		"snowflake_execute":                                                      resources.Execute(),
+		"snowflake_external_access_integration":                                  resources.ExternalAccessIntegration(),
 		"snowflake_external_function":                                            resources.ExternalFunction(),
 		"snowflake_external_oauth_integration":                                   resources.ExternalOauthIntegration(),
 		"snowflake_external_table":                                               resources.ExternalTable(),
@@ -432,6 +449,7 @@ func getResources() map[string]*schema.Resource {
 		"snowflake_grant_privileges_to_account_role":                             resources.GrantPrivilegesToAccountRole(),
 		"snowflake_grant_privileges_to_database_role":                            resources.GrantPrivilegesToDatabaseRole(),
 		"snowflake_grant_privileges_to_share":                                    resources.GrantPrivilegesToShare(),
+		"snowflake_hybrid_table":                                                 resources.HybridTable(),
 		"snowflake_legacy_service_user":                                          resources.LegacyServiceUser(),
 		"snowflake_managed_account":                                              resources.ManagedAccount(),
 		"snowflake_masking_policy":                                               resources.MaskingPolicy(),
@@ -440,9 +458,13 @@ func getResources() map[string]*schema.Resource {
 		"snowflake_network_policy_attachment":                                    resources.NetworkPolicyAttachment(),
 		"snowflake_network_rule":                                                 resources.NetworkRule(),
 		"snowflake_notification_integration":                                     resources.NotificationIntegration(),
+		"snowflake_notification_integration_aws_sns":                             resources.NotificationIntegrationAwsSns(),
+		"snowflake_notification_integration_azure_event_grid":                    resources.NotificationIntegrationAzureEventGrid(),
+		"snowflake_notification_integration_gcp_pubsub":                          resources.NotificationIntegrationGcpPubsub(),
 		"snowflake_oauth_integration_for_partner_applications":                   resources.OauthIntegrationForPartnerApplications(),
 		"snowflake_oauth_integration_for_custom_clients":                         resources.OauthIntegrationForCustomClients(),
 		"snowflake_object_parameter":                                             resources.ObjectParameter(),
+		"snowflake_organization_account":                                         resources.OrganizationAccount(),
 		"snowflake_password_policy":                                              resources.PasswordPolicy(),
 		"snowflake_pipe":                                                         resources.Pipe(),
 		"snowflake_primary_connection":                                           resources.PrimaryConnection(),
@@ -467,9 +489,17 @@ func getResources() map[string]*schema.Resource {
 		"snowflake_share":                                                        resources.Share(),
 		"snowflake_shared_database":                                              resources.SharedDatabase(),
 		"snowflake_stage":                                                        resources.Stage(),
+		"snowflake_stage_external_azure":                                         resources.StageExternalAzure(),
+		"snowflake_stage_external_gcs":                                           resources.StageExternalGcs(),
+		"snowflake_stage_external_s3":                                            resources.StageExternalS3(),
+		"snowflake_stage_file":                                                   resources.StageFile(),
+		"snowflake_stage_internal":                                               resources.StageInternal(),
 		"snowflake_storage_integration":                                          resources.StorageIntegration(),
 		"snowflake_stream_on_directory_table":                                    resources.StreamOnDirectoryTable(),
+		"snowflake_stream_on_dynamic_table":                                      resources.StreamOnDynamicTable(),
+		"snowflake_stream_on_event_table":                                        resources.StreamOnEventTable(),
 		"snowflake_stream_on_external_table":                                     resources.StreamOnExternalTable(),
+		"snowflake_stream_on_iceberg_table":                                      resources.StreamOnIcebergTable(),
 		"snowflake_stream_on_table":                                              resources.StreamOnTable(),
 		"snowflake_stream_on_view":                                               resources.StreamOnView(),
 		"snowflake_streamlit":                                                    resources.Streamlit(),
@@ -479,12 +509,16 @@ func getResources() map[string]*schema.Resource {
 		"snowflake_tag":                                                          resources.Tag(),
 		"snowflake_tag_association":                                              resources.TagAssociation(),
 		"snowflake_task":                                                         resources.Task(),
+		"snowflake_task_execution":                                               resources.TaskExecution(),
+		"snowflake_task_graph":                                                   resources.TaskGraph(),
 		"snowflake_user":                                                         resources.User(),
 		"snowflake_user_authentication_policy_attachment":                        resources.UserAuthenticationPolicyAttachment(),
 		"snowflake_user_password_policy_attachment":                              resources.UserPasswordPolicyAttachment(),
+		"snowflake_user_programmatic_access_token":                               resources.UserProgrammaticAccessToken(),
 		"snowflake_user_public_keys":                                             resources.UserPublicKeys(),
 		"snowflake_view":                                                         resources.View(),
 		"snowflake_warehouse":                                                    resources.Warehouse(),
+		"snowflake_webhook_notification_integration":                             resources.WebhookNotificationIntegration(),
 	}
 }
 
@@ -502,12 +536,14 @@ func getDataSources() map[string]*schema.Resource {
 		"snowflake_database_roles":                     datasources.DatabaseRoles(),
 		"snowflake_databases":                          datasources.Databases(),
 		"snowflake_dynamic_tables":                     datasources.DynamicTables(),
+		"snowflake_external_access_integrations":       datasources.ExternalAccessIntegrations(),
 		"snowflake_external_functions":                 datasources.ExternalFunctions(),
 		"snowflake_external_tables":                    datasources.ExternalTables(),
 		"snowflake_failover_groups":                    datasources.FailoverGroups(),
 		"snowflake_file_formats":                       datasources.FileFormats(),
 		"snowflake_functions":                          datasources.Functions(),
 		"snowflake_grants":                             datasources.Grants(),
+		"snowflake_hybrid_tables":                      datasources.HybridTables(),
 		"snowflake_masking_policies":                   datasources.MaskingPolicies(),
 		"snowflake_materialized_views":                 datasources.MaterializedViews(),
 		"snowflake_network_policies":                   datasources.NetworkPolicies(),
@@ -521,6 +557,7 @@ func getDataSources() map[string]*schema.Resource {
 		"snowflake_security_integrations":              datasources.SecurityIntegrations(),
 		"snowflake_sequences":                          datasources.Sequences(),
 		"snowflake_shares":                             datasources.Shares(),
+		"snowflake_stage_files":                        datasources.StageFiles(),
 		"snowflake_stages":                             datasources.Stages(),
 		"snowflake_storage_integrations":               datasources.StorageIntegrations(),
 		"snowflake_streams":                            datasources.Streams(),
@@ -533,6 +570,7 @@ func getDataSources() map[string]*schema.Resource {
 		"snowflake_tags":                               datasources.Tags(),
 		"snowflake_tasks":                              datasources.Tasks(),
 		"snowflake_users":                              datasources.Users(),
+		"snowflake_user_programmatic_access_tokens":    datasources.UserProgrammaticAccessTokens(),
 		"snowflake_views":                              datasources.Views(),
 		"snowflake_warehouses":                         datasources.Warehouses(),
 	}
@@ -660,6 +698,17 @@ func getDriverConfigFromTerraform(s *schema.ResourceData) (*gosnowflake.Config,
 			}
 			return nil
 		}(),
+		func() error {
+			if v, ok := s.GetOk("workload_identity_provider"); ok && v.(string) != "" {
+				workloadIdentityProvider, err := sdk.ToWorkloadIdentityProvider(v.(string))
+				if err != nil {
+					return err
+				}
+				config.WorkloadIdentityProvider = string(workloadIdentityProvider)
+			}
+			return nil
+		}(),
+		handleStringField(s, "workload_identity_entra_resource", &config.WorkloadIdentityEntraResource),
 		handleDurationInSecondsAttribute(s, "login_timeout", &config.LoginTimeout),
 		handleDurationInSecondsAttribute(s, "request_timeout", &config.RequestTimeout),
 		handleDurationInSecondsAttribute(s, "jwt_expire_timeout", &config.JWTExpireTimeout),
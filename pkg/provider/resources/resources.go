@@ -7,9 +7,12 @@ const (
 	AccountAuthenticationPolicyAttachment                  resource = "snowflake_account_authentication_policy_attachment"
 	AccountParameter                                       resource = "snowflake_account_parameter"
 	AccountPasswordPolicyAttachment                        resource = "snowflake_account_password_policy_attachment"
+	AccountPolicies                                        resource = "snowflake_account_policies"
 	AccountRole                                            resource = "snowflake_account_role"
+	AccountRoleMembers                                     resource = "snowflake_account_role_members"
 	Alert                                                  resource = "snowflake_alert"
 	ApiAuthenticationIntegrationWithAuthorizationCodeGrant resource = "snowflake_api_authentication_integration_with_authorization_code_grant"
+	ApiAuthenticationIntegrationWithAwsIam                 resource = "snowflake_api_authentication_integration_with_aws_iam"
 	ApiAuthenticationIntegrationWithClientCredentials      resource = "snowflake_api_authentication_integration_with_client_credentials"
 	ApiAuthenticationIntegrationWithJwtBearer              resource = "snowflake_api_authentication_integration_with_jwt_bearer"
 	ApiIntegration                                         resource = "snowflake_api_integration"
@@ -20,6 +23,7 @@ const (
 	DynamicTable                                           resource = "snowflake_dynamic_table"
 	EmailNotificationIntegration                           resource = "snowflake_email_notification_integration"
 	Execute                                                resource = "snowflake_execute"
+	ExternalAccessIntegration                              resource = "snowflake_external_access_integration"
 	ExternalFunction                                       resource = "snowflake_external_function"
 	ExternalTable                                          resource = "snowflake_external_table"
 	ExternalOauthSecurityIntegration                       resource = "snowflake_external_oauth_integration"
@@ -33,6 +37,7 @@ const (
 	GrantPrivilegesToAccountRole                           resource = "snowflake_grant_privileges_to_account_role"
 	GrantPrivilegesToDatabaseRole                          resource = "snowflake_grant_privileges_to_database_role"
 	GrantPrivilegesToShare                                 resource = "snowflake_grant_privileges_to_share"
+	HybridTable                                            resource = "snowflake_hybrid_table"
 	FunctionJava                                           resource = "snowflake_function_java"
 	FunctionJavascript                                     resource = "snowflake_function_javascript"
 	FunctionPython                                         resource = "snowflake_function_python"
@@ -46,10 +51,14 @@ const (
 	NetworkPolicyAttachment                                resource = "snowflake_network_policy_attachment"
 	NetworkRule                                            resource = "snowflake_network_rule"
 	NotificationIntegration                                resource = "snowflake_notification_integration"
+	NotificationIntegrationAwsSns                          resource = "snowflake_notification_integration_aws_sns"
+	NotificationIntegrationAzureEventGrid                  resource = "snowflake_notification_integration_azure_event_grid"
+	NotificationIntegrationGcpPubsub                       resource = "snowflake_notification_integration_gcp_pubsub"
 	OauthIntegration                                       resource = "snowflake_oauth_integration"
 	OauthIntegrationForCustomClients                       resource = "snowflake_oauth_integration_for_custom_clients"
 	OauthIntegrationForPartnerApplications                 resource = "snowflake_oauth_integration_for_partner_applications"
 	ObjectParameter                                        resource = "snowflake_object_parameter"
+	OrganizationAccount                                    resource = "snowflake_organization_account"
 	PasswordPolicy                                         resource = "snowflake_password_policy"
 	Pipe                                                   resource = "snowflake_pipe"
 	PrimaryConnection                                      resource = "snowflake_primary_connection"
@@ -76,9 +85,17 @@ const (
 	Share                                                  resource = "snowflake_share"
 	SharedDatabase                                         resource = "snowflake_shared_database"
 	Stage                                                  resource = "snowflake_stage"
+	StageExternalAzure                                     resource = "snowflake_stage_external_azure"
+	StageExternalGcs                                       resource = "snowflake_stage_external_gcs"
+	StageExternalS3                                        resource = "snowflake_stage_external_s3"
+	StageFile                                              resource = "snowflake_stage_file"
+	StageInternal                                          resource = "snowflake_stage_internal"
 	StorageIntegration                                     resource = "snowflake_storage_integration"
 	StreamOnDirectoryTable                                 resource = "snowflake_stream_on_directory_table"
+	StreamOnDynamicTable                                   resource = "snowflake_stream_on_dynamic_table"
+	StreamOnEventTable                                     resource = "snowflake_stream_on_event_table"
 	StreamOnExternalTable                                  resource = "snowflake_stream_on_external_table"
+	StreamOnIcebergTable                                   resource = "snowflake_stream_on_iceberg_table"
 	StreamOnTable                                          resource = "snowflake_stream_on_table"
 	StreamOnView                                           resource = "snowflake_stream_on_view"
 	Streamlit                                              resource = "snowflake_streamlit"
@@ -89,12 +106,16 @@ const (
 	TagAssociation                                         resource = "snowflake_tag_association"
 	TagMaskingPolicyAssociation                            resource = "snowflake_tag_masking_policy_association"
 	Task                                                   resource = "snowflake_task"
+	TaskExecution                                          resource = "snowflake_task_execution"
+	TaskGraph                                              resource = "snowflake_task_graph"
 	User                                                   resource = "snowflake_user"
 	UserAuthenticationPolicyAttachment                     resource = "snowflake_user_authentication_policy_attachment"
 	UserPasswordPolicyAttachment                           resource = "snowflake_user_password_policy_attachment"
+	UserProgrammaticAccessToken                            resource = "snowflake_user_programmatic_access_token"
 	UserPublicKeys                                         resource = "snowflake_user_public_keys"
 	View                                                   resource = "snowflake_view"
 	Warehouse                                              resource = "snowflake_warehouse"
+	WebhookNotificationIntegration                         resource = "snowflake_webhook_notification_integration"
 )
 
 type Resource interface {
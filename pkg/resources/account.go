@@ -28,6 +28,13 @@ var accountSchema = map[string]*schema.Schema{
 		Required:    true,
 		Description: "Specifies the identifier (i.e. name) for the account. It must be unique within an organization, regardless of which Snowflake Region the account is in and must start with an alphabetic character and cannot contain spaces or special characters except for underscores (_). Note that if the account name includes underscores, features that do not accept account names with underscores (e.g. Okta SSO or SCIM) can reference a version of the account name that substitutes hyphens (-) for the underscores.",
 	},
+	"save_old_url": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		Default:          BooleanDefault,
+		ValidateDiagFunc: validateBooleanString,
+		Description:      booleanStringFieldDescription("Specifies whether the original account URL is saved when the account is renamed. The value is only used when `name` changes. When the old URL is saved, it can still be used to access the account until it is dropped with `ALTER ACCOUNT ... DROP OLD URL`."),
+	},
 	"admin_name": {
 		Type:             schema.TypeString,
 		Required:         true,
@@ -359,6 +366,7 @@ func ReadAccount(withExternalChangesMarking bool) schema.ReadContextFunc {
 		} else {
 			if err = setStateToValuesFromConfig(d, accountSchema, []string{
 				"name",
+				"save_old_url",
 				"admin_name",
 				"admin_password",
 				"admin_rsa_public_key",
@@ -408,11 +416,20 @@ func UpdateAccount(ctx context.Context, d *schema.ResourceData, meta any) diag.D
 	if d.HasChange("name") {
 		newId := sdk.NewAccountIdentifier(id.OrganizationName(), d.Get("name").(string))
 
+		rename := &sdk.AccountRename{
+			Name:    id.AsAccountObjectIdentifier(),
+			NewName: newId.AsAccountObjectIdentifier(),
+		}
+		if v := d.Get("save_old_url").(string); v != BooleanDefault {
+			parsed, err := booleanStringToBool(v)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			rename.SaveOldURL = &parsed
+		}
+
 		err = client.Accounts.Alter(ctx, &sdk.AlterAccountOptions{
-			Rename: &sdk.AccountRename{
-				Name:    id.AsAccountObjectIdentifier(),
-				NewName: newId.AsAccountObjectIdentifier(),
-			},
+			Rename: rename,
 		})
 		if err != nil {
 			return diag.FromErr(err)
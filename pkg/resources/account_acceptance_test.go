@@ -14,12 +14,14 @@ import (
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/model"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/helpers/random"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/planchecks"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/testenvs"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/snowflakeenvs"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/snowflakeroles"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	tfjson "github.com/hashicorp/terraform-json"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/tfversion"
@@ -296,6 +298,66 @@ func TestAcc_Account_Rename(t *testing.T) {
 	})
 }
 
+func TestAcc_Account_RenameWithSaveOldUrl(t *testing.T) {
+	_ = testenvs.GetOrSkipTest(t, testenvs.EnableAcceptance)
+	_ = testenvs.GetOrSkipTest(t, testenvs.TestAccountCreate)
+
+	organizationName := acc.TestClient().Context.CurrentAccountId(t).OrganizationName()
+	id := random.AdminName()
+
+	newId := random.AdminName()
+	newAccountId := sdk.NewAccountIdentifier(organizationName, newId)
+
+	email := random.Email()
+	name := random.AdminName()
+	key, _ := random.GenerateRSAPublicKey(t)
+
+	configModel := model.Account("test", name, string(sdk.EditionStandard), email, 3, id).
+		WithAdminUserTypeEnum(sdk.UserTypeService).
+		WithAdminRsaPublicKey(key).
+		WithSaveOldUrl(r.BooleanFalse)
+	newConfigModel := model.Account("test", name, string(sdk.EditionStandard), email, 3, newId).
+		WithAdminUserTypeEnum(sdk.UserTypeService).
+		WithAdminRsaPublicKey(key).
+		WithSaveOldUrl(r.BooleanFalse)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: acc.CheckDestroy(t, resources.Account),
+		Steps: []resource.TestStep{
+			{
+				Config: config.FromModels(t, configModel),
+				Check: assertThat(t,
+					resourceassert.AccountResource(t, configModel.ResourceReference()).
+						HasNameString(id).
+						HasSaveOldUrlString(r.BooleanFalse),
+				),
+			},
+			{
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(newConfigModel.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Config: config.FromModels(t, newConfigModel),
+				Check: assertThat(t,
+					resourceassert.AccountResource(t, newConfigModel.ResourceReference()).
+						HasNameString(newId).
+						HasFullyQualifiedNameString(newAccountId.FullyQualifiedName()).
+						HasSaveOldUrlString(r.BooleanFalse),
+					resourceshowoutputassert.AccountShowOutput(t, newConfigModel.ResourceReference()).
+						HasOrganizationName(organizationName).
+						HasAccountName(newId).
+						HasOldAccountURL(""),
+				),
+			},
+		},
+	})
+}
+
 func TestAcc_Account_IsOrgAdmin(t *testing.T) {
 	_ = testenvs.GetOrSkipTest(t, testenvs.EnableAcceptance)
 	_ = testenvs.GetOrSkipTest(t, testenvs.TestAccountCreate)
@@ -626,6 +688,58 @@ func TestAcc_Account_UpgradeFrom_v0_99_0(t *testing.T) {
 	})
 }
 
+func TestAcc_Account_UpgradeFrom_v1_1_0_SaveOldUrl(t *testing.T) {
+	_ = testenvs.GetOrSkipTest(t, testenvs.EnableAcceptance)
+	_ = testenvs.GetOrSkipTest(t, testenvs.TestAccountCreate)
+
+	id := random.AdminName()
+	email := random.Email()
+	name := random.AdminName()
+	key, _ := random.GenerateRSAPublicKey(t)
+
+	configModel := model.Account("test", name, string(sdk.EditionStandard), email, 3, id).
+		WithAdminUserTypeEnum(sdk.UserTypeService).
+		WithAdminRsaPublicKey(key)
+
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: acc.CheckDestroy(t, resources.Account),
+		Steps: []resource.TestStep{
+			{
+				ExternalProviders: acc.ExternalProviderWithExactVersion("1.1.0"),
+				Config:            config.FromModels(t, configModel),
+			},
+			// save_old_url is not in the state yet, so the default is set with a one-time update
+			{
+				ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(configModel.ResourceReference(), plancheck.ResourceActionUpdate),
+						planchecks.ExpectChange(configModel.ResourceReference(), "save_old_url", tfjson.ActionUpdate, nil, sdk.String(r.BooleanDefault)),
+					},
+				},
+				Config: config.FromModels(t, configModel),
+				Check: assertThat(t,
+					resourceassert.AccountResource(t, configModel.ResourceReference()).
+						HasNameString(id).
+						HasSaveOldUrlString(r.BooleanDefault),
+				),
+			},
+			{
+				ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Config: config.FromModels(t, configModel),
+			},
+		},
+	})
+}
+
 func accountConfig_v0_99_0(
 	name string,
 	adminName string,
@@ -0,0 +1,307 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// accountPolicySlot describes a single account-level policy which is attached with ALTER ACCOUNT SET <kind> POLICY.
+type accountPolicySlot struct {
+	field string
+	kind  sdk.PolicyKind
+	set   func(id sdk.SchemaObjectIdentifier) *sdk.AccountSet
+	unset *sdk.AccountUnset
+}
+
+var accountPolicySlots = []accountPolicySlot{
+	{
+		field: "password_policy",
+		kind:  sdk.PolicyKindPasswordPolicy,
+		set:   func(id sdk.SchemaObjectIdentifier) *sdk.AccountSet { return &sdk.AccountSet{PasswordPolicy: id} },
+		unset: &sdk.AccountUnset{PasswordPolicy: sdk.Bool(true)},
+	},
+	{
+		field: "session_policy",
+		kind:  sdk.PolicyKindSessionPolicy,
+		set:   func(id sdk.SchemaObjectIdentifier) *sdk.AccountSet { return &sdk.AccountSet{SessionPolicy: id} },
+		unset: &sdk.AccountUnset{SessionPolicy: sdk.Bool(true)},
+	},
+	{
+		field: "authentication_policy",
+		kind:  sdk.PolicyKindAuthenticationPolicy,
+		set:   func(id sdk.SchemaObjectIdentifier) *sdk.AccountSet { return &sdk.AccountSet{AuthenticationPolicy: id} },
+		unset: &sdk.AccountUnset{AuthenticationPolicy: sdk.Bool(true)},
+	},
+	{
+		field: "packages_policy",
+		kind:  sdk.PolicyKindPackagesPolicy,
+		set:   func(id sdk.SchemaObjectIdentifier) *sdk.AccountSet { return &sdk.AccountSet{PackagesPolicy: id} },
+		unset: &sdk.AccountUnset{PackagesPolicy: sdk.Bool(true)},
+	},
+	{
+		field: "feature_policy",
+		kind:  sdk.PolicyKindFeaturePolicy,
+		set: func(id sdk.SchemaObjectIdentifier) *sdk.AccountSet {
+			return &sdk.AccountSet{FeaturePolicy: &sdk.AccountFeaturePolicy{FeaturePolicy: id}}
+		},
+		unset: &sdk.AccountUnset{FeaturePolicy: sdk.Bool(true)},
+	},
+}
+
+var accountPoliciesSchema = map[string]*schema.Schema{
+	"password_policy": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		ValidateDiagFunc: IsValidIdentifier[sdk.SchemaObjectIdentifier](),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+		Description:      relatedResourceDescription("Specifies the fully qualified name of the password policy set for the current account.", resources.PasswordPolicy),
+	},
+	"session_policy": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		ValidateDiagFunc: IsValidIdentifier[sdk.SchemaObjectIdentifier](),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+		Description:      "Specifies the fully qualified name of the session policy set for the current account.",
+	},
+	"authentication_policy": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		ValidateDiagFunc: IsValidIdentifier[sdk.SchemaObjectIdentifier](),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+		Description:      relatedResourceDescription("Specifies the fully qualified name of the authentication policy set for the current account.", resources.AuthenticationPolicy),
+	},
+	"network_policy": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		ValidateDiagFunc: IsValidIdentifier[sdk.AccountObjectIdentifier](),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+		Description:      relatedResourceDescription("Specifies the network policy set for the current account. The Snowflake user running `terraform apply` must be on an IP address allowed by the network policy.", resources.NetworkPolicy),
+	},
+	"packages_policy": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		ValidateDiagFunc: IsValidIdentifier[sdk.SchemaObjectIdentifier](),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+		Description:      "Specifies the fully qualified name of the packages policy set for the current account. Packages policies control the Anaconda packages available in Python UDFs and procedures.",
+	},
+	"feature_policy": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		ValidateDiagFunc: IsValidIdentifier[sdk.SchemaObjectIdentifier](),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+		Description:      "Specifies the fully qualified name of the feature policy set for all applications in the current account.",
+	},
+}
+
+// AccountPolicies returns a pointer to the resource representing all account-level policies of the current account.
+func AccountPolicies() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: PreviewFeatureCreateContextWrapper(string(previewfeatures.AccountPoliciesResource), TrackingCreateWrapper(resources.AccountPolicies, CreateAccountPolicies)),
+		ReadContext:   PreviewFeatureReadContextWrapper(string(previewfeatures.AccountPoliciesResource), TrackingReadWrapper(resources.AccountPolicies, ReadAccountPolicies)),
+		UpdateContext: PreviewFeatureUpdateContextWrapper(string(previewfeatures.AccountPoliciesResource), TrackingUpdateWrapper(resources.AccountPolicies, UpdateAccountPolicies)),
+		DeleteContext: PreviewFeatureDeleteContextWrapper(string(previewfeatures.AccountPoliciesResource), TrackingDeleteWrapper(resources.AccountPolicies, DeleteAccountPolicies)),
+		Description:   "Resource used to manage all account-level policies of the current account (password, session, authentication, network, packages, and feature policy) in one place. The resource is authoritative: a policy slot that is not set in the configuration is unset in Snowflake. Policies are replaced with `FORCE`, so a policy is never unset during a replacement. To manage the policies of a different account, use a provider alias.",
+
+		Schema: accountPoliciesSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: TrackingImportWrapper(resources.AccountPolicies, schema.ImportStatePassthroughContext),
+		},
+		Timeouts: defaultTimeouts,
+	}
+}
+
+func CreateAccountPolicies(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+
+	id, err := currentAccountIdentifier(ctx, client)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// The resource is authoritative, so the policies which are already set on the account are replaced or unset.
+	current, err := readAccountPolicies(ctx, client)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	for _, slot := range accountPolicySlots {
+		if err := applyAccountPolicy(ctx, client, slot, current[slot.field], d.Get(slot.field).(string)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if err := applyAccountNetworkPolicy(ctx, client, current["network_policy"], d.Get("network_policy").(string)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(helpers.EncodeResourceIdentifier(id))
+
+	return ReadAccountPolicies(ctx, d, meta)
+}
+
+func ReadAccountPolicies(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+
+	id, err := sdk.ParseAccountIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	currentId, err := currentAccountIdentifier(ctx, client)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if id.FullyQualifiedName() != currentId.FullyQualifiedName() {
+		return diag.FromErr(fmt.Errorf("account policies are managed for account %s, but the provider is connected to account %s; use a provider alias for the right account", id.FullyQualifiedName(), currentId.FullyQualifiedName()))
+	}
+
+	policies, err := readAccountPolicies(ctx, client)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	errs := make([]error, 0, len(policies))
+	for field, value := range policies {
+		errs = append(errs, d.Set(field, value))
+	}
+	if err := errors.Join(errs...); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func UpdateAccountPolicies(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+
+	for _, slot := range accountPolicySlots {
+		if d.HasChange(slot.field) {
+			oldValue, newValue := d.GetChange(slot.field)
+			if err := applyAccountPolicy(ctx, client, slot, oldValue.(string), newValue.(string)); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+	if d.HasChange("network_policy") {
+		oldValue, newValue := d.GetChange("network_policy")
+		if err := applyAccountNetworkPolicy(ctx, client, oldValue.(string), newValue.(string)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return ReadAccountPolicies(ctx, d, meta)
+}
+
+func DeleteAccountPolicies(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+
+	for _, slot := range accountPolicySlots {
+		if err := applyAccountPolicy(ctx, client, slot, d.Get(slot.field).(string), ""); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if err := applyAccountNetworkPolicy(ctx, client, d.Get("network_policy").(string), ""); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// applyAccountPolicy moves the given policy slot from the current value to the desired one.
+// A policy that is already set is replaced with FORCE, so the slot is never empty in between.
+func applyAccountPolicy(ctx context.Context, client *sdk.Client, slot accountPolicySlot, current string, desired string) error {
+	switch {
+	case desired != "":
+		policyId, err := sdk.ParseSchemaObjectIdentifier(desired)
+		if err != nil {
+			return err
+		}
+		set := slot.set(policyId)
+		if current != "" {
+			set.Force = sdk.Bool(true)
+		}
+		if err := client.Accounts.Alter(ctx, &sdk.AlterAccountOptions{Set: set}); err != nil {
+			return fmt.Errorf("error setting %s on account: %w", accountPolicyDisplayName(slot), err)
+		}
+	case current != "":
+		if err := client.Accounts.Alter(ctx, &sdk.AlterAccountOptions{Unset: slot.unset}); err != nil {
+			return fmt.Errorf("error unsetting %s on account: %w", accountPolicyDisplayName(slot), err)
+		}
+	}
+	return nil
+}
+
+// applyAccountNetworkPolicy moves the NETWORK_POLICY account parameter from the current value to the desired one.
+// Setting the parameter replaces the previous network policy in a single statement.
+func applyAccountNetworkPolicy(ctx context.Context, client *sdk.Client, current string, desired string) error {
+	switch {
+	case desired != "":
+		policyId, err := sdk.ParseAccountObjectIdentifier(desired)
+		if err != nil {
+			return err
+		}
+		if err := client.Accounts.Alter(ctx, &sdk.AlterAccountOptions{Set: &sdk.AccountSet{Parameters: &sdk.AccountLevelParameters{ObjectParameters: &sdk.ObjectParameters{NetworkPolicy: sdk.String(policyId.Name())}}}}); err != nil {
+			return fmt.Errorf("error setting network policy on account: %w", err)
+		}
+	case current != "":
+		if err := client.Accounts.Alter(ctx, &sdk.AlterAccountOptions{Unset: &sdk.AccountUnset{Parameters: &sdk.AccountLevelParametersUnset{ObjectParameters: &sdk.ObjectParametersUnset{NetworkPolicy: sdk.Bool(true)}}}}); err != nil {
+			return fmt.Errorf("error unsetting network policy on account: %w", err)
+		}
+	}
+	return nil
+}
+
+// readAccountPolicies returns the fully qualified names of the policies set on the current account, keyed by the schema field.
+// Empty string means that no policy is set in the given slot.
+func readAccountPolicies(ctx context.Context, client *sdk.Client) (map[string]string, error) {
+	policies := map[string]string{"network_policy": ""}
+	for _, slot := range accountPolicySlots {
+		policies[slot.field] = ""
+	}
+
+	policyReferences, err := client.PolicyReferences.GetForEntity(ctx, sdk.NewGetForEntityPolicyReferenceRequest(sdk.NewAccountObjectIdentifier(client.GetAccountLocator()), sdk.PolicyEntityDomainAccount))
+	if err != nil {
+		return nil, err
+	}
+	for _, slot := range accountPolicySlots {
+		for _, policyReference := range policyReferences {
+			if policyReference.PolicyKind == slot.kind && policyReference.PolicyDb != nil && policyReference.PolicySchema != nil {
+				policies[slot.field] = sdk.NewSchemaObjectIdentifier(*policyReference.PolicyDb, *policyReference.PolicySchema, policyReference.PolicyName).FullyQualifiedName()
+			}
+		}
+	}
+
+	parameter, err := client.Parameters.ShowAccountParameter(ctx, sdk.AccountParameterNetworkPolicy)
+	if err != nil {
+		return nil, err
+	}
+	if parameter.Level == sdk.ParameterTypeAccount && parameter.Value != "" {
+		policies["network_policy"] = sdk.NewAccountObjectIdentifier(parameter.Value).FullyQualifiedName()
+	}
+
+	return policies, nil
+}
+
+func currentAccountIdentifier(ctx context.Context, client *sdk.Client) (sdk.AccountIdentifier, error) {
+	organizationName, err := client.ContextFunctions.CurrentOrganizationName(ctx)
+	if err != nil {
+		return sdk.AccountIdentifier{}, err
+	}
+	accountName, err := client.ContextFunctions.CurrentAccountName(ctx)
+	if err != nil {
+		return sdk.AccountIdentifier{}, err
+	}
+	return sdk.NewAccountIdentifier(organizationName, accountName), nil
+}
+
+func accountPolicyDisplayName(slot accountPolicySlot) string {
+	return strings.ToLower(strings.ReplaceAll(string(slot.kind), "_", " "))
+}
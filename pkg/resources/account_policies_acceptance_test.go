@@ -0,0 +1,123 @@
+package resources_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	accconfig "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/model"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/testenvs"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+// TestAcc_AccountPolicies_basic does not use the authentication and network policies, because they could lock out the test user.
+func TestAcc_AccountPolicies_basic(t *testing.T) {
+	_ = testenvs.GetOrSkipTest(t, testenvs.EnableAcceptance)
+	acc.TestAccPreCheck(t)
+
+	passwordPolicy, passwordPolicyCleanup := acc.TestClient().PasswordPolicy.CreatePasswordPolicy(t)
+	t.Cleanup(passwordPolicyCleanup)
+	otherPasswordPolicy, otherPasswordPolicyCleanup := acc.TestClient().PasswordPolicy.CreatePasswordPolicy(t)
+	t.Cleanup(otherPasswordPolicyCleanup)
+	sessionPolicy, sessionPolicyCleanup := acc.TestClient().SessionPolicy.CreateSessionPolicy(t)
+	t.Cleanup(sessionPolicyCleanup)
+
+	accountId := acc.TestClient().Account.GetAccountIdentifier(t)
+
+	modelPasswordPolicy := model.AccountPolicies("test").
+		WithPasswordPolicy(passwordPolicy.ID().FullyQualifiedName())
+	modelSwappedPasswordPolicy := model.AccountPolicies("test").
+		WithPasswordPolicy(otherPasswordPolicy.ID().FullyQualifiedName()).
+		WithSessionPolicy(sessionPolicy.ID().FullyQualifiedName())
+	modelSessionPolicyOnly := model.AccountPolicies("test").
+		WithSessionPolicy(sessionPolicy.ID().FullyQualifiedName())
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: checkAccountPoliciesUnset(t),
+		Steps: []resource.TestStep{
+			{
+				Config: accconfig.FromModels(t, modelPasswordPolicy),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(modelPasswordPolicy.ResourceReference(), "id", helpers.EncodeResourceIdentifier(accountId)),
+					resource.TestCheckResourceAttr(modelPasswordPolicy.ResourceReference(), "password_policy", passwordPolicy.ID().FullyQualifiedName()),
+					resource.TestCheckResourceAttr(modelPasswordPolicy.ResourceReference(), "session_policy", ""),
+					resource.TestCheckResourceAttr(modelPasswordPolicy.ResourceReference(), "packages_policy", ""),
+					resource.TestCheckResourceAttr(modelPasswordPolicy.ResourceReference(), "feature_policy", ""),
+				),
+			},
+			{
+				Config:            accconfig.FromModels(t, modelPasswordPolicy),
+				ResourceName:      modelPasswordPolicy.ResourceReference(),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// replace the password policy with FORCE and set the session policy
+			{
+				Config: accconfig.FromModels(t, modelSwappedPasswordPolicy),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(modelSwappedPasswordPolicy.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(modelSwappedPasswordPolicy.ResourceReference(), "password_policy", otherPasswordPolicy.ID().FullyQualifiedName()),
+					resource.TestCheckResourceAttr(modelSwappedPasswordPolicy.ResourceReference(), "session_policy", sessionPolicy.ID().FullyQualifiedName()),
+				),
+			},
+			// external change is detected
+			{
+				PreConfig: func() {
+					acc.TestClient().Account.Alter(t, &sdk.AlterAccountOptions{Set: &sdk.AccountSet{PasswordPolicy: passwordPolicy.ID(), Force: sdk.Bool(true)}})
+				},
+				Config: accconfig.FromModels(t, modelSwappedPasswordPolicy),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(modelSwappedPasswordPolicy.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(modelSwappedPasswordPolicy.ResourceReference(), "password_policy", otherPasswordPolicy.ID().FullyQualifiedName()),
+				),
+			},
+			// unset the password policy
+			{
+				Config: accconfig.FromModels(t, modelSessionPolicyOnly),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(modelSessionPolicyOnly.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(modelSessionPolicyOnly.ResourceReference(), "password_policy", ""),
+					resource.TestCheckResourceAttr(modelSessionPolicyOnly.ResourceReference(), "session_policy", sessionPolicy.ID().FullyQualifiedName()),
+				),
+			},
+		},
+	})
+}
+
+func checkAccountPoliciesUnset(t *testing.T) func(*terraform.State) error {
+	t.Helper()
+	return func(_ *terraform.State) error {
+		policyReferences, err := acc.TestClient().PolicyReferences.GetPolicyReferences(t, sdk.NewAccountObjectIdentifier(acc.TestClient().GetAccountLocator()), sdk.PolicyEntityDomainAccount)
+		if err != nil {
+			return err
+		}
+		for _, policyReference := range policyReferences {
+			if policyReference.PolicyKind == sdk.PolicyKindPasswordPolicy || policyReference.PolicyKind == sdk.PolicyKindSessionPolicy {
+				return fmt.Errorf("%s %s is still set on the account", policyReference.PolicyKind, policyReference.PolicyName)
+			}
+		}
+		return nil
+	}
+}
@@ -0,0 +1,292 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var accountRoleMembersSchema = map[string]*schema.Schema{
+	"role_name": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		Description:      relatedResourceDescription(blocklistedCharactersFieldDescription("The name of the account role whose members are managed by this resource."), resources.AccountRole),
+		ValidateDiagFunc: IsValidIdentifier[sdk.AccountObjectIdentifier](),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+	},
+	"users": {
+		Type: schema.TypeSet,
+		Elem: &schema.Schema{
+			Type:             schema.TypeString,
+			ValidateDiagFunc: IsValidIdentifier[sdk.AccountObjectIdentifier](),
+		},
+		Optional:         true,
+		DiffSuppressFunc: NormalizeAndCompareIdentifiersInSet("users"),
+		Description:      relatedResourceDescription("The full set of users the role is granted to. Users granted the role outside of this resource are revoked on the next apply.", resources.User),
+	},
+	"account_roles": {
+		Type: schema.TypeSet,
+		Elem: &schema.Schema{
+			Type:             schema.TypeString,
+			ValidateDiagFunc: IsValidIdentifier[sdk.AccountObjectIdentifier](),
+		},
+		Optional:         true,
+		DiffSuppressFunc: NormalizeAndCompareIdentifiersInSet("account_roles"),
+		Description:      relatedResourceDescription("The full set of account roles the role is granted to (the roles inheriting the privileges of the role). Account roles granted the role outside of this resource are revoked on the next apply.", resources.AccountRole),
+	},
+	"ignore_scim_managed_members": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: fmt.Sprintf("When set to true, memberships granted by the SCIM provisioner roles (%s, and the ones listed in `scim_provisioner_roles`) are neither read nor revoked by this resource, unless they are listed in the configuration. Use it when some of the members are synchronized by a SCIM integration.", possibleValuesListed(sdk.AllScimSecurityIntegrationRunAsRoles)),
+	},
+	"scim_provisioner_roles": {
+		Type: schema.TypeSet,
+		Elem: &schema.Schema{
+			Type:             schema.TypeString,
+			ValidateDiagFunc: IsValidIdentifier[sdk.AccountObjectIdentifier](),
+		},
+		Optional:         true,
+		DiffSuppressFunc: NormalizeAndCompareIdentifiersInSet("scim_provisioner_roles"),
+		Description:      fmt.Sprintf("Additional roles treated as SCIM provisioners when `ignore_scim_managed_members` is set. Specify the `run_as_role` of the SCIM integrations with `scim_client = \"%s\"` that run as a custom role.", sdk.ScimSecurityIntegrationScimClientGeneric),
+	},
+}
+
+// AccountRoleMembers returns a pointer to the resource representing the full set of members of an account role.
+func AccountRoleMembers() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: PreviewFeatureCreateContextWrapper(string(previewfeatures.AccountRoleMembersResource), TrackingCreateWrapper(resources.AccountRoleMembers, CreateAccountRoleMembers)),
+		ReadContext:   PreviewFeatureReadContextWrapper(string(previewfeatures.AccountRoleMembersResource), TrackingReadWrapper(resources.AccountRoleMembers, ReadAccountRoleMembers)),
+		UpdateContext: PreviewFeatureUpdateContextWrapper(string(previewfeatures.AccountRoleMembersResource), TrackingUpdateWrapper(resources.AccountRoleMembers, UpdateAccountRoleMembers)),
+		DeleteContext: PreviewFeatureDeleteContextWrapper(string(previewfeatures.AccountRoleMembersResource), TrackingDeleteWrapper(resources.AccountRoleMembers, DeleteAccountRoleMembers)),
+		Description:   "Resource used to manage the full set of members (users and account roles) of an account role. Contrary to `snowflake_grant_account_role`, this resource is authoritative: members granted outside of it are revoked. For more information, check [GRANT ROLE documentation](https://docs.snowflake.com/en/sql-reference/sql/grant-role).",
+
+		Schema: accountRoleMembersSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: TrackingImportWrapper(resources.AccountRoleMembers, ImportAccountRoleMembers),
+		},
+		Timeouts: defaultTimeouts,
+	}
+}
+
+func ImportAccountRoleMembers(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	id, err := sdk.ParseAccountObjectIdentifier(d.Id())
+	if err != nil {
+		return nil, err
+	}
+	if err := d.Set("role_name", id.Name()); err != nil {
+		return nil, err
+	}
+	if err := d.Set("ignore_scim_managed_members", false); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+func CreateAccountRoleMembers(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseAccountObjectIdentifier(d.Get("role_name").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	grants, err := client.Grants.Show(ctx, &sdk.ShowGrantOptions{
+		Of: &sdk.ShowGrantsOf{
+			Role: id,
+		},
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	currentUsers, currentAccountRoles := accountRoleMembersFromGrants(d, grants)
+
+	if err := updateAccountRoleMembers(ctx, client, id, currentUsers, d.Get("users").(*schema.Set).List(), currentAccountRoles, d.Get("account_roles").(*schema.Set).List()); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(helpers.EncodeResourceIdentifier(id))
+
+	return ReadAccountRoleMembers(ctx, d, meta)
+}
+
+func ReadAccountRoleMembers(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseAccountObjectIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	grants, err := client.Grants.Show(ctx, &sdk.ShowGrantOptions{
+		Of: &sdk.ShowGrantsOf{
+			Role: id,
+		},
+	})
+	if err != nil {
+		if errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+			d.SetId("")
+			return diag.Diagnostics{
+				diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  "Failed to query account role members. Marking the resource as removed.",
+					Detail:   fmt.Sprintf("Account role: %s, Err: %s", id.FullyQualifiedName(), err),
+				},
+			}
+		}
+		return diag.FromErr(err)
+	}
+
+	users, accountRoles := accountRoleMembersFromGrants(d, grants)
+
+	errs := errors.Join(
+		d.Set("role_name", id.Name()),
+		d.Set("users", users),
+		d.Set("account_roles", accountRoles),
+	)
+	if errs != nil {
+		return diag.FromErr(errs)
+	}
+	return nil
+}
+
+func UpdateAccountRoleMembers(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseAccountObjectIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	oldUsers, newUsers := d.GetChange("users")
+	oldAccountRoles, newAccountRoles := d.GetChange("account_roles")
+	if err := updateAccountRoleMembers(ctx, client, id, oldUsers.(*schema.Set).List(), newUsers.(*schema.Set).List(), oldAccountRoles.(*schema.Set).List(), newAccountRoles.(*schema.Set).List()); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return ReadAccountRoleMembers(ctx, d, meta)
+}
+
+func DeleteAccountRoleMembers(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseAccountObjectIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	users, err := collections.MapErr(expandStringList(d.Get("users").(*schema.Set).List()), sdk.ParseAccountObjectIdentifier)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	accountRoles, err := collections.MapErr(expandStringList(d.Get("account_roles").(*schema.Set).List()), sdk.ParseAccountObjectIdentifier)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := revokeAccountRoleMembers(ctx, client, id, users, accountRoles); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// accountRoleMembersFromGrants returns the users and account roles granted the role. When ignore_scim_managed_members is set,
+// the memberships granted by the SCIM provisioners are skipped, unless they are present in the configuration.
+func accountRoleMembersFromGrants(d *schema.ResourceData, grants []sdk.Grant) (users []any, accountRoles []any) {
+	ignoreScimManagedMembers := d.Get("ignore_scim_managed_members").(bool)
+	configuredUsers := expandStringList(d.Get("users").(*schema.Set).List())
+	configuredAccountRoles := expandStringList(d.Get("account_roles").(*schema.Set).List())
+	scimProvisionerRoles := expandStringList(d.Get("scim_provisioner_roles").(*schema.Set).List())
+
+	users = make([]any, 0)
+	accountRoles = make([]any, 0)
+	for _, grant := range grants {
+		granteeName := grant.GranteeName.Name()
+		switch grant.GrantedTo {
+		case sdk.ObjectTypeUser:
+			if ignoreScimManagedMembers && isGrantedByScimProvisioner(grant, scimProvisionerRoles) && !helpers.ContainsIdentifierIgnoringQuotes(configuredUsers, granteeName) {
+				continue
+			}
+			users = append(users, granteeName)
+		case sdk.ObjectTypeRole:
+			if ignoreScimManagedMembers && isGrantedByScimProvisioner(grant, scimProvisionerRoles) && !helpers.ContainsIdentifierIgnoringQuotes(configuredAccountRoles, granteeName) {
+				continue
+			}
+			accountRoles = append(accountRoles, granteeName)
+		}
+	}
+	return users, accountRoles
+}
+
+// updateAccountRoleMembers revokes the members present only in the old lists and grants the ones present only in the new lists.
+func updateAccountRoleMembers(ctx context.Context, client *sdk.Client, id sdk.AccountObjectIdentifier, oldUsers []any, newUsers []any, oldAccountRoles []any, newAccountRoles []any) error {
+	addedUsers, removedUsers, err := accountRoleMembersDiff(oldUsers, newUsers)
+	if err != nil {
+		return err
+	}
+	addedAccountRoles, removedAccountRoles, err := accountRoleMembersDiff(oldAccountRoles, newAccountRoles)
+	if err != nil {
+		return err
+	}
+	if err := revokeAccountRoleMembers(ctx, client, id, removedUsers, removedAccountRoles); err != nil {
+		return err
+	}
+	return grantAccountRoleMembers(ctx, client, id, addedUsers, addedAccountRoles)
+}
+
+func accountRoleMembersDiff(oldMembers []any, newMembers []any) (added []sdk.AccountObjectIdentifier, removed []sdk.AccountObjectIdentifier, err error) {
+	oldIds, err := collections.MapErr(expandStringList(oldMembers), sdk.ParseAccountObjectIdentifier)
+	if err != nil {
+		return nil, nil, err
+	}
+	newIds, err := collections.MapErr(expandStringList(newMembers), sdk.ParseAccountObjectIdentifier)
+	if err != nil {
+		return nil, nil, err
+	}
+	added, removed = ListDiff(oldIds, newIds)
+	return added, removed, nil
+}
+
+func grantAccountRoleMembers(ctx context.Context, client *sdk.Client, id sdk.AccountObjectIdentifier, users []sdk.AccountObjectIdentifier, accountRoles []sdk.AccountObjectIdentifier) error {
+	errs := make([]error, 0)
+	for _, user := range users {
+		if err := client.Roles.Grant(ctx, sdk.NewGrantRoleRequest(id, sdk.GrantRole{User: &user})); err != nil {
+			errs = append(errs, fmt.Errorf("granting account role %s to user %s: %w", id.FullyQualifiedName(), user.FullyQualifiedName(), err))
+		}
+	}
+	for _, accountRole := range accountRoles {
+		if err := client.Roles.Grant(ctx, sdk.NewGrantRoleRequest(id, sdk.GrantRole{Role: &accountRole})); err != nil {
+			errs = append(errs, fmt.Errorf("granting account role %s to account role %s: %w", id.FullyQualifiedName(), accountRole.FullyQualifiedName(), err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func revokeAccountRoleMembers(ctx context.Context, client *sdk.Client, id sdk.AccountObjectIdentifier, users []sdk.AccountObjectIdentifier, accountRoles []sdk.AccountObjectIdentifier) error {
+	errs := make([]error, 0)
+	for _, user := range users {
+		if err := client.Roles.Revoke(ctx, sdk.NewRevokeRoleRequest(id, sdk.RevokeRole{User: &user})); err != nil {
+			errs = append(errs, fmt.Errorf("revoking account role %s from user %s: %w", id.FullyQualifiedName(), user.FullyQualifiedName(), err))
+		}
+	}
+	for _, accountRole := range accountRoles {
+		if err := client.Roles.Revoke(ctx, sdk.NewRevokeRoleRequest(id, sdk.RevokeRole{Role: &accountRole})); err != nil {
+			errs = append(errs, fmt.Errorf("revoking account role %s from account role %s: %w", id.FullyQualifiedName(), accountRole.FullyQualifiedName(), err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func isGrantedByScimProvisioner(grant sdk.Grant, scimProvisionerRoles []string) bool {
+	grantedBy := grant.GrantedBy.Name()
+	return slices.Contains(sdk.AllScimSecurityIntegrationRunAsRoles, sdk.ScimSecurityIntegrationRunAsRoleOption(grantedBy)) ||
+		helpers.ContainsIdentifierIgnoringQuotes(scimProvisionerRoles, grantedBy)
+}
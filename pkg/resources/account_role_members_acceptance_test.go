@@ -0,0 +1,206 @@
+package resources_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/testenvs"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_AccountRoleMembers(t *testing.T) {
+	_ = testenvs.GetOrSkipTest(t, testenvs.EnableAcceptance)
+	acc.TestAccPreCheck(t)
+
+	role, roleCleanup := acc.TestClient().Role.CreateRole(t)
+	t.Cleanup(roleCleanup)
+
+	memberRole, memberRoleCleanup := acc.TestClient().Role.CreateRole(t)
+	t.Cleanup(memberRoleCleanup)
+
+	user1, user1Cleanup := acc.TestClient().User.CreateUser(t)
+	t.Cleanup(user1Cleanup)
+
+	user2, user2Cleanup := acc.TestClient().User.CreateUser(t)
+	t.Cleanup(user2Cleanup)
+
+	user3, user3Cleanup := acc.TestClient().User.CreateUser(t)
+	t.Cleanup(user3Cleanup)
+
+	resourceReference := "snowflake_account_role_members.test"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: acc.CheckAccountRoleMembersDestroy(t),
+		Steps: []resource.TestStep{
+			// create
+			{
+				Config: accountRoleMembersConfig(role.ID(), []sdk.AccountObjectIdentifier{user1.ID(), user2.ID()}, []sdk.AccountObjectIdentifier{memberRole.ID()}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceReference, "id", helpers.EncodeResourceIdentifier(role.ID())),
+					resource.TestCheckResourceAttr(resourceReference, "role_name", role.ID().Name()),
+					resource.TestCheckResourceAttr(resourceReference, "users.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceReference, "users.*", user1.ID().Name()),
+					resource.TestCheckTypeSetElemAttr(resourceReference, "users.*", user2.ID().Name()),
+					resource.TestCheckResourceAttr(resourceReference, "account_roles.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceReference, "account_roles.*", memberRole.ID().Name()),
+					resource.TestCheckResourceAttr(resourceReference, "ignore_scim_managed_members", "false"),
+				),
+			},
+			// external membership is detected and revoked
+			{
+				PreConfig: func() {
+					acc.TestClient().Role.GrantRoleToUser(t, role.ID(), user3.ID())
+				},
+				Config: accountRoleMembersConfig(role.ID(), []sdk.AccountObjectIdentifier{user1.ID(), user2.ID()}, []sdk.AccountObjectIdentifier{memberRole.ID()}),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceReference, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceReference, "users.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceReference, "users.*", user1.ID().Name()),
+					resource.TestCheckTypeSetElemAttr(resourceReference, "users.*", user2.ID().Name()),
+				),
+			},
+			// grant and revoke members as a set difference
+			{
+				Config: accountRoleMembersConfig(role.ID(), []sdk.AccountObjectIdentifier{user2.ID(), user3.ID()}, nil),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceReference, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceReference, "users.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceReference, "users.*", user2.ID().Name()),
+					resource.TestCheckTypeSetElemAttr(resourceReference, "users.*", user3.ID().Name()),
+					resource.TestCheckResourceAttr(resourceReference, "account_roles.#", "0"),
+				),
+			},
+			// import
+			{
+				ResourceName:      resourceReference,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAcc_AccountRoleMembers_MembershipRevokedOutsideOfTerraform(t *testing.T) {
+	_ = testenvs.GetOrSkipTest(t, testenvs.EnableAcceptance)
+	acc.TestAccPreCheck(t)
+
+	role, roleCleanup := acc.TestClient().Role.CreateRole(t)
+	t.Cleanup(roleCleanup)
+
+	user, userCleanup := acc.TestClient().User.CreateUser(t)
+	t.Cleanup(userCleanup)
+
+	resourceReference := "snowflake_account_role_members.test"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: acc.CheckAccountRoleMembersDestroy(t),
+		Steps: []resource.TestStep{
+			{
+				Config: accountRoleMembersConfig(role.ID(), []sdk.AccountObjectIdentifier{user.ID()}, nil),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceReference, "users.#", "1"),
+				),
+			},
+			{
+				PreConfig: func() {
+					acc.TestClient().Role.RevokeRoleFromUser(t, role.ID(), user.ID())
+				},
+				Config: accountRoleMembersConfig(role.ID(), []sdk.AccountObjectIdentifier{user.ID()}, nil),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceReference, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceReference, "users.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceReference, "users.*", user.ID().Name()),
+				),
+			},
+		},
+	})
+}
+
+func TestAcc_AccountRoleMembers_MembersGrantedBeforeCreation(t *testing.T) {
+	_ = testenvs.GetOrSkipTest(t, testenvs.EnableAcceptance)
+	acc.TestAccPreCheck(t)
+
+	role, roleCleanup := acc.TestClient().Role.CreateRole(t)
+	t.Cleanup(roleCleanup)
+
+	user1, user1Cleanup := acc.TestClient().User.CreateUser(t)
+	t.Cleanup(user1Cleanup)
+
+	user2, user2Cleanup := acc.TestClient().User.CreateUser(t)
+	t.Cleanup(user2Cleanup)
+
+	user3, user3Cleanup := acc.TestClient().User.CreateUser(t)
+	t.Cleanup(user3Cleanup)
+
+	resourceReference := "snowflake_account_role_members.test"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: acc.CheckAccountRoleMembersDestroy(t),
+		Steps: []resource.TestStep{
+			// the listed member granted earlier is kept and the unlisted one is revoked
+			{
+				PreConfig: func() {
+					acc.TestClient().Role.GrantRoleToUser(t, role.ID(), user2.ID())
+					acc.TestClient().Role.GrantRoleToUser(t, role.ID(), user3.ID())
+				},
+				Config: accountRoleMembersConfig(role.ID(), []sdk.AccountObjectIdentifier{user1.ID(), user2.ID()}, nil),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceReference, "users.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceReference, "users.*", user1.ID().Name()),
+					resource.TestCheckTypeSetElemAttr(resourceReference, "users.*", user2.ID().Name()),
+					resource.TestCheckResourceAttr(resourceReference, "account_roles.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func accountRoleMembersConfig(roleId sdk.AccountObjectIdentifier, users []sdk.AccountObjectIdentifier, accountRoles []sdk.AccountObjectIdentifier) string {
+	quoted := func(ids []sdk.AccountObjectIdentifier) string {
+		names := make([]string, len(ids))
+		for i, id := range ids {
+			names[i] = fmt.Sprintf("%q", id.Name())
+		}
+		return strings.Join(names, ", ")
+	}
+	return fmt.Sprintf(`
+resource "snowflake_account_role_members" "test" {
+  role_name     = "%[1]s"
+  users         = [%[2]s]
+  account_roles = [%[3]s]
+}
+`, roleId.Name(), quoted(users), quoted(accountRoles))
+}
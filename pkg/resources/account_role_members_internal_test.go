@@ -0,0 +1,30 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_isGrantedByScimProvisioner(t *testing.T) {
+	testCases := []struct {
+		name                 string
+		grantedBy            string
+		scimProvisionerRoles []string
+		expected             bool
+	}{
+		{name: "predefined provisioner", grantedBy: "OKTA_PROVISIONER", expected: true},
+		{name: "generic predefined provisioner", grantedBy: "GENERIC_SCIM_PROVISIONER", expected: true},
+		{name: "custom role not listed", grantedBy: "CUSTOM_PROVISIONER", expected: false},
+		{name: "custom role listed", grantedBy: "CUSTOM_PROVISIONER", scimProvisionerRoles: []string{"CUSTOM_PROVISIONER"}, expected: true},
+		{name: "custom role listed with quotes", grantedBy: "CUSTOM_PROVISIONER", scimProvisionerRoles: []string{`"CUSTOM_PROVISIONER"`}, expected: true},
+		{name: "other role with provisioners listed", grantedBy: "ACCOUNTADMIN", scimProvisionerRoles: []string{"CUSTOM_PROVISIONER"}, expected: false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			grant := sdk.Grant{GrantedBy: sdk.NewAccountObjectIdentifier(tc.grantedBy)}
+			assert.Equal(t, tc.expected, isGrantedByScimProvisioner(grant, tc.scimProvisionerRoles))
+		})
+	}
+}
@@ -2,87 +2,81 @@ package resources
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"log"
-	"strconv"
 	"strings"
-	"time"
-
-	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
-	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
-	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/util"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/schemas"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 var alertSchema = map[string]*schema.Schema{
-	"name": {
-		Type:        schema.TypeString,
-		Required:    true,
-		Description: "Specifies the identifier for the alert; must be unique for the database and schema in which the alert is created.",
-		ForceNew:    true,
-	},
 	"database": {
-		Type:        schema.TypeString,
-		Required:    true,
-		Description: "The database in which to create the alert.",
-		ForceNew:    true,
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		DiffSuppressFunc: suppressIdentifierQuoting,
+		Description:      blocklistedCharactersFieldDescription("The database in which to create the alert."),
 	},
 	"schema": {
-		Type:        schema.TypeString,
-		Required:    true,
-		Description: "The schema in which to create the alert.",
-		ForceNew:    true,
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		DiffSuppressFunc: suppressIdentifierQuoting,
+		Description:      blocklistedCharactersFieldDescription("The schema in which to create the alert."),
 	},
-	"comment": {
-		Type:        schema.TypeString,
-		Optional:    true,
-		Description: "Specifies a comment for the alert.",
+	"name": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		DiffSuppressFunc: suppressIdentifierQuoting,
+		Description:      blocklistedCharactersFieldDescription("Specifies the identifier for the alert; must be unique for the database and schema in which the alert is created."),
+	},
+	"started": {
+		Type:     schema.TypeBool,
+		Required: true,
+		DiffSuppressFunc: IgnoreChangeToCurrentSnowflakeValueInShowWithMapping("state", func(state any) any {
+			return sdk.AlertState(state.(string)) == sdk.AlertStateStarted
+		}),
+		Description: "Specifies if the alert should be started (resumed) or suspended.",
 	},
 	"warehouse": {
-		Type:        schema.TypeString,
-		Required:    true,
-		Description: "The warehouse the alert will use.",
-		ForceNew:    true,
+		Type:             schema.TypeString,
+		Optional:         true,
+		ValidateDiagFunc: IsValidIdentifier[sdk.AccountObjectIdentifier](),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+		Description:      relatedResourceDescription("The warehouse that provides the compute resources for evaluating the alert condition and executing the alert action. Omit this parameter to create a serverless alert that uses Snowflake-managed compute resources.", resources.Warehouse),
 	},
-	"alert_schedule": {
+	"schedule": {
 		Type:        schema.TypeList,
 		Optional:    true,
 		MaxItems:    1,
-		Description: "The schedule for periodically running an alert.",
+		Description: "The schedule for periodically evaluating the condition of the alert. This can be a cron or interval in minutes. Omit this parameter to create an alert on new data, which is evaluated only when new rows are inserted into the table referenced in the condition. Adding or removing the schedule recreates the alert. (when set, one of the sub-fields `minutes` or `using_cron` should be set)",
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
-				"cron": {
-					Type:          schema.TypeList,
-					Optional:      true,
-					MaxItems:      1,
-					ConflictsWith: []string{"alert_schedule.interval"},
-					Description:   "Specifies the cron expression for the alert. The cron expression must be in the following format: \"minute hour day-of-month month day-of-week\". The following values are supported: minute: 0-59 hour: 0-23 day-of-month: 1-31 month: 1-12 day-of-week: 0-6 (0 is Sunday)",
-					Elem: &schema.Resource{
-						Schema: map[string]*schema.Schema{
-							"expression": {
-								Type:        schema.TypeString,
-								Required:    true,
-								Description: "Specifies the cron expression for the alert. The cron expression must be in the following format: \"minute hour day-of-month month day-of-week\". The following values are supported: minute: 0-59 hour: 0-23 day-of-month: 1-31 month: 1-12 day-of-week: 0-6 (0 is Sunday)",
-							},
-							"time_zone": {
-								Type:        schema.TypeString,
-								Required:    true,
-								Description: "Specifies the time zone for alert refresh.",
-							},
-						},
-					},
+				"minutes": {
+					Type:             schema.TypeInt,
+					Optional:         true,
+					Description:      "Specifies an interval (in minutes) of wait time inserted between evaluations of the alert. Accepts positive integers only. (conflicts with `using_cron`)",
+					ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
+					ExactlyOneOf:     []string{"schedule.0.minutes", "schedule.0.using_cron"},
 				},
-				"interval": {
-					Type:          schema.TypeInt,
-					Optional:      true,
-					ConflictsWith: []string{"alert_schedule.cron"},
-					Description:   "Specifies the interval in minutes for the alert schedule. The interval must be greater than 0 and less than 1440 (24 hours).",
+				"using_cron": {
+					Type:             schema.TypeString,
+					Optional:         true,
+					Description:      "Specifies a cron expression and time zone for periodically evaluating the alert. Supports a subset of standard cron utility syntax. (conflicts with `minutes`)",
+					DiffSuppressFunc: ignoreCaseSuppressFunc,
+					ExactlyOneOf:     []string{"schedule.0.minutes", "schedule.0.using_cron"},
 				},
 			},
 		},
@@ -90,324 +84,308 @@ var alertSchema = map[string]*schema.Schema{
 	"condition": {
 		Type:             schema.TypeString,
 		Required:         true,
-		Description:      "The SQL statement that represents the condition for the alert. (SELECT, SHOW, CALL)",
-		ForceNew:         false,
-		DiffSuppressFunc: DiffSuppressStatement,
+		DiffSuppressFunc: SuppressIfAny(DiffSuppressStatement, IgnoreChangeToCurrentSnowflakeValueInShow("condition")),
+		Description:      "The SQL statement that represents the condition for the alert (SELECT, SHOW or CALL). For alerts on new data, the statement must query the table that is monitored for new rows.",
 	},
 	"action": {
 		Type:             schema.TypeString,
 		Required:         true,
+		DiffSuppressFunc: SuppressIfAny(DiffSuppressStatement, IgnoreChangeToCurrentSnowflakeValueInShow("action")),
 		Description:      "The SQL statement that should be executed if the condition returns one or more rows.",
-		ForceNew:         false,
-		DiffSuppressFunc: DiffSuppressStatement,
 	},
-	"enabled": {
+	"comment": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies a comment for the alert.",
+	},
+	"execute_alert": {
 		Type:        schema.TypeBool,
 		Optional:    true,
 		Default:     false,
-		Description: "Specifies if an alert should be 'started' (enabled) after creation or should remain 'suspended' (default).",
+		Description: "When set to true, the alert is executed (`EXECUTE ALERT`) after every successful create or update of the resource, so that the condition is evaluated immediately, independently of the schedule. For more information, check [EXECUTE ALERT documentation](https://docs.snowflake.com/en/sql-reference/sql/execute-alert).",
 	},
 	FullyQualifiedNameAttributeName: schemas.FullyQualifiedNameSchema,
+	ShowOutputAttributeName: {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Outputs the result of `SHOW ALERTS` for the given alert.",
+		Elem: &schema.Resource{
+			Schema: schemas.ShowAlertSchema,
+		},
+	},
+	ParametersAttributeName: {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Outputs the result of `SHOW PARAMETERS IN ALERT` for the given alert.",
+		Elem: &schema.Resource{
+			Schema: schemas.ShowAlertParametersSchema,
+		},
+	},
 }
 
 // Alert returns a pointer to the resource representing an alert.
 func Alert() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: PreviewFeatureCreateContextWrapper(string(previewfeatures.AlertResource), TrackingCreateWrapper(resources.Alert, CreateAlert)),
-		ReadContext:   PreviewFeatureReadContextWrapper(string(previewfeatures.AlertResource), TrackingReadWrapper(resources.Alert, ReadAlert)),
+		ReadContext:   PreviewFeatureReadContextWrapper(string(previewfeatures.AlertResource), TrackingReadWrapper(resources.Alert, ReadAlert(true))),
 		UpdateContext: PreviewFeatureUpdateContextWrapper(string(previewfeatures.AlertResource), TrackingUpdateWrapper(resources.Alert, UpdateAlert)),
 		DeleteContext: PreviewFeatureDeleteContextWrapper(string(previewfeatures.AlertResource), TrackingDeleteWrapper(resources.Alert, DeleteAlert)),
+		Description:   "Resource used to manage alert objects. Alerts can be evaluated on a schedule or on new data, using a warehouse or serverless compute. For more information, check [alert documentation](https://docs.snowflake.com/en/user-guide/alerts).",
 
-		Schema: alertSchema,
+		Schema: collections.MergeMaps(alertSchema, alertParametersSchema),
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: TrackingImportWrapper(resources.Alert, ImportAlert),
+		},
+
+		CustomizeDiff: TrackingCustomDiffWrapper(resources.Alert, customdiff.All(
+			ComputedIfAnyAttributeChanged(alertSchema, ShowOutputAttributeName, "name", "started", "warehouse", "schedule", "condition", "action", "comment"),
+			ComputedIfAnyAttributeChanged(alertParametersSchema, ParametersAttributeName, collections.Map(sdk.AsStringList(sdk.AllAlertParameters), strings.ToLower)...),
+			ComputedIfAnyAttributeChanged(alertSchema, FullyQualifiedNameAttributeName, "name"),
+			// Scheduled alerts and alerts on new data cannot be converted into each other.
+			customdiff.ForceNewIfChange("schedule", func(_ context.Context, oldValue, newValue, _ any) bool {
+				return len(oldValue.([]any)) != len(newValue.([]any))
+			}),
+			alertParametersCustomDiff,
+		)),
+
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Version: 0,
+				// setting to empty object to not affect all the existing resources in the state
+				Type:    cty.EmptyObject,
+				Upgrade: v1_1_0_AlertStateUpgrader,
+			},
 		},
 		Timeouts: defaultTimeouts,
 	}
 }
 
-// ReadAlert implements schema.ReadContextFunc.
-func ReadAlert(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+func ImportAlert(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
 	client := meta.(*provider.Context).Client
-	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
 
-	alert, err := client.Alerts.ShowByID(ctx, id)
+	id, err := sdk.ParseSchemaObjectIdentifier(d.Id())
 	if err != nil {
-		// If not found, mark resource to be removed from state file during apply or refresh
-		log.Printf("[DEBUG] alert (%s) not found", d.Id())
-		d.SetId("")
-		return nil
-	}
-
-	if err := d.Set("enabled", alert.State == sdk.AlertStateStarted); err != nil {
-		return diag.FromErr(err)
-	}
-
-	if err := d.Set(FullyQualifiedNameAttributeName, id.FullyQualifiedName()); err != nil {
-		return diag.FromErr(err)
-	}
-
-	if err := d.Set("name", alert.Name); err != nil {
-		return diag.FromErr(err)
-	}
-
-	if err := d.Set("database", alert.DatabaseName); err != nil {
-		return diag.FromErr(err)
+		return nil, err
 	}
 
-	alertSchedule := alert.Schedule
-	if alertSchedule != "" {
-		if strings.Contains(alertSchedule, "MINUTE") {
-			interval, err := strconv.Atoi(strings.TrimSuffix(alertSchedule, " MINUTE"))
-			if err != nil {
-				return diag.FromErr(err)
-			}
-			err = d.Set("alert_schedule", []interface{}{
-				map[string]interface{}{
-					"interval": interval,
-				},
-			})
-			if err != nil {
-				return diag.FromErr(err)
-			}
-		} else {
-			repScheduleParts := strings.Split(alertSchedule, " ")
-			timeZone := repScheduleParts[len(repScheduleParts)-1]
-			expression := strings.TrimSuffix(strings.TrimPrefix(alertSchedule, "USING CRON "), " "+timeZone)
-			err = d.Set("alert_schedule", []interface{}{
-				map[string]interface{}{
-					"cron": []interface{}{
-						map[string]interface{}{
-							"expression": expression,
-							"time_zone":  timeZone,
-						},
-					},
-				},
-			})
-			if err != nil {
-				return diag.FromErr(err)
-			}
-		}
-	}
-
-	if err := d.Set("schema", alert.SchemaName); err != nil {
-		return diag.FromErr(err)
-	}
-
-	if err := d.Set("warehouse", alert.Warehouse); err != nil {
-		return diag.FromErr(err)
+	alert, err := client.Alerts.ShowByID(ctx, id)
+	if err != nil {
+		return nil, err
 	}
 
-	if err := d.Set("comment", alert.Comment); err != nil {
-		return diag.FromErr(err)
+	if _, err := ImportName[sdk.SchemaObjectIdentifier](context.Background(), d, nil); err != nil {
+		return nil, err
 	}
 
-	if err := d.Set("condition", alert.Condition); err != nil {
-		return diag.FromErr(err)
+	if err := d.Set("started", alert.State == sdk.AlertStateStarted); err != nil {
+		return nil, err
 	}
 
-	if err := d.Set("action", alert.Action); err != nil {
-		return diag.FromErr(err)
-	}
-	return nil
+	return []*schema.ResourceData{d}, nil
 }
 
-// CreateAlert implements schema.CreateContextFunc.
-func CreateAlert(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+func CreateAlert(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	client := meta.(*provider.Context).Client
 
 	databaseName := d.Get("database").(string)
 	schemaName := d.Get("schema").(string)
 	name := d.Get("name").(string)
-
-	objectIdentifier := sdk.NewSchemaObjectIdentifier(databaseName, schemaName, name)
-
-	alertSchedule := getAlertSchedule(d.Get("alert_schedule"))
-
-	warehouseName := d.Get("warehouse").(string)
-	warehouse := sdk.NewAccountObjectIdentifier(warehouseName)
+	id := sdk.NewSchemaObjectIdentifier(databaseName, schemaName, name)
 
 	opts := &sdk.CreateAlertOptions{}
-
-	if v, ok := d.GetOk("comment"); ok {
-		opts.Comment = sdk.String(v.(string))
+	if errs := errors.Join(
+		accountObjectIdentifierAttributeCreate(d, "warehouse", &opts.Warehouse),
+		attributeMappedValueCreate(d, "schedule", &opts.Schedule, func(v any) (*string, error) {
+			return taskScheduleCreate(d, v)
+		}),
+		stringAttributeCreate(d, "comment", &opts.Comment),
+	); errs != nil {
+		return diag.FromErr(errs)
 	}
 
-	condition := d.Get("condition").(string)
-
-	action := d.Get("action").(string)
+	if parameterCreateDiags := handleAlertParametersCreate(d, opts); len(parameterCreateDiags) > 0 {
+		return parameterCreateDiags
+	}
 
-	err := client.Alerts.Create(ctx, objectIdentifier, warehouse, alertSchedule, condition, action, opts)
-	if err != nil {
+	if err := client.Alerts.Create(ctx, id, d.Get("condition").(string), d.Get("action").(string), opts); err != nil {
 		return diag.FromErr(err)
 	}
 
-	d.SetId(helpers.EncodeSnowflakeID(objectIdentifier))
+	d.SetId(helpers.EncodeResourceIdentifier(id))
 
-	enabled := d.Get("enabled").(bool)
-	var diags diag.Diagnostics
-	if enabled {
-		opts := sdk.AlterAlertOptions{Action: &sdk.AlertActionResume}
-		err := client.Alerts.Alter(ctx, objectIdentifier, &opts)
-		if err != nil {
-			diags = append(diags, diag.Diagnostic{
-				Severity: diag.Warning,
-				Summary:  fmt.Sprintf("Enabling alert: %s", err.Error()),
-			})
+	// Alerts are created as suspended (https://docs.snowflake.com/en/sql-reference/sql/create-alert).
+	if d.Get("started").(bool) {
+		if err := client.Alerts.Alter(ctx, id, &sdk.AlterAlertOptions{Action: &sdk.AlertActionResume}); err != nil {
+			return diag.FromErr(fmt.Errorf("error resuming alert %s, err = %w", id.FullyQualifiedName(), err))
 		}
 	}
-	return append(diags, ReadAlert(ctx, d, meta)...)
-}
 
-func getAlertSchedule(v interface{}) string {
-	var alertSchedule string
-	schedule := v.([]interface{})[0].(map[string]interface{})
-	if v, ok := schedule["cron"]; ok {
-		c := v.([]interface{})
-		if len(c) > 0 {
-			cron := c[0].(map[string]interface{})
-			cronExpression := cron["expression"].(string)
-			timeZone := cron["time_zone"].(string)
-			alertSchedule = fmt.Sprintf("USING CRON %s %s", cronExpression, timeZone)
+	if d.Get("execute_alert").(bool) {
+		if err := client.Alerts.Execute(ctx, id); err != nil {
+			return diag.FromErr(fmt.Errorf("error executing alert %s, err = %w", id.FullyQualifiedName(), err))
 		}
 	}
-	if v, ok := schedule["interval"]; ok {
-		interval := v.(int)
-		if interval > 0 {
-			alertSchedule = fmt.Sprintf("%s MINUTE", strconv.Itoa(interval))
-		}
-	}
-	return alertSchedule
+
+	return ReadAlert(false)(ctx, d, meta)
 }
 
-// UpdateAlert implements schema.UpdateContextFunc.
-func UpdateAlert(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+func UpdateAlert(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	client := meta.(*provider.Context).Client
-	objectIdentifier := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+	id, err := sdk.ParseSchemaObjectIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
-	enabled := d.Get("enabled").(bool)
-	if d.HasChanges("enabled", "warehouse", "alert_schedule", "condition", "action", "comment") {
-		if err := waitSuspendAlert(ctx, client, objectIdentifier); err != nil {
-			log.Printf("[WARN] failed to suspend alert %s", objectIdentifier.Name())
+	alert, err := client.Alerts.ShowByID(ctx, id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// The alert has to be suspended to be altered; it is resumed at the end of the update if it should be started.
+	if alert.State == sdk.AlertStateStarted {
+		if err := client.Alerts.Alter(ctx, id, &sdk.AlterAlertOptions{Action: &sdk.AlertActionSuspend}); err != nil {
+			return diag.FromErr(fmt.Errorf("error suspending alert %s, err = %w", id.FullyQualifiedName(), err))
 		}
 	}
 
-	opts := &sdk.AlterAlertOptions{
-		Set:   &sdk.AlertSet{},
-		Unset: &sdk.AlertUnset{},
+	set, unset := &sdk.AlertSet{}, &sdk.AlertUnset{}
+	if err := errors.Join(
+		accountObjectIdentifierAttributeUpdate(d, "warehouse", &set.Warehouse, &unset.Warehouse),
+		stringAttributeUpdate(d, "comment", &set.Comment, &unset.Comment),
+	); err != nil {
+		return diag.FromErr(err)
 	}
-	runSetStatement := false
-
-	if d.HasChange("warehouse") {
-		runSetStatement = true
-		_, v := d.GetChange("warehouse")
-		warehouseName := v.(string)
-		warehouse := sdk.NewAccountObjectIdentifier(warehouseName)
-		opts.Set.Warehouse = &warehouse
+
+	if d.HasChange("schedule") {
+		// Removing the schedule recreates the alert, so only the new value has to be handled here.
+		if schedule, err := taskScheduleCreate(d, d.Get("schedule")); err != nil {
+			return diag.FromErr(err)
+		} else if schedule != nil {
+			set.Schedule = schedule
+		}
 	}
 
-	if d.HasChange("alert_schedule") {
-		runSetStatement = true
-		_, v := d.GetChange("alert_schedule")
-		alertSchedule := getAlertSchedule(v)
-		opts.Set.Schedule = &alertSchedule
+	if updateDiags := handleAlertParametersUpdate(d, set, unset); len(updateDiags) > 0 {
+		return updateDiags
 	}
 
-	if d.HasChange("comment") {
-		_, v := d.GetChange("comment")
-		runSetStatement = true
-		newComment := v.(string)
-		opts.Set.Comment = &newComment
+	if *set != (sdk.AlertSet{}) {
+		if err := client.Alerts.Alter(ctx, id, &sdk.AlterAlertOptions{Set: set}); err != nil {
+			return diag.FromErr(err)
+		}
 	}
 
-	if runSetStatement {
-		setOptions := &sdk.AlterAlertOptions{Set: opts.Set}
-		err := client.Alerts.Alter(ctx, objectIdentifier, setOptions)
-		if err != nil {
-			return diag.Errorf("error updating alert %v: %v", objectIdentifier.Name(), err)
+	if *unset != (sdk.AlertUnset{}) {
+		if err := client.Alerts.Alter(ctx, id, &sdk.AlterAlertOptions{Unset: unset}); err != nil {
+			return diag.FromErr(err)
 		}
 	}
 
 	if d.HasChange("condition") {
-		condition := d.Get("condition").(string)
-		alterOptions := &sdk.AlterAlertOptions{}
-		alterOptions.ModifyCondition = &[]string{condition}
-		err := client.Alerts.Alter(ctx, objectIdentifier, alterOptions)
-		if err != nil {
-			return diag.Errorf("error updating schedule on condition %v: %v", objectIdentifier.Name(), err)
+		if err := client.Alerts.Alter(ctx, id, &sdk.AlterAlertOptions{ModifyCondition: &[]string{d.Get("condition").(string)}}); err != nil {
+			return diag.FromErr(err)
 		}
 	}
 
 	if d.HasChange("action") {
-		action := d.Get("action").(string)
-		alterOptions := &sdk.AlterAlertOptions{}
-		alterOptions.ModifyAction = &action
-		err := client.Alerts.Alter(ctx, objectIdentifier, alterOptions)
-		if err != nil {
-			return diag.Errorf("error updating schedule on action %v: %v", objectIdentifier.Name(), err)
+		if err := client.Alerts.Alter(ctx, id, &sdk.AlterAlertOptions{ModifyAction: sdk.String(d.Get("action").(string))}); err != nil {
+			return diag.FromErr(err)
 		}
 	}
-	var diags diag.Diagnostics
-	if enabled {
-		if err := waitResumeAlert(ctx, client, objectIdentifier); err != nil {
-			diags = append(diags, diag.Diagnostic{
-				Severity: diag.Warning,
-				Summary:  fmt.Sprintf("failed to resume alert %s", objectIdentifier.Name()),
-			})
-		}
-	} else {
-		if err := waitSuspendAlert(ctx, client, objectIdentifier); err != nil {
-			diags = append(diags, diag.Diagnostic{
-				Severity: diag.Warning,
-				Summary:  fmt.Sprintf("failed to suspend alert %s", objectIdentifier.Name()),
-			})
+
+	if d.Get("started").(bool) {
+		if err := client.Alerts.Alter(ctx, id, &sdk.AlterAlertOptions{Action: &sdk.AlertActionResume}); err != nil {
+			return diag.FromErr(fmt.Errorf("error resuming alert %s, err = %w", id.FullyQualifiedName(), err))
 		}
 	}
-	return append(diags, ReadAlert(ctx, d, meta)...)
-}
+	// We don't process the else case, because the alert was already suspended at the beginning of the Update method.
 
-// DeleteAlert implements schema.DeleteContextFunc.
-func DeleteAlert(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*provider.Context).Client
-	objectIdentifier := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
-
-	err := client.Alerts.Drop(ctx, objectIdentifier, &sdk.DropAlertOptions{IfExists: sdk.Bool(true)})
-	if err != nil {
-		return diag.FromErr(err)
+	if d.Get("execute_alert").(bool) {
+		if err := client.Alerts.Execute(ctx, id); err != nil {
+			return diag.FromErr(fmt.Errorf("error executing alert %s, err = %w", id.FullyQualifiedName(), err))
+		}
 	}
 
-	d.SetId("")
-	return nil
+	return ReadAlert(false)(ctx, d, meta)
 }
 
-func waitResumeAlert(ctx context.Context, client *sdk.Client, id sdk.SchemaObjectIdentifier) error {
-	resumeAlert := func() (error, bool) {
-		opts := sdk.AlterAlertOptions{Action: &sdk.AlertActionResume}
-		err := client.Alerts.Alter(ctx, id, &opts)
+func ReadAlert(withExternalChangesMarking bool) schema.ReadContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+		client := meta.(*provider.Context).Client
+		id, err := sdk.ParseSchemaObjectIdentifier(d.Id())
 		if err != nil {
-			return fmt.Errorf("error resuming alert %v err = %w", id.Name(), err), false
+			return diag.FromErr(err)
 		}
+
 		alert, err := client.Alerts.ShowByID(ctx, id)
 		if err != nil {
-			return err, false
+			if errors.Is(err, sdk.ErrObjectNotFound) {
+				d.SetId("")
+				return diag.Diagnostics{
+					diag.Diagnostic{
+						Severity: diag.Warning,
+						Summary:  "Failed to query alert. Marking the resource as removed.",
+						Detail:   fmt.Sprintf("alert name: %s, Err: %s", id.FullyQualifiedName(), err),
+					},
+				}
+			}
+			return diag.FromErr(err)
 		}
-		return nil, alert.State == sdk.AlertStateStarted
-	}
-	return util.Retry(5, 10*time.Second, resumeAlert)
-}
 
-func waitSuspendAlert(ctx context.Context, client *sdk.Client, id sdk.SchemaObjectIdentifier) error {
-	suspendAlert := func() (error, bool) {
-		opts := sdk.AlterAlertOptions{Action: &sdk.AlertActionSuspend}
-		err := client.Alerts.Alter(ctx, id, &opts)
+		alertParameters, err := client.Alerts.ShowParameters(ctx, id)
 		if err != nil {
-			return fmt.Errorf("error suspending alert %v err = %w", id.Name(), err), false
+			return diag.FromErr(err)
 		}
-		alert, err := client.Alerts.ShowByID(ctx, id)
-		if err != nil {
-			return err, false
+
+		if withExternalChangesMarking {
+			if err = handleExternalChangesToObjectInShow(d,
+				outputMapping{"state", "started", string(alert.State), alert.State == sdk.AlertStateStarted, nil},
+			); err != nil {
+				return diag.FromErr(err)
+			}
+		} else {
+			if err = setStateToValuesFromConfig(d, alertSchema, []string{
+				"started",
+			}); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+
+		var warehouse string
+		if alert.Warehouse != "" {
+			warehouse = sdk.NewAccountObjectIdentifier(alert.Warehouse).Name()
+		}
+
+		if errs := errors.Join(
+			d.Set("warehouse", warehouse),
+			taskScheduleRead(d, alert.Schedule),
+			d.Set("condition", alert.Condition),
+			d.Set("action", alert.Action),
+			d.Set("comment", alert.Comment),
+			handleAlertParameterRead(d, alertParameters),
+			d.Set(FullyQualifiedNameAttributeName, id.FullyQualifiedName()),
+			d.Set(ShowOutputAttributeName, []map[string]any{schemas.AlertToSchema(alert)}),
+			d.Set(ParametersAttributeName, []map[string]any{schemas.AlertParametersToSchema(alertParameters)}),
+		); errs != nil {
+			return diag.FromErr(errs)
 		}
-		return nil, alert.State == sdk.AlertStateSuspended
+
+		return nil
+	}
+}
+
+func DeleteAlert(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseSchemaObjectIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
 	}
-	return util.Retry(5, 10*time.Second, suspendAlert)
+
+	if err := client.Alerts.Drop(ctx, id, &sdk.DropAlertOptions{IfExists: sdk.Bool(true)}); err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting alert %s err = %w", id.FullyQualifiedName(), err))
+	}
+
+	d.SetId("")
+	return nil
 }
@@ -1,101 +1,40 @@
 package resources_test
 
 import (
-	"bytes"
 	"fmt"
-	"strconv"
 	"testing"
-	"text/template"
 
 	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
-
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/helpers/random"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/testenvs"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 )
 
-type (
-	AccAlertTestSettings struct {
-		WarehouseName string
-		DatabaseName  string
-		Alert         *AlertSettings
-	}
-
-	AlertSettings struct {
-		Name      string
-		Enabled   bool
-		Schema    string
-		Condition string
-		Action    string
-		Schedule  int
-		Comment   string
-	}
-)
-
-func TestAcc_Alert(t *testing.T) {
+func TestAcc_Alert_BasicFlow(t *testing.T) {
 	_ = testenvs.GetOrSkipTest(t, testenvs.EnableAcceptance)
 	acc.TestAccPreCheck(t)
 
 	id := acc.TestClient().Ids.RandomSchemaObjectIdentifier()
+	warehouseId := acc.TestClient().Ids.WarehouseId()
+	comment := random.Comment()
+	resourceReference := "snowflake_alert.test"
 
-	alertInitialState := &AccAlertTestSettings{ //nolint
-		WarehouseName: acc.TestWarehouseName,
-		DatabaseName:  acc.TestDatabaseName,
-		Alert: &AlertSettings{
-			Name:      id.Name(),
-			Condition: "select 0 as c",
-			Action:    "select 0 as c",
-			Schema:    acc.TestSchemaName,
-			Enabled:   true,
-			Schedule:  5,
-			Comment:   "dummy",
-		},
-	}
-
-	// Changes: condition, action, comment, schedule.
-	alertStepOne := &AccAlertTestSettings{ //nolint
-		WarehouseName: acc.TestWarehouseName,
-		DatabaseName:  acc.TestDatabaseName,
-		Alert: &AlertSettings{
-			Name:      id.Name(),
-			Condition: "select 1 as c",
-			Action:    "select 1 as c",
-			Schema:    acc.TestSchemaName,
-			Enabled:   true,
-			Schedule:  15,
-			Comment:   "test",
-		},
-	}
-
-	// Changes: condition, action, comment, schedule.
-	alertStepTwo := &AccAlertTestSettings{ //nolint
-		WarehouseName: acc.TestWarehouseName,
-		DatabaseName:  acc.TestDatabaseName,
-		Alert: &AlertSettings{
-			Name:      id.Name(),
-			Condition: "select 2 as c",
-			Action:    "select 2 as c",
-			Schema:    acc.TestSchemaName,
-			Enabled:   true,
-			Schedule:  25,
-			Comment:   "text",
-		},
-	}
-
-	// Changes: condition, action, comment, schedule.
-	alertStepThree := &AccAlertTestSettings{ //nolint
-		WarehouseName: acc.TestWarehouseName,
-		DatabaseName:  acc.TestDatabaseName,
-		Alert: &AlertSettings{
-			Name:      id.Name(),
-			Condition: "select 2 as c",
-			Action:    "select 2 as c",
-			Schema:    acc.TestSchemaName,
-			Enabled:   false,
-			Schedule:  5,
-		},
+	assertAlertState := func(state sdk.AlertState) resource.TestCheckFunc {
+		return func(_ *terraform.State) error {
+			alert, err := acc.TestClient().Alert.Show(t, id)
+			if err != nil {
+				return err
+			}
+			if alert.State != state {
+				return fmt.Errorf("expected alert %s to be in state %s, got %s", id.FullyQualifiedName(), state, alert.State)
+			}
+			return nil
+		}
 	}
 
 	resource.Test(t, resource.TestCase{
@@ -106,106 +45,244 @@ func TestAcc_Alert(t *testing.T) {
 		},
 		CheckDestroy: acc.CheckDestroy(t, resources.Alert),
 		Steps: []resource.TestStep{
+			// create with a warehouse and an interval schedule
 			{
-				Config: alertConfig(alertInitialState),
+				Config: alertScheduledConfig(id, warehouseId, true, `minutes = 5`, "select 0 as c", "", false),
 				Check: resource.ComposeTestCheckFunc(
-					resource.TestCheckResourceAttr("snowflake_alert.test_alert", "enabled", strconv.FormatBool(alertInitialState.Alert.Enabled)),
-					resource.TestCheckResourceAttr("snowflake_alert.test_alert", "name", id.Name()),
-					resource.TestCheckResourceAttr("snowflake_alert.test_alert", "fully_qualified_name", id.FullyQualifiedName()),
-					resource.TestCheckResourceAttr("snowflake_alert.test_alert", "database", acc.TestDatabaseName),
-					resource.TestCheckResourceAttr("snowflake_alert.test_alert", "schema", acc.TestSchemaName),
-					resource.TestCheckResourceAttr("snowflake_alert.test_alert", "condition", alertInitialState.Alert.Condition),
-					resource.TestCheckResourceAttr("snowflake_alert.test_alert", "action", alertInitialState.Alert.Action),
-					resource.TestCheckResourceAttr("snowflake_alert.test_alert", "comment", alertInitialState.Alert.Comment),
-					resource.TestCheckResourceAttr("snowflake_alert.test_alert", "alert_schedule.0.interval", strconv.Itoa(alertInitialState.Alert.Schedule)),
+					resource.TestCheckResourceAttr(resourceReference, "name", id.Name()),
+					resource.TestCheckResourceAttr(resourceReference, "fully_qualified_name", id.FullyQualifiedName()),
+					resource.TestCheckResourceAttr(resourceReference, "database", id.DatabaseName()),
+					resource.TestCheckResourceAttr(resourceReference, "schema", id.SchemaName()),
+					resource.TestCheckResourceAttr(resourceReference, "started", "true"),
+					resource.TestCheckResourceAttr(resourceReference, "warehouse", warehouseId.Name()),
+					resource.TestCheckResourceAttr(resourceReference, "schedule.0.minutes", "5"),
+					resource.TestCheckResourceAttr(resourceReference, "condition", "select 0 as c"),
+					resource.TestCheckResourceAttr(resourceReference, "action", "select 0 as c"),
+					resource.TestCheckResourceAttr(resourceReference, "comment", ""),
+					resource.TestCheckResourceAttr(resourceReference, "show_output.#", "1"),
+					resource.TestCheckResourceAttr(resourceReference, "show_output.0.name", id.Name()),
+					resource.TestCheckResourceAttr(resourceReference, "show_output.0.state", string(sdk.AlertStateStarted)),
+					resource.TestCheckResourceAttr(resourceReference, "show_output.0.warehouse", warehouseId.Name()),
+					resource.TestCheckResourceAttr(resourceReference, "parameters.#", "1"),
+					resource.TestCheckResourceAttr(resourceReference, "parameters.0.suspend_task_after_num_failures.0.value", "10"),
+					assertAlertState(sdk.AlertStateStarted),
 				),
 			},
+			// import
+			{
+				ResourceName:            resourceReference,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"execute_alert"},
+			},
+			// update in place: cron schedule, condition, action, comment, parameters, execute after update
 			{
-				Config: alertConfig(alertStepOne),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceReference, plancheck.ResourceActionUpdate),
+					},
+				},
+				Config: alertScheduledConfig(id, warehouseId, true, `using_cron = "0 * * * * UTC"`, "select 1 as c", comment, true),
 				Check: resource.ComposeTestCheckFunc(
-					resource.TestCheckResourceAttr("snowflake_alert.test_alert", "enabled", strconv.FormatBool(alertStepOne.Alert.Enabled)),
-					resource.TestCheckResourceAttr("snowflake_alert.test_alert", "name", id.Name()),
-					resource.TestCheckResourceAttr("snowflake_alert.test_alert", "fully_qualified_name", id.FullyQualifiedName()),
-					resource.TestCheckResourceAttr("snowflake_alert.test_alert", "database", acc.TestDatabaseName),
-					resource.TestCheckResourceAttr("snowflake_alert.test_alert", "schema", acc.TestSchemaName),
-					resource.TestCheckResourceAttr("snowflake_alert.test_alert", "condition", alertStepOne.Alert.Condition),
-					resource.TestCheckResourceAttr("snowflake_alert.test_alert", "action", alertStepOne.Alert.Action),
-					resource.TestCheckResourceAttr("snowflake_alert.test_alert", "comment", alertStepOne.Alert.Comment),
-					resource.TestCheckResourceAttr("snowflake_alert.test_alert", "alert_schedule.0.interval", strconv.Itoa(alertStepOne.Alert.Schedule)),
+					resource.TestCheckResourceAttr(resourceReference, "schedule.0.using_cron", "0 * * * * UTC"),
+					resource.TestCheckResourceAttr(resourceReference, "condition", "select 1 as c"),
+					resource.TestCheckResourceAttr(resourceReference, "action", "select 1 as c"),
+					resource.TestCheckResourceAttr(resourceReference, "comment", comment),
+					resource.TestCheckResourceAttr(resourceReference, "suspend_task_after_num_failures", "3"),
+					resource.TestCheckResourceAttr(resourceReference, "parameters.0.suspend_task_after_num_failures.0.value", "3"),
+					resource.TestCheckResourceAttr(resourceReference, "parameters.0.suspend_task_after_num_failures.0.level", string(sdk.ParameterTypeAlert)),
+					assertAlertState(sdk.AlertStateStarted),
 				),
 			},
+			// suspend and make the alert serverless
 			{
-				Config: alertConfig(alertStepTwo),
+				Config: alertScheduledConfig(id, sdk.AccountObjectIdentifier{}, false, `using_cron = "0 * * * * UTC"`, "select 1 as c", comment, true),
 				Check: resource.ComposeTestCheckFunc(
-					resource.TestCheckResourceAttr("snowflake_alert.test_alert", "enabled", strconv.FormatBool(alertStepTwo.Alert.Enabled)),
-					resource.TestCheckResourceAttr("snowflake_alert.test_alert", "name", id.Name()),
-					resource.TestCheckResourceAttr("snowflake_alert.test_alert", "fully_qualified_name", id.FullyQualifiedName()),
-					resource.TestCheckResourceAttr("snowflake_alert.test_alert", "database", acc.TestDatabaseName),
-					resource.TestCheckResourceAttr("snowflake_alert.test_alert", "schema", acc.TestSchemaName),
-					resource.TestCheckResourceAttr("snowflake_alert.test_alert", "condition", alertStepTwo.Alert.Condition),
-					resource.TestCheckResourceAttr("snowflake_alert.test_alert", "action", alertStepTwo.Alert.Action),
-					resource.TestCheckResourceAttr("snowflake_alert.test_alert", "comment", alertStepTwo.Alert.Comment),
-					resource.TestCheckResourceAttr("snowflake_alert.test_alert", "alert_schedule.0.interval", strconv.Itoa(alertStepTwo.Alert.Schedule)),
+					resource.TestCheckResourceAttr(resourceReference, "started", "false"),
+					resource.TestCheckResourceAttr(resourceReference, "warehouse", ""),
+					resource.TestCheckResourceAttr(resourceReference, "show_output.0.warehouse", ""),
+					assertAlertState(sdk.AlertStateSuspended),
 				),
 			},
+			// external change to the state is detected
 			{
-				Config: alertConfig(alertStepThree),
+				PreConfig: func() {
+					acc.TestClient().Alert.Alter(t, id, &sdk.AlterAlertOptions{Action: &sdk.AlertActionResume})
+				},
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceReference, plancheck.ResourceActionUpdate),
+					},
+				},
+				Config: alertScheduledConfig(id, sdk.AccountObjectIdentifier{}, false, `using_cron = "0 * * * * UTC"`, "select 1 as c", comment, true),
 				Check: resource.ComposeTestCheckFunc(
-					resource.TestCheckResourceAttr("snowflake_alert.test_alert", "enabled", strconv.FormatBool(alertStepThree.Alert.Enabled)),
-					resource.TestCheckResourceAttr("snowflake_alert.test_alert", "name", id.Name()),
-					resource.TestCheckResourceAttr("snowflake_alert.test_alert", "fully_qualified_name", id.FullyQualifiedName()),
-					resource.TestCheckResourceAttr("snowflake_alert.test_alert", "database", acc.TestDatabaseName),
-					resource.TestCheckResourceAttr("snowflake_alert.test_alert", "schema", acc.TestSchemaName),
-					resource.TestCheckResourceAttr("snowflake_alert.test_alert", "condition", alertStepThree.Alert.Condition),
-					resource.TestCheckResourceAttr("snowflake_alert.test_alert", "action", alertStepThree.Alert.Action),
-					resource.TestCheckResourceAttr("snowflake_alert.test_alert", "comment", alertStepThree.Alert.Comment),
-					resource.TestCheckResourceAttr("snowflake_alert.test_alert", "alert_schedule.0.interval", strconv.Itoa(alertStepThree.Alert.Schedule)),
+					resource.TestCheckResourceAttr(resourceReference, "started", "false"),
+					assertAlertState(sdk.AlertStateSuspended),
 				),
 			},
+		},
+	})
+}
+
+func TestAcc_Alert_OnNewData(t *testing.T) {
+	_ = testenvs.GetOrSkipTest(t, testenvs.EnableAcceptance)
+	acc.TestAccPreCheck(t)
+
+	table, tableCleanup := acc.TestClient().Table.CreateWithChangeTracking(t)
+	t.Cleanup(tableCleanup)
+
+	id := acc.TestClient().Ids.RandomSchemaObjectIdentifier()
+	condition := fmt.Sprintf("select * from %s", table.ID().FullyQualifiedName())
+	resourceReference := "snowflake_alert.test"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: acc.CheckDestroy(t, resources.Alert),
+		Steps: []resource.TestStep{
+			// serverless alert on new data
 			{
-				Config: alertConfig(alertInitialState),
+				Config: alertOnNewDataConfig(id, condition),
 				Check: resource.ComposeTestCheckFunc(
-					resource.TestCheckResourceAttr("snowflake_alert.test_alert", "enabled", strconv.FormatBool(alertInitialState.Alert.Enabled)),
-					resource.TestCheckResourceAttr("snowflake_alert.test_alert", "name", id.Name()),
-					resource.TestCheckResourceAttr("snowflake_alert.test_alert", "fully_qualified_name", id.FullyQualifiedName()),
-					resource.TestCheckResourceAttr("snowflake_alert.test_alert", "database", acc.TestDatabaseName),
-					resource.TestCheckResourceAttr("snowflake_alert.test_alert", "schema", acc.TestSchemaName),
-					resource.TestCheckResourceAttr("snowflake_alert.test_alert", "condition", alertInitialState.Alert.Condition),
-					resource.TestCheckResourceAttr("snowflake_alert.test_alert", "action", alertInitialState.Alert.Action),
-					resource.TestCheckResourceAttr("snowflake_alert.test_alert", "comment", alertInitialState.Alert.Comment),
-					resource.TestCheckResourceAttr("snowflake_alert.test_alert", "alert_schedule.0.interval", strconv.Itoa(alertInitialState.Alert.Schedule)),
+					resource.TestCheckResourceAttr(resourceReference, "started", "true"),
+					resource.TestCheckResourceAttr(resourceReference, "warehouse", ""),
+					resource.TestCheckResourceAttr(resourceReference, "schedule.#", "0"),
+					resource.TestCheckResourceAttr(resourceReference, "condition", condition),
+					resource.TestCheckResourceAttr(resourceReference, "show_output.0.schedule", ""),
+				),
+			},
+			// adding a schedule recreates the alert
+			{
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceReference, plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+				Config: alertScheduledConfig(id, sdk.AccountObjectIdentifier{}, true, `minutes = 10`, condition, "", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceReference, "schedule.0.minutes", "10"),
 				),
 			},
 		},
 	})
 }
 
-func alertConfig(settings *AccAlertTestSettings) string { //nolint
-	config, err := template.New("alert_acceptance_test_config").Parse(`
-resource "snowflake_alert" "test_alert" {
-	name     	      = "{{ .Alert.Name }}"
-	database  	      = "{{ .DatabaseName }}"
-	schema   	      = "{{ .Alert.Schema }}"
-	warehouse 	      = "{{ .WarehouseName }}"
-	alert_schedule 	  {
-		interval = "{{ .Alert.Schedule }}"
-	}
-	condition         = "{{ .Alert.Condition }}"
-	action            = "{{ .Alert.Action }}"
-	enabled  	      = {{ .Alert.Enabled }}
-	comment           = "{{ .Alert.Comment }}"
+func TestAcc_Alert_migrateFromVersion_0_92_0(t *testing.T) {
+	_ = testenvs.GetOrSkipTest(t, testenvs.EnableAcceptance)
+	acc.TestAccPreCheck(t)
+
+	id := acc.TestClient().Ids.RandomSchemaObjectIdentifier()
+	warehouseId := acc.TestClient().Ids.WarehouseId()
+	resourceReference := "snowflake_alert.test_alert"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: acc.CheckDestroy(t, resources.Alert),
+		Steps: []resource.TestStep{
+			{
+				PreConfig:         func() { acc.SetV097CompatibleConfigPathEnv(t) },
+				ExternalProviders: acc.ExternalProviderWithExactVersion("0.92.0"),
+				Config:            alertV092Config(id, warehouseId),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceReference, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceReference, "alert_schedule.0.cron.0.expression", "0 * * * *"),
+				),
+			},
+			{
+				PreConfig:                func() { acc.UnsetConfigPathEnv(t) },
+				ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Config: alertIssue3117Config(id, warehouseId, "test_alert"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceReference, "id", id.FullyQualifiedName()),
+					resource.TestCheckResourceAttr(resourceReference, "started", "true"),
+					resource.TestCheckResourceAttr(resourceReference, "schedule.0.using_cron", "0 * * * * UTC"),
+				),
+			},
+		},
+	})
 }
-	`)
-	if err != nil {
-		fmt.Println(err)
-	}
 
-	var result bytes.Buffer
-	err = config.Execute(&result, settings) //nolint
-	if err != nil {
-		fmt.Println(err)
+func alertScheduledConfig(id sdk.SchemaObjectIdentifier, warehouseId sdk.AccountObjectIdentifier, started bool, schedule string, statement string, comment string, withParameters bool) string {
+	warehouseConfig := ""
+	if warehouseId.Name() != "" {
+		warehouseConfig = fmt.Sprintf(`warehouse = "%s"`, warehouseId.Name())
+	}
+	commentConfig := ""
+	if comment != "" {
+		commentConfig = fmt.Sprintf(`comment = "%s"`, comment)
 	}
-	return result.String()
+	parametersConfig := ""
+	if withParameters {
+		parametersConfig = `
+  suspend_task_after_num_failures = 3
+  execute_alert                   = true`
+	}
+	return fmt.Sprintf(`
+resource "snowflake_alert" "test" {
+  database  = "%[1]s"
+  schema    = "%[2]s"
+  name      = "%[3]s"
+  started   = %[4]t
+  %[5]s
+
+  schedule {
+    %[6]s
+  }
+
+  condition = "%[7]s"
+  action    = "%[7]s"
+  %[8]s
+  %[9]s
+}
+`, id.DatabaseName(), id.SchemaName(), id.Name(), started, warehouseConfig, schedule, statement, commentConfig, parametersConfig)
+}
+
+func alertOnNewDataConfig(id sdk.SchemaObjectIdentifier, condition string) string {
+	return fmt.Sprintf(`
+resource "snowflake_alert" "test" {
+  database  = "%[1]s"
+  schema    = "%[2]s"
+  name      = "%[3]s"
+  started   = true
+  condition = "%[4]s"
+  action    = "select 1"
+}
+`, id.DatabaseName(), id.SchemaName(), id.Name(), condition)
+}
+
+func alertV092Config(id sdk.SchemaObjectIdentifier, warehouseId sdk.AccountObjectIdentifier) string {
+	return fmt.Sprintf(`
+resource "snowflake_alert" "test_alert" {
+  database  = "%[1]s"
+  schema    = "%[2]s"
+  name      = "%[3]s"
+  warehouse = "%[4]s"
+
+  alert_schedule {
+    cron {
+      expression = "0 * * * *"
+      time_zone  = "UTC"
+    }
+  }
+
+  action    = "select 0 as c"
+  condition = "select 0 as c"
+
+  enabled   = true
+  comment   = "Alert config for GH issue 3117"
+}
+`, id.DatabaseName(), id.SchemaName(), id.Name(), warehouseId.Name())
 }
 
 // Can't reproduce the issue, leaving the test for now.
@@ -224,7 +301,7 @@ func TestAcc_Alert_Issue3117(t *testing.T) {
 			{
 				PreConfig:         func() { acc.SetV097CompatibleConfigPathEnv(t) },
 				ExternalProviders: acc.ExternalProviderWithExactVersion("0.92.0"),
-				Config:            alertIssue3117Config(id, acc.TestClient().Ids.WarehouseId(), "test_alert"),
+				Config:            alertV092Config(id, acc.TestClient().Ids.WarehouseId()),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("snowflake_alert.test_alert", "name", id.Name()),
 				),
@@ -318,14 +395,14 @@ resource "snowflake_alert" "%[5]s" {
   name      = "%[3]s"
   warehouse = "%[4]s"
 
-  alert_schedule {
-    interval = 1 #check every minute for new alerts
+  schedule {
+    using_cron = "0 * * * * UTC"
   }
 
   action    = "select 0 as c"
   condition = "select 0 as c"
 
-  enabled   = true
+  started   = true
   comment   = "Alert config for GH issue 3117"
 }
 `, alertId.DatabaseName(), alertId.SchemaName(), alertId.Name(), warehouseId.Name(), resourceName)
@@ -0,0 +1,83 @@
+package resources
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var (
+	alertParametersSchema     = make(map[string]*schema.Schema)
+	alertParametersCustomDiff = ParametersCustomDiff(
+		alertParametersProvider,
+		parameter[sdk.AlertParameter]{sdk.AlertParameterSuspendTaskAfterNumFailures, valueTypeInt, sdk.ParameterTypeAlert},
+		parameter[sdk.AlertParameter]{sdk.AlertParameterUserTaskTimeoutMs, valueTypeInt, sdk.ParameterTypeAlert},
+	)
+)
+
+func init() {
+	alertParameterFields := []parameterDef[sdk.AlertParameter]{
+		{Name: sdk.AlertParameterSuspendTaskAfterNumFailures, Type: schema.TypeInt, ValidateDiag: validation.ToDiagFunc(validation.IntAtLeast(0)), Description: "Specifies the number of consecutive failed alert evaluations after which the alert is suspended automatically. The default is 0 (no automatic suspension)."},
+		{Name: sdk.AlertParameterUserTaskTimeoutMs, Type: schema.TypeInt, ValidateDiag: validation.ToDiagFunc(validation.IntAtLeast(0)), Description: "Specifies the time limit on a single evaluation of the alert before it times out (in milliseconds)."},
+	}
+
+	for _, field := range alertParameterFields {
+		fieldName := strings.ToLower(string(field.Name))
+
+		alertParametersSchema[fieldName] = &schema.Schema{
+			Type:             field.Type,
+			Description:      enrichWithReferenceToParameterDocs(field.Name, field.Description),
+			Computed:         true,
+			Optional:         true,
+			ValidateDiagFunc: field.ValidateDiag,
+			DiffSuppressFunc: field.DiffSuppress,
+		}
+	}
+}
+
+func alertParametersProvider(ctx context.Context, d ResourceIdProvider, meta any) ([]*sdk.Parameter, error) {
+	return parametersProvider(ctx, d, meta.(*provider.Context), alertParametersProviderFunc, sdk.ParseSchemaObjectIdentifier)
+}
+
+func alertParametersProviderFunc(c *sdk.Client) showParametersFunc[sdk.SchemaObjectIdentifier] {
+	return c.Alerts.ShowParameters
+}
+
+func handleAlertParameterRead(d *schema.ResourceData, alertParameters []*sdk.Parameter) error {
+	for _, p := range alertParameters {
+		switch p.Key {
+		case
+			string(sdk.AlertParameterSuspendTaskAfterNumFailures),
+			string(sdk.AlertParameterUserTaskTimeoutMs):
+			value, err := strconv.Atoi(p.Value)
+			if err != nil {
+				return err
+			}
+			if err := d.Set(strings.ToLower(p.Key), value); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func handleAlertParametersCreate(d *schema.ResourceData, createOpts *sdk.CreateAlertOptions) diag.Diagnostics {
+	return JoinDiags(
+		handleParameterCreate(d, sdk.AlertParameterSuspendTaskAfterNumFailures, &createOpts.SuspendTaskAfterNumFailures),
+		handleParameterCreate(d, sdk.AlertParameterUserTaskTimeoutMs, &createOpts.UserTaskTimeoutMs),
+	)
+}
+
+func handleAlertParametersUpdate(d *schema.ResourceData, set *sdk.AlertSet, unset *sdk.AlertUnset) diag.Diagnostics {
+	return JoinDiags(
+		handleParameterUpdate(d, sdk.AlertParameterSuspendTaskAfterNumFailures, &set.SuspendTaskAfterNumFailures, &unset.SuspendTaskAfterNumFailures),
+		handleParameterUpdate(d, sdk.AlertParameterUserTaskTimeoutMs, &set.UserTaskTimeoutMs, &unset.UserTaskTimeoutMs),
+	)
+}
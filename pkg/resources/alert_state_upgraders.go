@@ -0,0 +1,39 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+)
+
+// v1_1_0_AlertStateUpgrader migrates the alert state from before the rework: enabled is renamed to started,
+// alert_schedule is replaced with schedule, and the resource id becomes a fully qualified name.
+func v1_1_0_AlertStateUpgrader(ctx context.Context, rawState map[string]any, meta any) (map[string]any, error) {
+	if rawState == nil {
+		return rawState, nil
+	}
+
+	if enabled, ok := rawState["enabled"].(bool); ok {
+		rawState["started"] = enabled
+	}
+	delete(rawState, "enabled")
+
+	delete(rawState, "schedule")
+	if alertSchedule, ok := rawState["alert_schedule"].([]any); ok && len(alertSchedule) == 1 {
+		if alertScheduleMap, ok := alertSchedule[0].(map[string]any); ok {
+			scheduleMap := make(map[string]any)
+			if cron, ok := alertScheduleMap["cron"].([]any); ok && len(cron) == 1 {
+				if cronMap, ok := cron[0].(map[string]any); ok {
+					scheduleMap["using_cron"] = fmt.Sprintf("%s %s", cronMap["expression"], cronMap["time_zone"])
+				}
+			} else if interval, ok := alertScheduleMap["interval"].(float64); ok && interval > 0 {
+				scheduleMap["minutes"] = int(interval)
+			}
+			if len(scheduleMap) > 0 {
+				rawState["schedule"] = []any{scheduleMap}
+			}
+		}
+	}
+	delete(rawState, "alert_schedule")
+
+	return migratePipeSeparatedObjectIdentifierResourceIdToFullyQualifiedName(ctx, rawState, meta)
+}
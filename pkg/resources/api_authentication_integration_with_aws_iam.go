@@ -0,0 +1,262 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/schemas"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var apiAuthAwsIamSchema = map[string]*schema.Schema{
+	"name": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		Description:      blocklistedCharactersFieldDescription("Specifies the identifier (i.e. name) for the integration. This value must be unique in your account."),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+	},
+	"enabled": {
+		Type:        schema.TypeBool,
+		Required:    true,
+		Description: "Specifies whether this security integration is enabled or disabled.",
+	},
+	"aws_role_arn": {
+		Type:             schema.TypeString,
+		Required:         true,
+		Description:      "Specifies the Amazon Resource Name (ARN) of the AWS Identity and Access Management (IAM) role that grants privileges on the AWS resources accessed through the integration.",
+		DiffSuppressFunc: IgnoreChangeToCurrentSnowflakeListValueInDescribe("aws_role_arn"),
+	},
+	"comment": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies a comment for the integration.",
+	},
+	ShowOutputAttributeName: {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Outputs the result of `SHOW SECURITY INTEGRATIONS` for the given security integration.",
+		Elem: &schema.Resource{
+			Schema: schemas.ShowSecurityIntegrationSchema,
+		},
+	},
+	DescribeOutputAttributeName: {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Outputs the result of `DESCRIBE SECURITY INTEGRATIONS` for the given security integration.",
+		Elem: &schema.Resource{
+			Schema: schemas.DescribeApiAuthAwsIamSecurityIntegrationSchema,
+		},
+	},
+	FullyQualifiedNameAttributeName: schemas.FullyQualifiedNameSchema,
+}
+
+func ApiAuthenticationIntegrationWithAwsIam() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: PreviewFeatureCreateContextWrapper(string(previewfeatures.ApiAuthenticationIntegrationWithAwsIamResource), TrackingCreateWrapper(resources.ApiAuthenticationIntegrationWithAwsIam, CreateContextApiAuthenticationIntegrationWithAwsIam)),
+		ReadContext:   PreviewFeatureReadContextWrapper(string(previewfeatures.ApiAuthenticationIntegrationWithAwsIamResource), TrackingReadWrapper(resources.ApiAuthenticationIntegrationWithAwsIam, ReadContextApiAuthenticationIntegrationWithAwsIam(true))),
+		UpdateContext: PreviewFeatureUpdateContextWrapper(string(previewfeatures.ApiAuthenticationIntegrationWithAwsIamResource), TrackingUpdateWrapper(resources.ApiAuthenticationIntegrationWithAwsIam, UpdateContextApiAuthenticationIntegrationWithAwsIam)),
+		DeleteContext: PreviewFeatureDeleteContextWrapper(string(previewfeatures.ApiAuthenticationIntegrationWithAwsIamResource), TrackingDeleteWrapper(resources.ApiAuthenticationIntegrationWithAwsIam, DeleteContextApiAuthenticationIntegrationWithAwsIam)),
+		Description:   "Resource used to manage api authentication security integration objects with AWS IAM authentication. For more information, check [security integrations documentation](https://docs.snowflake.com/en/sql-reference/sql/create-security-integration-api-auth).",
+
+		Schema: apiAuthAwsIamSchema,
+		CustomizeDiff: TrackingCustomDiffWrapper(resources.ApiAuthenticationIntegrationWithAwsIam, customdiff.All(
+			ComputedIfAnyAttributeChanged(apiAuthAwsIamSchema, ShowOutputAttributeName, "enabled", "comment"),
+			ComputedIfAnyAttributeChanged(apiAuthAwsIamSchema, DescribeOutputAttributeName, "enabled", "comment", "aws_role_arn"),
+		)),
+		Importer: &schema.ResourceImporter{
+			StateContext: TrackingImportWrapper(resources.ApiAuthenticationIntegrationWithAwsIam, ImportApiAuthenticationWithAwsIam),
+		},
+		Timeouts: defaultTimeouts,
+	}
+}
+
+func ImportApiAuthenticationWithAwsIam(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseAccountObjectIdentifier(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	integration, err := client.SecurityIntegrations.ShowByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	properties, err := client.SecurityIntegrations.Describe(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := ImportName[sdk.AccountObjectIdentifier](ctx, d, nil); err != nil {
+		return nil, err
+	}
+	if err := d.Set("enabled", integration.Enabled); err != nil {
+		return nil, err
+	}
+	if err := d.Set("comment", integration.Comment); err != nil {
+		return nil, err
+	}
+	awsRoleArn, err := collections.FindFirst(properties, func(property sdk.SecurityIntegrationProperty) bool { return property.Name == "AWS_ROLE_ARN" })
+	if err == nil {
+		if err = d.Set("aws_role_arn", awsRoleArn.Value); err != nil {
+			return nil, err
+		}
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func CreateContextApiAuthenticationIntegrationWithAwsIam(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseAccountObjectIdentifier(d.Get("name").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	req := sdk.NewCreateApiAuthenticationWithAwsIamSecurityIntegrationRequest(id, d.Get("enabled").(bool), d.Get("aws_role_arn").(string))
+	if v, ok := d.GetOk("comment"); ok {
+		req.WithComment(v.(string))
+	}
+
+	if err := client.SecurityIntegrations.CreateApiAuthenticationWithAwsIam(ctx, req); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(helpers.EncodeResourceIdentifier(id))
+	return ReadContextApiAuthenticationIntegrationWithAwsIam(false)(ctx, d, meta)
+}
+
+func ReadContextApiAuthenticationIntegrationWithAwsIam(withExternalChangesMarking bool) schema.ReadContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+		client := meta.(*provider.Context).Client
+		id, err := sdk.ParseAccountObjectIdentifier(d.Id())
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		integration, err := client.SecurityIntegrations.ShowByID(ctx, id)
+		if err != nil {
+			if errors.Is(err, sdk.ErrObjectNotFound) {
+				d.SetId("")
+				return diag.Diagnostics{
+					diag.Diagnostic{
+						Severity: diag.Warning,
+						Summary:  "Failed to query security integration. Marking the resource as removed.",
+						Detail:   fmt.Sprintf("Security integration name: %s, Err: %s", id.FullyQualifiedName(), err),
+					},
+				}
+			}
+			return diag.FromErr(err)
+		}
+		properties, err := client.SecurityIntegrations.Describe(ctx, id)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		if c := integration.Category; c != sdk.SecurityIntegrationCategory {
+			return diag.FromErr(fmt.Errorf("expected %v to be a %s integration, got %v", id, sdk.SecurityIntegrationCategory, c))
+		}
+		if err := d.Set(FullyQualifiedNameAttributeName, id.FullyQualifiedName()); err != nil {
+			return diag.FromErr(err)
+		}
+		if err := d.Set("enabled", integration.Enabled); err != nil {
+			return diag.FromErr(err)
+		}
+		if err := d.Set("comment", integration.Comment); err != nil {
+			return diag.FromErr(err)
+		}
+
+		if withExternalChangesMarking {
+			awsRoleArn, err := collections.FindFirst(properties, func(property sdk.SecurityIntegrationProperty) bool { return property.Name == "AWS_ROLE_ARN" })
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			if err = handleExternalChangesToObjectInDescribe(d,
+				describeMapping{"aws_role_arn", "aws_role_arn", awsRoleArn.Value, awsRoleArn.Value, nil},
+			); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+		if err := setStateToValuesFromConfig(d, apiAuthAwsIamSchema, []string{
+			"aws_role_arn",
+		}); err != nil {
+			return diag.FromErr(err)
+		}
+
+		if err := d.Set(ShowOutputAttributeName, []map[string]any{schemas.SecurityIntegrationToSchema(integration)}); err != nil {
+			return diag.FromErr(err)
+		}
+		if err := d.Set(DescribeOutputAttributeName, []map[string]any{schemas.ApiAuthAwsIamSecurityIntegrationPropertiesToSchema(properties)}); err != nil {
+			return diag.FromErr(err)
+		}
+		return nil
+	}
+}
+
+func UpdateContextApiAuthenticationIntegrationWithAwsIam(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseAccountObjectIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	set, unset := sdk.NewApiAuthenticationWithAwsIamIntegrationSetRequest(), sdk.NewApiAuthenticationWithAwsIamIntegrationUnsetRequest()
+
+	if d.HasChange("enabled") {
+		set.WithEnabled(d.Get("enabled").(bool))
+	}
+	if d.HasChange("aws_role_arn") {
+		set.WithAwsRoleArn(d.Get("aws_role_arn").(string))
+	}
+	if d.HasChange("comment") {
+		if v := d.Get("comment").(string); len(v) > 0 {
+			set.WithComment(v)
+		} else {
+			unset.WithComment(true)
+		}
+	}
+
+	if !reflect.DeepEqual(*set, sdk.ApiAuthenticationWithAwsIamIntegrationSetRequest{}) {
+		if err := client.SecurityIntegrations.AlterApiAuthenticationWithAwsIam(ctx, sdk.NewAlterApiAuthenticationWithAwsIamSecurityIntegrationRequest(id).WithSet(*set)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if !reflect.DeepEqual(*unset, sdk.ApiAuthenticationWithAwsIamIntegrationUnsetRequest{}) {
+		if err := client.SecurityIntegrations.AlterApiAuthenticationWithAwsIam(ctx, sdk.NewAlterApiAuthenticationWithAwsIamSecurityIntegrationRequest(id).WithUnset(*unset)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return ReadContextApiAuthenticationIntegrationWithAwsIam(false)(ctx, d, meta)
+}
+
+func DeleteContextApiAuthenticationIntegrationWithAwsIam(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseAccountObjectIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := client.SecurityIntegrations.Drop(ctx, sdk.NewDropSecurityIntegrationRequest(id).WithIfExists(true)); err != nil {
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Error deleting integration",
+				Detail:   fmt.Sprintf("id %v err = %v", id.Name(), err),
+			},
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
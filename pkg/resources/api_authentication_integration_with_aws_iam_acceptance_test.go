@@ -0,0 +1,137 @@
+package resources_test
+
+import (
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	accconfig "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/model"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/helpers/random"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/testenvs"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_ApiAuthenticationIntegrationWithAwsIam_basic(t *testing.T) {
+	_ = testenvs.GetOrSkipTest(t, testenvs.EnableAcceptance)
+	acc.TestAccPreCheck(t)
+
+	id := acc.TestClient().Ids.RandomAccountObjectIdentifier()
+	awsRoleArn := "arn:aws:iam::123456789012:role/terraform-provider-snowflake"
+	otherAwsRoleArn := "arn:aws:iam::123456789012:role/terraform-provider-snowflake-other"
+	comment := random.Comment()
+
+	modelBasic := model.ApiAuthenticationIntegrationWithAwsIam("test", awsRoleArn, false, id.Name())
+	modelComplete := model.ApiAuthenticationIntegrationWithAwsIam("test", otherAwsRoleArn, true, id.Name()).
+		WithComment(comment)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: acc.CheckDestroy(t, resources.ApiAuthenticationIntegrationWithAwsIam),
+		Steps: []resource.TestStep{
+			// create with only required fields
+			{
+				Config: accconfig.FromModels(t, modelBasic),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "id", helpers.EncodeResourceIdentifier(id)),
+					resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "name", id.Name()),
+					resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "fully_qualified_name", id.FullyQualifiedName()),
+					resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "enabled", "false"),
+					resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "aws_role_arn", awsRoleArn),
+					resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "comment", ""),
+
+					resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "show_output.#", "1"),
+					resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "show_output.0.name", id.Name()),
+					resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "show_output.0.integration_type", "API_AUTHENTICATION"),
+					resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "show_output.0.category", "SECURITY"),
+					resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "show_output.0.enabled", "false"),
+					resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "show_output.0.comment", ""),
+					resource.TestCheckResourceAttrSet(modelBasic.ResourceReference(), "show_output.0.created_on"),
+
+					resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "describe_output.#", "1"),
+					resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "describe_output.0.enabled.0.value", "false"),
+					resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "describe_output.0.auth_type.0.value", "AWS_IAM"),
+					resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "describe_output.0.aws_role_arn.0.value", awsRoleArn),
+					resource.TestCheckResourceAttrSet(modelBasic.ResourceReference(), "describe_output.0.api_aws_iam_user_arn.0.value"),
+					resource.TestCheckResourceAttrSet(modelBasic.ResourceReference(), "describe_output.0.api_aws_external_id.0.value"),
+					resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "describe_output.0.comment.0.value", ""),
+				),
+			},
+			// import - without optionals
+			{
+				Config:            accconfig.FromModels(t, modelBasic),
+				ResourceName:      modelBasic.ResourceReference(),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// set optionals
+			{
+				Config: accconfig.FromModels(t, modelComplete),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(modelComplete.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(modelComplete.ResourceReference(), "enabled", "true"),
+					resource.TestCheckResourceAttr(modelComplete.ResourceReference(), "aws_role_arn", otherAwsRoleArn),
+					resource.TestCheckResourceAttr(modelComplete.ResourceReference(), "comment", comment),
+
+					resource.TestCheckResourceAttr(modelComplete.ResourceReference(), "show_output.0.enabled", "true"),
+					resource.TestCheckResourceAttr(modelComplete.ResourceReference(), "show_output.0.comment", comment),
+					resource.TestCheckResourceAttr(modelComplete.ResourceReference(), "describe_output.0.enabled.0.value", "true"),
+					resource.TestCheckResourceAttr(modelComplete.ResourceReference(), "describe_output.0.aws_role_arn.0.value", otherAwsRoleArn),
+					resource.TestCheckResourceAttr(modelComplete.ResourceReference(), "describe_output.0.comment.0.value", comment),
+				),
+			},
+			// import - complete
+			{
+				Config:            accconfig.FromModels(t, modelComplete),
+				ResourceName:      modelComplete.ResourceReference(),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// external change is detected
+			{
+				PreConfig: func() {
+					acc.TestClient().SecurityIntegration.UpdateApiAuthenticationWithAwsIam(t, sdk.NewAlterApiAuthenticationWithAwsIamSecurityIntegrationRequest(id).WithSet(
+						*sdk.NewApiAuthenticationWithAwsIamIntegrationSetRequest().WithAwsRoleArn(awsRoleArn),
+					))
+				},
+				Config: accconfig.FromModels(t, modelComplete),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(modelComplete.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(modelComplete.ResourceReference(), "aws_role_arn", otherAwsRoleArn),
+					resource.TestCheckResourceAttr(modelComplete.ResourceReference(), "describe_output.0.aws_role_arn.0.value", otherAwsRoleArn),
+				),
+			},
+			// unset optionals
+			{
+				Config: accconfig.FromModels(t, modelBasic),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(modelBasic.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "enabled", "false"),
+					resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "aws_role_arn", awsRoleArn),
+					resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "comment", ""),
+					resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "show_output.0.comment", ""),
+				),
+			},
+		},
+	})
+}
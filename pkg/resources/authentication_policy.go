@@ -59,10 +59,37 @@ var authenticationPolicySchema = map[string]*schema.Schema{
 	"mfa_enrollment": {
 		Type:             schema.TypeString,
 		Optional:         true,
-		Description:      "Determines whether a user must enroll in multi-factor authentication. Allowed values are REQUIRED and OPTIONAL. When REQUIRED is specified, Enforces users to enroll in MFA. If this value is used, then the CLIENT_TYPES parameter must include SNOWFLAKE_UI, because Snowsight is the only place users can enroll in multi-factor authentication (MFA).",
+		Description:      fmt.Sprintf("Determines whether a user must enroll in multi-factor authentication. Allowed values are %s. When REQUIRED is specified, Enforces users to enroll in MFA. If this value is used, then the CLIENT_TYPES parameter must include SNOWFLAKE_UI, because Snowsight is the only place users can enroll in multi-factor authentication (MFA). REQUIRED_PASSWORD_ONLY enforces MFA enrollment only for users that authenticate with a password. REQUIRED_SNOWFLAKE_UI_PASSWORD_ONLY enforces MFA enrollment only for users that authenticate with a password in Snowsight.", possibleValuesListed(sdk.AllMfaEnrollmentOptions)),
 		ValidateDiagFunc: sdkValidation(sdk.ToMfaEnrollmentOption),
 		Default:          "OPTIONAL",
 	},
+	"mfa_policy": {
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"allowed_methods": {
+					Type: schema.TypeSet,
+					Elem: &schema.Schema{
+						Type:             schema.TypeString,
+						ValidateDiagFunc: sdkValidation(sdk.ToMfaPolicyAllowedMethodsOption),
+					},
+					Optional:     true,
+					Description:  fmt.Sprintf("Specifies the MFA methods that users can use as a second factor of authentication. Allowed values are %s.", possibleValuesListed(sdk.AllMfaPolicyAllowedMethods)),
+					AtLeastOneOf: []string{"mfa_policy.0.allowed_methods", "mfa_policy.0.enforce_mfa_on_external_authentication"},
+				},
+				"enforce_mfa_on_external_authentication": {
+					Type:             schema.TypeString,
+					Optional:         true,
+					ValidateDiagFunc: sdkValidation(sdk.ToEnforceMfaOnExternalAuthenticationOption),
+					Description:      fmt.Sprintf("Determines whether multi-factor authentication (MFA) is enforced on external authentication, like SAML or OAuth. Allowed values are %s.", possibleValuesListed(sdk.AllEnforceMfaOnExternalAuthenticationOptions)),
+					AtLeastOneOf:     []string{"mfa_policy.0.allowed_methods", "mfa_policy.0.enforce_mfa_on_external_authentication"},
+				},
+			},
+		},
+		Description: "Specifies the multi-factor authentication (MFA) policy that applies to users assigned to the authentication policy.",
+	},
 	"client_types": {
 		Type: schema.TypeSet,
 		Elem: &schema.Schema{
@@ -216,6 +243,14 @@ func CreateContextAuthenticationPolicy(ctx context.Context, d *schema.ResourceDa
 		req = req.WithMfaEnrollment(*option)
 	}
 
+	if _, ok := d.GetOk("mfa_policy"); ok {
+		mfaPolicy, err := authenticationPolicyMfaPolicyFromConfig(d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		req.WithMfaPolicy(*mfaPolicy)
+	}
+
 	if v, ok := d.GetOk("client_types"); ok {
 		clientTypesRawList := expandStringList(v.(*schema.Set).List())
 		clientTypes := make([]sdk.ClientTypes, len(clientTypesRawList))
@@ -296,6 +331,17 @@ func ReadContextAuthenticationPolicy(ctx context.Context, d *schema.ResourceData
 		}
 	}
 
+	mfaPolicy, err := collections.FindFirst(authenticationPolicyDescriptions, func(prop sdk.AuthenticationPolicyDescription) bool { return prop.Property == "MFA_POLICY" })
+	if err == nil {
+		mfaPolicyDetails, err := sdk.ParseAuthenticationPolicyMfaPolicy(mfaPolicy.Value)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if err = d.Set("mfa_policy", authenticationPolicyMfaPolicyToSchema(d, mfaPolicyDetails)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	clientTypes := getListArgumentWithDefaults(d, "client_types", getListParameterFromDescribe(authenticationPolicyDescriptions, "CLIENT_TYPES"), []string{"ALL"})
 	if err = d.Set("client_types", clientTypes); err != nil {
 		return diag.FromErr(err)
@@ -324,6 +370,30 @@ func ReadContextAuthenticationPolicy(ctx context.Context, d *schema.ResourceData
 	return diags
 }
 
+func authenticationPolicyMfaPolicyFromConfig(d *schema.ResourceData) (*sdk.AuthenticationPolicyMfaPolicyRequest, error) {
+	mfaPolicy := sdk.NewAuthenticationPolicyMfaPolicyRequest()
+	if v, ok := d.GetOk("mfa_policy.0.allowed_methods"); ok {
+		allowedMethodsRawList := expandStringList(v.(*schema.Set).List())
+		allowedMethods := make([]sdk.MfaPolicyAllowedMethod, len(allowedMethodsRawList))
+		for i, v := range allowedMethodsRawList {
+			option, err := sdk.ToMfaPolicyAllowedMethodsOption(v)
+			if err != nil {
+				return nil, err
+			}
+			allowedMethods[i] = sdk.MfaPolicyAllowedMethod{Method: *option}
+		}
+		mfaPolicy.WithAllowedMethods(allowedMethods)
+	}
+	if v, ok := d.GetOk("mfa_policy.0.enforce_mfa_on_external_authentication"); ok {
+		option, err := sdk.ToEnforceMfaOnExternalAuthenticationOption(v.(string))
+		if err != nil {
+			return nil, err
+		}
+		mfaPolicy.WithEnforceMfaOnExternalAuthentication(*option)
+	}
+	return mfaPolicy, nil
+}
+
 func getListParameterFromDescribe(authenticationPolicyDescriptions []sdk.AuthenticationPolicyDescription, parameterName string) []string {
 	parameterList := make([]string, 0)
 	if parameterProperty, err := collections.FindFirst(authenticationPolicyDescriptions, func(prop sdk.AuthenticationPolicyDescription) bool {
@@ -335,6 +405,30 @@ func getListParameterFromDescribe(authenticationPolicyDescriptions []sdk.Authent
 }
 
 // getListArgumentWithDefaults returns the list of values for a given argument, with the defaults applied, if necessary. Otherwise, tf plan will always show a diff with a list parameter with defaults when no value is set.
+func authenticationPolicyMfaPolicyToSchema(d *schema.ResourceData, mfaPolicy *sdk.AuthenticationPolicyMfaPolicyDetails) []map[string]any {
+	allowedMethods := make([]string, len(mfaPolicy.AllowedMethods))
+	for i, method := range mfaPolicy.AllowedMethods {
+		allowedMethods[i] = string(method)
+	}
+	// in case nothing is set in the tf resource and the is equals the default, we set the is to empty
+	if stringSlicesEqual(allowedMethods, []string{string(sdk.MfaPolicyAllowedMethodsAll)}) && d.Get("mfa_policy.0.allowed_methods").(*schema.Set).Len() == 0 {
+		allowedMethods = []string{}
+	}
+	enforceMfaOnExternalAuthentication := string(mfaPolicy.EnforceMfaOnExternalAuthentication)
+	if mfaPolicy.EnforceMfaOnExternalAuthentication == sdk.EnforceMfaOnExternalAuthenticationNone && d.Get("mfa_policy.0.enforce_mfa_on_external_authentication").(string) == "" {
+		enforceMfaOnExternalAuthentication = ""
+	}
+	if len(allowedMethods) == 0 && enforceMfaOnExternalAuthentication == "" {
+		return []map[string]any{}
+	}
+	return []map[string]any{
+		{
+			"allowed_methods":                        allowedMethods,
+			"enforce_mfa_on_external_authentication": enforceMfaOnExternalAuthentication,
+		},
+	}
+}
+
 func getListArgumentWithDefaults(d *schema.ResourceData, argumentName string, argumentIs []string, argumentDefaults []string) []string {
 	// in case nothing is set in the tf resource and the is equals the default, we set the is to empty
 	argumentShould := d.Get(argumentName).(*schema.Set).List()
@@ -416,6 +510,26 @@ func UpdateContextAuthenticationPolicy(ctx context.Context, d *schema.ResourceDa
 		}
 	}
 
+	// change to mfa policy
+	if d.HasChange("mfa_policy") {
+		if _, ok := d.GetOk("mfa_policy"); ok {
+			mfaPolicy, err := authenticationPolicyMfaPolicyFromConfig(d)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			// the fields removed from the config are set back to their defaults, because SET MFA_POLICY does not reset them
+			if mfaPolicy.AllowedMethods == nil {
+				mfaPolicy.WithAllowedMethods([]sdk.MfaPolicyAllowedMethod{{Method: sdk.MfaPolicyAllowedMethodsAll}})
+			}
+			if mfaPolicy.EnforceMfaOnExternalAuthentication == nil {
+				mfaPolicy.WithEnforceMfaOnExternalAuthentication(sdk.EnforceMfaOnExternalAuthenticationNone)
+			}
+			set.WithMfaPolicy(*mfaPolicy)
+		} else {
+			unset.WithMfaPolicy(true)
+		}
+	}
+
 	// change to client types
 	if d.HasChange("client_types") {
 		if v, ok := d.GetOk("client_types"); ok {
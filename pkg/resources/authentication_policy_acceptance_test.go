@@ -1,14 +1,18 @@
 package resources_test
 
 import (
+	"fmt"
+	"regexp"
 	"testing"
 
 	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/testenvs"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	"github.com/hashicorp/terraform-plugin-testing/config"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 )
 
@@ -83,3 +87,131 @@ func TestAcc_AuthenticationPolicy(t *testing.T) {
 		},
 	})
 }
+
+func TestAcc_AuthenticationPolicy_MfaPolicy(t *testing.T) {
+	_ = testenvs.GetOrSkipTest(t, testenvs.EnableAcceptance)
+	acc.TestAccPreCheck(t)
+
+	id := acc.TestClient().Ids.RandomSchemaObjectIdentifier()
+	resourceName := "snowflake_authentication_policy.authentication_policy"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: acc.CheckDestroy(t, resources.AuthenticationPolicy),
+		Steps: []resource.TestStep{
+			{
+				Config: authenticationPolicyMfaPolicyConfig(id, "REQUIRED_PASSWORD_ONLY", `mfa_policy {
+    allowed_methods                        = ["PASSKEY", "TOTP"]
+    enforce_mfa_on_external_authentication = "ALL"
+  }`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "mfa_enrollment", "REQUIRED_PASSWORD_ONLY"),
+					resource.TestCheckResourceAttr(resourceName, "mfa_policy.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "mfa_policy.0.allowed_methods.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "mfa_policy.0.allowed_methods.*", "PASSKEY"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "mfa_policy.0.allowed_methods.*", "TOTP"),
+					resource.TestCheckResourceAttr(resourceName, "mfa_policy.0.enforce_mfa_on_external_authentication", "ALL"),
+					resource.TestCheckResourceAttrSet(resourceName, "describe_output.0.mfa_policy"),
+				),
+			},
+			// external change
+			{
+				PreConfig: func() {
+					acc.TestClient().AuthenticationPolicy.Alter(t, sdk.NewAlterAuthenticationPolicyRequest(id).WithSet(*sdk.NewAuthenticationPolicySetRequest().
+						WithMfaPolicy(*sdk.NewAuthenticationPolicyMfaPolicyRequest().
+							WithAllowedMethods([]sdk.MfaPolicyAllowedMethod{{Method: sdk.MfaPolicyAllowedMethodsDuo}}).
+							WithEnforceMfaOnExternalAuthentication(sdk.EnforceMfaOnExternalAuthenticationNone),
+						),
+					))
+				},
+				Config: authenticationPolicyMfaPolicyConfig(id, "REQUIRED_PASSWORD_ONLY", `mfa_policy {
+    allowed_methods                        = ["PASSKEY", "TOTP"]
+    enforce_mfa_on_external_authentication = "ALL"
+  }`),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "mfa_policy.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "mfa_policy.0.allowed_methods.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "mfa_policy.0.allowed_methods.*", "PASSKEY"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "mfa_policy.0.allowed_methods.*", "TOTP"),
+					resource.TestCheckResourceAttr(resourceName, "mfa_policy.0.enforce_mfa_on_external_authentication", "ALL"),
+				),
+			},
+			{
+				Config: authenticationPolicyMfaPolicyConfig(id, "REQUIRED_SNOWFLAKE_UI_PASSWORD_ONLY", `mfa_policy {
+    enforce_mfa_on_external_authentication = "NONE"
+  }`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "mfa_enrollment", "REQUIRED_SNOWFLAKE_UI_PASSWORD_ONLY"),
+					resource.TestCheckResourceAttr(resourceName, "mfa_policy.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "mfa_policy.0.allowed_methods.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "mfa_policy.0.enforce_mfa_on_external_authentication", "NONE"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"mfa_enrollment", "mfa_policy"},
+			},
+			{
+				Config: authenticationPolicyMfaPolicyConfig(id, "OPTIONAL", ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "mfa_enrollment", "OPTIONAL"),
+					resource.TestCheckResourceAttr(resourceName, "mfa_policy.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAcc_AuthenticationPolicy_MfaPolicy_Validations(t *testing.T) {
+	_ = testenvs.GetOrSkipTest(t, testenvs.EnableAcceptance)
+	acc.TestAccPreCheck(t)
+
+	id := acc.TestClient().Ids.RandomSchemaObjectIdentifier()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      authenticationPolicyMfaPolicyConfig(id, "OPTIONAL", `mfa_policy {}`),
+				ExpectError: regexp.MustCompile(`one of\s*` + "`" + `mfa_policy.0.allowed_methods,mfa_policy.0.enforce_mfa_on_external_authentication` + "`"),
+			},
+			{
+				Config: authenticationPolicyMfaPolicyConfig(id, "OPTIONAL", `mfa_policy {
+    allowed_methods = ["SMS"]
+  }`),
+				ExpectError: regexp.MustCompile("invalid MFA policy allowed method: SMS"),
+			},
+			{
+				Config:      authenticationPolicyMfaPolicyConfig(id, "REQUIRED_ALWAYS", ""),
+				ExpectError: regexp.MustCompile("invalid enrollment option type: REQUIRED_ALWAYS"),
+			},
+		},
+	})
+}
+
+func authenticationPolicyMfaPolicyConfig(id sdk.SchemaObjectIdentifier, mfaEnrollment string, mfaPolicy string) string {
+	return fmt.Sprintf(`
+resource "snowflake_authentication_policy" "authentication_policy" {
+  database       = "%[1]s"
+  schema         = "%[2]s"
+  name           = "%[3]s"
+  mfa_enrollment = "%[4]s"
+  client_types   = ["SNOWFLAKE_UI", "DRIVERS"]
+  %[5]s
+}
+`, id.DatabaseName(), id.SchemaName(), id.Name(), mfaEnrollment, mfaPolicy)
+}
@@ -0,0 +1,138 @@
+package resources
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// cloneTimeTravelSchema returns the schema of the AT or BEFORE block nested in the clone block available under clonePath (e.g. "from_clone.0").
+func cloneTimeTravelSchema(clonePath string, attributeName string, conflictingAttributeName string, description string) *schema.Schema {
+	timeTravelPath := fmt.Sprintf("%s.%s.0", clonePath, attributeName)
+	exactlyOneOf := []string{timeTravelPath + ".timestamp", timeTravelPath + ".offset", timeTravelPath + ".statement"}
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		ForceNew:    true,
+		MaxItems:    1,
+		Description: externalChangesNotDetectedFieldDescription(description),
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"timestamp": {
+					Type:             schema.TypeString,
+					Optional:         true,
+					ForceNew:         true,
+					Description:      "Specifies an exact date and time to use for Time Travel in the RFC 3339 format (e.g. `2024-06-01T12:00:00Z`).",
+					ValidateDiagFunc: validation.ToDiagFunc(validation.IsRFC3339Time),
+					ExactlyOneOf:     exactlyOneOf,
+				},
+				"offset": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ForceNew:     true,
+					Description:  "Specifies the difference in seconds from the current time to use for Time Travel, in the form -N where N is an integer (e.g. -120 is 120 seconds).",
+					ValidateFunc: validation.IntAtMost(-1),
+					ExactlyOneOf: exactlyOneOf,
+				},
+				"statement": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ForceNew:     true,
+					Description:  "Specifies the query ID of a statement to use as the reference point for Time Travel.",
+					ExactlyOneOf: exactlyOneOf,
+				},
+			},
+		},
+		ConflictsWith: []string{fmt.Sprintf("%s.%s", clonePath, conflictingAttributeName)},
+	}
+}
+
+// getCloneTimeTravel returns the Time Travel point described by the AT or BEFORE block of the clone block; nil is returned when the block is not set.
+func getCloneTimeTravel(v any) (*sdk.TimeTravel, error) {
+	timeTravelList, ok := v.([]any)
+	if !ok || len(timeTravelList) == 0 || timeTravelList[0] == nil {
+		return nil, nil
+	}
+	timeTravelConfig := timeTravelList[0].(map[string]any)
+	timeTravel := &sdk.TimeTravel{}
+	if timestamp := timeTravelConfig["timestamp"].(string); timestamp != "" {
+		parsed, err := time.Parse(time.RFC3339, timestamp)
+		if err != nil {
+			return nil, err
+		}
+		timeTravel.Timestamp = sdk.Pointer(parsed)
+	}
+	if offset := timeTravelConfig["offset"].(int); offset != 0 {
+		timeTravel.Offset = sdk.Int(offset)
+	}
+	if statement := timeTravelConfig["statement"].(string); statement != "" {
+		timeTravel.Statement = sdk.String(statement)
+	}
+	return timeTravel, nil
+}
+
+// objectCloneSchema returns the schema of the from_clone field of the resources managing objects cloned together with their children (databases and schemas).
+func objectCloneSchema(objectName string, sourceDescription string, sourceValidation schema.SchemaValidateDiagFunc) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		ForceNew:    true,
+		MaxItems:    1,
+		Description: externalChangesNotDetectedFieldDescription(fmt.Sprintf("Creates the %[1]s as a zero-copy clone of another %[1]s (`CREATE %[2]s ... CLONE`), optionally at or before a Time Travel point. Changing this field recreates the %[1]s.", objectName, strings.ToUpper(objectName))),
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"source": {
+					Type:             schema.TypeString,
+					Required:         true,
+					ForceNew:         true,
+					Description:      sourceDescription,
+					DiffSuppressFunc: suppressIdentifierQuoting,
+					ValidateDiagFunc: sourceValidation,
+				},
+				AtAttributeName:     cloneTimeTravelSchema("from_clone.0", AtAttributeName, BeforeAttributeName, fmt.Sprintf("Clones the %s as it was at the given point in time.", objectName)),
+				BeforeAttributeName: cloneTimeTravelSchema("from_clone.0", BeforeAttributeName, AtAttributeName, fmt.Sprintf("Clones the %s as it was immediately before the given point in time.", objectName)),
+				"ignore_tables_with_insufficient_data_retention": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					ForceNew:    true,
+					Default:     false,
+					Description: "Skips the tables that no longer have historical data available in Time Travel at the given point in time, instead of failing the whole clone.",
+				},
+			},
+		},
+	}
+}
+
+// getObjectClone returns the clone definition from the from_clone field created with objectCloneSchema; nil is returned when the field is not set.
+func getObjectClone(d *schema.ResourceData, parseSource func(string) (sdk.ObjectIdentifier, error)) (*sdk.Clone, error) {
+	v, ok := d.GetOk("from_clone")
+	if !ok || len(v.([]any)) == 0 || v.([]any)[0] == nil {
+		return nil, nil
+	}
+	cloneConfig := v.([]any)[0].(map[string]any)
+	sourceId, err := parseSource(cloneConfig["source"].(string))
+	if err != nil {
+		return nil, err
+	}
+	at, err := getCloneTimeTravel(cloneConfig[AtAttributeName])
+	if err != nil {
+		return nil, err
+	}
+	before, err := getCloneTimeTravel(cloneConfig[BeforeAttributeName])
+	if err != nil {
+		return nil, err
+	}
+	clone := &sdk.Clone{
+		SourceObject: sourceId,
+		At:           at,
+		Before:       before,
+	}
+	if cloneConfig["ignore_tables_with_insufficient_data_retention"].(bool) {
+		clone.IgnoreTablesWithInsufficientDataRetention = sdk.Bool(true)
+	}
+	return clone, nil
+}
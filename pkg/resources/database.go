@@ -35,8 +35,9 @@ var databaseSchema = map[string]*schema.Schema{
 	"drop_public_schema_on_creation": {
 		Type:             schema.TypeBool,
 		Optional:         true,
-		Description:      "Specifies whether to drop public schema on creation or not. Modifying the parameter after database is already created won't have any effect.",
+		Description:      "Specifies whether to drop public schema on creation or not. Modifying the parameter after database is already created won't have any effect. Cannot be used together with `from_clone`.",
 		DiffSuppressFunc: IgnoreAfterCreation,
+		ConflictsWith:    []string{"from_clone"},
 	},
 	"is_transient": {
 		Type:        schema.TypeBool,
@@ -88,6 +89,7 @@ var databaseSchema = map[string]*schema.Schema{
 		Optional:    true,
 		Description: "Specifies a comment for the database.",
 	},
+	"from_clone":                    objectCloneSchema("database", relatedResourceDescription("Name of the database to clone.", resources.Database), IsValidIdentifier[sdk.AccountObjectIdentifier]()),
 	FullyQualifiedNameAttributeName: schemas.FullyQualifiedNameSchema,
 }
 
@@ -137,6 +139,11 @@ func CreateDatabase(ctx context.Context, d *schema.ResourceData, meta any) diag.
 	if parametersCreateDiags := handleDatabaseParametersCreate(d, opts); len(parametersCreateDiags) > 0 {
 		return parametersCreateDiags
 	}
+	clone, err := getObjectClone(d, func(source string) (sdk.ObjectIdentifier, error) { return sdk.ParseAccountObjectIdentifier(source) })
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	opts.Clone = clone
 
 	err = client.Databases.Create(ctx, id, opts)
 	if err != nil {
@@ -145,6 +152,27 @@ func CreateDatabase(ctx context.Context, d *schema.ResourceData, meta any) diag.
 
 	d.SetId(helpers.EncodeResourceIdentifier(id))
 
+	if clone != nil {
+		// the clone inherits the comment and the parameters of the source database
+		set, unset := new(sdk.DatabaseSet), new(sdk.DatabaseUnset)
+		if diags := handleDatabaseParametersAfterClone(d, set, unset); diags != nil {
+			return diags
+		}
+		if comment := GetConfigPropertyAsPointerAllowingZeroValue[string](d, "comment"); comment != nil {
+			set.Comment = comment
+		} else {
+			unset.Comment = sdk.Bool(true)
+		}
+		if (*set != sdk.DatabaseSet{}) {
+			if err := client.Databases.Alter(ctx, id, &sdk.AlterDatabaseOptions{Set: set}); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+		if err := client.Databases.Alter(ctx, id, &sdk.AlterDatabaseOptions{Unset: unset}); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	var diags diag.Diagnostics
 
 	if d.Get("drop_public_schema_on_creation").(bool) {
@@ -2,6 +2,7 @@ package resources_test
 
 import (
 	"fmt"
+	"regexp"
 	"strconv"
 	"testing"
 
@@ -24,6 +25,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/config"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 	"github.com/stretchr/testify/require"
 )
@@ -1354,3 +1356,112 @@ func databaseConfigBasicWithExternalVolumeAndCatalog(databaseName string, extern
 		catalog = "%v"
 	}`, databaseName, externalVolumeName, catalogName)
 }
+
+func TestAcc_Database_FromClone(t *testing.T) {
+	_ = testenvs.GetOrSkipTest(t, testenvs.EnableAcceptance)
+	acc.TestAccPreCheck(t)
+
+	sourceDatabase, sourceDatabaseCleanup := acc.TestClient().Database.CreateDatabaseWithOptions(t, acc.TestClient().Ids.RandomAccountObjectIdentifier(), &sdk.CreateDatabaseOptions{
+		Comment:                    sdk.String(random.Comment()),
+		MaxDataExtensionTimeInDays: sdk.Int(20),
+	})
+	t.Cleanup(sourceDatabaseCleanup)
+	sourceSchema, sourceSchemaCleanup := acc.TestClient().Schema.CreateSchemaInDatabase(t, sourceDatabase.ID())
+	t.Cleanup(sourceSchemaCleanup)
+	sourceTable, sourceTableCleanup := acc.TestClient().Table.CreateInSchema(t, sourceSchema.ID())
+	t.Cleanup(sourceTableCleanup)
+
+	id := acc.TestClient().Ids.RandomAccountObjectIdentifier()
+	clonedTableId := sdk.NewSchemaObjectIdentifier(id.Name(), sourceSchema.Name, sourceTable.Name)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: acc.CheckDestroy(t, resources.Database),
+		Steps: []resource.TestStep{
+			{
+				Config: databaseFromCloneConfig(id, sourceDatabase.ID(), false),
+				Check: assertThat(t,
+					assert.Check(resource.TestCheckResourceAttr("snowflake_database.test", "name", id.Name())),
+					assert.Check(resource.TestCheckResourceAttr("snowflake_database.test", "from_clone.#", "1")),
+					assert.Check(resource.TestCheckResourceAttr("snowflake_database.test", "from_clone.0.source", sourceDatabase.ID().Name())),
+					assert.Check(resource.TestCheckResourceAttr("snowflake_database.test", "from_clone.0.ignore_tables_with_insufficient_data_retention", "false")),
+					assert.Check(func(_ *terraform.State) error {
+						_, err := acc.TestClient().Table.Show(t, clonedTableId)
+						return err
+					}),
+					// the comment and the parameters inherited from the source database are unset
+					assert.Check(resource.TestCheckResourceAttr("snowflake_database.test", "comment", "")),
+					objectparametersassert.DatabaseParameters(t, id).HasMaxDataExtensionTimeInDaysLevel(sdk.ParameterTypeSnowflakeDefault),
+				),
+			},
+			{
+				Config: databaseFromCloneConfig(id, sourceDatabase.ID(), false),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			// changing the clone definition recreates the database
+			{
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("snowflake_database.test", plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+				Config: databaseFromCloneConfig(id, sourceDatabase.ID(), true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_database.test", "from_clone.0.ignore_tables_with_insufficient_data_retention", "true"),
+				),
+			},
+		},
+	})
+}
+
+func databaseFromCloneConfig(id sdk.AccountObjectIdentifier, sourceId sdk.AccountObjectIdentifier, ignoreTablesWithInsufficientDataRetention bool) string {
+	return fmt.Sprintf(`
+resource "snowflake_database" "test" {
+	name = "%[1]s"
+
+	from_clone {
+		source = "%[2]s"
+		ignore_tables_with_insufficient_data_retention = %[3]t
+	}
+}
+`, id.Name(), sourceId.Name(), ignoreTablesWithInsufficientDataRetention)
+}
+
+func TestAcc_Database_FromClone_Validations(t *testing.T) {
+	_ = testenvs.GetOrSkipTest(t, testenvs.EnableAcceptance)
+	acc.TestAccPreCheck(t)
+
+	id := acc.TestClient().Ids.RandomAccountObjectIdentifier()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "snowflake_database" "test" {
+	name                           = "%[1]s"
+	drop_public_schema_on_creation = true
+
+	from_clone {
+		source = "%[2]s"
+	}
+}
+`, id.Name(), acc.TestClient().Ids.DatabaseId().Name()),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`"drop_public_schema_on_creation": conflicts with from_clone`),
+			},
+		},
+	})
+}
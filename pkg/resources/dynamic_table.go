@@ -2,49 +2,68 @@ package resources
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"log"
 	"regexp"
 	"strings"
-	"time"
 
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-
-	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/schemas"
-
-	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
-var refreshModePattern = regexp.MustCompile(`refresh_mode = '(\w+)'`)
+var (
+	refreshModePattern = regexp.MustCompile(`refresh_mode = '(\w+)'`)
+	transientPattern   = regexp.MustCompile(`(?i)\btransient\s+dynamic\s+table\b`)
+)
 
 var dynamicTableSchema = map[string]*schema.Schema{
-	"or_replace": {
-		Type:        schema.TypeBool,
-		Optional:    true,
-		Description: "Specifies whether to replace the dynamic table if it already exists.",
-		Default:     false,
+	"database": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		DiffSuppressFunc: suppressIdentifierQuoting,
+		Description:      blocklistedCharactersFieldDescription("The database in which to create the dynamic table."),
+	},
+	"schema": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		DiffSuppressFunc: suppressIdentifierQuoting,
+		Description:      blocklistedCharactersFieldDescription("The schema in which to create the dynamic table."),
 	},
 	"name": {
-		Type:        schema.TypeString,
-		Required:    true,
-		Description: "Specifies the identifier (i.e. name) for the dynamic table; must be unique for the schema in which the dynamic table is created.",
+		Type:             schema.TypeString,
+		Required:         true,
+		DiffSuppressFunc: suppressIdentifierQuoting,
+		Description:      blocklistedCharactersFieldDescription("Specifies the identifier (i.e. name) for the dynamic table; must be unique for the schema in which the dynamic table is created."),
+	},
+	"is_transient": {
+		Type:        schema.TypeBool,
+		Optional:    true,
 		ForceNew:    true,
+		Default:     false,
+		Description: "Specifies whether the dynamic table is transient. Transient dynamic tables don't have a Fail-safe period.",
 	},
-	"database": {
-		Type:        schema.TypeString,
-		Required:    true,
-		Description: "The database in which to create the dynamic table.",
-	},
-	"schema": {
-		Type:        schema.TypeString,
-		Required:    true,
-		Description: "The schema in which to create the dynamic table.",
+	"started": {
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  true,
+		DiffSuppressFunc: IgnoreChangeToCurrentSnowflakeValueInShowWithMapping("scheduling_state", func(state any) any {
+			return sdk.DynamicTableSchedulingState(state.(string)) == sdk.DynamicTableSchedulingStateActive
+		}),
+		Description: "Specifies if the dynamic table should be started (resumed) or suspended. Suspended dynamic tables are not refreshed.",
 	},
 	"target_lag": {
 		Type:        schema.TypeList,
@@ -69,16 +88,17 @@ var dynamicTableSchema = map[string]*schema.Schema{
 		},
 	},
 	"warehouse": {
-		Type:        schema.TypeString,
-		Required:    true,
-		Description: "The warehouse in which to create the dynamic table.",
+		Type:             schema.TypeString,
+		Required:         true,
+		ValidateDiagFunc: IsValidIdentifier[sdk.AccountObjectIdentifier](),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+		Description:      relatedResourceDescription("The warehouse in which to create the dynamic table.", resources.Warehouse),
 	},
 	"query": {
 		Type:             schema.TypeString,
 		Required:         true,
-		ForceNew:         true,
-		Description:      "Specifies the query to use to populate the dynamic table.",
 		DiffSuppressFunc: DiffSuppressStatement,
+		Description:      "Specifies the query to use to populate the dynamic table. Changing the query alters the dynamic table in place with `CREATE OR ALTER DYNAMIC TABLE`.",
 	},
 	"comment": {
 		Type:        schema.TypeString,
@@ -101,203 +121,128 @@ var dynamicTableSchema = map[string]*schema.Schema{
 		ValidateFunc: validation.StringInSlice(sdk.AsStringList(sdk.AllDynamicTableInitializes), true),
 		ForceNew:     true,
 	},
-	"created_on": {
-		Type:        schema.TypeString,
-		Description: "Time when this dynamic table was created.",
-		Computed:    true,
-	},
-	"cluster_by": {
-		Type:        schema.TypeString,
-		Description: "The clustering key for the dynamic table.",
-		Computed:    true,
-	},
-	"rows": {
-		Type:        schema.TypeInt,
-		Description: "Number of rows in the table.",
-		Computed:    true,
-	},
-	"bytes": {
-		Type:        schema.TypeInt,
-		Description: "Number of bytes that will be scanned if the entire dynamic table is scanned in a query.",
-		Computed:    true,
-	},
-	"owner": {
+	"immutable_where": {
 		Type:        schema.TypeString,
-		Description: "Role that owns the dynamic table.",
-		Computed:    true,
-	},
-	"refresh_mode_reason": {
-		Type:        schema.TypeString,
-		Description: "Explanation for why FULL refresh mode was chosen. NULL if refresh mode is not FULL.",
-		Computed:    true,
-	},
-	"automatic_clustering": {
-		Type:        schema.TypeBool,
-		Description: "Whether auto-clustering is enabled on the dynamic table. Not currently supported for dynamic tables.",
-		Computed:    true,
+		Optional:    true,
+		Description: externalChangesNotDetectedFieldDescription("Specifies a condition that defines the immutable region of the dynamic table. Rows matching the condition are not updated by refreshes. The condition is provided without the surrounding parentheses, e.g. `ts < '2025-01-01'`."),
 	},
-	"scheduling_state": {
-		Type:        schema.TypeString,
-		Description: "Displays ACTIVE for dynamic tables that are actively scheduling refreshes and SUSPENDED for suspended dynamic tables.",
-		Computed:    true,
+	"backfill_from": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		ForceNew:         true,
+		ValidateDiagFunc: IsValidIdentifier[sdk.SchemaObjectIdentifier](),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+		Description:      externalChangesNotDetectedFieldDescription("Specifies the fully qualified name of a table from which the data of the dynamic table is backfilled on creation. Can only be set on creation."),
 	},
-	"last_suspended_on": {
-		Type:        schema.TypeString,
-		Description: "Timestamp of last suspension.",
-		Computed:    true,
+	"row_access_policy": {
+		Type:     schema.TypeList,
+		MaxItems: 1,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"policy_name": {
+					Type:             schema.TypeString,
+					Required:         true,
+					DiffSuppressFunc: suppressIdentifierQuoting,
+					ValidateDiagFunc: IsValidIdentifier[sdk.SchemaObjectIdentifier](),
+					Description:      relatedResourceDescription("Row access policy name.", resources.RowAccessPolicy),
+				},
+				"on": {
+					Type:     schema.TypeSet,
+					Required: true,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+					Description: "Defines which columns are affected by the policy.",
+				},
+			},
+		},
+		Description: "Specifies the row access policy to set on a dynamic table.",
 	},
-	"is_clone": {
-		Type:        schema.TypeBool,
-		Description: "TRUE if the dynamic table has been cloned, else FALSE.",
-		Computed:    true,
+	"tag": {
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: "Definitions of a tag to associate with the dynamic table. Only the values of the tags defined here are read from Snowflake; the other tags set on the dynamic table are not tracked.",
+		Elem:        tagReferenceSchema.Elem,
 	},
-	"is_replica": {
-		Type:        schema.TypeBool,
-		Description: "TRUE if the dynamic table is a replica. else FALSE.",
+	FullyQualifiedNameAttributeName: schemas.FullyQualifiedNameSchema,
+	ShowOutputAttributeName: {
+		Type:        schema.TypeList,
 		Computed:    true,
+		Description: "Outputs the result of `SHOW DYNAMIC TABLES` for the given dynamic table.",
+		Elem: &schema.Resource{
+			Schema: schemas.ShowDynamicTableSchema,
+		},
 	},
-	"data_timestamp": {
-		Type:        schema.TypeString,
-		Description: "Timestamp of the data in the base object(s) that is included in the dynamic table.",
+	ParametersAttributeName: {
+		Type:        schema.TypeList,
 		Computed:    true,
+		Description: "Outputs the result of `SHOW PARAMETERS IN TABLE` for the given dynamic table.",
+		Elem: &schema.Resource{
+			Schema: schemas.ShowDynamicTableParametersSchema,
+		},
 	},
-	FullyQualifiedNameAttributeName: schemas.FullyQualifiedNameSchema,
 }
 
 // DynamicTable returns a pointer to the resource representing a dynamic table.
 func DynamicTable() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: PreviewFeatureCreateContextWrapper(string(previewfeatures.DynamicTableResource), TrackingCreateWrapper(resources.DynamicTable, CreateDynamicTable)),
-		ReadContext:   PreviewFeatureReadContextWrapper(string(previewfeatures.DynamicTableResource), TrackingReadWrapper(resources.DynamicTable, ReadDynamicTable)),
+		ReadContext:   PreviewFeatureReadContextWrapper(string(previewfeatures.DynamicTableResource), TrackingReadWrapper(resources.DynamicTable, ReadDynamicTable(true))),
 		UpdateContext: PreviewFeatureUpdateContextWrapper(string(previewfeatures.DynamicTableResource), TrackingUpdateWrapper(resources.DynamicTable, UpdateDynamicTable)),
 		DeleteContext: PreviewFeatureDeleteContextWrapper(string(previewfeatures.DynamicTableResource), TrackingDeleteWrapper(resources.DynamicTable, DeleteDynamicTable)),
+		Description:   "Resource used to manage dynamic table objects. For more information, check [dynamic table documentation](https://docs.snowflake.com/en/user-guide/dynamic-tables-about).",
 
-		Schema: dynamicTableSchema,
+		Schema: collections.MergeMaps(dynamicTableSchema, dynamicTableParametersSchema),
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: TrackingImportWrapper(resources.DynamicTable, ImportDynamicTable),
+		},
+
+		CustomizeDiff: TrackingCustomDiffWrapper(resources.DynamicTable, customdiff.All(
+			ComputedIfAnyAttributeChanged(dynamicTableSchema, ShowOutputAttributeName, "name", "started", "target_lag", "warehouse", "query", "comment", "refresh_mode"),
+			ComputedIfAnyAttributeChanged(dynamicTableParametersSchema, ParametersAttributeName, collections.Map(sdk.AsStringList(sdk.AllDynamicTableParameters), strings.ToLower)...),
+			ComputedIfAnyAttributeChanged(dynamicTableSchema, FullyQualifiedNameAttributeName, "name"),
+			dynamicTableParametersCustomDiff,
+		)),
+
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Version: 0,
+				// setting to empty object to not affect all the existing resources in the state
+				Type:    cty.EmptyObject,
+				Upgrade: v1_1_0_DynamicTableStateUpgrader,
+			},
 		},
 		Timeouts: defaultTimeouts,
 	}
 }
 
-// ReadDynamicTable implements schema.ReadFunc.
-func ReadDynamicTable(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+func ImportDynamicTable(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
 	client := meta.(*provider.Context).Client
 
-	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
-	dynamicTable, err := client.DynamicTables.ShowByID(ctx, id)
+	id, err := sdk.ParseSchemaObjectIdentifier(d.Id())
 	if err != nil {
-		log.Printf("[DEBUG] dynamic table (%s) not found", d.Id())
-		d.SetId("")
-		return nil
-	}
-	if err := d.Set(FullyQualifiedNameAttributeName, id.FullyQualifiedName()); err != nil {
-		return diag.FromErr(err)
-	}
-	if err := d.Set("name", dynamicTable.Name); err != nil {
-		return diag.FromErr(err)
-	}
-	if err := d.Set("database", dynamicTable.DatabaseName); err != nil {
-		return diag.FromErr(err)
-	}
-	if err := d.Set("schema", dynamicTable.SchemaName); err != nil {
-		return diag.FromErr(err)
-	}
-	if err := d.Set("warehouse", dynamicTable.Warehouse); err != nil {
-		return diag.FromErr(err)
-	}
-	if err := d.Set("comment", dynamicTable.Comment); err != nil {
-		return diag.FromErr(err)
-	}
-	tl := map[string]interface{}{}
-	if dynamicTable.TargetLag == "DOWNSTREAM" {
-		tl["downstream"] = true
-		if err := d.Set("target_lag", []interface{}{tl}); err != nil {
-			return diag.FromErr(err)
-		}
-	} else {
-		tl["maximum_duration"] = dynamicTable.TargetLag
-		if err := d.Set("target_lag", []interface{}{tl}); err != nil {
-			return diag.FromErr(err)
-		}
-	}
-	if strings.Contains(dynamicTable.Text, "OR REPLACE") {
-		if err := d.Set("or_replace", true); err != nil {
-			return diag.FromErr(err)
-		}
-	} else {
-		if err := d.Set("or_replace", false); err != nil {
-			return diag.FromErr(err)
-		}
-	}
-	if strings.Contains(dynamicTable.Text, "initialize = 'ON_CREATE'") {
-		if err := d.Set("initialize", "ON_CREATE"); err != nil {
-			return diag.FromErr(err)
-		}
-	} else if strings.Contains(dynamicTable.Text, "initialize = 'ON_SCHEDULE'") {
-		if err := d.Set("initialize", "ON_SCHEDULE"); err != nil {
-			return diag.FromErr(err)
-		}
-	}
-	m := refreshModePattern.FindStringSubmatch(dynamicTable.Text)
-	if len(m) > 1 {
-		if err := d.Set("refresh_mode", m[1]); err != nil {
-			return diag.FromErr(err)
-		}
-	}
-	if err := d.Set("created_on", dynamicTable.CreatedOn.Format(time.RFC3339)); err != nil {
-		return diag.FromErr(err)
-	}
-	if err := d.Set("cluster_by", dynamicTable.ClusterBy); err != nil {
-		return diag.FromErr(err)
-	}
-	if err := d.Set("rows", dynamicTable.Rows); err != nil {
-		return diag.FromErr(err)
-	}
-	if err := d.Set("bytes", dynamicTable.Bytes); err != nil {
-		return diag.FromErr(err)
-	}
-	if err := d.Set("owner", dynamicTable.Owner); err != nil {
-		return diag.FromErr(err)
-	}
-	if err := d.Set("refresh_mode_reason", dynamicTable.RefreshModeReason); err != nil {
-		return diag.FromErr(err)
-	}
-	if err := d.Set("automatic_clustering", dynamicTable.AutomaticClustering); err != nil {
-		return diag.FromErr(err)
-	}
-	if err := d.Set("scheduling_state", string(dynamicTable.SchedulingState)); err != nil {
-		return diag.FromErr(err)
-	}
-	/*
-		guides on time formatting
-		https://docs.snowflake.com/en/user-guide/date-time-input-output
-		https://pkg.go.dev/time
-		note: format may depend on what the account parameter for TIMESTAMP_OUTPUT_FORMAT is set to. Perhaps we should return this as a string rather than a time.Time?
-	*/
-	if err := d.Set("last_suspended_on", dynamicTable.LastSuspendedOn.Format("2006-01-02T16:04:05.000 -0700")); err != nil {
-		return diag.FromErr(err)
-	}
-	if err := d.Set("is_clone", dynamicTable.IsClone); err != nil {
-		return diag.FromErr(err)
-	}
-	if err := d.Set("is_replica", dynamicTable.IsReplica); err != nil {
-		return diag.FromErr(err)
-	}
-	if err := d.Set("data_timestamp", dynamicTable.DataTimestamp.Format("2006-01-02T16:04:05.000 -0700")); err != nil {
-		return diag.FromErr(err)
+		return nil, err
 	}
 
-	extractor := snowflake.NewViewSelectStatementExtractor(dynamicTable.Text)
-	query, err := extractor.ExtractDynamicTable()
+	dynamicTable, err := client.DynamicTables.ShowByID(ctx, id)
 	if err != nil {
-		return diag.FromErr(err)
+		return nil, err
 	}
-	if err := d.Set("query", query); err != nil {
-		return diag.FromErr(err)
+
+	if _, err := ImportName[sdk.SchemaObjectIdentifier](context.Background(), d, nil); err != nil {
+		return nil, err
 	}
 
-	return nil
+	if err := errors.Join(
+		d.Set("started", dynamicTable.SchedulingState == sdk.DynamicTableSchedulingStateActive),
+		d.Set("is_transient", transientPattern.MatchString(dynamicTable.Text)),
+	); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
 }
 
 func parseTargetLag(v interface{}) sdk.TargetLag {
@@ -313,7 +258,30 @@ func parseTargetLag(v interface{}) sdk.TargetLag {
 	return result
 }
 
-// CreateDynamicTable implements schema.CreateFunc.
+// dynamicTableCreateRequest builds the request shared by CREATE and CREATE OR ALTER. CREATE OR ALTER unsets
+// every property that is omitted in the statement, so all the properties that can be altered in place are set here.
+func dynamicTableCreateRequest(d *schema.ResourceData, id sdk.SchemaObjectIdentifier) (*sdk.CreateDynamicTableRequest, diag.Diagnostics) {
+	warehouse := sdk.NewAccountObjectIdentifier(d.Get("warehouse").(string))
+	request := sdk.NewCreateDynamicTableRequest(id, warehouse, parseTargetLag(d.Get("target_lag")), d.Get("query").(string))
+
+	if d.Get("is_transient").(bool) {
+		request.WithTransient(true)
+	}
+	if v, ok := d.GetOk("comment"); ok {
+		request.WithComment(sdk.String(v.(string)))
+	}
+	if v, ok := d.GetOk("refresh_mode"); ok {
+		request.WithRefreshMode(sdk.DynamicTableRefreshMode(v.(string)))
+	}
+	if v, ok := d.GetOk("immutable_where"); ok {
+		request.WithImmutableWhere(v.(string))
+	}
+	if diags := handleDynamicTableParametersCreate(d, request); len(diags) > 0 {
+		return nil, diags
+	}
+	return request, nil
+}
+
 func CreateDynamicTable(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	client := meta.(*provider.Context).Client
 
@@ -322,80 +290,313 @@ func CreateDynamicTable(ctx context.Context, d *schema.ResourceData, meta any) d
 	name := d.Get("name").(string)
 	id := sdk.NewSchemaObjectIdentifier(databaseName, schemaName, name)
 
-	warehouse := sdk.NewAccountObjectIdentifier(d.Get("warehouse").(string))
-	tl := parseTargetLag(d.Get("target_lag"))
-	query := d.Get("query").(string)
-
-	request := sdk.NewCreateDynamicTableRequest(id, warehouse, tl, query)
-	if v, ok := d.GetOk("comment"); ok {
-		request.WithComment(sdk.String(v.(string)))
-	}
-	if v, ok := d.GetOk("or_replace"); ok && v.(bool) {
-		request.WithOrReplace(true)
-	}
-	if v, ok := d.GetOk("refresh_mode"); ok {
-		request.WithRefreshMode(sdk.DynamicTableRefreshMode(v.(string)))
+	request, diags := dynamicTableCreateRequest(d, id)
+	if len(diags) > 0 {
+		return diags
 	}
 	if v, ok := d.GetOk("initialize"); ok {
 		request.WithInitialize(sdk.DynamicTableInitialize(v.(string)))
 	}
+	if v, ok := d.GetOk("backfill_from"); ok {
+		backfillFrom, err := sdk.ParseSchemaObjectIdentifier(v.(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		request.WithBackfillFrom(backfillFrom)
+	}
+	if v := d.Get("row_access_policy"); len(v.([]any)) > 0 {
+		policyId, columns, err := extractPolicyWithColumnsSet(v, "on")
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		request.WithRowAccessPolicy(*sdk.NewDynamicTableRowAccessPolicyRequest(policyId, columns))
+	}
+	if _, ok := d.GetOk("tag"); ok {
+		request.WithTag(getPropertyTags(d, "tag"))
+	}
+
 	if err := client.DynamicTables.Create(ctx, request); err != nil {
 		return diag.FromErr(err)
 	}
-	d.SetId(helpers.EncodeSnowflakeID(id))
 
-	return ReadDynamicTable(ctx, d, meta)
+	d.SetId(helpers.EncodeResourceIdentifier(id))
+
+	if !d.Get("started").(bool) {
+		if err := client.DynamicTables.Alter(ctx, sdk.NewAlterDynamicTableRequest(id).WithSuspend(sdk.Bool(true))); err != nil {
+			return diag.FromErr(fmt.Errorf("error suspending dynamic table %s, err = %w", id.FullyQualifiedName(), err))
+		}
+	}
+
+	return ReadDynamicTable(false)(ctx, d, meta)
 }
 
-// UpdateDynamicTable implements schema.UpdateFunc.
 func UpdateDynamicTable(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	client := meta.(*provider.Context).Client
-	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
-	request := sdk.NewAlterDynamicTableRequest(id)
-
-	runSet := false
-	set := sdk.NewDynamicTableSetRequest()
-	if d.HasChange("target_lag") {
-		tl := parseTargetLag(d.Get("target_lag"))
-		set.WithTargetLag(tl)
-		runSet = true
+	id, err := sdk.ParseSchemaObjectIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
-	if d.HasChange("warehouse") {
-		warehouseName := d.Get("warehouse").(string)
-		set.WithWarehouse(sdk.NewAccountObjectIdentifier(warehouseName))
-		runSet = true
+	if d.HasChange("name") {
+		newId := sdk.NewSchemaObjectIdentifierInSchema(id.SchemaId(), d.Get("name").(string))
+
+		if err := client.DynamicTables.Alter(ctx, sdk.NewAlterDynamicTableRequest(id).WithRenameTo(newId)); err != nil {
+			return diag.FromErr(fmt.Errorf("error renaming dynamic table %v err = %w", d.Id(), err))
+		}
+
+		d.SetId(helpers.EncodeResourceIdentifier(newId))
+		id = newId
 	}
 
-	if runSet {
-		request.WithSet(set)
+	if d.HasChange("query") {
+		// The query can only be changed with CREATE OR ALTER, which also applies all the other in-place changes.
+		request, diags := dynamicTableCreateRequest(d, id)
+		if len(diags) > 0 {
+			return diags
+		}
+		if err := client.DynamicTables.Create(ctx, request.WithOrAlter(true)); err != nil {
+			return diag.FromErr(fmt.Errorf("error altering dynamic table %v with CREATE OR ALTER, err = %w", d.Id(), err))
+		}
+	} else {
+		set, unset := sdk.NewDynamicTableSetRequest(), sdk.NewDynamicTableUnsetRequest()
+		runSet, runUnset, diags := handleDynamicTableParametersUpdate(d, set, unset)
+		if len(diags) > 0 {
+			return diags
+		}
+
+		if d.HasChange("target_lag") {
+			set.WithTargetLag(parseTargetLag(d.Get("target_lag")))
+			runSet = true
+		}
+		if d.HasChange("warehouse") {
+			set.WithWarehouse(sdk.NewAccountObjectIdentifier(d.Get("warehouse").(string)))
+			runSet = true
+		}
+		if d.HasChange("comment") {
+			if v, ok := d.GetOk("comment"); ok {
+				set.WithComment(v.(string))
+				runSet = true
+			} else {
+				unset.WithComment(true)
+				runUnset = true
+			}
+		}
+
+		if runSet {
+			if err := client.DynamicTables.Alter(ctx, sdk.NewAlterDynamicTableRequest(id).WithSet(set)); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+		if runUnset {
+			if err := client.DynamicTables.Alter(ctx, sdk.NewAlterDynamicTableRequest(id).WithUnset(unset)); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+
+		if d.HasChange("immutable_where") {
+			request := sdk.NewAlterDynamicTableRequest(id)
+			if v, ok := d.GetOk("immutable_where"); ok {
+				request.WithSetImmutableWhere(v.(string))
+			} else {
+				request.WithUnsetImmutable(true)
+			}
+			if err := client.DynamicTables.Alter(ctx, request); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	if d.HasChange("row_access_policy") {
+		var addReq *sdk.DynamicTableRowAccessPolicyRequest
+		var dropId *sdk.SchemaObjectIdentifier
+
+		oldRaw, newRaw := d.GetChange("row_access_policy")
+		if len(oldRaw.([]any)) > 0 {
+			oldId, _, err := extractPolicyWithColumnsSet(oldRaw, "on")
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			dropId = &oldId
+		}
+		if len(newRaw.([]any)) > 0 {
+			newId, newColumns, err := extractPolicyWithColumnsSet(newRaw, "on")
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			addReq = sdk.NewDynamicTableRowAccessPolicyRequest(newId, newColumns)
+		}
+		req := sdk.NewAlterDynamicTableRequest(id)
+		if addReq != nil && dropId != nil { // nolint
+			req.WithDropAndAddRowAccessPolicy(*sdk.NewDynamicTableDropAndAddRowAccessPolicyRequest(*dropId, *addReq))
+		} else if addReq != nil {
+			req.WithAddRowAccessPolicy(*addReq)
+		} else if dropId != nil {
+			req.WithDropRowAccessPolicy(*dropId)
+		}
+		if err := client.DynamicTables.Alter(ctx, req); err != nil {
+			return diag.FromErr(fmt.Errorf("error altering row_access_policy for dynamic table %v: %w", d.Id(), err))
+		}
+	}
+
+	if d.HasChange("tag") {
+		unsetTags, setTags := GetTagsDiff(d, "tag")
+		if len(unsetTags) > 0 {
+			if err := client.DynamicTables.Alter(ctx, sdk.NewAlterDynamicTableRequest(id).WithUnsetTags(unsetTags)); err != nil {
+				return diag.FromErr(fmt.Errorf("error unsetting tags on dynamic table %v, err = %w", d.Id(), err))
+			}
+		}
+		if len(setTags) > 0 {
+			if err := client.DynamicTables.Alter(ctx, sdk.NewAlterDynamicTableRequest(id).WithSetTags(setTags)); err != nil {
+				return diag.FromErr(fmt.Errorf("error setting tags on dynamic table %v, err = %w", d.Id(), err))
+			}
+		}
+	}
+
+	if d.HasChange("started") {
+		request := sdk.NewAlterDynamicTableRequest(id)
+		if d.Get("started").(bool) {
+			request.WithResume(sdk.Bool(true))
+		} else {
+			request.WithSuspend(sdk.Bool(true))
+		}
 		if err := client.DynamicTables.Alter(ctx, request); err != nil {
-			return diag.FromErr(err)
+			return diag.FromErr(fmt.Errorf("error changing the scheduling state of dynamic table %s, err = %w", id.FullyQualifiedName(), err))
 		}
 	}
 
-	if d.HasChange("comment") {
-		err := client.Comments.Set(ctx, &sdk.SetCommentOptions{
-			ObjectType: sdk.ObjectTypeDynamicTable,
-			ObjectName: id,
-			Value:      sdk.String(d.Get("comment").(string)),
-		})
+	return ReadDynamicTable(false)(ctx, d, meta)
+}
+
+func ReadDynamicTable(withExternalChangesMarking bool) schema.ReadContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+		client := meta.(*provider.Context).Client
+		id, err := sdk.ParseSchemaObjectIdentifier(d.Id())
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		dynamicTable, err := client.DynamicTables.ShowByID(ctx, id)
+		if err != nil {
+			if errors.Is(err, sdk.ErrObjectNotFound) {
+				d.SetId("")
+				return diag.Diagnostics{
+					diag.Diagnostic{
+						Severity: diag.Warning,
+						Summary:  "Failed to query dynamic table. Marking the resource as removed.",
+						Detail:   fmt.Sprintf("Dynamic table name: %s, Err: %s", id.FullyQualifiedName(), err),
+					},
+				}
+			}
+			return diag.FromErr(err)
+		}
+
+		dynamicTableParameters, err := client.DynamicTables.ShowParameters(ctx, id)
 		if err != nil {
 			return diag.FromErr(err)
 		}
+
+		policyRefs, err := client.PolicyReferences.GetForEntity(ctx, sdk.NewGetForEntityPolicyReferenceRequest(id, sdk.PolicyEntityDomainTable))
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("getting policy references for dynamic table: %w", err))
+		}
+
+		// dynamic tables are tagged in the TABLE domain
+		tags, err := readTagReferences(ctx, client, d.Get("tag").([]any), id, sdk.ObjectTypeTable)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("reading tags for dynamic table: %w", err))
+		}
+
+		if withExternalChangesMarking {
+			if err = handleExternalChangesToObjectInShow(d,
+				outputMapping{"scheduling_state", "started", string(dynamicTable.SchedulingState), dynamicTable.SchedulingState == sdk.DynamicTableSchedulingStateActive, nil},
+			); err != nil {
+				return diag.FromErr(err)
+			}
+		} else {
+			if err = setStateToValuesFromConfig(d, dynamicTableSchema, []string{
+				"started",
+			}); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+
+		targetLag := map[string]any{}
+		if dynamicTable.TargetLag == "DOWNSTREAM" {
+			targetLag["downstream"] = true
+		} else {
+			targetLag["maximum_duration"] = dynamicTable.TargetLag
+		}
+
+		if strings.Contains(dynamicTable.Text, "initialize = 'ON_CREATE'") {
+			if err := d.Set("initialize", "ON_CREATE"); err != nil {
+				return diag.FromErr(err)
+			}
+		} else if strings.Contains(dynamicTable.Text, "initialize = 'ON_SCHEDULE'") {
+			if err := d.Set("initialize", "ON_SCHEDULE"); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+		if m := refreshModePattern.FindStringSubmatch(dynamicTable.Text); len(m) > 1 {
+			if err := d.Set("refresh_mode", m[1]); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+
+		query, err := snowflake.NewViewSelectStatementExtractor(dynamicTable.Text).ExtractDynamicTable()
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		if errs := errors.Join(
+			d.Set("is_transient", transientPattern.MatchString(dynamicTable.Text)),
+			d.Set("target_lag", []any{targetLag}),
+			d.Set("warehouse", dynamicTable.Warehouse),
+			d.Set("comment", dynamicTable.Comment),
+			d.Set("query", query),
+			handleDynamicTablePolicyReferences(policyRefs, d),
+			d.Set("tag", tags),
+			handleDynamicTableParameterRead(d, dynamicTableParameters),
+			d.Set(FullyQualifiedNameAttributeName, id.FullyQualifiedName()),
+			d.Set(ShowOutputAttributeName, []map[string]any{schemas.DynamicTableToSchema(dynamicTable)}),
+			d.Set(ParametersAttributeName, []map[string]any{schemas.DynamicTableParametersToSchema(dynamicTableParameters)}),
+		); errs != nil {
+			return diag.FromErr(errs)
+		}
+
+		return nil
+	}
+}
+
+func handleDynamicTablePolicyReferences(policyRefs []sdk.PolicyReference, d *schema.ResourceData) error {
+	var rowAccessPolicies []map[string]any
+	for _, p := range policyRefs {
+		if p.PolicyKind != sdk.PolicyKindRowAccessPolicy {
+			log.Printf("[DEBUG] unexpected policy kind %v in policy references returned from Snowflake", p.PolicyKind)
+			continue
+		}
+		var on []string
+		if p.RefArgColumnNames != nil {
+			on = sdk.ParseCommaSeparatedStringArray(*p.RefArgColumnNames, true)
+		}
+		rowAccessPolicies = append(rowAccessPolicies, map[string]any{
+			"policy_name": sdk.NewSchemaObjectIdentifier(*p.PolicyDb, *p.PolicySchema, p.PolicyName).FullyQualifiedName(),
+			"on":          on,
+		})
 	}
-	return ReadDynamicTable(ctx, d, meta)
+	return d.Set("row_access_policy", rowAccessPolicies)
 }
 
-// DeleteDynamicTable implements schema.DeleteFunc.
 func DeleteDynamicTable(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	client := meta.(*provider.Context).Client
-
-	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
-	if err := client.DynamicTables.Drop(ctx, sdk.NewDropDynamicTableRequest(id)); err != nil {
+	id, err := sdk.ParseSchemaObjectIdentifier(d.Id())
+	if err != nil {
 		return diag.FromErr(err)
 	}
-	d.SetId("")
 
+	if err := client.DynamicTables.Drop(ctx, sdk.NewDropDynamicTableRequest(id).WithIfExists(true)); err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting dynamic table %s err = %w", id.FullyQualifiedName(), err))
+	}
+
+	d.SetId("")
 	return nil
 }
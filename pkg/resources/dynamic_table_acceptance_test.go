@@ -3,6 +3,7 @@ package resources_test
 import (
 	"fmt"
 	"regexp"
+	"strings"
 	"testing"
 
 	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
@@ -77,22 +78,23 @@ func TestAcc_DynamicTable_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "query", fmt.Sprintf("select \"id\" from \"%v\".\"%v\".\"%v\"", acc.TestDatabaseName, acc.TestSchemaName, tableId.Name())),
 					resource.TestCheckResourceAttr(resourceName, "comment", comment),
 
-					// computed attributes
-
-					// - not used at this time
-					//  resource.TestCheckResourceAttrSet(resourceName, "cluster_by"),
-					resource.TestCheckResourceAttrSet(resourceName, "rows"),
-					resource.TestCheckResourceAttrSet(resourceName, "bytes"),
-					resource.TestCheckResourceAttrSet(resourceName, "owner"),
-					// - not used at this time
-					// resource.TestCheckResourceAttrSet(resourceName, "automatic_clustering"),
-					resource.TestCheckResourceAttrSet(resourceName, "scheduling_state"),
-					resource.TestCheckResourceAttrSet(resourceName, "last_suspended_on"),
-					resource.TestCheckResourceAttrSet(resourceName, "is_clone"),
-					resource.TestCheckResourceAttrSet(resourceName, "is_replica"),
-					resource.TestCheckResourceAttrSet(resourceName, "data_timestamp"),
-
-					resource.TestCheckResourceAttrWith(resourceName, "created_on", func(value string) error {
+					resource.TestCheckResourceAttr(resourceName, "started", "true"),
+					resource.TestCheckResourceAttr(resourceName, "is_transient", "false"),
+
+					resource.TestCheckResourceAttr(resourceName, "show_output.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "show_output.0.name", dynamicTableId.Name()),
+					resource.TestCheckResourceAttr(resourceName, "show_output.0.database_name", acc.TestDatabaseName),
+					resource.TestCheckResourceAttr(resourceName, "show_output.0.schema_name", acc.TestSchemaName),
+					resource.TestCheckResourceAttr(resourceName, "show_output.0.scheduling_state", string(sdk.DynamicTableSchedulingStateActive)),
+					resource.TestCheckResourceAttrSet(resourceName, "show_output.0.rows"),
+					resource.TestCheckResourceAttrSet(resourceName, "show_output.0.bytes"),
+					resource.TestCheckResourceAttrSet(resourceName, "show_output.0.owner"),
+
+					resource.TestCheckResourceAttr(resourceName, "parameters.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "parameters.0.data_retention_time_in_days.0.value"),
+					resource.TestCheckResourceAttrSet(resourceName, "parameters.0.max_data_extension_time_in_days.0.value"),
+
+					resource.TestCheckResourceAttrWith(resourceName, "show_output.0.created_on", func(value string) error {
 						createdOn = value
 						return nil
 					}),
@@ -113,7 +115,7 @@ func TestAcc_DynamicTable_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "target_lag.0.downstream", "true"),
 					resource.TestCheckResourceAttr(resourceName, "comment", newComment),
 
-					resource.TestCheckResourceAttrWith(resourceName, "created_on", func(value string) error {
+					resource.TestCheckResourceAttrWith(resourceName, "show_output.0.created_on", func(value string) error {
 						if value != createdOn {
 							return fmt.Errorf("created_on changed from %v to %v", createdOn, value)
 						}
@@ -128,7 +130,7 @@ func TestAcc_DynamicTable_basic(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(resourceName, "initialize", string(sdk.DynamicTableInitializeOnSchedule)),
 
-					resource.TestCheckResourceAttrWith(resourceName, "created_on", func(value string) error {
+					resource.TestCheckResourceAttrWith(resourceName, "show_output.0.created_on", func(value string) error {
 						if value == createdOn {
 							return fmt.Errorf("expected created_on to change but was not changed")
 						}
@@ -145,7 +147,7 @@ func TestAcc_DynamicTable_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "initialize", string(sdk.DynamicTableInitializeOnSchedule)),
 					resource.TestCheckResourceAttr(resourceName, "refresh_mode", string(sdk.DynamicTableRefreshModeFull)),
 
-					resource.TestCheckResourceAttrWith(resourceName, "created_on", func(value string) error {
+					resource.TestCheckResourceAttrWith(resourceName, "show_output.0.created_on", func(value string) error {
 						if value == createdOn {
 							return fmt.Errorf("expected created_on to change but was not changed")
 						}
@@ -509,3 +511,224 @@ func TestAcc_DynamicTable_issue3355_timeout(t *testing.T) {
 		},
 	})
 }
+
+func TestAcc_DynamicTable_complete(t *testing.T) {
+	_ = testenvs.GetOrSkipTest(t, testenvs.EnableAcceptance)
+	acc.TestAccPreCheck(t)
+
+	table, tableCleanup := acc.TestClient().Table.CreateWithChangeTracking(t)
+	t.Cleanup(tableCleanup)
+
+	rowAccessPolicy, rowAccessPolicyCleanup := acc.TestClient().RowAccessPolicy.CreateRowAccessPolicy(t)
+	t.Cleanup(rowAccessPolicyCleanup)
+
+	id := acc.TestClient().Ids.RandomSchemaObjectIdentifier()
+	newId := acc.TestClient().Ids.RandomSchemaObjectIdentifier()
+	comment := random.Comment()
+	query := fmt.Sprintf(`select "ID" from %s`, table.ID().FullyQualifiedName())
+	newQuery := fmt.Sprintf(`select "ID" from %s where "ID" > 0`, table.ID().FullyQualifiedName())
+
+	resourceName := "snowflake_dynamic_table.test"
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: acc.CheckDestroy(t, resources.DynamicTable),
+		Steps: []resource.TestStep{
+			{
+				Config: dynamicTableCompleteConfig(id, query, comment, false, `"ID" < 100`, rowAccessPolicy.ID()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "fully_qualified_name", id.FullyQualifiedName()),
+					resource.TestCheckResourceAttr(resourceName, "is_transient", "true"),
+					resource.TestCheckResourceAttr(resourceName, "started", "false"),
+					resource.TestCheckResourceAttr(resourceName, "comment", comment),
+					resource.TestCheckResourceAttr(resourceName, "immutable_where", `"ID" < 100`),
+					resource.TestCheckResourceAttr(resourceName, "data_retention_time_in_days", "0"),
+					resource.TestCheckResourceAttr(resourceName, "max_data_extension_time_in_days", "5"),
+					resource.TestCheckResourceAttr(resourceName, "row_access_policy.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "row_access_policy.0.policy_name", rowAccessPolicy.ID().FullyQualifiedName()),
+					resource.TestCheckResourceAttr(resourceName, "row_access_policy.0.on.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "row_access_policy.0.on.0", "ID"),
+
+					resource.TestCheckResourceAttr(resourceName, "show_output.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "show_output.0.scheduling_state", string(sdk.DynamicTableSchedulingStateSuspended)),
+					resource.TestCheckResourceAttr(resourceName, "show_output.0.comment", comment),
+
+					resource.TestCheckResourceAttr(resourceName, "parameters.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.0.data_retention_time_in_days.0.value", "0"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.0.data_retention_time_in_days.0.level", string(sdk.ParameterTypeTable)),
+					resource.TestCheckResourceAttr(resourceName, "parameters.0.max_data_extension_time_in_days.0.value", "5"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.0.max_data_extension_time_in_days.0.level", string(sdk.ParameterTypeTable)),
+				),
+			},
+			// rename, resume and alter the query in place
+			{
+				Config: dynamicTableCompleteConfig(newId, newQuery, comment, true, `"ID" < 50`, rowAccessPolicy.ID()),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "fully_qualified_name", newId.FullyQualifiedName()),
+					resource.TestCheckResourceAttr(resourceName, "started", "true"),
+					resource.TestCheckResourceAttr(resourceName, "query", newQuery),
+					resource.TestCheckResourceAttr(resourceName, "immutable_where", `"ID" < 50`),
+					resource.TestCheckResourceAttr(resourceName, "show_output.0.scheduling_state", string(sdk.DynamicTableSchedulingStateActive)),
+					resource.TestCheckResourceAttr(resourceName, "parameters.0.max_data_extension_time_in_days.0.value", "5"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"immutable_where"},
+			},
+		},
+	})
+}
+
+func dynamicTableCompleteConfig(id sdk.SchemaObjectIdentifier, query string, comment string, started bool, immutableWhere string, rowAccessPolicyId sdk.SchemaObjectIdentifier) string {
+	return fmt.Sprintf(`
+resource "snowflake_dynamic_table" "test" {
+  database     = "%[1]s"
+  schema       = "%[2]s"
+  name         = "%[3]s"
+  is_transient = true
+  started      = %[7]t
+  target_lag {
+    maximum_duration = "2 minutes"
+  }
+  warehouse       = "%[4]s"
+  query           = %[5]q
+  comment         = "%[6]s"
+  immutable_where = %[8]q
+
+  data_retention_time_in_days     = 0
+  max_data_extension_time_in_days = 5
+
+  row_access_policy {
+    policy_name = %[9]q
+    on          = ["ID"]
+  }
+}
+`, id.DatabaseName(), id.SchemaName(), id.Name(), acc.TestWarehouseName, query, comment, started, immutableWhere, rowAccessPolicyId.FullyQualifiedName())
+}
+
+func TestAcc_DynamicTable_Tags(t *testing.T) {
+	_ = testenvs.GetOrSkipTest(t, testenvs.EnableAcceptance)
+	acc.TestAccPreCheck(t)
+
+	table, tableCleanup := acc.TestClient().Table.CreateWithChangeTracking(t)
+	t.Cleanup(tableCleanup)
+
+	tag, tagCleanup := acc.TestClient().Tag.CreateTag(t)
+	t.Cleanup(tagCleanup)
+
+	tag2, tag2Cleanup := acc.TestClient().Tag.CreateTag(t)
+	t.Cleanup(tag2Cleanup)
+
+	id := acc.TestClient().Ids.RandomSchemaObjectIdentifier()
+	query := fmt.Sprintf(`select "ID" from %s`, table.ID().FullyQualifiedName())
+
+	resourceName := "snowflake_dynamic_table.test"
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: acc.CheckDestroy(t, resources.DynamicTable),
+		Steps: []resource.TestStep{
+			{
+				Config: dynamicTableWithTagsConfig(id, query, []sdk.TagAssociation{{Name: tag.ID(), Value: "v1"}}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "tag.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tag.0.name", tag.ID().Name()),
+					resource.TestCheckResourceAttr(resourceName, "tag.0.value", "v1"),
+				),
+			},
+			// change the value and add another tag in place
+			{
+				Config: dynamicTableWithTagsConfig(id, query, []sdk.TagAssociation{{Name: tag.ID(), Value: "v2"}, {Name: tag2.ID(), Value: "v3"}}),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "tag.#", "2"),
+				),
+			},
+			// change the value externally
+			{
+				PreConfig: func() {
+					acc.TestClient().Tag.Set(t, sdk.ObjectTypeDynamicTable, id, []sdk.TagAssociation{{Name: tag.ID(), Value: "external"}})
+				},
+				Config: dynamicTableWithTagsConfig(id, query, []sdk.TagAssociation{{Name: tag.ID(), Value: "v2"}, {Name: tag2.ID(), Value: "v3"}}),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "tag.#", "2"),
+				),
+			},
+			// unset the tag externally
+			{
+				PreConfig: func() {
+					acc.TestClient().Tag.Unset(t, sdk.ObjectTypeDynamicTable, id, []sdk.ObjectIdentifier{tag2.ID()})
+				},
+				Config: dynamicTableWithTagsConfig(id, query, []sdk.TagAssociation{{Name: tag.ID(), Value: "v2"}, {Name: tag2.ID(), Value: "v3"}}),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "tag.#", "2"),
+				),
+			},
+			// unset the tags
+			{
+				Config: dynamicTableWithTagsConfig(id, query, nil),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "tag.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func dynamicTableWithTagsConfig(id sdk.SchemaObjectIdentifier, query string, tags []sdk.TagAssociation) string {
+	var tagBlocks strings.Builder
+	for _, tag := range tags {
+		tagId := tag.Name.(sdk.SchemaObjectIdentifier)
+		tagBlocks.WriteString(fmt.Sprintf(`
+  tag {
+    database = "%[1]s"
+    schema   = "%[2]s"
+    name     = "%[3]s"
+    value    = "%[4]s"
+  }
+`, tagId.DatabaseName(), tagId.SchemaName(), tagId.Name(), tag.Value))
+	}
+	return fmt.Sprintf(`
+resource "snowflake_dynamic_table" "test" {
+  database = "%[1]s"
+  schema   = "%[2]s"
+  name     = "%[3]s"
+  target_lag {
+    maximum_duration = "2 minutes"
+  }
+  warehouse = "%[4]s"
+  query     = %[5]q
+%[6]s}
+`, id.DatabaseName(), id.SchemaName(), id.Name(), acc.TestWarehouseName, query, tagBlocks.String())
+}
@@ -0,0 +1,116 @@
+package resources
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var (
+	dynamicTableParametersSchema     = make(map[string]*schema.Schema)
+	dynamicTableParametersCustomDiff = ParametersCustomDiff(
+		dynamicTableParametersProvider,
+		parameter[sdk.DynamicTableParameter]{sdk.DynamicTableParameterDataRetentionTimeInDays, valueTypeInt, sdk.ParameterTypeTable},
+		parameter[sdk.DynamicTableParameter]{sdk.DynamicTableParameterMaxDataExtensionTimeInDays, valueTypeInt, sdk.ParameterTypeTable},
+	)
+)
+
+func init() {
+	dynamicTableParameterFields := []parameterDef[sdk.DynamicTableParameter]{
+		{Name: sdk.DynamicTableParameterDataRetentionTimeInDays, Type: schema.TypeInt, ValidateDiag: validation.ToDiagFunc(validation.IntBetween(0, 90)), Description: "Specifies the retention period for the dynamic table so that Time Travel actions (SELECT, CLONE, UNDROP) can be performed on historical data in the dynamic table."},
+		{Name: sdk.DynamicTableParameterMaxDataExtensionTimeInDays, Type: schema.TypeInt, ValidateDiag: validation.ToDiagFunc(validation.IntBetween(0, 90)), Description: "Maximum number of days for which Snowflake can extend the data retention period for the dynamic table to prevent streams on the dynamic table from becoming stale."},
+	}
+
+	for _, field := range dynamicTableParameterFields {
+		fieldName := strings.ToLower(string(field.Name))
+
+		dynamicTableParametersSchema[fieldName] = &schema.Schema{
+			Type:             field.Type,
+			Description:      enrichWithReferenceToParameterDocs(field.Name, field.Description),
+			Computed:         true,
+			Optional:         true,
+			ValidateDiagFunc: field.ValidateDiag,
+			DiffSuppressFunc: field.DiffSuppress,
+		}
+	}
+}
+
+func dynamicTableParametersProvider(ctx context.Context, d ResourceIdProvider, meta any) ([]*sdk.Parameter, error) {
+	return parametersProvider(ctx, d, meta.(*provider.Context), dynamicTableParametersProviderFunc, sdk.ParseSchemaObjectIdentifier)
+}
+
+func dynamicTableParametersProviderFunc(c *sdk.Client) showParametersFunc[sdk.SchemaObjectIdentifier] {
+	return c.DynamicTables.ShowParameters
+}
+
+func handleDynamicTableParameterRead(d *schema.ResourceData, dynamicTableParameters []*sdk.Parameter) error {
+	for _, p := range dynamicTableParameters {
+		switch p.Key {
+		case
+			string(sdk.DynamicTableParameterDataRetentionTimeInDays),
+			string(sdk.DynamicTableParameterMaxDataExtensionTimeInDays):
+			value, err := strconv.Atoi(p.Value)
+			if err != nil {
+				return err
+			}
+			if err := d.Set(strings.ToLower(p.Key), value); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func handleDynamicTableParametersCreate(d *schema.ResourceData, request *sdk.CreateDynamicTableRequest) diag.Diagnostics {
+	var dataRetentionTimeInDays, maxDataExtensionTimeInDays *int
+	if diags := JoinDiags(
+		handleParameterCreate(d, sdk.DynamicTableParameterDataRetentionTimeInDays, &dataRetentionTimeInDays),
+		handleParameterCreate(d, sdk.DynamicTableParameterMaxDataExtensionTimeInDays, &maxDataExtensionTimeInDays),
+	); len(diags) > 0 {
+		return diags
+	}
+	if dataRetentionTimeInDays != nil {
+		request.WithDataRetentionTimeInDays(*dataRetentionTimeInDays)
+	}
+	if maxDataExtensionTimeInDays != nil {
+		request.WithMaxDataExtensionTimeInDays(*maxDataExtensionTimeInDays)
+	}
+	return nil
+}
+
+func handleDynamicTableParametersUpdate(d *schema.ResourceData, set *sdk.DynamicTableSetRequest, unset *sdk.DynamicTableUnsetRequest) (bool, bool, diag.Diagnostics) {
+	var dataRetentionTimeInDays, maxDataExtensionTimeInDays *int
+	var unsetDataRetentionTimeInDays, unsetMaxDataExtensionTimeInDays *bool
+	if diags := JoinDiags(
+		handleParameterUpdate(d, sdk.DynamicTableParameterDataRetentionTimeInDays, &dataRetentionTimeInDays, &unsetDataRetentionTimeInDays),
+		handleParameterUpdate(d, sdk.DynamicTableParameterMaxDataExtensionTimeInDays, &maxDataExtensionTimeInDays, &unsetMaxDataExtensionTimeInDays),
+	); len(diags) > 0 {
+		return false, false, diags
+	}
+
+	var runSet, runUnset bool
+	if dataRetentionTimeInDays != nil {
+		set.WithDataRetentionTimeInDays(*dataRetentionTimeInDays)
+		runSet = true
+	}
+	if maxDataExtensionTimeInDays != nil {
+		set.WithMaxDataExtensionTimeInDays(*maxDataExtensionTimeInDays)
+		runSet = true
+	}
+	if unsetDataRetentionTimeInDays != nil {
+		unset.WithDataRetentionTimeInDays(true)
+		runUnset = true
+	}
+	if unsetMaxDataExtensionTimeInDays != nil {
+		unset.WithMaxDataExtensionTimeInDays(true)
+		runUnset = true
+	}
+	return runSet, runUnset, nil
+}
@@ -0,0 +1,33 @@
+package resources
+
+import (
+	"context"
+)
+
+// v1_1_0_DynamicTableStateUpgrader migrates the dynamic table state from before the rework: or_replace and the flat
+// computed attributes are removed (they are now available in show_output), and the resource id becomes a fully qualified name.
+func v1_1_0_DynamicTableStateUpgrader(ctx context.Context, rawState map[string]any, meta any) (map[string]any, error) {
+	if rawState == nil {
+		return rawState, nil
+	}
+
+	for _, removedAttribute := range []string{
+		"or_replace",
+		"created_on",
+		"cluster_by",
+		"rows",
+		"bytes",
+		"owner",
+		"refresh_mode_reason",
+		"automatic_clustering",
+		"scheduling_state",
+		"last_suspended_on",
+		"is_clone",
+		"is_replica",
+		"data_timestamp",
+	} {
+		delete(rawState, removedAttribute)
+	}
+
+	return migratePipeSeparatedObjectIdentifierResourceIdToFullyQualifiedName(ctx, rawState, meta)
+}
@@ -0,0 +1,330 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/schemas"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var externalAccessIntegrationSchema = map[string]*schema.Schema{
+	"name": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		DiffSuppressFunc: suppressIdentifierQuoting,
+		Description:      blocklistedCharactersFieldDescription("Specifies the identifier (i.e. name) for the external access integration. This value must be unique in your account."),
+	},
+	"allowed_network_rules": {
+		Type: schema.TypeSet,
+		Elem: &schema.Schema{
+			Type:             schema.TypeString,
+			ValidateDiagFunc: IsValidIdentifier[sdk.SchemaObjectIdentifier](),
+		},
+		Required:         true,
+		MinItems:         1,
+		DiffSuppressFunc: NormalizeAndCompareIdentifiersInSet("allowed_network_rules"),
+		Description:      relatedResourceDescription("Specifies the fully qualified names of the network rules that define the allowed network locations. Only network rules with the `EGRESS` mode are accepted by Snowflake.", resources.NetworkRule),
+	},
+	"allowed_api_authentication_integrations": {
+		Type: schema.TypeSet,
+		Elem: &schema.Schema{
+			Type:             schema.TypeString,
+			ValidateDiagFunc: IsValidIdentifier[sdk.AccountObjectIdentifier](),
+		},
+		Optional:         true,
+		DiffSuppressFunc: NormalizeAndCompareIdentifiersInSet("allowed_api_authentication_integrations"),
+		Description:      "Specifies the security integrations whose OAuth authorization server issued the secret used by the UDF or procedure. The security integrations must be of the `API_AUTHENTICATION` type.",
+	},
+	"allowed_authentication_secrets": {
+		Type: schema.TypeSet,
+		Elem: &schema.Schema{
+			Type:             schema.TypeString,
+			ValidateDiagFunc: IsValidIdentifier[sdk.SchemaObjectIdentifier](),
+		},
+		Optional:         true,
+		DiffSuppressFunc: NormalizeAndCompareIdentifiersInSet("allowed_authentication_secrets"),
+		Description:      "Specifies the fully qualified names of the secrets that UDF or procedure handler code can use when accessing the external network locations referenced in allowed network rules.",
+	},
+	"enabled": {
+		Type:        schema.TypeBool,
+		Required:    true,
+		Description: "Specifies whether this integration is enabled or disabled.",
+	},
+	"comment": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies a comment for the external access integration.",
+	},
+	ShowOutputAttributeName: {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Outputs the result of `SHOW EXTERNAL ACCESS INTEGRATIONS` for the given external access integration.",
+		Elem: &schema.Resource{
+			Schema: schemas.ShowExternalAccessIntegrationSchema,
+		},
+	},
+	DescribeOutputAttributeName: {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Outputs the result of `DESCRIBE EXTERNAL ACCESS INTEGRATION` for the given external access integration.",
+		Elem: &schema.Resource{
+			Schema: schemas.DescribeExternalAccessIntegrationSchema,
+		},
+	},
+	FullyQualifiedNameAttributeName: schemas.FullyQualifiedNameSchema,
+}
+
+// ExternalAccessIntegration returns a pointer to the resource representing an external access integration.
+func ExternalAccessIntegration() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: PreviewFeatureCreateContextWrapper(string(previewfeatures.ExternalAccessIntegrationResource), TrackingCreateWrapper(resources.ExternalAccessIntegration, CreateContextExternalAccessIntegration)),
+		ReadContext:   PreviewFeatureReadContextWrapper(string(previewfeatures.ExternalAccessIntegrationResource), TrackingReadWrapper(resources.ExternalAccessIntegration, ReadContextExternalAccessIntegration)),
+		UpdateContext: PreviewFeatureUpdateContextWrapper(string(previewfeatures.ExternalAccessIntegrationResource), TrackingUpdateWrapper(resources.ExternalAccessIntegration, UpdateContextExternalAccessIntegration)),
+		DeleteContext: PreviewFeatureDeleteContextWrapper(string(previewfeatures.ExternalAccessIntegrationResource), TrackingDeleteWrapper(resources.ExternalAccessIntegration, DeleteContextExternalAccessIntegration)),
+		Description:   "Resource used to manage external access integrations. For more information, check [external access integration documentation](https://docs.snowflake.com/en/sql-reference/sql/create-external-access-integration).",
+
+		Schema: externalAccessIntegrationSchema,
+		CustomizeDiff: TrackingCustomDiffWrapper(resources.ExternalAccessIntegration, customdiff.All(
+			ComputedIfAnyAttributeChanged(externalAccessIntegrationSchema, ShowOutputAttributeName, "enabled", "comment"),
+			ComputedIfAnyAttributeChanged(externalAccessIntegrationSchema, DescribeOutputAttributeName, "enabled", "comment", "allowed_network_rules", "allowed_api_authentication_integrations", "allowed_authentication_secrets"),
+		)),
+		Importer: &schema.ResourceImporter{
+			StateContext: TrackingImportWrapper(resources.ExternalAccessIntegration, ImportName[sdk.AccountObjectIdentifier]),
+		},
+		Timeouts: defaultTimeouts,
+	}
+}
+
+func CreateContextExternalAccessIntegration(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseAccountObjectIdentifier(d.Get("name").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	networkRules, err := parseSchemaObjectIdentifierSet(d.Get("allowed_network_rules"))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	req := sdk.NewCreateExternalAccessIntegrationRequest(id, networkRules, d.Get("enabled").(bool))
+
+	if v, ok := d.GetOk("allowed_api_authentication_integrations"); ok {
+		integrations, err := parseAccountObjectIdentifierSet(v)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		req.WithAllowedApiAuthenticationIntegrations(integrations)
+	}
+	if v, ok := d.GetOk("allowed_authentication_secrets"); ok {
+		secrets, err := parseSchemaObjectIdentifierSet(v)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		req.WithAllowedAuthenticationSecrets(secrets)
+	}
+	if v, ok := d.GetOk("comment"); ok {
+		req.WithComment(v.(string))
+	}
+
+	if err := client.ExternalAccessIntegrations.Create(ctx, req); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(helpers.EncodeResourceIdentifier(id))
+
+	return ReadContextExternalAccessIntegration(ctx, d, meta)
+}
+
+func ReadContextExternalAccessIntegration(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseAccountObjectIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	integration, err := client.ExternalAccessIntegrations.ShowByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, sdk.ErrObjectNotFound) {
+			d.SetId("")
+			return diag.Diagnostics{
+				diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  "Failed to query external access integration. Marking the resource as removed.",
+					Detail:   fmt.Sprintf("External access integration name: %s, Err: %s", id.FullyQualifiedName(), err),
+				},
+			}
+		}
+		return diag.FromErr(err)
+	}
+
+	properties, err := client.ExternalAccessIntegrations.Describe(ctx, id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	networkRules, err := externalAccessIntegrationSchemaObjectIdentifiersProperty(properties, "ALLOWED_NETWORK_RULES")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	secrets, err := externalAccessIntegrationSchemaObjectIdentifiersProperty(properties, "ALLOWED_AUTHENTICATION_SECRETS")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	integrations, err := externalAccessIntegrationAccountObjectIdentifiersProperty(properties, "ALLOWED_API_AUTHENTICATION_INTEGRATIONS")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	errs := errors.Join(
+		d.Set(FullyQualifiedNameAttributeName, id.FullyQualifiedName()),
+		d.Set("enabled", integration.Enabled),
+		d.Set("comment", integration.Comment),
+		d.Set("allowed_network_rules", networkRules),
+		d.Set("allowed_authentication_secrets", secrets),
+		d.Set("allowed_api_authentication_integrations", integrations),
+		d.Set(ShowOutputAttributeName, []map[string]any{schemas.ExternalAccessIntegrationToSchema(integration)}),
+		d.Set(DescribeOutputAttributeName, []map[string]any{schemas.ExternalAccessIntegrationPropertiesToSchema(properties)}),
+	)
+	if errs != nil {
+		return diag.FromErr(errs)
+	}
+
+	return nil
+}
+
+func UpdateContextExternalAccessIntegration(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseAccountObjectIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	set, unset := sdk.NewExternalAccessIntegrationSetRequest(), sdk.NewExternalAccessIntegrationUnsetRequest()
+	var runSet, runUnset bool
+
+	if d.HasChange("allowed_network_rules") {
+		// required field
+		networkRules, err := parseSchemaObjectIdentifierSet(d.Get("allowed_network_rules"))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		set.WithAllowedNetworkRules(networkRules)
+		runSet = true
+	}
+
+	if d.HasChange("allowed_api_authentication_integrations") {
+		integrations, err := parseAccountObjectIdentifierSet(d.Get("allowed_api_authentication_integrations"))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if len(integrations) > 0 {
+			set.WithAllowedApiAuthenticationIntegrations(integrations)
+			runSet = true
+		} else {
+			unset.WithAllowedApiAuthenticationIntegrations(true)
+			runUnset = true
+		}
+	}
+
+	if d.HasChange("allowed_authentication_secrets") {
+		secrets, err := parseSchemaObjectIdentifierSet(d.Get("allowed_authentication_secrets"))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if len(secrets) > 0 {
+			set.WithAllowedAuthenticationSecrets(secrets)
+			runSet = true
+		} else {
+			unset.WithAllowedAuthenticationSecrets(true)
+			runUnset = true
+		}
+	}
+
+	if d.HasChange("enabled") {
+		// required field
+		set.WithEnabled(d.Get("enabled").(bool))
+		runSet = true
+	}
+
+	if d.HasChange("comment") {
+		if v, ok := d.GetOk("comment"); ok {
+			set.WithComment(v.(string))
+			runSet = true
+		} else {
+			unset.WithComment(true)
+			runUnset = true
+		}
+	}
+
+	if runSet {
+		if err := client.ExternalAccessIntegrations.Alter(ctx, sdk.NewAlterExternalAccessIntegrationRequest(id).WithSet(*set)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if runUnset {
+		if err := client.ExternalAccessIntegrations.Alter(ctx, sdk.NewAlterExternalAccessIntegrationRequest(id).WithUnset(*unset)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return ReadContextExternalAccessIntegration(ctx, d, meta)
+}
+
+func DeleteContextExternalAccessIntegration(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseAccountObjectIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := client.ExternalAccessIntegrations.Drop(ctx, sdk.NewDropExternalAccessIntegrationRequest(id).WithIfExists(true)); err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting external access integration %s err = %w", id.FullyQualifiedName(), err))
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// externalAccessIntegrationSchemaObjectIdentifiersProperty returns fully qualified names of the identifiers listed in the given DESCRIBE property.
+// A missing property is treated as an empty list.
+func externalAccessIntegrationSchemaObjectIdentifiersProperty(properties []sdk.ExternalAccessIntegrationProperty, name string) ([]string, error) {
+	property, err := collections.FindFirst(properties, func(property sdk.ExternalAccessIntegrationProperty) bool { return property.Name == name })
+	if err != nil {
+		return []string{}, nil
+	}
+	ids, err := sdk.ParseCommaSeparatedSchemaObjectIdentifierArray(property.Value)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %s property of external access integration: %w", name, err)
+	}
+	return collections.Map(ids, sdk.SchemaObjectIdentifier.FullyQualifiedName), nil
+}
+
+// externalAccessIntegrationAccountObjectIdentifiersProperty returns fully qualified names of the identifiers listed in the given DESCRIBE property.
+// A missing property is treated as an empty list.
+func externalAccessIntegrationAccountObjectIdentifiersProperty(properties []sdk.ExternalAccessIntegrationProperty, name string) ([]string, error) {
+	property, err := collections.FindFirst(properties, func(property sdk.ExternalAccessIntegrationProperty) bool { return property.Name == name })
+	if err != nil {
+		return []string{}, nil
+	}
+	idsRaw := sdk.ParseCommaSeparatedStringArray(property.Value, false)
+	ids := make([]string, len(idsRaw))
+	for i, idRaw := range idsRaw {
+		id, err := sdk.ParseAccountObjectIdentifier(idRaw)
+		if err != nil {
+			return nil, fmt.Errorf("parsing %s property of external access integration: %w", name, err)
+		}
+		ids[i] = id.FullyQualifiedName()
+	}
+	return ids, nil
+}
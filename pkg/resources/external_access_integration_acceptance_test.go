@@ -0,0 +1,157 @@
+package resources_test
+
+import (
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	accconfig "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/model"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/helpers/random"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/testenvs"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_ExternalAccessIntegration_Basic(t *testing.T) {
+	_ = testenvs.GetOrSkipTest(t, testenvs.EnableAcceptance)
+	acc.TestAccPreCheck(t)
+
+	networkRule, networkRuleCleanup := acc.TestClient().NetworkRule.Create(t)
+	t.Cleanup(networkRuleCleanup)
+
+	networkRule2, networkRule2Cleanup := acc.TestClient().NetworkRule.Create(t)
+	t.Cleanup(networkRule2Cleanup)
+
+	secret, secretCleanup := acc.TestClient().Secret.CreateWithGenericString(t, acc.TestClient().Ids.RandomSchemaObjectIdentifier(), "test_secret_string")
+	t.Cleanup(secretCleanup)
+
+	apiAuthIntegration, apiAuthIntegrationCleanup := acc.TestClient().SecurityIntegration.CreateApiAuthenticationWithClientCredentialsFlow(t)
+	t.Cleanup(apiAuthIntegrationCleanup)
+
+	id := acc.TestClient().Ids.RandomAccountObjectIdentifier()
+	comment := random.Comment()
+
+	modelBasic := model.ExternalAccessIntegration("test", []sdk.SchemaObjectIdentifier{networkRule.ID()}, true, id.Name())
+	modelComplete := model.ExternalAccessIntegration("test", []sdk.SchemaObjectIdentifier{networkRule.ID(), networkRule2.ID()}, false, id.Name()).
+		WithAllowedApiAuthenticationIntegrations(apiAuthIntegration.ID()).
+		WithAllowedAuthenticationSecrets(secret.ID()).
+		WithComment(comment)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: acc.CheckDestroy(t, resources.ExternalAccessIntegration),
+		Steps: []resource.TestStep{
+			// create with only required fields
+			{
+				Config: accconfig.FromModels(t, modelBasic),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "id", helpers.EncodeResourceIdentifier(id)),
+					resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "name", id.Name()),
+					resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "fully_qualified_name", id.FullyQualifiedName()),
+					resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "enabled", "true"),
+					resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "comment", ""),
+					resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "allowed_network_rules.#", "1"),
+					resource.TestCheckTypeSetElemAttr(modelBasic.ResourceReference(), "allowed_network_rules.*", networkRule.ID().FullyQualifiedName()),
+					resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "allowed_api_authentication_integrations.#", "0"),
+					resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "allowed_authentication_secrets.#", "0"),
+
+					resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "show_output.#", "1"),
+					resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "show_output.0.name", id.Name()),
+					resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "show_output.0.integration_type", "EXTERNAL_ACCESS"),
+					resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "show_output.0.category", "SECURITY"),
+					resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "show_output.0.enabled", "true"),
+					resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "show_output.0.comment", ""),
+					resource.TestCheckResourceAttrSet(modelBasic.ResourceReference(), "show_output.0.created_on"),
+
+					resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "describe_output.#", "1"),
+					resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "describe_output.0.enabled", "true"),
+					resource.TestCheckResourceAttrSet(modelBasic.ResourceReference(), "describe_output.0.allowed_network_rules"),
+				),
+			},
+			// import - without optionals
+			{
+				Config:            accconfig.FromModels(t, modelBasic),
+				ResourceName:      modelBasic.ResourceReference(),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// set optionals
+			{
+				Config: accconfig.FromModels(t, modelComplete),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(modelComplete.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(modelComplete.ResourceReference(), "enabled", "false"),
+					resource.TestCheckResourceAttr(modelComplete.ResourceReference(), "comment", comment),
+					resource.TestCheckResourceAttr(modelComplete.ResourceReference(), "allowed_network_rules.#", "2"),
+					resource.TestCheckTypeSetElemAttr(modelComplete.ResourceReference(), "allowed_network_rules.*", networkRule.ID().FullyQualifiedName()),
+					resource.TestCheckTypeSetElemAttr(modelComplete.ResourceReference(), "allowed_network_rules.*", networkRule2.ID().FullyQualifiedName()),
+					resource.TestCheckResourceAttr(modelComplete.ResourceReference(), "allowed_api_authentication_integrations.#", "1"),
+					resource.TestCheckTypeSetElemAttr(modelComplete.ResourceReference(), "allowed_api_authentication_integrations.*", apiAuthIntegration.ID().FullyQualifiedName()),
+					resource.TestCheckResourceAttr(modelComplete.ResourceReference(), "allowed_authentication_secrets.#", "1"),
+					resource.TestCheckTypeSetElemAttr(modelComplete.ResourceReference(), "allowed_authentication_secrets.*", secret.ID().FullyQualifiedName()),
+
+					resource.TestCheckResourceAttr(modelComplete.ResourceReference(), "show_output.0.enabled", "false"),
+					resource.TestCheckResourceAttr(modelComplete.ResourceReference(), "show_output.0.comment", comment),
+					resource.TestCheckResourceAttr(modelComplete.ResourceReference(), "describe_output.0.enabled", "false"),
+					resource.TestCheckResourceAttr(modelComplete.ResourceReference(), "describe_output.0.comment", comment),
+				),
+			},
+			// import - complete
+			{
+				Config:            accconfig.FromModels(t, modelComplete),
+				ResourceName:      modelComplete.ResourceReference(),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// external change is detected
+			{
+				PreConfig: func() {
+					acc.TestClient().ExternalAccessIntegration.Alter(t, sdk.NewAlterExternalAccessIntegrationRequest(id).WithSet(
+						*sdk.NewExternalAccessIntegrationSetRequest().
+							WithAllowedNetworkRules([]sdk.SchemaObjectIdentifier{networkRule.ID()}).
+							WithEnabled(true),
+					))
+				},
+				Config: accconfig.FromModels(t, modelComplete),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(modelComplete.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(modelComplete.ResourceReference(), "enabled", "false"),
+					resource.TestCheckResourceAttr(modelComplete.ResourceReference(), "allowed_network_rules.#", "2"),
+				),
+			},
+			// unset optionals
+			{
+				Config: accconfig.FromModels(t, modelBasic),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(modelBasic.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "enabled", "true"),
+					resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "comment", ""),
+					resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "allowed_network_rules.#", "1"),
+					resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "allowed_api_authentication_integrations.#", "0"),
+					resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "allowed_authentication_secrets.#", "0"),
+					resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "show_output.0.comment", ""),
+				),
+			},
+		},
+	})
+}
@@ -1,11 +1,19 @@
 package resources
 
 import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
 	"fmt"
+	"io/fs"
+	"os"
+	"strings"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pmezard/go-difflib/difflib"
 )
 
 func readFunctionOrProcedureArguments(d *schema.ResourceData, args []sdk.NormalizedArgument) error {
@@ -126,3 +134,139 @@ func parseSecretsCommon(d *schema.ResourceData) ([]sdk.SecretReference, error) {
 	}
 	return secretReferences, nil
 }
+
+// definitionFieldNames returns the names of the fields describing the body of a function or a procedure (prefix is either "function" or "procedure").
+func definitionFieldNames(prefix string) (definitionKey string, fileKey string, hashKey string, diffKey string) {
+	definitionKey = prefix + "_definition"
+	return definitionKey, definitionKey + "_file", definitionKey + "_hash", definitionKey + "_diff"
+}
+
+// normalizeDefinition mitigates the whitespace differences between the body sent to Snowflake and the one returned by DESCRIBE,
+// while keeping the indentation (significant e.g. in Python).
+func normalizeDefinition(definition string) string {
+	lines := strings.Split(strings.ReplaceAll(definition, "\r\n", "\n"), "\n")
+	for i := range lines {
+		lines[i] = strings.TrimRight(lines[i], " \t")
+	}
+	return strings.Trim(strings.Join(lines, "\n"), "\n")
+}
+
+func definitionHash(definition string) string {
+	if definition == "" {
+		return ""
+	}
+	hash := sha256.Sum256([]byte(normalizeDefinition(definition)))
+	return hex.EncodeToString(hash[:])
+}
+
+func definitionUnifiedDiff(oldDefinition string, newDefinition string, toFile string) (string, error) {
+	return difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(normalizeDefinition(oldDefinition)),
+		B:        difflib.SplitLines(normalizeDefinition(newDefinition)),
+		FromFile: "snowflake",
+		ToFile:   toFile,
+		Context:  3,
+	})
+}
+
+// suppressDefinitionDiff ignores the inline definition when the body is loaded from a file (the file is tracked by its hash instead).
+func suppressDefinitionDiff(k, oldValue, newValue string, d *schema.ResourceData) bool {
+	if d.Get(k+"_file").(string) != "" {
+		return true
+	}
+	return DiffSuppressStatement(k, oldValue, newValue, d)
+}
+
+// readDefinition returns the body of a function or a procedure either from the inline definition or from the definition file.
+func readDefinition(d *schema.ResourceData, prefix string) (string, error) {
+	definitionKey, fileKey, _, _ := definitionFieldNames(prefix)
+	if path := d.Get(fileKey).(string); path != "" {
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return "", fmt.Errorf("could not read %s %s, err = %w", fileKey, path, err)
+		}
+		return string(content), nil
+	}
+	return d.Get(definitionKey).(string), nil
+}
+
+func setDefinitionInBuilder[T any](d *schema.ResourceData, prefix string, setDefinition func(string) T) error {
+	definition, err := readDefinition(d, prefix)
+	if err != nil {
+		return err
+	}
+	if definition != "" {
+		setDefinition(definition)
+	}
+	return nil
+}
+
+func readFunctionOrProcedureDefinition(d *schema.ResourceData, prefix string, body *string) error {
+	definitionKey, _, hashKey, _ := definitionFieldNames(prefix)
+	definition := ""
+	if body != nil {
+		definition = *body
+	}
+	return errors.Join(
+		setOptionalFromStringPtr(d, definitionKey, body),
+		d.Set(hashKey, definitionHash(definition)),
+	)
+}
+
+// definitionCustomDiff compares the normalized body returned by DESCRIBE with the configured one (inline or loaded from the file),
+// recreates the object when they differ, and shows the unified diff of the bodies in the plan.
+// The diff is computed only for the existing objects. When the object is replaced, the SDK calculates the diff again without the state;
+// the hash and the diff are cleared then, so that the values planned in the first pass are kept (for the new objects, they are unknown).
+func definitionCustomDiff(prefix string) schema.CustomizeDiffFunc {
+	definitionKey, fileKey, hashKey, diffKey := definitionFieldNames(prefix)
+	unknownDefinition := func(diff *schema.ResourceDiff) error {
+		if err := diff.SetNewComputed(hashKey); err != nil {
+			return err
+		}
+		// ForceNew fails for the keys without changes (e.g. when there was no body before)
+		if diff.HasChange(hashKey) {
+			return diff.ForceNew(hashKey)
+		}
+		return nil
+	}
+	return func(_ context.Context, diff *schema.ResourceDiff, _ any) error {
+		if diff.Id() == "" {
+			return errors.Join(diff.Clear(hashKey), diff.Clear(diffKey))
+		}
+		if !diff.NewValueKnown(fileKey) || !diff.NewValueKnown(definitionKey) {
+			return unknownDefinition(diff)
+		}
+		oldDefinition, newDefinition := diff.GetChange(definitionKey)
+
+		path := diff.Get(fileKey).(string)
+		if path == "" {
+			if !diff.HasChange(definitionKey) {
+				return nil
+			}
+			definitionDiff, err := definitionUnifiedDiff(oldDefinition.(string), newDefinition.(string), definitionKey)
+			if err != nil {
+				return err
+			}
+			return errors.Join(diff.SetNew(hashKey, definitionHash(newDefinition.(string))), diff.SetNew(diffKey, definitionDiff))
+		}
+
+		content, err := os.ReadFile(path)
+		switch {
+		case errors.Is(err, fs.ErrNotExist):
+			// the file may be generated by another resource during the apply, e.g. by local_file, whose filename is known during the plan;
+			// if it is still missing, reading the definition fails the apply
+			return unknownDefinition(diff)
+		case err != nil:
+			return err
+		}
+		hash := definitionHash(string(content))
+		if hash == diff.Get(hashKey).(string) {
+			return nil
+		}
+		definitionDiff, err := definitionUnifiedDiff(oldDefinition.(string), string(content), path)
+		if err != nil {
+			return err
+		}
+		return errors.Join(diff.SetNew(hashKey, hash), diff.SetNew(diffKey, definitionDiff), diff.ForceNew(hashKey))
+	}
+}
@@ -0,0 +1,150 @@
+package resources
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_definitionFieldNames(t *testing.T) {
+	definitionKey, fileKey, hashKey, diffKey := definitionFieldNames("function")
+	assert.Equal(t, "function_definition", definitionKey)
+	assert.Equal(t, "function_definition_file", fileKey)
+	assert.Equal(t, "function_definition_hash", hashKey)
+	assert.Equal(t, "function_definition_diff", diffKey)
+}
+
+func Test_normalizeDefinition(t *testing.T) {
+	testCases := []struct {
+		name       string
+		definition string
+		expected   string
+	}{
+		{name: "empty", definition: "", expected: ""},
+		{name: "windows line endings", definition: "def f():\r\n    return 1\r\n", expected: "def f():\n    return 1"},
+		{name: "trailing whitespace in lines", definition: "def f():  \n    return 1\t\n", expected: "def f():\n    return 1"},
+		{name: "surrounding blank lines", definition: "\n\ndef f():\n    return 1\n\n", expected: "def f():\n    return 1"},
+		{name: "indentation kept", definition: "def f():\n\n    return 1", expected: "def f():\n\n    return 1"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, normalizeDefinition(tc.definition))
+		})
+	}
+}
+
+func Test_definitionHash(t *testing.T) {
+	hash := definitionHash("def f():\n    return 1")
+
+	assert.Len(t, hash, 64)
+	assert.Equal(t, hash, definitionHash("\r\ndef f():  \r\n    return 1\r\n"))
+	assert.NotEqual(t, hash, definitionHash("def f():\n  return 1"))
+	assert.Empty(t, definitionHash(""))
+}
+
+func Test_definitionUnifiedDiff(t *testing.T) {
+	diff, err := definitionUnifiedDiff("def f():\n    return 1\n", "def f():\n    return 2\n", "handler.py")
+	require.NoError(t, err)
+	assert.Equal(t, `--- snowflake
++++ handler.py
+@@ -1,2 +1,2 @@
+ def f():
+-    return 1
++    return 2
+`, diff)
+
+	diff, err = definitionUnifiedDiff("def f():\n    return 1", "def f():  \r\n    return 1\r\n", "handler.py")
+	require.NoError(t, err)
+	assert.Empty(t, diff)
+}
+
+func Test_definitionCustomDiff(t *testing.T) {
+	definition := "def f():\n    return 1\n"
+	testResource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"function_definition":      {Type: schema.TypeString, Optional: true, ForceNew: true, DiffSuppressFunc: suppressDefinitionDiff},
+			"function_definition_file": {Type: schema.TypeString, Optional: true},
+			"function_definition_hash": {Type: schema.TypeString, Computed: true},
+			"function_definition_diff": {Type: schema.TypeString, Computed: true},
+		},
+		CustomizeDiff: definitionCustomDiff("function"),
+	}
+	calculateDiff := func(t *testing.T, config map[string]any) *terraform.InstanceDiff {
+		t.Helper()
+		diff, err := testResource.Diff(
+			context.Background(),
+			&terraform.InstanceState{
+				ID: "id",
+				Attributes: map[string]string{
+					"id":                       "id",
+					"function_definition":      definition,
+					"function_definition_hash": definitionHash(definition),
+				},
+			},
+			terraform.NewResourceConfigRaw(config),
+			nil,
+		)
+		require.NoError(t, err)
+		return diff
+	}
+	writeFile := func(t *testing.T, content string) string {
+		t.Helper()
+		path := filepath.Join(t.TempDir(), "handler.py")
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+		return path
+	}
+
+	t.Run("inline definition without changes", func(t *testing.T) {
+		diff := calculateDiff(t, map[string]any{"function_definition": definition})
+		assert.Nil(t, diff)
+	})
+
+	t.Run("inline definition changed", func(t *testing.T) {
+		diff := calculateDiff(t, map[string]any{"function_definition": "def f():\n    return 2\n"})
+		require.NotNil(t, diff)
+		assert.True(t, diff.RequiresNew())
+		assert.Equal(t, definitionHash("def f():\n    return 2"), diff.Attributes["function_definition_hash"].New)
+		assert.Contains(t, diff.Attributes["function_definition_diff"].New, "-    return 1\n+    return 2\n")
+	})
+
+	t.Run("file with whitespace changes only", func(t *testing.T) {
+		path := writeFile(t, "def f():  \r\n    return 1\r\n\r\n")
+		diff := calculateDiff(t, map[string]any{"function_definition_file": path})
+		require.NotNil(t, diff)
+		assert.False(t, diff.RequiresNew())
+		assert.Nil(t, diff.Attributes["function_definition"])
+		assert.Nil(t, diff.Attributes["function_definition_hash"])
+		assert.Equal(t, path, diff.Attributes["function_definition_file"].New)
+	})
+
+	t.Run("file with changed body", func(t *testing.T) {
+		path := writeFile(t, "def f():\n    return 2\n")
+		diff := calculateDiff(t, map[string]any{"function_definition_file": path})
+		require.NotNil(t, diff)
+		assert.True(t, diff.RequiresNew())
+		assert.True(t, diff.Attributes["function_definition_hash"].RequiresNew)
+		assert.Equal(t, definitionHash("def f():\n    return 2"), diff.Attributes["function_definition_hash"].New)
+		assert.Equal(t, "--- snowflake\n+++ "+path+"\n@@ -1,2 +1,2 @@\n def f():\n-    return 1\n+    return 2\n", diff.Attributes["function_definition_diff"].New)
+	})
+
+	t.Run("file does not exist yet", func(t *testing.T) {
+		diff := calculateDiff(t, map[string]any{"function_definition_file": filepath.Join(t.TempDir(), "handler.py")})
+		require.NotNil(t, diff)
+		assert.True(t, diff.RequiresNew())
+		assert.True(t, diff.Attributes["function_definition_hash"].NewComputed)
+	})
+
+	t.Run("file path unknown during plan", func(t *testing.T) {
+		// the value of hcl2shim.UnknownVariableValue (internal to the plugin SDK) marking the unknown values in the raw config
+		diff := calculateDiff(t, map[string]any{"function_definition_file": "74D93920-ED26-11E3-AC10-0800200C9A66"})
+		require.NotNil(t, diff)
+		assert.True(t, diff.RequiresNew())
+		assert.True(t, diff.Attributes["function_definition_hash"].NewComputed)
+	})
+}
@@ -47,10 +47,19 @@ func setUpFunctionSchema(definition functionSchemaDef) map[string]*schema.Schema
 	}
 	if v, ok := currentSchema["function_definition"]; ok && v != nil {
 		v.Description = diffSuppressStatementFieldDescription(definition.functionDefinitionDescription)
+		v.Optional = true
 		if definition.functionDefinitionRequired {
-			v.Required = true
+			v.Description += " Exactly one of `function_definition` and `function_definition_file` has to be set."
+			v.ExactlyOneOf = []string{"function_definition", "function_definition_file"}
 		} else {
-			v.Optional = true
+			v.ConflictsWith = []string{"function_definition_file"}
+		}
+	}
+	if v, ok := currentSchema["function_definition_file"]; ok && v != nil {
+		if definition.functionDefinitionRequired {
+			v.ExactlyOneOf = []string{"function_definition", "function_definition_file"}
+		} else {
+			v.ConflictsWith = []string{"function_definition"}
 		}
 	}
 	if v, ok := currentSchema["runtime_version"]; ok && v != nil {
@@ -91,6 +100,9 @@ var (
 		"return_results_behavior",
 		"comment",
 		"function_definition",
+		"function_definition_file",
+		"function_definition_hash",
+		"function_definition_diff",
 		"function_language",
 		ShowOutputAttributeName,
 		ParametersAttributeName,
@@ -367,7 +379,22 @@ func functionBaseSchema() map[string]schema.Schema {
 		"function_definition": {
 			Type:             schema.TypeString,
 			ForceNew:         true,
-			DiffSuppressFunc: DiffSuppressStatement,
+			DiffSuppressFunc: suppressDefinitionDiff,
+		},
+		"function_definition_file": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Path to the local file containing the `function_definition` (relative paths are resolved against the working directory, so e.g. `\"${path.module}/<file_name>\"` should be used). Alternative to the inline `function_definition`, useful for longer handlers. The file is tracked by the hash of its normalized content (see `function_definition_hash`), and the UDF is recreated when the content changes. Changing only the path does not recreate the UDF.",
+		},
+		"function_definition_hash": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "SHA-256 hash of the normalized body of the UDF (line endings unified, trailing whitespace in lines and surrounding blank lines removed). It is calculated from the body returned by `DESCRIBE FUNCTION`, so changes of the body made outside of Terraform are detected.",
+		},
+		"function_definition_diff": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Unified diff between the body of the UDF in Snowflake and the configured body. It is calculated during the plan when the body changes, and holds the diff of the last change of the body.",
 		},
 		"function_language": {
 			Type:        schema.TypeString,
@@ -32,6 +32,7 @@ func FunctionJava() *schema.Resource {
 			ComputedIfAnyAttributeChanged(javaFunctionSchema, FullyQualifiedNameAttributeName, "name"),
 			ComputedIfAnyAttributeChanged(functionParametersSchema, ParametersAttributeName, collections.Map(sdk.AsStringList(sdk.AllFunctionParameters), strings.ToLower)...),
 			functionParametersCustomDiff,
+			definitionCustomDiff("function"),
 			// The language check is more for the future.
 			// Currently, almost all attributes are marked as forceNew.
 			// When language changes, these attributes also change, causing the object to recreate either way.
@@ -79,7 +80,7 @@ func CreateContextFunctionJava(ctx context.Context, d *schema.ResourceData, meta
 		setExternalAccessIntegrationsInBuilder(d, request.WithExternalAccessIntegrations),
 		setSecretsInBuilder(d, request.WithSecrets),
 		setFunctionTargetPathInBuilder(d, request.WithTargetPath),
-		stringAttributeCreateBuilder(d, "function_definition", request.WithFunctionDefinitionWrapped),
+		setDefinitionInBuilder(d, "function", request.WithFunctionDefinitionWrapped),
 	)
 	if errs != nil {
 		return diag.FromErr(errs)
@@ -134,7 +135,7 @@ func ReadContextFunctionJava(ctx context.Context, d *schema.ResourceData, meta a
 		readFunctionOrProcedureExternalAccessIntegrations(d, allFunctionDetails.functionDetails.NormalizedExternalAccessIntegrations),
 		readFunctionOrProcedureSecrets(d, allFunctionDetails.functionDetails.NormalizedSecrets),
 		readFunctionOrProcedureTargetPath(d, allFunctionDetails.functionDetails.NormalizedTargetPath),
-		setOptionalFromStringPtr(d, "function_definition", allFunctionDetails.functionDetails.Body),
+		readFunctionOrProcedureDefinition(d, "function", allFunctionDetails.functionDetails.Body),
 		d.Set("function_language", allFunctionDetails.functionDetails.Language),
 
 		handleFunctionParameterRead(d, allFunctionDetails.functionParameters),
@@ -32,6 +32,7 @@ func FunctionPython() *schema.Resource {
 			ComputedIfAnyAttributeChanged(pythonFunctionSchema, FullyQualifiedNameAttributeName, "name"),
 			ComputedIfAnyAttributeChanged(functionParametersSchema, ParametersAttributeName, collections.Map(sdk.AsStringList(sdk.AllFunctionParameters), strings.ToLower)...),
 			functionParametersCustomDiff,
+			definitionCustomDiff("function"),
 			// The language check is more for the future.
 			// Currently, almost all attributes are marked as forceNew.
 			// When language changes, these attributes also change, causing the object to recreate either way.
@@ -78,7 +79,7 @@ func CreateContextFunctionPython(ctx context.Context, d *schema.ResourceData, me
 		setFunctionPackagesInBuilder(d, request.WithPackages),
 		setExternalAccessIntegrationsInBuilder(d, request.WithExternalAccessIntegrations),
 		setSecretsInBuilder(d, request.WithSecrets),
-		stringAttributeCreateBuilder(d, "function_definition", request.WithFunctionDefinitionWrapped),
+		setDefinitionInBuilder(d, "function", request.WithFunctionDefinitionWrapped),
 	)
 	if errs != nil {
 		return diag.FromErr(errs)
@@ -132,7 +133,7 @@ func ReadContextFunctionPython(ctx context.Context, d *schema.ResourceData, meta
 		setRequiredFromStringPtr(d, "handler", allFunctionDetails.functionDetails.Handler),
 		readFunctionOrProcedureExternalAccessIntegrations(d, allFunctionDetails.functionDetails.NormalizedExternalAccessIntegrations),
 		readFunctionOrProcedureSecrets(d, allFunctionDetails.functionDetails.NormalizedSecrets),
-		setOptionalFromStringPtr(d, "function_definition", allFunctionDetails.functionDetails.Body),
+		readFunctionOrProcedureDefinition(d, "function", allFunctionDetails.functionDetails.Body),
 		d.Set("function_language", allFunctionDetails.functionDetails.Language),
 
 		handleFunctionParameterRead(d, allFunctionDetails.functionParameters),
@@ -1,6 +1,8 @@
 package resources_test
 
 import (
+	"os"
+	"path/filepath"
 	"testing"
 
 	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
@@ -250,3 +252,80 @@ func TestAcc_FunctionPython_InlineFull(t *testing.T) {
 		},
 	})
 }
+
+func TestAcc_FunctionPython_InlineToDefinitionFile(t *testing.T) {
+	_ = testenvs.GetOrSkipTest(t, testenvs.EnableAcceptance)
+	acc.TestAccPreCheck(t)
+
+	funcName := "some_function"
+	argName := "x"
+	dataType := testdatatypes.DataTypeNumber_36_2
+
+	id := acc.TestClient().Ids.RandomSchemaObjectIdentifierWithArgumentsNewDataTypes(dataType)
+
+	definition := acc.TestClient().Function.SamplePythonDefinition(t, funcName, argName)
+	definitionFile := filepath.Join(t.TempDir(), "handler.py")
+	if err := os.WriteFile(definitionFile, []byte(definition), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	otherDefinitionFile := filepath.Join(t.TempDir(), "other_handler.py")
+	if err := os.WriteFile(otherDefinitionFile, []byte(definition+"\n\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	functionModel := model.FunctionPythonBasicInline("test", id, "3.8", dataType, funcName, definition).
+		WithArgument(argName, dataType)
+	functionModelFromFile := model.FunctionPython("test", id.DatabaseName(), funcName, id.Name(), dataType.ToSql(), "3.8", id.SchemaName()).
+		WithFunctionDefinitionFile(definitionFile).
+		WithArgument(argName, dataType)
+	functionModelFromOtherFile := model.FunctionPython("test", id.DatabaseName(), funcName, id.Name(), dataType.ToSql(), "3.8", id.SchemaName()).
+		WithFunctionDefinitionFile(otherDefinitionFile).
+		WithArgument(argName, dataType)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		CheckDestroy: acc.CheckDestroy(t, resources.FunctionPython),
+		Steps: []resource.TestStep{
+			{
+				Config: config.FromModels(t, functionModel),
+				Check: assertThat(t,
+					resourceassert.FunctionPythonResource(t, functionModel.ResourceReference()).
+						HasFunctionDefinitionString(definition).
+						HasFunctionDefinitionFileString(""),
+					assert.Check(resource.TestCheckResourceAttrSet(functionModel.ResourceReference(), "function_definition_hash")),
+				),
+			},
+			// SWITCH TO FILE WITH THE SAME CONTENT (NO RECREATION)
+			{
+				Config: config.FromModels(t, functionModelFromFile),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(functionModelFromFile.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.FunctionPythonResource(t, functionModelFromFile.ResourceReference()).
+						HasFunctionDefinitionFileString(definitionFile).
+						HasFunctionDefinitionString(definition),
+				),
+			},
+			// CHANGE PATH ONLY (NO RECREATION)
+			{
+				Config: config.FromModels(t, functionModelFromOtherFile),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(functionModelFromOtherFile.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.FunctionPythonResource(t, functionModelFromOtherFile.ResourceReference()).
+						HasFunctionDefinitionFileString(otherDefinitionFile),
+				),
+			},
+		},
+	})
+}
@@ -32,6 +32,7 @@ func FunctionScala() *schema.Resource {
 			ComputedIfAnyAttributeChanged(scalaFunctionSchema, FullyQualifiedNameAttributeName, "name"),
 			ComputedIfAnyAttributeChanged(functionParametersSchema, ParametersAttributeName, collections.Map(sdk.AsStringList(sdk.AllFunctionParameters), strings.ToLower)...),
 			functionParametersCustomDiff,
+			definitionCustomDiff("function"),
 			// The language check is more for the future.
 			// Currently, almost all attributes are marked as forceNew.
 			// When language changes, these attributes also change, causing the object to recreate either way.
@@ -80,7 +81,7 @@ func CreateContextFunctionScala(ctx context.Context, d *schema.ResourceData, met
 		setExternalAccessIntegrationsInBuilder(d, request.WithExternalAccessIntegrations),
 		setSecretsInBuilder(d, request.WithSecrets),
 		setFunctionTargetPathInBuilder(d, request.WithTargetPath),
-		stringAttributeCreateBuilder(d, "function_definition", request.WithFunctionDefinitionWrapped),
+		setDefinitionInBuilder(d, "function", request.WithFunctionDefinitionWrapped),
 	)
 	if errs != nil {
 		return diag.FromErr(errs)
@@ -135,7 +136,7 @@ func ReadContextFunctionScala(ctx context.Context, d *schema.ResourceData, meta
 		readFunctionOrProcedureExternalAccessIntegrations(d, allFunctionDetails.functionDetails.NormalizedExternalAccessIntegrations),
 		readFunctionOrProcedureSecrets(d, allFunctionDetails.functionDetails.NormalizedSecrets),
 		readFunctionOrProcedureTargetPath(d, allFunctionDetails.functionDetails.NormalizedTargetPath),
-		setOptionalFromStringPtr(d, "function_definition", allFunctionDetails.functionDetails.Body),
+		readFunctionOrProcedureDefinition(d, "function", allFunctionDetails.functionDetails.Body),
 		d.Set("function_language", allFunctionDetails.functionDetails.Language),
 
 		handleFunctionParameterRead(d, allFunctionDetails.functionParameters),
@@ -32,6 +32,7 @@ func FunctionSql() *schema.Resource {
 			ComputedIfAnyAttributeChanged(sqlFunctionSchema, FullyQualifiedNameAttributeName, "name"),
 			ComputedIfAnyAttributeChanged(functionParametersSchema, ParametersAttributeName, collections.Map(sdk.AsStringList(sdk.AllFunctionParameters), strings.ToLower)...),
 			functionParametersCustomDiff,
+			definitionCustomDiff("function"),
 			// The language check is more for the future.
 			// Currently, almost all attributes are marked as forceNew.
 			// When language changes, these attributes also change, causing the object to recreate either way.
@@ -61,7 +62,10 @@ func CreateContextFunctionSql(ctx context.Context, d *schema.ResourceData, meta
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	functionDefinition := d.Get("function_definition").(string)
+	functionDefinition, err := readDefinition(d, "function")
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
 	argumentDataTypes := collections.Map(argumentRequests, func(r sdk.FunctionArgumentRequest) datatypes.DataType { return r.ArgDataType })
 	id := sdk.NewSchemaObjectIdentifierWithArgumentsNormalized(database, sc, name, argumentDataTypes...)
@@ -119,7 +123,7 @@ func ReadContextFunctionSql(ctx context.Context, d *schema.ResourceData, meta an
 		// not reading return_results_behavior on purpose (handled as external change to show output)
 		d.Set("comment", allFunctionDetails.function.Description),
 		setRequiredFromStringPtr(d, "handler", allFunctionDetails.functionDetails.Handler),
-		setOptionalFromStringPtr(d, "function_definition", allFunctionDetails.functionDetails.Body),
+		readFunctionOrProcedureDefinition(d, "function", allFunctionDetails.functionDetails.Body),
 		d.Set("function_language", allFunctionDetails.functionDetails.Language),
 
 		handleFunctionParameterRead(d, allFunctionDetails.functionParameters),
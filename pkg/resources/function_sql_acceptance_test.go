@@ -1,6 +1,10 @@
 package resources_test
 
 import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
 	"testing"
 
 	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
@@ -13,6 +17,7 @@ import (
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/model"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/helpers/random"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/importchecks"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/planchecks"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/testdatatypes"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/testenvs"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
@@ -186,3 +191,123 @@ func TestAcc_FunctionSql_InlineFull(t *testing.T) {
 		},
 	})
 }
+
+func TestAcc_FunctionSql_DefinitionFile(t *testing.T) {
+	_ = testenvs.GetOrSkipTest(t, testenvs.EnableAcceptance)
+	acc.TestAccPreCheck(t)
+
+	argName := "x"
+	dataType := testdatatypes.DataTypeFloat
+
+	id := acc.TestClient().Ids.RandomSchemaObjectIdentifierWithArgumentsNewDataTypes(dataType)
+
+	definition := "x\n"
+	changedDefinition := "x + 1\n"
+	definitionFile := filepath.Join(t.TempDir(), "function.sql")
+	writeDefinitionFile := func(content string) func() {
+		return func() {
+			if err := os.WriteFile(definitionFile, []byte(content), 0o600); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	writeDefinitionFile(definition)()
+
+	functionModel := model.FunctionSql("w", id.DatabaseName(), id.Name(), dataType.ToSql(), id.SchemaName()).
+		WithFunctionDefinitionFile(definitionFile).
+		WithArgument(argName, dataType)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		CheckDestroy: acc.CheckDestroy(t, resources.FunctionSql),
+		Steps: []resource.TestStep{
+			// CREATE FROM FILE
+			{
+				Config: config.FromModels(t, functionModel),
+				Check: assertThat(t,
+					resourceassert.FunctionSqlResource(t, functionModel.ResourceReference()).
+						HasNameString(id.Name()).
+						HasFunctionDefinitionFileString(definitionFile).
+						HasFunctionDefinitionDiffString(""),
+					assert.Check(resource.TestCheckResourceAttrSet(functionModel.ResourceReference(), "function_definition_hash")),
+				),
+			},
+			// WHITESPACE CHANGES IN FILE (NO CHANGES)
+			{
+				PreConfig: writeDefinitionFile("\r\nx  \r\n\r\n"),
+				Config:    config.FromModels(t, functionModel),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			// CHANGE BODY IN FILE (RECREATE)
+			{
+				PreConfig: writeDefinitionFile(changedDefinition),
+				Config:    config.FromModels(t, functionModel),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(functionModel.ResourceReference(), plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.FunctionSqlResource(t, functionModel.ResourceReference()).
+						HasFunctionDefinitionString(changedDefinition),
+					assert.Check(resource.TestMatchResourceAttr(functionModel.ResourceReference(), "function_definition_diff", regexp.MustCompile(regexp.QuoteMeta("\n-x\n+x + 1\n")))),
+				),
+			},
+			// CHANGE BODY EXTERNALLY (DETECT AND RECREATE)
+			{
+				PreConfig: func() {
+					acc.TestClient().Function.DropFunctionFunc(t, id)()
+					_, _ = acc.TestClient().Function.CreateSqlWithIdentifierAndArgument(t, id.SchemaObjectId(), dataType)
+				},
+				Config: config.FromModels(t, functionModel),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(functionModel.ResourceReference(), plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.FunctionSqlResource(t, functionModel.ResourceReference()).
+						HasFunctionDefinitionString(changedDefinition),
+				),
+			},
+			// MISSING FILE (UNKNOWN HASH, RECREATE FAILS ON READING THE FILE)
+			{
+				PreConfig: func() {
+					if err := os.Remove(definitionFile); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: config.FromModels(t, functionModel),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(functionModel.ResourceReference(), plancheck.ResourceActionDestroyBeforeCreate),
+						planchecks.ExpectComputed(functionModel.ResourceReference(), "function_definition_hash", true),
+					},
+				},
+				ExpectError: regexp.MustCompile(fmt.Sprintf("could not read function_definition_file %s", regexp.QuoteMeta(definitionFile))),
+			},
+			// FILE RESTORED (CREATE)
+			{
+				PreConfig: writeDefinitionFile(changedDefinition),
+				Config:    config.FromModels(t, functionModel),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(functionModel.ResourceReference(), plancheck.ResourceActionCreate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.FunctionSqlResource(t, functionModel.ResourceReference()).
+						HasFunctionDefinitionString(changedDefinition),
+				),
+			},
+		},
+	})
+}
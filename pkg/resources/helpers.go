@@ -48,7 +48,11 @@ func getTagsFromList(tags []any) []sdk.TagAssociation {
 
 func GetTagsDiff(d *schema.ResourceData, key string) (unsetTags []sdk.ObjectIdentifier, setTags []sdk.TagAssociation) {
 	o, n := d.GetChange(key)
-	removed, added, changed := getTags(o).diffs(getTags(n))
+	return getTagsDiffFromLists(getTags(o), getTags(n))
+}
+
+func getTagsDiffFromLists(o tags, n tags) (unsetTags []sdk.ObjectIdentifier, setTags []sdk.TagAssociation) {
+	removed, added, changed := o.diffs(n)
 
 	unsetTags = make([]sdk.ObjectIdentifier, len(removed))
 	for i, t := range removed {
@@ -296,6 +300,20 @@ func parseSchemaObjectIdentifierSet(v any) ([]sdk.SchemaObjectIdentifier, error)
 	return ids, nil
 }
 
+// parseAccountObjectIdentifierSet is a helper function to parse a given account object identifier list from ResourceData.
+func parseAccountObjectIdentifierSet(v any) ([]sdk.AccountObjectIdentifier, error) {
+	idsRaw := expandStringList(v.(*schema.Set).List())
+	ids := make([]sdk.AccountObjectIdentifier, len(idsRaw))
+	for i, idRaw := range idsRaw {
+		id, err := sdk.ParseAccountObjectIdentifier(idRaw)
+		if err != nil {
+			return nil, err
+		}
+		ids[i] = id
+	}
+	return ids, nil
+}
+
 type PlanCheckFunc func(ctx context.Context, req plancheck.CheckPlanRequest, resp *plancheck.CheckPlanResponse)
 
 func (fn PlanCheckFunc) CheckPlan(ctx context.Context, req plancheck.CheckPlanRequest, resp *plancheck.CheckPlanResponse) {
@@ -0,0 +1,468 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/schemas"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// hybridTableSystemIndexPrefix is the prefix of the indexes created by Snowflake automatically for the primary key and unique constraints.
+const hybridTableSystemIndexPrefix = "SYS_INDEX_"
+
+var hybridTableSchema = map[string]*schema.Schema{
+	"name": {
+		Type:             schema.TypeString,
+		Required:         true,
+		Description:      blocklistedCharactersFieldDescription("Specifies the identifier for the hybrid table; must be unique for the database and schema in which the hybrid table is created."),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+	},
+	"database": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		Description:      blocklistedCharactersFieldDescription("The database in which to create the hybrid table."),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+	},
+	"schema": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		Description:      blocklistedCharactersFieldDescription("The schema in which to create the hybrid table."),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+	},
+	"column": {
+		Type:        schema.TypeList,
+		Required:    true,
+		ForceNew:    true,
+		MinItems:    1,
+		Description: "Definitions of the columns to create in the hybrid table. Changing any of the columns recreates the hybrid table.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:        schema.TypeString,
+					Required:    true,
+					ForceNew:    true,
+					Description: "Column name.",
+				},
+				"type": {
+					Type:             schema.TypeString,
+					Required:         true,
+					ForceNew:         true,
+					Description:      "Column type, e.g. NUMBER. For a full list of column types, see [Summary of Data Types](https://docs.snowflake.com/en/sql-reference/intro-summary-data-types).",
+					DiffSuppressFunc: DiffSuppressDataTypes,
+				},
+				"nullable": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					ForceNew:    true,
+					Default:     true,
+					Description: "Whether this column can contain null values. Columns that are part of the primary key are always not nullable.",
+				},
+				"comment": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					ForceNew:    true,
+					Description: "Column comment.",
+				},
+			},
+		},
+	},
+	"primary_key": {
+		Type:        schema.TypeList,
+		Required:    true,
+		ForceNew:    true,
+		MaxItems:    1,
+		Description: "Definitions of the primary key constraint. Hybrid tables require a primary key. Changing the primary key recreates the hybrid table.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					ForceNew:    true,
+					Description: externalChangesNotDetectedFieldDescription("Name of the primary key constraint."),
+				},
+				"keys": {
+					Type:        schema.TypeList,
+					Required:    true,
+					ForceNew:    true,
+					MinItems:    1,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: "Columns to use in the primary key.",
+				},
+			},
+		},
+	},
+	"index": {
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: "Definitions of the secondary indexes of the hybrid table. Changing an index drops it and creates it again.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "Name of the index.",
+				},
+				"columns": {
+					Type:        schema.TypeList,
+					Required:    true,
+					MinItems:    1,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: "Columns on which the index is created.",
+				},
+				"include_columns": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: "Additional columns included in the index to avoid lookups in the base table.",
+				},
+			},
+		},
+	},
+	"comment": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies a comment for the hybrid table.",
+	},
+	ShowOutputAttributeName: {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Outputs the result of `SHOW HYBRID TABLES` for the given hybrid table.",
+		Elem: &schema.Resource{
+			Schema: schemas.ShowHybridTableSchema,
+		},
+	},
+	FullyQualifiedNameAttributeName: schemas.FullyQualifiedNameSchema,
+}
+
+func HybridTable() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: PreviewFeatureCreateContextWrapper(string(previewfeatures.HybridTableResource), TrackingCreateWrapper(resources.HybridTable, CreateHybridTable)),
+		ReadContext:   PreviewFeatureReadContextWrapper(string(previewfeatures.HybridTableResource), TrackingReadWrapper(resources.HybridTable, ReadHybridTable)),
+		UpdateContext: PreviewFeatureUpdateContextWrapper(string(previewfeatures.HybridTableResource), TrackingUpdateWrapper(resources.HybridTable, UpdateHybridTable)),
+		DeleteContext: PreviewFeatureDeleteContextWrapper(string(previewfeatures.HybridTableResource), TrackingDeleteWrapper(resources.HybridTable, DeleteHybridTable)),
+		Description:   "Resource used to manage hybrid table objects. For more information, check [hybrid table documentation](https://docs.snowflake.com/en/sql-reference/sql/create-hybrid-table).",
+
+		CustomizeDiff: TrackingCustomDiffWrapper(resources.HybridTable, customdiff.All(
+			ComputedIfAnyAttributeChanged(hybridTableSchema, ShowOutputAttributeName, "name", "comment"),
+			ComputedIfAnyAttributeChanged(hybridTableSchema, FullyQualifiedNameAttributeName, "name"),
+		)),
+
+		Schema: hybridTableSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: TrackingImportWrapper(resources.HybridTable, ImportName[sdk.SchemaObjectIdentifier]),
+		},
+		Timeouts: defaultTimeouts,
+	}
+}
+
+type hybridTableIndex struct {
+	name           string
+	columns        []string
+	includeColumns []string
+}
+
+func (i hybridTableIndex) equals(other hybridTableIndex) bool {
+	return i.name == other.name && slices.Equal(i.columns, other.columns) && slices.Equal(i.includeColumns, other.includeColumns)
+}
+
+func (i hybridTableIndex) toMap() map[string]any {
+	return map[string]any{
+		"name":            i.name,
+		"columns":         i.columns,
+		"include_columns": i.includeColumns,
+	}
+}
+
+func getHybridTableIndexes(v any) []hybridTableIndex {
+	indexesRaw := v.([]any)
+	indexes := make([]hybridTableIndex, len(indexesRaw))
+	for i, indexRaw := range indexesRaw {
+		index := indexRaw.(map[string]any)
+		indexes[i] = hybridTableIndex{
+			name:           index["name"].(string),
+			columns:        expandStringList(index["columns"].([]any)),
+			includeColumns: expandStringList(index["include_columns"].([]any)),
+		}
+	}
+	return indexes
+}
+
+func CreateHybridTable(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	databaseName, schemaName, name := d.Get("database").(string), d.Get("schema").(string), d.Get("name").(string)
+	id := sdk.NewSchemaObjectIdentifier(databaseName, schemaName, name)
+
+	columnsRaw := d.Get("column").([]any)
+	columns := make([]sdk.TableColumn, len(columnsRaw))
+	for i, columnRaw := range columnsRaw {
+		column := columnRaw.(map[string]any)
+		columns[i] = sdk.TableColumn{
+			Name: fmt.Sprintf("\"%s\"", column["name"].(string)),
+			Type: sdk.DataType(column["type"].(string)),
+		}
+		if !column["nullable"].(bool) {
+			columns[i].NotNull = sdk.Bool(true)
+		}
+		if comment := column["comment"].(string); comment != "" {
+			columns[i].Comment = sdk.String(comment)
+		}
+	}
+
+	primaryKey := d.Get("primary_key").([]any)[0].(map[string]any)
+	primaryKeyConstraint := sdk.OutOfLineConstraint{
+		Type:    sdk.ColumnConstraintTypePrimaryKey,
+		Columns: snowflake.QuoteStringList(expandStringList(primaryKey["keys"].([]any))),
+	}
+	if constraintName := primaryKey["name"].(string); constraintName != "" {
+		primaryKeyConstraint.Name = sdk.String(constraintName)
+	}
+
+	indexes := getHybridTableIndexes(d.Get("index"))
+	outOfLineIndexes := make([]sdk.HybridTableOutOfLineIndex, len(indexes))
+	for i, index := range indexes {
+		outOfLineIndexes[i] = sdk.HybridTableOutOfLineIndex{
+			Name:           index.name,
+			Columns:        snowflake.QuoteStringList(index.columns),
+			IncludeColumns: snowflake.QuoteStringList(index.includeColumns),
+		}
+	}
+
+	request := sdk.NewCreateHybridTableRequest(id, sdk.HybridTableColumnsConstraintsAndIndexes{
+		Columns:             columns,
+		OutOfLineConstraint: []sdk.OutOfLineConstraint{primaryKeyConstraint},
+		OutOfLineIndex:      outOfLineIndexes,
+	})
+	if err := stringAttributeCreate(d, "comment", &request.Comment); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := client.HybridTables.Create(ctx, request); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(helpers.EncodeResourceIdentifier(id))
+
+	return ReadHybridTable(ctx, d, meta)
+}
+
+func ReadHybridTable(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseSchemaObjectIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	hybridTable, err := client.HybridTables.ShowByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, sdk.ErrObjectNotFound) {
+			d.SetId("")
+			return diag.Diagnostics{
+				diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  "Failed to query hybrid table. Marking the resource as removed.",
+					Detail:   fmt.Sprintf("Hybrid table: %s, Err: %s", id.FullyQualifiedName(), err),
+				},
+			}
+		}
+		return diag.FromErr(err)
+	}
+
+	columnDetails, err := client.Tables.DescribeColumns(ctx, sdk.NewDescribeTableColumnsRequest(id))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	indexes, err := client.HybridTables.ShowIndexes(ctx, sdk.NewShowHybridTableIndexesRequest().WithIn(sdk.HybridTableIndexesIn{Table: id}))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	errs := errors.Join(
+		d.Set("name", hybridTable.Name),
+		d.Set("database", hybridTable.DatabaseName),
+		d.Set("schema", hybridTable.SchemaName),
+		d.Set("comment", hybridTable.Comment),
+		d.Set("column", toHybridTableColumnsConfig(d, columnDetails)),
+		d.Set("primary_key", toHybridTablePrimaryKeyConfig(d, columnDetails)),
+		d.Set("index", toHybridTableIndexesConfig(d, indexes)),
+		d.Set(ShowOutputAttributeName, []map[string]any{schemas.HybridTableToSchema(hybridTable)}),
+		d.Set(FullyQualifiedNameAttributeName, id.FullyQualifiedName()),
+	)
+	if errs != nil {
+		return diag.FromErr(errs)
+	}
+
+	return nil
+}
+
+func toHybridTableColumnsConfig(d *schema.ResourceData, columnDetails []sdk.TableColumnDetails) []any {
+	nullableInState := make(map[string]bool)
+	for _, columnRaw := range d.Get("column").([]any) {
+		column := columnRaw.(map[string]any)
+		nullableInState[column["name"].(string)] = column["nullable"].(bool)
+	}
+
+	columns := make([]any, 0)
+	for _, details := range columnDetails {
+		if details.Kind != "COLUMN" {
+			continue
+		}
+		nullable := details.IsNullable
+		// Primary key columns are always reported as not nullable, so the value from the configuration (or the default, e.g. after import) is kept to prevent permanent plan.
+		if details.IsPrimary {
+			nullable = true
+			if v, ok := nullableInState[details.Name]; ok {
+				nullable = v
+			}
+		}
+		column := map[string]any{
+			"name":     details.Name,
+			"type":     string(details.Type),
+			"nullable": nullable,
+		}
+		if details.Comment != nil {
+			column["comment"] = *details.Comment
+		}
+		columns = append(columns, column)
+	}
+	return columns
+}
+
+func toHybridTablePrimaryKeyConfig(d *schema.ResourceData, columnDetails []sdk.TableColumnDetails) []any {
+	keys := make([]string, 0)
+	for _, details := range columnDetails {
+		if details.IsPrimary {
+			keys = append(keys, details.Name)
+		}
+	}
+
+	// The constraint name is not returned by DESCRIBE TABLE and the keys are returned in the column order, so the configuration is kept when it still matches Snowflake.
+	primaryKey := map[string]any{
+		"name": "",
+		"keys": keys,
+	}
+	if v, ok := d.GetOk("primary_key"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
+		primaryKeyInState := v.([]any)[0].(map[string]any)
+		primaryKey["name"] = primaryKeyInState["name"]
+		keysInState := expandStringList(primaryKeyInState["keys"].([]any))
+		if added, removed := ListDiff(keysInState, keys); len(added) == 0 && len(removed) == 0 {
+			primaryKey["keys"] = keysInState
+		}
+	}
+	return []any{primaryKey}
+}
+
+func toHybridTableIndexesConfig(d *schema.ResourceData, indexes []sdk.HybridTableIndex) []any {
+	indexesInSnowflake := make([]hybridTableIndex, 0)
+	for _, index := range indexes {
+		if strings.HasPrefix(index.Name, hybridTableSystemIndexPrefix) {
+			continue
+		}
+		indexesInSnowflake = append(indexesInSnowflake, hybridTableIndex{
+			name:           index.Name,
+			columns:        index.Columns,
+			includeColumns: index.IncludedColumns,
+		})
+	}
+
+	// Indexes are returned in arbitrary order, so the order from the state is preserved for the indexes that are still present.
+	result := make([]any, 0, len(indexesInSnowflake))
+	for _, indexInState := range getHybridTableIndexes(d.Get("index")) {
+		if idx := slices.IndexFunc(indexesInSnowflake, func(index hybridTableIndex) bool { return index.name == indexInState.name }); idx != -1 {
+			result = append(result, indexesInSnowflake[idx].toMap())
+			indexesInSnowflake = slices.Delete(indexesInSnowflake, idx, idx+1)
+		}
+	}
+	for _, index := range indexesInSnowflake {
+		result = append(result, index.toMap())
+	}
+	return result
+}
+
+func UpdateHybridTable(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseSchemaObjectIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("name") {
+		newId := sdk.NewSchemaObjectIdentifierInSchema(id.SchemaId(), d.Get("name").(string))
+
+		err := client.HybridTables.Alter(ctx, sdk.NewAlterHybridTableRequest(id).WithRenameTo(newId))
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error renaming hybrid table %v err = %w", d.Id(), err))
+		}
+
+		d.SetId(helpers.EncodeResourceIdentifier(newId))
+		id = newId
+	}
+
+	if d.HasChange("comment") {
+		if comment := d.Get("comment").(string); comment == "" {
+			if err := client.HybridTables.Alter(ctx, sdk.NewAlterHybridTableRequest(id).WithUnsetComment(true)); err != nil {
+				return diag.FromErr(fmt.Errorf("error unsetting comment for hybrid table %v err = %w", d.Id(), err))
+			}
+		} else {
+			if err := client.HybridTables.Alter(ctx, sdk.NewAlterHybridTableRequest(id).WithSetComment(comment)); err != nil {
+				return diag.FromErr(fmt.Errorf("error setting comment for hybrid table %v err = %w", d.Id(), err))
+			}
+		}
+	}
+
+	if d.HasChange("index") {
+		oldIndexesRaw, newIndexesRaw := d.GetChange("index")
+		oldIndexes, newIndexes := getHybridTableIndexes(oldIndexesRaw), getHybridTableIndexes(newIndexesRaw)
+
+		for _, oldIndex := range oldIndexes {
+			if !slices.ContainsFunc(newIndexes, oldIndex.equals) {
+				err := client.HybridTables.DropIndex(ctx, sdk.NewDropIndexHybridTableRequest(sdk.NewHybridTableIndexIdentifier(id, oldIndex.name)).WithIfExists(true))
+				if err != nil {
+					return diag.FromErr(fmt.Errorf("error dropping index %s for hybrid table %v err = %w", oldIndex.name, d.Id(), err))
+				}
+			}
+		}
+		for _, newIndex := range newIndexes {
+			if !slices.ContainsFunc(oldIndexes, newIndex.equals) {
+				request := sdk.NewCreateIndexHybridTableRequest(newIndex.name, id, snowflake.QuoteStringList(newIndex.columns))
+				if len(newIndex.includeColumns) > 0 {
+					request.WithIncludeColumns(snowflake.QuoteStringList(newIndex.includeColumns))
+				}
+				if err := client.HybridTables.CreateIndex(ctx, request); err != nil {
+					return diag.FromErr(fmt.Errorf("error creating index %s for hybrid table %v err = %w", newIndex.name, d.Id(), err))
+				}
+			}
+		}
+	}
+
+	return ReadHybridTable(ctx, d, meta)
+}
+
+func DeleteHybridTable(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseSchemaObjectIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := client.HybridTables.Drop(ctx, sdk.NewDropHybridTableRequest(id).WithIfExists(true)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,236 @@
+package resources_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/helpers/random"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/testenvs"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_HybridTable_BasicFlow(t *testing.T) {
+	_ = testenvs.GetOrSkipTest(t, testenvs.EnableAcceptance)
+	acc.TestAccPreCheck(t)
+
+	id := acc.TestClient().Ids.RandomSchemaObjectIdentifier()
+	newId := acc.TestClient().Ids.RandomSchemaObjectIdentifier()
+	comment := random.Comment()
+	resourceReference := "snowflake_hybrid_table.test"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: acc.CheckDestroy(t, resources.HybridTable),
+		Steps: []resource.TestStep{
+			// create
+			{
+				Config: hybridTableConfig(id, "", ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceReference, "name", id.Name()),
+					resource.TestCheckResourceAttr(resourceReference, "database", id.DatabaseName()),
+					resource.TestCheckResourceAttr(resourceReference, "schema", id.SchemaName()),
+					resource.TestCheckResourceAttr(resourceReference, "fully_qualified_name", id.FullyQualifiedName()),
+					resource.TestCheckResourceAttr(resourceReference, "comment", ""),
+					resource.TestCheckResourceAttr(resourceReference, "column.#", "3"),
+					resource.TestCheckResourceAttr(resourceReference, "column.0.name", "ID"),
+					resource.TestCheckResourceAttr(resourceReference, "column.0.type", "NUMBER(38,0)"),
+					resource.TestCheckResourceAttr(resourceReference, "column.1.name", "EMAIL"),
+					resource.TestCheckResourceAttr(resourceReference, "column.1.nullable", "false"),
+					resource.TestCheckResourceAttr(resourceReference, "column.2.name", "NAME"),
+					resource.TestCheckResourceAttr(resourceReference, "column.2.comment", "name column"),
+					resource.TestCheckResourceAttr(resourceReference, "primary_key.#", "1"),
+					resource.TestCheckResourceAttr(resourceReference, "primary_key.0.keys.#", "1"),
+					resource.TestCheckResourceAttr(resourceReference, "primary_key.0.keys.0", "ID"),
+					resource.TestCheckResourceAttr(resourceReference, "index.#", "0"),
+					resource.TestCheckResourceAttr(resourceReference, "show_output.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceReference, "show_output.0.created_on"),
+					resource.TestCheckResourceAttr(resourceReference, "show_output.0.name", id.Name()),
+					resource.TestCheckResourceAttr(resourceReference, "show_output.0.database_name", id.DatabaseName()),
+					resource.TestCheckResourceAttr(resourceReference, "show_output.0.schema_name", id.SchemaName()),
+					resource.TestCheckResourceAttr(resourceReference, "show_output.0.comment", ""),
+				),
+			},
+			// import
+			{
+				ResourceName:            resourceReference,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"primary_key.0.name"},
+			},
+			// set comment and add index
+			{
+				Config: hybridTableConfig(id, comment, hybridTableIndexConfig("IDX_NAME", []string{"NAME"}, []string{"EMAIL"})),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceReference, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceReference, "comment", comment),
+					resource.TestCheckResourceAttr(resourceReference, "show_output.0.comment", comment),
+					resource.TestCheckResourceAttr(resourceReference, "index.#", "1"),
+					resource.TestCheckResourceAttr(resourceReference, "index.0.name", "IDX_NAME"),
+					resource.TestCheckResourceAttr(resourceReference, "index.0.columns.#", "1"),
+					resource.TestCheckResourceAttr(resourceReference, "index.0.columns.0", "NAME"),
+					resource.TestCheckResourceAttr(resourceReference, "index.0.include_columns.#", "1"),
+					resource.TestCheckResourceAttr(resourceReference, "index.0.include_columns.0", "EMAIL"),
+				),
+			},
+			// change index in place
+			{
+				Config: hybridTableConfig(id, comment, hybridTableIndexConfig("IDX_NAME", []string{"NAME", "EMAIL"}, nil)),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceReference, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceReference, "index.#", "1"),
+					resource.TestCheckResourceAttr(resourceReference, "index.0.columns.#", "2"),
+					resource.TestCheckResourceAttr(resourceReference, "index.0.include_columns.#", "0"),
+				),
+			},
+			// rename, unset comment and remove index
+			{
+				Config: hybridTableConfig(newId, "", ""),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceReference, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceReference, "name", newId.Name()),
+					resource.TestCheckResourceAttr(resourceReference, "fully_qualified_name", newId.FullyQualifiedName()),
+					resource.TestCheckResourceAttr(resourceReference, "comment", ""),
+					resource.TestCheckResourceAttr(resourceReference, "index.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAcc_HybridTable_ExternalIndexChange(t *testing.T) {
+	_ = testenvs.GetOrSkipTest(t, testenvs.EnableAcceptance)
+	acc.TestAccPreCheck(t)
+
+	id := acc.TestClient().Ids.RandomSchemaObjectIdentifier()
+	resourceReference := "snowflake_hybrid_table.test"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: acc.CheckDestroy(t, resources.HybridTable),
+		Steps: []resource.TestStep{
+			{
+				Config: hybridTableConfig(id, "", ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceReference, "index.#", "0"),
+				),
+			},
+			// index created outside of Terraform is dropped
+			{
+				PreConfig: func() {
+					acc.TestClient().HybridTable.CreateIndex(t, id, "EXTERNAL_IDX", []string{`"NAME"`})
+				},
+				Config: hybridTableConfig(id, "", ""),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceReference, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceReference, "index.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAcc_HybridTable_ImportWithIndex(t *testing.T) {
+	_ = testenvs.GetOrSkipTest(t, testenvs.EnableAcceptance)
+	acc.TestAccPreCheck(t)
+
+	id := acc.TestClient().Ids.RandomSchemaObjectIdentifier()
+	resourceReference := "snowflake_hybrid_table.test"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: acc.CheckDestroy(t, resources.HybridTable),
+		Steps: []resource.TestStep{
+			{
+				Config: hybridTableConfig(id, "", hybridTableIndexConfig("IDX_NAME", []string{"NAME"}, nil)),
+			},
+			{
+				ResourceName:            resourceReference,
+				ImportState:             true,
+				ImportStateId:           helpers.EncodeResourceIdentifier(id),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"primary_key.0.name"},
+			},
+		},
+	})
+}
+
+func hybridTableIndexConfig(name string, columns []string, includeColumns []string) string {
+	includeColumnsConfig := ""
+	if len(includeColumns) > 0 {
+		includeColumnsConfig = fmt.Sprintf(`include_columns = ["%s"]`, strings.Join(includeColumns, `", "`))
+	}
+	return fmt.Sprintf(`
+  index {
+    name    = "%[1]s"
+    columns = ["%[2]s"]
+    %[3]s
+  }
+`, name, strings.Join(columns, `", "`), includeColumnsConfig)
+}
+
+func hybridTableConfig(id sdk.SchemaObjectIdentifier, comment string, indexConfig string) string {
+	return fmt.Sprintf(`
+resource "snowflake_hybrid_table" "test" {
+  database = "%[1]s"
+  schema   = "%[2]s"
+  name     = "%[3]s"
+  comment  = "%[4]s"
+
+  column {
+    name = "ID"
+    type = "NUMBER(38,0)"
+  }
+  column {
+    name     = "EMAIL"
+    type     = "VARCHAR(256)"
+    nullable = false
+  }
+  column {
+    name    = "NAME"
+    type    = "VARCHAR(256)"
+    comment = "name column"
+  }
+
+  primary_key {
+    name = "PK_ID"
+    keys = ["ID"]
+  }
+%[5]s
+}
+`, id.DatabaseName(), id.SchemaName(), id.Name(), comment, indexConfig)
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider/validators"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/schemas"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
@@ -59,6 +60,12 @@ var networkPolicySchema = map[string]*schema.Schema{
 		Optional:    true,
 		Description: "Specifies one or more IPv4 addresses (CIDR notation) that are denied access to your Snowflake account. **Do not** add `0.0.0.0/0` to `blocked_ip_list`, in order to block all IP addresses except a select list, you only need to add IP addresses to `allowed_ip_list`.",
 	},
+	"reject_overlapping_ip_lists": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "When enabled, the plan fails if any of the addresses from `blocked_ip_list` overlaps with any of the addresses from `allowed_ip_list`, and if any of the entries in both lists is not a valid IPv4 address or CIDR. Snowflake accepts overlapping lists (blocked addresses take precedence over the allowed ones), so enable this mode only when your allowed and blocked ranges are meant to be disjoint. The field is not sent to Snowflake; it is used by the provider only.",
+	},
 	"comment": {
 		Type:        schema.TypeString,
 		Optional:    true,
@@ -94,6 +101,7 @@ func NetworkPolicy() *schema.Resource {
 		Description:   "Resource used to control network traffic. For more information, check an [official guide](https://docs.snowflake.com/en/user-guide/network-policies) on controlling network traffic with network policies.",
 
 		CustomizeDiff: TrackingCustomDiffWrapper(resources.NetworkPolicy, customdiff.All(
+			validateNetworkPolicyIpListsDoNotOverlap,
 			// For now, allowed_network_rule_list and blocked_network_rule_list have to stay commented.
 			// The main issue lays in the old Terraform SDK and how its handling DiffSuppression and CustomizeDiff
 			// for complex types like Sets, Lists, and Maps. When every element of the Set is suppressed in custom diff,
@@ -120,12 +128,34 @@ func NetworkPolicy() *schema.Resource {
 		)),
 
 		Importer: &schema.ResourceImporter{
-			StateContext: TrackingImportWrapper(resources.NetworkPolicy, ImportName[sdk.AccountObjectIdentifier]),
+			StateContext: TrackingImportWrapper(resources.NetworkPolicy, ImportNetworkPolicy),
 		},
 		Timeouts: defaultTimeouts,
 	}
 }
 
+func ImportNetworkPolicy(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	if _, err := ImportName[sdk.AccountObjectIdentifier](ctx, d, meta); err != nil {
+		return nil, err
+	}
+	if err := d.Set("reject_overlapping_ip_lists", false); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+// validateNetworkPolicyIpListsDoNotOverlap rejects overlapping allowed and blocked IP lists during plan when reject_overlapping_ip_lists is enabled.
+// The check is skipped when any of the lists is not known yet (e.g. it's computed from another resource).
+func validateNetworkPolicyIpListsDoNotOverlap(_ context.Context, d *schema.ResourceDiff, _ any) error {
+	if !d.Get("reject_overlapping_ip_lists").(bool) || !d.NewValueKnown("allowed_ip_list") || !d.NewValueKnown("blocked_ip_list") {
+		return nil
+	}
+	return validators.ValidateNonOverlappingIpLists(
+		expandStringList(d.Get("allowed_ip_list").(*schema.Set).List()),
+		expandStringList(d.Get("blocked_ip_list").(*schema.Set).List()),
+	)
+}
+
 func CreateContextNetworkPolicy(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	id, err := sdk.ParseAccountObjectIdentifier(d.Get("name").(string))
 	if err != nil {
@@ -145,6 +145,7 @@ func TestAcc_NetworkPolicy_Basic(t *testing.T) {
 					importchecks.TestCheckResourceAttrInstanceState(resourcehelpers.EncodeResourceIdentifier(id), "allowed_network_rule_list.#", "2"),
 					importchecks.TestCheckResourceAttrInstanceState(resourcehelpers.EncodeResourceIdentifier(id), "blocked_network_rule_list.#", "2"),
 					importchecks.TestCheckResourceAttrInstanceState(resourcehelpers.EncodeResourceIdentifier(id), "comment", comment),
+					importchecks.TestCheckResourceAttrInstanceState(resourcehelpers.EncodeResourceIdentifier(id), "reject_overlapping_ip_lists", "false"),
 				),
 			},
 			// change externally
@@ -381,6 +382,63 @@ func networkPolicyConfigInvalidBlockedIpListValue(networkPolicyId sdk.AccountObj
 	}`, networkPolicyId.Name())
 }
 
+func TestAcc_NetworkPolicy_RejectOverlappingIpLists(t *testing.T) {
+	_ = testenvs.GetOrSkipTest(t, testenvs.EnableAcceptance)
+	acc.TestAccPreCheck(t)
+
+	id := acc.TestClient().Ids.RandomAccountObjectIdentifier()
+
+	networkPolicyModelOverlapping := model.NetworkPolicy("test", id.Name()).
+		WithAllowedIps("192.168.1.0/24").
+		WithBlockedIps("192.168.1.99")
+	networkPolicyModelOverlappingRejected := model.NetworkPolicy("test", id.Name()).
+		WithAllowedIps("192.168.1.0/24").
+		WithBlockedIps("192.168.1.99").
+		WithRejectOverlappingIpLists(true)
+	networkPolicyModelDisjoint := model.NetworkPolicy("test", id.Name()).
+		WithAllowedIps("192.168.1.0/24").
+		WithBlockedIps("192.168.2.99").
+		WithRejectOverlappingIpLists(true)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: acc.CheckDestroy(t, resources.NetworkPolicy),
+		Steps: []resource.TestStep{
+			// overlapping lists are allowed by default
+			{
+				Config: accconfig.FromModels(t, networkPolicyModelOverlapping),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(networkPolicyModelOverlapping.ResourceReference(), "reject_overlapping_ip_lists", "false"),
+					resource.TestCheckResourceAttr(networkPolicyModelOverlapping.ResourceReference(), "allowed_ip_list.#", "1"),
+					resource.TestCheckResourceAttr(networkPolicyModelOverlapping.ResourceReference(), "blocked_ip_list.#", "1"),
+				),
+			},
+			{
+				Config:      accconfig.FromModels(t, networkPolicyModelOverlappingRejected),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("blocked IP range 192.168.1.99 overlaps with allowed IP range 192.168.1.0/24"),
+			},
+			{
+				Config: accconfig.FromModels(t, networkPolicyModelDisjoint),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(networkPolicyModelDisjoint.ResourceReference(), "reject_overlapping_ip_lists", "true"),
+					resource.TestCheckTypeSetElemAttr(networkPolicyModelDisjoint.ResourceReference(), "blocked_ip_list.*", "192.168.2.99"),
+				),
+			},
+			{
+				ResourceName:            networkPolicyModelDisjoint.ResourceReference(),
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"reject_overlapping_ip_lists"},
+			},
+		},
+	})
+}
+
 func TestAcc_NetworkPolicy_InvalidNetworkRuleIds(t *testing.T) {
 	_ = testenvs.GetOrSkipTest(t, testenvs.EnableAcceptance)
 	acc.TestAccPreCheck(t)
@@ -5,63 +5,84 @@ import (
 	"errors"
 	"fmt"
 
-	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
-	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
-
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider/validators"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/schemas"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
-
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 var networkRuleSchema = map[string]*schema.Schema{
 	"name": {
-		Type:        schema.TypeString,
-		Required:    true,
-		Description: "Specifies the identifier for the network rule; must be unique for the database and schema in which the network rule is created.",
-		ForceNew:    true,
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		DiffSuppressFunc: suppressIdentifierQuoting,
+		Description:      blocklistedCharactersFieldDescription("Specifies the identifier for the network rule; must be unique for the database and schema in which the network rule is created."),
 	},
 	"schema": {
-		Type:        schema.TypeString,
-		Required:    true,
-		ForceNew:    true,
-		Description: "The schema in which to create the network rule.",
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		DiffSuppressFunc: suppressIdentifierQuoting,
+		Description:      blocklistedCharactersFieldDescription("The schema in which to create the network rule."),
 	},
 	"database": {
-		Type:        schema.TypeString,
-		Required:    true,
-		ForceNew:    true,
-		Description: "The database in which to create the network rule.",
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		DiffSuppressFunc: suppressIdentifierQuoting,
+		Description:      blocklistedCharactersFieldDescription("The database in which to create the network rule."),
 	},
 	"type": {
-		Type:         schema.TypeString,
-		Required:     true,
-		ForceNew:     true,
-		Description:  "Specifies the type of network identifiers being allowed or blocked. A network rule can have only one type. Allowed values are IPV4, AWSVPCEID, AZURELINKID and HOST_PORT; allowed values are determined by the mode of the network rule; see https://docs.snowflake.com/en/sql-reference/sql/create-network-rule#required-parameters for details.",
-		ValidateFunc: validation.StringInSlice([]string{"IPV4", "AWSVPCEID", "AZURELINKID", "HOST_PORT"}, false),
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		ValidateDiagFunc: sdkValidation(sdk.ToNetworkRuleType),
+		DiffSuppressFunc: NormalizeAndCompare(sdk.ToNetworkRuleType),
+		Description:      fmt.Sprintf("Specifies the type of network identifiers being allowed or blocked. A network rule can have only one type. Valid values are (case-insensitive): %s. Allowed values are determined by the mode of the network rule; see https://docs.snowflake.com/en/sql-reference/sql/create-network-rule#required-parameters for details.", possibleValuesListed(sdk.AllNetworkRuleTypes)),
 	},
 	"value_list": {
 		Type:        schema.TypeSet,
 		Elem:        &schema.Schema{Type: schema.TypeString},
 		Required:    true,
-		Description: "Specifies the network identifiers that will be allowed or blocked. Valid values in the list are determined by the type of network rule, see https://docs.snowflake.com/en/sql-reference/sql/create-network-rule#required-parameters for details.",
+		Description: "Specifies the network identifiers that will be allowed or blocked. The values are validated against the `type` during plan: IPv4 addresses or CIDRs for `IPV4`, `host[:port]` pairs for `HOST_PORT` and `PRIVATE_HOST_PORT`, `vpce-` prefixed ids for `AWSVPCEID`, and numeric ids for `AZURELINKID` and `GCPPSCID`. Values for `IDENTIFIER` are not validated by the provider. See https://docs.snowflake.com/en/sql-reference/sql/create-network-rule#required-parameters for details.",
 	},
 	"mode": {
-		Type:         schema.TypeString,
-		Required:     true,
-		ForceNew:     true,
-		Description:  "Specifies what is restricted by the network rule. Valid values are INGRESS, INTERNAL_STAGE and EGRESS; see https://docs.snowflake.com/en/sql-reference/sql/create-network-rule#required-parameters for details.",
-		ValidateFunc: validation.StringInSlice([]string{"INGRESS", "INTERNAL_STAGE", "EGRESS"}, false),
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		ValidateDiagFunc: sdkValidation(sdk.ToNetworkRuleMode),
+		DiffSuppressFunc: NormalizeAndCompare(sdk.ToNetworkRuleMode),
+		Description:      fmt.Sprintf("Specifies what is restricted by the network rule. Valid values are (case-insensitive): %s; see https://docs.snowflake.com/en/sql-reference/sql/create-network-rule#required-parameters for details.", possibleValuesListed(sdk.AllNetworkRuleModes)),
 	},
 	"comment": {
 		Type:        schema.TypeString,
 		Optional:    true,
 		Description: "Specifies a comment for the network rule.",
 	},
+	ShowOutputAttributeName: {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Outputs the result of `SHOW NETWORK RULES` for the given network rule.",
+		Elem: &schema.Resource{
+			Schema: schemas.ShowNetworkRuleSchema,
+		},
+	},
+	DescribeOutputAttributeName: {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Outputs the result of `DESCRIBE NETWORK RULE` for the given network rule.",
+		Elem: &schema.Resource{
+			Schema: schemas.DescribeNetworkRuleSchema,
+		},
+	},
 	FullyQualifiedNameAttributeName: schemas.FullyQualifiedNameSchema,
 }
 
@@ -72,58 +93,81 @@ func NetworkRule() *schema.Resource {
 		ReadContext:   PreviewFeatureReadContextWrapper(string(previewfeatures.NetworkRuleResource), TrackingReadWrapper(resources.NetworkRule, ReadContextNetworkRule)),
 		UpdateContext: PreviewFeatureUpdateContextWrapper(string(previewfeatures.NetworkRuleResource), TrackingUpdateWrapper(resources.NetworkRule, UpdateContextNetworkRule)),
 		DeleteContext: PreviewFeatureDeleteContextWrapper(string(previewfeatures.NetworkRuleResource), TrackingDeleteWrapper(resources.NetworkRule, DeleteContextNetworkRule)),
+		Description:   "Resource used to manage network rules. For more information, check [network rules documentation](https://docs.snowflake.com/en/user-guide/network-rules).",
 
 		Schema: networkRuleSchema,
+		CustomizeDiff: TrackingCustomDiffWrapper(resources.NetworkRule, customdiff.All(
+			validateNetworkRuleValueList,
+			ComputedIfAnyAttributeChanged(networkRuleSchema, ShowOutputAttributeName, "comment", "value_list"),
+			ComputedIfAnyAttributeChanged(networkRuleSchema, DescribeOutputAttributeName, "comment", "value_list"),
+		)),
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: TrackingImportWrapper(resources.NetworkRule, ImportName[sdk.SchemaObjectIdentifier]),
+		},
+
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Version: 0,
+				// setting to empty object to not affect all the existing resources in the state
+				Type:    cty.EmptyObject,
+				Upgrade: migratePipeSeparatedObjectIdentifierResourceIdToFullyQualifiedName,
+			},
 		},
 		Timeouts: defaultTimeouts,
 	}
 }
 
-func CreateContextNetworkRule(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	name := d.Get("name").(string)
-	databaseName := d.Get("database").(string)
-	schemaName := d.Get("schema").(string)
-	id := sdk.NewSchemaObjectIdentifier(databaseName, schemaName, name)
+// validateNetworkRuleValueList checks the value_list entries against the network rule type during plan.
+// The check is skipped when any of the values is not known yet (e.g. it's computed from another resource).
+func validateNetworkRuleValueList(_ context.Context, d *schema.ResourceDiff, _ any) error {
+	if !d.NewValueKnown("type") || !d.NewValueKnown("value_list") {
+		return nil
+	}
+	ruleType, err := sdk.ToNetworkRuleType(d.Get("type").(string))
+	if err != nil {
+		return err
+	}
+	return validators.ValidateNetworkRuleValueList(ruleType, expandStringList(d.Get("value_list").(*schema.Set).List()))
+}
 
-	ruleType := sdk.NetworkRuleType(d.Get("type").(string))
-	ruleMode := sdk.NetworkRuleMode(d.Get("mode").(string))
+func CreateContextNetworkRule(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	databaseName, schemaName, name := d.Get("database").(string), d.Get("schema").(string), d.Get("name").(string)
+	id := sdk.NewSchemaObjectIdentifier(databaseName, schemaName, name)
 
-	valueList := expandStringList(d.Get("value_list").(*schema.Set).List())
-	networkRuleValues := make([]sdk.NetworkRuleValue, len(valueList))
-	for i, v := range valueList {
-		networkRuleValues[i] = sdk.NetworkRuleValue{Value: v}
+	ruleType, err := sdk.ToNetworkRuleType(d.Get("type").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	ruleMode, err := sdk.ToNetworkRuleMode(d.Get("mode").(string))
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
-	req := sdk.NewCreateNetworkRuleRequest(
-		id,
-		ruleType,
-		networkRuleValues,
-		ruleMode,
-	)
+	req := sdk.NewCreateNetworkRuleRequest(id, ruleType, networkRuleValuesFromConfig(d), ruleMode)
 
-	// Set optionals
 	if v, ok := d.GetOk("comment"); ok {
 		req = req.WithComment(sdk.String(v.(string)))
 	}
 
-	client := meta.(*provider.Context).Client
 	if err := client.NetworkRules.Create(ctx, req); err != nil {
 		return diag.FromErr(err)
 	}
-	d.SetId(helpers.EncodeSnowflakeID(id))
+	d.SetId(helpers.EncodeResourceIdentifier(id))
 
 	return ReadContextNetworkRule(ctx, d, meta)
 }
 
-func ReadContextNetworkRule(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	diags := diag.Diagnostics{}
+func ReadContextNetworkRule(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	client := meta.(*provider.Context).Client
-	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+	id, err := sdk.ParseSchemaObjectIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
 	networkRule, err := client.NetworkRules.ShowByID(ctx, id)
-	if networkRule == nil || err != nil {
+	if err != nil {
 		if errors.Is(err, sdk.ErrObjectNotFound) {
 			d.SetId("")
 			return diag.Diagnostics{
@@ -143,76 +187,56 @@ func ReadContextNetworkRule(ctx context.Context, d *schema.ResourceData, meta in
 		}
 	}
 
-	networkRuleDescriptions, err := client.NetworkRules.Describe(ctx, id)
+	networkRuleDetails, err := client.NetworkRules.Describe(ctx, id)
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	if err = d.Set("name", networkRule.Name); err != nil {
-		return diag.FromErr(err)
-	}
-	if err = d.Set("database", networkRule.DatabaseName); err != nil {
-		return diag.FromErr(err)
-	}
-	if err = d.Set("schema", networkRule.SchemaName); err != nil {
-		return diag.FromErr(err)
-	}
 
-	if err = d.Set("type", networkRule.Type); err != nil {
-		return diag.FromErr(err)
-	}
-	if err = d.Set("value_list", networkRuleDescriptions.ValueList); err != nil {
-		return diag.FromErr(err)
-	}
-	if err = d.Set("mode", networkRule.Mode); err != nil {
-		return diag.FromErr(err)
-	}
-	if err = d.Set("comment", networkRule.Comment); err != nil {
-		return diag.FromErr(err)
-	}
-	if err := d.Set(FullyQualifiedNameAttributeName, id.FullyQualifiedName()); err != nil {
-		return diag.FromErr(err)
+	errs := errors.Join(
+		d.Set(FullyQualifiedNameAttributeName, id.FullyQualifiedName()),
+		d.Set("type", networkRule.Type),
+		d.Set("mode", networkRule.Mode),
+		d.Set("value_list", networkRuleDetails.ValueList),
+		d.Set("comment", networkRule.Comment),
+		d.Set(ShowOutputAttributeName, []map[string]any{schemas.NetworkRuleToSchema(networkRule)}),
+		d.Set(DescribeOutputAttributeName, []map[string]any{schemas.NetworkRuleDetailsToSchema(networkRuleDetails)}),
+	)
+	if errs != nil {
+		return diag.FromErr(errs)
 	}
 
-	return diags
+	return nil
 }
 
-func UpdateContextNetworkRule(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+func UpdateContextNetworkRule(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	client := meta.(*provider.Context).Client
-	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
-
-	valueList := expandStringList(d.Get("value_list").(*schema.Set).List())
-	networkRuleValues := make([]sdk.NetworkRuleValue, len(valueList))
-	for i, v := range valueList {
-		networkRuleValues[i] = sdk.NetworkRuleValue{Value: v}
+	id, err := sdk.ParseSchemaObjectIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
 	}
-	comment := d.Get("comment").(string)
 
 	if d.HasChange("value_list") {
-		baseReq := sdk.NewAlterNetworkRuleRequest(id)
-		if len(valueList) == 0 {
-			unsetReq := sdk.NewNetworkRuleUnsetRequest().WithValueList(sdk.Bool(true))
-			baseReq.WithUnset(unsetReq)
+		req := sdk.NewAlterNetworkRuleRequest(id)
+		if values := networkRuleValuesFromConfig(d); len(values) == 0 {
+			req.WithUnset(sdk.NewNetworkRuleUnsetRequest().WithValueList(sdk.Bool(true)))
 		} else {
-			setReq := sdk.NewNetworkRuleSetRequest(networkRuleValues)
-			baseReq.WithSet(setReq)
+			req.WithSet(sdk.NewNetworkRuleSetRequest(values))
 		}
 
-		if err := client.NetworkRules.Alter(ctx, baseReq); err != nil {
+		if err := client.NetworkRules.Alter(ctx, req); err != nil {
 			return diag.FromErr(err)
 		}
 	}
 
 	if d.HasChange("comment") {
-		baseReq := sdk.NewAlterNetworkRuleRequest(id)
-		if len(comment) == 0 {
-			unsetReq := sdk.NewNetworkRuleUnsetRequest().WithComment(sdk.Bool(true))
-			baseReq.WithUnset(unsetReq)
+		req := sdk.NewAlterNetworkRuleRequest(id)
+		if comment := d.Get("comment").(string); len(comment) == 0 {
+			req.WithUnset(sdk.NewNetworkRuleUnsetRequest().WithComment(sdk.Bool(true)))
 		} else {
-			setReq := sdk.NewNetworkRuleSetRequest(networkRuleValues).WithComment(sdk.String(comment))
-			baseReq.WithSet(setReq)
+			req.WithSet(sdk.NewNetworkRuleSetRequest(networkRuleValuesFromConfig(d)).WithComment(sdk.String(comment)))
 		}
 
-		if err := client.NetworkRules.Alter(ctx, baseReq); err != nil {
+		if err := client.NetworkRules.Alter(ctx, req); err != nil {
 			return diag.FromErr(err)
 		}
 	}
@@ -220,16 +244,27 @@ func UpdateContextNetworkRule(ctx context.Context, d *schema.ResourceData, meta
 	return ReadContextNetworkRule(ctx, d, meta)
 }
 
-func DeleteContextNetworkRule(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	name := d.Id()
+func DeleteContextNetworkRule(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	client := meta.(*provider.Context).Client
-	id := helpers.DecodeSnowflakeID(name).(sdk.SchemaObjectIdentifier)
+	id, err := sdk.ParseSchemaObjectIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
 	// TODO(SNOW-1818849): unassign network rules before dropping
 	if err := client.NetworkRules.Drop(ctx, sdk.NewDropNetworkRuleRequest(id).WithIfExists(sdk.Bool(true))); err != nil {
-		diag.FromErr(err)
+		return diag.FromErr(fmt.Errorf("error deleting network rule %s err = %w", id.FullyQualifiedName(), err))
 	}
 
 	d.SetId("")
 	return nil
 }
+
+func networkRuleValuesFromConfig(d *schema.ResourceData) []sdk.NetworkRuleValue {
+	valueList := expandStringList(d.Get("value_list").(*schema.Set).List())
+	networkRuleValues := make([]sdk.NetworkRuleValue, len(valueList))
+	for i, v := range valueList {
+		networkRuleValues[i] = sdk.NetworkRuleValue{Value: v}
+	}
+	return networkRuleValues
+}
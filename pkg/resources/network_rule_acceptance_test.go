@@ -2,6 +2,7 @@ package resources_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
@@ -11,6 +12,7 @@ import (
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 )
 
@@ -40,6 +42,17 @@ func TestAcc_NetworkRule(t *testing.T) {
 					resource.TestCheckResourceAttr("snowflake_network_rule.test", "type", "IPV4"),
 					resource.TestCheckResourceAttr("snowflake_network_rule.test", "mode", "INGRESS"),
 					resource.TestCheckResourceAttr("snowflake_network_rule.test", "value_list.#", "2"),
+					resource.TestCheckResourceAttr("snowflake_network_rule.test", "fully_qualified_name", id.FullyQualifiedName()),
+					resource.TestCheckResourceAttr("snowflake_network_rule.test", "show_output.#", "1"),
+					resource.TestCheckResourceAttr("snowflake_network_rule.test", "show_output.0.name", id.Name()),
+					resource.TestCheckResourceAttr("snowflake_network_rule.test", "show_output.0.type", "IPV4"),
+					resource.TestCheckResourceAttr("snowflake_network_rule.test", "show_output.0.mode", "INGRESS"),
+					resource.TestCheckResourceAttr("snowflake_network_rule.test", "show_output.0.entries_in_value_list", "2"),
+					resource.TestCheckResourceAttr("snowflake_network_rule.test", "show_output.0.comment", comment),
+					resource.TestCheckResourceAttr("snowflake_network_rule.test", "describe_output.#", "1"),
+					resource.TestCheckResourceAttr("snowflake_network_rule.test", "describe_output.0.type", "IPV4"),
+					resource.TestCheckResourceAttr("snowflake_network_rule.test", "describe_output.0.mode", "INGRESS"),
+					resource.TestCheckResourceAttr("snowflake_network_rule.test", "describe_output.0.value_list.#", "2"),
 				),
 			},
 			//// IMPORT - all fields are non-empty
@@ -59,6 +72,8 @@ func TestAcc_NetworkRule(t *testing.T) {
 					resource.TestCheckResourceAttr("snowflake_network_rule.test", "type", "IPV4"),
 					resource.TestCheckResourceAttr("snowflake_network_rule.test", "mode", "INGRESS"),
 					resource.TestCheckResourceAttr("snowflake_network_rule.test", "value_list.#", "0"),
+					resource.TestCheckResourceAttr("snowflake_network_rule.test", "show_output.0.entries_in_value_list", "0"),
+					resource.TestCheckResourceAttr("snowflake_network_rule.test", "describe_output.0.value_list.#", "0"),
 				),
 			},
 			// IMPORT - incomplete
@@ -83,6 +98,104 @@ func TestAcc_NetworkRule(t *testing.T) {
 	})
 }
 
+func TestAcc_NetworkRule_ValueListValidation(t *testing.T) {
+	_ = testenvs.GetOrSkipTest(t, testenvs.EnableAcceptance)
+	acc.TestAccPreCheck(t)
+
+	id := acc.TestClient().Ids.RandomSchemaObjectIdentifier()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: acc.CheckDestroy(t, resources.NetworkRule),
+		Steps: []resource.TestStep{
+			{
+				Config:      networkRuleWithValues(id, "IPV4", "INGRESS", `"192.168.0.100/24", "example.com"`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("invalid IPv4 address: example.com"),
+			},
+			{
+				Config:      networkRuleWithValues(id, "HOST_PORT", "EGRESS", `"example.com:99999"`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("expected a port number between 1 and 65535, got: 99999"),
+			},
+			{
+				Config:      networkRuleWithValues(id, "AWSVPCEID", "INTERNAL_STAGE", `"123abc3420c1931"`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("expected an AWS VPC endpoint id in the vpce-<hex> format"),
+			},
+			{
+				Config:      networkRuleWithValues(id, "GCPPSCID", "INGRESS", `"psc-1"`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("expected a numeric GCP Private Service Connect connection id"),
+			},
+			{
+				Config:      networkRuleWithValues(id, "IPV6", "INGRESS", `"::1"`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("invalid network rule type: IPV6"),
+			},
+			{
+				Config:      networkRuleWithValues(id, "IPV4", "OUTGRESS", `"1.1.1.1"`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("invalid network rule mode: OUTGRESS"),
+			},
+		},
+	})
+}
+
+func TestAcc_NetworkRule_TypeAndModeCaseInsensitive(t *testing.T) {
+	_ = testenvs.GetOrSkipTest(t, testenvs.EnableAcceptance)
+	acc.TestAccPreCheck(t)
+
+	id := acc.TestClient().Ids.RandomSchemaObjectIdentifier()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: acc.CheckDestroy(t, resources.NetworkRule),
+		Steps: []resource.TestStep{
+			{
+				Config: networkRuleWithValues(id, "host_port", "egress", `"example.com:443"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_network_rule.test", "type", "HOST_PORT"),
+					resource.TestCheckResourceAttr("snowflake_network_rule.test", "mode", "EGRESS"),
+					resource.TestCheckResourceAttr("snowflake_network_rule.test", "show_output.0.type", "HOST_PORT"),
+					resource.TestCheckResourceAttr("snowflake_network_rule.test", "show_output.0.mode", "EGRESS"),
+					resource.TestCheckResourceAttr("snowflake_network_rule.test", "describe_output.0.value_list.#", "1"),
+					resource.TestCheckResourceAttr("snowflake_network_rule.test", "describe_output.0.value_list.0", "example.com:443"),
+				),
+			},
+			{
+				Config: networkRuleWithValues(id, "HOST_PORT", "EGRESS", `"example.com:443"`),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
+func networkRuleWithValues(id sdk.SchemaObjectIdentifier, ruleType string, mode string, values string) string {
+	return fmt.Sprintf(`
+resource "snowflake_network_rule" "test" {
+	database   = "%[1]s"
+	schema     = "%[2]s"
+	name       = "%[3]s"
+	type       = "%[4]s"
+	mode       = "%[5]s"
+	value_list = [%[6]s]
+}
+`, id.DatabaseName(), id.SchemaName(), id.Name(), ruleType, mode, values)
+}
+
 func networkRuleIpv4(id sdk.SchemaObjectIdentifier, comment string) string {
 	return fmt.Sprintf(`
 resource "snowflake_network_rule" "test" {
@@ -19,9 +19,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
-// TODO [SNOW-1348345]: split this resource into smaller ones (SNOW-1021713)
 // TODO [SNOW-1348345]: remove SQS entirely
-// TODO [SNOW-1348345]: support Azure push notifications (AZURE_EVENT_GRID)
 var notificationIntegrationSchema = map[string]*schema.Schema{
 	// The first part of the schema is shared between all integration vendors
 	"name": {
@@ -160,6 +158,13 @@ func NotificationIntegration() *schema.Resource {
 		UpdateContext: PreviewFeatureUpdateContextWrapper(string(previewfeatures.NotificationIntegrationResource), TrackingUpdateWrapper(resources.NotificationIntegration, UpdateNotificationIntegration)),
 		DeleteContext: PreviewFeatureDeleteContextWrapper(string(previewfeatures.NotificationIntegrationResource), TrackingDeleteWrapper(resources.NotificationIntegration, DeleteNotificationIntegration)),
 
+		Description: "Resource used to manage notification integration objects. For more information, check [notification integration documentation](https://docs.snowflake.com/en/sql-reference/sql/create-notification-integration).",
+		DeprecationMessage: deprecatedResourceDescription(
+			string(resources.NotificationIntegrationAwsSns),
+			string(resources.NotificationIntegrationAzureEventGrid),
+			string(resources.NotificationIntegrationGcpPubsub),
+		),
+
 		Schema: notificationIntegrationSchema,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
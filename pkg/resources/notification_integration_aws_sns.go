@@ -0,0 +1,145 @@
+package resources
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/schemas"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var notificationIntegrationAwsSnsSchema = collections.MergeMaps(notificationIntegrationCommonSchema, map[string]*schema.Schema{
+	"aws_sns_topic_arn": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "Specifies the Amazon Resource Name (ARN) of the Amazon SNS topic to which notifications are pushed.",
+	},
+	"aws_sns_role_arn": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "Specifies the ARN of the AWS IAM role that has permissions to publish messages to the Amazon SNS topic.",
+	},
+	DescribeOutputAttributeName: notificationIntegrationDescribeOutputSchema(schemas.DescribeAwsSnsNotificationIntegrationSchema),
+})
+
+func NotificationIntegrationAwsSns() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: PreviewFeatureCreateContextWrapper(string(previewfeatures.NotificationIntegrationAwsSnsResource), TrackingCreateWrapper(resources.NotificationIntegrationAwsSns, CreateContextNotificationIntegrationAwsSns)),
+		ReadContext:   PreviewFeatureReadContextWrapper(string(previewfeatures.NotificationIntegrationAwsSnsResource), TrackingReadWrapper(resources.NotificationIntegrationAwsSns, ReadContextNotificationIntegrationAwsSns(true))),
+		UpdateContext: PreviewFeatureUpdateContextWrapper(string(previewfeatures.NotificationIntegrationAwsSnsResource), TrackingUpdateWrapper(resources.NotificationIntegrationAwsSns, UpdateContextNotificationIntegrationAwsSns)),
+		DeleteContext: PreviewFeatureDeleteContextWrapper(string(previewfeatures.NotificationIntegrationAwsSnsResource), TrackingDeleteWrapper(resources.NotificationIntegrationAwsSns, DeleteContextNotificationIntegration)),
+		Description:   "Resource used to manage notification integration objects pushing notifications to Amazon SNS. For more information, check [notification integration documentation](https://docs.snowflake.com/en/sql-reference/sql/create-notification-integration-queue-outbound-aws).",
+
+		Schema: notificationIntegrationAwsSnsSchema,
+		CustomizeDiff: TrackingCustomDiffWrapper(resources.NotificationIntegrationAwsSns, customdiff.All(
+			ComputedIfAnyAttributeChanged(notificationIntegrationAwsSnsSchema, ShowOutputAttributeName, "enabled", "comment"),
+			ComputedIfAnyAttributeChanged(notificationIntegrationAwsSnsSchema, DescribeOutputAttributeName, "enabled", "comment", "aws_sns_topic_arn", "aws_sns_role_arn"),
+		)),
+		Importer: &schema.ResourceImporter{
+			StateContext: TrackingImportWrapper(resources.NotificationIntegrationAwsSns, ImportNotificationIntegrationAwsSns),
+		},
+		Timeouts: defaultTimeouts,
+	}
+}
+
+func ImportNotificationIntegrationAwsSns(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	if err := handleNotificationIntegrationImport(ctx, d, meta, map[string]string{
+		"aws_sns_topic_arn": "AWS_SNS_TOPIC_ARN",
+		"aws_sns_role_arn":  "AWS_SNS_ROLE_ARN",
+	}); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+func CreateContextNotificationIntegrationAwsSns(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseAccountObjectIdentifier(d.Get("name").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	req := sdk.NewCreateNotificationIntegrationRequest(id, d.Get("enabled").(bool)).
+		WithPushNotificationParams(sdk.NewPushNotificationParamsRequest().
+			WithAmazonPushParams(sdk.NewAmazonPushParamsRequest(d.Get("aws_sns_topic_arn").(string), d.Get("aws_sns_role_arn").(string))),
+		)
+	if v, ok := d.GetOk("comment"); ok {
+		req.WithComment(sdk.String(v.(string)))
+	}
+
+	if err := client.NotificationIntegrations.Create(ctx, req); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(helpers.EncodeResourceIdentifier(id))
+	return ReadContextNotificationIntegrationAwsSns(false)(ctx, d, meta)
+}
+
+func ReadContextNotificationIntegrationAwsSns(withExternalChangesMarking bool) schema.ReadContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+		client := meta.(*provider.Context).Client
+		id, err := sdk.ParseAccountObjectIdentifier(d.Id())
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		integration, properties, diags := queryNotificationIntegration(ctx, d, client, id)
+		if integration == nil {
+			return diags
+		}
+
+		if withExternalChangesMarking {
+			topicArn, _ := notificationIntegrationPropertyValue(properties, "AWS_SNS_TOPIC_ARN")
+			roleArn, _ := notificationIntegrationPropertyValue(properties, "AWS_SNS_ROLE_ARN")
+			if err = handleExternalChangesToObjectInDescribe(d,
+				describeMapping{"aws_sns_topic_arn", "aws_sns_topic_arn", topicArn, topicArn, nil},
+				describeMapping{"aws_sns_role_arn", "aws_sns_role_arn", roleArn, roleArn, nil},
+			); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+		if err := setStateToValuesFromConfig(d, notificationIntegrationAwsSnsSchema, []string{
+			"aws_sns_topic_arn",
+			"aws_sns_role_arn",
+		}); err != nil {
+			return diag.FromErr(err)
+		}
+
+		if err := handleNotificationIntegrationRead(d, id, integration, schemas.AwsSnsNotificationIntegrationPropertiesToSchema(properties)); err != nil {
+			return diag.FromErr(err)
+		}
+		return nil
+	}
+}
+
+func UpdateContextNotificationIntegrationAwsSns(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseAccountObjectIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	set := sdk.NewNotificationIntegrationSetRequest()
+	handleNotificationIntegrationUpdate(d, set)
+	// Both ARNs have to be provided in the ALTER statement.
+	if d.HasChanges("aws_sns_topic_arn", "aws_sns_role_arn") {
+		set.WithSetPushParams(sdk.NewSetPushParamsRequest().
+			WithSetAmazonPush(sdk.NewSetAmazonPushRequest(d.Get("aws_sns_topic_arn").(string), d.Get("aws_sns_role_arn").(string))),
+		)
+	}
+
+	if !reflect.DeepEqual(*set, sdk.NotificationIntegrationSetRequest{}) {
+		if err := client.NotificationIntegrations.Alter(ctx, sdk.NewAlterNotificationIntegrationRequest(id).WithSet(set)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return ReadContextNotificationIntegrationAwsSns(false)(ctx, d, meta)
+}
@@ -0,0 +1,139 @@
+package resources_test
+
+import (
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	accconfig "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/model"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/helpers/random"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/testenvs"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_NotificationIntegrationAwsSns_basic(t *testing.T) {
+	_ = testenvs.GetOrSkipTest(t, testenvs.EnableAcceptance)
+	acc.TestAccPreCheck(t)
+
+	id := acc.TestClient().Ids.RandomAccountObjectIdentifier()
+	topicArn := "arn:aws:sns:us-east-2:123456789012:MyTopic"
+	otherTopicArn := "arn:aws:sns:us-east-2:123456789012:MyOtherTopic"
+	roleArn := "arn:aws:iam::000000000001:/role/test"
+	comment := random.Comment()
+
+	modelBasic := model.NotificationIntegrationAwsSns("test", roleArn, topicArn, false, id.Name())
+	modelComplete := model.NotificationIntegrationAwsSns("test", roleArn, otherTopicArn, true, id.Name()).
+		WithComment(comment)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: acc.CheckDestroy(t, resources.NotificationIntegrationAwsSns),
+		Steps: []resource.TestStep{
+			// create with only required fields
+			{
+				Config: accconfig.FromModels(t, modelBasic),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "id", helpers.EncodeResourceIdentifier(id)),
+					resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "name", id.Name()),
+					resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "fully_qualified_name", id.FullyQualifiedName()),
+					resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "enabled", "false"),
+					resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "aws_sns_topic_arn", topicArn),
+					resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "aws_sns_role_arn", roleArn),
+					resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "comment", ""),
+
+					resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "show_output.#", "1"),
+					resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "show_output.0.name", id.Name()),
+					resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "show_output.0.notification_type", "QUEUE - AWS_SNS"),
+					resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "show_output.0.category", "NOTIFICATION"),
+					resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "show_output.0.enabled", "false"),
+					resource.TestCheckResourceAttrSet(modelBasic.ResourceReference(), "show_output.0.created_on"),
+
+					resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "describe_output.#", "1"),
+					resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "describe_output.0.enabled.0.value", "false"),
+					resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "describe_output.0.direction.0.value", "OUTBOUND"),
+					resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "describe_output.0.notification_provider.0.value", "AWS_SNS"),
+					resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "describe_output.0.aws_sns_topic_arn.0.value", topicArn),
+					resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "describe_output.0.aws_sns_role_arn.0.value", roleArn),
+					resource.TestCheckResourceAttrSet(modelBasic.ResourceReference(), "describe_output.0.sf_aws_iam_user_arn.0.value"),
+					resource.TestCheckResourceAttrSet(modelBasic.ResourceReference(), "describe_output.0.sf_aws_external_id.0.value"),
+				),
+			},
+			// import - without optionals
+			{
+				Config:            accconfig.FromModels(t, modelBasic),
+				ResourceName:      modelBasic.ResourceReference(),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// set optionals
+			{
+				Config: accconfig.FromModels(t, modelComplete),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(modelComplete.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(modelComplete.ResourceReference(), "enabled", "true"),
+					resource.TestCheckResourceAttr(modelComplete.ResourceReference(), "aws_sns_topic_arn", otherTopicArn),
+					resource.TestCheckResourceAttr(modelComplete.ResourceReference(), "comment", comment),
+
+					resource.TestCheckResourceAttr(modelComplete.ResourceReference(), "show_output.0.enabled", "true"),
+					resource.TestCheckResourceAttr(modelComplete.ResourceReference(), "show_output.0.comment", comment),
+					resource.TestCheckResourceAttr(modelComplete.ResourceReference(), "describe_output.0.aws_sns_topic_arn.0.value", otherTopicArn),
+				),
+			},
+			// import - complete
+			{
+				Config:            accconfig.FromModels(t, modelComplete),
+				ResourceName:      modelComplete.ResourceReference(),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// external change is detected
+			{
+				PreConfig: func() {
+					acc.TestClient().NotificationIntegration.Alter(t, sdk.NewAlterNotificationIntegrationRequest(id).WithSet(
+						sdk.NewNotificationIntegrationSetRequest().WithSetPushParams(
+							sdk.NewSetPushParamsRequest().WithSetAmazonPush(sdk.NewSetAmazonPushRequest(topicArn, roleArn)),
+						),
+					))
+				},
+				Config: accconfig.FromModels(t, modelComplete),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(modelComplete.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(modelComplete.ResourceReference(), "aws_sns_topic_arn", otherTopicArn),
+					resource.TestCheckResourceAttr(modelComplete.ResourceReference(), "describe_output.0.aws_sns_topic_arn.0.value", otherTopicArn),
+				),
+			},
+			// unset optionals
+			{
+				Config: accconfig.FromModels(t, modelBasic),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(modelBasic.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "enabled", "false"),
+					resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "aws_sns_topic_arn", topicArn),
+					resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "comment", ""),
+					resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "show_output.0.comment", ""),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,161 @@
+package resources
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/schemas"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var notificationIntegrationAzureEventGridSchema = collections.MergeMaps(notificationIntegrationCommonSchema, map[string]*schema.Schema{
+	"azure_event_grid_topic_endpoint": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		ForceNew:     true,
+		Description:  "Specifies the Event Grid topic endpoint to which Snowflake pushes notifications (outbound direction, `NOTIFICATION_PROVIDER = AZURE_EVENT_GRID`).",
+		ExactlyOneOf: []string{"azure_event_grid_topic_endpoint", "azure_storage_queue_primary_uri"},
+	},
+	"azure_storage_queue_primary_uri": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		ForceNew:     true,
+		Description:  "Specifies the queue ID for the Azure Queue Storage queue created for Event Grid notifications (inbound direction, `NOTIFICATION_PROVIDER = AZURE_STORAGE_QUEUE`).",
+		ExactlyOneOf: []string{"azure_event_grid_topic_endpoint", "azure_storage_queue_primary_uri"},
+	},
+	"azure_tenant_id": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "Specifies the ID of the Microsoft Entra tenant used for identity management.",
+	},
+	DescribeOutputAttributeName: notificationIntegrationDescribeOutputSchema(schemas.DescribeAzureEventGridNotificationIntegrationSchema),
+})
+
+func NotificationIntegrationAzureEventGrid() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: PreviewFeatureCreateContextWrapper(string(previewfeatures.NotificationIntegrationAzureEventGridResource), TrackingCreateWrapper(resources.NotificationIntegrationAzureEventGrid, CreateContextNotificationIntegrationAzureEventGrid)),
+		ReadContext:   PreviewFeatureReadContextWrapper(string(previewfeatures.NotificationIntegrationAzureEventGridResource), TrackingReadWrapper(resources.NotificationIntegrationAzureEventGrid, ReadContextNotificationIntegrationAzureEventGrid(true))),
+		UpdateContext: PreviewFeatureUpdateContextWrapper(string(previewfeatures.NotificationIntegrationAzureEventGridResource), TrackingUpdateWrapper(resources.NotificationIntegrationAzureEventGrid, UpdateContextNotificationIntegrationAzureEventGrid)),
+		DeleteContext: PreviewFeatureDeleteContextWrapper(string(previewfeatures.NotificationIntegrationAzureEventGridResource), TrackingDeleteWrapper(resources.NotificationIntegrationAzureEventGrid, DeleteContextNotificationIntegration)),
+		Description:   "Resource used to manage notification integration objects for Azure Event Grid. It supports both receiving notifications through Azure Queue Storage (e.g. for automated data loads) and pushing notifications to an Event Grid topic. For more information, check [notification integration documentation](https://docs.snowflake.com/en/sql-reference/sql/create-notification-integration).",
+
+		Schema: notificationIntegrationAzureEventGridSchema,
+		CustomizeDiff: TrackingCustomDiffWrapper(resources.NotificationIntegrationAzureEventGrid, customdiff.All(
+			ComputedIfAnyAttributeChanged(notificationIntegrationAzureEventGridSchema, ShowOutputAttributeName, "enabled", "comment"),
+			ComputedIfAnyAttributeChanged(notificationIntegrationAzureEventGridSchema, DescribeOutputAttributeName, "enabled", "comment"),
+		)),
+		Importer: &schema.ResourceImporter{
+			StateContext: TrackingImportWrapper(resources.NotificationIntegrationAzureEventGrid, ImportNotificationIntegrationAzureEventGrid),
+		},
+		Timeouts: defaultTimeouts,
+	}
+}
+
+func ImportNotificationIntegrationAzureEventGrid(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	if err := handleNotificationIntegrationImport(ctx, d, meta, map[string]string{
+		"azure_event_grid_topic_endpoint": "AZURE_EVENT_GRID_TOPIC_ENDPOINT",
+		"azure_storage_queue_primary_uri": "AZURE_STORAGE_QUEUE_PRIMARY_URI",
+		"azure_tenant_id":                 "AZURE_TENANT_ID",
+	}); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+func CreateContextNotificationIntegrationAzureEventGrid(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseAccountObjectIdentifier(d.Get("name").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	req := sdk.NewCreateNotificationIntegrationRequest(id, d.Get("enabled").(bool))
+	tenantId := d.Get("azure_tenant_id").(string)
+	if v, ok := d.GetOk("azure_event_grid_topic_endpoint"); ok {
+		req.WithPushNotificationParams(sdk.NewPushNotificationParamsRequest().
+			WithAzurePushParams(sdk.NewAzurePushParamsRequest(v.(string), tenantId)),
+		)
+	}
+	if v, ok := d.GetOk("azure_storage_queue_primary_uri"); ok {
+		req.WithAutomatedDataLoadsParams(sdk.NewAutomatedDataLoadsParamsRequest().
+			WithAzureAutoParams(sdk.NewAzureAutoParamsRequest(v.(string), tenantId)),
+		)
+	}
+	if v, ok := d.GetOk("comment"); ok {
+		req.WithComment(sdk.String(v.(string)))
+	}
+
+	if err := client.NotificationIntegrations.Create(ctx, req); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(helpers.EncodeResourceIdentifier(id))
+	return ReadContextNotificationIntegrationAzureEventGrid(false)(ctx, d, meta)
+}
+
+func ReadContextNotificationIntegrationAzureEventGrid(withExternalChangesMarking bool) schema.ReadContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+		client := meta.(*provider.Context).Client
+		id, err := sdk.ParseAccountObjectIdentifier(d.Id())
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		integration, properties, diags := queryNotificationIntegration(ctx, d, client, id)
+		if integration == nil {
+			return diags
+		}
+
+		if withExternalChangesMarking {
+			topicEndpoint, _ := notificationIntegrationPropertyValue(properties, "AZURE_EVENT_GRID_TOPIC_ENDPOINT")
+			storageQueuePrimaryUri, _ := notificationIntegrationPropertyValue(properties, "AZURE_STORAGE_QUEUE_PRIMARY_URI")
+			tenantId, _ := notificationIntegrationPropertyValue(properties, "AZURE_TENANT_ID")
+			if err = handleExternalChangesToObjectInDescribe(d,
+				describeMapping{"azure_event_grid_topic_endpoint", "azure_event_grid_topic_endpoint", topicEndpoint, topicEndpoint, nil},
+				describeMapping{"azure_storage_queue_primary_uri", "azure_storage_queue_primary_uri", storageQueuePrimaryUri, storageQueuePrimaryUri, nil},
+				describeMapping{"azure_tenant_id", "azure_tenant_id", tenantId, tenantId, nil},
+			); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+		if err := setStateToValuesFromConfig(d, notificationIntegrationAzureEventGridSchema, []string{
+			"azure_event_grid_topic_endpoint",
+			"azure_storage_queue_primary_uri",
+			"azure_tenant_id",
+		}); err != nil {
+			return diag.FromErr(err)
+		}
+
+		if err := handleNotificationIntegrationRead(d, id, integration, schemas.AzureEventGridNotificationIntegrationPropertiesToSchema(properties)); err != nil {
+			return diag.FromErr(err)
+		}
+		return nil
+	}
+}
+
+func UpdateContextNotificationIntegrationAzureEventGrid(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseAccountObjectIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	set := sdk.NewNotificationIntegrationSetRequest()
+	handleNotificationIntegrationUpdate(d, set)
+
+	if !reflect.DeepEqual(*set, sdk.NotificationIntegrationSetRequest{}) {
+		if err := client.NotificationIntegrations.Alter(ctx, sdk.NewAlterNotificationIntegrationRequest(id).WithSet(set)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return ReadContextNotificationIntegrationAzureEventGrid(false)(ctx, d, meta)
+}
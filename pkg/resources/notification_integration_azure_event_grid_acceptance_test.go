@@ -0,0 +1,157 @@
+package resources_test
+
+import (
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	accconfig "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/model"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/helpers/random"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/testenvs"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_NotificationIntegrationAzureEventGrid_inbound(t *testing.T) {
+	_ = testenvs.GetOrSkipTest(t, testenvs.EnableAcceptance)
+	acc.TestAccPreCheck(t)
+
+	id := acc.TestClient().Ids.RandomAccountObjectIdentifier()
+	storageQueuePrimaryUri := "azure://great-bucket/great-path/"
+	tenantId := "00000000-0000-0000-0000-000000000000"
+	comment := random.Comment()
+
+	modelBasic := model.NotificationIntegrationAzureEventGrid("test", tenantId, false, id.Name()).
+		WithAzureStorageQueuePrimaryUri(storageQueuePrimaryUri)
+	modelComplete := model.NotificationIntegrationAzureEventGrid("test", tenantId, true, id.Name()).
+		WithAzureStorageQueuePrimaryUri(storageQueuePrimaryUri).
+		WithComment(comment)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: acc.CheckDestroy(t, resources.NotificationIntegrationAzureEventGrid),
+		Steps: []resource.TestStep{
+			// create with only required fields
+			{
+				Config: accconfig.FromModels(t, modelBasic),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "id", helpers.EncodeResourceIdentifier(id)),
+					resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "name", id.Name()),
+					resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "fully_qualified_name", id.FullyQualifiedName()),
+					resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "enabled", "false"),
+					resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "azure_storage_queue_primary_uri", storageQueuePrimaryUri),
+					resource.TestCheckNoResourceAttr(modelBasic.ResourceReference(), "azure_event_grid_topic_endpoint"),
+					resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "azure_tenant_id", tenantId),
+					resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "comment", ""),
+
+					resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "show_output.#", "1"),
+					resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "show_output.0.name", id.Name()),
+					resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "show_output.0.category", "NOTIFICATION"),
+					resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "show_output.0.enabled", "false"),
+
+					resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "describe_output.#", "1"),
+					resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "describe_output.0.direction.0.value", "INBOUND"),
+					resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "describe_output.0.notification_provider.0.value", "AZURE_STORAGE_QUEUE"),
+					resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "describe_output.0.azure_storage_queue_primary_uri.0.value", storageQueuePrimaryUri),
+					resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "describe_output.0.azure_tenant_id.0.value", tenantId),
+					resource.TestCheckResourceAttrSet(modelBasic.ResourceReference(), "describe_output.0.azure_consent_url.0.value"),
+				),
+			},
+			// import - without optionals
+			{
+				Config:            accconfig.FromModels(t, modelBasic),
+				ResourceName:      modelBasic.ResourceReference(),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// set optionals
+			{
+				Config: accconfig.FromModels(t, modelComplete),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(modelComplete.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(modelComplete.ResourceReference(), "enabled", "true"),
+					resource.TestCheckResourceAttr(modelComplete.ResourceReference(), "comment", comment),
+					resource.TestCheckResourceAttr(modelComplete.ResourceReference(), "show_output.0.enabled", "true"),
+					resource.TestCheckResourceAttr(modelComplete.ResourceReference(), "show_output.0.comment", comment),
+				),
+			},
+			// unset optionals
+			{
+				Config: accconfig.FromModels(t, modelBasic),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(modelBasic.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "enabled", "false"),
+					resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "comment", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAcc_NotificationIntegrationAzureEventGrid_outbound(t *testing.T) {
+	_ = testenvs.GetOrSkipTest(t, testenvs.EnableAcceptance)
+	acc.TestAccPreCheck(t)
+
+	id := acc.TestClient().Ids.RandomAccountObjectIdentifier()
+	topicEndpoint := "https://apim-hello-world.azure-api.net/dev"
+	tenantId := "00000000-0000-0000-0000-000000000000"
+
+	modelOutbound := model.NotificationIntegrationAzureEventGrid("test", tenantId, true, id.Name()).
+		WithAzureEventGridTopicEndpoint(topicEndpoint)
+	modelInbound := model.NotificationIntegrationAzureEventGrid("test", tenantId, true, id.Name()).
+		WithAzureStorageQueuePrimaryUri("azure://great-bucket/great-path/")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: acc.CheckDestroy(t, resources.NotificationIntegrationAzureEventGrid),
+		Steps: []resource.TestStep{
+			{
+				Config: accconfig.FromModels(t, modelOutbound),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(modelOutbound.ResourceReference(), "azure_event_grid_topic_endpoint", topicEndpoint),
+					resource.TestCheckNoResourceAttr(modelOutbound.ResourceReference(), "azure_storage_queue_primary_uri"),
+					resource.TestCheckResourceAttr(modelOutbound.ResourceReference(), "describe_output.0.direction.0.value", "OUTBOUND"),
+					resource.TestCheckResourceAttr(modelOutbound.ResourceReference(), "describe_output.0.notification_provider.0.value", "AZURE_EVENT_GRID"),
+					resource.TestCheckResourceAttr(modelOutbound.ResourceReference(), "describe_output.0.azure_event_grid_topic_endpoint.0.value", topicEndpoint),
+				),
+			},
+			{
+				Config:            accconfig.FromModels(t, modelOutbound),
+				ResourceName:      modelOutbound.ResourceReference(),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// changing the direction recreates the integration
+			{
+				Config: accconfig.FromModels(t, modelInbound),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(modelInbound.ResourceReference(), plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(modelInbound.ResourceReference(), "describe_output.0.direction.0.value", "INBOUND"),
+				),
+			},
+		},
+	})
+}
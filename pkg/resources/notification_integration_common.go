@@ -0,0 +1,163 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/schemas"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const notificationIntegrationCategory = "NOTIFICATION"
+
+var notificationIntegrationCommonSchema = map[string]*schema.Schema{
+	"name": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		Description:      blocklistedCharactersFieldDescription("Specifies the identifier (i.e. name) for the integration. This value must be unique in your account."),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+	},
+	"enabled": {
+		Type:        schema.TypeBool,
+		Required:    true,
+		Description: "Specifies whether this notification integration is enabled or disabled.",
+	},
+	"comment": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies a comment for the integration.",
+	},
+	ShowOutputAttributeName: {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Outputs the result of `SHOW NOTIFICATION INTEGRATIONS` for the given notification integration.",
+		Elem: &schema.Resource{
+			Schema: schemas.ShowNotificationIntegrationSchema,
+		},
+	},
+	FullyQualifiedNameAttributeName: schemas.FullyQualifiedNameSchema,
+}
+
+func notificationIntegrationDescribeOutputSchema(describeSchema map[string]*schema.Schema) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Outputs the result of `DESCRIBE NOTIFICATION INTEGRATION` for the given notification integration.",
+		Elem: &schema.Resource{
+			Schema: describeSchema,
+		},
+	}
+}
+
+func notificationIntegrationPropertyValue(properties []sdk.NotificationIntegrationProperty, name string) (string, bool) {
+	property, err := collections.FindFirst(properties, func(property sdk.NotificationIntegrationProperty) bool { return property.Name == name })
+	if err != nil {
+		return "", false
+	}
+	return property.Value, true
+}
+
+// handleNotificationIntegrationImport sets the common fields and the given properties (field name -> DESCRIBE property name) during the import.
+func handleNotificationIntegrationImport(ctx context.Context, d *schema.ResourceData, meta any, properties map[string]string) error {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseAccountObjectIdentifier(d.Id())
+	if err != nil {
+		return err
+	}
+
+	integration, err := client.NotificationIntegrations.ShowByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	integrationProperties, err := client.NotificationIntegrations.Describe(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if _, err := ImportName[sdk.AccountObjectIdentifier](ctx, d, nil); err != nil {
+		return err
+	}
+	if err := errors.Join(
+		d.Set("enabled", integration.Enabled),
+		d.Set("comment", integration.Comment),
+	); err != nil {
+		return err
+	}
+	for field, propertyName := range properties {
+		if value, ok := notificationIntegrationPropertyValue(integrationProperties, propertyName); ok && value != "" {
+			if err := d.Set(field, value); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// queryNotificationIntegration returns SHOW and DESCRIBE outputs for the notification integration. When the object is missing, the resource is marked as removed.
+func queryNotificationIntegration(ctx context.Context, d *schema.ResourceData, client *sdk.Client, id sdk.AccountObjectIdentifier) (*sdk.NotificationIntegration, []sdk.NotificationIntegrationProperty, diag.Diagnostics) {
+	integration, err := client.NotificationIntegrations.ShowByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, sdk.ErrObjectNotFound) {
+			d.SetId("")
+			return nil, nil, diag.Diagnostics{
+				diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  "Failed to query notification integration. Marking the resource as removed.",
+					Detail:   fmt.Sprintf("Notification integration name: %s, Err: %s", id.FullyQualifiedName(), err),
+				},
+			}
+		}
+		return nil, nil, diag.FromErr(err)
+	}
+	if c := integration.Category; c != notificationIntegrationCategory {
+		return nil, nil, diag.FromErr(fmt.Errorf("expected %v to be a %s integration, got %v", id, notificationIntegrationCategory, c))
+	}
+
+	properties, err := client.NotificationIntegrations.Describe(ctx, id)
+	if err != nil {
+		return nil, nil, diag.FromErr(err)
+	}
+	return integration, properties, nil
+}
+
+func handleNotificationIntegrationRead(d *schema.ResourceData, id sdk.AccountObjectIdentifier, integration *sdk.NotificationIntegration, describeOutput map[string]any) error {
+	return errors.Join(
+		d.Set(FullyQualifiedNameAttributeName, id.FullyQualifiedName()),
+		d.Set("enabled", integration.Enabled),
+		d.Set("comment", integration.Comment),
+		d.Set(ShowOutputAttributeName, []map[string]any{schemas.NotificationIntegrationToSchema(integration)}),
+		d.Set(DescribeOutputAttributeName, []map[string]any{describeOutput}),
+	)
+}
+
+// handleNotificationIntegrationUpdate handles fields that can be set for every notification integration type.
+func handleNotificationIntegrationUpdate(d *schema.ResourceData, set *sdk.NotificationIntegrationSetRequest) {
+	if d.HasChange("enabled") {
+		set.WithEnabled(sdk.Bool(d.Get("enabled").(bool)))
+	}
+	if d.HasChange("comment") {
+		set.WithComment(sdk.String(d.Get("comment").(string)))
+	}
+}
+
+func DeleteContextNotificationIntegration(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseAccountObjectIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := client.NotificationIntegrations.Drop(ctx, sdk.NewDropNotificationIntegrationRequest(id).WithIfExists(sdk.Bool(true))); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,150 @@
+package resources
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/schemas"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var notificationIntegrationGcpPubsubSchema = collections.MergeMaps(notificationIntegrationCommonSchema, map[string]*schema.Schema{
+	"gcp_pubsub_topic_name": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		ForceNew:     true,
+		Description:  "Specifies the Pub/Sub topic to which Snowflake pushes notifications (outbound direction).",
+		ExactlyOneOf: []string{"gcp_pubsub_topic_name", "gcp_pubsub_subscription_name"},
+	},
+	"gcp_pubsub_subscription_name": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		ForceNew:     true,
+		Description:  "Specifies the Pub/Sub subscription from which Snowflake receives notifications (inbound direction, e.g. for automated data loads).",
+		ExactlyOneOf: []string{"gcp_pubsub_topic_name", "gcp_pubsub_subscription_name"},
+	},
+	DescribeOutputAttributeName: notificationIntegrationDescribeOutputSchema(schemas.DescribeGcpPubsubNotificationIntegrationSchema),
+})
+
+func NotificationIntegrationGcpPubsub() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: PreviewFeatureCreateContextWrapper(string(previewfeatures.NotificationIntegrationGcpPubsubResource), TrackingCreateWrapper(resources.NotificationIntegrationGcpPubsub, CreateContextNotificationIntegrationGcpPubsub)),
+		ReadContext:   PreviewFeatureReadContextWrapper(string(previewfeatures.NotificationIntegrationGcpPubsubResource), TrackingReadWrapper(resources.NotificationIntegrationGcpPubsub, ReadContextNotificationIntegrationGcpPubsub(true))),
+		UpdateContext: PreviewFeatureUpdateContextWrapper(string(previewfeatures.NotificationIntegrationGcpPubsubResource), TrackingUpdateWrapper(resources.NotificationIntegrationGcpPubsub, UpdateContextNotificationIntegrationGcpPubsub)),
+		DeleteContext: PreviewFeatureDeleteContextWrapper(string(previewfeatures.NotificationIntegrationGcpPubsubResource), TrackingDeleteWrapper(resources.NotificationIntegrationGcpPubsub, DeleteContextNotificationIntegration)),
+		Description:   "Resource used to manage notification integration objects for Google Cloud Pub/Sub. It supports both receiving notifications from a subscription (e.g. for automated data loads) and pushing notifications to a topic. For more information, check [notification integration documentation](https://docs.snowflake.com/en/sql-reference/sql/create-notification-integration).",
+
+		Schema: notificationIntegrationGcpPubsubSchema,
+		CustomizeDiff: TrackingCustomDiffWrapper(resources.NotificationIntegrationGcpPubsub, customdiff.All(
+			ComputedIfAnyAttributeChanged(notificationIntegrationGcpPubsubSchema, ShowOutputAttributeName, "enabled", "comment"),
+			ComputedIfAnyAttributeChanged(notificationIntegrationGcpPubsubSchema, DescribeOutputAttributeName, "enabled", "comment"),
+		)),
+		Importer: &schema.ResourceImporter{
+			StateContext: TrackingImportWrapper(resources.NotificationIntegrationGcpPubsub, ImportNotificationIntegrationGcpPubsub),
+		},
+		Timeouts: defaultTimeouts,
+	}
+}
+
+func ImportNotificationIntegrationGcpPubsub(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	if err := handleNotificationIntegrationImport(ctx, d, meta, map[string]string{
+		"gcp_pubsub_topic_name":        "GCP_PUBSUB_TOPIC_NAME",
+		"gcp_pubsub_subscription_name": "GCP_PUBSUB_SUBSCRIPTION_NAME",
+	}); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+func CreateContextNotificationIntegrationGcpPubsub(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseAccountObjectIdentifier(d.Get("name").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	req := sdk.NewCreateNotificationIntegrationRequest(id, d.Get("enabled").(bool))
+	if v, ok := d.GetOk("gcp_pubsub_topic_name"); ok {
+		req.WithPushNotificationParams(sdk.NewPushNotificationParamsRequest().
+			WithGooglePushParams(sdk.NewGooglePushParamsRequest(v.(string))),
+		)
+	}
+	if v, ok := d.GetOk("gcp_pubsub_subscription_name"); ok {
+		req.WithAutomatedDataLoadsParams(sdk.NewAutomatedDataLoadsParamsRequest().
+			WithGoogleAutoParams(sdk.NewGoogleAutoParamsRequest(v.(string))),
+		)
+	}
+	if v, ok := d.GetOk("comment"); ok {
+		req.WithComment(sdk.String(v.(string)))
+	}
+
+	if err := client.NotificationIntegrations.Create(ctx, req); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(helpers.EncodeResourceIdentifier(id))
+	return ReadContextNotificationIntegrationGcpPubsub(false)(ctx, d, meta)
+}
+
+func ReadContextNotificationIntegrationGcpPubsub(withExternalChangesMarking bool) schema.ReadContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+		client := meta.(*provider.Context).Client
+		id, err := sdk.ParseAccountObjectIdentifier(d.Id())
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		integration, properties, diags := queryNotificationIntegration(ctx, d, client, id)
+		if integration == nil {
+			return diags
+		}
+
+		if withExternalChangesMarking {
+			topicName, _ := notificationIntegrationPropertyValue(properties, "GCP_PUBSUB_TOPIC_NAME")
+			subscriptionName, _ := notificationIntegrationPropertyValue(properties, "GCP_PUBSUB_SUBSCRIPTION_NAME")
+			if err = handleExternalChangesToObjectInDescribe(d,
+				describeMapping{"gcp_pubsub_topic_name", "gcp_pubsub_topic_name", topicName, topicName, nil},
+				describeMapping{"gcp_pubsub_subscription_name", "gcp_pubsub_subscription_name", subscriptionName, subscriptionName, nil},
+			); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+		if err := setStateToValuesFromConfig(d, notificationIntegrationGcpPubsubSchema, []string{
+			"gcp_pubsub_topic_name",
+			"gcp_pubsub_subscription_name",
+		}); err != nil {
+			return diag.FromErr(err)
+		}
+
+		if err := handleNotificationIntegrationRead(d, id, integration, schemas.GcpPubsubNotificationIntegrationPropertiesToSchema(properties)); err != nil {
+			return diag.FromErr(err)
+		}
+		return nil
+	}
+}
+
+func UpdateContextNotificationIntegrationGcpPubsub(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseAccountObjectIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	set := sdk.NewNotificationIntegrationSetRequest()
+	handleNotificationIntegrationUpdate(d, set)
+
+	if !reflect.DeepEqual(*set, sdk.NotificationIntegrationSetRequest{}) {
+		if err := client.NotificationIntegrations.Alter(ctx, sdk.NewAlterNotificationIntegrationRequest(id).WithSet(set)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return ReadContextNotificationIntegrationGcpPubsub(false)(ctx, d, meta)
+}
@@ -0,0 +1,119 @@
+package resources_test
+
+import (
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	accconfig "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/model"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/helpers/random"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/testenvs"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_NotificationIntegrationGcpPubsub_basic(t *testing.T) {
+	_ = testenvs.GetOrSkipTest(t, testenvs.EnableAcceptance)
+	acc.TestAccPreCheck(t)
+
+	id := acc.TestClient().Ids.RandomAccountObjectIdentifier()
+	subscriptionName := "projects/project-1234/subscriptions/sub2"
+	topicName := "projects/project-1234/topics/top2"
+	comment := random.Comment()
+
+	modelBasic := model.NotificationIntegrationGcpPubsub("test", false, id.Name()).
+		WithGcpPubsubSubscriptionName(subscriptionName)
+	modelComplete := model.NotificationIntegrationGcpPubsub("test", true, id.Name()).
+		WithGcpPubsubSubscriptionName(subscriptionName).
+		WithComment(comment)
+	modelOutbound := model.NotificationIntegrationGcpPubsub("test", true, id.Name()).
+		WithGcpPubsubTopicName(topicName)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: acc.CheckDestroy(t, resources.NotificationIntegrationGcpPubsub),
+		Steps: []resource.TestStep{
+			// create with only required fields
+			{
+				Config: accconfig.FromModels(t, modelBasic),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "id", helpers.EncodeResourceIdentifier(id)),
+					resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "name", id.Name()),
+					resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "fully_qualified_name", id.FullyQualifiedName()),
+					resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "enabled", "false"),
+					resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "gcp_pubsub_subscription_name", subscriptionName),
+					resource.TestCheckNoResourceAttr(modelBasic.ResourceReference(), "gcp_pubsub_topic_name"),
+					resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "comment", ""),
+
+					resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "show_output.#", "1"),
+					resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "show_output.0.name", id.Name()),
+					resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "show_output.0.category", "NOTIFICATION"),
+					resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "show_output.0.enabled", "false"),
+
+					resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "describe_output.#", "1"),
+					resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "describe_output.0.direction.0.value", "INBOUND"),
+					resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "describe_output.0.notification_provider.0.value", "GCP_PUBSUB"),
+					resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "describe_output.0.gcp_pubsub_subscription_name.0.value", subscriptionName),
+					resource.TestCheckResourceAttrSet(modelBasic.ResourceReference(), "describe_output.0.gcp_pubsub_service_account.0.value"),
+				),
+			},
+			// import - without optionals
+			{
+				Config:            accconfig.FromModels(t, modelBasic),
+				ResourceName:      modelBasic.ResourceReference(),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// set optionals
+			{
+				Config: accconfig.FromModels(t, modelComplete),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(modelComplete.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(modelComplete.ResourceReference(), "enabled", "true"),
+					resource.TestCheckResourceAttr(modelComplete.ResourceReference(), "comment", comment),
+					resource.TestCheckResourceAttr(modelComplete.ResourceReference(), "show_output.0.enabled", "true"),
+					resource.TestCheckResourceAttr(modelComplete.ResourceReference(), "show_output.0.comment", comment),
+				),
+			},
+			// unset optionals
+			{
+				Config: accconfig.FromModels(t, modelBasic),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(modelBasic.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "enabled", "false"),
+					resource.TestCheckResourceAttr(modelBasic.ResourceReference(), "comment", ""),
+				),
+			},
+			// changing the direction recreates the integration
+			{
+				Config: accconfig.FromModels(t, modelOutbound),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(modelOutbound.ResourceReference(), plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(modelOutbound.ResourceReference(), "gcp_pubsub_topic_name", topicName),
+					resource.TestCheckNoResourceAttr(modelOutbound.ResourceReference(), "gcp_pubsub_subscription_name"),
+					resource.TestCheckResourceAttr(modelOutbound.ResourceReference(), "describe_output.0.direction.0.value", "OUTBOUND"),
+					resource.TestCheckResourceAttr(modelOutbound.ResourceReference(), "describe_output.0.gcp_pubsub_topic_name.0.value", topicName),
+				),
+			},
+		},
+	})
+}
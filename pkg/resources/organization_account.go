@@ -0,0 +1,444 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider/docs"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/snowflakeroles"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/schemas"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var organizationAccountSchema = map[string]*schema.Schema{
+	"name": {
+		Type:             schema.TypeString,
+		Required:         true,
+		DiffSuppressFunc: suppressIdentifierQuoting,
+		Description:      blocklistedCharactersFieldDescription("Specifies the identifier (i.e. name) for the organization account. Changing the name renames the organization account in place."),
+	},
+	"save_old_url": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		Default:          BooleanDefault,
+		ValidateDiagFunc: validateBooleanString,
+		Description:      booleanStringFieldDescription("Specifies whether the original account URL is saved when the organization account is renamed. The value is only used when `name` changes."),
+	},
+	"admin_name": {
+		Type:             schema.TypeString,
+		Required:         true,
+		Sensitive:        true,
+		Description:      externalChangesNotDetectedFieldDescription("Login name of the initial administrative user of the organization account. A new user is created in the new account with this name and password and granted the GLOBALORGADMIN role in the account."),
+		DiffSuppressFunc: IgnoreAfterCreation,
+	},
+	"admin_password": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		Sensitive:        true,
+		Description:      externalChangesNotDetectedFieldDescription("Password for the initial administrative user of the organization account. Either admin_password or admin_rsa_public_key has to be specified. This field cannot be used whenever admin_user_type is set to SERVICE."),
+		DiffSuppressFunc: IgnoreAfterCreation,
+		AtLeastOneOf:     []string{"admin_password", "admin_rsa_public_key"},
+	},
+	"admin_rsa_public_key": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		Description:      externalChangesNotDetectedFieldDescription("Assigns a public key to the initial administrative user of the organization account. Either admin_password or admin_rsa_public_key has to be specified."),
+		DiffSuppressFunc: IgnoreAfterCreation,
+		AtLeastOneOf:     []string{"admin_password", "admin_rsa_public_key"},
+	},
+	"admin_user_type": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		Description:      externalChangesNotDetectedFieldDescription(fmt.Sprintf("Used for setting the type of the first user that is assigned the GLOBALORGADMIN role during organization account creation. Valid options are: %s", docs.PossibleValuesListed(sdk.AllUserTypes))),
+		DiffSuppressFunc: SuppressIfAny(IgnoreAfterCreation, NormalizeAndCompare(sdk.ToUserType)),
+		ValidateDiagFunc: sdkValidation(sdk.ToUserType),
+	},
+	"first_name": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		Sensitive:        true,
+		Description:      externalChangesNotDetectedFieldDescription("First name of the initial administrative user of the organization account. This field cannot be used whenever admin_user_type is set to SERVICE."),
+		DiffSuppressFunc: IgnoreAfterCreation,
+	},
+	"last_name": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		Sensitive:        true,
+		Description:      externalChangesNotDetectedFieldDescription("Last name of the initial administrative user of the organization account. This field cannot be used whenever admin_user_type is set to SERVICE."),
+		DiffSuppressFunc: IgnoreAfterCreation,
+	},
+	"email": {
+		Type:             schema.TypeString,
+		Required:         true,
+		Sensitive:        true,
+		Description:      externalChangesNotDetectedFieldDescription("Email address of the initial administrative user of the organization account. This email address is used to send any notifications about the account."),
+		DiffSuppressFunc: IgnoreAfterCreation,
+	},
+	"must_change_password": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		Default:          BooleanDefault,
+		Description:      externalChangesNotDetectedFieldDescription("Specifies whether the new user created to administer the organization account is forced to change their password upon first login into the account. This field cannot be used whenever admin_user_type is set to SERVICE."),
+		DiffSuppressFunc: IgnoreAfterCreation,
+		ValidateDiagFunc: validateBooleanString,
+	},
+	"edition": {
+		Type:             schema.TypeString,
+		Required:         true,
+		Description:      fmt.Sprintf("Snowflake Edition of the organization account. The value is only used during creation, because an organization account cannot be recreated. Valid options are: %s", docs.PossibleValuesListed(sdk.AllOrganizationAccountEditions)),
+		DiffSuppressFunc: SuppressIfAny(IgnoreAfterCreation, NormalizeAndCompare(sdk.ToOrganizationAccountEdition)),
+		ValidateDiagFunc: sdkValidation(sdk.ToOrganizationAccountEdition),
+	},
+	"region_group": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		DiffSuppressFunc: IgnoreAfterCreation,
+		Description:      "ID of the region group where the organization account is created. The value is only used during creation, because an organization account cannot be recreated.",
+	},
+	"region": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		DiffSuppressFunc: IgnoreAfterCreation,
+		Description:      "[Snowflake Region ID](https://docs.snowflake.com/en/user-guide/admin-account-identifier.html#label-snowflake-region-ids) of the region where the organization account is created. If no value is provided, Snowflake creates the account in the same Snowflake Region as the current account. The value is only used during creation, because an organization account cannot be recreated.",
+	},
+	"comment": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		DiffSuppressFunc: IgnoreAfterCreation,
+		Description:      "Specifies a comment for the organization account. The value is only used during creation, because an organization account cannot be recreated.",
+	},
+	"resource_monitor": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		ValidateDiagFunc: IsValidIdentifier[sdk.AccountObjectIdentifier](),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+		Description:      externalChangesNotDetectedFieldDescription(relatedResourceDescription("Specifies the resource monitor assigned to the organization account.", resources.ResourceMonitor)),
+	},
+	"password_policy": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		ValidateDiagFunc: IsValidIdentifier[sdk.SchemaObjectIdentifier](),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+		Description:      externalChangesNotDetectedFieldDescription(relatedResourceDescription("Specifies the fully qualified name of the password policy assigned to the organization account.", resources.PasswordPolicy)),
+	},
+	"session_policy": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		ValidateDiagFunc: IsValidIdentifier[sdk.SchemaObjectIdentifier](),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+		Description:      externalChangesNotDetectedFieldDescription("Specifies the fully qualified name of the session policy assigned to the organization account."),
+	},
+	FullyQualifiedNameAttributeName: schemas.FullyQualifiedNameSchema,
+	ShowOutputAttributeName: {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Outputs the result of `SHOW ORGANIZATION ACCOUNTS` for the given organization account.",
+		Elem: &schema.Resource{
+			Schema: schemas.ShowOrganizationAccountSchema,
+		},
+	},
+}
+
+// OrganizationAccount returns a pointer to the resource representing an organization account.
+func OrganizationAccount() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: PreviewFeatureCreateContextWrapper(string(previewfeatures.OrganizationAccountResource), TrackingCreateWrapper(resources.OrganizationAccount, CreateOrganizationAccount)),
+		ReadContext:   PreviewFeatureReadContextWrapper(string(previewfeatures.OrganizationAccountResource), TrackingReadWrapper(resources.OrganizationAccount, ReadOrganizationAccount)),
+		UpdateContext: PreviewFeatureUpdateContextWrapper(string(previewfeatures.OrganizationAccountResource), TrackingUpdateWrapper(resources.OrganizationAccount, UpdateOrganizationAccount)),
+		DeleteContext: PreviewFeatureDeleteContextWrapper(string(previewfeatures.OrganizationAccountResource), TrackingDeleteWrapper(resources.OrganizationAccount, DeleteOrganizationAccount)),
+		Description:   "Resource used to manage the organization account. An organization can have only one organization account and Snowflake does not support dropping it, so removing the resource only removes it from the state. The GLOBALORGADMIN role has to be in the session. For more information, check [organization account documentation](https://docs.snowflake.com/en/user-guide/organization-accounts).",
+
+		Schema: organizationAccountSchema,
+		CustomizeDiff: TrackingCustomDiffWrapper(resources.OrganizationAccount, customdiff.All(
+			ComputedIfAnyAttributeChanged(organizationAccountSchema, FullyQualifiedNameAttributeName, "name"),
+			ComputedIfAnyAttributeChanged(organizationAccountSchema, ShowOutputAttributeName, "name"),
+		)),
+		Importer: &schema.ResourceImporter{
+			StateContext: TrackingImportWrapper(resources.OrganizationAccount, ImportOrganizationAccount),
+		},
+		Timeouts: defaultTimeouts,
+	}
+}
+
+func ImportOrganizationAccount(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	client := meta.(*provider.Context).Client
+
+	if err := checkGlobalOrgadminInSession(ctx, client); err != nil {
+		return nil, err
+	}
+
+	id, err := sdk.ParseAccountObjectIdentifier(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	organizationAccount, err := client.OrganizationAccounts.ShowByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := ImportName[sdk.AccountObjectIdentifier](ctx, d, nil); err != nil {
+		return nil, err
+	}
+
+	var edition string
+	if organizationAccount.Edition != nil {
+		edition = string(*organizationAccount.Edition)
+	}
+	var comment string
+	if organizationAccount.Comment != nil {
+		comment = *organizationAccount.Comment
+	}
+
+	if err := errors.Join(
+		d.Set("edition", edition),
+		d.Set("region", organizationAccount.SnowflakeRegion),
+		d.Set("comment", comment),
+	); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func CreateOrganizationAccount(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+
+	if err := checkGlobalOrgadminInSession(ctx, client); err != nil {
+		return diag.FromErr(err)
+	}
+
+	id, err := sdk.ParseAccountObjectIdentifier(d.Get("name").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	edition, err := sdk.ToOrganizationAccountEdition(d.Get("edition").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	req := sdk.NewCreateOrganizationAccountRequest(id, d.Get("admin_name").(string), d.Get("email").(string), edition)
+
+	if v, ok := d.GetOk("admin_password"); ok {
+		req.WithAdminPassword(v.(string))
+	}
+	if v, ok := d.GetOk("admin_rsa_public_key"); ok {
+		req.WithAdminRsaPublicKey(v.(string))
+	}
+	if v, ok := d.GetOk("admin_user_type"); ok {
+		userType, err := sdk.ToUserType(v.(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		req.WithAdminUserType(userType)
+	}
+	if v, ok := d.GetOk("first_name"); ok {
+		req.WithFirstName(v.(string))
+	}
+	if v, ok := d.GetOk("last_name"); ok {
+		req.WithLastName(v.(string))
+	}
+	if v := d.Get("must_change_password"); v != BooleanDefault {
+		parsedBool, err := booleanStringToBool(v.(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		req.WithMustChangePassword(parsedBool)
+	}
+	if v, ok := d.GetOk("region_group"); ok {
+		req.WithRegionGroup(v.(string))
+	}
+	if v, ok := d.GetOk("region"); ok {
+		req.WithRegion(v.(string))
+	}
+	if v, ok := d.GetOk("comment"); ok {
+		req.WithComment(v.(string))
+	}
+
+	if err := client.OrganizationAccounts.Create(ctx, req); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(helpers.EncodeResourceIdentifier(id))
+
+	for _, field := range []string{"resource_monitor", "password_policy", "session_policy"} {
+		if v, ok := d.GetOk(field); ok {
+			set, err := organizationAccountSetRequest(field, v.(string))
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			if err := client.OrganizationAccounts.Alter(ctx, sdk.NewAlterOrganizationAccountRequest(id).WithSet(*set)); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	return ReadOrganizationAccount(ctx, d, meta)
+}
+
+func ReadOrganizationAccount(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+
+	if err := checkGlobalOrgadminInSession(ctx, client); err != nil {
+		return diag.FromErr(err)
+	}
+
+	id, err := sdk.ParseAccountObjectIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	organizationAccount, err := client.OrganizationAccounts.ShowByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, sdk.ErrObjectNotFound) {
+			d.SetId("")
+			return diag.Diagnostics{
+				diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  "Failed to query organization account. Marking the resource as removed.",
+					Detail:   fmt.Sprintf("Organization account name: %s, Err: %s", id.FullyQualifiedName(), err),
+				},
+			}
+		}
+		return diag.FromErr(err)
+	}
+
+	if errs := errors.Join(
+		d.Set("name", organizationAccount.AccountName),
+		d.Set(FullyQualifiedNameAttributeName, id.FullyQualifiedName()),
+		d.Set(ShowOutputAttributeName, []map[string]any{schemas.OrganizationAccountToSchema(organizationAccount)}),
+	); errs != nil {
+		return diag.FromErr(errs)
+	}
+
+	return nil
+}
+
+func UpdateOrganizationAccount(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+
+	if err := checkGlobalOrgadminInSession(ctx, client); err != nil {
+		return diag.FromErr(err)
+	}
+
+	id, err := sdk.ParseAccountObjectIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("name") {
+		newId, err := sdk.ParseAccountObjectIdentifier(d.Get("name").(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		rename := sdk.NewOrganizationAccountRenameRequest(newId)
+		if v := d.Get("save_old_url").(string); v != BooleanDefault {
+			parsed, err := booleanStringToBool(v)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			rename.WithSaveOldUrl(parsed)
+		}
+
+		if err := client.OrganizationAccounts.Alter(ctx, sdk.NewAlterOrganizationAccountRequest(id).WithRenameTo(*rename)); err != nil {
+			return diag.FromErr(err)
+		}
+
+		d.SetId(helpers.EncodeResourceIdentifier(newId))
+		id = newId
+	}
+
+	for _, field := range []string{"resource_monitor", "password_policy", "session_policy"} {
+		if !d.HasChange(field) {
+			continue
+		}
+		if v, ok := d.GetOk(field); ok {
+			set, err := organizationAccountSetRequest(field, v.(string))
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			if err := client.OrganizationAccounts.Alter(ctx, sdk.NewAlterOrganizationAccountRequest(id).WithSet(*set)); err != nil {
+				return diag.FromErr(err)
+			}
+		} else {
+			if err := client.OrganizationAccounts.Alter(ctx, sdk.NewAlterOrganizationAccountRequest(id).WithUnset(*organizationAccountUnsetRequest(field))); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	return ReadOrganizationAccount(ctx, d, meta)
+}
+
+func DeleteOrganizationAccount(_ context.Context, d *schema.ResourceData, _ any) diag.Diagnostics {
+	id := d.Id()
+	d.SetId("")
+
+	return diag.Diagnostics{
+		diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "Organization account was removed only from the state.",
+			Detail:   fmt.Sprintf("Snowflake does not support dropping an organization account, so %s still exists in Snowflake.", id),
+		},
+	}
+}
+
+func checkGlobalOrgadminInSession(ctx context.Context, client *sdk.Client) error {
+	isGlobalOrgadmin, err := client.ContextFunctions.IsRoleInSession(ctx, snowflakeroles.GlobalOrgadmin)
+	if err != nil {
+		return err
+	}
+	if !isGlobalOrgadmin {
+		return errors.New("current user does not have the GLOBALORGADMIN role in session")
+	}
+	return nil
+}
+
+func organizationAccountSetRequest(field string, value string) (*sdk.OrganizationAccountSetRequest, error) {
+	set := sdk.NewOrganizationAccountSetRequest()
+	switch field {
+	case "resource_monitor":
+		id, err := sdk.ParseAccountObjectIdentifier(value)
+		if err != nil {
+			return nil, err
+		}
+		set.WithResourceMonitor(id)
+	case "password_policy":
+		id, err := sdk.ParseSchemaObjectIdentifier(value)
+		if err != nil {
+			return nil, err
+		}
+		set.WithPasswordPolicy(id)
+	case "session_policy":
+		id, err := sdk.ParseSchemaObjectIdentifier(value)
+		if err != nil {
+			return nil, err
+		}
+		set.WithSessionPolicy(id)
+	default:
+		return nil, fmt.Errorf("unsupported organization account field: %s", field)
+	}
+	return set, nil
+}
+
+func organizationAccountUnsetRequest(field string) *sdk.OrganizationAccountUnsetRequest {
+	unset := sdk.NewOrganizationAccountUnsetRequest()
+	switch field {
+	case "resource_monitor":
+		unset.WithResourceMonitor(true)
+	case "password_policy":
+		unset.WithPasswordPolicy(true)
+	case "session_policy":
+		unset.WithSessionPolicy(true)
+	}
+	return unset
+}
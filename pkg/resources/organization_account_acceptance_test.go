@@ -0,0 +1,97 @@
+package resources_test
+
+import (
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert/resourceassert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/model"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/helpers/random"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/testenvs"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	r "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+// TestAcc_OrganizationAccount_basic is a manual test, because an organization can have only one organization account
+// and Snowflake does not support dropping it. It has to be run with the GLOBALORGADMIN role in an organization without an organization account.
+func TestAcc_OrganizationAccount_basic(t *testing.T) {
+	_ = testenvs.GetOrSkipTest(t, testenvs.EnableAcceptance)
+	_ = testenvs.GetOrSkipTest(t, testenvs.EnableManual)
+
+	id := sdk.NewAccountObjectIdentifier(random.AdminName())
+	newId := sdk.NewAccountObjectIdentifier(random.AdminName())
+	adminName := random.AdminName()
+	email := random.Email()
+	key, _ := random.GenerateRSAPublicKey(t)
+	comment := random.Comment()
+
+	configModel := model.OrganizationAccount("test", adminName, string(sdk.OrganizationAccountEditionEnterprise), email, id.Name()).
+		WithAdminUserType(string(sdk.UserTypeService)).
+		WithAdminRsaPublicKey(key).
+		WithComment(comment)
+	renamedConfigModel := model.OrganizationAccount("test", adminName, string(sdk.OrganizationAccountEditionEnterprise), email, newId.Name()).
+		WithAdminUserType(string(sdk.UserTypeService)).
+		WithAdminRsaPublicKey(key).
+		WithComment(comment).
+		WithSaveOldUrl(r.BooleanTrue)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		// no CheckDestroy, because the organization account is only removed from the state
+		Steps: []resource.TestStep{
+			{
+				Config: config.FromModels(t, configModel),
+				Check: resource.ComposeTestCheckFunc(
+					assertThat(t,
+						resourceassert.OrganizationAccountResource(t, configModel.ResourceReference()).
+							HasNameString(id.Name()).
+							HasFullyQualifiedNameString(id.FullyQualifiedName()).
+							HasEditionString(string(sdk.OrganizationAccountEditionEnterprise)).
+							HasCommentString(comment).
+							HasSaveOldUrlString(r.BooleanDefault),
+					),
+					resource.TestCheckResourceAttr(configModel.ResourceReference(), "id", helpers.EncodeResourceIdentifier(id)),
+					resource.TestCheckResourceAttr(configModel.ResourceReference(), "show_output.#", "1"),
+					resource.TestCheckResourceAttr(configModel.ResourceReference(), "show_output.0.account_name", id.Name()),
+					resource.TestCheckResourceAttr(configModel.ResourceReference(), "show_output.0.edition", string(sdk.OrganizationAccountEditionEnterprise)),
+					resource.TestCheckResourceAttr(configModel.ResourceReference(), "show_output.0.comment", comment),
+					resource.TestCheckResourceAttr(configModel.ResourceReference(), "show_output.0.is_organization_account", "true"),
+				),
+			},
+			{
+				Config:                  config.FromModels(t, configModel),
+				ResourceName:            configModel.ResourceReference(),
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"admin_name", "admin_rsa_public_key", "admin_user_type", "email", "must_change_password", "save_old_url", "region"},
+			},
+			{
+				Config: config.FromModels(t, renamedConfigModel),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(renamedConfigModel.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					assertThat(t,
+						resourceassert.OrganizationAccountResource(t, renamedConfigModel.ResourceReference()).
+							HasNameString(newId.Name()).
+							HasFullyQualifiedNameString(newId.FullyQualifiedName()).
+							HasSaveOldUrlString(r.BooleanTrue),
+					),
+					resource.TestCheckResourceAttr(renamedConfigModel.ResourceReference(), "id", helpers.EncodeResourceIdentifier(newId)),
+					resource.TestCheckResourceAttr(renamedConfigModel.ResourceReference(), "show_output.0.account_name", newId.Name()),
+					resource.TestCheckResourceAttrSet(renamedConfigModel.ResourceReference(), "show_output.0.old_account_url"),
+				),
+			},
+		},
+	})
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 var pipeSchema = map[string]*schema.Schema{
@@ -82,6 +83,60 @@ var pipeSchema = map[string]*schema.Schema{
 		Optional:    true,
 		Description: "Specifies the name of the notification integration used for error notifications.",
 	},
+	"refresh_on_create": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "When set to true, `ALTER PIPE ... REFRESH` is run right after the pipe is created (also when it is recreated, e.g. after changing `copy_statement`), so that the files staged before the pipe existed are loaded. Changing this field does not refresh the existing pipe. For more information, check [ALTER PIPE documentation](https://docs.snowflake.com/en/sql-reference/sql/alter-pipe).",
+	},
+	"refresh_prefix": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Path (or prefix) appended to the stage reference in the pipe definition; only the files under it are loaded by the refresh. Used only when `refresh_on_create` is set to true.",
+	},
+	"modified_after": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		ValidateDiagFunc: validation.ToDiagFunc(validation.IsRFC3339Time),
+		Description:      "Timestamp in the RFC 3339 format (e.g. `2024-01-01T10:00:00Z`); only the files modified after it are loaded by the refresh. Snowflake allows at most the last 7 days. Used only when `refresh_on_create` is set to true.",
+	},
+	"pipe_status": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Current status of the pipe returned by [SYSTEM$PIPE_STATUS](https://docs.snowflake.com/en/sql-reference/functions/system_pipe_status). Empty when the status cannot be retrieved.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"execution_state": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"pending_file_count": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"oldest_file_timestamp": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"last_ingested_timestamp": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"last_ingested_file_path": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"error": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"fault": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	},
 	FullyQualifiedNameAttributeName: schemas.FullyQualifiedNameSchema,
 }
 
@@ -151,6 +206,19 @@ func CreatePipe(ctx context.Context, d *schema.ResourceData, meta any) diag.Diag
 
 	d.SetId(helpers.EncodeSnowflakeID(objectIdentifier))
 
+	if d.Get("refresh_on_create").(bool) {
+		refresh := &sdk.PipeRefresh{}
+		if v, ok := d.GetOk("refresh_prefix"); ok {
+			refresh.Prefix = sdk.String(v.(string))
+		}
+		if v, ok := d.GetOk("modified_after"); ok {
+			refresh.ModifiedAfter = sdk.String(v.(string))
+		}
+		if err := client.Pipes.Alter(ctx, objectIdentifier, &sdk.AlterPipeOptions{Refresh: refresh}); err != nil {
+			return diag.FromErr(fmt.Errorf("error refreshing pipe %v: %w", objectIdentifier.FullyQualifiedName(), err))
+		}
+	}
+
 	return ReadPipe(ctx, d, meta)
 }
 
@@ -213,9 +281,49 @@ func ReadPipe(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagno
 		return diag.FromErr(err)
 	}
 
+	pipeStatus, err := client.SystemFunctions.PipeStatusDetails(ctx, id)
+	if err != nil {
+		if err := d.Set("pipe_status", []any{}); err != nil {
+			return diag.FromErr(err)
+		}
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "Failed to query pipe status. The pipe_status field is left empty.",
+				Detail:   fmt.Sprintf("Pipe id: %s, Err: %s", id.FullyQualifiedName(), err),
+			},
+		}
+	}
+	if err := d.Set("pipe_status", []any{pipeStatusToSchema(pipeStatus)}); err != nil {
+		return diag.FromErr(err)
+	}
+
 	return nil
 }
 
+func pipeStatusToSchema(pipeStatus *sdk.PipeStatus) map[string]any {
+	pipeStatusSchema := map[string]any{
+		"execution_state":    string(pipeStatus.ExecutionState),
+		"pending_file_count": pipeStatus.PendingFileCount,
+	}
+	if pipeStatus.OldestFileTimestamp != nil {
+		pipeStatusSchema["oldest_file_timestamp"] = *pipeStatus.OldestFileTimestamp
+	}
+	if pipeStatus.LastIngestedTimestamp != nil {
+		pipeStatusSchema["last_ingested_timestamp"] = *pipeStatus.LastIngestedTimestamp
+	}
+	if pipeStatus.LastIngestedFilePath != nil {
+		pipeStatusSchema["last_ingested_file_path"] = *pipeStatus.LastIngestedFilePath
+	}
+	if pipeStatus.Error != nil {
+		pipeStatusSchema["error"] = *pipeStatus.Error
+	}
+	if pipeStatus.Fault != nil {
+		pipeStatusSchema["fault"] = *pipeStatus.Fault
+	}
+	return pipeStatusSchema
+}
+
 // UpdatePipe implements schema.UpdateFunc.
 func UpdatePipe(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	client := meta.(*provider.Context).Client
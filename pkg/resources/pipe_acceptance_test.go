@@ -3,6 +3,7 @@ package resources_test
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
 
@@ -10,6 +11,7 @@ import (
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 )
 
@@ -39,6 +41,53 @@ func TestAcc_Pipe(t *testing.T) {
 					resource.TestCheckResourceAttr("snowflake_pipe.test", "comment", "Terraform acceptance test"),
 					resource.TestCheckResourceAttr("snowflake_pipe.test", "auto_ingest", "false"),
 					resource.TestCheckResourceAttr("snowflake_pipe.test", "notification_channel", ""),
+					resource.TestCheckResourceAttr("snowflake_pipe.test", "refresh_on_create", "false"),
+					resource.TestCheckResourceAttr("snowflake_pipe.test", "pipe_status.#", "1"),
+					resource.TestCheckResourceAttr("snowflake_pipe.test", "pipe_status.0.execution_state", string(sdk.RunningPipeExecutionState)),
+					resource.TestCheckResourceAttr("snowflake_pipe.test", "pipe_status.0.pending_file_count", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAcc_Pipe_RefreshOnCreate(t *testing.T) {
+	_ = testenvs.GetOrSkipTest(t, testenvs.EnableAcceptance)
+	acc.TestAccPreCheck(t)
+
+	pipeId := acc.TestClient().Ids.RandomSchemaObjectIdentifier()
+	tableId := acc.TestClient().Ids.RandomSchemaObjectIdentifier()
+	stageId := acc.TestClient().Ids.RandomSchemaObjectIdentifier()
+	modifiedAfter := time.Now().Add(-24 * time.Hour).UTC().Format(time.RFC3339)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: acc.CheckDestroy(t, resources.Pipe),
+		Steps: []resource.TestStep{
+			{
+				Config: pipeConfigWithRefreshOnCreate(pipeId, tableId, stageId, "data/", modifiedAfter),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_pipe.test", "name", pipeId.Name()),
+					resource.TestCheckResourceAttr("snowflake_pipe.test", "refresh_on_create", "true"),
+					resource.TestCheckResourceAttr("snowflake_pipe.test", "refresh_prefix", "data/"),
+					resource.TestCheckResourceAttr("snowflake_pipe.test", "modified_after", modifiedAfter),
+					resource.TestCheckResourceAttr("snowflake_pipe.test", "pipe_status.0.execution_state", string(sdk.RunningPipeExecutionState)),
+				),
+			},
+			// changing the refresh fields does not recreate the pipe
+			{
+				Config: pipeConfigWithRefreshOnCreate(pipeId, tableId, stageId, "other/", modifiedAfter),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("snowflake_pipe.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_pipe.test", "refresh_prefix", "other/"),
 				),
 			},
 		},
@@ -85,3 +134,34 @@ CMD
 }
 `, pipeId.DatabaseName(), pipeId.SchemaName(), pipeId.Name(), tableId.Name(), stageId.Name())
 }
+
+func pipeConfigWithRefreshOnCreate(pipeId sdk.SchemaObjectIdentifier, tableId sdk.SchemaObjectIdentifier, stageId sdk.SchemaObjectIdentifier, refreshPrefix string, modifiedAfter string) string {
+	return fmt.Sprintf(`
+resource "snowflake_table" "test" {
+  database = "%[1]s"
+  schema   = "%[2]s"
+  name     = "%[4]s"
+
+  column {
+    name = "id"
+    type = "NUMBER(5,0)"
+  }
+}
+
+resource "snowflake_stage" "test" {
+  database = "%[1]s"
+  schema   = "%[2]s"
+  name     = "%[5]s"
+}
+
+resource "snowflake_pipe" "test" {
+  database          = "%[1]s"
+  schema            = "%[2]s"
+  name              = "%[3]s"
+  copy_statement    = "COPY INTO ${snowflake_table.test.fully_qualified_name} FROM @${snowflake_stage.test.fully_qualified_name} FILE_FORMAT = (TYPE = CSV)"
+  refresh_on_create = true
+  refresh_prefix    = "%[6]s"
+  modified_after    = "%[7]s"
+}
+`, pipeId.DatabaseName(), pipeId.SchemaName(), pipeId.Name(), tableId.Name(), stageId.Name(), refreshPrefix, modifiedAfter)
+}
@@ -47,10 +47,19 @@ func setUpProcedureSchema(definition procedureSchemaDef) map[string]*schema.Sche
 	}
 	if v, ok := currentSchema["procedure_definition"]; ok && v != nil {
 		v.Description = diffSuppressStatementFieldDescription(definition.procedureDefinitionDescription)
+		v.Optional = true
 		if definition.procedureDefinitionRequired {
-			v.Required = true
+			v.Description += " Exactly one of `procedure_definition` and `procedure_definition_file` has to be set."
+			v.ExactlyOneOf = []string{"procedure_definition", "procedure_definition_file"}
 		} else {
-			v.Optional = true
+			v.ConflictsWith = []string{"procedure_definition_file"}
+		}
+	}
+	if v, ok := currentSchema["procedure_definition_file"]; ok && v != nil {
+		if definition.procedureDefinitionRequired {
+			v.ExactlyOneOf = []string{"procedure_definition", "procedure_definition_file"}
+		} else {
+			v.ConflictsWith = []string{"procedure_definition"}
 		}
 	}
 	if v, ok := currentSchema["return_type"]; ok && v != nil {
@@ -91,6 +100,9 @@ var (
 		"comment",
 		"execute_as",
 		"procedure_definition",
+		"procedure_definition_file",
+		"procedure_definition_hash",
+		"procedure_definition_diff",
 		"procedure_language",
 		ShowOutputAttributeName,
 		ParametersAttributeName,
@@ -360,7 +372,22 @@ func procedureBaseSchema() map[string]schema.Schema {
 		"procedure_definition": {
 			Type:             schema.TypeString,
 			ForceNew:         true,
-			DiffSuppressFunc: DiffSuppressStatement,
+			DiffSuppressFunc: suppressDefinitionDiff,
+		},
+		"procedure_definition_file": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Path to the local file containing the `procedure_definition` (relative paths are resolved against the working directory, so e.g. `\"${path.module}/<file_name>\"` should be used). Alternative to the inline `procedure_definition`, useful for longer handlers. The file is tracked by the hash of its normalized content (see `procedure_definition_hash`), and the stored procedure is recreated when the content changes. Changing only the path does not recreate the stored procedure.",
+		},
+		"procedure_definition_hash": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "SHA-256 hash of the normalized body of the stored procedure (line endings unified, trailing whitespace in lines and surrounding blank lines removed). It is calculated from the body returned by `DESCRIBE PROCEDURE`, so changes of the body made outside of Terraform are detected.",
+		},
+		"procedure_definition_diff": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Unified diff between the body of the stored procedure in Snowflake and the configured body. It is calculated during the plan when the body changes, and holds the diff of the last change of the body.",
 		},
 		"procedure_language": {
 			Type:        schema.TypeString,
@@ -33,6 +33,7 @@ func ProcedurePython() *schema.Resource {
 			ComputedIfAnyAttributeChanged(pythonProcedureSchema, FullyQualifiedNameAttributeName, "name"),
 			ComputedIfAnyAttributeChanged(procedureParametersSchema, ParametersAttributeName, collections.Map(sdk.AsStringList(sdk.AllProcedureParameters), strings.ToLower)...),
 			procedureParametersCustomDiff,
+			definitionCustomDiff("procedure"),
 			// The language check is more for the future.
 			// Currently, almost all attributes are marked as forceNew.
 			// When language changes, these attributes also change, causing the object to recreate either way.
@@ -84,7 +85,7 @@ func CreateContextProcedurePython(ctx context.Context, d *schema.ResourceData, m
 		setProcedureImportsInBuilder(d, request.WithImports),
 		setExternalAccessIntegrationsInBuilder(d, request.WithExternalAccessIntegrations),
 		setSecretsInBuilder(d, request.WithSecrets),
-		stringAttributeCreateBuilder(d, "procedure_definition", request.WithProcedureDefinitionWrapped),
+		setDefinitionInBuilder(d, "procedure", request.WithProcedureDefinitionWrapped),
 	)
 	if errs != nil {
 		return diag.FromErr(errs)
@@ -139,7 +140,7 @@ func ReadContextProcedurePython(ctx context.Context, d *schema.ResourceData, met
 		setRequiredFromStringPtr(d, "handler", allProcedureDetails.procedureDetails.Handler),
 		readFunctionOrProcedureExternalAccessIntegrations(d, allProcedureDetails.procedureDetails.NormalizedExternalAccessIntegrations),
 		readFunctionOrProcedureSecrets(d, allProcedureDetails.procedureDetails.NormalizedSecrets),
-		setOptionalFromStringPtr(d, "procedure_definition", allProcedureDetails.procedureDetails.Body),
+		readFunctionOrProcedureDefinition(d, "procedure", allProcedureDetails.procedureDetails.Body),
 		d.Set("procedure_language", allProcedureDetails.procedureDetails.Language),
 
 		handleProcedureParameterRead(d, allProcedureDetails.procedureParameters),
@@ -33,6 +33,7 @@ func ProcedureScala() *schema.Resource {
 			ComputedIfAnyAttributeChanged(scalaProcedureSchema, FullyQualifiedNameAttributeName, "name"),
 			ComputedIfAnyAttributeChanged(procedureParametersSchema, ParametersAttributeName, collections.Map(sdk.AsStringList(sdk.AllProcedureParameters), strings.ToLower)...),
 			procedureParametersCustomDiff,
+			definitionCustomDiff("procedure"),
 			// The language check is more for the future.
 			// Currently, almost all attributes are marked as forceNew.
 			// When language changes, these attributes also change, causing the object to recreate either way.
@@ -85,7 +86,7 @@ func CreateContextProcedureScala(ctx context.Context, d *schema.ResourceData, me
 		setExternalAccessIntegrationsInBuilder(d, request.WithExternalAccessIntegrations),
 		setSecretsInBuilder(d, request.WithSecrets),
 		setProcedureTargetPathInBuilder(d, request.WithTargetPath),
-		stringAttributeCreateBuilder(d, "procedure_definition", request.WithProcedureDefinitionWrapped),
+		setDefinitionInBuilder(d, "procedure", request.WithProcedureDefinitionWrapped),
 	)
 	if errs != nil {
 		return diag.FromErr(errs)
@@ -141,7 +142,7 @@ func ReadContextProcedureScala(ctx context.Context, d *schema.ResourceData, meta
 		readFunctionOrProcedureExternalAccessIntegrations(d, allProcedureDetails.procedureDetails.NormalizedExternalAccessIntegrations),
 		readFunctionOrProcedureSecrets(d, allProcedureDetails.procedureDetails.NormalizedSecrets),
 		readFunctionOrProcedureTargetPath(d, allProcedureDetails.procedureDetails.NormalizedTargetPath),
-		setOptionalFromStringPtr(d, "procedure_definition", allProcedureDetails.procedureDetails.Body),
+		readFunctionOrProcedureDefinition(d, "procedure", allProcedureDetails.procedureDetails.Body),
 		d.Set("procedure_language", allProcedureDetails.procedureDetails.Language),
 
 		handleProcedureParameterRead(d, allProcedureDetails.procedureParameters),
@@ -32,6 +32,7 @@ func ProcedureSql() *schema.Resource {
 			ComputedIfAnyAttributeChanged(sqlProcedureSchema, FullyQualifiedNameAttributeName, "name"),
 			ComputedIfAnyAttributeChanged(procedureParametersSchema, ParametersAttributeName, collections.Map(sdk.AsStringList(sdk.AllProcedureParameters), strings.ToLower)...),
 			procedureParametersCustomDiff,
+			definitionCustomDiff("procedure"),
 			// The language check is more for the future.
 			// Currently, almost all attributes are marked as forceNew.
 			// When language changes, these attributes also change, causing the object to recreate either way.
@@ -61,7 +62,10 @@ func CreateContextProcedureSql(ctx context.Context, d *schema.ResourceData, meta
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	procedureDefinition := d.Get("procedure_definition").(string)
+	procedureDefinition, err := readDefinition(d, "procedure")
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
 	argumentDataTypes := collections.Map(argumentRequests, func(r sdk.ProcedureArgumentRequest) datatypes.DataType { return r.ArgDataType })
 	id := sdk.NewSchemaObjectIdentifierWithArgumentsNormalized(database, sc, name, argumentDataTypes...)
@@ -120,7 +124,7 @@ func ReadContextProcedureSql(ctx context.Context, d *schema.ResourceData, meta a
 		// not reading null_input_behavior on purpose (handled as external change to show output)
 		// not reading execute_as on purpose (handled as external change to show output)
 		d.Set("comment", allProcedureDetails.procedure.Description),
-		setOptionalFromStringPtr(d, "procedure_definition", allProcedureDetails.procedureDetails.Body),
+		readFunctionOrProcedureDefinition(d, "procedure", allProcedureDetails.procedureDetails.Body),
 		d.Set("procedure_language", allProcedureDetails.procedureDetails.Language),
 
 		handleProcedureParameterRead(d, allProcedureDetails.procedureParameters),
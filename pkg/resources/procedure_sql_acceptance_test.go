@@ -1,6 +1,10 @@
 package resources_test
 
 import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
 	"testing"
 
 	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
@@ -12,6 +16,7 @@ import (
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/model"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/importchecks"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/planchecks"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/testdatatypes"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/testenvs"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
@@ -195,3 +200,123 @@ func TestAcc_ProcedureSql_InlineFull(t *testing.T) {
 		},
 	})
 }
+
+func TestAcc_ProcedureSql_DefinitionFile(t *testing.T) {
+	_ = testenvs.GetOrSkipTest(t, testenvs.EnableAcceptance)
+	acc.TestAccPreCheck(t)
+
+	argName := "x"
+	dataType := testdatatypes.DataTypeVarchar_100
+
+	id := acc.TestClient().Ids.RandomSchemaObjectIdentifierWithArgumentsNewDataTypes(dataType)
+
+	definition := "BEGIN\n  RETURN x;\nEND;\n"
+	changedDefinition := "BEGIN\n  RETURN UPPER(x);\nEND;\n"
+	definitionFile := filepath.Join(t.TempDir(), "procedure.sql")
+	writeDefinitionFile := func(content string) func() {
+		return func() {
+			if err := os.WriteFile(definitionFile, []byte(content), 0o600); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	writeDefinitionFile(definition)()
+
+	procedureModel := model.ProcedureSql("w", id.DatabaseName(), id.Name(), dataType.ToSql(), id.SchemaName()).
+		WithProcedureDefinitionFile(definitionFile).
+		WithArgument(argName, dataType)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		CheckDestroy: acc.CheckDestroy(t, resources.ProcedureSql),
+		Steps: []resource.TestStep{
+			// CREATE FROM FILE
+			{
+				Config: config.FromModels(t, procedureModel),
+				Check: assertThat(t,
+					resourceassert.ProcedureSqlResource(t, procedureModel.ResourceReference()).
+						HasNameString(id.Name()).
+						HasProcedureDefinitionFileString(definitionFile).
+						HasProcedureDefinitionDiffString(""),
+					assert.Check(resource.TestCheckResourceAttrSet(procedureModel.ResourceReference(), "procedure_definition_hash")),
+				),
+			},
+			// WHITESPACE CHANGES IN FILE (NO CHANGES)
+			{
+				PreConfig: writeDefinitionFile("\r\nBEGIN  \r\n  RETURN x;\r\nEND;\r\n\r\n"),
+				Config:    config.FromModels(t, procedureModel),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			// CHANGE BODY IN FILE (RECREATE)
+			{
+				PreConfig: writeDefinitionFile(changedDefinition),
+				Config:    config.FromModels(t, procedureModel),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(procedureModel.ResourceReference(), plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.ProcedureSqlResource(t, procedureModel.ResourceReference()).
+						HasProcedureDefinitionString(changedDefinition).
+						HasProcedureDefinitionDiffString("--- snowflake\n+++ "+definitionFile+"\n@@ -1,3 +1,3 @@\n BEGIN\n-  RETURN x;\n+  RETURN UPPER(x);\n END;\n"),
+				),
+			},
+			// CHANGE BODY EXTERNALLY (DETECT AND RECREATE)
+			{
+				PreConfig: func() {
+					acc.TestClient().Procedure.DropProcedureFunc(t, id)()
+					_, _ = acc.TestClient().Procedure.CreateSqlWithIdentifierAndArgument(t, id.SchemaObjectId(), dataType, definition)
+				},
+				Config: config.FromModels(t, procedureModel),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(procedureModel.ResourceReference(), plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.ProcedureSqlResource(t, procedureModel.ResourceReference()).
+						HasProcedureDefinitionString(changedDefinition),
+				),
+			},
+			// MISSING FILE (UNKNOWN HASH, RECREATE FAILS ON READING THE FILE)
+			{
+				PreConfig: func() {
+					if err := os.Remove(definitionFile); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: config.FromModels(t, procedureModel),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(procedureModel.ResourceReference(), plancheck.ResourceActionDestroyBeforeCreate),
+						planchecks.ExpectComputed(procedureModel.ResourceReference(), "procedure_definition_hash", true),
+					},
+				},
+				ExpectError: regexp.MustCompile(fmt.Sprintf("could not read procedure_definition_file %s", regexp.QuoteMeta(definitionFile))),
+			},
+			// FILE RESTORED (CREATE)
+			{
+				PreConfig: writeDefinitionFile(changedDefinition),
+				Config:    config.FromModels(t, procedureModel),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(procedureModel.ResourceReference(), plancheck.ResourceActionCreate),
+					},
+				},
+				Check: assertThat(t,
+					resourceassert.ProcedureSqlResource(t, procedureModel.ResourceReference()).
+						HasProcedureDefinitionString(changedDefinition),
+				),
+			},
+		},
+	})
+}
@@ -56,6 +56,24 @@ func handleParameterUpdateWithMapping[T, R any, P ~string](d *schema.ResourceDat
 	return nil
 }
 
+// handleParameterAfterClone calls internally handleParameterAfterCloneWithMapping with identity mapping
+func handleParameterAfterClone[T any, P ~string](d *schema.ResourceData, parameterName P, setField **T, unsetField **bool) diag.Diagnostics {
+	return handleParameterAfterCloneWithMapping[T, T](d, parameterName, setField, unsetField, identityMapping[T])
+}
+
+// handleParameterAfterCloneWithMapping is used for objects created as a clone, which inherit the parameters of the source object.
+// If the value is set in the configuration, setField is set to the planned value applying mapping beforehand.
+// Otherwise, unsetField is populated, so that the value inherited from the source is not kept.
+func handleParameterAfterCloneWithMapping[T, R any, P ~string](d *schema.ResourceData, parameterName P, setField **R, unsetField **bool, mapping func(value T) (R, error)) diag.Diagnostics {
+	if diags := handleParameterCreateWithMapping(d, parameterName, setField, mapping); diags != nil {
+		return diags
+	}
+	if *setField == nil {
+		*unsetField = sdk.Bool(true)
+	}
+	return nil
+}
+
 func identityMapping[T any](value T) (T, error) {
 	return value, nil
 }
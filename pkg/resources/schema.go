@@ -61,6 +61,7 @@ var schemaSchema = map[string]*schema.Schema{
 		Optional:    true,
 		Description: "Specifies a comment for the schema.",
 	},
+	"from_clone": objectCloneSchema("schema", relatedResourceDescription("Fully qualified name of the schema to clone, e.g. `\"<database_name>\".\"<schema_name>\"`.", resources.Schema), IsValidIdentifier[sdk.DatabaseObjectIdentifier]()),
 	ShowOutputAttributeName: {
 		Type:        schema.TypeList,
 		Computed:    true,
@@ -171,7 +172,7 @@ func CreateContextSchema(ctx context.Context, d *schema.ResourceData, meta any)
 	database := d.Get("database").(string)
 	id := sdk.NewDatabaseObjectIdentifier(database, name)
 
-	if strings.EqualFold(strings.TrimSpace(name), "PUBLIC") {
+	if _, isClone := d.GetOk("from_clone"); strings.EqualFold(strings.TrimSpace(name), "PUBLIC") && !isClone {
 		_, err := client.Schemas.ShowByID(ctx, id)
 		if err != nil && !errors.Is(err, sdk.ErrObjectNotFound) {
 			return diag.FromErr(err)
@@ -197,6 +198,11 @@ func CreateContextSchema(ctx context.Context, d *schema.ResourceData, meta any)
 		}
 		opts.Transient = sdk.Bool(parsed)
 	}
+	clone, err := getObjectClone(d, func(source string) (sdk.ObjectIdentifier, error) { return sdk.ParseDatabaseObjectIdentifier(source) })
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	opts.Clone = clone
 	if v := d.Get("with_managed_access").(string); v != BooleanDefault {
 		parsed, err := booleanStringToBool(v)
 		if err != nil {
@@ -216,6 +222,27 @@ func CreateContextSchema(ctx context.Context, d *schema.ResourceData, meta any)
 
 	d.SetId(helpers.EncodeResourceIdentifier(id))
 
+	if clone != nil {
+		// the clone inherits the comment and the parameters of the source schema
+		set, unset := new(sdk.SchemaSet), new(sdk.SchemaUnset)
+		if diags := handleSchemaParametersAfterClone(d, set, unset); diags != nil {
+			return diags
+		}
+		if comment := GetConfigPropertyAsPointerAllowingZeroValue[string](d, "comment"); comment != nil {
+			set.Comment = comment
+		} else {
+			unset.Comment = sdk.Bool(true)
+		}
+		if (*set != sdk.SchemaSet{}) {
+			if err := client.Schemas.Alter(ctx, id, &sdk.AlterSchemaOptions{Set: set}); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+		if err := client.Schemas.Alter(ctx, id, &sdk.AlterSchemaOptions{Unset: unset}); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return ReadContextSchema(false)(ctx, d, meta)
 }
 
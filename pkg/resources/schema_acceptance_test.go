@@ -1126,3 +1126,74 @@ func TestAcc_Schema_IdentifierQuotingDiffSuppression(t *testing.T) {
 		},
 	})
 }
+
+func TestAcc_Schema_FromClone(t *testing.T) {
+	_ = testenvs.GetOrSkipTest(t, testenvs.EnableAcceptance)
+	acc.TestAccPreCheck(t)
+
+	sourceSchema, sourceSchemaCleanup := acc.TestClient().Schema.CreateSchemaWithOpts(t, acc.TestClient().Ids.RandomDatabaseObjectIdentifier(), &sdk.CreateSchemaOptions{
+		Comment:                    sdk.String(random.Comment()),
+		MaxDataExtensionTimeInDays: sdk.Int(20),
+	})
+	t.Cleanup(sourceSchemaCleanup)
+	sourceTable, sourceTableCleanup := acc.TestClient().Table.CreateInSchema(t, sourceSchema.ID())
+	t.Cleanup(sourceTableCleanup)
+
+	id := acc.TestClient().Ids.RandomDatabaseObjectIdentifier()
+	clonedTableId := sdk.NewSchemaObjectIdentifierInSchema(id, sourceTable.Name)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: acc.CheckDestroy(t, resources.Schema),
+		Steps: []resource.TestStep{
+			{
+				Config: schemaFromCloneConfig(id, sourceSchema.ID()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_schema.test", "name", id.Name()),
+					resource.TestCheckResourceAttr("snowflake_schema.test", "from_clone.#", "1"),
+					resource.TestCheckResourceAttr("snowflake_schema.test", "from_clone.0.source", sourceSchema.ID().FullyQualifiedName()),
+					resource.TestCheckResourceAttr("snowflake_schema.test", "from_clone.0.at.#", "0"),
+					resource.TestCheckResourceAttr("snowflake_schema.test", "from_clone.0.before.#", "0"),
+					func(_ *terraform.State) error {
+						_, err := acc.TestClient().Table.Show(t, clonedTableId)
+						return err
+					},
+					// the comment and the parameters inherited from the source schema are unset
+					resource.TestCheckResourceAttr("snowflake_schema.test", "comment", ""),
+					func(_ *terraform.State) error {
+						parameter := acchelpers.FindParameter(t, acc.TestClient().Parameter.ShowSchemaParameters(t, id), sdk.ObjectParameterMaxDataExtensionTimeInDays)
+						if parameter.Level == sdk.ParameterTypeSchema {
+							return fmt.Errorf("expected %s not to be set on the schema level", sdk.ObjectParameterMaxDataExtensionTimeInDays)
+						}
+						return nil
+					},
+				),
+			},
+			{
+				Config: schemaFromCloneConfig(id, sourceSchema.ID()),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
+func schemaFromCloneConfig(id sdk.DatabaseObjectIdentifier, sourceId sdk.DatabaseObjectIdentifier) string {
+	return fmt.Sprintf(`
+resource "snowflake_schema" "test" {
+	database = "%[1]s"
+	name     = "%[2]s"
+
+	from_clone {
+		source = %[3]s
+	}
+}
+`, id.DatabaseName(), id.Name(), strconv.Quote(sourceId.FullyQualifiedName()))
+}
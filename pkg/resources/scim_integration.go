@@ -46,9 +46,10 @@ var scimIntegrationSchema = map[string]*schema.Schema{
 		Required: true,
 		ForceNew: true,
 		Description: fmt.Sprintf("Specify the SCIM role in Snowflake that owns any users and roles that are imported from the identity provider into Snowflake using SCIM."+
-			" Provider assumes that the specified role is already provided. Valid options are: %v.", possibleValuesListed(sdk.AllScimSecurityIntegrationRunAsRoles)),
-		ValidateDiagFunc: sdkValidation(sdk.ToScimSecurityIntegrationRunAsRoleOption),
-		DiffSuppressFunc: NormalizeAndCompare(sdk.ToScimSecurityIntegrationRunAsRoleOption),
+			" Provider assumes that the specified role is already provided. Valid options are: %v. For `scim_client = \"%s\"`, a custom role can be specified instead.",
+			possibleValuesListed(sdk.AllScimSecurityIntegrationRunAsRoles), sdk.ScimSecurityIntegrationScimClientGeneric),
+		ValidateDiagFunc: IsValidIdentifier[sdk.AccountObjectIdentifier](),
+		DiffSuppressFunc: SuppressIfAny(NormalizeAndCompare(sdk.ToScimSecurityIntegrationRunAsRoleOption), suppressIdentifierQuoting),
 	},
 	"network_policy": {
 		Type:             schema.TypeString,
@@ -103,6 +104,7 @@ func SCIMIntegration() *schema.Resource {
 		},
 
 		CustomizeDiff: TrackingCustomDiffWrapper(resources.ScimSecurityIntegration, customdiff.All(
+			validateScimIntegrationRunAsRole,
 			ComputedIfAnyAttributeChanged(scimIntegrationSchema, ShowOutputAttributeName, "enabled", "scim_client", "comment"),
 			ComputedIfAnyAttributeChanged(scimIntegrationSchema, DescribeOutputAttributeName, "enabled", "comment", "network_policy", "run_as_role", "sync_password"),
 		)),
@@ -126,6 +128,19 @@ func SCIMIntegration() *schema.Resource {
 	}
 }
 
+// validateScimIntegrationRunAsRole allows custom run as roles only for GENERIC scim clients.
+func validateScimIntegrationRunAsRole(_ context.Context, d *schema.ResourceDiff, _ any) error {
+	if !d.NewValueKnown("scim_client") || !d.NewValueKnown("run_as_role") {
+		return nil
+	}
+	scimClient, err := sdk.ToScimSecurityIntegrationScimClientOption(d.Get("scim_client").(string))
+	if err != nil {
+		return err
+	}
+	_, err = sdk.ToScimSecurityIntegrationRunAsRoleOptionForClient(scimClient, d.Get("run_as_role").(string))
+	return err
+}
+
 func ImportScimIntegration(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
 	client := meta.(*provider.Context).Client
 	id, err := sdk.ParseAccountObjectIdentifier(d.Id())
@@ -197,7 +212,7 @@ func CreateContextSCIMIntegration(ctx context.Context, d *schema.ResourceData, m
 		return diag.FromErr(err)
 	}
 
-	runAsRole, err := sdk.ToScimSecurityIntegrationRunAsRoleOption(d.Get("run_as_role").(string))
+	runAsRole, err := sdk.ToScimSecurityIntegrationRunAsRoleOptionForClient(scimClient, d.Get("run_as_role").(string))
 	if err != nil {
 		return diag.FromErr(err)
 	}
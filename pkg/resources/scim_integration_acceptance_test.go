@@ -247,6 +247,46 @@ func TestAcc_ScimIntegration_completeAzure(t *testing.T) {
 	})
 }
 
+func TestAcc_ScimIntegration_genericWithCustomRunAsRole(t *testing.T) {
+	_ = testenvs.GetOrSkipTest(t, testenvs.EnableAcceptance)
+	acc.TestAccPreCheck(t)
+
+	role, roleCleanup := acc.TestClient().Role.CreateRole(t)
+	t.Cleanup(roleCleanup)
+	acc.TestClient().Role.GrantRoleToCurrentRole(t, role.ID())
+
+	id := acc.TestClient().Ids.RandomAccountObjectIdentifier()
+
+	scimModel := model.ScimSecurityIntegration("test", true, id.Name(), role.ID().Name(), string(sdk.ScimSecurityIntegrationScimClientGeneric))
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: acc.CheckDestroy(t, resources.ScimSecurityIntegration),
+		Steps: []resource.TestStep{
+			{
+				Config: accconfig.FromModels(t, scimModel),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(scimModel.ResourceReference(), "name", id.Name()),
+					resource.TestCheckResourceAttr(scimModel.ResourceReference(), "scim_client", "GENERIC"),
+					resource.TestCheckResourceAttr(scimModel.ResourceReference(), "run_as_role", role.ID().Name()),
+					resource.TestCheckResourceAttr(scimModel.ResourceReference(), "describe_output.#", "1"),
+					resource.TestCheckResourceAttr(scimModel.ResourceReference(), "describe_output.0.run_as_role.0.value", role.ID().Name()),
+				),
+			},
+			{
+				Config:            accconfig.FromModels(t, scimModel),
+				ResourceName:      scimModel.ResourceReference(),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAcc_ScimIntegration_InvalidScimClient(t *testing.T) {
 	_ = testenvs.GetOrSkipTest(t, testenvs.EnableAcceptance)
 	acc.TestAccPreCheck(t)
@@ -277,7 +317,7 @@ func TestAcc_ScimIntegration_InvalidRunAsRole(t *testing.T) {
 
 	id := acc.TestClient().Ids.RandomAccountObjectIdentifier()
 
-	scimModelBasic := model.ScimSecurityIntegration("test", false, id.Name(), "invalid", string(sdk.ScimSecurityIntegrationScimClientGeneric))
+	scimModelBasic := model.ScimSecurityIntegration("test", false, id.Name(), "invalid", string(sdk.ScimSecurityIntegrationScimClientOkta))
 
 	resource.Test(t, resource.TestCase{
 		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
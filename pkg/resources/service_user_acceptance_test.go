@@ -20,8 +20,10 @@ import (
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/testenvs"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	tfconfig "github.com/hashicorp/terraform-plugin-testing/config"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 )
 
@@ -539,6 +541,123 @@ func TestAcc_ServiceUser_AllParameters(t *testing.T) {
 	})
 }
 
+func TestAcc_ServiceUser_WorkloadIdentity(t *testing.T) {
+	_ = testenvs.GetOrSkipTest(t, testenvs.EnableAcceptance)
+	acc.TestAccPreCheck(t)
+
+	userId := acc.TestClient().Ids.RandomAccountObjectIdentifier()
+	arn := "arn:aws:iam::123456789012:role/" + random.AlphaN(10)
+	issuer := "https://oidc.example.com"
+	subject := "system:serviceaccount:default:" + random.AlphaLowerN(10)
+
+	userModelNoWorkloadIdentity := model.ServiceUser("w", userId.Name())
+	userModelAws := model.ServiceUser("w", userId.Name()).
+		WithWorkloadIdentityAws(arn)
+	userModelOidc := model.ServiceUser("w", userId.Name()).
+		WithWorkloadIdentityOidc(issuer, subject, "snowflakecomputing.com", "other")
+
+	assertHasWorkloadIdentity := func(expected bool) resource.TestCheckFunc {
+		return func(_ *terraform.State) error {
+			details, err := acc.TestClient().User.Describe(t, userId)
+			if err != nil {
+				return err
+			}
+			if details.HasWorkloadIdentity == nil || details.HasWorkloadIdentity.Value != expected {
+				return fmt.Errorf("expected has workload identity to be %t, got: %v", expected, details.HasWorkloadIdentity)
+			}
+			return nil
+		}
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		CheckDestroy: acc.CheckDestroy(t, resources.ServiceUser),
+		Steps: []resource.TestStep{
+			// create with aws workload identity
+			{
+				Config: config.FromModels(t, userModelAws),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(userModelAws.ResourceReference(), "workload_identity.#", "1"),
+					resource.TestCheckResourceAttr(userModelAws.ResourceReference(), "workload_identity.0.aws.0.arn", arn),
+					assertHasWorkloadIdentity(true),
+				),
+			},
+			// change to oidc workload identity
+			{
+				Config: config.FromModels(t, userModelOidc),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(userModelOidc.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(userModelOidc.ResourceReference(), "workload_identity.#", "1"),
+					resource.TestCheckResourceAttr(userModelOidc.ResourceReference(), "workload_identity.0.aws.#", "0"),
+					resource.TestCheckResourceAttr(userModelOidc.ResourceReference(), "workload_identity.0.oidc.0.issuer", issuer),
+					resource.TestCheckResourceAttr(userModelOidc.ResourceReference(), "workload_identity.0.oidc.0.subject", subject),
+					resource.TestCheckResourceAttr(userModelOidc.ResourceReference(), "workload_identity.0.oidc.0.oidc_audience_list.#", "2"),
+					assertHasWorkloadIdentity(true),
+				),
+			},
+			// external removal is detected
+			{
+				PreConfig: func() {
+					acc.TestClient().User.UnsetWorkloadIdentity(t, userId)
+				},
+				Config: config.FromModels(t, userModelOidc),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(userModelOidc.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(userModelOidc.ResourceReference(), "workload_identity.#", "1"),
+					assertHasWorkloadIdentity(true),
+				),
+			},
+			// remove workload identity
+			{
+				Config: config.FromModels(t, userModelNoWorkloadIdentity),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(userModelNoWorkloadIdentity.ResourceReference(), "workload_identity.#", "0"),
+					assertHasWorkloadIdentity(false),
+				),
+			},
+		},
+	})
+}
+
+func TestAcc_ServiceUser_WorkloadIdentity_Validations(t *testing.T) {
+	_ = testenvs.GetOrSkipTest(t, testenvs.EnableAcceptance)
+	acc.TestAccPreCheck(t)
+
+	userId := acc.TestClient().Ids.RandomAccountObjectIdentifier()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		PreCheck: func() { acc.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: config.FromModels(t, model.ServiceUser("w", userId.Name()).WithWorkloadIdentityValue(
+					tfconfig.ObjectVariable(map[string]tfconfig.Variable{
+						"aws": tfconfig.ObjectVariable(map[string]tfconfig.Variable{"arn": tfconfig.StringVariable("arn")}),
+						"gcp": tfconfig.ObjectVariable(map[string]tfconfig.Variable{"subject": tfconfig.StringVariable("subject")}),
+					}),
+				)),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("only one of `workload_identity.0.aws,workload_identity.0.azure,workload_identity.0.gcp,workload_identity.0.oidc`"),
+			},
+		},
+	})
+}
+
 func TestAcc_ServiceUser_handleExternalTypeChange(t *testing.T) {
 	_ = testenvs.GetOrSkipTest(t, testenvs.EnableAcceptance)
 	acc.TestAccPreCheck(t)
@@ -0,0 +1,358 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/schemas"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var stageCommonSchema = map[string]*schema.Schema{
+	"name": {
+		Type:             schema.TypeString,
+		Required:         true,
+		Description:      blocklistedCharactersFieldDescription("Specifies the identifier for the stage; must be unique for the database and schema in which the stage is created."),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+	},
+	"database": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		Description:      blocklistedCharactersFieldDescription("The database in which to create the stage."),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+	},
+	"schema": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		Description:      blocklistedCharactersFieldDescription("The schema in which to create the stage."),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+	},
+	"file_format": {
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "Specifies the file format for the stage. When not set, Snowflake uses the `CSV` type with the default options. To use custom format options, create a named file format (e.g. with `snowflake_file_format`) and reference it in `format_name`.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"format_name": {
+					Type:             schema.TypeString,
+					Optional:         true,
+					ExactlyOneOf:     []string{"file_format.0.format_name", "file_format.0.type"},
+					Description:      relatedResourceDescription("Fully qualified name of the file format to use for the stage.", resources.FileFormat),
+					ValidateDiagFunc: IsValidIdentifier[sdk.SchemaObjectIdentifier](),
+					DiffSuppressFunc: suppressIdentifierQuoting,
+				},
+				"type": {
+					Type:             schema.TypeString,
+					Optional:         true,
+					ExactlyOneOf:     []string{"file_format.0.format_name", "file_format.0.type"},
+					Description:      fmt.Sprintf("Specifies the type of files for the stage. Valid values are (case-insensitive): %s.", possibleValuesListed(sdk.AllFileFormatTypes)),
+					ValidateDiagFunc: sdkValidation(sdk.ToFileFormatType),
+					DiffSuppressFunc: NormalizeAndCompare(sdk.ToFileFormatType),
+				},
+			},
+		},
+	},
+	"comment": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies a comment for the stage.",
+	},
+	ShowOutputAttributeName: {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Outputs the result of `SHOW STAGES` for the given stage.",
+		Elem: &schema.Resource{
+			Schema: schemas.ShowStageSchema,
+		},
+	},
+	DescribeOutputAttributeName: {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Outputs the result of `DESCRIBE STAGE` for the given stage.",
+		Elem: &schema.Resource{
+			Schema: schemas.DescribeStageSchema,
+		},
+	},
+	FullyQualifiedNameAttributeName: schemas.FullyQualifiedNameSchema,
+}
+
+// stageDirectorySchema returns the schema of the directory block. The external stages additionally support automatic refreshes,
+// and the GCS and Azure stages need a notification integration for them.
+func stageDirectorySchema(withAutoRefresh bool, withNotificationIntegration bool) *schema.Schema {
+	directorySchema := map[string]*schema.Schema{
+		"enable": {
+			Type:        schema.TypeBool,
+			Required:    true,
+			Description: "Specifies whether to add a directory table to the stage.",
+		},
+		"refresh_on_create": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Specifies whether to automatically refresh the directory table metadata once, immediately after the stage is created. Used only during the creation of the stage.",
+		},
+		"refresh_after_apply": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Specifies whether to refresh the directory table metadata with `ALTER STAGE ... REFRESH` after every create or update of the stage. To refresh the metadata without changing the stage, change `refresh_subpath`.",
+		},
+		"refresh_subpath": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Specifies the relative path of the files to refresh when `refresh_after_apply` is set. By default, the metadata of all the files in the stage is refreshed.",
+		},
+	}
+	if withAutoRefresh {
+		directorySchema["auto_refresh"] = &schema.Schema{
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			ForceNew:    true,
+			Description: "Specifies whether Snowflake should enable triggering automatic refreshes of the directory table metadata when new or updated data files are available in the external stage.",
+		}
+	}
+	if withNotificationIntegration {
+		directorySchema["notification_integration"] = &schema.Schema{
+			Type:             schema.TypeString,
+			Optional:         true,
+			ForceNew:         true,
+			Description:      "Specifies the name of the notification integration used to automatically refresh the directory table metadata.",
+			DiffSuppressFunc: suppressIdentifierQuoting,
+		}
+	}
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "Specifies the directory table settings for the stage. For more information, check [directory tables documentation](https://docs.snowflake.com/en/user-guide/data-load-dirtables).",
+		Elem: &schema.Resource{
+			Schema: directorySchema,
+		},
+	}
+}
+
+func ImportStage(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseSchemaObjectIdentifier(d.Id())
+	if err != nil {
+		return nil, err
+	}
+	if _, err := ImportName[sdk.SchemaObjectIdentifier](ctx, d, nil); err != nil {
+		return nil, err
+	}
+
+	properties, err := client.Stages.Describe(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	details, err := sdk.ParseStageDetails(properties)
+	if err != nil {
+		return nil, err
+	}
+	if err := errors.Join(
+		d.Set("file_format", stageFileFormatToSchema(details)),
+		d.Set("directory", stageDirectoryToSchema(d, details)),
+	); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+// readStageCommon reads the stage and its description. The resource is removed from the state when the stage does not exist; in that case, nil details are returned.
+func readStageCommon(ctx context.Context, d *schema.ResourceData, client *sdk.Client, id sdk.SchemaObjectIdentifier, withExternalChangesMarking bool) (*sdk.Stage, *sdk.StageDetails, diag.Diagnostics) {
+	stage, err := client.Stages.ShowByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, sdk.ErrObjectNotFound) {
+			d.SetId("")
+			return nil, nil, diag.Diagnostics{
+				diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  "Failed to query stage. Marking the resource as removed.",
+					Detail:   fmt.Sprintf("Stage id: %s, Err: %s", id.FullyQualifiedName(), err),
+				},
+			}
+		}
+		return nil, nil, diag.FromErr(err)
+	}
+
+	properties, err := client.Stages.Describe(ctx, id)
+	if err != nil {
+		return nil, nil, diag.FromErr(err)
+	}
+	details, err := sdk.ParseStageDetails(properties)
+	if err != nil {
+		return nil, nil, diag.FromErr(err)
+	}
+
+	if withExternalChangesMarking {
+		var fileFormatName, fileFormatType string
+		if details.FileFormatName != nil {
+			fileFormatName = *details.FileFormatName
+		}
+		if details.FileFormatType != nil {
+			fileFormatType = string(*details.FileFormatType)
+		}
+		if err := handleExternalChangesToObjectInFlatDescribe(d,
+			outputMapping{"file_format_name", "file_format", fileFormatName, stageFileFormatToSchema(details), nil},
+			outputMapping{"file_format_type", "file_format", fileFormatType, stageFileFormatToSchema(details), nil},
+			outputMapping{"directory_enable", "directory", details.DirectoryEnable, stageDirectoryToSchema(d, details), nil},
+		); err != nil {
+			return nil, nil, diag.FromErr(err)
+		}
+	}
+
+	if err := errors.Join(
+		d.Set("comment", stage.Comment),
+		d.Set(FullyQualifiedNameAttributeName, id.FullyQualifiedName()),
+		d.Set(ShowOutputAttributeName, []map[string]any{schemas.StageToSchema(stage)}),
+		d.Set(DescribeOutputAttributeName, []map[string]any{schemas.StageDescriptionToSchema(*details)}),
+	); err != nil {
+		return nil, nil, diag.FromErr(err)
+	}
+	return stage, details, nil
+}
+
+// handleStageExternalRead sets the location of the external stage based on its description.
+func handleStageExternalRead(d *schema.ResourceData, details *sdk.StageDetails) error {
+	var storageIntegration string
+	if details.StorageIntegration != nil {
+		storageIntegration = details.StorageIntegration.Name()
+	}
+	return errors.Join(
+		d.Set("url", details.Url),
+		d.Set("storage_integration", storageIntegration),
+	)
+}
+
+// stageFileFormatToSchema returns the file_format block; the default CSV format is represented by an empty block.
+func stageFileFormatToSchema(details *sdk.StageDetails) []any {
+	switch {
+	case details.FileFormatName != nil && *details.FileFormatName != "":
+		return []any{map[string]any{"format_name": *details.FileFormatName}}
+	case details.FileFormatType != nil && *details.FileFormatType != sdk.FileFormatTypeCSV:
+		return []any{map[string]any{"type": string(*details.FileFormatType)}}
+	default:
+		return []any{}
+	}
+}
+
+// stageDirectoryToSchema returns the directory block. The fields that are not returned by DESCRIBE STAGE are kept from the state.
+func stageDirectoryToSchema(d *schema.ResourceData, details *sdk.StageDetails) []any {
+	if !details.DirectoryEnable && len(d.Get("directory").([]any)) == 0 {
+		return []any{}
+	}
+	directory := map[string]any{
+		"enable":              details.DirectoryEnable,
+		"refresh_on_create":   d.Get("directory.0.refresh_on_create").(bool),
+		"refresh_after_apply": d.Get("directory.0.refresh_after_apply").(bool),
+		"refresh_subpath":     d.Get("directory.0.refresh_subpath").(string),
+	}
+	if _, ok := d.GetOk("directory.0.auto_refresh"); ok || details.DirectoryAutoRefresh {
+		directory["auto_refresh"] = details.DirectoryAutoRefresh
+	}
+	if v, ok := d.GetOk("directory.0.notification_integration"); ok {
+		directory["notification_integration"] = v.(string)
+	}
+	return []any{directory}
+}
+
+func stageFileFormatRequest(d *schema.ResourceData) (*sdk.StageFileFormatRequest, error) {
+	if v, ok := d.GetOk("file_format.0.format_name"); ok {
+		formatId, err := sdk.ParseSchemaObjectIdentifier(v.(string))
+		if err != nil {
+			return nil, err
+		}
+		return &sdk.StageFileFormatRequest{FormatName: sdk.String(formatId.FullyQualifiedName())}, nil
+	}
+	if v, ok := d.GetOk("file_format.0.type"); ok {
+		fileFormatType, err := sdk.ToFileFormatType(v.(string))
+		if err != nil {
+			return nil, err
+		}
+		return &sdk.StageFileFormatRequest{Type: &fileFormatType}, nil
+	}
+	return nil, nil
+}
+
+// handleStageFileFormatAndCommentUpdate returns the file format and the comment that should be set on the stage.
+// A removed file format is replaced with the default CSV type, and a removed comment with an empty one.
+func handleStageFileFormatAndCommentUpdate(d *schema.ResourceData) (*sdk.StageFileFormatRequest, *string, error) {
+	var fileFormat *sdk.StageFileFormatRequest
+	var comment *string
+	if d.HasChange("file_format") {
+		request, err := stageFileFormatRequest(d)
+		if err != nil {
+			return nil, nil, err
+		}
+		if request == nil {
+			request = &sdk.StageFileFormatRequest{Type: sdk.Pointer(sdk.FileFormatTypeCSV)}
+		}
+		fileFormat = request
+	}
+	if d.HasChange("comment") {
+		comment = sdk.String(d.Get("comment").(string))
+	}
+	return fileFormat, comment, nil
+}
+
+// handleStageRenameAndDirectoryUpdate renames the stage and enables or disables its directory table. The id after the rename is returned.
+func handleStageRenameAndDirectoryUpdate(ctx context.Context, d *schema.ResourceData, client *sdk.Client, id sdk.SchemaObjectIdentifier) (sdk.SchemaObjectIdentifier, error) {
+	if d.HasChange("name") {
+		newId := sdk.NewSchemaObjectIdentifierInSchema(id.SchemaId(), d.Get("name").(string))
+
+		if err := client.Stages.Alter(ctx, sdk.NewAlterStageRequest(id).WithRenameTo(&newId)); err != nil {
+			return id, fmt.Errorf("error renaming stage %v err = %w", d.Id(), err)
+		}
+
+		d.SetId(helpers.EncodeResourceIdentifier(newId))
+		id = newId
+	}
+
+	if d.HasChange("directory.0.enable") {
+		enable := d.Get("directory.0.enable").(bool)
+		if err := client.Stages.AlterDirectoryTable(ctx, sdk.NewAlterDirectoryTableStageRequest(id).WithSetDirectory(sdk.NewDirectoryTableSetRequest(enable))); err != nil {
+			return id, fmt.Errorf("error updating directory table of stage %v err = %w", id.FullyQualifiedName(), err)
+		}
+	}
+	return id, nil
+}
+
+// handleStageDirectoryRefresh refreshes the directory table metadata when requested with refresh_after_apply.
+func handleStageDirectoryRefresh(ctx context.Context, d *schema.ResourceData, client *sdk.Client, id sdk.SchemaObjectIdentifier) error {
+	if !d.Get("directory.0.enable").(bool) || !d.Get("directory.0.refresh_after_apply").(bool) {
+		return nil
+	}
+	refresh := sdk.NewDirectoryTableRefreshRequest()
+	if v, ok := d.GetOk("directory.0.refresh_subpath"); ok {
+		refresh.WithSubpath(sdk.String(v.(string)))
+	}
+	if err := client.Stages.AlterDirectoryTable(ctx, sdk.NewAlterDirectoryTableStageRequest(id).WithRefresh(refresh)); err != nil {
+		return fmt.Errorf("error refreshing directory table of stage %v err = %w", id.FullyQualifiedName(), err)
+	}
+	return nil
+}
+
+func DeleteStageCommon(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseSchemaObjectIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := client.Stages.Drop(ctx, sdk.NewDropStageRequest(id).WithIfExists(sdk.Bool(true))); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
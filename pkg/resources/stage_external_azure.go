@@ -0,0 +1,234 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var stageExternalAzureSchema = func() map[string]*schema.Schema {
+	stageExternalAzure := map[string]*schema.Schema{
+		"url": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Specifies the URL for the Azure container (e.g. `azure://account.blob.core.windows.net/container/path/`).",
+		},
+		"storage_integration": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			ConflictsWith:    []string{"credentials"},
+			Description:      relatedResourceDescription("Specifies the name of the storage integration used to delegate authentication responsibility to a Snowflake identity.", resources.StorageIntegration),
+			ValidateDiagFunc: IsValidIdentifier[sdk.AccountObjectIdentifier](),
+			DiffSuppressFunc: suppressIdentifierQuoting,
+		},
+		"credentials": {
+			Type:          schema.TypeList,
+			Optional:      true,
+			MaxItems:      1,
+			ConflictsWith: []string{"storage_integration"},
+			Description:   externalChangesNotDetectedFieldDescription("Specifies the Azure credentials for the external stage."),
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"azure_sas_token": {
+						Type:        schema.TypeString,
+						Required:    true,
+						Sensitive:   true,
+						Description: "Specifies the shared access signature (SAS) token for connecting to Azure and accessing the private container.",
+					},
+				},
+			},
+		},
+		"encryption": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Description: externalChangesNotDetectedFieldDescription("Specifies the encryption settings used to decrypt the encrypted files in the container. When removed, the encryption type is set to `NONE`."),
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"type": {
+						Type:             schema.TypeString,
+						Required:         true,
+						Description:      fmt.Sprintf("Specifies the encryption type. Valid values are (case-insensitive): %s.", possibleValuesListed(sdk.AllExternalStageAzureEncryptionOptions)),
+						ValidateDiagFunc: sdkValidation(sdk.ToExternalStageAzureEncryptionOption),
+						DiffSuppressFunc: NormalizeAndCompare(sdk.ToExternalStageAzureEncryptionOption),
+					},
+					"master_key": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Sensitive:   true,
+						Description: "Specifies the client-side master key used to encrypt the files in the container (Base64-encoded). Required for the `AZURE_CSE` type.",
+					},
+				},
+			},
+		},
+		"directory": stageDirectorySchema(true, true),
+	}
+	return collections.MergeMaps(stageCommonSchema, stageExternalAzure)
+}()
+
+func StageExternalAzure() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: PreviewFeatureCreateContextWrapper(string(previewfeatures.StageExternalAzureResource), TrackingCreateWrapper(resources.StageExternalAzure, CreateStageExternalAzure)),
+		ReadContext:   PreviewFeatureReadContextWrapper(string(previewfeatures.StageExternalAzureResource), TrackingReadWrapper(resources.StageExternalAzure, ReadStageExternalAzureFunc(true))),
+		UpdateContext: PreviewFeatureUpdateContextWrapper(string(previewfeatures.StageExternalAzureResource), TrackingUpdateWrapper(resources.StageExternalAzure, UpdateStageExternalAzure)),
+		DeleteContext: PreviewFeatureDeleteContextWrapper(string(previewfeatures.StageExternalAzureResource), TrackingDeleteWrapper(resources.StageExternalAzure, DeleteStageCommon)),
+		Description:   "Resource used to manage external stages pointing to Microsoft Azure containers. For more information, check [stage documentation](https://docs.snowflake.com/en/sql-reference/sql/create-stage).",
+
+		CustomizeDiff: TrackingCustomDiffWrapper(resources.StageExternalAzure, customdiff.All(
+			ComputedIfAnyAttributeChanged(stageExternalAzureSchema, ShowOutputAttributeName, "name", "comment", "url", "storage_integration", "credentials", "encryption", "directory"),
+			ComputedIfAnyAttributeChanged(stageExternalAzureSchema, DescribeOutputAttributeName, "url", "storage_integration", "file_format", "directory"),
+		)),
+
+		Schema: stageExternalAzureSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: TrackingImportWrapper(resources.StageExternalAzure, ImportStage),
+		},
+		Timeouts: defaultTimeouts,
+	}
+}
+
+func stageExternalAzureParamsRequest(d *schema.ResourceData) (*sdk.ExternalAzureStageParamsRequest, error) {
+	params := sdk.NewExternalAzureStageParamsRequest(d.Get("url").(string))
+	if v, ok := d.GetOk("storage_integration"); ok {
+		storageIntegration, err := sdk.ParseAccountObjectIdentifier(v.(string))
+		if err != nil {
+			return nil, err
+		}
+		params.WithStorageIntegration(&storageIntegration)
+	}
+	if v, ok := d.GetOk("credentials.0.azure_sas_token"); ok {
+		params.WithCredentials(sdk.NewExternalStageAzureCredentialsRequest(v.(string)))
+	}
+	// a removed encryption is replaced with the NONE type
+	if _, ok := d.GetOk("encryption"); ok || (!d.IsNewResource() && d.HasChange("encryption")) {
+		encryptionType := sdk.ExternalStageAzureEncryptionNone
+		if v, ok := d.GetOk("encryption.0.type"); ok {
+			var err error
+			if encryptionType, err = sdk.ToExternalStageAzureEncryptionOption(v.(string)); err != nil {
+				return nil, err
+			}
+		}
+		encryption := sdk.NewExternalStageAzureEncryptionRequest(&encryptionType)
+		if v, ok := d.GetOk("encryption.0.master_key"); ok {
+			encryption.WithMasterKey(sdk.String(v.(string)))
+		}
+		params.WithEncryption(encryption)
+	}
+	return params, nil
+}
+
+func CreateStageExternalAzure(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	databaseName, schemaName, name := d.Get("database").(string), d.Get("schema").(string), d.Get("name").(string)
+	id := sdk.NewSchemaObjectIdentifier(databaseName, schemaName, name)
+
+	params, err := stageExternalAzureParamsRequest(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	request := sdk.NewCreateOnAzureStageRequest(id).WithExternalStageParams(params)
+	if _, ok := d.GetOk("directory"); ok {
+		directory := sdk.NewExternalAzureDirectoryTableOptionsRequest().
+			WithEnable(sdk.Bool(d.Get("directory.0.enable").(bool))).
+			WithAutoRefresh(sdk.Bool(d.Get("directory.0.auto_refresh").(bool)))
+		if d.Get("directory.0.refresh_on_create").(bool) {
+			directory.WithRefreshOnCreate(sdk.Bool(true))
+		}
+		if v, ok := d.GetOk("directory.0.notification_integration"); ok {
+			directory.WithNotificationIntegration(sdk.String(v.(string)))
+		}
+		request.WithDirectoryTableOptions(directory)
+	}
+	fileFormat, err := stageFileFormatRequest(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	request.WithFileFormat(fileFormat)
+	if v, ok := d.GetOk("comment"); ok {
+		request.WithComment(sdk.String(v.(string)))
+	}
+
+	if err := client.Stages.CreateOnAzure(ctx, request); err != nil {
+		return diag.FromErr(fmt.Errorf("error creating external Azure stage %v err = %w", id.FullyQualifiedName(), err))
+	}
+
+	d.SetId(helpers.EncodeResourceIdentifier(id))
+
+	if err := handleStageDirectoryRefresh(ctx, d, client, id); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return ReadStageExternalAzureFunc(false)(ctx, d, meta)
+}
+
+func ReadStageExternalAzureFunc(withExternalChangesMarking bool) schema.ReadContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+		client := meta.(*provider.Context).Client
+		id, err := sdk.ParseSchemaObjectIdentifier(d.Id())
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		_, details, diags := readStageCommon(ctx, d, client, id, withExternalChangesMarking)
+		if diags != nil {
+			return diags
+		}
+		if err := handleStageExternalRead(d, details); err != nil {
+			return diag.FromErr(err)
+		}
+		return nil
+	}
+}
+
+func UpdateStageExternalAzure(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseSchemaObjectIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id, err = handleStageRenameAndDirectoryUpdate(ctx, d, client, id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	request := sdk.NewAlterExternalAzureStageStageRequest(id)
+	var runAlter bool
+	// the location has to be set as a whole, because the URL is always a part of the external stage parameters
+	if d.HasChanges("url", "storage_integration", "credentials", "encryption") {
+		params, err := stageExternalAzureParamsRequest(d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		request.WithExternalStageParams(params)
+		runAlter = true
+	}
+	fileFormat, comment, err := handleStageFileFormatAndCommentUpdate(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if fileFormat != nil || comment != nil {
+		request.WithFileFormat(fileFormat).WithComment(comment)
+		runAlter = true
+	}
+	if runAlter {
+		if err := client.Stages.AlterExternalAzureStage(ctx, request); err != nil {
+			return diag.FromErr(fmt.Errorf("error updating external Azure stage %v err = %w", id.FullyQualifiedName(), err))
+		}
+	}
+
+	if err := handleStageDirectoryRefresh(ctx, d, client, id); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return ReadStageExternalAzureFunc(false)(ctx, d, meta)
+}
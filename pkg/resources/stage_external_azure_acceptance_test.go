@@ -0,0 +1,83 @@
+package resources_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/helpers/random"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/testenvs"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_StageExternalAzure_BasicFlow(t *testing.T) {
+	_ = testenvs.GetOrSkipTest(t, testenvs.EnableAcceptance)
+	acc.TestAccPreCheck(t)
+
+	azureBucketUrl := testenvs.GetOrSkipTest(t, testenvs.AzureExternalBucketUrl)
+	azureSasToken := testenvs.GetOrSkipTest(t, testenvs.AzureExternalSasToken)
+
+	id := acc.TestClient().Ids.RandomSchemaObjectIdentifier()
+	comment := random.Comment()
+	resourceReference := "snowflake_stage_external_azure.test"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: acc.CheckDestroy(t, resources.StageExternalAzure),
+		Steps: []resource.TestStep{
+			{
+				Config: stageExternalAzureConfig(id, azureBucketUrl, azureSasToken, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceReference, "name", id.Name()),
+					resource.TestCheckResourceAttr(resourceReference, "url", azureBucketUrl),
+					resource.TestCheckResourceAttr(resourceReference, "credentials.0.azure_sas_token", azureSasToken),
+					resource.TestCheckResourceAttr(resourceReference, "show_output.0.cloud", "AZURE"),
+					resource.TestCheckResourceAttr(resourceReference, "describe_output.0.url", azureBucketUrl),
+				),
+			},
+			// import; credentials are not returned by Snowflake
+			{
+				ResourceName:            resourceReference,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"credentials"},
+			},
+			{
+				Config: stageExternalAzureConfig(id, azureBucketUrl, azureSasToken, comment),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceReference, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceReference, "comment", comment),
+					resource.TestCheckResourceAttr(resourceReference, "show_output.0.comment", comment),
+				),
+			},
+		},
+	})
+}
+
+func stageExternalAzureConfig(id sdk.SchemaObjectIdentifier, url string, sasToken string, comment string) string {
+	return fmt.Sprintf(`
+resource "snowflake_stage_external_azure" "test" {
+  database = "%[1]s"
+  schema   = "%[2]s"
+  name     = "%[3]s"
+  url      = "%[4]s"
+  comment  = "%[6]s"
+
+  credentials {
+    azure_sas_token = "%[5]s"
+  }
+}
+`, id.DatabaseName(), id.SchemaName(), id.Name(), url, sasToken, comment)
+}
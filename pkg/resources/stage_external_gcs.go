@@ -0,0 +1,212 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var stageExternalGcsSchema = func() map[string]*schema.Schema {
+	stageExternalGcs := map[string]*schema.Schema{
+		"url": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Specifies the URL for the Google Cloud Storage bucket (e.g. `gcs://bucket/path/`).",
+		},
+		"storage_integration": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			Description:      relatedResourceDescription("Specifies the name of the storage integration used to delegate authentication responsibility to a Snowflake identity. Required for private buckets.", resources.StorageIntegration),
+			ValidateDiagFunc: IsValidIdentifier[sdk.AccountObjectIdentifier](),
+			DiffSuppressFunc: suppressIdentifierQuoting,
+		},
+		"encryption": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Description: externalChangesNotDetectedFieldDescription("Specifies the encryption settings used to decrypt the encrypted files in the bucket. When removed, the encryption type is set to `NONE`."),
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"type": {
+						Type:             schema.TypeString,
+						Required:         true,
+						Description:      fmt.Sprintf("Specifies the encryption type. Valid values are (case-insensitive): %s.", possibleValuesListed(sdk.AllExternalStageGCSEncryptionOptions)),
+						ValidateDiagFunc: sdkValidation(sdk.ToExternalStageGCSEncryptionOption),
+						DiffSuppressFunc: NormalizeAndCompare(sdk.ToExternalStageGCSEncryptionOption),
+					},
+					"kms_key_id": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Description: "Specifies the ID for the Cloud KMS-managed key used to encrypt the files unloaded into the bucket. Can be used with the `GCS_SSE_KMS` type.",
+					},
+				},
+			},
+		},
+		"directory": stageDirectorySchema(true, true),
+	}
+	return collections.MergeMaps(stageCommonSchema, stageExternalGcs)
+}()
+
+func StageExternalGcs() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: PreviewFeatureCreateContextWrapper(string(previewfeatures.StageExternalGcsResource), TrackingCreateWrapper(resources.StageExternalGcs, CreateStageExternalGcs)),
+		ReadContext:   PreviewFeatureReadContextWrapper(string(previewfeatures.StageExternalGcsResource), TrackingReadWrapper(resources.StageExternalGcs, ReadStageExternalGcsFunc(true))),
+		UpdateContext: PreviewFeatureUpdateContextWrapper(string(previewfeatures.StageExternalGcsResource), TrackingUpdateWrapper(resources.StageExternalGcs, UpdateStageExternalGcs)),
+		DeleteContext: PreviewFeatureDeleteContextWrapper(string(previewfeatures.StageExternalGcsResource), TrackingDeleteWrapper(resources.StageExternalGcs, DeleteStageCommon)),
+		Description:   "Resource used to manage external stages pointing to Google Cloud Storage buckets. For more information, check [stage documentation](https://docs.snowflake.com/en/sql-reference/sql/create-stage).",
+
+		CustomizeDiff: TrackingCustomDiffWrapper(resources.StageExternalGcs, customdiff.All(
+			ComputedIfAnyAttributeChanged(stageExternalGcsSchema, ShowOutputAttributeName, "name", "comment", "url", "storage_integration", "encryption", "directory"),
+			ComputedIfAnyAttributeChanged(stageExternalGcsSchema, DescribeOutputAttributeName, "url", "storage_integration", "file_format", "directory"),
+		)),
+
+		Schema: stageExternalGcsSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: TrackingImportWrapper(resources.StageExternalGcs, ImportStage),
+		},
+		Timeouts: defaultTimeouts,
+	}
+}
+
+func stageExternalGcsParamsRequest(d *schema.ResourceData) (*sdk.ExternalGCSStageParamsRequest, error) {
+	params := sdk.NewExternalGCSStageParamsRequest(d.Get("url").(string))
+	if v, ok := d.GetOk("storage_integration"); ok {
+		storageIntegration, err := sdk.ParseAccountObjectIdentifier(v.(string))
+		if err != nil {
+			return nil, err
+		}
+		params.WithStorageIntegration(&storageIntegration)
+	}
+	// a removed encryption is replaced with the NONE type
+	if _, ok := d.GetOk("encryption"); ok || (!d.IsNewResource() && d.HasChange("encryption")) {
+		encryptionType := sdk.ExternalStageGCSEncryptionNone
+		if v, ok := d.GetOk("encryption.0.type"); ok {
+			var err error
+			if encryptionType, err = sdk.ToExternalStageGCSEncryptionOption(v.(string)); err != nil {
+				return nil, err
+			}
+		}
+		encryption := sdk.NewExternalStageGCSEncryptionRequest(&encryptionType)
+		if v, ok := d.GetOk("encryption.0.kms_key_id"); ok {
+			encryption.WithKmsKeyId(sdk.String(v.(string)))
+		}
+		params.WithEncryption(encryption)
+	}
+	return params, nil
+}
+
+func CreateStageExternalGcs(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	databaseName, schemaName, name := d.Get("database").(string), d.Get("schema").(string), d.Get("name").(string)
+	id := sdk.NewSchemaObjectIdentifier(databaseName, schemaName, name)
+
+	params, err := stageExternalGcsParamsRequest(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	request := sdk.NewCreateOnGCSStageRequest(id).WithExternalStageParams(params)
+	if _, ok := d.GetOk("directory"); ok {
+		directory := sdk.NewExternalGCSDirectoryTableOptionsRequest().
+			WithEnable(sdk.Bool(d.Get("directory.0.enable").(bool))).
+			WithAutoRefresh(sdk.Bool(d.Get("directory.0.auto_refresh").(bool)))
+		if d.Get("directory.0.refresh_on_create").(bool) {
+			directory.WithRefreshOnCreate(sdk.Bool(true))
+		}
+		if v, ok := d.GetOk("directory.0.notification_integration"); ok {
+			directory.WithNotificationIntegration(sdk.String(v.(string)))
+		}
+		request.WithDirectoryTableOptions(directory)
+	}
+	fileFormat, err := stageFileFormatRequest(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	request.WithFileFormat(fileFormat)
+	if v, ok := d.GetOk("comment"); ok {
+		request.WithComment(sdk.String(v.(string)))
+	}
+
+	if err := client.Stages.CreateOnGCS(ctx, request); err != nil {
+		return diag.FromErr(fmt.Errorf("error creating external GCS stage %v err = %w", id.FullyQualifiedName(), err))
+	}
+
+	d.SetId(helpers.EncodeResourceIdentifier(id))
+
+	if err := handleStageDirectoryRefresh(ctx, d, client, id); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return ReadStageExternalGcsFunc(false)(ctx, d, meta)
+}
+
+func ReadStageExternalGcsFunc(withExternalChangesMarking bool) schema.ReadContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+		client := meta.(*provider.Context).Client
+		id, err := sdk.ParseSchemaObjectIdentifier(d.Id())
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		_, details, diags := readStageCommon(ctx, d, client, id, withExternalChangesMarking)
+		if diags != nil {
+			return diags
+		}
+		if err := handleStageExternalRead(d, details); err != nil {
+			return diag.FromErr(err)
+		}
+		return nil
+	}
+}
+
+func UpdateStageExternalGcs(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseSchemaObjectIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id, err = handleStageRenameAndDirectoryUpdate(ctx, d, client, id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	request := sdk.NewAlterExternalGCSStageStageRequest(id)
+	var runAlter bool
+	// the location has to be set as a whole, because the URL is always a part of the external stage parameters
+	if d.HasChanges("url", "storage_integration", "encryption") {
+		params, err := stageExternalGcsParamsRequest(d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		request.WithExternalStageParams(params)
+		runAlter = true
+	}
+	fileFormat, comment, err := handleStageFileFormatAndCommentUpdate(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if fileFormat != nil || comment != nil {
+		request.WithFileFormat(fileFormat).WithComment(comment)
+		runAlter = true
+	}
+	if runAlter {
+		if err := client.Stages.AlterExternalGCSStage(ctx, request); err != nil {
+			return diag.FromErr(fmt.Errorf("error updating external GCS stage %v err = %w", id.FullyQualifiedName(), err))
+		}
+	}
+
+	if err := handleStageDirectoryRefresh(ctx, d, client, id); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return ReadStageExternalGcsFunc(false)(ctx, d, meta)
+}
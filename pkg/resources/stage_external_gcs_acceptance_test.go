@@ -0,0 +1,85 @@
+package resources_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/helpers/random"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/testenvs"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_StageExternalGcs_BasicFlow(t *testing.T) {
+	_ = testenvs.GetOrSkipTest(t, testenvs.EnableAcceptance)
+	acc.TestAccPreCheck(t)
+
+	gcsBucketUrl := testenvs.GetOrSkipTest(t, testenvs.GcsExternalBucketUrl)
+
+	storageIntegrationId := acc.TestClient().Ids.RandomAccountObjectIdentifier()
+	id := acc.TestClient().Ids.RandomSchemaObjectIdentifier()
+	comment := random.Comment()
+	resourceReference := "snowflake_stage_external_gcs.test"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: acc.CheckDestroy(t, resources.StageExternalGcs),
+		Steps: []resource.TestStep{
+			{
+				Config: stageExternalGcsConfig(id, storageIntegrationId, gcsBucketUrl, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceReference, "name", id.Name()),
+					resource.TestCheckResourceAttr(resourceReference, "url", gcsBucketUrl),
+					resource.TestCheckResourceAttr(resourceReference, "storage_integration", storageIntegrationId.Name()),
+					resource.TestCheckResourceAttr(resourceReference, "show_output.0.cloud", "GCP"),
+					resource.TestCheckResourceAttr(resourceReference, "describe_output.0.url", gcsBucketUrl),
+					resource.TestCheckResourceAttr(resourceReference, "describe_output.0.storage_integration", storageIntegrationId.Name()),
+				),
+			},
+			{
+				ResourceName:      resourceReference,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: stageExternalGcsConfig(id, storageIntegrationId, gcsBucketUrl, comment),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceReference, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceReference, "comment", comment),
+					resource.TestCheckResourceAttr(resourceReference, "show_output.0.comment", comment),
+				),
+			},
+		},
+	})
+}
+
+func stageExternalGcsConfig(id sdk.SchemaObjectIdentifier, storageIntegrationId sdk.AccountObjectIdentifier, url string, comment string) string {
+	return fmt.Sprintf(`
+resource "snowflake_storage_integration" "test" {
+  name                      = "%[4]s"
+  storage_allowed_locations = ["%[5]s"]
+  storage_provider          = "GCS"
+}
+
+resource "snowflake_stage_external_gcs" "test" {
+  database            = "%[1]s"
+  schema              = "%[2]s"
+  name                = "%[3]s"
+  url                 = "%[5]s"
+  storage_integration = snowflake_storage_integration.test.name
+  comment             = "%[6]s"
+}
+`, id.DatabaseName(), id.SchemaName(), id.Name(), storageIntegrationId.Name(), url, comment)
+}
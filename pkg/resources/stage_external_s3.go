@@ -0,0 +1,274 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var stageExternalS3Schema = func() map[string]*schema.Schema {
+	stageExternalS3 := map[string]*schema.Schema{
+		"url": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Specifies the URL for the S3 bucket (e.g. `s3://bucket/path/`).",
+		},
+		"storage_integration": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			ConflictsWith:    []string{"credentials"},
+			Description:      relatedResourceDescription("Specifies the name of the storage integration used to delegate authentication responsibility to a Snowflake identity.", resources.StorageIntegration),
+			ValidateDiagFunc: IsValidIdentifier[sdk.AccountObjectIdentifier](),
+			DiffSuppressFunc: suppressIdentifierQuoting,
+		},
+		"credentials": {
+			Type:          schema.TypeList,
+			Optional:      true,
+			MaxItems:      1,
+			ConflictsWith: []string{"storage_integration"},
+			Description:   externalChangesNotDetectedFieldDescription("Specifies the AWS credentials for the external stage. Use either the AWS key id with the secret key (and optionally the session token), or the AWS role."),
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"aws_key_id": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						Sensitive:    true,
+						ExactlyOneOf: []string{"credentials.0.aws_key_id", "credentials.0.aws_role"},
+						RequiredWith: []string{"credentials.0.aws_secret_key"},
+						Description:  "Specifies the ID of the AWS access key.",
+					},
+					"aws_secret_key": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						Sensitive:    true,
+						RequiredWith: []string{"credentials.0.aws_key_id"},
+						Description:  "Specifies the AWS secret access key.",
+					},
+					"aws_token": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						Sensitive:    true,
+						RequiredWith: []string{"credentials.0.aws_key_id"},
+						Description:  "Specifies the AWS session token for temporary credentials.",
+					},
+					"aws_role": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						ExactlyOneOf: []string{"credentials.0.aws_key_id", "credentials.0.aws_role"},
+						Description:  "Specifies the AWS role ARN used to access the bucket.",
+					},
+				},
+			},
+		},
+		"encryption": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Description: externalChangesNotDetectedFieldDescription("Specifies the encryption settings used to decrypt the encrypted files in the S3 bucket. When removed, the encryption type is set to `NONE`."),
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"type": {
+						Type:             schema.TypeString,
+						Required:         true,
+						Description:      fmt.Sprintf("Specifies the encryption type. Valid values are (case-insensitive): %s.", possibleValuesListed(sdk.AllExternalStageS3EncryptionOptions)),
+						ValidateDiagFunc: sdkValidation(sdk.ToExternalStageS3EncryptionOption),
+						DiffSuppressFunc: NormalizeAndCompare(sdk.ToExternalStageS3EncryptionOption),
+					},
+					"master_key": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Sensitive:   true,
+						Description: "Specifies the client-side master key used to encrypt the files in the bucket (Base64-encoded). Required for the `AWS_CSE` type.",
+					},
+					"kms_key_id": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Description: "Specifies the ID for the AWS KMS-managed key used to encrypt the files unloaded into the bucket. Can be used with the `AWS_SSE_KMS` type.",
+					},
+				},
+			},
+		},
+		"directory": stageDirectorySchema(true, false),
+	}
+	return collections.MergeMaps(stageCommonSchema, stageExternalS3)
+}()
+
+func StageExternalS3() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: PreviewFeatureCreateContextWrapper(string(previewfeatures.StageExternalS3Resource), TrackingCreateWrapper(resources.StageExternalS3, CreateStageExternalS3)),
+		ReadContext:   PreviewFeatureReadContextWrapper(string(previewfeatures.StageExternalS3Resource), TrackingReadWrapper(resources.StageExternalS3, ReadStageExternalS3Func(true))),
+		UpdateContext: PreviewFeatureUpdateContextWrapper(string(previewfeatures.StageExternalS3Resource), TrackingUpdateWrapper(resources.StageExternalS3, UpdateStageExternalS3)),
+		DeleteContext: PreviewFeatureDeleteContextWrapper(string(previewfeatures.StageExternalS3Resource), TrackingDeleteWrapper(resources.StageExternalS3, DeleteStageCommon)),
+		Description:   "Resource used to manage external stages pointing to Amazon S3 buckets. For more information, check [stage documentation](https://docs.snowflake.com/en/sql-reference/sql/create-stage).",
+
+		CustomizeDiff: TrackingCustomDiffWrapper(resources.StageExternalS3, customdiff.All(
+			ComputedIfAnyAttributeChanged(stageExternalS3Schema, ShowOutputAttributeName, "name", "comment", "url", "storage_integration", "credentials", "encryption", "directory"),
+			ComputedIfAnyAttributeChanged(stageExternalS3Schema, DescribeOutputAttributeName, "url", "storage_integration", "file_format", "directory"),
+		)),
+
+		Schema: stageExternalS3Schema,
+		Importer: &schema.ResourceImporter{
+			StateContext: TrackingImportWrapper(resources.StageExternalS3, ImportStage),
+		},
+		Timeouts: defaultTimeouts,
+	}
+}
+
+func stageExternalS3ParamsRequest(d *schema.ResourceData) (*sdk.ExternalS3StageParamsRequest, error) {
+	params := sdk.NewExternalS3StageParamsRequest(d.Get("url").(string))
+	if v, ok := d.GetOk("storage_integration"); ok {
+		storageIntegration, err := sdk.ParseAccountObjectIdentifier(v.(string))
+		if err != nil {
+			return nil, err
+		}
+		params.WithStorageIntegration(&storageIntegration)
+	}
+	if _, ok := d.GetOk("credentials"); ok {
+		credentials := sdk.NewExternalStageS3CredentialsRequest()
+		if v, ok := d.GetOk("credentials.0.aws_key_id"); ok {
+			credentials.WithAwsKeyId(sdk.String(v.(string)))
+		}
+		if v, ok := d.GetOk("credentials.0.aws_secret_key"); ok {
+			credentials.WithAwsSecretKey(sdk.String(v.(string)))
+		}
+		if v, ok := d.GetOk("credentials.0.aws_token"); ok {
+			credentials.WithAwsToken(sdk.String(v.(string)))
+		}
+		if v, ok := d.GetOk("credentials.0.aws_role"); ok {
+			credentials.WithAwsRole(sdk.String(v.(string)))
+		}
+		params.WithCredentials(credentials)
+	}
+	// a removed encryption is replaced with the NONE type
+	if _, ok := d.GetOk("encryption"); ok || (!d.IsNewResource() && d.HasChange("encryption")) {
+		encryptionType := sdk.ExternalStageS3EncryptionNone
+		if v, ok := d.GetOk("encryption.0.type"); ok {
+			var err error
+			if encryptionType, err = sdk.ToExternalStageS3EncryptionOption(v.(string)); err != nil {
+				return nil, err
+			}
+		}
+		encryption := sdk.NewExternalStageS3EncryptionRequest(&encryptionType)
+		if v, ok := d.GetOk("encryption.0.master_key"); ok {
+			encryption.WithMasterKey(sdk.String(v.(string)))
+		}
+		if v, ok := d.GetOk("encryption.0.kms_key_id"); ok {
+			encryption.WithKmsKeyId(sdk.String(v.(string)))
+		}
+		params.WithEncryption(encryption)
+	}
+	return params, nil
+}
+
+func CreateStageExternalS3(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	databaseName, schemaName, name := d.Get("database").(string), d.Get("schema").(string), d.Get("name").(string)
+	id := sdk.NewSchemaObjectIdentifier(databaseName, schemaName, name)
+
+	params, err := stageExternalS3ParamsRequest(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	request := sdk.NewCreateOnS3StageRequest(id).WithExternalStageParams(params)
+	if _, ok := d.GetOk("directory"); ok {
+		directory := sdk.NewExternalS3DirectoryTableOptionsRequest().
+			WithEnable(sdk.Bool(d.Get("directory.0.enable").(bool))).
+			WithAutoRefresh(sdk.Bool(d.Get("directory.0.auto_refresh").(bool)))
+		if d.Get("directory.0.refresh_on_create").(bool) {
+			directory.WithRefreshOnCreate(sdk.Bool(true))
+		}
+		request.WithDirectoryTableOptions(directory)
+	}
+	fileFormat, err := stageFileFormatRequest(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	request.WithFileFormat(fileFormat)
+	if v, ok := d.GetOk("comment"); ok {
+		request.WithComment(sdk.String(v.(string)))
+	}
+
+	if err := client.Stages.CreateOnS3(ctx, request); err != nil {
+		return diag.FromErr(fmt.Errorf("error creating external S3 stage %v err = %w", id.FullyQualifiedName(), err))
+	}
+
+	d.SetId(helpers.EncodeResourceIdentifier(id))
+
+	if err := handleStageDirectoryRefresh(ctx, d, client, id); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return ReadStageExternalS3Func(false)(ctx, d, meta)
+}
+
+func ReadStageExternalS3Func(withExternalChangesMarking bool) schema.ReadContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+		client := meta.(*provider.Context).Client
+		id, err := sdk.ParseSchemaObjectIdentifier(d.Id())
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		_, details, diags := readStageCommon(ctx, d, client, id, withExternalChangesMarking)
+		if diags != nil {
+			return diags
+		}
+		if err := handleStageExternalRead(d, details); err != nil {
+			return diag.FromErr(err)
+		}
+		return nil
+	}
+}
+
+func UpdateStageExternalS3(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseSchemaObjectIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id, err = handleStageRenameAndDirectoryUpdate(ctx, d, client, id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	request := sdk.NewAlterExternalS3StageStageRequest(id)
+	var runAlter bool
+	// the location has to be set as a whole, because the URL is always a part of the external stage parameters
+	if d.HasChanges("url", "storage_integration", "credentials", "encryption") {
+		params, err := stageExternalS3ParamsRequest(d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		request.WithExternalStageParams(params)
+		runAlter = true
+	}
+	fileFormat, comment, err := handleStageFileFormatAndCommentUpdate(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if fileFormat != nil || comment != nil {
+		request.WithFileFormat(fileFormat).WithComment(comment)
+		runAlter = true
+	}
+	if runAlter {
+		if err := client.Stages.AlterExternalS3Stage(ctx, request); err != nil {
+			return diag.FromErr(fmt.Errorf("error updating external S3 stage %v err = %w", id.FullyQualifiedName(), err))
+		}
+	}
+
+	if err := handleStageDirectoryRefresh(ctx, d, client, id); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return ReadStageExternalS3Func(false)(ctx, d, meta)
+}
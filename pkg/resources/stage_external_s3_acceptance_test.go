@@ -0,0 +1,151 @@
+package resources_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/helpers/random"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/testenvs"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_StageExternalS3_BasicFlow(t *testing.T) {
+	_ = testenvs.GetOrSkipTest(t, testenvs.EnableAcceptance)
+	acc.TestAccPreCheck(t)
+
+	id := acc.TestClient().Ids.RandomSchemaObjectIdentifier()
+	comment := random.Comment()
+	url := "s3://com.example.bucket/prefix/"
+	newUrl := "s3://com.example.bucket/other/"
+	resourceReference := "snowflake_stage_external_s3.test"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: acc.CheckDestroy(t, resources.StageExternalS3),
+		Steps: []resource.TestStep{
+			{
+				Config: stageExternalS3Config(id, url, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceReference, "name", id.Name()),
+					resource.TestCheckResourceAttr(resourceReference, "fully_qualified_name", id.FullyQualifiedName()),
+					resource.TestCheckResourceAttr(resourceReference, "url", url),
+					resource.TestCheckResourceAttr(resourceReference, "comment", ""),
+					resource.TestCheckResourceAttr(resourceReference, "show_output.0.type", "EXTERNAL"),
+					resource.TestCheckResourceAttr(resourceReference, "show_output.0.cloud", "AWS"),
+					resource.TestCheckResourceAttr(resourceReference, "describe_output.0.url", url),
+				),
+			},
+			// import
+			{
+				ResourceName:      resourceReference,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// change url and comment in place
+			{
+				Config: stageExternalS3Config(id, newUrl, comment),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceReference, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceReference, "url", newUrl),
+					resource.TestCheckResourceAttr(resourceReference, "comment", comment),
+					resource.TestCheckResourceAttr(resourceReference, "describe_output.0.url", newUrl),
+				),
+			},
+		},
+	})
+}
+
+func TestAcc_StageExternalS3_Credentials(t *testing.T) {
+	_ = testenvs.GetOrSkipTest(t, testenvs.EnableAcceptance)
+	acc.TestAccPreCheck(t)
+
+	awsBucketUrl := testenvs.GetOrSkipTest(t, testenvs.AwsExternalBucketUrl)
+	awsKeyId := testenvs.GetOrSkipTest(t, testenvs.AwsExternalKeyId)
+	awsSecretKey := testenvs.GetOrSkipTest(t, testenvs.AwsExternalSecretKey)
+
+	id := acc.TestClient().Ids.RandomSchemaObjectIdentifier()
+	resourceReference := "snowflake_stage_external_s3.test"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: acc.CheckDestroy(t, resources.StageExternalS3),
+		Steps: []resource.TestStep{
+			{
+				Config: stageExternalS3WithCredentialsConfig(id, awsBucketUrl, awsKeyId, awsSecretKey),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceReference, "url", awsBucketUrl),
+					resource.TestCheckResourceAttr(resourceReference, "credentials.0.aws_key_id", awsKeyId),
+					resource.TestCheckResourceAttr(resourceReference, "encryption.0.type", string(sdk.ExternalStageS3EncryptionSSES3)),
+					resource.TestCheckResourceAttr(resourceReference, "directory.0.enable", "true"),
+					resource.TestCheckResourceAttr(resourceReference, "file_format.0.type", string(sdk.FileFormatTypeJSON)),
+					resource.TestCheckResourceAttr(resourceReference, "describe_output.0.directory_enable", "true"),
+					resource.TestCheckResourceAttr(resourceReference, "describe_output.0.file_format_type", string(sdk.FileFormatTypeJSON)),
+				),
+			},
+			// import; credentials and encryption are not returned by Snowflake
+			{
+				ResourceName:            resourceReference,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"credentials", "encryption"},
+			},
+		},
+	})
+}
+
+func stageExternalS3Config(id sdk.SchemaObjectIdentifier, url string, comment string) string {
+	return fmt.Sprintf(`
+resource "snowflake_stage_external_s3" "test" {
+  database = "%[1]s"
+  schema   = "%[2]s"
+  name     = "%[3]s"
+  url      = "%[4]s"
+  comment  = "%[5]s"
+}
+`, id.DatabaseName(), id.SchemaName(), id.Name(), url, comment)
+}
+
+func stageExternalS3WithCredentialsConfig(id sdk.SchemaObjectIdentifier, url string, awsKeyId string, awsSecretKey string) string {
+	return fmt.Sprintf(`
+resource "snowflake_stage_external_s3" "test" {
+  database = "%[1]s"
+  schema   = "%[2]s"
+  name     = "%[3]s"
+  url      = "%[4]s"
+
+  credentials {
+    aws_key_id     = "%[5]s"
+    aws_secret_key = "%[6]s"
+  }
+
+  encryption {
+    type = "AWS_SSE_S3"
+  }
+
+  directory {
+    enable = true
+  }
+
+  file_format {
+    type = "JSON"
+  }
+}
+`, id.DatabaseName(), id.SchemaName(), id.Name(), url, awsKeyId, awsSecretKey)
+}
@@ -0,0 +1,252 @@
+package resources
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var stageFileSchema = map[string]*schema.Schema{
+	"stage": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		Description:      relatedResourceDescription("Fully qualified name of the internal stage to which the file is uploaded.", resources.StageInternal),
+		ValidateDiagFunc: IsValidIdentifier[sdk.SchemaObjectIdentifier](),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+	},
+	"source": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "Path to the local file that is uploaded. The file is uploaded under its own name and is not compressed. Changing the name of the file recreates the file on the stage.",
+	},
+	"path": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		ForceNew:    true,
+		Description: "Path on the stage (e.g. `libs/python`) under which the file is uploaded. By default, the file is uploaded to the root of the stage.",
+		StateFunc: func(v any) string {
+			return strings.Trim(v.(string), "/")
+		},
+	},
+	"content_hash": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "SHA-256 hash of the content of the local file. The file is uploaded again when the content changes. Changes to the file made on the stage are not detected.",
+	},
+	"file_name": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Name of the file on the stage, including the path (e.g. `libs/python/handler.py`). Can be used in the `imports` of functions and procedures.",
+	},
+}
+
+func StageFile() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: PreviewFeatureCreateContextWrapper(string(previewfeatures.StageFileResource), TrackingCreateWrapper(resources.StageFile, CreateStageFile)),
+		ReadContext:   PreviewFeatureReadContextWrapper(string(previewfeatures.StageFileResource), TrackingReadWrapper(resources.StageFile, ReadStageFile)),
+		UpdateContext: PreviewFeatureUpdateContextWrapper(string(previewfeatures.StageFileResource), TrackingUpdateWrapper(resources.StageFile, UpdateStageFile)),
+		DeleteContext: PreviewFeatureDeleteContextWrapper(string(previewfeatures.StageFileResource), TrackingDeleteWrapper(resources.StageFile, DeleteStageFile)),
+		Description:   "Resource used to upload a local file to an internal stage with [PUT](https://docs.snowflake.com/en/sql-reference/sql/put). The file is removed from the stage with [REMOVE](https://docs.snowflake.com/en/sql-reference/sql/remove) on destroy.",
+
+		CustomizeDiff: TrackingCustomDiffWrapper(resources.StageFile, customdiff.All(
+			customdiff.ForceNewIfChange("source", func(_ context.Context, oldValue, newValue, _ any) bool {
+				return filepath.Base(oldValue.(string)) != filepath.Base(newValue.(string))
+			}),
+			stageFileContentHashDiff,
+		)),
+
+		Schema:   stageFileSchema,
+		Timeouts: defaultTimeouts,
+	}
+}
+
+// stageFileContentHashDiff plans an upload when the content of the local file changed. The hash is unknown when the file does not exist yet during the plan
+// (e.g. it is generated by another resource).
+func stageFileContentHashDiff(_ context.Context, diff *schema.ResourceDiff, _ any) error {
+	if !diff.NewValueKnown("source") {
+		return diff.SetNewComputed("content_hash")
+	}
+	hash, err := stageFileContentHash(diff.Get("source").(string))
+	switch {
+	case errors.Is(err, fs.ErrNotExist):
+		return diff.SetNewComputed("content_hash")
+	case err != nil:
+		return err
+	case hash != diff.Get("content_hash").(string):
+		return diff.SetNew("content_hash", hash)
+	}
+	return nil
+}
+
+func stageFileContentHash(source string) (string, error) {
+	file, err := os.Open(source)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	hash := sha256.New()
+	if _, err := io.Copy(hash, file); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// stageFileName returns the name of the uploaded file relative to the root of the stage.
+func stageFileName(path string, source string) string {
+	if path = strings.Trim(path, "/"); path == "" {
+		return filepath.Base(source)
+	}
+	return path + "/" + filepath.Base(source)
+}
+
+// stageFilePattern returns a pattern matching exactly the given file, so that the files sharing its name as a prefix are not affected.
+func stageFilePattern(fileName string) string {
+	return ".*/" + regexp.QuoteMeta(fileName)
+}
+
+func putStageFile(ctx context.Context, d *schema.ResourceData, client *sdk.Client, stageId sdk.SchemaObjectIdentifier) error {
+	source, err := filepath.Abs(d.Get("source").(string))
+	if err != nil {
+		return err
+	}
+	hash, err := stageFileContentHash(source)
+	if err != nil {
+		return fmt.Errorf("error reading file %s err = %w", source, err)
+	}
+
+	request := sdk.NewPutStageRequest(filepath.ToSlash(source), stageId).WithAutoCompress(false).WithOverwrite(true)
+	if v, ok := d.GetOk("path"); ok {
+		request.WithPath(strings.Trim(v.(string), "/") + "/")
+	}
+	if err := client.Stages.Put(ctx, request); err != nil {
+		return fmt.Errorf("error uploading file %s to stage %v err = %w", source, stageId.FullyQualifiedName(), err)
+	}
+	return d.Set("content_hash", hash)
+}
+
+func CreateStageFile(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	stageId, err := sdk.ParseSchemaObjectIdentifier(d.Get("stage").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := putStageFile(ctx, d, client, stageId); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(helpers.EncodeResourceIdentifier(stageId.FullyQualifiedName(), stageFileName(d.Get("path").(string), d.Get("source").(string))))
+
+	return ReadStageFile(ctx, d, meta)
+}
+
+func ReadStageFile(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	stageId, fileName, err := parseStageFileId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	files, err := client.Stages.List(ctx, sdk.NewListStageRequest(stageId).WithPath(fileName))
+	if err != nil {
+		if errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+			d.SetId("")
+			return diag.Diagnostics{
+				diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  "Failed to list stage files. Marking the resource as removed.",
+					Detail:   fmt.Sprintf("Stage: %s, Err: %s", stageId.FullyQualifiedName(), err),
+				},
+			}
+		}
+		return diag.FromErr(err)
+	}
+	if !stageFileListed(files, fileName) {
+		d.SetId("")
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "Failed to find the file on the stage. Marking the resource as removed.",
+				Detail:   fmt.Sprintf("Stage: %s, File: %s", stageId.FullyQualifiedName(), fileName),
+			},
+		}
+	}
+
+	if err := d.Set("file_name", fileName); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+// stageFileListed checks if the exact file is returned by LIST. The returned names are prefixed with the stage name (internal stages) or the stage URL (external stages).
+func stageFileListed(files []sdk.StageFile, fileName string) bool {
+	for _, file := range files {
+		if strings.HasSuffix(file.Name, "/"+fileName) {
+			return true
+		}
+	}
+	return false
+}
+
+func parseStageFileId(id string) (sdk.SchemaObjectIdentifier, string, error) {
+	parts := helpers.ParseResourceIdentifier(id)
+	if len(parts) != 2 {
+		return sdk.SchemaObjectIdentifier{}, "", fmt.Errorf("unexpected number of parts in the stage file id %s, expected 2, got %d", id, len(parts))
+	}
+	stageId, err := sdk.ParseSchemaObjectIdentifier(parts[0])
+	if err != nil {
+		return sdk.SchemaObjectIdentifier{}, "", err
+	}
+	return stageId, parts[1], nil
+}
+
+func UpdateStageFile(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	stageId, _, err := parseStageFileId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChanges("source", "content_hash") {
+		if err := putStageFile(ctx, d, client, stageId); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return ReadStageFile(ctx, d, meta)
+}
+
+func DeleteStageFile(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	stageId, fileName, err := parseStageFileId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = client.Stages.Remove(ctx, sdk.NewRemoveStageRequest(stageId).WithPath(fileName).WithPattern(stageFilePattern(fileName)))
+	if err != nil && !errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+		return diag.FromErr(fmt.Errorf("error removing file %s from stage %v err = %w", fileName, stageId.FullyQualifiedName(), err))
+	}
+
+	d.SetId("")
+	return nil
+}
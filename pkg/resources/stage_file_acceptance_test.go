@@ -0,0 +1,126 @@
+package resources_test
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/testenvs"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/testhelpers"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAcc_StageFile_BasicFlow(t *testing.T) {
+	_ = testenvs.GetOrSkipTest(t, testenvs.EnableAcceptance)
+	acc.TestAccPreCheck(t)
+
+	stage, stageCleanup := acc.TestClient().Stage.CreateStage(t)
+	t.Cleanup(stageCleanup)
+
+	source := testhelpers.TestFile(t, "handler.py", []byte("def handler(): return 1"))
+	resourceReference := "snowflake_stage_file.test"
+
+	assertFileSize := func(fileName string, size int64) resource.TestCheckFunc {
+		return func(_ *terraform.State) error {
+			files, err := acc.TestClient().Stage.List(t, stage.ID(), fileName)
+			if err != nil {
+				return err
+			}
+			if len(files) != 1 {
+				return fmt.Errorf("expected one file %s on stage %s, got %d", fileName, stage.ID().FullyQualifiedName(), len(files))
+			}
+			if files[0].Size != size {
+				return fmt.Errorf("expected file %s to have size %d, got %d", fileName, size, files[0].Size)
+			}
+			return nil
+		}
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: acc.CheckStageFileDestroy(t),
+		Steps: []resource.TestStep{
+			{
+				Config: stageFileConfig(stage.ID(), source, "libs/python"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceReference, "file_name", "libs/python/handler.py"),
+					resource.TestCheckResourceAttrSet(resourceReference, "content_hash"),
+					assertFileSize("libs/python/handler.py", 23),
+				),
+			},
+			// no changes
+			{
+				Config: stageFileConfig(stage.ID(), source, "libs/python"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			// changed content is uploaded again
+			{
+				PreConfig: func() {
+					require.NoError(t, os.WriteFile(source, []byte("def handler(): return 10"), 0o600))
+				},
+				Config: stageFileConfig(stage.ID(), source, "libs/python"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceReference, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					assertFileSize("libs/python/handler.py", 24),
+				),
+			},
+			// the file is removed externally
+			{
+				PreConfig: func() {
+					acc.TestClient().Stage.RemoveFromStage(t, stage.Location(), "libs/python/handler.py")
+				},
+				Config: stageFileConfig(stage.ID(), source, "libs/python"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceReference, plancheck.ResourceActionCreate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					assertFileSize("libs/python/handler.py", 24),
+				),
+			},
+			// the path change recreates the file
+			{
+				Config: stageFileConfig(stage.ID(), source, ""),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceReference, plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceReference, "file_name", "handler.py"),
+					assertFileSize("handler.py", 24),
+				),
+			},
+		},
+	})
+}
+
+func stageFileConfig(stageId sdk.SchemaObjectIdentifier, source string, path string) string {
+	return fmt.Sprintf(`
+resource "snowflake_stage_file" "test" {
+  stage  = %[1]s
+  source = %[2]s
+  path   = "%[3]s"
+}
+`, strconv.Quote(stageId.FullyQualifiedName()), strconv.Quote(source), path)
+}
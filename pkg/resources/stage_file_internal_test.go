@@ -0,0 +1,89 @@
+package resources
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_stageFileName(t *testing.T) {
+	testCases := []struct {
+		path     string
+		source   string
+		expected string
+	}{
+		{path: "", source: "/tmp/handler.py", expected: "handler.py"},
+		{path: "libs", source: "/tmp/handler.py", expected: "libs/handler.py"},
+		{path: "/libs/python/", source: "handler.py", expected: "libs/python/handler.py"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.expected, func(t *testing.T) {
+			assert.Equal(t, tc.expected, stageFileName(tc.path, tc.source))
+		})
+	}
+}
+
+func Test_stageFilePattern(t *testing.T) {
+	testCases := []struct {
+		fileName string
+		expected string
+	}{
+		{fileName: "handler.py", expected: `.*/handler\.py`},
+		{fileName: "libs/a+b(1).jar", expected: `.*/libs/a\+b\(1\)\.jar`},
+		{fileName: `^a\b$[c]{2}|d*?.txt`, expected: `.*/\^a\\b\$\[c\]\{2\}\|d\*\?\.txt`},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.fileName, func(t *testing.T) {
+			pattern := stageFilePattern(tc.fileName)
+			assert.Equal(t, tc.expected, pattern)
+
+			matcher := regexp.MustCompile("^" + pattern + "$")
+			assert.True(t, matcher.MatchString("stage/"+tc.fileName))
+			assert.False(t, matcher.MatchString("stage/"+tc.fileName+".bak"))
+			assert.False(t, matcher.MatchString("stage/x"+tc.fileName))
+		})
+	}
+}
+
+func Test_stageFileListed(t *testing.T) {
+	files := []sdk.StageFile{
+		{Name: "stage/libs/handler.py.bak"},
+		{Name: "stage/libs/handler.py"},
+	}
+	assert.True(t, stageFileListed(files, "libs/handler.py"))
+	assert.True(t, stageFileListed([]sdk.StageFile{{Name: "s3://bucket/path/libs/handler.py"}}, "libs/handler.py"))
+	assert.False(t, stageFileListed(files[:1], "libs/handler.py"))
+	assert.False(t, stageFileListed(nil, "libs/handler.py"))
+}
+
+func Test_stageFileContentHash(t *testing.T) {
+	source := filepath.Join(t.TempDir(), "data.csv")
+	require.NoError(t, os.WriteFile(source, []byte("1,2,3"), 0o600))
+
+	hash, err := stageFileContentHash(source)
+	require.NoError(t, err)
+	assert.Equal(t, "8a6ae15122001229edb8866f56e342af12ae8187203c3e3b33931743e7c0c48d", hash)
+
+	require.NoError(t, os.WriteFile(source, []byte("1,2,4"), 0o600))
+	changedHash, err := stageFileContentHash(source)
+	require.NoError(t, err)
+	assert.NotEqual(t, hash, changedHash)
+
+	_, err = stageFileContentHash(filepath.Join(t.TempDir(), "non_existing.csv"))
+	require.ErrorIs(t, err, os.ErrNotExist)
+}
+
+func Test_parseStageFileId(t *testing.T) {
+	stageId, fileName, err := parseStageFileId(`"db"."schema"."stage"|libs/handler.py`)
+	require.NoError(t, err)
+	assert.Equal(t, sdk.NewSchemaObjectIdentifier("db", "schema", "stage"), stageId)
+	assert.Equal(t, "libs/handler.py", fileName)
+
+	_, _, err = parseStageFileId(`"db"."schema"."stage"`)
+	require.ErrorContains(t, err, "expected 2, got 1")
+}
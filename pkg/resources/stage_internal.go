@@ -0,0 +1,150 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var stageInternalSchema = func() map[string]*schema.Schema {
+	stageInternal := map[string]*schema.Schema{
+		"encryption": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			ForceNew:    true,
+			MaxItems:    1,
+			Description: externalChangesNotDetectedFieldDescription("Specifies the type of encryption supported for all files stored on the stage. The encryption type cannot be changed after the stage is created."),
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"type": {
+						Type:             schema.TypeString,
+						Required:         true,
+						ForceNew:         true,
+						Description:      fmt.Sprintf("Specifies the encryption type. Valid values are (case-insensitive): %s.", possibleValuesListed(sdk.AllInternalStageEncryptionOptions)),
+						ValidateDiagFunc: sdkValidation(sdk.ToInternalStageEncryptionOption),
+						DiffSuppressFunc: NormalizeAndCompare(sdk.ToInternalStageEncryptionOption),
+					},
+				},
+			},
+		},
+		"directory": stageDirectorySchema(false, false),
+	}
+	return collections.MergeMaps(stageCommonSchema, stageInternal)
+}()
+
+func StageInternal() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: PreviewFeatureCreateContextWrapper(string(previewfeatures.StageInternalResource), TrackingCreateWrapper(resources.StageInternal, CreateStageInternal)),
+		ReadContext:   PreviewFeatureReadContextWrapper(string(previewfeatures.StageInternalResource), TrackingReadWrapper(resources.StageInternal, ReadStageInternalFunc(true))),
+		UpdateContext: PreviewFeatureUpdateContextWrapper(string(previewfeatures.StageInternalResource), TrackingUpdateWrapper(resources.StageInternal, UpdateStageInternal)),
+		DeleteContext: PreviewFeatureDeleteContextWrapper(string(previewfeatures.StageInternalResource), TrackingDeleteWrapper(resources.StageInternal, DeleteStageCommon)),
+		Description:   "Resource used to manage internal stages. For more information, check [stage documentation](https://docs.snowflake.com/en/sql-reference/sql/create-stage).",
+
+		CustomizeDiff: TrackingCustomDiffWrapper(resources.StageInternal, customdiff.All(
+			ComputedIfAnyAttributeChanged(stageInternalSchema, ShowOutputAttributeName, "name", "comment", "directory"),
+			ComputedIfAnyAttributeChanged(stageInternalSchema, DescribeOutputAttributeName, "file_format", "directory"),
+		)),
+
+		Schema: stageInternalSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: TrackingImportWrapper(resources.StageInternal, ImportStage),
+		},
+		Timeouts: defaultTimeouts,
+	}
+}
+
+func CreateStageInternal(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	databaseName, schemaName, name := d.Get("database").(string), d.Get("schema").(string), d.Get("name").(string)
+	id := sdk.NewSchemaObjectIdentifier(databaseName, schemaName, name)
+
+	request := sdk.NewCreateInternalStageRequest(id)
+	if v, ok := d.GetOk("encryption.0.type"); ok {
+		encryptionType, err := sdk.ToInternalStageEncryptionOption(v.(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		request.WithEncryption(&sdk.InternalStageEncryptionRequest{Type: &encryptionType})
+	}
+	if _, ok := d.GetOk("directory"); ok {
+		directory := &sdk.InternalDirectoryTableOptionsRequest{Enable: sdk.Bool(d.Get("directory.0.enable").(bool))}
+		if d.Get("directory.0.refresh_on_create").(bool) {
+			directory.RefreshOnCreate = sdk.Bool(true)
+		}
+		request.WithDirectoryTableOptions(directory)
+	}
+	fileFormat, err := stageFileFormatRequest(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	request.WithFileFormat(fileFormat)
+	if v, ok := d.GetOk("comment"); ok {
+		request.WithComment(sdk.String(v.(string)))
+	}
+
+	if err := client.Stages.CreateInternal(ctx, request); err != nil {
+		return diag.FromErr(fmt.Errorf("error creating internal stage %v err = %w", id.FullyQualifiedName(), err))
+	}
+
+	d.SetId(helpers.EncodeResourceIdentifier(id))
+
+	if err := handleStageDirectoryRefresh(ctx, d, client, id); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return ReadStageInternalFunc(false)(ctx, d, meta)
+}
+
+func ReadStageInternalFunc(withExternalChangesMarking bool) schema.ReadContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+		client := meta.(*provider.Context).Client
+		id, err := sdk.ParseSchemaObjectIdentifier(d.Id())
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		if _, _, diags := readStageCommon(ctx, d, client, id, withExternalChangesMarking); diags != nil {
+			return diags
+		}
+		return nil
+	}
+}
+
+func UpdateStageInternal(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseSchemaObjectIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id, err = handleStageRenameAndDirectoryUpdate(ctx, d, client, id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	fileFormat, comment, err := handleStageFileFormatAndCommentUpdate(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if fileFormat != nil || comment != nil {
+		request := sdk.NewAlterInternalStageStageRequest(id).WithFileFormat(fileFormat).WithComment(comment)
+		if err := client.Stages.AlterInternalStage(ctx, request); err != nil {
+			return diag.FromErr(fmt.Errorf("error updating internal stage %v err = %w", id.FullyQualifiedName(), err))
+		}
+	}
+
+	if err := handleStageDirectoryRefresh(ctx, d, client, id); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return ReadStageInternalFunc(false)(ctx, d, meta)
+}
@@ -0,0 +1,237 @@
+package resources_test
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/helpers/random"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/testenvs"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_StageInternal_BasicFlow(t *testing.T) {
+	_ = testenvs.GetOrSkipTest(t, testenvs.EnableAcceptance)
+	acc.TestAccPreCheck(t)
+
+	id := acc.TestClient().Ids.RandomSchemaObjectIdentifier()
+	newId := acc.TestClient().Ids.RandomSchemaObjectIdentifier()
+	comment := random.Comment()
+	resourceReference := "snowflake_stage_internal.test"
+
+	fileFormat, fileFormatCleanup := acc.TestClient().FileFormat.CreateFileFormat(t)
+	t.Cleanup(fileFormatCleanup)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: acc.CheckDestroy(t, resources.StageInternal),
+		Steps: []resource.TestStep{
+			// create with defaults
+			{
+				Config: stageInternalBasicConfig(id),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceReference, "name", id.Name()),
+					resource.TestCheckResourceAttr(resourceReference, "fully_qualified_name", id.FullyQualifiedName()),
+					resource.TestCheckResourceAttr(resourceReference, "comment", ""),
+					resource.TestCheckResourceAttr(resourceReference, "file_format.#", "0"),
+					resource.TestCheckResourceAttr(resourceReference, "directory.#", "0"),
+					resource.TestCheckResourceAttr(resourceReference, "show_output.#", "1"),
+					resource.TestCheckResourceAttr(resourceReference, "show_output.0.name", id.Name()),
+					resource.TestCheckResourceAttr(resourceReference, "show_output.0.type", "INTERNAL"),
+					resource.TestCheckResourceAttr(resourceReference, "describe_output.#", "1"),
+					resource.TestCheckResourceAttr(resourceReference, "describe_output.0.file_format_type", string(sdk.FileFormatTypeCSV)),
+					resource.TestCheckResourceAttr(resourceReference, "describe_output.0.directory_enable", "false"),
+				),
+			},
+			// set comment, file format and directory
+			{
+				Config: stageInternalCompleteConfig(id, comment, fileFormat.ID()),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceReference, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceReference, "comment", comment),
+					resource.TestCheckResourceAttr(resourceReference, "file_format.#", "1"),
+					resource.TestCheckResourceAttr(resourceReference, "file_format.0.format_name", fileFormat.ID().FullyQualifiedName()),
+					resource.TestCheckResourceAttr(resourceReference, "directory.0.enable", "true"),
+					resource.TestCheckResourceAttr(resourceReference, "show_output.0.comment", comment),
+					resource.TestCheckResourceAttr(resourceReference, "show_output.0.directory_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceReference, "describe_output.0.file_format_name", fileFormat.ID().FullyQualifiedName()),
+					resource.TestCheckResourceAttr(resourceReference, "describe_output.0.directory_enable", "true"),
+				),
+			},
+			// import
+			{
+				ResourceName:            resourceReference,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"directory.0.refresh_on_create"},
+			},
+			// rename and unset everything
+			{
+				Config: stageInternalBasicConfig(newId),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceReference, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceReference, "name", newId.Name()),
+					resource.TestCheckResourceAttr(resourceReference, "fully_qualified_name", newId.FullyQualifiedName()),
+					resource.TestCheckResourceAttr(resourceReference, "comment", ""),
+					resource.TestCheckResourceAttr(resourceReference, "file_format.#", "0"),
+					resource.TestCheckResourceAttr(resourceReference, "directory.#", "0"),
+					resource.TestCheckResourceAttr(resourceReference, "describe_output.0.file_format_type", string(sdk.FileFormatTypeCSV)),
+					resource.TestCheckResourceAttr(resourceReference, "describe_output.0.directory_enable", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAcc_StageInternal_Encryption(t *testing.T) {
+	_ = testenvs.GetOrSkipTest(t, testenvs.EnableAcceptance)
+	acc.TestAccPreCheck(t)
+
+	id := acc.TestClient().Ids.RandomSchemaObjectIdentifier()
+	resourceReference := "snowflake_stage_internal.test"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: acc.CheckDestroy(t, resources.StageInternal),
+		Steps: []resource.TestStep{
+			{
+				Config: stageInternalEncryptionConfig(id, string(sdk.InternalStageEncryptionFull)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceReference, "encryption.0.type", string(sdk.InternalStageEncryptionFull)),
+				),
+			},
+			// the encryption cannot be altered
+			{
+				Config: stageInternalEncryptionConfig(id, string(sdk.InternalStageEncryptionSSE)),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceReference, plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceReference, "encryption.0.type", string(sdk.InternalStageEncryptionSSE)),
+				),
+			},
+		},
+	})
+}
+
+func TestAcc_StageInternal_DirectoryRefresh(t *testing.T) {
+	_ = testenvs.GetOrSkipTest(t, testenvs.EnableAcceptance)
+	acc.TestAccPreCheck(t)
+
+	id := acc.TestClient().Ids.RandomSchemaObjectIdentifier()
+	resourceReference := "snowflake_stage_internal.test"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: acc.CheckDestroy(t, resources.StageInternal),
+		Steps: []resource.TestStep{
+			{
+				Config: stageInternalDirectoryRefreshConfig(id, "/"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceReference, "directory.0.enable", "true"),
+					resource.TestCheckResourceAttr(resourceReference, "directory.0.refresh_after_apply", "true"),
+					resource.TestCheckResourceAttr(resourceReference, "directory.0.refresh_subpath", "/"),
+				),
+			},
+			// changing the subpath only refreshes the directory table
+			{
+				Config: stageInternalDirectoryRefreshConfig(id, "data/"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceReference, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceReference, "directory.0.refresh_subpath", "data/"),
+				),
+			},
+		},
+	})
+}
+
+func stageInternalBasicConfig(id sdk.SchemaObjectIdentifier) string {
+	return fmt.Sprintf(`
+resource "snowflake_stage_internal" "test" {
+  database = "%[1]s"
+  schema   = "%[2]s"
+  name     = "%[3]s"
+}
+`, id.DatabaseName(), id.SchemaName(), id.Name())
+}
+
+func stageInternalCompleteConfig(id sdk.SchemaObjectIdentifier, comment string, fileFormatId sdk.SchemaObjectIdentifier) string {
+	return fmt.Sprintf(`
+resource "snowflake_stage_internal" "test" {
+  database = "%[1]s"
+  schema   = "%[2]s"
+  name     = "%[3]s"
+  comment  = "%[4]s"
+
+  directory {
+    enable            = true
+    refresh_on_create = true
+  }
+
+  file_format {
+    format_name = %[5]s
+  }
+}
+`, id.DatabaseName(), id.SchemaName(), id.Name(), comment, strconv.Quote(fileFormatId.FullyQualifiedName()))
+}
+
+func stageInternalEncryptionConfig(id sdk.SchemaObjectIdentifier, encryptionType string) string {
+	return fmt.Sprintf(`
+resource "snowflake_stage_internal" "test" {
+  database = "%[1]s"
+  schema   = "%[2]s"
+  name     = "%[3]s"
+
+  encryption {
+    type = "%[4]s"
+  }
+}
+`, id.DatabaseName(), id.SchemaName(), id.Name(), encryptionType)
+}
+
+func stageInternalDirectoryRefreshConfig(id sdk.SchemaObjectIdentifier, subpath string) string {
+	return fmt.Sprintf(`
+resource "snowflake_stage_internal" "test" {
+  database = "%[1]s"
+  schema   = "%[2]s"
+  name     = "%[3]s"
+
+  directory {
+    enable              = true
+    refresh_after_apply = true
+    refresh_subpath     = "%[4]s"
+  }
+}
+`, id.DatabaseName(), id.SchemaName(), id.Name(), subpath)
+}
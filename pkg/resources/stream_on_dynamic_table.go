@@ -0,0 +1,179 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var streamOnDynamicTableSchema = func() map[string]*schema.Schema {
+	streamOnDynamicTable := map[string]*schema.Schema{
+		"dynamic_table": {
+			Type:             schema.TypeString,
+			Required:         true,
+			Description:      relatedResourceDescription(blocklistedCharactersFieldDescription("Specifies an identifier for the dynamic table the stream will monitor."), resources.DynamicTable),
+			DiffSuppressFunc: SuppressIfAny(suppressIdentifierQuoting, IgnoreChangeToCurrentSnowflakeValueInShow("table_name")),
+			ValidateDiagFunc: IsValidIdentifier[sdk.SchemaObjectIdentifier](),
+		},
+		"show_initial_rows": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			Default:          BooleanDefault,
+			ValidateDiagFunc: validateBooleanString,
+			Description:      externalChangesNotDetectedFieldDescription(booleanStringFieldDescription("Specifies whether to return all existing rows in the source dynamic table as row inserts the first time the stream is consumed.")),
+		},
+		AtAttributeName:     atSchema,
+		BeforeAttributeName: beforeSchema,
+	}
+	return collections.MergeMaps(streamCommonSchema, streamOnDynamicTable)
+}()
+
+func StreamOnDynamicTable() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: PreviewFeatureCreateContextWrapper(string(previewfeatures.StreamOnDynamicTableResource), TrackingCreateWrapper(resources.StreamOnDynamicTable, CreateStreamOnDynamicTable(false))),
+		ReadContext:   PreviewFeatureReadContextWrapper(string(previewfeatures.StreamOnDynamicTableResource), TrackingReadWrapper(resources.StreamOnDynamicTable, ReadStreamOnDynamicTable)),
+		UpdateContext: PreviewFeatureUpdateContextWrapper(string(previewfeatures.StreamOnDynamicTableResource), TrackingUpdateWrapper(resources.StreamOnDynamicTable, UpdateStreamOnDynamicTable)),
+		DeleteContext: PreviewFeatureDeleteContextWrapper(string(previewfeatures.StreamOnDynamicTableResource), TrackingDeleteWrapper(resources.StreamOnDynamicTable, DeleteStreamContext)),
+		Description:   "Resource used to manage streams on dynamic tables. For more information, check [stream documentation](https://docs.snowflake.com/en/sql-reference/sql/create-stream).",
+
+		CustomizeDiff: TrackingCustomDiffWrapper(resources.StreamOnDynamicTable, customdiff.All(
+			ComputedIfAnyAttributeChanged(streamOnDynamicTableSchema, ShowOutputAttributeName, "dynamic_table", "comment"),
+			ComputedIfAnyAttributeChanged(streamOnDynamicTableSchema, DescribeOutputAttributeName, "dynamic_table", "comment"),
+			RecreateWhenStreamIsStale(),
+			RecreateWhenStreamTypeChangedExternally(sdk.StreamSourceTypeDynamicTable),
+		)),
+
+		Schema: streamOnDynamicTableSchema,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: TrackingImportWrapper(resources.StreamOnDynamicTable, ImportName[sdk.SchemaObjectIdentifier]),
+		},
+		Timeouts: defaultTimeouts,
+	}
+}
+
+func CreateStreamOnDynamicTable(orReplace bool) schema.CreateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+		client := meta.(*provider.Context).Client
+		databaseName := d.Get("database").(string)
+		schemaName := d.Get("schema").(string)
+		name := d.Get("name").(string)
+		id := sdk.NewSchemaObjectIdentifier(databaseName, schemaName, name)
+
+		dynamicTableId, err := sdk.ParseSchemaObjectIdentifier(d.Get("dynamic_table").(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		req := sdk.NewCreateOnDynamicTableStreamRequest(id, dynamicTableId)
+
+		errs := errors.Join(
+			copyGrantsAttributeCreate(d, orReplace, &req.OrReplace, &req.CopyGrants),
+			booleanStringAttributeCreate(d, "show_initial_rows", &req.ShowInitialRows),
+			stringAttributeCreate(d, "comment", &req.Comment),
+		)
+		if errs != nil {
+			return diag.FromErr(errs)
+		}
+
+		streamTimeTravelReq := handleStreamTimeTravel(d)
+		if streamTimeTravelReq != nil {
+			req.WithOn(*streamTimeTravelReq)
+		}
+
+		err = client.Streams.CreateOnDynamicTable(ctx, req)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		d.SetId(helpers.EncodeResourceIdentifier(id))
+
+		return ReadStreamOnDynamicTable(ctx, d, meta)
+	}
+}
+
+func ReadStreamOnDynamicTable(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseSchemaObjectIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	stream, err := client.Streams.ShowByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, sdk.ErrObjectNotFound) {
+			d.SetId("")
+			return diag.Diagnostics{
+				diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  "Failed to query stream. Marking the resource as removed.",
+					Detail:   fmt.Sprintf("stream name: %s, Err: %s", id.FullyQualifiedName(), err),
+				},
+			}
+		}
+		return diag.FromErr(err)
+	}
+	dynamicTableId, err := sdk.ParseSchemaObjectIdentifier(*stream.TableName)
+	if err != nil {
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Failed to parse dynamic table ID in Read.",
+				Detail:   fmt.Sprintf("stream name: %s, Err: %s", id.FullyQualifiedName(), err),
+			},
+		}
+	}
+	if err := d.Set("dynamic_table", dynamicTableId.FullyQualifiedName()); err != nil {
+		return diag.FromErr(err)
+	}
+	streamDescription, err := client.Streams.Describe(ctx, id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := handleStreamRead(d, id, stream, streamDescription); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func UpdateStreamOnDynamicTable(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseSchemaObjectIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// change on these fields can not be ForceNew because then the object is dropped explicitly and copying grants does not have effect
+	// recreate when the stream is stale - see https://community.snowflake.com/s/article/using-tasks-to-avoid-stale-streams-when-incoming-data-is-empty
+	if keys := changedKeys(d, "dynamic_table", "at", "before", "show_initial_rows", "stale"); len(keys) > 0 {
+		log.Printf("[DEBUG] Detected change on %q, recreating...", keys)
+		return CreateStreamOnDynamicTable(true)(ctx, d, meta)
+	}
+
+	if d.HasChange("comment") {
+		comment := d.Get("comment").(string)
+		if comment == "" {
+			err := client.Streams.Alter(ctx, sdk.NewAlterStreamRequest(id).WithUnsetComment(true))
+			if err != nil {
+				return diag.FromErr(err)
+			}
+		} else {
+			err := client.Streams.Alter(ctx, sdk.NewAlterStreamRequest(id).WithSetComment(comment))
+			if err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	return ReadStreamOnDynamicTable(ctx, d, meta)
+}
@@ -0,0 +1,85 @@
+package resources_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/helpers/random"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/testenvs"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_StreamOnDynamicTable_Basic(t *testing.T) {
+	_ = testenvs.GetOrSkipTest(t, testenvs.EnableAcceptance)
+	acc.TestAccPreCheck(t)
+
+	table, cleanupTable := acc.TestClient().Table.CreateWithChangeTracking(t)
+	t.Cleanup(cleanupTable)
+
+	dynamicTable, cleanupDynamicTable := acc.TestClient().DynamicTable.CreateDynamicTable(t, table.ID())
+	t.Cleanup(cleanupDynamicTable)
+
+	id := acc.TestClient().Ids.RandomSchemaObjectIdentifier()
+	comment := random.Comment()
+	resourceReference := "snowflake_stream_on_dynamic_table.test"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: acc.CheckDestroy(t, resources.StreamOnDynamicTable),
+		Steps: []resource.TestStep{
+			{
+				Config: streamOnDynamicTableConfig(id, dynamicTable.ID(), ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceReference, "name", id.Name()),
+					resource.TestCheckResourceAttr(resourceReference, "fully_qualified_name", id.FullyQualifiedName()),
+					resource.TestCheckResourceAttr(resourceReference, "dynamic_table", dynamicTable.ID().FullyQualifiedName()),
+					resource.TestCheckResourceAttr(resourceReference, "stale", "false"),
+					resource.TestCheckResourceAttr(resourceReference, "stream_type", string(sdk.StreamSourceTypeDynamicTable)),
+					resource.TestCheckResourceAttr(resourceReference, "show_output.0.source_type", string(sdk.StreamSourceTypeDynamicTable)),
+					resource.TestCheckResourceAttr(resourceReference, "show_output.0.mode", string(sdk.StreamModeDefault)),
+					resource.TestCheckResourceAttr(resourceReference, "show_output.0.table_name", dynamicTable.ID().FullyQualifiedName()),
+					resource.TestCheckResourceAttr(resourceReference, "describe_output.0.source_type", string(sdk.StreamSourceTypeDynamicTable)),
+				),
+			},
+			{
+				ResourceName:            resourceReference,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"show_initial_rows"},
+			},
+			{
+				Config: streamOnDynamicTableConfig(id, dynamicTable.ID(), comment),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceReference, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceReference, "comment", comment),
+					resource.TestCheckResourceAttr(resourceReference, "show_output.0.comment", comment),
+				),
+			},
+		},
+	})
+}
+
+func streamOnDynamicTableConfig(id sdk.SchemaObjectIdentifier, dynamicTableId sdk.SchemaObjectIdentifier, comment string) string {
+	return fmt.Sprintf(`
+resource "snowflake_stream_on_dynamic_table" "test" {
+  database      = "%[1]s"
+  schema        = "%[2]s"
+  name          = "%[3]s"
+  dynamic_table = %[4]q
+  comment       = "%[5]s"
+}
+`, id.DatabaseName(), id.SchemaName(), id.Name(), dynamicTableId.FullyQualifiedName(), comment)
+}
@@ -0,0 +1,79 @@
+package resources_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/testenvs"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_StreamOnEventTable_Basic(t *testing.T) {
+	_ = testenvs.GetOrSkipTest(t, testenvs.EnableAcceptance)
+	acc.TestAccPreCheck(t)
+
+	eventTable, cleanupEventTable := acc.TestClient().EventTable.Create(t)
+	t.Cleanup(cleanupEventTable)
+
+	id := acc.TestClient().Ids.RandomSchemaObjectIdentifier()
+	resourceReference := "snowflake_stream_on_event_table.test"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: acc.CheckDestroy(t, resources.StreamOnEventTable),
+		Steps: []resource.TestStep{
+			{
+				Config: streamOnEventTableConfig(id, eventTable.ID(), "false"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceReference, "name", id.Name()),
+					resource.TestCheckResourceAttr(resourceReference, "fully_qualified_name", id.FullyQualifiedName()),
+					resource.TestCheckResourceAttr(resourceReference, "event_table", eventTable.ID().FullyQualifiedName()),
+					resource.TestCheckResourceAttr(resourceReference, "append_only", "false"),
+					resource.TestCheckResourceAttr(resourceReference, "stream_type", string(sdk.StreamSourceTypeEventTable)),
+					resource.TestCheckResourceAttr(resourceReference, "show_output.0.source_type", string(sdk.StreamSourceTypeEventTable)),
+					resource.TestCheckResourceAttr(resourceReference, "show_output.0.mode", string(sdk.StreamModeDefault)),
+				),
+			},
+			{
+				ResourceName:            resourceReference,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"show_initial_rows"},
+			},
+			// changing append_only recreates the stream
+			{
+				Config: streamOnEventTableConfig(id, eventTable.ID(), "true"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceReference, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceReference, "append_only", "true"),
+					resource.TestCheckResourceAttr(resourceReference, "show_output.0.mode", string(sdk.StreamModeAppendOnly)),
+				),
+			},
+		},
+	})
+}
+
+func streamOnEventTableConfig(id sdk.SchemaObjectIdentifier, eventTableId sdk.SchemaObjectIdentifier, appendOnly string) string {
+	return fmt.Sprintf(`
+resource "snowflake_stream_on_event_table" "test" {
+  database    = "%[1]s"
+  schema      = "%[2]s"
+  name        = "%[3]s"
+  event_table = %[4]q
+  append_only = "%[5]s"
+}
+`, id.DatabaseName(), id.SchemaName(), id.Name(), eventTableId.FullyQualifiedName(), appendOnly)
+}
@@ -0,0 +1,251 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var streamOnIcebergTableSchema = func() map[string]*schema.Schema {
+	streamOnIcebergTable := map[string]*schema.Schema{
+		"iceberg_table": {
+			Type:             schema.TypeString,
+			Required:         true,
+			Description:      blocklistedCharactersFieldDescription("Specifies an identifier for the Iceberg table the stream will monitor."),
+			DiffSuppressFunc: SuppressIfAny(suppressIdentifierQuoting, IgnoreChangeToCurrentSnowflakeValueInShow("table_name")),
+			ValidateDiagFunc: IsValidIdentifier[sdk.SchemaObjectIdentifier](),
+		},
+		"append_only": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			Default:          BooleanDefault,
+			ValidateDiagFunc: validateBooleanString,
+			DiffSuppressFunc: IgnoreChangeToCurrentSnowflakeValueInShowWithMapping("mode", func(x any) any {
+				return x.(string) == string(sdk.StreamModeAppendOnly)
+			}),
+			ConflictsWith: []string{"insert_only"},
+			Description:   booleanStringFieldDescription("Specifies whether this is an append-only stream."),
+		},
+		"insert_only": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			Default:          BooleanDefault,
+			ValidateDiagFunc: validateBooleanString,
+			DiffSuppressFunc: IgnoreChangeToCurrentSnowflakeValueInShowWithMapping("mode", func(x any) any {
+				return x.(string) == string(sdk.StreamModeInsertOnly)
+			}),
+			ConflictsWith: []string{"append_only"},
+			Description:   booleanStringFieldDescription("Specifies whether this is an insert-only stream. Insert-only streams are supported for externally managed Iceberg tables."),
+		},
+		"show_initial_rows": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			Default:          BooleanDefault,
+			ValidateDiagFunc: validateBooleanString,
+			Description:      externalChangesNotDetectedFieldDescription(booleanStringFieldDescription("Specifies whether to return all existing rows in the source Iceberg table as row inserts the first time the stream is consumed.")),
+		},
+		AtAttributeName:     atSchema,
+		BeforeAttributeName: beforeSchema,
+	}
+	return collections.MergeMaps(streamCommonSchema, streamOnIcebergTable)
+}()
+
+func StreamOnIcebergTable() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: PreviewFeatureCreateContextWrapper(string(previewfeatures.StreamOnIcebergTableResource), TrackingCreateWrapper(resources.StreamOnIcebergTable, CreateStreamOnIcebergTable(false))),
+		ReadContext:   PreviewFeatureReadContextWrapper(string(previewfeatures.StreamOnIcebergTableResource), TrackingReadWrapper(resources.StreamOnIcebergTable, ReadStreamOnIcebergTable(true))),
+		UpdateContext: PreviewFeatureUpdateContextWrapper(string(previewfeatures.StreamOnIcebergTableResource), TrackingUpdateWrapper(resources.StreamOnIcebergTable, UpdateStreamOnIcebergTable)),
+		DeleteContext: PreviewFeatureDeleteContextWrapper(string(previewfeatures.StreamOnIcebergTableResource), TrackingDeleteWrapper(resources.StreamOnIcebergTable, DeleteStreamContext)),
+		Description:   "Resource used to manage streams on Iceberg tables. For more information, check [stream documentation](https://docs.snowflake.com/en/sql-reference/sql/create-stream).",
+
+		CustomizeDiff: TrackingCustomDiffWrapper(resources.StreamOnIcebergTable, customdiff.All(
+			ComputedIfAnyAttributeChanged(streamOnIcebergTableSchema, ShowOutputAttributeName, "iceberg_table", "append_only", "insert_only", "comment"),
+			ComputedIfAnyAttributeChanged(streamOnIcebergTableSchema, DescribeOutputAttributeName, "iceberg_table", "append_only", "insert_only", "comment"),
+			RecreateWhenStreamIsStale(),
+			RecreateWhenStreamTypeChangedExternally(sdk.StreamSourceTypeIcebergTable),
+		)),
+
+		Schema: streamOnIcebergTableSchema,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: TrackingImportWrapper(resources.StreamOnIcebergTable, ImportStreamOnIcebergTable),
+		},
+		Timeouts: defaultTimeouts,
+	}
+}
+
+func ImportStreamOnIcebergTable(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	log.Printf("[DEBUG] Starting stream import")
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseSchemaObjectIdentifier(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	v, err := client.Streams.ShowByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := ImportName[sdk.SchemaObjectIdentifier](context.Background(), d, nil); err != nil {
+		return nil, err
+	}
+	if err := errors.Join(
+		d.Set("append_only", booleanStringFromBool(v.IsAppendOnly())),
+		d.Set("insert_only", booleanStringFromBool(v.IsInsertOnly())),
+	); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+func CreateStreamOnIcebergTable(orReplace bool) schema.CreateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+		client := meta.(*provider.Context).Client
+		databaseName := d.Get("database").(string)
+		schemaName := d.Get("schema").(string)
+		name := d.Get("name").(string)
+		id := sdk.NewSchemaObjectIdentifier(databaseName, schemaName, name)
+
+		icebergTableIdRaw := d.Get("iceberg_table").(string)
+		icebergTableId, err := sdk.ParseSchemaObjectIdentifier(icebergTableIdRaw)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		req := sdk.NewCreateOnIcebergTableStreamRequest(id, icebergTableId)
+
+		errs := errors.Join(
+			copyGrantsAttributeCreate(d, orReplace, &req.OrReplace, &req.CopyGrants),
+			booleanStringAttributeCreate(d, "append_only", &req.AppendOnly),
+			booleanStringAttributeCreate(d, "insert_only", &req.InsertOnly),
+			booleanStringAttributeCreate(d, "show_initial_rows", &req.ShowInitialRows),
+			stringAttributeCreate(d, "comment", &req.Comment),
+		)
+		if errs != nil {
+			return diag.FromErr(errs)
+		}
+
+		streamTimeTravelReq := handleStreamTimeTravel(d)
+		if streamTimeTravelReq != nil {
+			req.WithOn(*streamTimeTravelReq)
+		}
+
+		err = client.Streams.CreateOnIcebergTable(ctx, req)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		d.SetId(helpers.EncodeResourceIdentifier(id))
+
+		return ReadStreamOnIcebergTable(false)(ctx, d, meta)
+	}
+}
+
+func ReadStreamOnIcebergTable(withExternalChangesMarking bool) schema.ReadContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+		client := meta.(*provider.Context).Client
+		id, err := sdk.ParseSchemaObjectIdentifier(d.Id())
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		stream, err := client.Streams.ShowByID(ctx, id)
+		if err != nil {
+			if errors.Is(err, sdk.ErrObjectNotFound) {
+				d.SetId("")
+				return diag.Diagnostics{
+					diag.Diagnostic{
+						Severity: diag.Warning,
+						Summary:  "Failed to query stream. Marking the resource as removed.",
+						Detail:   fmt.Sprintf("stream name: %s, Err: %s", id.FullyQualifiedName(), err),
+					},
+				}
+			}
+			return diag.FromErr(err)
+		}
+		icebergTableId, err := sdk.ParseSchemaObjectIdentifier(*stream.TableName)
+		if err != nil {
+			return diag.Diagnostics{
+				diag.Diagnostic{
+					Severity: diag.Error,
+					Summary:  "Failed to parse Iceberg table ID in Read.",
+					Detail:   fmt.Sprintf("stream name: %s, Err: %s", id.FullyQualifiedName(), err),
+				},
+			}
+		}
+		if err := d.Set("iceberg_table", icebergTableId.FullyQualifiedName()); err != nil {
+			return diag.FromErr(err)
+		}
+		streamDescription, err := client.Streams.Describe(ctx, id)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if err := handleStreamRead(d, id, stream, streamDescription); err != nil {
+			return diag.FromErr(err)
+		}
+		if withExternalChangesMarking {
+			var mode sdk.StreamMode
+			if stream.Mode != nil {
+				mode = *stream.Mode
+			}
+			if err = handleExternalChangesToObjectInShow(d,
+				outputMapping{"mode", "append_only", string(mode), booleanStringFromBool(stream.IsAppendOnly()), nil},
+				outputMapping{"mode", "insert_only", string(mode), booleanStringFromBool(stream.IsInsertOnly()), nil},
+			); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+
+		if err = setStateToValuesFromConfig(d, streamOnIcebergTableSchema, []string{
+			"append_only",
+			"insert_only",
+		}); err != nil {
+			return diag.FromErr(err)
+		}
+
+		return nil
+	}
+}
+
+func UpdateStreamOnIcebergTable(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseSchemaObjectIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// change on these fields can not be ForceNew because then the object is dropped explicitly and copying grants does not have effect
+	// recreate when the stream is stale - see https://community.snowflake.com/s/article/using-tasks-to-avoid-stale-streams-when-incoming-data-is-empty
+	if keys := changedKeys(d, "iceberg_table", "append_only", "insert_only", "at", "before", "show_initial_rows", "stale"); len(keys) > 0 {
+		log.Printf("[DEBUG] Detected change on %q, recreating...", keys)
+		return CreateStreamOnIcebergTable(true)(ctx, d, meta)
+	}
+
+	if d.HasChange("comment") {
+		comment := d.Get("comment").(string)
+		if comment == "" {
+			err := client.Streams.Alter(ctx, sdk.NewAlterStreamRequest(id).WithUnsetComment(true))
+			if err != nil {
+				return diag.FromErr(err)
+			}
+		} else {
+			err := client.Streams.Alter(ctx, sdk.NewAlterStreamRequest(id).WithSetComment(comment))
+			if err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	return ReadStreamOnIcebergTable(false)(ctx, d, meta)
+}
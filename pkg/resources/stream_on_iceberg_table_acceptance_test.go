@@ -0,0 +1,342 @@
+package resources_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/helpers/random"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/planchecks"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/testenvs"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/snowflakeroles"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	r "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	tfjson "github.com/hashicorp/terraform-json"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_StreamOnIcebergTable_Basic(t *testing.T) {
+	_ = testenvs.GetOrSkipTest(t, testenvs.EnableAcceptance)
+	acc.TestAccPreCheck(t)
+
+	icebergTableId, cleanupIcebergTable := acc.TestClient().IcebergTable.Create(t)
+	t.Cleanup(cleanupIcebergTable)
+
+	id := acc.TestClient().Ids.RandomSchemaObjectIdentifier()
+	comment := random.Comment()
+	resourceReference := "snowflake_stream_on_iceberg_table.test"
+
+	var createdOn string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: acc.CheckDestroy(t, resources.StreamOnIcebergTable),
+		Steps: []resource.TestStep{
+			{
+				Config: streamOnIcebergTableConfig(id, icebergTableId, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceReference, "name", id.Name()),
+					resource.TestCheckResourceAttr(resourceReference, "fully_qualified_name", id.FullyQualifiedName()),
+					resource.TestCheckResourceAttr(resourceReference, "iceberg_table", icebergTableId.FullyQualifiedName()),
+					resource.TestCheckResourceAttr(resourceReference, "append_only", r.BooleanDefault),
+					resource.TestCheckResourceAttr(resourceReference, "insert_only", r.BooleanDefault),
+					resource.TestCheckResourceAttr(resourceReference, "stale", r.BooleanFalse),
+					resource.TestCheckResourceAttr(resourceReference, "stream_type", string(sdk.StreamSourceTypeIcebergTable)),
+					resource.TestCheckResourceAttr(resourceReference, "show_output.0.source_type", string(sdk.StreamSourceTypeIcebergTable)),
+					resource.TestCheckResourceAttr(resourceReference, "show_output.0.mode", string(sdk.StreamModeDefault)),
+					resource.TestCheckResourceAttr(resourceReference, "show_output.0.table_name", icebergTableId.FullyQualifiedName()),
+					resource.TestCheckResourceAttr(resourceReference, "describe_output.0.source_type", string(sdk.StreamSourceTypeIcebergTable)),
+					resource.TestCheckResourceAttrWith(resourceReference, "show_output.0.created_on", func(value string) error {
+						createdOn = value
+						return nil
+					}),
+				),
+			},
+			{
+				ResourceName:            resourceReference,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"append_only", "insert_only", "show_initial_rows"},
+			},
+			// the comment is altered in place
+			{
+				Config: streamOnIcebergTableConfig(id, icebergTableId, fmt.Sprintf(`comment = %q`, comment)),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceReference, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceReference, "comment", comment),
+					resource.TestCheckResourceAttr(resourceReference, "show_output.0.comment", comment),
+					resource.TestCheckResourceAttrWith(resourceReference, "show_output.0.created_on", func(value string) error {
+						if value != createdOn {
+							return fmt.Errorf("stream was recreated")
+						}
+						return nil
+					}),
+				),
+			},
+			// changing append_only recreates the stream
+			{
+				Config: streamOnIcebergTableConfig(id, icebergTableId, fmt.Sprintf(`comment = %q
+  append_only = "true"`, comment)),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceReference, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceReference, "append_only", r.BooleanTrue),
+					resource.TestCheckResourceAttr(resourceReference, "show_output.0.mode", string(sdk.StreamModeAppendOnly)),
+					resource.TestCheckResourceAttrWith(resourceReference, "show_output.0.created_on", func(value string) error {
+						if value == createdOn {
+							return fmt.Errorf("stream was not recreated")
+						}
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAcc_StreamOnIcebergTable_Complete(t *testing.T) {
+	_ = testenvs.GetOrSkipTest(t, testenvs.EnableAcceptance)
+	acc.TestAccPreCheck(t)
+
+	icebergTableId, cleanupIcebergTable := acc.TestClient().IcebergTable.Create(t)
+	t.Cleanup(cleanupIcebergTable)
+
+	id := acc.TestClient().Ids.RandomSchemaObjectIdentifier()
+	comment := random.Comment()
+	resourceReference := "snowflake_stream_on_iceberg_table.test"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: acc.CheckDestroy(t, resources.StreamOnIcebergTable),
+		Steps: []resource.TestStep{
+			{
+				Config: streamOnIcebergTableConfig(id, icebergTableId, fmt.Sprintf(`copy_grants       = true
+  append_only       = "true"
+  show_initial_rows = "true"
+  comment           = %q`, comment)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceReference, "name", id.Name()),
+					resource.TestCheckResourceAttr(resourceReference, "database", id.DatabaseName()),
+					resource.TestCheckResourceAttr(resourceReference, "schema", id.SchemaName()),
+					resource.TestCheckResourceAttr(resourceReference, "fully_qualified_name", id.FullyQualifiedName()),
+					resource.TestCheckResourceAttr(resourceReference, "iceberg_table", icebergTableId.FullyQualifiedName()),
+					resource.TestCheckResourceAttr(resourceReference, "copy_grants", "true"),
+					resource.TestCheckResourceAttr(resourceReference, "append_only", r.BooleanTrue),
+					resource.TestCheckResourceAttr(resourceReference, "insert_only", r.BooleanDefault),
+					resource.TestCheckResourceAttr(resourceReference, "show_initial_rows", r.BooleanTrue),
+					resource.TestCheckResourceAttr(resourceReference, "comment", comment),
+					resource.TestCheckResourceAttr(resourceReference, "stale", r.BooleanFalse),
+					resource.TestCheckResourceAttr(resourceReference, "stream_type", string(sdk.StreamSourceTypeIcebergTable)),
+
+					resource.TestCheckResourceAttr(resourceReference, "show_output.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceReference, "show_output.0.created_on"),
+					resource.TestCheckResourceAttr(resourceReference, "show_output.0.name", id.Name()),
+					resource.TestCheckResourceAttr(resourceReference, "show_output.0.database_name", id.DatabaseName()),
+					resource.TestCheckResourceAttr(resourceReference, "show_output.0.schema_name", id.SchemaName()),
+					resource.TestCheckResourceAttr(resourceReference, "show_output.0.owner", snowflakeroles.Accountadmin.Name()),
+					resource.TestCheckResourceAttr(resourceReference, "show_output.0.comment", comment),
+					resource.TestCheckResourceAttr(resourceReference, "show_output.0.table_name", icebergTableId.FullyQualifiedName()),
+					resource.TestCheckResourceAttr(resourceReference, "show_output.0.source_type", string(sdk.StreamSourceTypeIcebergTable)),
+					resource.TestCheckResourceAttr(resourceReference, "show_output.0.type", "DELTA"),
+					resource.TestCheckResourceAttr(resourceReference, "show_output.0.stale", "false"),
+					resource.TestCheckResourceAttr(resourceReference, "show_output.0.mode", string(sdk.StreamModeAppendOnly)),
+					resource.TestCheckResourceAttrSet(resourceReference, "show_output.0.stale_after"),
+					resource.TestCheckResourceAttr(resourceReference, "show_output.0.owner_role_type", "ROLE"),
+
+					resource.TestCheckResourceAttr(resourceReference, "describe_output.#", "1"),
+					resource.TestCheckResourceAttr(resourceReference, "describe_output.0.name", id.Name()),
+					resource.TestCheckResourceAttr(resourceReference, "describe_output.0.table_name", icebergTableId.FullyQualifiedName()),
+					resource.TestCheckResourceAttr(resourceReference, "describe_output.0.source_type", string(sdk.StreamSourceTypeIcebergTable)),
+					resource.TestCheckResourceAttr(resourceReference, "describe_output.0.mode", string(sdk.StreamModeAppendOnly)),
+				),
+			},
+			{
+				ResourceName:            resourceReference,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"copy_grants", "insert_only", "show_initial_rows"},
+			},
+		},
+	})
+}
+
+func TestAcc_StreamOnIcebergTable_PermadiffWhenIsStaleAndHasNoRetentionTime(t *testing.T) {
+	_ = testenvs.GetOrSkipTest(t, testenvs.EnableAcceptance)
+	acc.TestAccPreCheck(t)
+
+	schema, cleanupSchema := acc.TestClient().Schema.CreateSchemaWithOpts(t,
+		acc.TestClient().Ids.RandomDatabaseObjectIdentifierInDatabase(acc.TestClient().Ids.DatabaseId()),
+		&sdk.CreateSchemaOptions{
+			DataRetentionTimeInDays:    sdk.Pointer(0),
+			MaxDataExtensionTimeInDays: sdk.Pointer(0),
+		},
+	)
+	t.Cleanup(cleanupSchema)
+
+	icebergTableId, cleanupIcebergTable := acc.TestClient().IcebergTable.CreateInSchema(t, schema.ID())
+	t.Cleanup(cleanupIcebergTable)
+
+	id := acc.TestClient().Ids.RandomSchemaObjectIdentifierInSchema(schema.ID())
+	resourceReference := "snowflake_stream_on_iceberg_table.test"
+
+	var createdOn string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: acc.CheckDestroy(t, resources.StreamOnIcebergTable),
+		Steps: []resource.TestStep{
+			// check that stale state is marked properly and forces an update
+			{
+				Config: streamOnIcebergTableConfig(id, icebergTableId, ""),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceReference, plancheck.ResourceActionUpdate),
+						planchecks.ExpectChange(resourceReference, "stale", tfjson.ActionUpdate, sdk.String(r.BooleanTrue), sdk.String(r.BooleanFalse)),
+					},
+				},
+				ExpectNonEmptyPlan: true,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceReference, "stale", r.BooleanTrue),
+					resource.TestCheckResourceAttr(resourceReference, "show_output.0.stale", "true"),
+					resource.TestCheckResourceAttrWith(resourceReference, "show_output.0.created_on", func(value string) error {
+						createdOn = value
+						return nil
+					}),
+				),
+			},
+			// check that the resource was recreated
+			// note that it is stale again because we still have schema parameters set to 0
+			{
+				Config: streamOnIcebergTableConfig(id, icebergTableId, ""),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceReference, plancheck.ResourceActionUpdate),
+						planchecks.ExpectChange(resourceReference, "stale", tfjson.ActionUpdate, sdk.String(r.BooleanTrue), sdk.String(r.BooleanFalse)),
+					},
+				},
+				ExpectNonEmptyPlan: true,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceReference, "stale", r.BooleanTrue),
+					resource.TestCheckResourceAttrWith(resourceReference, "show_output.0.created_on", func(value string) error {
+						if value == createdOn {
+							return fmt.Errorf("stream was not recreated")
+						}
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
+
+// There is no way to check at/before fields in show and describe. That's why we try creating with these values, but do not assert them.
+func TestAcc_StreamOnIcebergTable_AtAndBefore(t *testing.T) {
+	_ = testenvs.GetOrSkipTest(t, testenvs.EnableAcceptance)
+	acc.TestAccPreCheck(t)
+
+	icebergTableId, cleanupIcebergTable := acc.TestClient().IcebergTable.Create(t)
+	t.Cleanup(cleanupIcebergTable)
+
+	acc.TestClient().IcebergTable.InsertInt(t, icebergTableId)
+	lastQueryId := acc.TestClient().Context.LastQueryId(t)
+
+	id := acc.TestClient().Ids.RandomSchemaObjectIdentifier()
+	resourceReference := "snowflake_stream_on_iceberg_table.test"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: acc.CheckDestroy(t, resources.StreamOnIcebergTable),
+		Steps: []resource.TestStep{
+			{
+				Config: streamOnIcebergTableConfig(id, icebergTableId, `at {
+    offset = "0"
+  }`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceReference, "name", id.Name()),
+					resource.TestCheckResourceAttr(resourceReference, "at.#", "1"),
+					resource.TestCheckResourceAttr(resourceReference, "at.0.offset", "0"),
+					resource.TestCheckResourceAttr(resourceReference, "show_output.0.table_name", icebergTableId.FullyQualifiedName()),
+				),
+			},
+			// changing at recreates the stream
+			{
+				Config: streamOnIcebergTableConfig(id, icebergTableId, fmt.Sprintf(`at {
+    statement = %q
+  }`, lastQueryId)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceReference, "at.#", "1"),
+					resource.TestCheckResourceAttr(resourceReference, "at.0.statement", lastQueryId),
+				),
+			},
+			{
+				Config: streamOnIcebergTableConfig(id, icebergTableId, fmt.Sprintf(`before {
+    statement = %q
+  }`, lastQueryId)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceReference, "at.#", "0"),
+					resource.TestCheckResourceAttr(resourceReference, "before.#", "1"),
+					resource.TestCheckResourceAttr(resourceReference, "before.0.statement", lastQueryId),
+				),
+			},
+		},
+	})
+}
+
+func TestAcc_StreamOnIcebergTable_InvalidConfiguration(t *testing.T) {
+	_ = testenvs.GetOrSkipTest(t, testenvs.EnableAcceptance)
+	acc.TestAccPreCheck(t)
+
+	id := sdk.NewSchemaObjectIdentifier("db", "schema", "stream")
+	icebergTableId := sdk.NewSchemaObjectIdentifier("db", "schema", "iceberg_table")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: streamOnIcebergTableConfig(id, icebergTableId, `append_only = "true"
+  insert_only = "true"`),
+				ExpectError: regexp.MustCompile(`"append_only": conflicts with insert_only`),
+			},
+		},
+	})
+}
+
+func streamOnIcebergTableConfig(id sdk.SchemaObjectIdentifier, icebergTableId sdk.SchemaObjectIdentifier, extraFields string) string {
+	return fmt.Sprintf(`
+resource "snowflake_stream_on_iceberg_table" "test" {
+  database      = "%[1]s"
+  schema        = "%[2]s"
+  name          = "%[3]s"
+  iceberg_table = %[4]q
+  %[5]s
+}
+`, id.DatabaseName(), id.SchemaName(), id.Name(), icebergTableId.FullyQualifiedName(), extraFields)
+}
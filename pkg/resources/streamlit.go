@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"path"
+	"reflect"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
@@ -40,21 +41,37 @@ var streamlitSchema = map[string]*schema.Schema{
 	},
 	"stage": {
 		Type:             schema.TypeString,
-		Required:         true,
-		Description:      relatedResourceDescription("The stage in which streamlit files are located.", resources.Stage),
+		Optional:         true,
+		Description:      relatedResourceDescription("The stage in which streamlit files are located. The streamlit is created with `ROOT_LOCATION`.", resources.Stage),
 		ValidateDiagFunc: IsValidIdentifier[sdk.SchemaObjectIdentifier](),
 		DiffSuppressFunc: SuppressIfAny(suppressIdentifierQuoting, IgnoreChangeToCurrentSnowflakeValueInDescribe("root_location")),
+		ExactlyOneOf:     []string{"stage", "source_location"},
 	},
 	"directory_location": {
 		Type:             schema.TypeString,
 		Optional:         true,
 		Description:      "Specifies the full path to the named stage containing the Streamlit Python files, media files, and the environment.yml file.",
 		DiffSuppressFunc: IgnoreChangeToCurrentSnowflakeValueInDescribe("root_location"),
+		ConflictsWith:    []string{"source_location"},
+	},
+	"source_location": {
+		Type:     schema.TypeString,
+		Optional: true,
+		Description: externalChangesNotDetectedFieldDescription("Specifies the location from which the files of the Streamlit app are copied, e.g. a path on a stage (`@db.schema.stage/app`) or in a Git repository clone (`@db.schema.repository/branches/main/app`). The streamlit is created with `FROM`, and the files are stored in a version of the app managed by Snowflake. " +
+			"A change of this field or of `source_revision` adds a live version to the app (if it does not have one), copies the files from this location into it, and commits it as a new version. The app is not recreated, so it keeps its URL. Files removed from the source location are not removed from the new version. " +
+			"Switching between `stage` and `source_location` recreates the streamlit."),
+		ExactlyOneOf: []string{"stage", "source_location"},
+	},
+	"source_revision": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		Description:  "An arbitrary value identifying the revision of the files in `source_location`, e.g. a Git commit hash or a hash of the uploaded files. A change of this field commits a new version of the app from `source_location`. It can only be used with `source_location`.",
+		RequiredWith: []string{"source_location"},
 	},
 	"main_file": {
 		Type:             schema.TypeString,
 		Required:         true,
-		Description:      "Specifies the filename of the Streamlit Python application. This filename is relative to the value of `directory_location`",
+		Description:      "Specifies the filename of the Streamlit Python application. This filename is relative to the value of `directory_location` or `source_location`.",
 		DiffSuppressFunc: IgnoreChangeToCurrentSnowflakeValueInDescribe("main_file"),
 	},
 	"query_warehouse": {
@@ -64,6 +81,12 @@ var streamlitSchema = map[string]*schema.Schema{
 		Description:      relatedResourceDescription("Specifies the warehouse where SQL queries issued by the Streamlit application are run. Due to Snowflake limitations warehouse identifier can consist of only upper-cased letters.", resources.Warehouse),
 		DiffSuppressFunc: SuppressIfAny(suppressIdentifierQuoting, IgnoreChangeToCurrentSnowflakeValueInShow("query_warehouse")),
 	},
+	"imports": {
+		Type:        schema.TypeSet,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Optional:    true,
+		Description: externalChangesNotDetectedFieldDescription("Specifies the files on stages to import into the Streamlit app, e.g. `@db.schema.stage/libs/package.zip`. Removing all the imports recreates the streamlit."),
+	},
 	"external_access_integrations": {
 		Type: schema.TypeSet,
 		Elem: &schema.Schema{
@@ -121,7 +144,11 @@ func Streamlit() *schema.Resource {
 		CustomizeDiff: TrackingCustomDiffWrapper(resources.Streamlit, customdiff.All(
 			ComputedIfAnyAttributeChanged(streamlitSchema, ShowOutputAttributeName, "name", "title", "comment", "query_warehouse"),
 			ComputedIfAnyAttributeChanged(streamlitSchema, FullyQualifiedNameAttributeName, "name"),
-			ComputedIfAnyAttributeChanged(streamlitSchema, DescribeOutputAttributeName, "title", "comment", "root_location", "main_file", "query_warehouse", "external_access_integrations"),
+			ComputedIfAnyAttributeChanged(streamlitSchema, DescribeOutputAttributeName, "title", "comment", "root_location", "main_file", "query_warehouse", "external_access_integrations", "source_location", "source_revision", "imports"),
+			customdiff.ForceNewIfChange("source_location", func(_ context.Context, oldValue, newValue, _ any) bool {
+				return (oldValue.(string) == "") != (newValue.(string) == "")
+			}),
+			ForceNewIfChangeToEmptySet("imports"),
 		)),
 
 		StateUpgraders: []schema.StateUpgrader{
@@ -162,14 +189,18 @@ func ImportStreamlit(ctx context.Context, d *schema.ResourceData, meta any) ([]*
 	if err != nil {
 		return nil, err
 	}
-	stageId, location, err := helpers.ParseRootLocation(streamlitDetails.RootLocation)
-	if err != nil {
-		return nil, err
-	}
-	if err := d.Set("stage", stageId.FullyQualifiedName()); err != nil {
-		return nil, err
-	}
-	if err := d.Set("directory_location", location); err != nil {
+	if streamlitDetails.RootLocation != "" {
+		stageId, location, err := helpers.ParseRootLocation(streamlitDetails.RootLocation)
+		if err != nil {
+			return nil, err
+		}
+		if err := d.Set("stage", stageId.FullyQualifiedName()); err != nil {
+			return nil, err
+		}
+		if err := d.Set("directory_location", location); err != nil {
+			return nil, err
+		}
+	} else if err := d.Set("source_location", streamlitDetails.DefaultVersionSourceLocationUri); err != nil {
 		return nil, err
 	}
 	if err = d.Set("main_file", streamlitDetails.MainFile); err != nil {
@@ -197,17 +228,25 @@ func CreateContextStreamlit(ctx context.Context, d *schema.ResourceData, meta an
 	name := d.Get("name").(string)
 	id := sdk.NewSchemaObjectIdentifier(databaseName, schemaName, name)
 
-	stageId, err := sdk.ParseSchemaObjectIdentifier(d.Get("stage").(string))
-	if err != nil {
-		return diag.FromErr(err)
-	}
+	req := sdk.NewCreateStreamlitRequest(id, d.Get("main_file").(string))
 
-	rootLocation := fmt.Sprintf("@%s", stageId.FullyQualifiedName())
-	if v, ok := d.GetOk("directory_location"); ok {
-		rootLocation = path.Join(rootLocation, v.(string))
+	if v, ok := d.GetOk("source_location"); ok {
+		req.WithFrom(v.(string))
+	} else {
+		stageId, err := sdk.ParseSchemaObjectIdentifier(d.Get("stage").(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		rootLocation := fmt.Sprintf("@%s", stageId.FullyQualifiedName())
+		if v, ok := d.GetOk("directory_location"); ok {
+			rootLocation = path.Join(rootLocation, v.(string))
+		}
+		req.WithRootLocation(rootLocation)
 	}
 
-	req := sdk.NewCreateStreamlitRequest(id, rootLocation, d.Get("main_file").(string))
+	if v, ok := d.GetOk("imports"); ok {
+		req.WithImports(streamlitImports(v.(*schema.Set)))
+	}
 
 	if v, ok := d.GetOk("query_warehouse"); ok {
 		warehouseId, err := sdk.ParseAccountObjectIdentifier(v.(string))
@@ -231,7 +270,7 @@ func CreateContextStreamlit(ctx context.Context, d *schema.ResourceData, meta an
 		for i, v := range raw {
 			integrations[i] = sdk.NewAccountObjectIdentifier(v)
 		}
-		req.WithExternalAccessIntegrations(sdk.ExternalAccessIntegrationsRequest{
+		req.WithExternalAccessIntegrations(sdk.StreamlitExternalAccessIntegrationsRequest{
 			ExternalAccessIntegrations: integrations,
 		})
 	}
@@ -274,15 +313,18 @@ func ReadContextStreamlit(ctx context.Context, d *schema.ResourceData, meta any)
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	stageId, location, err := helpers.ParseRootLocation(streamlitDetails.RootLocation)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-	if err := d.Set("stage", stageId.FullyQualifiedName()); err != nil {
-		return diag.FromErr(err)
-	}
-	if err := d.Set("directory_location", location); err != nil {
-		return diag.FromErr(err)
+	// root location is not set for the streamlits created from a source location
+	if streamlitDetails.RootLocation != "" {
+		stageId, location, err := helpers.ParseRootLocation(streamlitDetails.RootLocation)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if err := d.Set("stage", stageId.FullyQualifiedName()); err != nil {
+			return diag.FromErr(err)
+		}
+		if err := d.Set("directory_location", location); err != nil {
+			return diag.FromErr(err)
+		}
 	}
 	if err := d.Set("main_file", streamlitDetails.MainFile); err != nil {
 		return diag.FromErr(err)
@@ -347,6 +389,12 @@ func UpdateContextStreamlit(ctx context.Context, d *schema.ResourceData, meta an
 		set.WithRootLocation(rootLocation)
 	}
 
+	if d.HasChange("imports") {
+		if v, ok := d.GetOk("imports"); ok {
+			set.WithImports(streamlitImports(v.(*schema.Set)))
+		}
+	}
+
 	if d.HasChange("main_file") {
 		// required field
 		set.WithMainFile(d.Get("main_file").(string))
@@ -398,12 +446,12 @@ func UpdateContextStreamlit(ctx context.Context, d *schema.ResourceData, meta an
 			}
 			integrations[i] = integrationId
 		}
-		set.WithExternalAccessIntegrations(sdk.ExternalAccessIntegrationsRequest{
+		set.WithExternalAccessIntegrations(sdk.StreamlitExternalAccessIntegrationsRequest{
 			ExternalAccessIntegrations: integrations,
 		})
 	}
 
-	if (*set != sdk.StreamlitSetRequest{}) {
+	if !reflect.DeepEqual(*set, sdk.StreamlitSetRequest{}) {
 		if err := client.Streamlits.Alter(ctx, sdk.NewAlterStreamlitRequest(id).WithSet(*set)); err != nil {
 			return diag.FromErr(err)
 		}
@@ -415,9 +463,45 @@ func UpdateContextStreamlit(ctx context.Context, d *schema.ResourceData, meta an
 		}
 	}
 
+	if d.HasChanges("source_location", "source_revision") {
+		if err := commitStreamlitVersionFromSourceLocation(ctx, client, id, d.Get("source_location").(string)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return ReadContextStreamlit(ctx, d, meta)
 }
 
+// commitStreamlitVersionFromSourceLocation commits a new version of the streamlit with the files copied from the given source location.
+func commitStreamlitVersionFromSourceLocation(ctx context.Context, client *sdk.Client, id sdk.SchemaObjectIdentifier, sourceLocation string) error {
+	details, err := client.Streamlits.Describe(ctx, id)
+	if err != nil {
+		return err
+	}
+	if details.LiveVersionLocationUri == "" {
+		if err := client.Streamlits.Alter(ctx, sdk.NewAlterStreamlitRequest(id).WithAddLiveVersionFromLast(true)); err != nil {
+			return err
+		}
+		details, err = client.Streamlits.Describe(ctx, id)
+		if err != nil {
+			return err
+		}
+	}
+	if err := client.Stages.CopyFiles(ctx, sdk.NewCopyFilesStageRequest(details.LiveVersionLocationUri, sourceLocation)); err != nil {
+		return err
+	}
+	return client.Streamlits.Alter(ctx, sdk.NewAlterStreamlitRequest(id).WithCommit(true))
+}
+
+func streamlitImports(imports *schema.Set) []sdk.StreamlitImportRequest {
+	raw := expandStringList(imports.List())
+	requests := make([]sdk.StreamlitImportRequest, len(raw))
+	for i, v := range raw {
+		requests[i] = *sdk.NewStreamlitImportRequest().WithImport(v)
+	}
+	return requests
+}
+
 func DeleteContextStreamlit(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	client := meta.(*provider.Context).Client
 	id, err := sdk.ParseSchemaObjectIdentifier(d.Id())
@@ -383,13 +383,93 @@ func TestAcc_Streamlit_Rename(t *testing.T) {
 	})
 }
 
+func TestAcc_Streamlit_SourceLocation(t *testing.T) {
+	_ = testenvs.GetOrSkipTest(t, testenvs.EnableAcceptance)
+	acc.TestAccPreCheck(t)
+
+	stage, stageCleanup := acc.TestClient().Stage.CreateStage(t)
+	t.Cleanup(stageCleanup)
+
+	id := acc.TestClient().Ids.RandomSchemaObjectIdentifier()
+	mainFile := "streamlit_app.py"
+	importLocation := fmt.Sprintf("%s/libs/lib.zip", stage.Location())
+
+	streamlitModel := model.Streamlit("test", id.DatabaseName(), mainFile, id.Name(), id.SchemaName()).
+		WithSourceLocation(stage.Location()).
+		WithImports(importLocation)
+	streamlitModelWithRevision := model.Streamlit("test", id.DatabaseName(), mainFile, id.Name(), id.SchemaName()).
+		WithSourceLocation(stage.Location()).
+		WithSourceRevision("v2").
+		WithImports(importLocation)
+	streamlitModelFromStage := model.StreamlitWithIds("test", id, mainFile, stage.ID())
+
+	var urlId string
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: acc.CheckDestroy(t, resources.Streamlit),
+		Steps: []resource.TestStep{
+			{
+				Config: accconfig.FromModels(t, streamlitModel),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(streamlitModel.ResourceReference(), "source_location", stage.Location()),
+					resource.TestCheckResourceAttr(streamlitModel.ResourceReference(), "stage", ""),
+					resource.TestCheckResourceAttr(streamlitModel.ResourceReference(), "imports.#", "1"),
+					resource.TestCheckResourceAttr(streamlitModel.ResourceReference(), "describe_output.0.root_location", ""),
+					resource.TestCheckResourceAttr(streamlitModel.ResourceReference(), "describe_output.0.main_file", mainFile),
+					resource.TestCheckResourceAttrWith(streamlitModel.ResourceReference(), "show_output.0.url_id", func(value string) error {
+						urlId = value
+						return nil
+					}),
+				),
+			},
+			// a new revision commits a new version without recreating the streamlit
+			{
+				Config: accconfig.FromModels(t, streamlitModelWithRevision),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(streamlitModelWithRevision.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(streamlitModelWithRevision.ResourceReference(), "source_revision", "v2"),
+					resource.TestCheckResourceAttrSet(streamlitModelWithRevision.ResourceReference(), "describe_output.0.live_version_location_uri"),
+					resource.TestCheckResourceAttrWith(streamlitModelWithRevision.ResourceReference(), "show_output.0.url_id", func(value string) error {
+						if value != urlId {
+							return fmt.Errorf("expected url_id to stay %s, got %s", urlId, value)
+						}
+						return nil
+					}),
+				),
+			},
+			// switching to the stage recreates the streamlit
+			{
+				Config: accconfig.FromModels(t, streamlitModelFromStage),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(streamlitModelFromStage.ResourceReference(), plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(streamlitModelFromStage.ResourceReference(), "stage", stage.ID().FullyQualifiedName()),
+					resource.TestCheckResourceAttr(streamlitModelFromStage.ResourceReference(), "source_location", ""),
+					resource.TestCheckResourceAttr(streamlitModelFromStage.ResourceReference(), "describe_output.0.root_location", stage.Location()),
+				),
+			},
+		},
+	})
+}
+
 func TestAcc_Streamlit_InvalidStage(t *testing.T) {
 	_ = testenvs.GetOrSkipTest(t, testenvs.EnableAcceptance)
 	acc.TestAccPreCheck(t)
 
 	id := acc.TestClient().Ids.RandomSchemaObjectIdentifier()
 
-	streamlitModel := model.Streamlit("test", id.DatabaseId().FullyQualifiedName(), "some", id.Name(), id.SchemaId().FullyQualifiedName(), "some")
+	streamlitModel := model.Streamlit("test", id.DatabaseId().FullyQualifiedName(), "some", id.Name(), id.SchemaId().FullyQualifiedName()).WithStage("some")
 
 	resource.Test(t, resource.TestCase{
 		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
@@ -458,7 +538,7 @@ func TestAcc_Streamlit_IdentifierQuotingDiffSuppression(t *testing.T) {
 	quotedSchemaName := fmt.Sprintf(`"%s"`, id.SchemaName())
 	quotedName := fmt.Sprintf(`"%s"`, id.Name())
 
-	streamlitModel := model.Streamlit("test", quotedDatabaseName, "main_file", quotedName, quotedSchemaName, stage.ID().FullyQualifiedName())
+	streamlitModel := model.Streamlit("test", quotedDatabaseName, "main_file", quotedName, quotedSchemaName).WithStage(stage.ID().FullyQualifiedName())
 
 	resource.Test(t, resource.TestCase{
 		PreCheck: func() { acc.TestAccPreCheck(t) },
@@ -5,10 +5,12 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"slices"
 	"strconv"
 	"strings"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
@@ -50,10 +52,11 @@ var tableSchema = map[string]*schema.Schema{
 		Description: "A list of one or more table columns/expressions to be used as clustering key(s) for the table",
 	},
 	"column": {
-		Type:        schema.TypeList,
-		Required:    true,
-		MinItems:    1,
-		Description: "Definitions of a column to create in the table. Minimum one required.",
+		Type:         schema.TypeList,
+		Optional:     true,
+		Computed:     true,
+		ExactlyOneOf: tableCreationModeAttributes,
+		Description:  "Definitions of a column to create in the table. Minimum one required, unless the table is created with one of the `from_clone`, `from_like`, `from_query`, or `from_template` fields; then, the columns are read from Snowflake after creation.",
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
 				"name": {
@@ -154,6 +157,12 @@ var tableSchema = map[string]*schema.Schema{
 					Computed:    true,
 					Description: "Record of schema evolution.",
 				},
+				"tag": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					Description: "Definitions of a tag to associate with the column.",
+					Elem:        tagReferenceSchema.Elem,
+				},
 				// TODO(SNOW-1348114): Consider adding fully_qualified_name for columns. Update the examples of referencing columns from other resources.
 			},
 		},
@@ -205,10 +214,161 @@ var tableSchema = map[string]*schema.Schema{
 		Default:     false,
 		Description: "Specifies whether to enable change tracking on the table. Default false.",
 	},
+	"row_access_policy": {
+		Type:     schema.TypeList,
+		MaxItems: 1,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"policy_name": {
+					Type:             schema.TypeString,
+					Required:         true,
+					DiffSuppressFunc: suppressIdentifierQuoting,
+					ValidateDiagFunc: IsValidIdentifier[sdk.SchemaObjectIdentifier](),
+					Description:      relatedResourceDescription("Row access policy name.", resources.RowAccessPolicy),
+				},
+				"on": {
+					Type:     schema.TypeSet,
+					Required: true,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+					Description: "Defines which columns are affected by the policy.",
+				},
+			},
+		},
+		Description: "Specifies the row access policy to set on a table.",
+	},
+	"search_optimization": {
+		Type:     schema.TypeList,
+		MaxItems: 1,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"on": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"method": {
+								Type:             schema.TypeString,
+								Required:         true,
+								ValidateDiagFunc: sdkValidation(sdk.ToSearchOptimizationMethod),
+								DiffSuppressFunc: NormalizeAndCompare(sdk.ToSearchOptimizationMethod),
+								Description:      fmt.Sprintf("Search method used for the target. Valid values are (case-insensitive): %s.", possibleValuesListed(sdk.AllSearchOptimizationMethods)),
+							},
+							"target": {
+								Type:        schema.TypeString,
+								Required:    true,
+								Description: "Column name (quoted automatically, like the columns of this resource) or `*` to apply the method to all eligible columns.",
+							},
+						},
+					},
+					Description: "Defines on which columns and with which methods search optimization is enabled. If not set, search optimization is enabled for the whole table (Snowflake then decides which columns are optimized).",
+				},
+			},
+		},
+		Description: "Specifies the search optimization on the table. External changes are detected using `SHOW TABLES` and `DESCRIBE SEARCH OPTIMIZATION` outputs. For more information, check [search optimization documentation](https://docs.snowflake.com/en/user-guide/search-optimization-service).",
+	},
+	"from_clone": {
+		Type:         schema.TypeList,
+		Optional:     true,
+		ForceNew:     true,
+		MaxItems:     1,
+		ExactlyOneOf: tableCreationModeAttributes,
+		Description:  externalChangesNotDetectedFieldDescription("Creates the table as a zero-copy clone of another table (`CREATE TABLE ... CLONE`), optionally at or before a Time Travel point. Changing this field recreates the table."),
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"source_table": {
+					Type:             schema.TypeString,
+					Required:         true,
+					ForceNew:         true,
+					Description:      relatedResourceDescription("Fully qualified name of the table to clone.", resources.Table),
+					DiffSuppressFunc: suppressIdentifierQuoting,
+					ValidateDiagFunc: IsValidIdentifier[sdk.SchemaObjectIdentifier](),
+				},
+				AtAttributeName:     cloneTimeTravelSchema("from_clone.0", AtAttributeName, BeforeAttributeName, "Clones the table as it was at the given point in time."),
+				BeforeAttributeName: cloneTimeTravelSchema("from_clone.0", BeforeAttributeName, AtAttributeName, "Clones the table as it was immediately before the given point in time."),
+			},
+		},
+	},
+	"from_like": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		ForceNew:         true,
+		ExactlyOneOf:     tableCreationModeAttributes,
+		Description:      externalChangesNotDetectedFieldDescription(relatedResourceDescription("Creates an empty copy of the given table, including its column definitions (`CREATE TABLE ... LIKE`). Changing this field recreates the table.", resources.Table)),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+		ValidateDiagFunc: IsValidIdentifier[sdk.SchemaObjectIdentifier](),
+	},
+	"from_query": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		ForceNew:         true,
+		ExactlyOneOf:     tableCreationModeAttributes,
+		Description:      externalChangesNotDetectedFieldDescription("Creates the table with the columns and the data returned by the given query (`CREATE TABLE ... AS SELECT`). Changing this field recreates the table."),
+		DiffSuppressFunc: DiffSuppressStatement,
+	},
+	"from_template": {
+		Type:         schema.TypeList,
+		Optional:     true,
+		ForceNew:     true,
+		MaxItems:     1,
+		ExactlyOneOf: tableCreationModeAttributes,
+		Description:  externalChangesNotDetectedFieldDescription("Creates the table with the column definitions derived from a set of staged files (`CREATE TABLE ... USING TEMPLATE`). Changing this field recreates the table."),
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"query": {
+					Type:             schema.TypeString,
+					Optional:         true,
+					ForceNew:         true,
+					ExactlyOneOf:     []string{"from_template.0.query", "from_template.0.infer_schema"},
+					Description:      "Query returning the column definitions as an array of objects, e.g. `SELECT ARRAY_AGG(OBJECT_CONSTRUCT(*)) FROM TABLE(INFER_SCHEMA(...))`.",
+					DiffSuppressFunc: DiffSuppressStatement,
+				},
+				"infer_schema": {
+					Type:         schema.TypeList,
+					Optional:     true,
+					ForceNew:     true,
+					MaxItems:     1,
+					ExactlyOneOf: []string{"from_template.0.query", "from_template.0.infer_schema"},
+					Description:  "Derives the column definitions with the [INFER_SCHEMA](https://docs.snowflake.com/en/sql-reference/functions/infer_schema) function.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"location": {
+								Type:        schema.TypeString,
+								Required:    true,
+								ForceNew:    true,
+								Description: "Name of the stage (with an optional path) where the files are stored, e.g. `@\"DATABASE\".\"SCHEMA\".\"STAGE\"/path/`.",
+							},
+							"file_format": {
+								Type:             schema.TypeString,
+								Required:         true,
+								ForceNew:         true,
+								Description:      relatedResourceDescription("Fully qualified name of the file format describing the staged files.", resources.FileFormat),
+								DiffSuppressFunc: suppressIdentifierQuoting,
+								ValidateDiagFunc: IsValidIdentifier[sdk.SchemaObjectIdentifier](),
+							},
+							"ignore_case": {
+								Type:        schema.TypeBool,
+								Optional:    true,
+								ForceNew:    true,
+								Default:     false,
+								Description: "Specifies whether the column names detected from the files are treated as case-insensitive.",
+							},
+						},
+					},
+				},
+			},
+		},
+	},
 	"tag":                           tagReferenceSchema,
 	FullyQualifiedNameAttributeName: schemas.FullyQualifiedNameSchema,
 }
 
+// tableCreationModeAttributes lists the mutually exclusive ways of defining the table structure on creation.
+var tableCreationModeAttributes = []string{"column", "from_clone", "from_like", "from_query", "from_template"}
+
 func Table() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: PreviewFeatureCreateContextWrapper(string(previewfeatures.TableResource), TrackingCreateWrapper(resources.Table, CreateTable)),
@@ -264,6 +424,7 @@ type column struct {
 	comment       string
 	maskingPolicy string
 	collate       string
+	tags          tags
 }
 
 type columns []column
@@ -278,13 +439,15 @@ type changedColumn struct {
 	changedComment        bool
 	changedMaskingPolicy  bool
 	changedCollate        bool
+	unsetTags             []sdk.ObjectIdentifier
+	setTags               []sdk.TagAssociation
 }
 
 func (c columns) getChangedColumnProperties(new columns) (changed changedColumns) {
 	changed = changedColumns{}
 	for _, cO := range c {
 		for _, cN := range new {
-			changeColumn := changedColumn{cN, false, false, false, false, false, false}
+			changeColumn := changedColumn{newColumn: cN}
 			if cO.name == cN.name && cO.dataType != cN.dataType {
 				changeColumn.changedDataType = true
 			}
@@ -307,6 +470,10 @@ func (c columns) getChangedColumnProperties(new columns) (changed changedColumns
 				changeColumn.changedCollate = true
 			}
 
+			if cO.name == cN.name {
+				changeColumn.unsetTags, changeColumn.setTags = getTagsDiffFromLists(cO.tags, cN.tags)
+			}
+
 			changed = append(changed, changeColumn)
 		}
 	}
@@ -379,6 +546,7 @@ func getColumn(from interface{}) (to column) {
 		comment:       c["comment"].(string),
 		collate:       c["collate"].(string),
 		maskingPolicy: c["masking_policy"].(string),
+		tags:          getTags(c["tag"]),
 	}
 }
 
@@ -446,6 +614,10 @@ func getTableColumnRequest(from interface{}) (*sdk.TableColumnRequest, error) {
 		request.WithCollate(sdk.String(c["collate"].(string)))
 	}
 
+	if tags := getTagsFromList(c["tag"].([]any)); len(tags) > 0 {
+		request.WithTags(tags)
+	}
+
 	return request.
 		WithNotNull(sdk.Bool(!c["nullable"].(bool))).
 		WithComment(sdk.String(c["comment"].(string))), nil
@@ -525,6 +697,36 @@ func toColumnConfig(descriptions []sdk.TableColumnDetails) []any {
 	return flattened
 }
 
+// readColumnTags reads the values of the tags tracked in the state for each column, because they are not returned by DESCRIBE TABLE.
+// Tags unset outside of Terraform are removed from the column and the changed values are replaced, so that the drift is visible in the plan.
+func readColumnTags(ctx context.Context, client *sdk.Client, id sdk.SchemaObjectIdentifier, flattened []any, current []any) ([]any, error) {
+	tagsByColumn := make(map[string][]any)
+	for _, c := range current {
+		if c == nil {
+			continue
+		}
+		column := c.(map[string]any)
+		if columnTags, ok := column["tag"].([]any); ok {
+			tagsByColumn[column["name"].(string)] = columnTags
+		}
+	}
+	for _, c := range flattened {
+		column := c.(map[string]any)
+		columnName := column["name"].(string)
+		columnTags, ok := tagsByColumn[columnName]
+		if !ok {
+			continue
+		}
+		columnId := sdk.NewTableColumnIdentifier(id.DatabaseName(), id.SchemaName(), id.Name(), columnName)
+		readTags, err := readTagReferences(ctx, client, columnTags, columnId, sdk.ObjectTypeColumn)
+		if err != nil {
+			return nil, fmt.Errorf("reading tags for column %s: %w", columnName, err)
+		}
+		column["tag"] = readTags
+	}
+	return flattened, nil
+}
+
 func toColumnDefaultConfig(td sdk.TableColumnDetails) map[string]any {
 	if td.Default == nil {
 		return nil
@@ -588,6 +790,16 @@ func CreateTable(ctx context.Context, d *schema.ResourceData, meta any) diag.Dia
 	name := d.Get("name").(string)
 	id := sdk.NewSchemaObjectIdentifier(databaseName, schemaName, name)
 
+	if createdFromSource, err := createTableFromSource(ctx, client, d, id); err != nil {
+		return diag.FromErr(fmt.Errorf("error creating table %v err = %w", name, err))
+	} else if createdFromSource {
+		d.SetId(helpers.EncodeSnowflakeID(id))
+		if err := applyTableSettingsAfterCreation(ctx, client, d, id); err != nil {
+			return diag.FromErr(fmt.Errorf("error setting up table %v err = %w", name, err))
+		}
+		return ReadTable(ctx, d, meta)
+	}
+
 	tableColumnRequests, err := getTableColumnRequests(d.Get("column").([]interface{}))
 	if err != nil {
 		return diag.FromErr(err)
@@ -634,6 +846,14 @@ func CreateTable(ctx context.Context, d *schema.ResourceData, meta any) diag.Dia
 		createRequest.WithTags(tagAssociationRequests)
 	}
 
+	if v := d.Get("row_access_policy"); len(v.([]any)) > 0 {
+		policyId, policyColumns, err := extractTablePolicyWithColumns(v)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		createRequest.WithRowAccessPolicy(sdk.NewRowAccessPolicyRequest(policyId, policyColumns))
+	}
+
 	err = client.Tables.Create(ctx, createRequest)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("error creating table %v err = %w", name, err))
@@ -641,9 +861,167 @@ func CreateTable(ctx context.Context, d *schema.ResourceData, meta any) diag.Dia
 
 	d.SetId(helpers.EncodeSnowflakeID(id))
 
+	if v := d.Get("search_optimization"); len(v.([]any)) > 0 {
+		if err := addTableSearchOptimization(ctx, client, id, getSearchOptimizationExpressions(v)); err != nil {
+			return diag.FromErr(fmt.Errorf("error adding search optimization to table %v err = %w", name, err))
+		}
+	}
+
 	return ReadTable(ctx, d, meta)
 }
 
+// createTableFromSource creates the table using one of the from_clone, from_like, from_query, and from_template fields.
+// It returns false when none of them is set, meaning that the table should be created from the column definitions.
+func createTableFromSource(ctx context.Context, client *sdk.Client, d *schema.ResourceData, id sdk.SchemaObjectIdentifier) (bool, error) {
+	if v, ok := d.GetOk("from_clone"); ok {
+		cloneConfig := v.([]any)[0].(map[string]any)
+		sourceId, err := sdk.ParseSchemaObjectIdentifier(cloneConfig["source_table"].(string))
+		if err != nil {
+			return true, err
+		}
+		request := sdk.NewCreateTableCloneRequest(id, sourceId)
+		at, err := getCloneTimeTravel(cloneConfig[AtAttributeName])
+		if err != nil {
+			return true, err
+		}
+		before, err := getCloneTimeTravel(cloneConfig[BeforeAttributeName])
+		if err != nil {
+			return true, err
+		}
+		switch {
+		case at != nil:
+			request.WithClonePoint(sdk.NewClonePointRequest().WithMoment(sdk.CloneMomentAt).WithAt(sdk.TimeTravelRequest{Timestamp: at.Timestamp, Offset: at.Offset, Statement: at.Statement}))
+		case before != nil:
+			request.WithClonePoint(sdk.NewClonePointRequest().WithMoment(sdk.CloneMomentBefore).WithAt(sdk.TimeTravelRequest{Timestamp: before.Timestamp, Offset: before.Offset, Statement: before.Statement}))
+		}
+		return true, client.Tables.CreateClone(ctx, request)
+	}
+
+	if v, ok := d.GetOk("from_like"); ok {
+		sourceId, err := sdk.ParseSchemaObjectIdentifier(v.(string))
+		if err != nil {
+			return true, err
+		}
+		return true, client.Tables.CreateLike(ctx, sdk.NewCreateTableLikeRequest(id, sourceId))
+	}
+
+	if v, ok := d.GetOk("from_query"); ok {
+		return true, client.Tables.CreateAsSelect(ctx, sdk.NewCreateTableAsSelectRequest(id, nil, v.(string)))
+	}
+
+	if v, ok := d.GetOk("from_template"); ok {
+		templateConfig := v.([]any)[0].(map[string]any)
+		query := templateConfig["query"].(string)
+		if inferSchema := templateConfig["infer_schema"].([]any); len(inferSchema) > 0 {
+			inferSchemaConfig := inferSchema[0].(map[string]any)
+			fileFormatId, err := sdk.ParseSchemaObjectIdentifier(inferSchemaConfig["file_format"].(string))
+			if err != nil {
+				return true, err
+			}
+			query = inferSchemaTemplateQuery(inferSchemaConfig["location"].(string), fileFormatId, inferSchemaConfig["ignore_case"].(bool))
+		}
+		return true, client.Tables.CreateUsingTemplate(ctx, sdk.NewCreateTableUsingTemplateRequest(id, query))
+	}
+
+	return false, nil
+}
+
+// inferSchemaTemplateQuery returns the query used in USING TEMPLATE to derive the columns from the staged files, as described in https://docs.snowflake.com/en/sql-reference/functions/infer_schema.
+func inferSchemaTemplateQuery(location string, fileFormatId sdk.SchemaObjectIdentifier, ignoreCase bool) string {
+	return fmt.Sprintf(
+		"SELECT ARRAY_AGG(OBJECT_CONSTRUCT(*)) WITHIN GROUP (ORDER BY ORDER_ID) FROM TABLE(INFER_SCHEMA(LOCATION => '%s', FILE_FORMAT => '%s', IGNORE_CASE => %t))",
+		snowflake.EscapeString(location), snowflake.EscapeString(fileFormatId.FullyQualifiedName()), ignoreCase,
+	)
+}
+
+// applyTableSettingsAfterCreation sets the properties that can't be specified in the CREATE TABLE variants with a source (clone, LIKE, CTAS, and USING TEMPLATE).
+// The properties inherited from the source (e.g. by a clone) are reconciled with the configuration: the ones missing in the configuration are unset.
+func applyTableSettingsAfterCreation(ctx context.Context, client *sdk.Client, d *schema.ResourceData, id sdk.SchemaObjectIdentifier) error {
+	table, err := client.Tables.ShowByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	var runSetStatement, runUnsetStatement bool
+	setRequest := sdk.NewTableSetRequest()
+	unsetRequest := sdk.NewTableUnsetRequest()
+	if v, ok := d.GetOk("comment"); ok {
+		runSetStatement = true
+		setRequest.WithComment(sdk.String(v.(string)))
+	} else if table.Comment != "" {
+		runUnsetStatement = true
+		unsetRequest.WithComment(true)
+	}
+	if v := d.Get("change_tracking").(bool); v != table.ChangeTracking {
+		runSetStatement = true
+		setRequest.WithChangeTracking(sdk.Bool(v))
+	}
+	if v := d.Get("data_retention_time_in_days"); v.(int) != IntDefault {
+		runSetStatement = true
+		setRequest.WithDataRetentionTimeInDays(sdk.Int(v.(int)))
+	} else {
+		s, err := client.Schemas.ShowByID(ctx, id.SchemaId())
+		if err != nil {
+			return err
+		}
+		if strconv.Itoa(table.RetentionTime) != s.RetentionTime {
+			runUnsetStatement = true
+			unsetRequest.WithDataRetentionTimeInDays(true)
+		}
+	}
+	if runSetStatement {
+		if err := client.Tables.Alter(ctx, sdk.NewAlterTableRequest(id).WithSet(setRequest)); err != nil {
+			return err
+		}
+	}
+	if runUnsetStatement {
+		if err := client.Tables.Alter(ctx, sdk.NewAlterTableRequest(id).WithUnset(unsetRequest)); err != nil {
+			return err
+		}
+	}
+
+	if v, ok := d.GetOk("cluster_by"); ok {
+		if err := client.Tables.Alter(ctx, sdk.NewAlterTableRequest(id).WithClusteringAction(sdk.NewTableClusteringActionRequest().WithClusterBy(expandStringList(v.([]any))))); err != nil {
+			return err
+		}
+	} else if len(table.GetClusterByKeys()) > 0 {
+		if err := client.Tables.Alter(ctx, sdk.NewAlterTableRequest(id).WithClusteringAction(sdk.NewTableClusteringActionRequest().WithDropClusteringKey(sdk.Bool(true)))); err != nil {
+			return err
+		}
+	}
+
+	if v, ok := d.GetOk("primary_key"); ok {
+		if err := addTablePrimaryKeyAfterCreation(ctx, client, id, getPrimaryKey(v)); err != nil {
+			return err
+		}
+	}
+
+	if _, ok := d.GetOk("tag"); ok {
+		tagAssociations := getPropertyTags(d, "tag")
+		tagAssociationRequests := make([]sdk.TagAssociationRequest, len(tagAssociations))
+		for i, t := range tagAssociations {
+			tagAssociationRequests[i] = *sdk.NewTagAssociationRequest(t.Name, t.Value)
+		}
+		if err := client.Tables.Alter(ctx, sdk.NewAlterTableRequest(id).WithSetTags(tagAssociationRequests)); err != nil {
+			return err
+		}
+	}
+
+	if v := d.Get("row_access_policy"); len(v.([]any)) > 0 {
+		if err := addTableRowAccessPolicyAfterCreation(ctx, client, id, v); err != nil {
+			return err
+		}
+	}
+
+	if v := d.Get("search_optimization"); len(v.([]any)) > 0 {
+		if err := addTableSearchOptimization(ctx, client, id, getSearchOptimizationExpressions(v)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // ReadTable implements schema.ReadFunc.
 func ReadTable(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	client := meta.(*provider.Context).Client
@@ -693,6 +1071,11 @@ func ReadTable(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagn
 		return diag.FromErr(err)
 	}
 
+	columns, err := readColumnTags(ctx, client, id, toColumnConfig(tableDescription), d.Get("column").([]any))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	// Set the relevant data in the state
 	toSet := map[string]interface{}{
 		"name":            table.Name,
@@ -700,7 +1083,7 @@ func ReadTable(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagn
 		"database":        table.DatabaseName,
 		"schema":          table.SchemaName,
 		"comment":         table.Comment,
-		"column":          toColumnConfig(tableDescription),
+		"column":          columns,
 		"cluster_by":      table.GetClusterByKeys(),
 		"change_tracking": table.ChangeTracking,
 	}
@@ -708,6 +1091,18 @@ func ReadTable(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagn
 		toSet["data_retention_time_in_days"] = table.RetentionTime
 	}
 
+	policyRefs, err := client.PolicyReferences.GetForEntity(ctx, sdk.NewGetForEntityPolicyReferenceRequest(id, sdk.PolicyEntityDomainTable))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("getting policy references for table: %w", err))
+	}
+	toSet["row_access_policy"] = toTableRowAccessPolicyConfig(policyRefs)
+
+	searchOptimization, err := readTableSearchOptimization(ctx, client, d, table)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	toSet["search_optimization"] = searchOptimization
+
 	for key, val := range toSet {
 		if err := d.Set(key, val); err != nil { // lintignore:R001
 			return diag.FromErr(err)
@@ -844,6 +1239,11 @@ func UpdateTable(ctx context.Context, d *schema.ResourceData, meta any) diag.Dia
 				addRequest.WithCollate(sdk.String(cA.collate))
 			}
 
+			if len(cA.tags) > 0 {
+				_, columnTags := getTagsDiffFromLists(nil, cA.tags)
+				addRequest.WithTags(columnTags)
+			}
+
 			err := client.Tables.Alter(ctx, sdk.NewAlterTableRequest(id).WithColumnAction(sdk.NewTableColumnActionRequest().WithAdd(addRequest)))
 			if err != nil {
 				return diag.FromErr(fmt.Errorf("error adding column: %w", err))
@@ -891,6 +1291,18 @@ func UpdateTable(ctx context.Context, d *schema.ResourceData, meta any) diag.Dia
 					return diag.FromErr(fmt.Errorf("error changing property on %v: err %w", d.Id(), err))
 				}
 			}
+			if len(cA.unsetTags) > 0 {
+				err := client.Tables.Alter(ctx, sdk.NewAlterTableRequest(id).WithColumnAction(sdk.NewTableColumnActionRequest().WithUnsetTags(sdk.NewTableColumnAlterUnsetTagsActionRequest(fmt.Sprintf("\"%s\"", cA.newColumn.name), cA.unsetTags))))
+				if err != nil {
+					return diag.FromErr(fmt.Errorf("error unsetting tags on column %v of %v: err %w", cA.newColumn.name, d.Id(), err))
+				}
+			}
+			if len(cA.setTags) > 0 {
+				err := client.Tables.Alter(ctx, sdk.NewAlterTableRequest(id).WithColumnAction(sdk.NewTableColumnActionRequest().WithSetTags(sdk.NewTableColumnAlterSetTagsActionRequest(fmt.Sprintf("\"%s\"", cA.newColumn.name), cA.setTags))))
+				if err != nil {
+					return diag.FromErr(fmt.Errorf("error setting tags on column %v of %v: err %w", cA.newColumn.name, d.Id(), err))
+				}
+			}
 			if cA.changedMaskingPolicy {
 				columnAction := sdk.NewTableColumnActionRequest()
 				if strings.TrimSpace(cA.newColumn.maskingPolicy) == "" {
@@ -959,6 +1371,81 @@ func UpdateTable(ctx context.Context, d *schema.ResourceData, meta any) diag.Dia
 		}
 	}
 
+	if d.HasChange("row_access_policy") {
+		req := sdk.NewAlterTableRequest(id)
+		oldRaw, newRaw := d.GetChange("row_access_policy")
+		var dropPolicyId, addPolicyId *sdk.SchemaObjectIdentifier
+		var addPolicyColumns []string
+		if len(oldRaw.([]any)) > 0 {
+			oldId, _, err := extractTablePolicyWithColumns(oldRaw)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			dropPolicyId = &oldId
+		}
+		if len(newRaw.([]any)) > 0 {
+			newId, newColumns, err := extractTablePolicyWithColumns(newRaw)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			addPolicyId, addPolicyColumns = &newId, newColumns
+		}
+		switch {
+		case dropPolicyId != nil && addPolicyId != nil:
+			req.WithDropAndAddRowAccessPolicy(&sdk.TableDropAndAddRowAccessPolicy{
+				Drop: sdk.TableDropRowAccessPolicy{RowAccessPolicy: *dropPolicyId},
+				Add:  sdk.TableAddRowAccessPolicy{RowAccessPolicy: *addPolicyId, On: addPolicyColumns},
+			})
+		case addPolicyId != nil:
+			req.WithAddRowAccessPolicy(sdk.NewTableAddRowAccessPolicyRequest(*addPolicyId, addPolicyColumns))
+		case dropPolicyId != nil:
+			req.WithDropRowAccessPolicy(sdk.NewTableDropRowAccessPolicyRequest(*dropPolicyId))
+		}
+		if err := client.Tables.Alter(ctx, req); err != nil {
+			return diag.FromErr(fmt.Errorf("error altering row_access_policy for table %v: %w", d.Id(), err))
+		}
+	}
+
+	if d.HasChange("search_optimization") {
+		oldRaw, newRaw := d.GetChange("search_optimization")
+		switch {
+		case len(newRaw.([]any)) == 0:
+			err := client.Tables.Alter(ctx, sdk.NewAlterTableRequest(id).WithSearchOptimizationAction(sdk.NewTableSearchOptimizationActionRequest().WithDropSearchOptimization(sdk.Bool(true))))
+			if err != nil {
+				return diag.FromErr(fmt.Errorf("error dropping search optimization on table %v: %w", d.Id(), err))
+			}
+		case len(oldRaw.([]any)) == 0:
+			if err := addTableSearchOptimization(ctx, client, id, getSearchOptimizationExpressions(newRaw)); err != nil {
+				return diag.FromErr(fmt.Errorf("error adding search optimization on table %v: %w", d.Id(), err))
+			}
+		default:
+			oldExpressions, newExpressions := getSearchOptimizationExpressions(oldRaw), getSearchOptimizationExpressions(newRaw)
+			if len(newExpressions) == 0 || len(oldExpressions) == 0 {
+				// switching between the whole table and the specific expressions requires starting from scratch
+				err := client.Tables.Alter(ctx, sdk.NewAlterTableRequest(id).WithSearchOptimizationAction(sdk.NewTableSearchOptimizationActionRequest().WithDropSearchOptimization(sdk.Bool(true))))
+				if err != nil {
+					return diag.FromErr(fmt.Errorf("error dropping search optimization on table %v: %w", d.Id(), err))
+				}
+				if err := addTableSearchOptimization(ctx, client, id, newExpressions); err != nil {
+					return diag.FromErr(fmt.Errorf("error adding search optimization on table %v: %w", d.Id(), err))
+				}
+			} else {
+				added, removed := ListDiff(oldExpressions, newExpressions)
+				if len(removed) > 0 {
+					err := client.Tables.Alter(ctx, sdk.NewAlterTableRequest(id).WithSearchOptimizationAction(sdk.NewTableSearchOptimizationActionRequest().WithDropSearchOptimizationOn(removed)))
+					if err != nil {
+						return diag.FromErr(fmt.Errorf("error dropping search optimization on table %v: %w", d.Id(), err))
+					}
+				}
+				if len(added) > 0 {
+					if err := addTableSearchOptimization(ctx, client, id, added); err != nil {
+						return diag.FromErr(fmt.Errorf("error adding search optimization on table %v: %w", d.Id(), err))
+					}
+				}
+			}
+		}
+	}
+
 	return ReadTable(ctx, d, meta)
 }
 
@@ -977,3 +1464,178 @@ func DeleteTable(ctx context.Context, d *schema.ResourceData, meta any) diag.Dia
 
 	return nil
 }
+
+// addTablePrimaryKeyAfterCreation adds the configured primary key to a table created from a source.
+// A clone or a table created with LIKE already has the primary key of the source. It is kept when it matches the configuration, and replaced otherwise.
+func addTablePrimaryKeyAfterCreation(ctx context.Context, client *sdk.Client, id sdk.SchemaObjectIdentifier, primaryKey primarykey) error {
+	primaryKeyColumns, err := client.Tables.ShowPrimaryKeys(ctx, sdk.NewShowPrimaryKeysRequest(id))
+	if err != nil {
+		return err
+	}
+	if len(primaryKeyColumns) > 0 {
+		slices.SortFunc(primaryKeyColumns, func(a, b sdk.TablePrimaryKeyColumn) int { return a.KeySequence - b.KeySequence })
+		existingKeys := collections.Map(primaryKeyColumns, func(c sdk.TablePrimaryKeyColumn) string { return c.ColumnName })
+		if slices.Equal(existingKeys, primaryKey.keys) && (primaryKey.name == "" || primaryKey.name == primaryKeyColumns[0].ConstraintName) {
+			return nil
+		}
+		if err := client.Tables.Alter(ctx, sdk.NewAlterTableRequest(id).WithConstraintAction(
+			sdk.NewTableConstraintActionRequest().WithDrop(sdk.NewTableConstraintDropActionRequest().WithPrimaryKey(sdk.Bool(true))),
+		)); err != nil {
+			return err
+		}
+	}
+	constraint := sdk.NewOutOfLineConstraintRequest(sdk.ColumnConstraintTypePrimaryKey).WithColumns(snowflake.QuoteStringList(primaryKey.keys))
+	if primaryKey.name != "" {
+		constraint.WithName(sdk.String(primaryKey.name))
+	}
+	return client.Tables.Alter(ctx, sdk.NewAlterTableRequest(id).WithConstraintAction(sdk.NewTableConstraintActionRequest().WithAdd(constraint)))
+}
+
+// addTableRowAccessPolicyAfterCreation attaches the configured row access policy to a table created from a source.
+// A cloned table keeps the row access policy of the source table, so the policy is added only when it is not attached yet,
+// and it replaces the one inherited from the source otherwise.
+func addTableRowAccessPolicyAfterCreation(ctx context.Context, client *sdk.Client, id sdk.SchemaObjectIdentifier, v any) error {
+	policyId, policyColumns, err := extractTablePolicyWithColumns(v)
+	if err != nil {
+		return err
+	}
+	policyRefs, err := client.PolicyReferences.GetForEntity(ctx, sdk.NewGetForEntityPolicyReferenceRequest(id, sdk.PolicyEntityDomainTable))
+	if err != nil {
+		return fmt.Errorf("getting policy references for table: %w", err)
+	}
+	request := sdk.NewAlterTableRequest(id).WithAddRowAccessPolicy(sdk.NewTableAddRowAccessPolicyRequest(policyId, policyColumns))
+	for _, p := range policyRefs {
+		if p.PolicyKind != sdk.PolicyKindRowAccessPolicy || p.PolicyDb == nil || p.PolicySchema == nil {
+			continue
+		}
+		attachedPolicyId := sdk.NewSchemaObjectIdentifier(*p.PolicyDb, *p.PolicySchema, p.PolicyName)
+		var attachedColumns []string
+		if p.RefArgColumnNames != nil {
+			attachedColumns = snowflake.QuoteStringList(sdk.ParseCommaSeparatedStringArray(*p.RefArgColumnNames, true))
+		}
+		if attachedPolicyId.FullyQualifiedName() == policyId.FullyQualifiedName() && slices.Equal(slices.Sorted(slices.Values(attachedColumns)), slices.Sorted(slices.Values(policyColumns))) {
+			return nil
+		}
+		request = sdk.NewAlterTableRequest(id).WithDropAndAddRowAccessPolicy(&sdk.TableDropAndAddRowAccessPolicy{
+			Drop: sdk.TableDropRowAccessPolicy{RowAccessPolicy: attachedPolicyId},
+			Add:  sdk.TableAddRowAccessPolicy{RowAccessPolicy: policyId, On: policyColumns},
+		})
+	}
+	return client.Tables.Alter(ctx, request)
+}
+
+func extractTablePolicyWithColumns(v any) (sdk.SchemaObjectIdentifier, []string, error) {
+	policyConfig := v.([]any)[0].(map[string]any)
+	id, err := sdk.ParseSchemaObjectIdentifier(policyConfig["policy_name"].(string))
+	if err != nil {
+		return sdk.SchemaObjectIdentifier{}, nil, err
+	}
+	return id, snowflake.QuoteStringList(expandStringList(policyConfig["on"].(*schema.Set).List())), nil
+}
+
+func toTableRowAccessPolicyConfig(policyRefs []sdk.PolicyReference) []map[string]any {
+	var rowAccessPolicies []map[string]any
+	for _, p := range policyRefs {
+		if p.PolicyKind != sdk.PolicyKindRowAccessPolicy {
+			continue
+		}
+		var on []string
+		if p.RefArgColumnNames != nil {
+			on = sdk.ParseCommaSeparatedStringArray(*p.RefArgColumnNames, true)
+		}
+		rowAccessPolicies = append(rowAccessPolicies, map[string]any{
+			"policy_name": sdk.NewSchemaObjectIdentifier(*p.PolicyDb, *p.PolicySchema, p.PolicyName).FullyQualifiedName(),
+			"on":          on,
+		})
+	}
+	return rowAccessPolicies
+}
+
+// getSearchOptimizationExpressions returns search optimization expressions (e.g. EQUALITY("column")) from the search_optimization block.
+// An empty result means that the search optimization is configured for the whole table.
+func getSearchOptimizationExpressions(v any) []string {
+	searchOptimization := v.([]any)
+	if len(searchOptimization) == 0 || searchOptimization[0] == nil {
+		return nil
+	}
+	on := searchOptimization[0].(map[string]any)["on"].(*schema.Set).List()
+	expressions := make([]string, len(on))
+	for i, o := range on {
+		method, _ := sdk.ToSearchOptimizationMethod(o.(map[string]any)["method"].(string))
+		expressions[i] = searchOptimizationExpression(method, o.(map[string]any)["target"].(string))
+	}
+	return expressions
+}
+
+func searchOptimizationExpression(method sdk.SearchOptimizationMethod, target string) string {
+	if target != "*" {
+		target = fmt.Sprintf(`"%v"`, snowflake.EscapeString(target))
+	}
+	return fmt.Sprintf("%s(%s)", method, target)
+}
+
+func addTableSearchOptimization(ctx context.Context, client *sdk.Client, id sdk.SchemaObjectIdentifier, expressions []string) error {
+	request := sdk.NewTableSearchOptimizationActionRequest()
+	if len(expressions) > 0 {
+		request.WithAddSearchOptimizationOn(expressions)
+	} else {
+		request.WithAddSearchOptimization(sdk.Bool(true))
+	}
+	return client.Tables.Alter(ctx, sdk.NewAlterTableRequest(id).WithSearchOptimizationAction(request))
+}
+
+func readTableSearchOptimization(ctx context.Context, client *sdk.Client, d *schema.ResourceData, table *sdk.Table) ([]any, error) {
+	if !table.SearchOptimization {
+		return nil, nil
+	}
+	// search optimization enabled for the whole table is reflected as all the optimized columns in the DESCRIBE output,
+	// so there is nothing more to compare
+	if v := d.Get("search_optimization"); len(v.([]any)) > 0 && len(getSearchOptimizationExpressions(v)) == 0 {
+		return []any{map[string]any{"on": []any{}}}, nil
+	}
+	details, err := client.Tables.DescribeSearchOptimization(ctx, sdk.NewDescribeSearchOptimizationRequest(table.ID()))
+	if err != nil {
+		return nil, fmt.Errorf("describing search optimization for table: %w", err)
+	}
+	on := make([]any, len(details))
+	for i, detail := range details {
+		on[i] = map[string]any{
+			"method": string(detail.Method),
+			"target": strings.Trim(detail.Target, `"`),
+		}
+	}
+	var configured []any
+	if v := d.Get("search_optimization").([]any); len(v) > 0 && v[0] != nil {
+		configured = v[0].(map[string]any)["on"].(*schema.Set).List()
+	}
+	return []any{map[string]any{"on": collapseSearchOptimizationTargets(on, configured)}}, nil
+}
+
+// collapseSearchOptimizationTargets replaces the per-column targets returned by DESCRIBE SEARCH OPTIMIZATION with "*"
+// for the methods configured with the "*" target (e.g. EQUALITY(*) is described as EQUALITY on every supported column).
+// The targets configured explicitly next to "*" for the same method are kept.
+func collapseSearchOptimizationTargets(on []any, configured []any) []any {
+	wildcardMethods := make(map[string]bool)
+	explicitTargets := make(map[string]bool)
+	for _, c := range configured {
+		method, target := c.(map[string]any)["method"].(string), c.(map[string]any)["target"].(string)
+		if target == "*" {
+			wildcardMethods[strings.ToUpper(method)] = true
+		} else {
+			explicitTargets[strings.ToUpper(method)+"("+target+")"] = true
+		}
+	}
+	collapsed := make([]any, 0, len(on))
+	collapsedMethods := make(map[string]bool)
+	for _, o := range on {
+		method, target := o.(map[string]any)["method"].(string), o.(map[string]any)["target"].(string)
+		switch {
+		case !wildcardMethods[method], explicitTargets[method+"("+target+")"]:
+			collapsed = append(collapsed, o)
+		case !collapsedMethods[method]:
+			collapsedMethods[method] = true
+			collapsed = append(collapsed, map[string]any{"method": method, "target": "*"})
+		}
+	}
+	return collapsed
+}
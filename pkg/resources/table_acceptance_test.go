@@ -2295,3 +2295,431 @@ func TestAcc_Table_SchemaRemovedExternally(t *testing.T) {
 		},
 	})
 }
+
+func TestAcc_Table_RowAccessPolicy(t *testing.T) {
+	_ = testenvs.GetOrSkipTest(t, testenvs.EnableAcceptance)
+	acc.TestAccPreCheck(t)
+
+	rowAccessPolicy, rowAccessPolicyCleanup := acc.TestClient().RowAccessPolicy.CreateRowAccessPolicyWithDataType(t, sdk.DataTypeVARCHAR)
+	t.Cleanup(rowAccessPolicyCleanup)
+
+	rowAccessPolicy2, rowAccessPolicy2Cleanup := acc.TestClient().RowAccessPolicy.CreateRowAccessPolicyWithDataType(t, sdk.DataTypeVARCHAR)
+	t.Cleanup(rowAccessPolicy2Cleanup)
+
+	tableId := acc.TestClient().Ids.RandomSchemaObjectIdentifier()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: acc.CheckDestroy(t, resources.Table),
+		Steps: []resource.TestStep{
+			{
+				Config: tableWithRowAccessPolicy(tableId, rowAccessPolicy.ID()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_table.test_table", "row_access_policy.#", "1"),
+					resource.TestCheckResourceAttr("snowflake_table.test_table", "row_access_policy.0.policy_name", rowAccessPolicy.ID().FullyQualifiedName()),
+					resource.TestCheckResourceAttr("snowflake_table.test_table", "row_access_policy.0.on.#", "1"),
+					resource.TestCheckResourceAttr("snowflake_table.test_table", "row_access_policy.0.on.0", "column1"),
+				),
+			},
+			{
+				Config: tableWithRowAccessPolicy(tableId, rowAccessPolicy2.ID()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_table.test_table", "row_access_policy.#", "1"),
+					resource.TestCheckResourceAttr("snowflake_table.test_table", "row_access_policy.0.policy_name", rowAccessPolicy2.ID().FullyQualifiedName()),
+				),
+			},
+			{
+				Config: tableWithVarcharColumn(tableId),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_table.test_table", "row_access_policy.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func tableWithRowAccessPolicy(tableId sdk.SchemaObjectIdentifier, rowAccessPolicyId sdk.SchemaObjectIdentifier) string {
+	return fmt.Sprintf(`
+resource "snowflake_table" "test_table" {
+	database = "%[1]s"
+	schema   = "%[2]s"
+	name     = "%[3]s"
+	comment  = "Terraform acceptance test"
+
+	column {
+		name = "column1"
+		type = "VARCHAR(16)"
+	}
+
+	row_access_policy {
+		policy_name = %[4]s
+		on          = ["column1"]
+	}
+}
+`, tableId.DatabaseName(), tableId.SchemaName(), tableId.Name(), strconv.Quote(rowAccessPolicyId.FullyQualifiedName()))
+}
+
+func TestAcc_Table_SearchOptimization(t *testing.T) {
+	_ = testenvs.GetOrSkipTest(t, testenvs.EnableAcceptance)
+	acc.TestAccPreCheck(t)
+
+	tableId := acc.TestClient().Ids.RandomSchemaObjectIdentifier()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: acc.CheckDestroy(t, resources.Table),
+		Steps: []resource.TestStep{
+			{
+				Config: tableWithSearchOptimization(tableId, `
+		on {
+			method = "EQUALITY"
+			target = "column1"
+		}`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_table.test_table", "search_optimization.#", "1"),
+					resource.TestCheckResourceAttr("snowflake_table.test_table", "search_optimization.0.on.#", "1"),
+					resource.TestCheckResourceAttr("snowflake_table.test_table", "search_optimization.0.on.0.method", "EQUALITY"),
+					resource.TestCheckResourceAttr("snowflake_table.test_table", "search_optimization.0.on.0.target", "column1"),
+				),
+			},
+			{
+				Config: tableWithSearchOptimization(tableId, `
+		on {
+			method = "EQUALITY"
+			target = "column1"
+		}
+		on {
+			method = "SUBSTRING"
+			target = "column1"
+		}`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_table.test_table", "search_optimization.#", "1"),
+					resource.TestCheckResourceAttr("snowflake_table.test_table", "search_optimization.0.on.#", "2"),
+				),
+			},
+			// set externally
+			{
+				PreConfig: func() {
+					acc.TestClient().Table.Alter(t, sdk.NewAlterTableRequest(tableId).WithSearchOptimizationAction(sdk.NewTableSearchOptimizationActionRequest().WithDropSearchOptimization(sdk.Bool(true))))
+				},
+				Config: tableWithSearchOptimization(tableId, `
+		on {
+			method = "EQUALITY"
+			target = "column1"
+		}
+		on {
+			method = "SUBSTRING"
+			target = "column1"
+		}`),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("snowflake_table.test_table", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_table.test_table", "search_optimization.0.on.#", "2"),
+				),
+			},
+			{
+				Config: tableWithVarcharColumn(tableId),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_table.test_table", "search_optimization.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func tableWithSearchOptimization(tableId sdk.SchemaObjectIdentifier, searchOptimizationOn string) string {
+	return fmt.Sprintf(`
+resource "snowflake_table" "test_table" {
+	database = "%[1]s"
+	schema   = "%[2]s"
+	name     = "%[3]s"
+	comment  = "Terraform acceptance test"
+
+	column {
+		name = "column1"
+		type = "VARCHAR(16)"
+	}
+
+	search_optimization {
+%[4]s
+	}
+}
+`, tableId.DatabaseName(), tableId.SchemaName(), tableId.Name(), searchOptimizationOn)
+}
+
+func tableWithVarcharColumn(tableId sdk.SchemaObjectIdentifier) string {
+	return fmt.Sprintf(`
+resource "snowflake_table" "test_table" {
+	database = "%[1]s"
+	schema   = "%[2]s"
+	name     = "%[3]s"
+	comment  = "Terraform acceptance test"
+
+	column {
+		name = "column1"
+		type = "VARCHAR(16)"
+	}
+}
+`, tableId.DatabaseName(), tableId.SchemaName(), tableId.Name())
+}
+
+func TestAcc_Table_CreationModes(t *testing.T) {
+	_ = testenvs.GetOrSkipTest(t, testenvs.EnableAcceptance)
+	acc.TestAccPreCheck(t)
+
+	sourceTable, sourceTableCleanup := acc.TestClient().Table.CreateWithPredefinedColumns(t)
+	t.Cleanup(sourceTableCleanup)
+
+	tableId := acc.TestClient().Ids.RandomSchemaObjectIdentifier()
+	comment := random.Comment()
+
+	testCases := []struct {
+		name         string
+		creationMode string
+	}{
+		{
+			name: "clone",
+			creationMode: fmt.Sprintf(`
+	from_clone {
+		source_table = %[1]s
+	}`, strconv.Quote(sourceTable.ID().FullyQualifiedName())),
+		},
+		{
+			name:         "like",
+			creationMode: fmt.Sprintf(`from_like = %s`, strconv.Quote(sourceTable.ID().FullyQualifiedName())),
+		},
+		{
+			name:         "query",
+			creationMode: fmt.Sprintf(`from_query = %s`, strconv.Quote(fmt.Sprintf("SELECT * FROM %s", sourceTable.ID().FullyQualifiedName()))),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			resource.Test(t, resource.TestCase{
+				ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+				PreCheck:                 func() { acc.TestAccPreCheck(t) },
+				TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+					tfversion.RequireAbove(tfversion.Version1_5_0),
+				},
+				CheckDestroy: acc.CheckDestroy(t, resources.Table),
+				Steps: []resource.TestStep{
+					{
+						Config: tableWithCreationMode(tableId, comment, tc.creationMode),
+						Check: resource.ComposeTestCheckFunc(
+							resource.TestCheckResourceAttr("snowflake_table.test_table", "name", tableId.Name()),
+							resource.TestCheckResourceAttr("snowflake_table.test_table", "comment", comment),
+							resource.TestCheckResourceAttr("snowflake_table.test_table", "change_tracking", "true"),
+							resource.TestCheckResourceAttr("snowflake_table.test_table", "column.#", "3"),
+							resource.TestCheckResourceAttr("snowflake_table.test_table", "column.0.name", "ID"),
+							resource.TestCheckResourceAttr("snowflake_table.test_table", "column.1.name", "SOME_TEXT_COLUMN"),
+						),
+					},
+					// no changes after adopting the columns
+					{
+						Config: tableWithCreationMode(tableId, comment, tc.creationMode),
+						ConfigPlanChecks: resource.ConfigPlanChecks{
+							PreApply: []plancheck.PlanCheck{
+								plancheck.ExpectEmptyPlan(),
+							},
+						},
+					},
+				},
+			})
+		})
+	}
+}
+
+func TestAcc_Table_CreationModes_CloneWithRowAccessPolicy(t *testing.T) {
+	_ = testenvs.GetOrSkipTest(t, testenvs.EnableAcceptance)
+	acc.TestAccPreCheck(t)
+
+	rowAccessPolicy, rowAccessPolicyCleanup := acc.TestClient().RowAccessPolicy.CreateRowAccessPolicyWithDataType(t, sdk.DataTypeVARCHAR)
+	t.Cleanup(rowAccessPolicyCleanup)
+
+	rowAccessPolicy2, rowAccessPolicy2Cleanup := acc.TestClient().RowAccessPolicy.CreateRowAccessPolicyWithDataType(t, sdk.DataTypeVARCHAR)
+	t.Cleanup(rowAccessPolicy2Cleanup)
+
+	sourceTable, sourceTableCleanup := acc.TestClient().Table.CreateWithPredefinedColumns(t)
+	t.Cleanup(sourceTableCleanup)
+	acc.TestClient().Table.Alter(t, sdk.NewAlterTableRequest(sourceTable.ID()).WithAddRowAccessPolicy(sdk.NewTableAddRowAccessPolicyRequest(rowAccessPolicy.ID(), []string{"SOME_TEXT_COLUMN"})))
+
+	testCases := []struct {
+		name            string
+		rowAccessPolicy sdk.SchemaObjectIdentifier
+	}{
+		{name: "same policy as the source table", rowAccessPolicy: rowAccessPolicy.ID()},
+		{name: "different policy than the source table", rowAccessPolicy: rowAccessPolicy2.ID()},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tableId := acc.TestClient().Ids.RandomSchemaObjectIdentifier()
+			creationMode := fmt.Sprintf(`
+	from_clone {
+		source_table = %[1]s
+	}
+
+	row_access_policy {
+		policy_name = %[2]s
+		on          = ["SOME_TEXT_COLUMN"]
+	}`, strconv.Quote(sourceTable.ID().FullyQualifiedName()), strconv.Quote(tc.rowAccessPolicy.FullyQualifiedName()))
+
+			resource.Test(t, resource.TestCase{
+				ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+				PreCheck:                 func() { acc.TestAccPreCheck(t) },
+				TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+					tfversion.RequireAbove(tfversion.Version1_5_0),
+				},
+				CheckDestroy: acc.CheckDestroy(t, resources.Table),
+				Steps: []resource.TestStep{
+					{
+						Config: tableWithCreationMode(tableId, "", creationMode),
+						Check: resource.ComposeTestCheckFunc(
+							resource.TestCheckResourceAttr("snowflake_table.test_table", "row_access_policy.#", "1"),
+							resource.TestCheckResourceAttr("snowflake_table.test_table", "row_access_policy.0.policy_name", tc.rowAccessPolicy.FullyQualifiedName()),
+							resource.TestCheckResourceAttr("snowflake_table.test_table", "row_access_policy.0.on.#", "1"),
+							resource.TestCheckResourceAttr("snowflake_table.test_table", "row_access_policy.0.on.0", "SOME_TEXT_COLUMN"),
+						),
+					},
+					{
+						Config: tableWithCreationMode(tableId, "", creationMode),
+						ConfigPlanChecks: resource.ConfigPlanChecks{
+							PreApply: []plancheck.PlanCheck{
+								plancheck.ExpectEmptyPlan(),
+							},
+						},
+					},
+				},
+			})
+		})
+	}
+}
+
+func TestAcc_Table_CreationModes_InheritedProperties(t *testing.T) {
+	_ = testenvs.GetOrSkipTest(t, testenvs.EnableAcceptance)
+	acc.TestAccPreCheck(t)
+
+	sourceTableId := acc.TestClient().Ids.RandomSchemaObjectIdentifier()
+	_, sourceTableCleanup := acc.TestClient().Table.CreateWithRequest(t, sdk.NewCreateTableRequest(sourceTableId, []sdk.TableColumnRequest{
+		*sdk.NewTableColumnRequest("ID", sdk.DataTypeNumber),
+		*sdk.NewTableColumnRequest("SOME_TEXT_COLUMN", sdk.DataTypeVARCHAR),
+	}).
+		WithComment(sdk.String(random.Comment())).
+		WithChangeTracking(sdk.Bool(true)).
+		WithClusterBy([]string{"ID"}).
+		WithDataRetentionTimeInDays(sdk.Int(2)).
+		WithOutOfLineConstraint(*sdk.NewOutOfLineConstraintRequest(sdk.ColumnConstraintTypePrimaryKey).WithColumns([]string{"ID"})))
+	t.Cleanup(sourceTableCleanup)
+
+	cloneMode := fmt.Sprintf(`
+	from_clone {
+		source_table = %[1]s
+	}`, strconv.Quote(sourceTableId.FullyQualifiedName()))
+	likeMode := fmt.Sprintf(`from_like = %s`, strconv.Quote(sourceTableId.FullyQualifiedName()))
+	primaryKey := `
+	primary_key {
+		keys = ["ID"]
+	}`
+
+	testCases := []struct {
+		name         string
+		creationMode string
+	}{
+		{name: "clone without the inherited properties in the config", creationMode: cloneMode},
+		{name: "clone with the inherited primary key in the config", creationMode: cloneMode + primaryKey},
+		{name: "like without the inherited properties in the config", creationMode: likeMode},
+		{name: "like with the inherited primary key in the config", creationMode: likeMode + primaryKey},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tableId := acc.TestClient().Ids.RandomSchemaObjectIdentifier()
+			tableConfig := fmt.Sprintf(`
+resource "snowflake_table" "test_table" {
+	database = "%[1]s"
+	schema   = "%[2]s"
+	name     = "%[3]s"
+	%[4]s
+}
+`, tableId.DatabaseName(), tableId.SchemaName(), tableId.Name(), tc.creationMode)
+
+			resource.Test(t, resource.TestCase{
+				ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+				PreCheck:                 func() { acc.TestAccPreCheck(t) },
+				TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+					tfversion.RequireAbove(tfversion.Version1_5_0),
+				},
+				CheckDestroy: acc.CheckDestroy(t, resources.Table),
+				Steps: []resource.TestStep{
+					{
+						Config: tableConfig,
+						Check: resource.ComposeTestCheckFunc(
+							resource.TestCheckResourceAttr("snowflake_table.test_table", "name", tableId.Name()),
+							resource.TestCheckResourceAttr("snowflake_table.test_table", "comment", ""),
+							resource.TestCheckResourceAttr("snowflake_table.test_table", "change_tracking", "false"),
+							resource.TestCheckResourceAttr("snowflake_table.test_table", "cluster_by.#", "0"),
+							resource.TestCheckResourceAttr("snowflake_table.test_table", "data_retention_time_in_days", "-1"),
+						),
+					},
+					{
+						Config: tableConfig,
+						ConfigPlanChecks: resource.ConfigPlanChecks{
+							PreApply: []plancheck.PlanCheck{
+								plancheck.ExpectEmptyPlan(),
+							},
+						},
+					},
+				},
+			})
+		})
+	}
+}
+
+func TestAcc_Table_CreationModes_Validations(t *testing.T) {
+	_ = testenvs.GetOrSkipTest(t, testenvs.EnableAcceptance)
+	acc.TestAccPreCheck(t)
+
+	tableId := acc.TestClient().Ids.RandomSchemaObjectIdentifier()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      tableWithCreationMode(tableId, "", `from_like = "\"a\".\"b\".\"c\""`+"\n"+`from_query = "SELECT 1"`),
+				ExpectError: regexp.MustCompile(`"from_like": only one of`),
+			},
+			{
+				Config:      tableWithCreationMode(tableId, "", ""),
+				ExpectError: regexp.MustCompile(`one of\s*` + "`column,from_clone,from_like,from_query,from_template`" + `\s*must be specified`),
+			},
+		},
+	})
+}
+
+func tableWithCreationMode(tableId sdk.SchemaObjectIdentifier, comment string, creationMode string) string {
+	return fmt.Sprintf(`
+resource "snowflake_table" "test_table" {
+	database        = "%[1]s"
+	schema          = "%[2]s"
+	name            = "%[3]s"
+	comment         = "%[4]s"
+	change_tracking = true
+	%[5]s
+}
+`, tableId.DatabaseName(), tableId.SchemaName(), tableId.Name(), comment, creationMode)
+}
@@ -0,0 +1,65 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_collapseSearchOptimizationTargets(t *testing.T) {
+	on := func(method string, target string) map[string]any {
+		return map[string]any{"method": method, "target": target}
+	}
+	described := []any{
+		on("EQUALITY", "ID"),
+		on("EQUALITY", "NAME"),
+		on("SUBSTRING", "NAME"),
+		on("GEO", "LOCATION"),
+	}
+
+	testCases := []struct {
+		name       string
+		configured []any
+		expected   []any
+	}{
+		{
+			name:       "explicit targets",
+			configured: []any{on("EQUALITY", "ID"), on("EQUALITY", "NAME"), on("SUBSTRING", "NAME"), on("GEO", "LOCATION")},
+			expected:   described,
+		},
+		{
+			name:       "wildcard target",
+			configured: []any{on("EQUALITY", "*"), on("SUBSTRING", "NAME"), on("GEO", "LOCATION")},
+			expected:   []any{on("EQUALITY", "*"), on("SUBSTRING", "NAME"), on("GEO", "LOCATION")},
+		},
+		{
+			name:       "wildcard target with lowercase method",
+			configured: []any{on("equality", "*")},
+			expected:   []any{on("EQUALITY", "*"), on("SUBSTRING", "NAME"), on("GEO", "LOCATION")},
+		},
+		{
+			name:       "wildcard target next to an explicit target for the same method",
+			configured: []any{on("EQUALITY", "*"), on("EQUALITY", "NAME")},
+			expected:   []any{on("EQUALITY", "*"), on("EQUALITY", "NAME"), on("SUBSTRING", "NAME"), on("GEO", "LOCATION")},
+		},
+		{
+			name:       "wildcard targets for all methods",
+			configured: []any{on("EQUALITY", "*"), on("SUBSTRING", "*"), on("GEO", "*")},
+			expected:   []any{on("EQUALITY", "*"), on("SUBSTRING", "*"), on("GEO", "*")},
+		},
+		{
+			name:       "wildcard target for one method keeps the described targets of the other methods",
+			configured: []any{on("SUBSTRING", "*")},
+			expected:   []any{on("EQUALITY", "ID"), on("EQUALITY", "NAME"), on("SUBSTRING", "*"), on("GEO", "LOCATION")},
+		},
+		{
+			name:     "no configuration (import)",
+			expected: described,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, collapseSearchOptimizationTargets(described, tc.configured))
+		})
+	}
+}
@@ -105,6 +105,29 @@ var tagReferenceSchema = &schema.Schema{
 	},
 }
 
+// readTagReferences reads the values of the given tag references (defined with tagReferenceSchema) set on the object.
+// The tags that are not set on the object anymore are skipped.
+func readTagReferences(ctx context.Context, client *sdk.Client, tagReferences []any, objectId sdk.ObjectIdentifier, objectType sdk.ObjectType) ([]any, error) {
+	readTags := make([]any, 0, len(tagReferences))
+	for _, t := range tagReferences {
+		tag := t.(map[string]any)
+		value, err := client.SystemFunctions.GetTag(ctx, getTagObjectIdentifier(tag), objectId, objectType)
+		if err != nil {
+			return nil, fmt.Errorf("reading tag %s: %w", tag["name"], err)
+		}
+		if value == nil {
+			continue
+		}
+		readTags = append(readTags, map[string]any{
+			"name":     tag["name"],
+			"value":    *value,
+			"database": tag["database"],
+			"schema":   tag["schema"],
+		})
+	}
+	return readTags, nil
+}
+
 // Schema returns a pointer to the resource representing a schema.
 func Tag() *schema.Resource {
 	return &schema.Resource{
@@ -244,16 +244,7 @@ func CreateTask(ctx context.Context, d *schema.ResourceData, meta any) (diags di
 			return sdk.NewCreateTaskWarehouseRequest().WithWarehouse(warehouseId), nil
 		}),
 		attributeMappedValueCreate(d, "schedule", &req.Schedule, func(v any) (*string, error) {
-			if len(v.([]any)) > 0 {
-				if minutes, ok := d.GetOk("schedule.0.minutes"); ok {
-					return sdk.String(fmt.Sprintf("%d MINUTE", minutes)), nil
-				}
-				if cron, ok := d.GetOk("schedule.0.using_cron"); ok {
-					return sdk.String(fmt.Sprintf("USING CRON %s", cron)), nil
-				}
-				return nil, fmt.Errorf("when setting a schedule either minutes or using_cron field should be set")
-			}
-			return nil, nil
+			return taskScheduleCreate(d, v)
 		}),
 		stringAttributeCreate(d, "config", &req.Config),
 		booleanStringAttributeCreate(d, "allow_overlapping_execution", &req.AllowOverlappingExecution),
@@ -379,20 +370,7 @@ func UpdateTask(ctx context.Context, d *schema.ResourceData, meta any) (diags di
 		return diag.FromErr(err)
 	}
 
-	if d.HasChange("schedule") {
-		_, newSchedule := d.GetChange("schedule")
-
-		if newSchedule != nil && len(newSchedule.([]any)) == 1 {
-			if _, newMinutes := d.GetChange("schedule.0.minutes"); newMinutes.(int) > 0 {
-				set.Schedule = sdk.String(fmt.Sprintf("%d MINUTE", newMinutes.(int)))
-			}
-			if _, newCron := d.GetChange("schedule.0.using_cron"); newCron.(string) != "" {
-				set.Schedule = sdk.String(fmt.Sprintf("USING CRON %s", newCron.(string)))
-			}
-		} else {
-			unset.Schedule = sdk.Bool(true)
-		}
-	}
+	taskScheduleUpdate(d, set, unset)
 
 	if updateDiags := handleTaskParametersUpdate(d, set, unset); len(updateDiags) > 0 {
 		return updateDiags
@@ -583,30 +561,7 @@ func ReadTask(withExternalChangesMarking bool) schema.ReadContextFunc {
 			attributeMappedValueReadOrDefault(d, "warehouse", task.Warehouse, func(warehouse *sdk.AccountObjectIdentifier) (string, error) {
 				return warehouse.Name(), nil
 			}, nil),
-			func() error {
-				if len(task.Schedule) > 0 {
-					taskSchedule, err := sdk.ParseTaskSchedule(task.Schedule)
-					if err != nil {
-						return err
-					}
-					switch {
-					case len(taskSchedule.Cron) > 0:
-						if err := d.Set("schedule", []any{map[string]any{
-							"using_cron": taskSchedule.Cron,
-						}}); err != nil {
-							return err
-						}
-					case taskSchedule.Minutes > 0:
-						if err := d.Set("schedule", []any{map[string]any{
-							"minutes": taskSchedule.Minutes,
-						}}); err != nil {
-							return err
-						}
-					}
-					return nil
-				}
-				return d.Set("schedule", nil)
-			}(),
+			taskScheduleRead(d, task.Schedule),
 			d.Set("started", task.IsStarted()),
 			d.Set("when", task.Condition),
 			d.Set("config", task.Config),
@@ -651,6 +606,61 @@ func DeleteTask(ctx context.Context, d *schema.ResourceData, meta any) (diags di
 	return diags
 }
 
+func taskScheduleCreate(d *schema.ResourceData, v any) (*string, error) {
+	if len(v.([]any)) > 0 {
+		if minutes, ok := d.GetOk("schedule.0.minutes"); ok {
+			return sdk.String(fmt.Sprintf("%d MINUTE", minutes)), nil
+		}
+		if cron, ok := d.GetOk("schedule.0.using_cron"); ok {
+			return sdk.String(fmt.Sprintf("USING CRON %s", cron)), nil
+		}
+		return nil, fmt.Errorf("when setting a schedule either minutes or using_cron field should be set")
+	}
+	return nil, nil
+}
+
+func taskScheduleUpdate(d *schema.ResourceData, set *sdk.TaskSetRequest, unset *sdk.TaskUnsetRequest) {
+	if d.HasChange("schedule") {
+		_, newSchedule := d.GetChange("schedule")
+
+		if newSchedule != nil && len(newSchedule.([]any)) == 1 {
+			if _, newMinutes := d.GetChange("schedule.0.minutes"); newMinutes.(int) > 0 {
+				set.Schedule = sdk.String(fmt.Sprintf("%d MINUTE", newMinutes.(int)))
+			}
+			if _, newCron := d.GetChange("schedule.0.using_cron"); newCron.(string) != "" {
+				set.Schedule = sdk.String(fmt.Sprintf("USING CRON %s", newCron.(string)))
+			}
+		} else {
+			unset.Schedule = sdk.Bool(true)
+		}
+	}
+}
+
+func taskScheduleRead(d *schema.ResourceData, schedule string) error {
+	if len(schedule) > 0 {
+		taskSchedule, err := sdk.ParseTaskSchedule(schedule)
+		if err != nil {
+			return err
+		}
+		switch {
+		case len(taskSchedule.Cron) > 0:
+			if err := d.Set("schedule", []any{map[string]any{
+				"using_cron": taskSchedule.Cron,
+			}}); err != nil {
+				return err
+			}
+		case taskSchedule.Minutes > 0:
+			if err := d.Set("schedule", []any{map[string]any{
+				"minutes": taskSchedule.Minutes,
+			}}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	return d.Set("schedule", nil)
+}
+
 func resumeTaskErrorDiag(id sdk.SchemaObjectIdentifier, operation string, originalErr error) diag.Diagnostic {
 	return diag.Diagnostic{
 		Severity: diag.Warning,
@@ -0,0 +1,275 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// taskHistoryResultLimit is the number of the latest task runs inspected to find the run triggered by the resource.
+const taskHistoryResultLimit = 100
+
+var taskExecutionSchema = map[string]*schema.Schema{
+	"task": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		Description:      relatedResourceDescription("Fully qualified name of the task to execute. For a root task, the whole task graph is run.", resources.Task),
+		ValidateDiagFunc: IsValidIdentifier[sdk.SchemaObjectIdentifier](),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+	},
+	"retry_last": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		ForceNew:    true,
+		Description: "Retries the last failed run of the task graph instead of starting a new one (`EXECUTE TASK ... RETRY LAST`). Can be used only with root tasks.",
+	},
+	"triggers": {
+		Type:        schema.TypeMap,
+		Optional:    true,
+		ForceNew:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Description: "A map of arbitrary values that, when changed, cause the task to be executed again (e.g. the `sql_statement` or the `fully_qualified_name` of the task).",
+	},
+	"wait_for_completion": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "When set to true, the provider polls [TASK_HISTORY](https://docs.snowflake.com/en/sql-reference/functions/task_history) until the triggered run of the task finishes, and fails the apply with the task error message if the run fails. For a root task, only the run of the root task is awaited, not the runs of its child tasks. The waiting time is limited by the `create` timeout of the resource. Changing this field does not execute the task again.",
+	},
+	"task_run": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Outcome of the task run triggered by the resource, read from TASK_HISTORY. Filled only when `wait_for_completion` is set to true.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"query_id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"state": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"error_code": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"error_message": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"scheduled_time": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"query_start_time": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"completed_time": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"return_value": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"run_id": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"attempt_number": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+			},
+		},
+	},
+}
+
+func TaskExecution() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: PreviewFeatureCreateContextWrapper(string(previewfeatures.TaskExecutionResource), TrackingCreateWrapper(resources.TaskExecution, CreateTaskExecution)),
+		ReadContext:   PreviewFeatureReadContextWrapper(string(previewfeatures.TaskExecutionResource), TrackingReadWrapper(resources.TaskExecution, ReadTaskExecution)),
+		UpdateContext: PreviewFeatureUpdateContextWrapper(string(previewfeatures.TaskExecutionResource), TrackingUpdateWrapper(resources.TaskExecution, UpdateTaskExecution)),
+		DeleteContext: PreviewFeatureDeleteContextWrapper(string(previewfeatures.TaskExecutionResource), TrackingDeleteWrapper(resources.TaskExecution, DeleteTaskExecution)),
+		Description:   "Resource used to run a task once (`EXECUTE TASK`), e.g. right after a changed task graph is deployed. The task is executed on creation and every time the resource is replaced (see `triggers`); removing the resource does not affect the task. For more information, check [EXECUTE TASK documentation](https://docs.snowflake.com/en/sql-reference/sql/execute-task).",
+
+		Schema:   taskExecutionSchema,
+		Timeouts: defaultTimeouts,
+	}
+}
+
+func CreateTaskExecution(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseSchemaObjectIdentifier(d.Get("task").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// The runs known before the execution are remembered to tell the triggered run apart from the older ones (including previous attempts of the retried run).
+	var previousRuns []sdk.TaskRun
+	waitForCompletion := d.Get("wait_for_completion").(bool)
+	if waitForCompletion {
+		previousRuns, err = client.Tasks.History(ctx, sdk.NewTaskHistoryRequest(id).WithResultLimit(taskHistoryResultLimit))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	request := sdk.NewExecuteTaskRequest(id)
+	if d.Get("retry_last").(bool) {
+		request.WithRetryLast(true)
+	}
+	if err := client.Tasks.Execute(ctx, request); err != nil {
+		return diag.FromErr(fmt.Errorf("error executing task %s: %w", id.FullyQualifiedName(), err))
+	}
+
+	executionId, err := uuid.GenerateUUID()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(executionId)
+
+	if !waitForCompletion {
+		return diag.FromErr(d.Set("task_run", []any{}))
+	}
+
+	taskRun, err := waitForTaskRun(ctx, client, id, previousRuns, d.Timeout(schema.TimeoutCreate))
+	if taskRun != nil {
+		if setErr := d.Set("task_run", []any{taskRunToSchema(taskRun)}); setErr != nil {
+			return diag.FromErr(errors.Join(err, setErr))
+		}
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	return ReadTaskExecution(ctx, d, meta)
+}
+
+// waitForTaskRun polls the task history until the run triggered by EXECUTE TASK finishes.
+// The last seen run is returned together with the error, so that the outcome of a failed run can be saved in the state.
+func waitForTaskRun(ctx context.Context, client *sdk.Client, id sdk.SchemaObjectIdentifier, previousRuns []sdk.TaskRun, timeout time.Duration) (*sdk.TaskRun, error) {
+	var taskRun *sdk.TaskRun
+	err := retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+		taskRuns, err := client.Tasks.History(ctx, sdk.NewTaskHistoryRequest(id).WithResultLimit(taskHistoryResultLimit))
+		if err != nil {
+			return retry.NonRetryableError(err)
+		}
+		taskRun = findExecutedTaskRun(taskRuns, previousRuns)
+		switch {
+		case taskRun == nil:
+			return retry.RetryableError(fmt.Errorf("the run of task %s has not been scheduled yet", id.FullyQualifiedName()))
+		case !taskRun.State.IsFinished():
+			return retry.RetryableError(fmt.Errorf("the run of task %s has not finished yet, current state: %s", id.FullyQualifiedName(), taskRun.State))
+		default:
+			return nil
+		}
+	})
+	if err != nil {
+		return taskRun, fmt.Errorf("error waiting for the run of task %s: %w", id.FullyQualifiedName(), err)
+	}
+	if taskRun.State.IsFailed() {
+		var errorCode, errorMessage string
+		if taskRun.ErrorCode != nil {
+			errorCode = *taskRun.ErrorCode
+		}
+		if taskRun.ErrorMessage != nil {
+			errorMessage = *taskRun.ErrorMessage
+		}
+		return taskRun, fmt.Errorf("the run of task %s finished with state %s, error code: %s, error message: %s", id.FullyQualifiedName(), taskRun.State, errorCode, errorMessage)
+	}
+	return taskRun, nil
+}
+
+// findExecutedTaskRun returns the latest run scheduled with EXECUTE TASK that is not present in the runs known before the execution.
+// The run and its attempt number are compared, because RETRY LAST reuses the run id of the retried graph run.
+func findExecutedTaskRun(taskRuns []sdk.TaskRun, previousRuns []sdk.TaskRun) *sdk.TaskRun {
+	for _, taskRun := range taskRuns {
+		if taskRun.ScheduledFrom != sdk.TaskRunScheduledFromExecuteTask {
+			continue
+		}
+		if !slices.ContainsFunc(previousRuns, func(previousRun sdk.TaskRun) bool {
+			return previousRun.RunId == taskRun.RunId && previousRun.AttemptNumber == taskRun.AttemptNumber
+		}) {
+			return &taskRun
+		}
+	}
+	return nil
+}
+
+func taskRunToSchema(taskRun *sdk.TaskRun) map[string]any {
+	taskRunSchema := map[string]any{
+		"state":          string(taskRun.State),
+		"scheduled_time": taskRun.ScheduledTime.String(),
+		"run_id":         int(taskRun.RunId),
+		"attempt_number": taskRun.AttemptNumber,
+	}
+	if taskRun.QueryId != nil {
+		taskRunSchema["query_id"] = *taskRun.QueryId
+	}
+	if taskRun.ErrorCode != nil {
+		taskRunSchema["error_code"] = *taskRun.ErrorCode
+	}
+	if taskRun.ErrorMessage != nil {
+		taskRunSchema["error_message"] = *taskRun.ErrorMessage
+	}
+	if taskRun.QueryStartTime != nil {
+		taskRunSchema["query_start_time"] = taskRun.QueryStartTime.String()
+	}
+	if taskRun.CompletedTime != nil {
+		taskRunSchema["completed_time"] = taskRun.CompletedTime.String()
+	}
+	if taskRun.ReturnValue != nil {
+		taskRunSchema["return_value"] = *taskRun.ReturnValue
+	}
+	return taskRunSchema
+}
+
+func ReadTaskExecution(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseSchemaObjectIdentifier(d.Get("task").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// The execution itself is not an object in Snowflake; the resource is removed from the state only when the executed task is gone,
+	// so that recreating the task runs it again.
+	if _, err := client.Tasks.ShowByID(ctx, id); err != nil {
+		if errors.Is(err, sdk.ErrObjectNotFound) {
+			d.SetId("")
+			return diag.Diagnostics{
+				diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  "Failed to query the executed task. Marking the resource as removed.",
+					Detail:   fmt.Sprintf("Task name: %s, Err: %s", id.FullyQualifiedName(), err),
+				},
+			}
+		}
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func UpdateTaskExecution(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	// Only wait_for_completion can be updated in place; it affects the next execution only.
+	return ReadTaskExecution(ctx, d, meta)
+}
+
+func DeleteTaskExecution(_ context.Context, d *schema.ResourceData, _ any) diag.Diagnostics {
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,129 @@
+package resources_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/testenvs"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_TaskExecution_BasicFlow(t *testing.T) {
+	_ = testenvs.GetOrSkipTest(t, testenvs.EnableAcceptance)
+	acc.TestAccPreCheck(t)
+
+	id := acc.TestClient().Ids.RandomSchemaObjectIdentifier()
+	resourceReference := "snowflake_task_execution.test"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: acc.CheckDestroy(t, resources.Task),
+		Steps: []resource.TestStep{
+			// execute without waiting
+			{
+				Config: taskExecutionConfig(id, "SELECT 1", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceReference, "task", id.FullyQualifiedName()),
+					resource.TestCheckResourceAttr(resourceReference, "wait_for_completion", "false"),
+					resource.TestCheckResourceAttr(resourceReference, "task_run.#", "0"),
+				),
+			},
+			// changing wait_for_completion does not execute the task again
+			{
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceReference, plancheck.ResourceActionUpdate),
+					},
+				},
+				Config: taskExecutionConfig(id, "SELECT 1", true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceReference, "wait_for_completion", "true"),
+					resource.TestCheckResourceAttr(resourceReference, "task_run.#", "0"),
+				),
+			},
+			// a change of the task definition triggers the execution and the provider waits for the run
+			{
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceReference, plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+				Config: taskExecutionConfig(id, "SELECT 2", true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceReference, "task_run.#", "1"),
+					resource.TestCheckResourceAttr(resourceReference, "task_run.0.state", string(sdk.TaskRunStateSucceeded)),
+					resource.TestCheckResourceAttr(resourceReference, "task_run.0.attempt_number", "1"),
+					resource.TestCheckResourceAttrSet(resourceReference, "task_run.0.query_id"),
+					resource.TestCheckResourceAttrSet(resourceReference, "task_run.0.completed_time"),
+					resource.TestCheckResourceAttr(resourceReference, "task_run.0.error_message", ""),
+				),
+			},
+			// a failed run fails the apply
+			{
+				Config:      taskExecutionConfig(id, "SELECT * FROM NOT_EXISTING_TABLE", true),
+				ExpectError: regexp.MustCompile(fmt.Sprintf(`the run of task %s finished with state FAILED`, regexp.QuoteMeta(id.FullyQualifiedName()))),
+			},
+		},
+	})
+}
+
+func TestAcc_TaskExecution_RetryLastWithoutFailedRun(t *testing.T) {
+	_ = testenvs.GetOrSkipTest(t, testenvs.EnableAcceptance)
+	acc.TestAccPreCheck(t)
+
+	id := acc.TestClient().Ids.RandomSchemaObjectIdentifier()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: acc.CheckDestroy(t, resources.Task),
+		Steps: []resource.TestStep{
+			{
+				Config: taskExecutionConfig(id, "SELECT 1", false) + `
+resource "snowflake_task_execution" "retry" {
+  task       = snowflake_task.test.fully_qualified_name
+  retry_last = true
+
+  depends_on = [snowflake_task_execution.test]
+}
+`,
+				ExpectError: regexp.MustCompile("Cannot perform retry|had no failures"),
+			},
+		},
+	})
+}
+
+func taskExecutionConfig(id sdk.SchemaObjectIdentifier, sqlStatement string, waitForCompletion bool) string {
+	return fmt.Sprintf(`
+resource "snowflake_task" "test" {
+  database      = "%[1]s"
+  schema        = "%[2]s"
+  name          = "%[3]s"
+  warehouse     = "%[4]s"
+  started       = false
+  sql_statement = "%[5]s"
+}
+
+resource "snowflake_task_execution" "test" {
+  task                = snowflake_task.test.fully_qualified_name
+  wait_for_completion = %[6]t
+
+  triggers = {
+    sql_statement = snowflake_task.test.sql_statement
+  }
+}
+`, id.DatabaseName(), id.SchemaName(), id.Name(), acc.TestClient().Ids.WarehouseId().Name(), sqlStatement, waitForCompletion)
+}
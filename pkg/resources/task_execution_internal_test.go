@@ -0,0 +1,45 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_findExecutedTaskRun(t *testing.T) {
+	run := func(runId int64, attemptNumber int, scheduledFrom sdk.TaskRunScheduledFrom) sdk.TaskRun {
+		return sdk.TaskRun{RunId: runId, AttemptNumber: attemptNumber, ScheduledFrom: scheduledFrom}
+	}
+	executed := func(runId int64, attemptNumber int) sdk.TaskRun {
+		return run(runId, attemptNumber, sdk.TaskRunScheduledFromExecuteTask)
+	}
+
+	testCases := []struct {
+		name          string
+		taskRuns      []sdk.TaskRun
+		previousRuns  []sdk.TaskRun
+		expectedRunId int64
+		expectedTry   int
+	}{
+		{name: "no runs"},
+		{name: "only previous runs", taskRuns: []sdk.TaskRun{executed(1, 1)}, previousRuns: []sdk.TaskRun{executed(1, 1)}},
+		{name: "only scheduled runs", taskRuns: []sdk.TaskRun{run(2, 1, sdk.TaskRunScheduledFromSchedule), run(1, 1, sdk.TaskRunScheduledFromTrigger)}},
+		{name: "new run", taskRuns: []sdk.TaskRun{executed(2, 1), executed(1, 1)}, previousRuns: []sdk.TaskRun{executed(1, 1)}, expectedRunId: 2, expectedTry: 1},
+		{name: "new run after a scheduled one", taskRuns: []sdk.TaskRun{run(3, 1, sdk.TaskRunScheduledFromSchedule), executed(2, 1)}, expectedRunId: 2, expectedTry: 1},
+		{name: "retried run", taskRuns: []sdk.TaskRun{executed(1, 2), executed(1, 1)}, previousRuns: []sdk.TaskRun{executed(1, 1)}, expectedRunId: 1, expectedTry: 2},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			taskRun := findExecutedTaskRun(tc.taskRuns, tc.previousRuns)
+			if tc.expectedRunId == 0 {
+				assert.Nil(t, taskRun)
+			} else {
+				require.NotNil(t, taskRun)
+				assert.Equal(t, tc.expectedRunId, taskRun.RunId)
+				assert.Equal(t, tc.expectedTry, taskRun.AttemptNumber)
+			}
+		})
+	}
+}
@@ -0,0 +1,867 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/schemas"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var taskGraphSchema = map[string]*schema.Schema{
+	"database": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		DiffSuppressFunc: suppressIdentifierQuoting,
+		Description:      blocklistedCharactersFieldDescription("The database in which to create all the tasks of the graph."),
+	},
+	"schema": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		DiffSuppressFunc: suppressIdentifierQuoting,
+		Description:      blocklistedCharactersFieldDescription("The schema in which to create all the tasks of the graph."),
+	},
+	"name": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		DiffSuppressFunc: suppressIdentifierQuoting,
+		Description:      blocklistedCharactersFieldDescription("Specifies the identifier of the root task of the graph; must be unique for the database and schema in which the task is created."),
+	},
+	"started": {
+		Type:        schema.TypeBool,
+		Required:    true,
+		Description: "Specifies if the task graph should be started or suspended. When started, all the tasks of the graph are resumed, and the root task is resumed as the last one.",
+	},
+	"warehouse": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		ValidateDiagFunc: IsValidIdentifier[sdk.AccountObjectIdentifier](),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+		Description:      relatedResourceDescription("The warehouse the root task will use. Omit this parameter to use Snowflake-managed compute resources for runs of this task.", resources.Warehouse),
+	},
+	"schedule": {
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "The schedule for periodically running the task graph. This can be a cron or interval in minutes. (when set, one of the sub-fields `minutes` or `using_cron` should be set)",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"minutes": {
+					Type:             schema.TypeInt,
+					Optional:         true,
+					Description:      "Specifies an interval (in minutes) of wait time inserted between runs of the task graph. Accepts positive integers only. (conflicts with `using_cron`)",
+					ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
+					ExactlyOneOf:     []string{"schedule.0.minutes", "schedule.0.using_cron"},
+				},
+				"using_cron": {
+					Type:             schema.TypeString,
+					Optional:         true,
+					Description:      "Specifies a cron expression and time zone for periodically running the task graph. Supports a subset of standard cron utility syntax. (conflicts with `minutes`)",
+					DiffSuppressFunc: ignoreCaseSuppressFunc,
+					ExactlyOneOf:     []string{"schedule.0.minutes", "schedule.0.using_cron"},
+				},
+			},
+		},
+	},
+	"config": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		DiffSuppressFunc: ignoreChangeToCurrentRootTaskValueInShow("config"),
+		Description:      "Specifies a string representation of key value pairs that can be accessed by all tasks in the task graph. Must be in JSON format.",
+	},
+	"error_integration": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		ValidateDiagFunc: IsValidIdentifier[sdk.AccountObjectIdentifier](),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+		Description:      relatedResourceDescription(blocklistedCharactersFieldDescription("Specifies the name of the notification integration used for error notifications."), resources.NotificationIntegration),
+	},
+	"comment": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies a comment for the root task.",
+	},
+	"when": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		DiffSuppressFunc: DiffSuppressStatement,
+		Description:      "Specifies a Boolean SQL expression for the root task. When the task graph is triggered, the root task validates the conditions of the expression to determine whether to execute. If the conditions are not met, then the whole graph run is skipped.",
+	},
+	"sql_statement": {
+		Type:             schema.TypeString,
+		Required:         true,
+		DiffSuppressFunc: DiffSuppressStatement,
+		Description:      "Any single SQL statement, or a call to a stored procedure, executed when the root task runs.",
+	},
+	"task": {
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: "Child tasks of the graph. Every child task has to run after at least one other task of the graph, so the root task stays the only task without predecessors. The graph is validated during the plan: task names have to be unique, `after` can only reference the root task or other child tasks, and the dependencies cannot contain cycles.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: blocklistedCharactersFieldDescription("Specifies the identifier of the child task; the task is created in the same database and schema as the root task. Changing the name drops the task and creates a new one."),
+				},
+				"after": {
+					Type:     schema.TypeSet,
+					Required: true,
+					MinItems: 1,
+					Elem: &schema.Schema{
+						Type:             schema.TypeString,
+						ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotEmpty),
+					},
+					Description: "Names of the predecessor tasks of the child task. Each name has to be the name of the root task or of another child task declared in the graph.",
+				},
+				"warehouse": {
+					Type:             schema.TypeString,
+					Optional:         true,
+					ValidateDiagFunc: IsValidIdentifier[sdk.AccountObjectIdentifier](),
+					DiffSuppressFunc: suppressIdentifierQuoting,
+					Description:      relatedResourceDescription("The warehouse the child task will use. Omit this parameter to use Snowflake-managed compute resources for runs of this task.", resources.Warehouse),
+				},
+				"comment": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Specifies a comment for the child task.",
+				},
+				"when": {
+					Type:             schema.TypeString,
+					Optional:         true,
+					DiffSuppressFunc: DiffSuppressStatement,
+					Description:      "Specifies a Boolean SQL expression; if the conditions of the expression are not met, the child task and the tasks that depend on it skip the current run.",
+				},
+				"sql_statement": {
+					Type:             schema.TypeString,
+					Required:         true,
+					DiffSuppressFunc: DiffSuppressStatement,
+					Description:      "Any single SQL statement, or a call to a stored procedure, executed when the child task runs.",
+				},
+			},
+		},
+	},
+	"finalizer": {
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "The finalizer task of the graph. It runs after all other tasks of the graph run to completion. For more information, see [Release and cleanup of task graphs](https://docs.snowflake.com/en/user-guide/tasks-graphs.html#label-finalizer-task).",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: blocklistedCharactersFieldDescription("Specifies the identifier of the finalizer task; the task is created in the same database and schema as the root task. Changing the name drops the task and creates a new one."),
+				},
+				"warehouse": {
+					Type:             schema.TypeString,
+					Optional:         true,
+					ValidateDiagFunc: IsValidIdentifier[sdk.AccountObjectIdentifier](),
+					DiffSuppressFunc: suppressIdentifierQuoting,
+					Description:      relatedResourceDescription("The warehouse the finalizer task will use. Omit this parameter to use Snowflake-managed compute resources for runs of this task.", resources.Warehouse),
+				},
+				"comment": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Specifies a comment for the finalizer task.",
+				},
+				"sql_statement": {
+					Type:             schema.TypeString,
+					Required:         true,
+					DiffSuppressFunc: DiffSuppressStatement,
+					Description:      "Any single SQL statement, or a call to a stored procedure, executed when the finalizer task runs.",
+				},
+			},
+		},
+	},
+	FullyQualifiedNameAttributeName: schemas.FullyQualifiedNameSchema,
+	ShowOutputAttributeName: {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Outputs the result of `SHOW TASKS` for every task of the graph: the root task first, then the child tasks in the order of execution, and the finalizer task last.",
+		Elem: &schema.Resource{
+			Schema: schemas.ShowTaskSchema,
+		},
+	},
+}
+
+func TaskGraph() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: PreviewFeatureCreateContextWrapper(string(previewfeatures.TaskGraphResource), TrackingCreateWrapper(resources.TaskGraph, CreateTaskGraph)),
+		ReadContext:   PreviewFeatureReadContextWrapper(string(previewfeatures.TaskGraphResource), TrackingReadWrapper(resources.TaskGraph, ReadTaskGraph)),
+		UpdateContext: PreviewFeatureUpdateContextWrapper(string(previewfeatures.TaskGraphResource), TrackingUpdateWrapper(resources.TaskGraph, UpdateTaskGraph)),
+		DeleteContext: PreviewFeatureDeleteContextWrapper(string(previewfeatures.TaskGraphResource), TrackingDeleteWrapper(resources.TaskGraph, DeleteTaskGraph)),
+		Description:   "Resource used to manage a whole task graph (the root task, its child tasks, and the finalizer task) as a single object. Every change suspends the root task once, applies all the changes, and resumes the graph once. For more information, check [task graphs documentation](https://docs.snowflake.com/en/user-guide/tasks-graphs).",
+
+		CustomizeDiff: TrackingCustomDiffWrapper(resources.TaskGraph, customdiff.All(
+			ComputedIfAnyAttributeChanged(taskGraphSchema, ShowOutputAttributeName, "name", "started", "warehouse", "schedule", "config", "error_integration", "comment", "when", "sql_statement", "task", "finalizer"),
+			ComputedIfAnyAttributeChanged(taskGraphSchema, FullyQualifiedNameAttributeName, "name"),
+			validateTaskGraphCustomDiff,
+		)),
+
+		Schema: taskGraphSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: TrackingImportWrapper(resources.TaskGraph, ImportName[sdk.SchemaObjectIdentifier]),
+		},
+		Timeouts: defaultTimeouts,
+	}
+}
+
+// taskGraphChild represents a child task declared in the task block.
+type taskGraphChild struct {
+	name         string
+	after        []string
+	warehouse    string
+	comment      string
+	when         string
+	sqlStatement string
+}
+
+func (c taskGraphChild) equalsIgnoringAfter(other taskGraphChild) bool {
+	return c.warehouse == other.warehouse && c.comment == other.comment && c.when == other.when && c.sqlStatement == other.sqlStatement
+}
+
+// taskGraphFinalizer represents the finalizer task declared in the finalizer block.
+type taskGraphFinalizer struct {
+	name         string
+	warehouse    string
+	comment      string
+	sqlStatement string
+}
+
+func taskGraphChildrenFromRaw(v any) []taskGraphChild {
+	children := make([]taskGraphChild, 0)
+	for _, raw := range v.([]any) {
+		if raw == nil {
+			continue
+		}
+		m := raw.(map[string]any)
+		after := make([]string, 0)
+		if afterSet, ok := m["after"].(*schema.Set); ok {
+			after = expandStringList(afterSet.List())
+			slices.Sort(after)
+		}
+		children = append(children, taskGraphChild{
+			name:         m["name"].(string),
+			after:        after,
+			warehouse:    m["warehouse"].(string),
+			comment:      m["comment"].(string),
+			when:         m["when"].(string),
+			sqlStatement: m["sql_statement"].(string),
+		})
+	}
+	return children
+}
+
+func taskGraphFinalizerFromRaw(v any) *taskGraphFinalizer {
+	list := v.([]any)
+	if len(list) == 0 || list[0] == nil {
+		return nil
+	}
+	m := list[0].(map[string]any)
+	return &taskGraphFinalizer{
+		name:         m["name"].(string),
+		warehouse:    m["warehouse"].(string),
+		comment:      m["comment"].(string),
+		sqlStatement: m["sql_statement"].(string),
+	}
+}
+
+// sortTaskGraphChildren validates the graph built from the root task and the given children and returns the children in the order of execution
+// (every task is placed after all of its predecessors). The root task has to be the only task without predecessors, all the predecessors have to
+// be declared in the graph, and the dependencies cannot contain cycles.
+func sortTaskGraphChildren(rootName string, children []taskGraphChild, finalizerName string) ([]taskGraphChild, error) {
+	byName := make(map[string]taskGraphChild)
+	for _, child := range children {
+		if child.name == rootName {
+			return nil, fmt.Errorf("task %s is declared both as the root task and as a child task", child.name)
+		}
+		if child.name == finalizerName {
+			return nil, fmt.Errorf("task %s is declared both as the finalizer task and as a child task", child.name)
+		}
+		if _, ok := byName[child.name]; ok {
+			return nil, fmt.Errorf("child task %s is declared more than once", child.name)
+		}
+		if len(child.after) == 0 {
+			return nil, fmt.Errorf("child task %s has no predecessors; the root task has to be the only task without predecessors", child.name)
+		}
+		byName[child.name] = child
+	}
+	if finalizerName != "" && finalizerName == rootName {
+		return nil, fmt.Errorf("task %s is declared both as the root task and as the finalizer task", finalizerName)
+	}
+
+	for _, child := range children {
+		for _, predecessor := range child.after {
+			if _, ok := byName[predecessor]; !ok && predecessor != rootName {
+				return nil, fmt.Errorf("child task %s runs after %s, which is neither the root task nor a child task of the graph", child.name, predecessor)
+			}
+		}
+	}
+
+	sorted := make([]taskGraphChild, 0, len(children))
+	done := map[string]bool{rootName: true}
+	for len(sorted) < len(children) {
+		progressed := false
+		for _, child := range children {
+			if done[child.name] {
+				continue
+			}
+			if !slices.ContainsFunc(child.after, func(predecessor string) bool { return !done[predecessor] }) {
+				sorted = append(sorted, child)
+				done[child.name] = true
+				progressed = true
+			}
+		}
+		if !progressed {
+			remaining := make([]string, 0)
+			for _, child := range children {
+				if !done[child.name] {
+					remaining = append(remaining, child.name)
+				}
+			}
+			return nil, fmt.Errorf("the dependencies between the following child tasks contain a cycle: %s", strings.Join(remaining, ", "))
+		}
+	}
+	return sorted, nil
+}
+
+func validateTaskGraphCustomDiff(_ context.Context, diff *schema.ResourceDiff, _ any) error {
+	if !diff.NewValueKnown("name") || !diff.NewValueKnown("task") || !diff.NewValueKnown("finalizer") {
+		return nil
+	}
+	children := taskGraphChildrenFromRaw(diff.Get("task"))
+	for _, child := range children {
+		if child.name == "" || slices.Contains(child.after, "") {
+			return nil
+		}
+	}
+	finalizerName := ""
+	if finalizer := taskGraphFinalizerFromRaw(diff.Get("finalizer")); finalizer != nil {
+		finalizerName = finalizer.name
+	}
+	_, err := sortTaskGraphChildren(diff.Get("name").(string), children, finalizerName)
+	return err
+}
+
+// ignoreChangeToCurrentRootTaskValueInShow works like IgnoreChangeToCurrentSnowflakeValueInShow, but for the root task, which is the first element of show_output.
+func ignoreChangeToCurrentRootTaskValueInShow(keyInOutput string) schema.SchemaDiffSuppressFunc {
+	return func(_, _, new string, d *schema.ResourceData) bool {
+		if d.Id() == "" {
+			return false
+		}
+		if showOutput, ok := d.GetOk(ShowOutputAttributeName); ok && len(showOutput.([]any)) > 0 {
+			return new == fmt.Sprintf("%v", showOutput.([]any)[0].(map[string]any)[keyInOutput])
+		}
+		return false
+	}
+}
+
+func taskGraphIdsFromNames(rootId sdk.SchemaObjectIdentifier, names []string) []sdk.SchemaObjectIdentifier {
+	return collections.Map(names, func(name string) sdk.SchemaObjectIdentifier {
+		return sdk.NewSchemaObjectIdentifierInSchema(rootId.SchemaId(), name)
+	})
+}
+
+func createTaskGraphChild(ctx context.Context, client *sdk.Client, rootId sdk.SchemaObjectIdentifier, child taskGraphChild) error {
+	req := sdk.NewCreateTaskRequest(sdk.NewSchemaObjectIdentifierInSchema(rootId.SchemaId(), child.name), child.sqlStatement).
+		WithAfter(taskGraphIdsFromNames(rootId, child.after))
+	if child.warehouse != "" {
+		req.WithWarehouse(*sdk.NewCreateTaskWarehouseRequest().WithWarehouse(sdk.NewAccountObjectIdentifier(child.warehouse)))
+	}
+	if child.comment != "" {
+		req.WithComment(child.comment)
+	}
+	if child.when != "" {
+		req.WithWhen(child.when)
+	}
+	return client.Tasks.Create(ctx, req)
+}
+
+func createTaskGraphFinalizer(ctx context.Context, client *sdk.Client, rootId sdk.SchemaObjectIdentifier, finalizer taskGraphFinalizer) error {
+	req := sdk.NewCreateTaskRequest(sdk.NewSchemaObjectIdentifierInSchema(rootId.SchemaId(), finalizer.name), finalizer.sqlStatement).
+		WithFinalize(rootId)
+	if finalizer.warehouse != "" {
+		req.WithWarehouse(*sdk.NewCreateTaskWarehouseRequest().WithWarehouse(sdk.NewAccountObjectIdentifier(finalizer.warehouse)))
+	}
+	if finalizer.comment != "" {
+		req.WithComment(finalizer.comment)
+	}
+	return client.Tasks.Create(ctx, req)
+}
+
+// alterTaskGraphMember applies the changes of the properties shared by the child and finalizer tasks.
+func alterTaskGraphMember(ctx context.Context, client *sdk.Client, id sdk.SchemaObjectIdentifier, oldWarehouse, newWarehouse, oldComment, newComment, oldSqlStatement, newSqlStatement string) error {
+	set, unset := sdk.NewTaskSetRequest(), sdk.NewTaskUnsetRequest()
+	if oldWarehouse != newWarehouse {
+		if newWarehouse != "" {
+			set.WithWarehouse(sdk.NewAccountObjectIdentifier(newWarehouse))
+		} else {
+			unset.WithWarehouse(true)
+		}
+	}
+	if oldComment != newComment {
+		if newComment != "" {
+			set.WithComment(newComment)
+		} else {
+			unset.WithComment(true)
+		}
+	}
+	if *set != (sdk.TaskSetRequest{}) {
+		if err := client.Tasks.Alter(ctx, sdk.NewAlterTaskRequest(id).WithSet(*set)); err != nil {
+			return err
+		}
+	}
+	if *unset != (sdk.TaskUnsetRequest{}) {
+		if err := client.Tasks.Alter(ctx, sdk.NewAlterTaskRequest(id).WithUnset(*unset)); err != nil {
+			return err
+		}
+	}
+	if oldSqlStatement != newSqlStatement {
+		if err := client.Tasks.Alter(ctx, sdk.NewAlterTaskRequest(id).WithModifyAs(newSqlStatement)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// resumeTaskGraph resumes all the child tasks and the finalizer task first, and then the root task.
+func resumeTaskGraph(ctx context.Context, client *sdk.Client, rootId sdk.SchemaObjectIdentifier, children []taskGraphChild, finalizer *taskGraphFinalizer) error {
+	names := collections.Map(children, func(child taskGraphChild) string { return child.name })
+	if finalizer != nil {
+		names = append(names, finalizer.name)
+	}
+	if err := client.Tasks.ResumeTasks(ctx, taskGraphIdsFromNames(rootId, names)); err != nil {
+		return err
+	}
+	return waitForTaskStart(ctx, client, rootId)
+}
+
+func CreateTaskGraph(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id := sdk.NewSchemaObjectIdentifier(d.Get("database").(string), d.Get("schema").(string), d.Get("name").(string))
+
+	finalizer := taskGraphFinalizerFromRaw(d.Get("finalizer"))
+	finalizerName := ""
+	if finalizer != nil {
+		finalizerName = finalizer.name
+	}
+	children, err := sortTaskGraphChildren(id.Name(), taskGraphChildrenFromRaw(d.Get("task")), finalizerName)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	req := sdk.NewCreateTaskRequest(id, d.Get("sql_statement").(string))
+	if errs := errors.Join(
+		attributeMappedValueCreate(d, "warehouse", &req.Warehouse, func(v any) (*sdk.CreateTaskWarehouseRequest, error) {
+			warehouseId, err := sdk.ParseAccountObjectIdentifier(v.(string))
+			if err != nil {
+				return nil, err
+			}
+			return sdk.NewCreateTaskWarehouseRequest().WithWarehouse(warehouseId), nil
+		}),
+		attributeMappedValueCreate(d, "schedule", &req.Schedule, func(v any) (*string, error) {
+			return taskScheduleCreate(d, v)
+		}),
+		stringAttributeCreate(d, "config", &req.Config),
+		accountObjectIdentifierAttributeCreate(d, "error_integration", &req.ErrorIntegration),
+		stringAttributeCreate(d, "comment", &req.Comment),
+		stringAttributeCreate(d, "when", &req.When),
+	); errs != nil {
+		return diag.FromErr(errs)
+	}
+
+	if err := client.Tasks.Create(ctx, req); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(helpers.EncodeResourceIdentifier(id))
+
+	for _, child := range children {
+		if err := createTaskGraphChild(ctx, client, id, child); err != nil {
+			return diag.FromErr(fmt.Errorf("error creating child task %s of task graph %s, err = %w", child.name, id.FullyQualifiedName(), err))
+		}
+	}
+
+	if finalizer != nil {
+		if err := createTaskGraphFinalizer(ctx, client, id, *finalizer); err != nil {
+			return diag.FromErr(fmt.Errorf("error creating finalizer task %s of task graph %s, err = %w", finalizer.name, id.FullyQualifiedName(), err))
+		}
+	}
+
+	// Tasks are created as suspended (https://docs.snowflake.com/en/sql-reference/sql/create-task; "important" section), so they are resumed only when needed.
+	if d.Get("started").(bool) {
+		if err := resumeTaskGraph(ctx, client, id, children, finalizer); err != nil {
+			return diag.Diagnostics{
+				{
+					Severity: diag.Error,
+					Summary:  "Failed to start the task graph",
+					Detail:   fmt.Sprintf("Id: %s, err: %s", id.FullyQualifiedName(), err),
+				},
+			}
+		}
+	}
+
+	return ReadTaskGraph(ctx, d, meta)
+}
+
+func UpdateTaskGraph(ctx context.Context, d *schema.ResourceData, meta any) (diags diag.Diagnostics) {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseSchemaObjectIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	oldFinalizerRaw, newFinalizerRaw := d.GetChange("finalizer")
+	oldFinalizer, newFinalizer := taskGraphFinalizerFromRaw(oldFinalizerRaw), taskGraphFinalizerFromRaw(newFinalizerRaw)
+	newFinalizerName := ""
+	if newFinalizer != nil {
+		newFinalizerName = newFinalizer.name
+	}
+	oldChildrenRaw, newChildrenRaw := d.GetChange("task")
+	oldChildren := taskGraphChildrenFromRaw(oldChildrenRaw)
+	newChildren, err := sortTaskGraphChildren(id.Name(), taskGraphChildrenFromRaw(newChildrenRaw), newFinalizerName)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	rootTask, err := client.Tasks.ShowByID(ctx, id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// The root task is suspended once for all the changes. There is no rollback: if any of the changes fails, the changes applied before it stay,
+	// and the deferred function only resumes the root task (if it was started before).
+	resumeRequired := rootTask.IsStarted()
+	if rootTask.IsStarted() {
+		if err := client.Tasks.Alter(ctx, sdk.NewAlterTaskRequest(id).WithSuspend(true)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	defer func() {
+		if resumeRequired {
+			if err := client.Tasks.ResumeTasks(ctx, []sdk.SchemaObjectIdentifier{id}); err != nil {
+				diags = append(diags, resumeTaskErrorDiag(id, "update", err))
+			}
+		}
+	}()
+
+	unset := sdk.NewTaskUnsetRequest()
+	set := sdk.NewTaskSetRequest()
+	if err := errors.Join(
+		accountObjectIdentifierAttributeUpdate(d, "warehouse", &set.Warehouse, &unset.Warehouse),
+		stringAttributeUpdate(d, "config", &set.Config, &unset.Config),
+		accountObjectIdentifierAttributeUpdate(d, "error_integration", &set.ErrorIntegration, &unset.ErrorIntegration),
+		stringAttributeUpdate(d, "comment", &set.Comment, &unset.Comment),
+	); err != nil {
+		return diag.FromErr(err)
+	}
+	taskScheduleUpdate(d, set, unset)
+
+	if *set != (sdk.TaskSetRequest{}) {
+		if err := client.Tasks.Alter(ctx, sdk.NewAlterTaskRequest(id).WithSet(*set)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if *unset != (sdk.TaskUnsetRequest{}) {
+		if err := client.Tasks.Alter(ctx, sdk.NewAlterTaskRequest(id).WithUnset(*unset)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if d.HasChange("when") {
+		if v := d.Get("when").(string); v != "" {
+			err = client.Tasks.Alter(ctx, sdk.NewAlterTaskRequest(id).WithModifyWhen(v))
+		} else {
+			err = client.Tasks.Alter(ctx, sdk.NewAlterTaskRequest(id).WithRemoveWhen(true))
+		}
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if d.HasChange("sql_statement") {
+		if err := client.Tasks.Alter(ctx, sdk.NewAlterTaskRequest(id).WithModifyAs(d.Get("sql_statement").(string))); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange("task") {
+		oldChildrenByName := make(map[string]taskGraphChild)
+		for _, child := range oldChildren {
+			oldChildrenByName[child.name] = child
+		}
+		newChildrenNames := collections.Map(newChildren, func(child taskGraphChild) string { return child.name })
+
+		// New tasks are created in the order of execution, so their predecessors always exist.
+		for _, child := range newChildren {
+			oldChild, ok := oldChildrenByName[child.name]
+			if !ok {
+				if err := createTaskGraphChild(ctx, client, id, child); err != nil {
+					return diag.FromErr(fmt.Errorf("error creating child task %s of task graph %s, err = %w", child.name, id.FullyQualifiedName(), err))
+				}
+				continue
+			}
+
+			childId := sdk.NewSchemaObjectIdentifierInSchema(id.SchemaId(), child.name)
+			if !child.equalsIgnoringAfter(oldChild) {
+				if err := alterTaskGraphMember(ctx, client, childId, oldChild.warehouse, child.warehouse, oldChild.comment, child.comment, oldChild.sqlStatement, child.sqlStatement); err != nil {
+					return diag.FromErr(err)
+				}
+				if oldChild.when != child.when {
+					if child.when != "" {
+						err = client.Tasks.Alter(ctx, sdk.NewAlterTaskRequest(childId).WithModifyWhen(child.when))
+					} else {
+						err = client.Tasks.Alter(ctx, sdk.NewAlterTaskRequest(childId).WithRemoveWhen(true))
+					}
+					if err != nil {
+						return diag.FromErr(err)
+					}
+				}
+			}
+
+			// Predecessors are added before the old ones are removed, so the task never becomes a standalone task.
+			addedPredecessors, removedPredecessors := ListDiff(oldChild.after, child.after)
+			if len(addedPredecessors) > 0 {
+				if err := client.Tasks.Alter(ctx, sdk.NewAlterTaskRequest(childId).WithAddAfter(taskGraphIdsFromNames(id, addedPredecessors))); err != nil {
+					return diag.FromErr(err)
+				}
+			}
+			if len(removedPredecessors) > 0 {
+				if err := client.Tasks.Alter(ctx, sdk.NewAlterTaskRequest(childId).WithRemoveAfter(taskGraphIdsFromNames(id, removedPredecessors))); err != nil {
+					return diag.FromErr(err)
+				}
+			}
+		}
+
+		// Removed tasks are dropped last, when no remaining task depends on them anymore.
+		for i := len(oldChildren) - 1; i >= 0; i-- {
+			if slices.Contains(newChildrenNames, oldChildren[i].name) {
+				continue
+			}
+			if err := client.Tasks.Drop(ctx, sdk.NewDropTaskRequest(sdk.NewSchemaObjectIdentifierInSchema(id.SchemaId(), oldChildren[i].name)).WithIfExists(true)); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	if d.HasChange("finalizer") {
+		switch {
+		case oldFinalizer != nil && newFinalizer != nil && oldFinalizer.name == newFinalizer.name:
+			finalizerId := sdk.NewSchemaObjectIdentifierInSchema(id.SchemaId(), newFinalizer.name)
+			if err := alterTaskGraphMember(ctx, client, finalizerId, oldFinalizer.warehouse, newFinalizer.warehouse, oldFinalizer.comment, newFinalizer.comment, oldFinalizer.sqlStatement, newFinalizer.sqlStatement); err != nil {
+				return diag.FromErr(err)
+			}
+		default:
+			if oldFinalizer != nil {
+				if err := client.Tasks.Drop(ctx, sdk.NewDropTaskRequest(sdk.NewSchemaObjectIdentifierInSchema(id.SchemaId(), oldFinalizer.name)).WithIfExists(true)); err != nil {
+					return diag.FromErr(err)
+				}
+			}
+			if newFinalizer != nil {
+				if err := createTaskGraphFinalizer(ctx, client, id, *newFinalizer); err != nil {
+					return diag.FromErr(fmt.Errorf("error creating finalizer task %s of task graph %s, err = %w", newFinalizer.name, id.FullyQualifiedName(), err))
+				}
+			}
+		}
+	}
+
+	resumeRequired = false
+	if d.Get("started").(bool) {
+		if err := resumeTaskGraph(ctx, client, id, newChildren, newFinalizer); err != nil {
+			return diag.FromErr(fmt.Errorf("failed to resume task graph %s, err = %w", id.FullyQualifiedName(), err))
+		}
+	}
+	// We don't process the else case, because the root task was already suspended at the beginning of the Update method.
+
+	return append(diags, ReadTaskGraph(ctx, d, meta)...)
+}
+
+func ReadTaskGraph(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseSchemaObjectIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	rootTask, err := client.Tasks.ShowByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, sdk.ErrObjectNotFound) {
+			d.SetId("")
+			return diag.Diagnostics{
+				diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  "Failed to query the root task of the task graph. Marking the resource as removed.",
+					Detail:   fmt.Sprintf("Task graph root task name: %s, Err: %s", id.FullyQualifiedName(), err),
+				},
+			}
+		}
+		return diag.FromErr(err)
+	}
+
+	tasksInSchema, err := client.Tasks.Show(ctx, sdk.NewShowTaskRequest().WithIn(sdk.ExtendedIn{In: sdk.In{Schema: id.SchemaId()}}))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	graphChildren, finalizerTask := taskGraphMembers(*rootTask, tasksInSchema)
+
+	// The child tasks already present in the state keep their positions; the ones added outside Terraform are appended in the order of execution.
+	stateChildrenNames := collections.Map(taskGraphChildrenFromRaw(d.Get("task")), func(child taskGraphChild) string { return child.name })
+	orderedChildren := slices.Clone(graphChildren)
+	slices.SortStableFunc(orderedChildren, func(a, b sdk.Task) int {
+		ai, bi := slices.Index(stateChildrenNames, a.Name), slices.Index(stateChildrenNames, b.Name)
+		switch {
+		case ai == -1 && bi == -1:
+			return 0
+		case ai == -1:
+			return 1
+		case bi == -1:
+			return -1
+		default:
+			return ai - bi
+		}
+	})
+	children := make([]map[string]any, len(orderedChildren))
+	for i, child := range orderedChildren {
+		children[i] = map[string]any{
+			"name":          child.Name,
+			"after":         collections.Map(child.TaskRelations.Predecessors, func(predecessor sdk.SchemaObjectIdentifier) string { return predecessor.Name() }),
+			"warehouse":     taskWarehouseName(child),
+			"comment":       child.Comment,
+			"when":          child.Condition,
+			"sql_statement": child.Definition,
+		}
+	}
+
+	var finalizer []map[string]any
+	if finalizerTask != nil {
+		finalizer = []map[string]any{{
+			"name":          finalizerTask.Name,
+			"warehouse":     taskWarehouseName(*finalizerTask),
+			"comment":       finalizerTask.Comment,
+			"sql_statement": finalizerTask.Definition,
+		}}
+	}
+
+	showOutput := []map[string]any{schemas.TaskToSchema(rootTask)}
+	for _, child := range graphChildren {
+		showOutput = append(showOutput, schemas.TaskToSchema(&child))
+	}
+	if finalizerTask != nil {
+		showOutput = append(showOutput, schemas.TaskToSchema(finalizerTask))
+	}
+
+	if errs := errors.Join(
+		attributeMappedValueReadOrDefault(d, "error_integration", rootTask.ErrorIntegration, func(errorIntegration *sdk.AccountObjectIdentifier) (string, error) {
+			return errorIntegration.Name(), nil
+		}, nil),
+		d.Set("warehouse", taskWarehouseName(*rootTask)),
+		taskScheduleRead(d, rootTask.Schedule),
+		d.Set("started", rootTask.IsStarted()),
+		d.Set("when", rootTask.Condition),
+		d.Set("config", rootTask.Config),
+		d.Set("comment", rootTask.Comment),
+		d.Set("sql_statement", rootTask.Definition),
+		d.Set("task", children),
+		d.Set("finalizer", finalizer),
+		d.Set(FullyQualifiedNameAttributeName, id.FullyQualifiedName()),
+		d.Set(ShowOutputAttributeName, showOutput),
+	); errs != nil {
+		return diag.FromErr(errs)
+	}
+
+	return nil
+}
+
+// taskGraphMembers returns the child tasks of the graph with the given root task in the order of execution, and the finalizer task of the graph (if any).
+// Only the tasks from the schema of the root task are considered.
+func taskGraphMembers(rootTask sdk.Task, tasksInSchema []sdk.Task) ([]sdk.Task, *sdk.Task) {
+	rootId := rootTask.ID()
+	members := map[string]bool{rootId.FullyQualifiedName(): true}
+	children := make([]sdk.Task, 0)
+	for {
+		progressed := false
+		for _, task := range tasksInSchema {
+			if members[task.ID().FullyQualifiedName()] || len(task.TaskRelations.Predecessors) == 0 {
+				continue
+			}
+			if !slices.ContainsFunc(task.TaskRelations.Predecessors, func(predecessor sdk.SchemaObjectIdentifier) bool {
+				return !members[predecessor.FullyQualifiedName()]
+			}) {
+				children = append(children, task)
+				members[task.ID().FullyQualifiedName()] = true
+				progressed = true
+			}
+		}
+		if !progressed {
+			break
+		}
+	}
+
+	var finalizer *sdk.Task
+	if rootTask.TaskRelations.FinalizerTask != nil {
+		if found, err := collections.FindFirst(tasksInSchema, func(task sdk.Task) bool {
+			return task.ID().FullyQualifiedName() == rootTask.TaskRelations.FinalizerTask.FullyQualifiedName()
+		}); err == nil {
+			finalizer = found
+		}
+	}
+	return children, finalizer
+}
+
+func taskWarehouseName(task sdk.Task) string {
+	if task.Warehouse != nil {
+		return task.Warehouse.Name()
+	}
+	return ""
+}
+
+func DeleteTaskGraph(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseSchemaObjectIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	rootTask, err := client.Tasks.ShowByID(ctx, id)
+	if err != nil && !errors.Is(err, sdk.ErrObjectNotFound) {
+		return diag.FromErr(err)
+	}
+	if rootTask != nil && rootTask.IsStarted() {
+		if err := client.Tasks.Alter(ctx, sdk.NewAlterTaskRequest(id).WithSuspend(true)); err != nil {
+			return diag.FromErr(fmt.Errorf("error suspending root task %s, err = %w", id.FullyQualifiedName(), err))
+		}
+	}
+
+	names := make([]string, 0)
+	if finalizer := taskGraphFinalizerFromRaw(d.Get("finalizer")); finalizer != nil {
+		names = append(names, finalizer.name)
+	}
+	children := taskGraphChildrenFromRaw(d.Get("task"))
+	for i := len(children) - 1; i >= 0; i-- {
+		names = append(names, children[i].name)
+	}
+	names = append(names, id.Name())
+
+	for _, taskId := range taskGraphIdsFromNames(id, names) {
+		if err := client.Tasks.Drop(ctx, sdk.NewDropTaskRequest(taskId).WithIfExists(true)); err != nil {
+			return diag.FromErr(fmt.Errorf("error deleting task %s of task graph %s, err = %w", taskId.FullyQualifiedName(), id.FullyQualifiedName(), err))
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,235 @@
+package resources_test
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/helpers/random"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/testenvs"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+type taskGraphChildConfig struct {
+	name         string
+	after        []string
+	sqlStatement string
+}
+
+func TestAcc_TaskGraph_BasicFlow(t *testing.T) {
+	_ = testenvs.GetOrSkipTest(t, testenvs.EnableAcceptance)
+	acc.TestAccPreCheck(t)
+
+	rootId := acc.TestClient().Ids.RandomSchemaObjectIdentifier()
+	childA := acc.TestClient().Ids.RandomSchemaObjectIdentifier()
+	childB := acc.TestClient().Ids.RandomSchemaObjectIdentifier()
+	childC := acc.TestClient().Ids.RandomSchemaObjectIdentifier()
+	finalizerId := acc.TestClient().Ids.RandomSchemaObjectIdentifier()
+	comment := random.Comment()
+	resourceReference := "snowflake_task_graph.test"
+
+	initialChildren := []taskGraphChildConfig{
+		{name: childA.Name(), after: []string{rootId.Name()}, sqlStatement: "SELECT 1"},
+		{name: childB.Name(), after: []string{childA.Name()}, sqlStatement: "SELECT 2"},
+	}
+	changedChildren := []taskGraphChildConfig{
+		{name: childB.Name(), after: []string{rootId.Name()}, sqlStatement: "SELECT 22"},
+		{name: childC.Name(), after: []string{childB.Name()}, sqlStatement: "SELECT 3"},
+	}
+
+	assertTaskState := func(id sdk.SchemaObjectIdentifier, state sdk.TaskState) resource.TestCheckFunc {
+		return func(_ *terraform.State) error {
+			task, err := acc.TestClient().Task.Show(t, id)
+			if err != nil {
+				return err
+			}
+			if task.State != state {
+				return fmt.Errorf("expected task %s to be in state %s, got %s", id.FullyQualifiedName(), state, task.State)
+			}
+			return nil
+		}
+	}
+	assertTaskNotExists := func(id sdk.SchemaObjectIdentifier) resource.TestCheckFunc {
+		return func(_ *terraform.State) error {
+			if _, err := acc.TestClient().Task.Show(t, id); err == nil {
+				return fmt.Errorf("expected task %s to be dropped", id.FullyQualifiedName())
+			}
+			return nil
+		}
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: acc.CheckDestroy(t, resources.TaskGraph),
+		Steps: []resource.TestStep{
+			// create
+			{
+				Config: taskGraphConfig(rootId, true, "", initialChildren, finalizerId.Name()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceReference, "name", rootId.Name()),
+					resource.TestCheckResourceAttr(resourceReference, "fully_qualified_name", rootId.FullyQualifiedName()),
+					resource.TestCheckResourceAttr(resourceReference, "started", "true"),
+					resource.TestCheckResourceAttr(resourceReference, "task.#", "2"),
+					resource.TestCheckResourceAttr(resourceReference, "task.0.name", childA.Name()),
+					resource.TestCheckResourceAttr(resourceReference, "task.1.name", childB.Name()),
+					resource.TestCheckResourceAttr(resourceReference, "task.1.after.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceReference, "task.1.after.*", childA.Name()),
+					resource.TestCheckResourceAttr(resourceReference, "finalizer.0.name", finalizerId.Name()),
+					resource.TestCheckResourceAttr(resourceReference, "show_output.#", "4"),
+					resource.TestCheckResourceAttr(resourceReference, "show_output.0.name", rootId.Name()),
+					resource.TestCheckResourceAttr(resourceReference, "show_output.1.name", childA.Name()),
+					resource.TestCheckResourceAttr(resourceReference, "show_output.2.name", childB.Name()),
+					resource.TestCheckResourceAttr(resourceReference, "show_output.3.name", finalizerId.Name()),
+					assertTaskState(rootId, sdk.TaskStateStarted),
+					assertTaskState(childA, sdk.TaskStateStarted),
+					assertTaskState(childB, sdk.TaskStateStarted),
+					assertTaskState(finalizerId, sdk.TaskStateStarted),
+				),
+			},
+			// import
+			{
+				ResourceName:      resourceReference,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// change the graph in place: drop A, re-attach B to the root, add C, change the root comment, remove the finalizer
+			{
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceReference, plancheck.ResourceActionUpdate),
+					},
+				},
+				Config: taskGraphConfig(rootId, true, comment, changedChildren, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceReference, "comment", comment),
+					resource.TestCheckResourceAttr(resourceReference, "task.#", "2"),
+					resource.TestCheckResourceAttr(resourceReference, "task.0.name", childB.Name()),
+					resource.TestCheckResourceAttr(resourceReference, "task.0.sql_statement", "SELECT 22"),
+					resource.TestCheckTypeSetElemAttr(resourceReference, "task.0.after.*", rootId.Name()),
+					resource.TestCheckResourceAttr(resourceReference, "task.1.name", childC.Name()),
+					resource.TestCheckResourceAttr(resourceReference, "finalizer.#", "0"),
+					resource.TestCheckResourceAttr(resourceReference, "show_output.#", "3"),
+					assertTaskNotExists(childA),
+					assertTaskNotExists(finalizerId),
+					assertTaskState(rootId, sdk.TaskStateStarted),
+					assertTaskState(childC, sdk.TaskStateStarted),
+				),
+			},
+			// suspend
+			{
+				Config: taskGraphConfig(rootId, false, comment, changedChildren, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceReference, "started", "false"),
+					assertTaskState(rootId, sdk.TaskStateSuspended),
+				),
+			},
+			// external change to a child task is detected
+			{
+				PreConfig: func() {
+					acc.TestClient().Task.Alter(t, sdk.NewAlterTaskRequest(childC).WithModifyAs("SELECT 4"))
+				},
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceReference, plancheck.ResourceActionUpdate),
+					},
+				},
+				Config: taskGraphConfig(rootId, false, comment, changedChildren, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceReference, "task.1.sql_statement", "SELECT 3"),
+				),
+			},
+		},
+	})
+}
+
+func TestAcc_TaskGraph_Validations(t *testing.T) {
+	_ = testenvs.GetOrSkipTest(t, testenvs.EnableAcceptance)
+	acc.TestAccPreCheck(t)
+
+	rootId := acc.TestClient().Ids.RandomSchemaObjectIdentifier()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: acc.CheckDestroy(t, resources.TaskGraph),
+		Steps: []resource.TestStep{
+			{
+				Config: taskGraphConfig(rootId, false, "", []taskGraphChildConfig{
+					{name: "A", after: []string{rootId.Name(), "B"}, sqlStatement: "SELECT 1"},
+					{name: "B", after: []string{"A"}, sqlStatement: "SELECT 1"},
+				}, ""),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("the dependencies between the following child tasks contain a cycle: A, B"),
+			},
+			{
+				Config: taskGraphConfig(rootId, false, "", []taskGraphChildConfig{
+					{name: "A", after: []string{"UNKNOWN"}, sqlStatement: "SELECT 1"},
+				}, ""),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("child task A runs after UNKNOWN, which is neither the root task nor a child task of the graph"),
+			},
+			{
+				Config: taskGraphConfig(rootId, false, "", []taskGraphChildConfig{
+					{name: "A", after: []string{rootId.Name()}, sqlStatement: "SELECT 1"},
+				}, "A"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("task A is declared both as the finalizer task and as a child task"),
+			},
+		},
+	})
+}
+
+func taskGraphConfig(rootId sdk.SchemaObjectIdentifier, started bool, comment string, children []taskGraphChildConfig, finalizerName string) string {
+	var b strings.Builder
+	for _, child := range children {
+		b.WriteString(fmt.Sprintf(`
+  task {
+    name          = "%s"
+    after         = ["%s"]
+    sql_statement = "%s"
+  }
+`, child.name, strings.Join(child.after, `", "`), child.sqlStatement))
+	}
+	if finalizerName != "" {
+		b.WriteString(fmt.Sprintf(`
+  finalizer {
+    name          = "%s"
+    sql_statement = "SELECT 0"
+  }
+`, finalizerName))
+	}
+	commentConfig := ""
+	if comment != "" {
+		commentConfig = fmt.Sprintf(`comment = "%s"`, comment)
+	}
+	return fmt.Sprintf(`
+resource "snowflake_task_graph" "test" {
+  database      = "%[1]s"
+  schema        = "%[2]s"
+  name          = "%[3]s"
+  started       = %[4]t
+  warehouse     = "%[5]s"
+  sql_statement = "SELECT 0"
+  %[6]s
+
+  schedule {
+    minutes = 10
+  }
+%[7]s
+}
+`, rootId.DatabaseName(), rootId.SchemaName(), rootId.Name(), started, acc.TestClient().Ids.WarehouseId().Name(), commentConfig, b.String())
+}
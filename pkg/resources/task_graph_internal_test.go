@@ -0,0 +1,88 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_sortTaskGraphChildren(t *testing.T) {
+	child := func(name string, after ...string) taskGraphChild {
+		return taskGraphChild{name: name, after: after}
+	}
+	names := func(children []taskGraphChild) []string {
+		return collections.Map(children, func(c taskGraphChild) string { return c.name })
+	}
+
+	testCases := []struct {
+		name          string
+		children      []taskGraphChild
+		finalizer     string
+		expectedOrder []string
+		expectedError string
+	}{
+		{name: "no children", children: []taskGraphChild{}, expectedOrder: []string{}},
+		{name: "chain in order", children: []taskGraphChild{child("a", "root"), child("b", "a")}, expectedOrder: []string{"a", "b"}},
+		{name: "chain in reverse order", children: []taskGraphChild{child("b", "a"), child("a", "root")}, expectedOrder: []string{"a", "b"}},
+		{name: "diamond", children: []taskGraphChild{child("d", "b", "c"), child("b", "root"), child("c", "root")}, expectedOrder: []string{"b", "c", "d"}},
+		{name: "with finalizer", children: []taskGraphChild{child("a", "root")}, finalizer: "fin", expectedOrder: []string{"a"}},
+		{name: "duplicated child", children: []taskGraphChild{child("a", "root"), child("a", "root")}, expectedError: "child task a is declared more than once"},
+		{name: "child named as root", children: []taskGraphChild{child("root", "root")}, expectedError: "task root is declared both as the root task and as a child task"},
+		{name: "child named as finalizer", children: []taskGraphChild{child("fin", "root")}, finalizer: "fin", expectedError: "task fin is declared both as the finalizer task and as a child task"},
+		{name: "finalizer named as root", children: []taskGraphChild{}, finalizer: "root", expectedError: "task root is declared both as the root task and as the finalizer task"},
+		{name: "second root", children: []taskGraphChild{child("a")}, expectedError: "child task a has no predecessors"},
+		{name: "unknown predecessor", children: []taskGraphChild{child("a", "root", "x")}, expectedError: "child task a runs after x, which is neither the root task nor a child task of the graph"},
+		{name: "finalizer as predecessor", children: []taskGraphChild{child("a", "fin")}, finalizer: "fin", expectedError: "child task a runs after fin"},
+		{name: "cycle", children: []taskGraphChild{child("a", "root", "c"), child("b", "a"), child("c", "b"), child("d", "root")}, expectedError: "contain a cycle: a, b, c"},
+		{name: "self reference", children: []taskGraphChild{child("a", "a")}, expectedError: "contain a cycle: a"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			sorted, err := sortTaskGraphChildren("root", tc.children, tc.finalizer)
+			if tc.expectedError != "" {
+				require.ErrorContains(t, err, tc.expectedError)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tc.expectedOrder, names(sorted))
+			}
+		})
+	}
+}
+
+func Test_taskGraphMembers(t *testing.T) {
+	schemaId := sdk.NewDatabaseObjectIdentifier("db", "sch")
+	task := func(name string, relations sdk.TaskRelations) sdk.Task {
+		return sdk.Task{DatabaseName: schemaId.DatabaseName(), SchemaName: schemaId.Name(), Name: name, TaskRelations: relations}
+	}
+	id := func(name string) sdk.SchemaObjectIdentifier {
+		return sdk.NewSchemaObjectIdentifierInSchema(schemaId, name)
+	}
+	predecessors := func(names ...string) sdk.TaskRelations {
+		return sdk.TaskRelations{Predecessors: collections.Map(names, id)}
+	}
+
+	root := task("root", sdk.TaskRelations{FinalizerTask: sdk.Pointer(id("fin"))})
+	tasksInSchema := []sdk.Task{
+		task("c", predecessors("a", "b")),
+		root,
+		task("other_root", sdk.TaskRelations{}),
+		task("other_child", predecessors("other_root")),
+		task("b", predecessors("root")),
+		task("a", predecessors("root")),
+		task("fin", sdk.TaskRelations{FinalizedRootTask: sdk.Pointer(id("root"))}),
+	}
+
+	children, finalizer := taskGraphMembers(root, tasksInSchema)
+
+	assert.Equal(t, []string{"b", "a", "c"}, collections.Map(children, func(t sdk.Task) string { return t.Name }))
+	require.NotNil(t, finalizer)
+	assert.Equal(t, "fin", finalizer.Name)
+
+	children, finalizer = taskGraphMembers(task("other_root", sdk.TaskRelations{}), tasksInSchema)
+
+	assert.Equal(t, []string{"other_child"}, collections.Map(children, func(t sdk.Task) string { return t.Name }))
+	assert.Nil(t, finalizer)
+}
@@ -373,6 +373,7 @@ func GetCreateUserFunc(userType sdk.UserType) func(ctx context.Context, d *schem
 			)
 			opts.ObjectProperties.Type = sdk.Pointer(sdk.UserTypeLegacyService)
 		case sdk.UserTypeService:
+			opts.ObjectProperties.WorkloadIdentity = workloadIdentityFromConfig(d)
 			opts.ObjectProperties.Type = sdk.Pointer(sdk.UserTypeService)
 		}
 		if userTypeSpecificFieldsErrs != nil {
@@ -520,6 +521,10 @@ func GetReadUserFunc(userType sdk.UserType, withExternalChangesMarking bool) sch
 						setFromStringPropertyIfNotEmpty(rd, "last_name", ud.LastName),
 					)
 				}
+				if userType == sdk.UserTypeService && ud.HasWorkloadIdentity != nil && !ud.HasWorkloadIdentity.Value {
+					// the details of the workload identity cannot be read, so only its removal is detected
+					errs = errors.Join(errs, rd.Set("workload_identity", nil))
+				}
 				return errs
 			}(d, userDetails),
 
@@ -617,6 +622,14 @@ func GetUpdateUserFunc(userType sdk.UserType) func(ctx context.Context, d *schem
 			userTypeSpecificFieldsErrs = errors.Join(
 				booleanStringAttributeUpdate(d, "must_change_password", &setObjectProperties.MustChangePassword, &unsetObjectProperties.MustChangePassword),
 			)
+		case sdk.UserTypeService:
+			if d.HasChange("workload_identity") {
+				if workloadIdentity := workloadIdentityFromConfig(d); workloadIdentity != nil {
+					setObjectProperties.WorkloadIdentity = workloadIdentity
+				} else {
+					unsetObjectProperties.WorkloadIdentity = sdk.Bool(true)
+				}
+			}
 		}
 		if userTypeSpecificFieldsErrs != nil {
 			return diag.FromErr(userTypeSpecificFieldsErrs)
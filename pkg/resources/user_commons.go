@@ -3,7 +3,9 @@ package resources
 import (
 	"slices"
 
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 var serviceUserNotApplicableAttributes = []string{
@@ -46,6 +48,108 @@ var userExternalChangesAttributes = []string{
 	"disable_mfa",
 }
 
+var workloadIdentityTypes = []string{"workload_identity.0.aws", "workload_identity.0.azure", "workload_identity.0.gcp", "workload_identity.0.oidc"}
+
+// serviceUserOnlySchema contains attributes that are applicable only to the SERVICE user type.
+var serviceUserOnlySchema = map[string]*schema.Schema{
+	"workload_identity": {
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: externalChangesNotDetectedFieldDescription("Specifies the workload identity federation configuration of the user; used for keyless authentication from AWS, Azure, GCP, or OIDC-compatible workloads. More information can be found in [doc](https://docs.snowflake.com/en/user-guide/workload-identity-federation). Only the presence of the workload identity is checked on Snowflake side, because the details cannot be read."),
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"aws": {
+					Type:         schema.TypeList,
+					Optional:     true,
+					MaxItems:     1,
+					ExactlyOneOf: workloadIdentityTypes,
+					Description:  "Specifies an AWS workload identity.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"arn": {
+								Type:             schema.TypeString,
+								Required:         true,
+								ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotEmpty),
+								Description:      "Specifies the ARN of the AWS IAM user or role that is allowed to authenticate.",
+							},
+						},
+					},
+				},
+				"azure": {
+					Type:         schema.TypeList,
+					Optional:     true,
+					MaxItems:     1,
+					ExactlyOneOf: workloadIdentityTypes,
+					Description:  "Specifies an Azure workload identity.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"issuer": {
+								Type:             schema.TypeString,
+								Required:         true,
+								ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotEmpty),
+								Description:      "Specifies the Microsoft Entra ID authorization server that issues the tokens.",
+							},
+							"subject": {
+								Type:             schema.TypeString,
+								Required:         true,
+								ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotEmpty),
+								Description:      "Specifies the object ID of the managed identity or the application.",
+							},
+						},
+					},
+				},
+				"gcp": {
+					Type:         schema.TypeList,
+					Optional:     true,
+					MaxItems:     1,
+					ExactlyOneOf: workloadIdentityTypes,
+					Description:  "Specifies a GCP workload identity.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"subject": {
+								Type:             schema.TypeString,
+								Required:         true,
+								ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotEmpty),
+								Description:      "Specifies the unique ID of the GCP service account.",
+							},
+						},
+					},
+				},
+				"oidc": {
+					Type:         schema.TypeList,
+					Optional:     true,
+					MaxItems:     1,
+					ExactlyOneOf: workloadIdentityTypes,
+					Description:  "Specifies an OIDC workload identity.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"issuer": {
+								Type:             schema.TypeString,
+								Required:         true,
+								ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotEmpty),
+								Description:      "Specifies the OpenID Connect (OIDC) issuer URL.",
+							},
+							"subject": {
+								Type:             schema.TypeString,
+								Required:         true,
+								ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotEmpty),
+								Description:      "Specifies the identifier of the workload that is connecting to Snowflake.",
+							},
+							"oidc_audience_list": {
+								Type:        schema.TypeSet,
+								Optional:    true,
+								Elem:        &schema.Schema{Type: schema.TypeString},
+								Description: "Specifies the list of allowed audience values in the ID token. When not set, Snowflake expects `snowflakecomputing.com`.",
+							},
+						},
+					},
+				},
+			},
+		},
+	},
+}
+
 var (
 	serviceUserSchema       = make(map[string]*schema.Schema)
 	legacyServiceUserSchema = make(map[string]*schema.Schema)
@@ -63,6 +167,9 @@ func init() {
 			legacyServiceUserSchema[k] = v
 		}
 	}
+	for k, v := range serviceUserOnlySchema {
+		serviceUserSchema[k] = v
+	}
 	for _, attr := range userExternalChangesAttributes {
 		if !slices.Contains(serviceUserNotApplicableAttributes, attr) {
 			serviceUserExternalChangesAttributes = append(serviceUserExternalChangesAttributes, attr)
@@ -72,3 +179,44 @@ func init() {
 		}
 	}
 }
+
+func workloadIdentityFromConfig(d *schema.ResourceData) *sdk.UserWorkloadIdentity {
+	workloadIdentityRaw, ok := d.GetOk("workload_identity")
+	if !ok || len(workloadIdentityRaw.([]any)) == 0 || workloadIdentityRaw.([]any)[0] == nil {
+		return nil
+	}
+	workloadIdentityConfig := workloadIdentityRaw.([]any)[0].(map[string]any)
+	workloadIdentity := &sdk.UserWorkloadIdentity{}
+	if v, ok := workloadIdentityConfig["aws"].([]any); ok && len(v) > 0 {
+		aws := v[0].(map[string]any)
+		workloadIdentity.AwsType = &sdk.UserWorkloadIdentityAws{
+			Arn: aws["arn"].(string),
+		}
+	}
+	if v, ok := workloadIdentityConfig["azure"].([]any); ok && len(v) > 0 {
+		azure := v[0].(map[string]any)
+		workloadIdentity.AzureType = &sdk.UserWorkloadIdentityAzure{
+			Issuer:  azure["issuer"].(string),
+			Subject: azure["subject"].(string),
+		}
+	}
+	if v, ok := workloadIdentityConfig["gcp"].([]any); ok && len(v) > 0 {
+		gcp := v[0].(map[string]any)
+		workloadIdentity.GcpType = &sdk.UserWorkloadIdentityGcp{
+			Subject: gcp["subject"].(string),
+		}
+	}
+	if v, ok := workloadIdentityConfig["oidc"].([]any); ok && len(v) > 0 {
+		oidc := v[0].(map[string]any)
+		workloadIdentity.OidcType = &sdk.UserWorkloadIdentityOidc{
+			Issuer:  oidc["issuer"].(string),
+			Subject: oidc["subject"].(string),
+		}
+		if audienceList, ok := oidc["oidc_audience_list"].(*schema.Set); ok && audienceList.Len() > 0 {
+			for _, audience := range expandStringList(audienceList.List()) {
+				workloadIdentity.OidcType.OidcAudienceList = append(workloadIdentity.OidcType.OidcAudienceList, sdk.OidcAudienceListItem{Item: audience})
+			}
+		}
+	}
+	return workloadIdentity
+}
@@ -0,0 +1,346 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/schemas"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var userProgrammaticAccessTokenSchema = map[string]*schema.Schema{
+	"user": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		Description:      relatedResourceDescription(blocklistedCharactersFieldDescription("The name of the user that the token is associated with. A user cannot use another user's programmatic access token to authenticate."), resources.User),
+		ValidateDiagFunc: IsValidIdentifier[sdk.AccountObjectIdentifier](),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+	},
+	"name": {
+		Type:             schema.TypeString,
+		Required:         true,
+		Description:      blocklistedCharactersFieldDescription("Specifies the name for the programmatic access token; must be unique for the user."),
+		ValidateDiagFunc: IsValidIdentifier[sdk.AccountObjectIdentifier](),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+	},
+	"role_restriction": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		ForceNew:         true,
+		Description:      relatedResourceDescription("The name of the role used for privilege evaluation and object creation. This must be one of the roles that has already been granted to the user. Required for service users.", resources.AccountRole),
+		ValidateDiagFunc: IsValidIdentifier[sdk.AccountObjectIdentifier](),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+	},
+	"days_to_expiry": {
+		Type:         schema.TypeInt,
+		Optional:     true,
+		ForceNew:     true,
+		ValidateFunc: validation.IntAtLeast(1),
+		Description:  externalChangesNotDetectedFieldDescription("The number of days that the programmatic access token can be used for authentication. By default, the token expires after 15 days (or the maximum set in the authentication policy of the user). Changing this value recreates the token."),
+	},
+	"mins_to_bypass_network_policy_requirement": {
+		Type:         schema.TypeInt,
+		Optional:     true,
+		ValidateFunc: validation.IntAtLeast(1),
+		Default:      IntDefault,
+		Description:  externalChangesNotDetectedFieldDescription("The number of minutes during which a user can use this token to access Snowflake without being subject to an active network policy."),
+	},
+	"disabled": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		ValidateDiagFunc: validateBooleanString,
+		Description:      booleanStringFieldDescription("Disables or enables the programmatic access token. A disabled token cannot be used for authentication."),
+		Default:          BooleanDefault,
+	},
+	"comment": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Descriptive comment about the programmatic access token.",
+	},
+	"expire_rotated_token_after_hours": {
+		Type:         schema.TypeInt,
+		Optional:     true,
+		ValidateFunc: validation.IntAtLeast(0),
+		Default:      IntDefault,
+		Description:  "The number of hours after which the previous token expires when the token is rotated. Setting it to `0` expires the previous token immediately. By default, the previous token expires after 24 hours. Used only when the token is rotated.",
+	},
+	"keepers": {
+		Type:        schema.TypeMap,
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Description: "Arbitrary map of values that, when changed, rotate the token (e.g. a timestamp from the `time_rotating` resource). The new token secret is saved in `token`.",
+	},
+	"token": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Sensitive:   true,
+		Description: "The secret of the programmatic access token. It is returned by Snowflake only when the token is added or rotated, so it is empty for imported tokens.",
+	},
+	"rotated_token_name": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The name of the previous token after the last rotation. The previous token stays valid until it expires (see `expire_rotated_token_after_hours`).",
+	},
+	ShowOutputAttributeName: {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Outputs the result of `SHOW USER PROGRAMMATIC ACCESS TOKENS` for the given token.",
+		Elem: &schema.Resource{
+			Schema: schemas.ShowProgrammaticAccessTokenSchema,
+		},
+	},
+}
+
+func UserProgrammaticAccessToken() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: PreviewFeatureCreateContextWrapper(string(previewfeatures.UserProgrammaticAccessTokenResource), TrackingCreateWrapper(resources.UserProgrammaticAccessToken, CreateUserProgrammaticAccessToken)),
+		ReadContext:   PreviewFeatureReadContextWrapper(string(previewfeatures.UserProgrammaticAccessTokenResource), TrackingReadWrapper(resources.UserProgrammaticAccessToken, ReadUserProgrammaticAccessToken)),
+		UpdateContext: PreviewFeatureUpdateContextWrapper(string(previewfeatures.UserProgrammaticAccessTokenResource), TrackingUpdateWrapper(resources.UserProgrammaticAccessToken, UpdateUserProgrammaticAccessToken)),
+		DeleteContext: PreviewFeatureDeleteContextWrapper(string(previewfeatures.UserProgrammaticAccessTokenResource), TrackingDeleteWrapper(resources.UserProgrammaticAccessToken, DeleteUserProgrammaticAccessToken)),
+		Description:   "Resource used to manage programmatic access tokens of a user. For more information, check [programmatic access tokens documentation](https://docs.snowflake.com/en/user-guide/programmatic-access-tokens).",
+
+		CustomizeDiff: TrackingCustomDiffWrapper(resources.UserProgrammaticAccessToken, customdiff.All(
+			ComputedIfAnyAttributeChanged(userProgrammaticAccessTokenSchema, ShowOutputAttributeName, "name", "mins_to_bypass_network_policy_requirement", "disabled", "comment", "keepers"),
+			ComputedIfAnyAttributeChanged(userProgrammaticAccessTokenSchema, "token", "keepers"),
+			ComputedIfAnyAttributeChanged(userProgrammaticAccessTokenSchema, "rotated_token_name", "keepers"),
+		)),
+
+		Schema: userProgrammaticAccessTokenSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: TrackingImportWrapper(resources.UserProgrammaticAccessToken, ImportUserProgrammaticAccessToken),
+		},
+		Timeouts: defaultTimeouts,
+	}
+}
+
+func ImportUserProgrammaticAccessToken(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	userId, tokenId, err := parseUserProgrammaticAccessTokenId(d.Id())
+	if err != nil {
+		return nil, err
+	}
+	if err := errors.Join(
+		d.Set("user", userId.Name()),
+		d.Set("name", tokenId.Name()),
+	); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+func CreateUserProgrammaticAccessToken(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	userId, err := sdk.ParseAccountObjectIdentifier(d.Get("user").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	tokenId, err := sdk.ParseAccountObjectIdentifier(d.Get("name").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	request := sdk.NewAddProgrammaticAccessTokenRequest(userId, tokenId)
+	if v, ok := d.GetOk("role_restriction"); ok {
+		roleId, err := sdk.ParseAccountObjectIdentifier(v.(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		request.WithRoleRestriction(roleId.Name())
+	}
+	if errs := errors.Join(
+		intAttributeCreate(d, "days_to_expiry", &request.DaysToExpiry),
+		intAttributeWithSpecialDefaultCreate(d, "mins_to_bypass_network_policy_requirement", &request.MinsToBypassNetworkPolicyRequirement),
+		stringAttributeCreate(d, "comment", &request.Comment),
+	); errs != nil {
+		return diag.FromErr(errs)
+	}
+
+	result, err := client.UserProgrammaticAccessTokens.Add(ctx, request)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(helpers.EncodeResourceIdentifier(userId.FullyQualifiedName(), tokenId.FullyQualifiedName()))
+
+	if err := d.Set("token", result.TokenSecret); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Tokens cannot be added as disabled.
+	if v := d.Get("disabled").(string); v != BooleanDefault {
+		disabled, err := booleanStringToBool(v)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if disabled {
+			err := client.UserProgrammaticAccessTokens.Modify(ctx, sdk.NewModifyProgrammaticAccessTokenRequest(userId, tokenId).
+				WithSet(*sdk.NewModifyProgrammaticAccessTokenSetRequest().WithDisabled(true)))
+			if err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	return ReadUserProgrammaticAccessToken(ctx, d, meta)
+}
+
+func ReadUserProgrammaticAccessToken(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	userId, tokenId, err := parseUserProgrammaticAccessTokenId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	token, err := client.UserProgrammaticAccessTokens.ShowByID(ctx, userId, tokenId)
+	if err != nil {
+		if errors.Is(err, sdk.ErrObjectNotFound) || errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+			d.SetId("")
+			return diag.Diagnostics{
+				diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  "Failed to query programmatic access token. Marking the resource as removed.",
+					Detail:   fmt.Sprintf("User: %s, Token: %s, Err: %s", userId.FullyQualifiedName(), tokenId.FullyQualifiedName(), err),
+				},
+			}
+		}
+		return diag.FromErr(err)
+	}
+
+	// The status of an expired token does not tell if it is disabled.
+	if token.Status != sdk.ProgrammaticAccessTokenStatusExpired {
+		if err := handleExternalChangesToObjectInShow(d,
+			outputMapping{"status", "disabled", string(token.Status), booleanStringFromBool(token.Status == sdk.ProgrammaticAccessTokenStatusDisabled), nil},
+		); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if err := setStateToValuesFromConfig(d, userProgrammaticAccessTokenSchema, []string{"disabled"}); err != nil {
+		return diag.FromErr(err)
+	}
+
+	roleRestriction, comment := "", ""
+	if token.RoleRestriction != nil {
+		roleRestriction = token.RoleRestriction.Name()
+	}
+	if token.Comment != nil {
+		comment = *token.Comment
+	}
+	if errs := errors.Join(
+		d.Set("user", userId.Name()),
+		d.Set("name", tokenId.Name()),
+		d.Set("role_restriction", roleRestriction),
+		// not reading days_to_expiry on purpose (it is only available as expires_at in show output)
+		// not reading mins_to_bypass_network_policy_requirement on purpose (it changes continuously)
+		d.Set("comment", comment),
+		d.Set(ShowOutputAttributeName, []map[string]any{schemas.ProgrammaticAccessTokenToSchema(token)}),
+	); errs != nil {
+		return diag.FromErr(errs)
+	}
+	return nil
+}
+
+func UpdateUserProgrammaticAccessToken(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	userId, tokenId, err := parseUserProgrammaticAccessTokenId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("name") {
+		newTokenId, err := sdk.ParseAccountObjectIdentifier(d.Get("name").(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		err = client.UserProgrammaticAccessTokens.Modify(ctx, sdk.NewModifyProgrammaticAccessTokenRequest(userId, tokenId).WithRenameTo(newTokenId))
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error renaming programmatic access token %v err = %w", tokenId.Name(), err))
+		}
+
+		d.SetId(helpers.EncodeResourceIdentifier(userId.FullyQualifiedName(), newTokenId.FullyQualifiedName()))
+		tokenId = newTokenId
+	}
+
+	set, unset := sdk.NewModifyProgrammaticAccessTokenSetRequest(), sdk.NewModifyProgrammaticAccessTokenUnsetRequest()
+	if errs := errors.Join(
+		intAttributeWithSpecialDefaultUpdate(d, "mins_to_bypass_network_policy_requirement", &set.MinsToBypassNetworkPolicyRequirement, &unset.MinsToBypassNetworkPolicyRequirement),
+		booleanStringAttributeUpdate(d, "disabled", &set.Disabled, &unset.Disabled),
+		stringAttributeUpdate(d, "comment", &set.Comment, &unset.Comment),
+	); errs != nil {
+		return diag.FromErr(errs)
+	}
+
+	if (*set != sdk.ModifyProgrammaticAccessTokenSetRequest{}) {
+		if err := client.UserProgrammaticAccessTokens.Modify(ctx, sdk.NewModifyProgrammaticAccessTokenRequest(userId, tokenId).WithSet(*set)); err != nil {
+			d.Partial(true)
+			return diag.FromErr(err)
+		}
+	}
+
+	if (*unset != sdk.ModifyProgrammaticAccessTokenUnsetRequest{}) {
+		if err := client.UserProgrammaticAccessTokens.Modify(ctx, sdk.NewModifyProgrammaticAccessTokenRequest(userId, tokenId).WithUnset(*unset)); err != nil {
+			d.Partial(true)
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange("keepers") {
+		request := sdk.NewRotateProgrammaticAccessTokenRequest(userId, tokenId)
+		if err := intAttributeWithSpecialDefaultCreate(d, "expire_rotated_token_after_hours", &request.ExpireRotatedTokenAfterHours); err != nil {
+			return diag.FromErr(err)
+		}
+		result, err := client.UserProgrammaticAccessTokens.Rotate(ctx, request)
+		if err != nil {
+			d.Partial(true)
+			return diag.FromErr(fmt.Errorf("error rotating programmatic access token %v err = %w", tokenId.Name(), err))
+		}
+		if err := errors.Join(
+			d.Set("token", result.TokenSecret),
+			d.Set("rotated_token_name", result.RotatedTokenName),
+		); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return ReadUserProgrammaticAccessToken(ctx, d, meta)
+}
+
+func DeleteUserProgrammaticAccessToken(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	userId, tokenId, err := parseUserProgrammaticAccessTokenId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = client.UserProgrammaticAccessTokens.Remove(ctx, sdk.NewRemoveProgrammaticAccessTokenRequest(userId, tokenId).WithIfExists(true))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func parseUserProgrammaticAccessTokenId(id string) (sdk.AccountObjectIdentifier, sdk.AccountObjectIdentifier, error) {
+	parts := helpers.ParseResourceIdentifier(id)
+	if len(parts) != 2 {
+		return sdk.AccountObjectIdentifier{}, sdk.AccountObjectIdentifier{}, fmt.Errorf("unexpected number of parts in the programmatic access token id %s, expected 2 (user and token name), got %d", id, len(parts))
+	}
+	userId, err := sdk.ParseAccountObjectIdentifier(parts[0])
+	if err != nil {
+		return sdk.AccountObjectIdentifier{}, sdk.AccountObjectIdentifier{}, err
+	}
+	tokenId, err := sdk.ParseAccountObjectIdentifier(parts[1])
+	if err != nil {
+		return sdk.AccountObjectIdentifier{}, sdk.AccountObjectIdentifier{}, err
+	}
+	return userId, tokenId, nil
+}
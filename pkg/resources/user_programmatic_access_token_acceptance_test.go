@@ -0,0 +1,278 @@
+package resources_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/helpers/random"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/testenvs"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	r "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_UserProgrammaticAccessToken_BasicFlow(t *testing.T) {
+	_ = testenvs.GetOrSkipTest(t, testenvs.EnableAcceptance)
+	acc.TestAccPreCheck(t)
+
+	user, userCleanup := acc.TestClient().User.CreateUser(t)
+	t.Cleanup(userCleanup)
+
+	id := acc.TestClient().Ids.RandomAccountObjectIdentifier()
+	newId := acc.TestClient().Ids.RandomAccountObjectIdentifier()
+	comment := random.Comment()
+	resourceReference := "snowflake_user_programmatic_access_token.test"
+
+	var token string
+	saveToken := resource.TestCheckResourceAttrWith(resourceReference, "token", func(value string) error {
+		token = value
+		return nil
+	})
+	tokenChanged := resource.TestCheckResourceAttrWith(resourceReference, "token", func(value string) error {
+		if value == "" || value == token {
+			return fmt.Errorf("expected the token to be rotated")
+		}
+		return nil
+	})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: acc.CheckUserProgrammaticAccessTokenDestroy(t),
+		Steps: []resource.TestStep{
+			// create with only required fields
+			{
+				Config: userProgrammaticAccessTokenConfig(user.ID(), id, "", "default", ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceReference, "id", helpers.EncodeResourceIdentifier(user.ID().FullyQualifiedName(), id.FullyQualifiedName())),
+					resource.TestCheckResourceAttr(resourceReference, "user", user.ID().Name()),
+					resource.TestCheckResourceAttr(resourceReference, "name", id.Name()),
+					resource.TestCheckResourceAttr(resourceReference, "disabled", r.BooleanDefault),
+					resource.TestCheckResourceAttr(resourceReference, "comment", ""),
+					resource.TestCheckResourceAttrSet(resourceReference, "token"),
+					resource.TestCheckResourceAttr(resourceReference, "rotated_token_name", ""),
+					resource.TestCheckResourceAttr(resourceReference, "show_output.#", "1"),
+					resource.TestCheckResourceAttr(resourceReference, "show_output.0.name", id.Name()),
+					resource.TestCheckResourceAttr(resourceReference, "show_output.0.user_name", user.ID().Name()),
+					resource.TestCheckResourceAttr(resourceReference, "show_output.0.status", string(sdk.ProgrammaticAccessTokenStatusActive)),
+					resource.TestCheckResourceAttrSet(resourceReference, "show_output.0.expires_at"),
+					saveToken,
+				),
+			},
+			// set optional fields
+			{
+				Config: userProgrammaticAccessTokenConfig(user.ID(), id, comment, "true", ""),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceReference, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceReference, "disabled", r.BooleanTrue),
+					resource.TestCheckResourceAttr(resourceReference, "comment", comment),
+					resource.TestCheckResourceAttr(resourceReference, "token", token),
+					resource.TestCheckResourceAttr(resourceReference, "show_output.0.status", string(sdk.ProgrammaticAccessTokenStatusDisabled)),
+					resource.TestCheckResourceAttr(resourceReference, "show_output.0.comment", comment),
+				),
+			},
+			// external change
+			{
+				PreConfig: func() {
+					acc.TestClient().UserProgrammaticAccessToken.Modify(t, sdk.NewModifyProgrammaticAccessTokenRequest(user.ID(), id).
+						WithSet(*sdk.NewModifyProgrammaticAccessTokenSetRequest().WithDisabled(false)))
+				},
+				Config: userProgrammaticAccessTokenConfig(user.ID(), id, comment, "true", ""),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceReference, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceReference, "disabled", r.BooleanTrue),
+					resource.TestCheckResourceAttr(resourceReference, "show_output.0.status", string(sdk.ProgrammaticAccessTokenStatusDisabled)),
+				),
+			},
+			// rotate
+			{
+				Config: userProgrammaticAccessTokenConfig(user.ID(), id, comment, "false", "1"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceReference, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceReference, "name", id.Name()),
+					resource.TestCheckResourceAttrSet(resourceReference, "rotated_token_name"),
+					resource.TestCheckResourceAttr(resourceReference, "show_output.0.status", string(sdk.ProgrammaticAccessTokenStatusActive)),
+					tokenChanged,
+				),
+			},
+			// rename
+			{
+				Config: userProgrammaticAccessTokenConfig(user.ID(), newId, comment, "false", "1"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceReference, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceReference, "id", helpers.EncodeResourceIdentifier(user.ID().FullyQualifiedName(), newId.FullyQualifiedName())),
+					resource.TestCheckResourceAttr(resourceReference, "name", newId.Name()),
+					resource.TestCheckResourceAttr(resourceReference, "show_output.0.name", newId.Name()),
+				),
+			},
+			// import
+			{
+				ResourceName:            resourceReference,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"token", "rotated_token_name", "keepers", "keepers.%", "keepers.rotation", "disabled", "expire_rotated_token_after_hours", "mins_to_bypass_network_policy_requirement"},
+			},
+			// unset optional fields
+			{
+				Config: userProgrammaticAccessTokenConfig(user.ID(), newId, "", "default", "1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceReference, "disabled", r.BooleanDefault),
+					resource.TestCheckResourceAttr(resourceReference, "comment", ""),
+					resource.TestCheckResourceAttr(resourceReference, "show_output.0.comment", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAcc_UserProgrammaticAccessToken_Complete(t *testing.T) {
+	_ = testenvs.GetOrSkipTest(t, testenvs.EnableAcceptance)
+	acc.TestAccPreCheck(t)
+
+	user, userCleanup := acc.TestClient().User.CreateServiceUser(t)
+	t.Cleanup(userCleanup)
+
+	role, roleCleanup := acc.TestClient().Role.CreateRole(t)
+	t.Cleanup(roleCleanup)
+	acc.TestClient().Role.GrantRoleToUser(t, role.ID(), user.ID())
+
+	id := acc.TestClient().Ids.RandomAccountObjectIdentifier()
+	resourceReference := "snowflake_user_programmatic_access_token.test"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: acc.CheckUserProgrammaticAccessTokenDestroy(t),
+		Steps: []resource.TestStep{
+			{
+				Config: userProgrammaticAccessTokenCompleteConfig(user.ID(), id, role.ID(), 10),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceReference, "role_restriction", role.ID().Name()),
+					resource.TestCheckResourceAttr(resourceReference, "days_to_expiry", "10"),
+					resource.TestCheckResourceAttr(resourceReference, "mins_to_bypass_network_policy_requirement", "5"),
+					resource.TestCheckResourceAttrSet(resourceReference, "token"),
+					resource.TestCheckResourceAttr(resourceReference, "show_output.0.role_restriction", role.ID().Name()),
+				),
+			},
+			// days_to_expiry cannot be altered
+			{
+				Config: userProgrammaticAccessTokenCompleteConfig(user.ID(), id, role.ID(), 20),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceReference, plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceReference, "days_to_expiry", "20"),
+				),
+			},
+		},
+	})
+}
+
+func TestAcc_UserProgrammaticAccessToken_RemovedExternally(t *testing.T) {
+	_ = testenvs.GetOrSkipTest(t, testenvs.EnableAcceptance)
+	acc.TestAccPreCheck(t)
+
+	user, userCleanup := acc.TestClient().User.CreateUser(t)
+	t.Cleanup(userCleanup)
+
+	id := acc.TestClient().Ids.RandomAccountObjectIdentifier()
+	resourceReference := "snowflake_user_programmatic_access_token.test"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: acc.CheckUserProgrammaticAccessTokenDestroy(t),
+		Steps: []resource.TestStep{
+			{
+				Config: userProgrammaticAccessTokenConfig(user.ID(), id, "", "default", ""),
+				Check:  resource.TestCheckResourceAttrSet(resourceReference, "token"),
+			},
+			{
+				PreConfig: func() {
+					acc.TestClient().UserProgrammaticAccessToken.RemoveFunc(t, user.ID(), id)()
+				},
+				Config: userProgrammaticAccessTokenConfig(user.ID(), id, "", "default", ""),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceReference, plancheck.ResourceActionCreate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceReference, "token"),
+					func(_ *terraform.State) error {
+						_, err := acc.TestClient().UserProgrammaticAccessToken.Show(t, user.ID(), id)
+						return err
+					},
+				),
+			},
+		},
+	})
+}
+
+func userProgrammaticAccessTokenConfig(userId sdk.AccountObjectIdentifier, id sdk.AccountObjectIdentifier, comment string, disabled string, rotation string) string {
+	optionalConfig := ""
+	if comment != "" {
+		optionalConfig += fmt.Sprintf("  comment = %q\n", comment)
+	}
+	if disabled != r.BooleanDefault {
+		optionalConfig += fmt.Sprintf("  disabled = %q\n", disabled)
+	}
+	if rotation != "" {
+		optionalConfig += fmt.Sprintf(`  expire_rotated_token_after_hours = 0
+  keepers = {
+    rotation = %q
+  }
+`, rotation)
+	}
+	return fmt.Sprintf(`
+resource "snowflake_user_programmatic_access_token" "test" {
+  user = %[1]s
+  name = %[2]s
+%[3]s}
+`, userId.FullyQualifiedName(), id.FullyQualifiedName(), optionalConfig)
+}
+
+func userProgrammaticAccessTokenCompleteConfig(userId sdk.AccountObjectIdentifier, id sdk.AccountObjectIdentifier, roleId sdk.AccountObjectIdentifier, daysToExpiry int) string {
+	return fmt.Sprintf(`
+resource "snowflake_user_programmatic_access_token" "test" {
+  user                                      = %[1]s
+  name                                      = %[2]s
+  role_restriction                          = %[3]s
+  days_to_expiry                            = %[4]d
+  mins_to_bypass_network_policy_requirement = 5
+  comment                                   = "complete"
+}
+`, userId.FullyQualifiedName(), id.FullyQualifiedName(), roleId.FullyQualifiedName(), daysToExpiry)
+}
@@ -0,0 +1,225 @@
+package resources
+
+import (
+	"context"
+	"reflect"
+	"slices"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/schemas"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var webhookNotificationIntegrationSchema = collections.MergeMaps(notificationIntegrationCommonSchema, map[string]*schema.Schema{
+	"webhook_url": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "Specifies the URL of the webhook. The URL can contain the `SNOWFLAKE_WEBHOOK_SECRET` placeholder, which is replaced with the secret specified in `webhook_secret`.",
+	},
+	"webhook_secret": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		Description:      relatedResourceDescription(externalChangesNotDetectedFieldDescription("Specifies the fully qualified name of the generic string secret used by the webhook. The value of the secret replaces the `SNOWFLAKE_WEBHOOK_SECRET` placeholder in `webhook_url`, `webhook_body_template` and `webhook_headers`."), resources.SecretWithGenericString),
+		ValidateDiagFunc: IsValidIdentifier[sdk.SchemaObjectIdentifier](),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+	},
+	"webhook_body_template": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies a template for the body of the HTTP request sent to the webhook. The template can contain the `SNOWFLAKE_WEBHOOK_MESSAGE` placeholder, which is replaced with the message sent with `SYSTEM$SEND_SNOWFLAKE_NOTIFICATION`.",
+	},
+	"webhook_headers": {
+		Type:        schema.TypeMap,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Optional:    true,
+		Description: externalChangesNotDetectedFieldDescription("Specifies the HTTP headers (header name to header value) sent with the request to the webhook."),
+	},
+	DescribeOutputAttributeName: notificationIntegrationDescribeOutputSchema(schemas.DescribeWebhookNotificationIntegrationSchema),
+})
+
+func WebhookNotificationIntegration() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: PreviewFeatureCreateContextWrapper(string(previewfeatures.WebhookNotificationIntegrationResource), TrackingCreateWrapper(resources.WebhookNotificationIntegration, CreateContextWebhookNotificationIntegration)),
+		ReadContext:   PreviewFeatureReadContextWrapper(string(previewfeatures.WebhookNotificationIntegrationResource), TrackingReadWrapper(resources.WebhookNotificationIntegration, ReadContextWebhookNotificationIntegration(true))),
+		UpdateContext: PreviewFeatureUpdateContextWrapper(string(previewfeatures.WebhookNotificationIntegrationResource), TrackingUpdateWrapper(resources.WebhookNotificationIntegration, UpdateContextWebhookNotificationIntegration)),
+		DeleteContext: PreviewFeatureDeleteContextWrapper(string(previewfeatures.WebhookNotificationIntegrationResource), TrackingDeleteWrapper(resources.WebhookNotificationIntegration, DeleteContextNotificationIntegration)),
+		Description:   "Resource used to manage webhook notification integration objects (e.g. for Slack, Microsoft Teams, or PagerDuty). The integration can be used with `SYSTEM$SEND_SNOWFLAKE_NOTIFICATION`. For more information, check [notification integration documentation](https://docs.snowflake.com/en/sql-reference/sql/create-notification-integration-webhooks).",
+
+		Schema: webhookNotificationIntegrationSchema,
+		CustomizeDiff: TrackingCustomDiffWrapper(resources.WebhookNotificationIntegration, customdiff.All(
+			ComputedIfAnyAttributeChanged(webhookNotificationIntegrationSchema, ShowOutputAttributeName, "enabled", "comment"),
+			ComputedIfAnyAttributeChanged(webhookNotificationIntegrationSchema, DescribeOutputAttributeName, "enabled", "comment", "webhook_url", "webhook_secret", "webhook_body_template", "webhook_headers"),
+		)),
+		Importer: &schema.ResourceImporter{
+			StateContext: TrackingImportWrapper(resources.WebhookNotificationIntegration, ImportWebhookNotificationIntegration),
+		},
+		Timeouts: defaultTimeouts,
+	}
+}
+
+func ImportWebhookNotificationIntegration(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	if err := handleNotificationIntegrationImport(ctx, d, meta, map[string]string{
+		"webhook_url":           "WEBHOOK_URL",
+		"webhook_body_template": "WEBHOOK_BODY_TEMPLATE",
+	}); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+func toWebhookHeaders(headers map[string]any) []sdk.NotificationIntegrationWebhookHeader {
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	// Sorted to keep the generated SQL deterministic.
+	slices.Sort(names)
+	webhookHeaders := make([]sdk.NotificationIntegrationWebhookHeader, len(names))
+	for i, name := range names {
+		webhookHeaders[i] = sdk.NotificationIntegrationWebhookHeader{Name: name, Value: headers[name].(string)}
+	}
+	return webhookHeaders
+}
+
+func CreateContextWebhookNotificationIntegration(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseAccountObjectIdentifier(d.Get("name").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	webhookParams := sdk.NewWebhookParamsRequest(d.Get("webhook_url").(string))
+	if v, ok := d.GetOk("webhook_secret"); ok {
+		secretId, err := sdk.ParseSchemaObjectIdentifier(v.(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		webhookParams.WithWebhookSecret(&secretId)
+	}
+	if v, ok := d.GetOk("webhook_body_template"); ok {
+		webhookParams.WithWebhookBodyTemplate(sdk.String(v.(string)))
+	}
+	if v, ok := d.GetOk("webhook_headers"); ok {
+		webhookParams.WithWebhookHeaders(toWebhookHeaders(v.(map[string]any)))
+	}
+
+	req := sdk.NewCreateNotificationIntegrationRequest(id, d.Get("enabled").(bool)).WithWebhookParams(webhookParams)
+	if v, ok := d.GetOk("comment"); ok {
+		req.WithComment(sdk.String(v.(string)))
+	}
+
+	if err := client.NotificationIntegrations.Create(ctx, req); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(helpers.EncodeResourceIdentifier(id))
+	return ReadContextWebhookNotificationIntegration(false)(ctx, d, meta)
+}
+
+func ReadContextWebhookNotificationIntegration(withExternalChangesMarking bool) schema.ReadContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+		client := meta.(*provider.Context).Client
+		id, err := sdk.ParseAccountObjectIdentifier(d.Id())
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		integration, properties, diags := queryNotificationIntegration(ctx, d, client, id)
+		if integration == nil {
+			return diags
+		}
+
+		if withExternalChangesMarking {
+			webhookUrl, _ := notificationIntegrationPropertyValue(properties, "WEBHOOK_URL")
+			webhookBodyTemplate, _ := notificationIntegrationPropertyValue(properties, "WEBHOOK_BODY_TEMPLATE")
+			if err = handleExternalChangesToObjectInDescribe(d,
+				describeMapping{"webhook_url", "webhook_url", webhookUrl, webhookUrl, nil},
+				describeMapping{"webhook_body_template", "webhook_body_template", webhookBodyTemplate, webhookBodyTemplate, nil},
+			); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+		if err := setStateToValuesFromConfig(d, webhookNotificationIntegrationSchema, []string{
+			"webhook_url",
+			"webhook_body_template",
+		}); err != nil {
+			return diag.FromErr(err)
+		}
+
+		if err := handleNotificationIntegrationRead(d, id, integration, schemas.WebhookNotificationIntegrationPropertiesToSchema(properties)); err != nil {
+			return diag.FromErr(err)
+		}
+		return nil
+	}
+}
+
+func UpdateContextWebhookNotificationIntegration(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseAccountObjectIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	set, webhookSet, unset := sdk.NewNotificationIntegrationSetRequest(), sdk.NewSetWebhookParamsRequest(), sdk.NewNotificationIntegrationUnsetWebhookParamsRequest()
+
+	if d.HasChange("enabled") {
+		set.WithEnabled(sdk.Bool(d.Get("enabled").(bool)))
+	}
+	if d.HasChange("webhook_url") {
+		webhookSet.WithWebhookUrl(sdk.String(d.Get("webhook_url").(string)))
+	}
+	if d.HasChange("webhook_secret") {
+		if v, ok := d.GetOk("webhook_secret"); ok {
+			secretId, err := sdk.ParseSchemaObjectIdentifier(v.(string))
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			webhookSet.WithWebhookSecret(&secretId)
+		} else {
+			unset.WithWebhookSecret(sdk.Bool(true))
+		}
+	}
+	if d.HasChange("webhook_body_template") {
+		if v, ok := d.GetOk("webhook_body_template"); ok {
+			webhookSet.WithWebhookBodyTemplate(sdk.String(v.(string)))
+		} else {
+			unset.WithWebhookBodyTemplate(sdk.Bool(true))
+		}
+	}
+	if d.HasChange("webhook_headers") {
+		if v := d.Get("webhook_headers").(map[string]any); len(v) > 0 {
+			webhookSet.WithWebhookHeaders(toWebhookHeaders(v))
+		} else {
+			unset.WithWebhookHeaders(sdk.Bool(true))
+		}
+	}
+	if d.HasChange("comment") {
+		if v := d.Get("comment").(string); len(v) > 0 {
+			set.WithComment(sdk.String(v))
+		} else {
+			unset.WithComment(sdk.Bool(true))
+		}
+	}
+
+	if !reflect.DeepEqual(*webhookSet, sdk.SetWebhookParamsRequest{}) {
+		set.WithSetWebhookParams(webhookSet)
+	}
+	if !reflect.DeepEqual(*set, sdk.NotificationIntegrationSetRequest{}) {
+		if err := client.NotificationIntegrations.Alter(ctx, sdk.NewAlterNotificationIntegrationRequest(id).WithSet(set)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if !reflect.DeepEqual(*unset, sdk.NotificationIntegrationUnsetWebhookParamsRequest{}) {
+		if err := client.NotificationIntegrations.Alter(ctx, sdk.NewAlterNotificationIntegrationRequest(id).WithUnsetWebhookParams(unset)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return ReadContextWebhookNotificationIntegration(false)(ctx, d, meta)
+}
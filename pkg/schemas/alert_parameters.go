@@ -0,0 +1,27 @@
+package schemas
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var ShowAlertParametersSchema = make(map[string]*schema.Schema)
+
+func init() {
+	for _, param := range sdk.AllAlertParameters {
+		ShowAlertParametersSchema[strings.ToLower(string(param))] = ParameterListSchema
+	}
+}
+
+func AlertParametersToSchema(parameters []*sdk.Parameter) map[string]any {
+	alertParametersValue := make(map[string]any)
+	for _, param := range parameters {
+		if slices.Contains(sdk.AllAlertParameters, sdk.AlertParameter(param.Key)) {
+			alertParametersValue[strings.ToLower(param.Key)] = []map[string]any{ParameterToSchema(param)}
+		}
+	}
+	return alertParametersValue
+}
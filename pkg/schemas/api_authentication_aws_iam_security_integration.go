@@ -0,0 +1,45 @@
+package schemas
+
+import (
+	"log"
+	"slices"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DescribeApiAuthAwsIamSecurityIntegrationSchema represents output of DESCRIBE query for the single SecurityIntegration.
+var DescribeApiAuthAwsIamSecurityIntegrationSchema = map[string]*schema.Schema{
+	"enabled":              DescribePropertyListSchema,
+	"auth_type":            DescribePropertyListSchema,
+	"aws_role_arn":         DescribePropertyListSchema,
+	"api_aws_iam_user_arn": DescribePropertyListSchema,
+	"api_aws_external_id":  DescribePropertyListSchema,
+	"comment":              DescribePropertyListSchema,
+}
+
+var ApiAuthenticationAwsIamPropertiesNames = []string{
+	"ENABLED",
+	"AUTH_TYPE",
+	"AWS_ROLE_ARN",
+	"API_AWS_IAM_USER_ARN",
+	"API_AWS_EXTERNAL_ID",
+	"COMMENT",
+}
+var _ = DescribeApiAuthAwsIamSecurityIntegrationSchema
+
+func ApiAuthAwsIamSecurityIntegrationPropertiesToSchema(securityIntegrationProperties []sdk.SecurityIntegrationProperty) map[string]any {
+	securityIntegrationSchema := make(map[string]any)
+	for _, securityIntegrationProperty := range securityIntegrationProperties {
+		securityIntegrationProperty := securityIntegrationProperty
+		if slices.Contains(ApiAuthenticationAwsIamPropertiesNames, securityIntegrationProperty.Name) {
+			securityIntegrationSchema[strings.ToLower(securityIntegrationProperty.Name)] = []map[string]any{SecurityIntegrationPropertyToSchema(&securityIntegrationProperty)}
+		} else {
+			log.Printf("[WARN] unexpected property %v in api auth aws iam security integration returned from Snowflake", securityIntegrationProperty.Name)
+		}
+	}
+	return securityIntegrationSchema
+}
+
+var _ = ApiAuthAwsIamSecurityIntegrationPropertiesToSchema
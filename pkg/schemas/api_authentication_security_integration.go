@@ -18,6 +18,7 @@ var DescribeApiAuthSecurityIntegrationSchema = map[string]*schema.Schema{
 	"oauth_client_auth_method":     DescribePropertyListSchema,
 	"oauth_authorization_endpoint": DescribePropertyListSchema,
 	"oauth_token_endpoint":         DescribePropertyListSchema,
+	"oauth_assertion_issuer":       DescribePropertyListSchema,
 	"oauth_allowed_scopes":         DescribePropertyListSchema,
 	"oauth_grant":                  DescribePropertyListSchema,
 	"parent_integration":           DescribePropertyListSchema,
@@ -33,6 +34,7 @@ var ApiAuthenticationPropertiesNames = []string{
 	"OAUTH_CLIENT_AUTH_METHOD",
 	"OAUTH_AUTHORIZATION_ENDPOINT",
 	"OAUTH_TOKEN_ENDPOINT",
+	"OAUTH_ASSERTION_ISSUER",
 	"OAUTH_ALLOWED_SCOPES",
 	"OAUTH_GRANT",
 	"PARENT_INTEGRATION",
@@ -16,6 +16,7 @@ var AuthenticationPolicyDescribeSchema = map[string]*schema.Schema{
 	"authentication_methods":     {Type: schema.TypeString, Computed: true},
 	"mfa_authentication_methods": {Type: schema.TypeString, Computed: true},
 	"mfa_enrollment":             {Type: schema.TypeString, Computed: true},
+	"mfa_policy":                 {Type: schema.TypeString, Computed: true},
 	"client_types":               {Type: schema.TypeString, Computed: true},
 	"security_integrations":      {Type: schema.TypeString, Computed: true},
 	"comment":                    {Type: schema.TypeString, Computed: true},
@@ -32,6 +33,7 @@ var AuthenticationPolicyNames = []string{
 	"SECURITY_INTEGRATIONS",
 	"MFA_ENROLLMENT",
 	"MFA_AUTHENTICATION_METHODS",
+	"MFA_POLICY",
 }
 
 func AuthenticationPolicyDescriptionToSchema(authenticationPolicyDescription []sdk.AuthenticationPolicyDescription) map[string]any {
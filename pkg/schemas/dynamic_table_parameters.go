@@ -0,0 +1,27 @@
+package schemas
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var ShowDynamicTableParametersSchema = make(map[string]*schema.Schema)
+
+func init() {
+	for _, param := range sdk.AllDynamicTableParameters {
+		ShowDynamicTableParametersSchema[strings.ToLower(string(param))] = ParameterListSchema
+	}
+}
+
+func DynamicTableParametersToSchema(parameters []*sdk.Parameter) map[string]any {
+	dynamicTableParametersValue := make(map[string]any)
+	for _, param := range parameters {
+		if slices.Contains(sdk.AllDynamicTableParameters, sdk.DynamicTableParameter(param.Key)) {
+			dynamicTableParametersValue[strings.ToLower(param.Key)] = []map[string]any{ParameterToSchema(param)}
+		}
+	}
+	return dynamicTableParametersValue
+}
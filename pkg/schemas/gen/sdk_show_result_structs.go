@@ -4,6 +4,7 @@ import "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 
 var SdkShowResultStructs = []any{
 	sdk.Account{},
+	sdk.OrganizationAccount{},
 	sdk.Alert{},
 	sdk.ApiIntegration{},
 	sdk.ApplicationPackage{},
@@ -15,6 +16,7 @@ var SdkShowResultStructs = []any{
 	sdk.Database{},
 	sdk.DynamicTable{},
 	sdk.EventTable{},
+	sdk.ExternalAccessIntegration{},
 	sdk.ExternalFunction{},
 	sdk.ExternalTable{},
 	sdk.ExternalVolume{},
@@ -22,6 +24,7 @@ var SdkShowResultStructs = []any{
 	sdk.FileFormat{},
 	sdk.Function{},
 	sdk.Grant{},
+	sdk.HybridTable{},
 	sdk.ManagedAccount{},
 	sdk.MaskingPolicy{},
 	sdk.MaterializedView{},
@@ -33,6 +36,7 @@ var SdkShowResultStructs = []any{
 	sdk.Pipe{},
 	sdk.PolicyReference{},
 	sdk.Procedure{},
+	sdk.ProgrammaticAccessToken{},
 	sdk.ReplicationAccount{},
 	sdk.ReplicationDatabase{},
 	sdk.Region{},
@@ -53,6 +57,7 @@ var SdkShowResultStructs = []any{
 	sdk.Tag{},
 	sdk.Task{},
 	sdk.User{},
+	sdk.UserMfaMethod{},
 	sdk.View{},
 	sdk.Warehouse{},
 }
@@ -60,5 +65,6 @@ var SdkShowResultStructs = []any{
 // TODO [SNOW-1501905]: currently all this structs have the "Show" added to the schema, while these are not show outputs
 // TODO [SNOW-1501905]: temporary struct, may be refactored with addition to generation of describe results; for now used to some structs needing a schema representation
 var AdditionalStructs = []any{
+	sdk.NotificationIntegrationProperty{},
 	sdk.SecurityIntegrationProperty{},
 }
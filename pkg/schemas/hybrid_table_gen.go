@@ -0,0 +1,70 @@
+// Code generated by sdk-to-schema generator; DO NOT EDIT.
+
+package schemas
+
+import (
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ShowHybridTableSchema represents output of SHOW query for the single HybridTable.
+var ShowHybridTableSchema = map[string]*schema.Schema{
+	"created_on": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"name": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"database_name": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"schema_name": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"owner": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"rows": {
+		Type:     schema.TypeInt,
+		Computed: true,
+	},
+	"bytes": {
+		Type:     schema.TypeInt,
+		Computed: true,
+	},
+	"comment": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"owner_role_type": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+}
+
+var _ = ShowHybridTableSchema
+
+func HybridTableToSchema(hybridTable *sdk.HybridTable) map[string]any {
+	hybridTableSchema := make(map[string]any)
+	hybridTableSchema["created_on"] = hybridTable.CreatedOn.String()
+	hybridTableSchema["name"] = hybridTable.Name
+	hybridTableSchema["database_name"] = hybridTable.DatabaseName
+	hybridTableSchema["schema_name"] = hybridTable.SchemaName
+	hybridTableSchema["owner"] = hybridTable.Owner
+	if hybridTable.Rows != nil {
+		hybridTableSchema["rows"] = hybridTable.Rows
+	}
+	if hybridTable.Bytes != nil {
+		hybridTableSchema["bytes"] = hybridTable.Bytes
+	}
+	hybridTableSchema["comment"] = hybridTable.Comment
+	hybridTableSchema["owner_role_type"] = hybridTable.OwnerRoleType
+	return hybridTableSchema
+}
+
+var _ = HybridTableToSchema
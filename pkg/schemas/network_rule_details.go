@@ -0,0 +1,65 @@
+package schemas
+
+import (
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DescribeNetworkRuleSchema represents output of DESCRIBE query for the single NetworkRule.
+var DescribeNetworkRuleSchema = map[string]*schema.Schema{
+	"created_on": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"name": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"database_name": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"schema_name": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"owner": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"comment": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"type": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"mode": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"value_list": {
+		Type:     schema.TypeList,
+		Elem:     &schema.Schema{Type: schema.TypeString},
+		Computed: true,
+	},
+}
+
+var _ = DescribeNetworkRuleSchema
+
+func NetworkRuleDetailsToSchema(networkRuleDetails *sdk.NetworkRuleDetails) map[string]any {
+	networkRuleDetailsSchema := make(map[string]any)
+	networkRuleDetailsSchema["created_on"] = networkRuleDetails.CreatedOn.String()
+	networkRuleDetailsSchema["name"] = networkRuleDetails.Name
+	networkRuleDetailsSchema["database_name"] = networkRuleDetails.DatabaseName
+	networkRuleDetailsSchema["schema_name"] = networkRuleDetails.SchemaName
+	networkRuleDetailsSchema["owner"] = networkRuleDetails.Owner
+	networkRuleDetailsSchema["comment"] = networkRuleDetails.Comment
+	networkRuleDetailsSchema["type"] = networkRuleDetails.Type
+	networkRuleDetailsSchema["mode"] = networkRuleDetails.Mode
+	networkRuleDetailsSchema["value_list"] = networkRuleDetails.ValueList
+	return networkRuleDetailsSchema
+}
+
+var _ = NetworkRuleDetailsToSchema
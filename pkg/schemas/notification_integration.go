@@ -0,0 +1,135 @@
+package schemas
+
+import (
+	"log"
+	"slices"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DescribeWebhookNotificationIntegrationSchema represents output of DESCRIBE query for the single webhook NotificationIntegration.
+var DescribeWebhookNotificationIntegrationSchema = map[string]*schema.Schema{
+	"enabled":               DescribePropertyListSchema,
+	"type":                  DescribePropertyListSchema,
+	"webhook_url":           DescribePropertyListSchema,
+	"webhook_secret":        DescribePropertyListSchema,
+	"webhook_body_template": DescribePropertyListSchema,
+	"webhook_headers":       DescribePropertyListSchema,
+	"comment":               DescribePropertyListSchema,
+}
+
+var WebhookNotificationIntegrationPropertiesNames = []string{
+	"ENABLED",
+	"TYPE",
+	"WEBHOOK_URL",
+	"WEBHOOK_SECRET",
+	"WEBHOOK_BODY_TEMPLATE",
+	"WEBHOOK_HEADERS",
+	"COMMENT",
+}
+
+// DescribeAwsSnsNotificationIntegrationSchema represents output of DESCRIBE query for the single AWS SNS NotificationIntegration.
+var DescribeAwsSnsNotificationIntegrationSchema = map[string]*schema.Schema{
+	"enabled":               DescribePropertyListSchema,
+	"type":                  DescribePropertyListSchema,
+	"direction":             DescribePropertyListSchema,
+	"notification_provider": DescribePropertyListSchema,
+	"aws_sns_topic_arn":     DescribePropertyListSchema,
+	"aws_sns_role_arn":      DescribePropertyListSchema,
+	"sf_aws_iam_user_arn":   DescribePropertyListSchema,
+	"sf_aws_external_id":    DescribePropertyListSchema,
+	"comment":               DescribePropertyListSchema,
+}
+
+var AwsSnsNotificationIntegrationPropertiesNames = []string{
+	"ENABLED",
+	"TYPE",
+	"DIRECTION",
+	"NOTIFICATION_PROVIDER",
+	"AWS_SNS_TOPIC_ARN",
+	"AWS_SNS_ROLE_ARN",
+	"SF_AWS_IAM_USER_ARN",
+	"SF_AWS_EXTERNAL_ID",
+	"COMMENT",
+}
+
+// DescribeAzureEventGridNotificationIntegrationSchema represents output of DESCRIBE query for the single Azure Event Grid NotificationIntegration.
+var DescribeAzureEventGridNotificationIntegrationSchema = map[string]*schema.Schema{
+	"enabled":                         DescribePropertyListSchema,
+	"type":                            DescribePropertyListSchema,
+	"direction":                       DescribePropertyListSchema,
+	"notification_provider":           DescribePropertyListSchema,
+	"azure_storage_queue_primary_uri": DescribePropertyListSchema,
+	"azure_event_grid_topic_endpoint": DescribePropertyListSchema,
+	"azure_tenant_id":                 DescribePropertyListSchema,
+	"azure_consent_url":               DescribePropertyListSchema,
+	"azure_multi_tenant_app_name":     DescribePropertyListSchema,
+	"comment":                         DescribePropertyListSchema,
+}
+
+var AzureEventGridNotificationIntegrationPropertiesNames = []string{
+	"ENABLED",
+	"TYPE",
+	"DIRECTION",
+	"NOTIFICATION_PROVIDER",
+	"AZURE_STORAGE_QUEUE_PRIMARY_URI",
+	"AZURE_EVENT_GRID_TOPIC_ENDPOINT",
+	"AZURE_TENANT_ID",
+	"AZURE_CONSENT_URL",
+	"AZURE_MULTI_TENANT_APP_NAME",
+	"COMMENT",
+}
+
+// DescribeGcpPubsubNotificationIntegrationSchema represents output of DESCRIBE query for the single GCP Pub/Sub NotificationIntegration.
+var DescribeGcpPubsubNotificationIntegrationSchema = map[string]*schema.Schema{
+	"enabled":                      DescribePropertyListSchema,
+	"type":                         DescribePropertyListSchema,
+	"direction":                    DescribePropertyListSchema,
+	"notification_provider":        DescribePropertyListSchema,
+	"gcp_pubsub_subscription_name": DescribePropertyListSchema,
+	"gcp_pubsub_topic_name":        DescribePropertyListSchema,
+	"gcp_pubsub_service_account":   DescribePropertyListSchema,
+	"comment":                      DescribePropertyListSchema,
+}
+
+var GcpPubsubNotificationIntegrationPropertiesNames = []string{
+	"ENABLED",
+	"TYPE",
+	"DIRECTION",
+	"NOTIFICATION_PROVIDER",
+	"GCP_PUBSUB_SUBSCRIPTION_NAME",
+	"GCP_PUBSUB_TOPIC_NAME",
+	"GCP_PUBSUB_SERVICE_ACCOUNT",
+	"COMMENT",
+}
+
+func WebhookNotificationIntegrationPropertiesToSchema(properties []sdk.NotificationIntegrationProperty) map[string]any {
+	return notificationIntegrationPropertiesToSchema(properties, WebhookNotificationIntegrationPropertiesNames, "webhook")
+}
+
+func AwsSnsNotificationIntegrationPropertiesToSchema(properties []sdk.NotificationIntegrationProperty) map[string]any {
+	return notificationIntegrationPropertiesToSchema(properties, AwsSnsNotificationIntegrationPropertiesNames, "aws sns")
+}
+
+func AzureEventGridNotificationIntegrationPropertiesToSchema(properties []sdk.NotificationIntegrationProperty) map[string]any {
+	return notificationIntegrationPropertiesToSchema(properties, AzureEventGridNotificationIntegrationPropertiesNames, "azure event grid")
+}
+
+func GcpPubsubNotificationIntegrationPropertiesToSchema(properties []sdk.NotificationIntegrationProperty) map[string]any {
+	return notificationIntegrationPropertiesToSchema(properties, GcpPubsubNotificationIntegrationPropertiesNames, "gcp pubsub")
+}
+
+func notificationIntegrationPropertiesToSchema(properties []sdk.NotificationIntegrationProperty, names []string, kind string) map[string]any {
+	notificationIntegrationSchema := make(map[string]any)
+	for _, property := range properties {
+		property := property
+		if slices.Contains(names, property.Name) {
+			notificationIntegrationSchema[strings.ToLower(property.Name)] = []map[string]any{NotificationIntegrationPropertyToSchema(&property)}
+		} else {
+			log.Printf("[WARN] unexpected property %v in %s notification integration returned from Snowflake", property.Name, kind)
+		}
+	}
+	return notificationIntegrationSchema
+}
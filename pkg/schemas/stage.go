@@ -0,0 +1,77 @@
+package schemas
+
+import (
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DescribeStageSchema represents output of DESCRIBE query for the single stage.
+var DescribeStageSchema = map[string]*schema.Schema{
+	"file_format_name": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"file_format_type": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"url": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"aws_access_point_arn": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"storage_integration": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"aws_external_id": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"snowflake_iam_user": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"directory_enable": {
+		Type:     schema.TypeBool,
+		Computed: true,
+	},
+	"directory_auto_refresh": {
+		Type:     schema.TypeBool,
+		Computed: true,
+	},
+	"directory_notification_channel": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"directory_last_refreshed_on": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+}
+
+func StageDescriptionToSchema(details sdk.StageDetails) map[string]any {
+	s := map[string]any{
+		"url":                            details.Url,
+		"aws_access_point_arn":           details.AwsAccessPointArn,
+		"aws_external_id":                details.AwsExternalId,
+		"snowflake_iam_user":             details.SnowflakeIamUser,
+		"directory_enable":               details.DirectoryEnable,
+		"directory_auto_refresh":         details.DirectoryAutoRefresh,
+		"directory_notification_channel": details.DirectoryNotificationChannel,
+		"directory_last_refreshed_on":    details.DirectoryLastRefreshedOn,
+	}
+	if details.FileFormatName != nil {
+		s["file_format_name"] = *details.FileFormatName
+	}
+	if details.FileFormatType != nil {
+		s["file_format_type"] = string(*details.FileFormatType)
+	}
+	if details.StorageIntegration != nil {
+		s["storage_integration"] = details.StorageIntegration.Name()
+	}
+	return s
+}
@@ -57,28 +57,57 @@ var DescribeStreamlitSchema = map[string]*schema.Schema{
 		Type:     schema.TypeString,
 		Computed: true,
 	},
+	"default_version": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"default_version_name": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"default_version_source_location_uri": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"last_version_name": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"live_version_location_uri": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
 }
 
 func StreamlitPropertiesToSchema(details sdk.StreamlitDetail) (map[string]any, error) {
-	stageId, location, err := helpers.ParseRootLocation(details.RootLocation)
-	if err != nil {
-		return nil, err
-	}
-	rootLocation := fmt.Sprintf("@%s", stageId.FullyQualifiedName())
-	if len(location) > 0 {
-		rootLocation = fmt.Sprintf("%s/%s", rootLocation, location)
+	// root location is only set for the streamlits created from a stage with ROOT_LOCATION
+	var rootLocation string
+	if details.RootLocation != "" {
+		stageId, location, err := helpers.ParseRootLocation(details.RootLocation)
+		if err != nil {
+			return nil, err
+		}
+		rootLocation = fmt.Sprintf("@%s", stageId.FullyQualifiedName())
+		if len(location) > 0 {
+			rootLocation = fmt.Sprintf("%s/%s", rootLocation, location)
+		}
 	}
 	return map[string]any{
-		"name":                         details.Name,
-		"title":                        details.Title,
-		"root_location":                rootLocation,
-		"main_file":                    details.MainFile,
-		"query_warehouse":              details.QueryWarehouse,
-		"url_id":                       details.UrlId,
-		"default_packages":             details.DefaultPackages,
-		"user_packages":                details.UserPackages,
-		"import_urls":                  details.ImportUrls,
-		"external_access_integrations": details.ExternalAccessIntegrations,
-		"external_access_secrets":      details.ExternalAccessSecrets,
+		"name":                                details.Name,
+		"title":                               details.Title,
+		"root_location":                       rootLocation,
+		"main_file":                           details.MainFile,
+		"query_warehouse":                     details.QueryWarehouse,
+		"url_id":                              details.UrlId,
+		"default_packages":                    details.DefaultPackages,
+		"user_packages":                       details.UserPackages,
+		"import_urls":                         details.ImportUrls,
+		"external_access_integrations":        details.ExternalAccessIntegrations,
+		"external_access_secrets":             details.ExternalAccessSecrets,
+		"default_version":                     details.DefaultVersion,
+		"default_version_name":                details.DefaultVersionName,
+		"default_version_source_location_uri": details.DefaultVersionSourceLocationUri,
+		"last_version_name":                   details.LastVersionName,
+		"live_version_location_uri":           details.LiveVersionLocationUri,
 	}, nil
 }
@@ -135,6 +135,14 @@ var UserDescribeSchema = map[string]*schema.Schema{
 		Type:     schema.TypeBool,
 		Computed: true,
 	},
+	"has_pat": {
+		Type:     schema.TypeBool,
+		Computed: true,
+	},
+	"has_workload_identity": {
+		Type:     schema.TypeBool,
+		Computed: true,
+	},
 }
 
 var _ = UserDescribeSchema
@@ -237,6 +245,12 @@ func UserDescriptionToSchema(userDetails sdk.UserDetails) []map[string]any {
 	if userDetails.HasMfa != nil {
 		userDetailsSchema["has_mfa"] = userDetails.HasMfa.Value
 	}
+	if userDetails.HasPat != nil {
+		userDetailsSchema["has_pat"] = userDetails.HasPat.Value
+	}
+	if userDetails.HasWorkloadIdentity != nil {
+		userDetailsSchema["has_workload_identity"] = userDetails.HasWorkloadIdentity.Value
+	}
 	return []map[string]any{
 		userDetailsSchema,
 	}
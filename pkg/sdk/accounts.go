@@ -241,16 +241,26 @@ type AccountSet struct {
 	PasswordPolicy       SchemaObjectIdentifier  `ddl:"identifier" sql:"PASSWORD POLICY"`
 	SessionPolicy        SchemaObjectIdentifier  `ddl:"identifier" sql:"SESSION POLICY"`
 	AuthenticationPolicy SchemaObjectIdentifier  `ddl:"identifier" sql:"AUTHENTICATION POLICY"`
+	FeaturePolicy        *AccountFeaturePolicy   `ddl:"keyword"`
 	Force                *bool                   `ddl:"keyword" sql:"FORCE"`
 }
 
+// AccountFeaturePolicy sets the feature policy for all applications in the account.
+type AccountFeaturePolicy struct {
+	FeaturePolicy      SchemaObjectIdentifier `ddl:"identifier" sql:"FEATURE POLICY"`
+	forAllApplications bool                   `ddl:"static" sql:"FOR ALL APPLICATIONS"`
+}
+
 func (opts *AccountSet) validate() error {
 	var errs []error
-	if !exactlyOneValueSet(opts.Parameters, opts.ResourceMonitor, opts.PackagesPolicy, opts.PasswordPolicy, opts.SessionPolicy, opts.AuthenticationPolicy) {
-		errs = append(errs, errExactlyOneOf("AccountSet", "Parameters", "ResourceMonitor", "PackagesPolicy", "PasswordPolicy", "SessionPolicy", "AuthenticationPolicy"))
+	if !exactlyOneValueSet(opts.Parameters, opts.ResourceMonitor, opts.PackagesPolicy, opts.PasswordPolicy, opts.SessionPolicy, opts.AuthenticationPolicy, opts.FeaturePolicy) {
+		errs = append(errs, errExactlyOneOf("AccountSet", "Parameters", "ResourceMonitor", "PackagesPolicy", "PasswordPolicy", "SessionPolicy", "AuthenticationPolicy", "FeaturePolicy"))
+	}
+	if valueSet(opts.Force) && !anyValueSet(opts.PackagesPolicy, opts.PasswordPolicy, opts.SessionPolicy, opts.AuthenticationPolicy, opts.FeaturePolicy) {
+		errs = append(errs, NewError("force can only be set with PackagesPolicy, PasswordPolicy, SessionPolicy, AuthenticationPolicy, or FeaturePolicy field"))
 	}
-	if valueSet(opts.Force) && !valueSet(opts.PackagesPolicy) {
-		errs = append(errs, NewError("force can only be set with PackagesPolicy field"))
+	if valueSet(opts.FeaturePolicy) && !ValidObjectIdentifier(opts.FeaturePolicy.FeaturePolicy) {
+		errs = append(errs, errInvalidIdentifier("AccountSet", "FeaturePolicy"))
 	}
 	if valueSet(opts.Parameters) {
 		if err := opts.Parameters.validate(); err != nil {
@@ -280,13 +290,14 @@ type AccountUnset struct {
 	PasswordPolicy       *bool                        `ddl:"keyword" sql:"PASSWORD POLICY"`
 	SessionPolicy        *bool                        `ddl:"keyword" sql:"SESSION POLICY"`
 	AuthenticationPolicy *bool                        `ddl:"keyword" sql:"AUTHENTICATION POLICY"`
+	FeaturePolicy        *bool                        `ddl:"keyword" sql:"FEATURE POLICY FOR ALL APPLICATIONS"`
 	ResourceMonitor      *bool                        `ddl:"keyword" sql:"RESOURCE_MONITOR"`
 }
 
 func (opts *AccountUnset) validate() error {
 	var errs []error
-	if !exactlyOneValueSet(opts.Parameters, opts.PackagesPolicy, opts.PasswordPolicy, opts.SessionPolicy, opts.AuthenticationPolicy, opts.ResourceMonitor) {
-		errs = append(errs, errExactlyOneOf("AccountUnset", "Parameters", "PackagesPolicy", "PasswordPolicy", "SessionPolicy", "AuthenticationPolicy", "ResourceMonitor"))
+	if !exactlyOneValueSet(opts.Parameters, opts.PackagesPolicy, opts.PasswordPolicy, opts.SessionPolicy, opts.AuthenticationPolicy, opts.FeaturePolicy, opts.ResourceMonitor) {
+		errs = append(errs, errExactlyOneOf("AccountUnset", "Parameters", "PackagesPolicy", "PasswordPolicy", "SessionPolicy", "AuthenticationPolicy", "FeaturePolicy", "ResourceMonitor"))
 	}
 	if valueSet(opts.Parameters) {
 		if err := opts.Parameters.validate(); err != nil {
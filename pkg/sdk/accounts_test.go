@@ -70,7 +70,7 @@ func TestAccountAlter(t *testing.T) {
 		opts := &AlterAccountOptions{
 			Set: &AccountSet{},
 		}
-		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("AccountSet", "Parameters", "ResourceMonitor", "PackagesPolicy", "PasswordPolicy", "SessionPolicy", "AuthenticationPolicy"))
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("AccountSet", "Parameters", "ResourceMonitor", "PackagesPolicy", "PasswordPolicy", "SessionPolicy", "AuthenticationPolicy", "FeaturePolicy"))
 	})
 
 	t.Run("validation: exactly one value set in AccountSet - multiple set", func(t *testing.T) {
@@ -81,14 +81,14 @@ func TestAccountAlter(t *testing.T) {
 				AuthenticationPolicy: randomSchemaObjectIdentifier(),
 			},
 		}
-		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("AccountSet", "Parameters", "ResourceMonitor", "PackagesPolicy", "PasswordPolicy", "SessionPolicy", "AuthenticationPolicy"))
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("AccountSet", "Parameters", "ResourceMonitor", "PackagesPolicy", "PasswordPolicy", "SessionPolicy", "AuthenticationPolicy", "FeaturePolicy"))
 	})
 
 	t.Run("validation: exactly one value set in AccountUnset - nothing set", func(t *testing.T) {
 		opts := &AlterAccountOptions{
 			Unset: &AccountUnset{},
 		}
-		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("AccountUnset", "Parameters", "PackagesPolicy", "PasswordPolicy", "SessionPolicy", "AuthenticationPolicy", "ResourceMonitor"))
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("AccountUnset", "Parameters", "PackagesPolicy", "PasswordPolicy", "SessionPolicy", "AuthenticationPolicy", "FeaturePolicy", "ResourceMonitor"))
 	})
 
 	t.Run("validation: exactly one value set in AccountUnset - multiple set", func(t *testing.T) {
@@ -99,7 +99,7 @@ func TestAccountAlter(t *testing.T) {
 				AuthenticationPolicy: Bool(true),
 			},
 		}
-		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("AccountUnset", "Parameters", "PackagesPolicy", "PasswordPolicy", "SessionPolicy", "AuthenticationPolicy", "ResourceMonitor"))
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("AccountUnset", "Parameters", "PackagesPolicy", "PasswordPolicy", "SessionPolicy", "AuthenticationPolicy", "FeaturePolicy", "ResourceMonitor"))
 	})
 
 	t.Run("with set params", func(t *testing.T) {
@@ -173,7 +173,26 @@ func TestAccountAlter(t *testing.T) {
 		assertOptsValidAndSQLEquals(t, opts, `ALTER ACCOUNT SET PACKAGES POLICY %s FORCE`, id.FullyQualifiedName())
 	})
 
-	t.Run("validate: force with other policy than packages", func(t *testing.T) {
+	t.Run("validate: force without policy", func(t *testing.T) {
+		opts := &AlterAccountOptions{
+			Set: &AccountSet{
+				ResourceMonitor: NewAccountObjectIdentifier("mymonitor"),
+				Force:           Bool(true),
+			},
+		}
+		assertOptsInvalidJoinedErrors(t, opts, fmt.Errorf("force can only be set with PackagesPolicy, PasswordPolicy, SessionPolicy, AuthenticationPolicy, or FeaturePolicy field"))
+	})
+
+	t.Run("validate: invalid feature policy identifier", func(t *testing.T) {
+		opts := &AlterAccountOptions{
+			Set: &AccountSet{
+				FeaturePolicy: &AccountFeaturePolicy{FeaturePolicy: emptySchemaObjectIdentifier},
+			},
+		}
+		assertOptsInvalidJoinedErrors(t, opts, errInvalidIdentifier("AccountSet", "FeaturePolicy"))
+	})
+
+	t.Run("with set password policy with force", func(t *testing.T) {
 		id := randomSchemaObjectIdentifier()
 		opts := &AlterAccountOptions{
 			Set: &AccountSet{
@@ -181,7 +200,28 @@ func TestAccountAlter(t *testing.T) {
 				Force:          Bool(true),
 			},
 		}
-		assertOptsInvalidJoinedErrors(t, opts, fmt.Errorf("force can only be set with PackagesPolicy field"))
+		assertOptsValidAndSQLEquals(t, opts, `ALTER ACCOUNT SET PASSWORD POLICY %s FORCE`, id.FullyQualifiedName())
+	})
+
+	t.Run("with set feature policy", func(t *testing.T) {
+		id := randomSchemaObjectIdentifier()
+		opts := &AlterAccountOptions{
+			Set: &AccountSet{
+				FeaturePolicy: &AccountFeaturePolicy{FeaturePolicy: id},
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER ACCOUNT SET FEATURE POLICY %s FOR ALL APPLICATIONS`, id.FullyQualifiedName())
+	})
+
+	t.Run("with set feature policy with force", func(t *testing.T) {
+		id := randomSchemaObjectIdentifier()
+		opts := &AlterAccountOptions{
+			Set: &AccountSet{
+				FeaturePolicy: &AccountFeaturePolicy{FeaturePolicy: id},
+				Force:         Bool(true),
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER ACCOUNT SET FEATURE POLICY %s FOR ALL APPLICATIONS FORCE`, id.FullyQualifiedName())
 	})
 
 	t.Run("with set password policy", func(t *testing.T) {
@@ -250,6 +290,15 @@ func TestAccountAlter(t *testing.T) {
 		assertOptsValidAndSQLEquals(t, opts, `ALTER ACCOUNT UNSET AUTHENTICATION POLICY`)
 	})
 
+	t.Run("with unset feature policy", func(t *testing.T) {
+		opts := &AlterAccountOptions{
+			Unset: &AccountUnset{
+				FeaturePolicy: Bool(true),
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER ACCOUNT UNSET FEATURE POLICY FOR ALL APPLICATIONS`)
+	})
+
 	t.Run("with unset resource monitor", func(t *testing.T) {
 		opts := &AlterAccountOptions{
 			Unset: &AccountUnset{
@@ -6,6 +6,8 @@ import (
 	"errors"
 	"fmt"
 	"time"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
 )
 
 // Compile-time proof of interface implementation.
@@ -16,15 +18,18 @@ var (
 	_ validatable = new(AlterAlertOptions)
 	_ validatable = new(DropAlertOptions)
 	_ validatable = new(ShowAlertOptions)
+	_ validatable = new(executeAlertOptions)
 )
 
 type Alerts interface {
-	Create(ctx context.Context, id SchemaObjectIdentifier, warehouse AccountObjectIdentifier, schedule string, condition string, action string, opts *CreateAlertOptions) error
+	Create(ctx context.Context, id SchemaObjectIdentifier, condition string, action string, opts *CreateAlertOptions) error
 	Alter(ctx context.Context, id SchemaObjectIdentifier, opts *AlterAlertOptions) error
 	Drop(ctx context.Context, id SchemaObjectIdentifier, opts *DropAlertOptions) error
 	Show(ctx context.Context, opts *ShowAlertOptions) ([]Alert, error)
 	ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*Alert, error)
 	Describe(ctx context.Context, id SchemaObjectIdentifier) (*AlertDetails, error)
+	Execute(ctx context.Context, id SchemaObjectIdentifier) error
+	ShowParameters(ctx context.Context, id SchemaObjectIdentifier) ([]*Parameter, error)
 }
 
 type alerts struct {
@@ -39,12 +44,14 @@ type CreateAlertOptions struct {
 	IfNotExists *bool                  `ddl:"keyword" sql:"IF NOT EXISTS"`
 	name        SchemaObjectIdentifier `ddl:"identifier"`
 
-	// required
-	warehouse AccountObjectIdentifier `ddl:"identifier,equals" sql:"WAREHOUSE"`
-	schedule  string                  `ddl:"parameter,single_quotes" sql:"SCHEDULE"`
-
 	// optional
-	Comment *string `ddl:"parameter,single_quotes" sql:"COMMENT"`
+	// Warehouse is not set for serverless alerts.
+	Warehouse *AccountObjectIdentifier `ddl:"identifier,equals" sql:"WAREHOUSE"`
+	// Schedule is not set for alerts on new data, which are evaluated when new rows are inserted into the table referenced in the condition.
+	Schedule                    *string `ddl:"parameter,single_quotes" sql:"SCHEDULE"`
+	SuspendTaskAfterNumFailures *int    `ddl:"parameter" sql:"SUSPEND_TASK_AFTER_NUM_FAILURES"`
+	UserTaskTimeoutMs           *int    `ddl:"parameter" sql:"USER_TASK_TIMEOUT_MS"`
+	Comment                     *string `ddl:"parameter,single_quotes" sql:"COMMENT"`
 
 	// required
 	condition []AlertCondition `ddl:"keyword,parentheses,no_comma"   sql:"IF"`
@@ -59,20 +66,24 @@ func (opts *CreateAlertOptions) validate() error {
 	if opts == nil {
 		return errors.Join(ErrNilOptions)
 	}
+	var errs []error
 	if !ValidObjectIdentifier(opts.name) {
-		return errors.Join(ErrInvalidObjectIdentifier)
+		errs = append(errs, ErrInvalidObjectIdentifier)
 	}
-	return nil
+	if opts.Warehouse != nil && !ValidObjectIdentifier(opts.Warehouse) {
+		errs = append(errs, errInvalidIdentifier("CreateAlertOptions", "Warehouse"))
+	}
+	if everyValueSet(opts.OrReplace, opts.IfNotExists) && *opts.OrReplace && *opts.IfNotExists {
+		errs = append(errs, errOneOf("CreateAlertOptions", "OrReplace", "IfNotExists"))
+	}
+	return errors.Join(errs...)
 }
 
-func (v *alerts) Create(ctx context.Context, id SchemaObjectIdentifier, warehouse AccountObjectIdentifier, schedule string, condition string, action string, opts *CreateAlertOptions) error {
+func (v *alerts) Create(ctx context.Context, id SchemaObjectIdentifier, condition string, action string, opts *CreateAlertOptions) error {
 	if opts == nil {
 		opts = &CreateAlertOptions{}
 	}
 	opts.name = id
-	opts.warehouse = warehouse
-	opts.schedule = schedule
-	opts.name = id
 	opts.condition = []AlertCondition{{Condition: []string{condition}}}
 	opts.action = action
 	if err := opts.validate(); err != nil {
@@ -112,7 +123,7 @@ type AlterAlertOptions struct {
 	// One of
 	Action          *AlertAction `ddl:"keyword"`
 	Set             *AlertSet    `ddl:"keyword" sql:"SET"`
-	Unset           *AlertUnset  `ddl:"keyword" sql:"UNSET"`
+	Unset           *AlertUnset  `ddl:"list,no_parentheses" sql:"UNSET"`
 	ModifyCondition *[]string    `ddl:"keyword,parentheses,no_comma" sql:"MODIFY CONDITION EXISTS"`
 	ModifyAction    *string      `ddl:"parameter,no_equals" sql:"MODIFY ACTION"`
 }
@@ -132,15 +143,19 @@ func (opts *AlterAlertOptions) validate() error {
 }
 
 type AlertSet struct {
-	Warehouse *AccountObjectIdentifier `ddl:"identifier,equals" sql:"WAREHOUSE"`
-	Schedule  *string                  `ddl:"parameter,single_quotes" sql:"SCHEDULE"`
-	Comment   *string                  `ddl:"parameter,single_quotes" sql:"COMMENT"`
+	Warehouse                   *AccountObjectIdentifier `ddl:"identifier,equals" sql:"WAREHOUSE"`
+	Schedule                    *string                  `ddl:"parameter,single_quotes" sql:"SCHEDULE"`
+	SuspendTaskAfterNumFailures *int                     `ddl:"parameter" sql:"SUSPEND_TASK_AFTER_NUM_FAILURES"`
+	UserTaskTimeoutMs           *int                     `ddl:"parameter" sql:"USER_TASK_TIMEOUT_MS"`
+	Comment                     *string                  `ddl:"parameter,single_quotes" sql:"COMMENT"`
 }
 
 type AlertUnset struct {
-	Warehouse *bool `ddl:"keyword" sql:"WAREHOUSE"`
-	Schedule  *bool `ddl:"keyword" sql:"SCHEDULE"`
-	Comment   *bool `ddl:"keyword" sql:"COMMENT"`
+	Warehouse                   *bool `ddl:"keyword" sql:"WAREHOUSE"`
+	Schedule                    *bool `ddl:"keyword" sql:"SCHEDULE"`
+	SuspendTaskAfterNumFailures *bool `ddl:"keyword" sql:"SUSPEND_TASK_AFTER_NUM_FAILURES"`
+	UserTaskTimeoutMs           *bool `ddl:"keyword" sql:"USER_TASK_TIMEOUT_MS"`
+	Comment                     *bool `ddl:"keyword" sql:"COMMENT"`
 }
 
 func (v *alerts) Alter(ctx context.Context, id SchemaObjectIdentifier, opts *AlterAlertOptions) error {
@@ -240,8 +255,8 @@ type alertDBRow struct {
 	SchemaName    string         `db:"schema_name"`
 	Owner         string         `db:"owner"`
 	Comment       *string        `db:"comment"`
-	Warehouse     string         `db:"warehouse"`
-	Schedule      string         `db:"schedule"`
+	Warehouse     sql.NullString `db:"warehouse"`
+	Schedule      sql.NullString `db:"schedule"`
 	State         string         `db:"state"` // suspended, started
 	Condition     string         `db:"condition"`
 	Action        string         `db:"action"`
@@ -256,12 +271,16 @@ func (row alertDBRow) convert() *Alert {
 		SchemaName:   row.SchemaName,
 		Owner:        row.Owner,
 		Comment:      row.Comment,
-		Warehouse:    row.Warehouse,
-		Schedule:     row.Schedule,
 		State:        AlertState(row.State),
 		Condition:    row.Condition,
 		Action:       row.Action,
 	}
+	if row.Warehouse.Valid {
+		alert.Warehouse = row.Warehouse.String
+	}
+	if row.Schedule.Valid {
+		alert.Schedule = row.Schedule.String
+	}
 	if row.OwnerRoleType.Valid {
 		alert.OwnerRoleType = row.OwnerRoleType.String
 	}
@@ -299,12 +318,15 @@ func (v *alerts) ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*Aler
 		return nil, err
 	}
 
-	for _, alert := range alerts {
-		if alert.ID().name == id.Name() {
-			return &alert, nil
-		}
-	}
-	return nil, ErrObjectNotExistOrAuthorized
+	return collections.FindFirst(alerts, func(alert Alert) bool { return alert.Name == id.Name() })
+}
+
+func (v *alerts) ShowParameters(ctx context.Context, id SchemaObjectIdentifier) ([]*Parameter, error) {
+	return v.client.Parameters.ShowParameters(ctx, &ShowParametersOptions{
+		In: &ParametersIn{
+			Alert: id,
+		},
+	})
 }
 
 // describeAlertOptions is based on https://docs.snowflake.com/en/sql-reference/sql/desc-alert.
@@ -346,8 +368,8 @@ func (row alertDBRow) toAlertDetails() (*AlertDetails, error) {
 		SchemaName:   row.SchemaName,
 		Owner:        row.Owner,
 		Comment:      row.Comment,
-		Warehouse:    row.Warehouse,
-		Schedule:     row.Schedule,
+		Warehouse:    row.Warehouse.String,
+		Schedule:     row.Schedule.String,
 		State:        row.State,
 		Condition:    row.Condition,
 		Action:       row.Action,
@@ -376,3 +398,28 @@ func (v *alerts) Describe(ctx context.Context, id SchemaObjectIdentifier) (*Aler
 
 	return dest.toAlertDetails()
 }
+
+// executeAlertOptions is based on https://docs.snowflake.com/en/sql-reference/sql/execute-alert.
+type executeAlertOptions struct {
+	execute bool                   `ddl:"static" sql:"EXECUTE"`
+	alert   bool                   `ddl:"static" sql:"ALERT"`
+	name    SchemaObjectIdentifier `ddl:"identifier"`
+}
+
+func (opts *executeAlertOptions) validate() error {
+	if opts == nil {
+		return errors.Join(ErrNilOptions)
+	}
+	if !ValidObjectIdentifier(opts.name) {
+		return errors.Join(ErrInvalidObjectIdentifier)
+	}
+	return nil
+}
+
+// Execute evaluates the condition of the alert immediately and runs the action if the condition is met.
+func (v *alerts) Execute(ctx context.Context, id SchemaObjectIdentifier) error {
+	opts := &executeAlertOptions{
+		name: id,
+	}
+	return validateAndExec(v.client, ctx, opts)
+}
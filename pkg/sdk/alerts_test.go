@@ -17,16 +17,47 @@ func TestAlertCreate(t *testing.T) {
 		schedule := "1 minute"
 		action := "INSERT INTO FOO VALUES (1)"
 
+		opts := &CreateAlertOptions{
+			name:                        id,
+			Warehouse:                   &warehouse,
+			Schedule:                    String(schedule),
+			SuspendTaskAfterNumFailures: Int(5),
+			UserTaskTimeoutMs:           Int(1000),
+			condition:                   []AlertCondition{condition},
+			action:                      action,
+			Comment:                     String(newComment),
+		}
+
+		assertOptsValidAndSQLEquals(t, opts, `CREATE ALERT %s WAREHOUSE = "%s" SCHEDULE = '%s' SUSPEND_TASK_AFTER_NUM_FAILURES = 5 USER_TASK_TIMEOUT_MS = 1000 COMMENT = '%s' IF (EXISTS (%s)) THEN %s`, id.FullyQualifiedName(), warehouse.name, schedule, newComment, existsCondition, action)
+	})
+
+	t.Run("serverless alert on new data", func(t *testing.T) {
+		opts := &CreateAlertOptions{
+			name:      id,
+			condition: []AlertCondition{{[]string{"SELECT * FROM FOO"}}},
+			action:    "SELECT 1",
+		}
+
+		assertOptsValidAndSQLEquals(t, opts, `CREATE ALERT %s IF (EXISTS (SELECT * FROM FOO)) THEN SELECT 1`, id.FullyQualifiedName())
+	})
+
+	t.Run("validation: invalid warehouse", func(t *testing.T) {
 		opts := &CreateAlertOptions{
 			name:      id,
-			warehouse: warehouse,
-			schedule:  schedule,
-			condition: []AlertCondition{condition},
-			action:    action,
-			Comment:   String(newComment),
+			Warehouse: &AccountObjectIdentifier{},
 		}
 
-		assertOptsValidAndSQLEquals(t, opts, `CREATE ALERT %s WAREHOUSE = "%s" SCHEDULE = '%s' COMMENT = '%s' IF (EXISTS (%s)) THEN %s`, id.FullyQualifiedName(), warehouse.name, schedule, newComment, existsCondition, action)
+		assertOptsInvalidJoinedErrors(t, opts, errInvalidIdentifier("CreateAlertOptions", "Warehouse"))
+	})
+
+	t.Run("validation: both or replace and if not exists", func(t *testing.T) {
+		opts := &CreateAlertOptions{
+			name:        id,
+			OrReplace:   Bool(true),
+			IfNotExists: Bool(true),
+		}
+
+		assertOptsInvalidJoinedErrors(t, opts, errOneOf("CreateAlertOptions", "OrReplace", "IfNotExists"))
 	})
 }
 
@@ -93,6 +124,32 @@ func TestAlertAlter(t *testing.T) {
 		assertOptsValidAndSQLEquals(t, opts, "ALTER ALERT %s UNSET COMMENT", id.FullyQualifiedName())
 	})
 
+	t.Run("with set parameters", func(t *testing.T) {
+		opts := &AlterAlertOptions{
+			name: id,
+			Set: &AlertSet{
+				SuspendTaskAfterNumFailures: Int(3),
+				UserTaskTimeoutMs:           Int(2000),
+			},
+		}
+
+		assertOptsValidAndSQLEquals(t, opts, "ALTER ALERT %s SET SUSPEND_TASK_AFTER_NUM_FAILURES = 3 USER_TASK_TIMEOUT_MS = 2000", id.FullyQualifiedName())
+	})
+
+	t.Run("with unset warehouse, schedule and parameters", func(t *testing.T) {
+		opts := &AlterAlertOptions{
+			name: id,
+			Unset: &AlertUnset{
+				Warehouse:                   Bool(true),
+				Schedule:                    Bool(true),
+				SuspendTaskAfterNumFailures: Bool(true),
+				UserTaskTimeoutMs:           Bool(true),
+			},
+		}
+
+		assertOptsValidAndSQLEquals(t, opts, "ALTER ALERT %s UNSET WAREHOUSE, SCHEDULE, SUSPEND_TASK_AFTER_NUM_FAILURES, USER_TASK_TIMEOUT_MS", id.FullyQualifiedName())
+	})
+
 	t.Run("with modify condition", func(t *testing.T) {
 		modifyCondition := "SELECT * FROM FOO"
 		opts := &AlterAlertOptions{
@@ -226,3 +283,19 @@ func TestAlertDescribe(t *testing.T) {
 		assertOptsValidAndSQLEquals(t, opts, "DESCRIBE ALERT %s", id.FullyQualifiedName())
 	})
 }
+
+func TestAlertExecute(t *testing.T) {
+	id := randomSchemaObjectIdentifier()
+
+	t.Run("empty options", func(t *testing.T) {
+		opts := &executeAlertOptions{}
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("only name", func(t *testing.T) {
+		opts := &executeAlertOptions{
+			name: id,
+		}
+		assertOptsValidAndSQLEquals(t, opts, "EXECUTE ALERT %s", id.FullyQualifiedName())
+	})
+}
@@ -44,10 +44,47 @@ var AllMfaAuthenticationMethods = []MfaAuthenticationMethodsOption{
 type MfaEnrollmentOption string
 
 const (
-	MfaEnrollmentRequired MfaEnrollmentOption = "REQUIRED"
-	MfaEnrollmentOptional MfaEnrollmentOption = "OPTIONAL"
+	MfaEnrollmentRequired                        MfaEnrollmentOption = "REQUIRED"
+	MfaEnrollmentRequiredPasswordOnly            MfaEnrollmentOption = "REQUIRED_PASSWORD_ONLY"
+	MfaEnrollmentRequiredSnowflakeUiPasswordOnly MfaEnrollmentOption = "REQUIRED_SNOWFLAKE_UI_PASSWORD_ONLY"
+	MfaEnrollmentOptional                        MfaEnrollmentOption = "OPTIONAL"
 )
 
+var AllMfaEnrollmentOptions = []MfaEnrollmentOption{
+	MfaEnrollmentRequired,
+	MfaEnrollmentRequiredPasswordOnly,
+	MfaEnrollmentRequiredSnowflakeUiPasswordOnly,
+	MfaEnrollmentOptional,
+}
+
+type MfaPolicyAllowedMethodsOption string
+
+const (
+	MfaPolicyAllowedMethodsAll     MfaPolicyAllowedMethodsOption = "ALL"
+	MfaPolicyAllowedMethodsPasskey MfaPolicyAllowedMethodsOption = "PASSKEY"
+	MfaPolicyAllowedMethodsTotp    MfaPolicyAllowedMethodsOption = "TOTP"
+	MfaPolicyAllowedMethodsDuo     MfaPolicyAllowedMethodsOption = "DUO"
+)
+
+var AllMfaPolicyAllowedMethods = []MfaPolicyAllowedMethodsOption{
+	MfaPolicyAllowedMethodsAll,
+	MfaPolicyAllowedMethodsPasskey,
+	MfaPolicyAllowedMethodsTotp,
+	MfaPolicyAllowedMethodsDuo,
+}
+
+type EnforceMfaOnExternalAuthenticationOption string
+
+const (
+	EnforceMfaOnExternalAuthenticationAll  EnforceMfaOnExternalAuthenticationOption = "ALL"
+	EnforceMfaOnExternalAuthenticationNone EnforceMfaOnExternalAuthenticationOption = "NONE"
+)
+
+var AllEnforceMfaOnExternalAuthenticationOptions = []EnforceMfaOnExternalAuthenticationOption{
+	EnforceMfaOnExternalAuthenticationAll,
+	EnforceMfaOnExternalAuthenticationNone,
+}
+
 type ClientTypesOption string
 
 const (
@@ -69,6 +106,11 @@ var (
 	MfaAuthenticationMethodsOptionDef = g.NewQueryStruct("MfaAuthenticationMethods").PredefinedQueryStructField("Method", g.KindOfT[MfaAuthenticationMethods](), g.KeywordOptions().SingleQuotes().Required())
 	ClientTypesOptionDef              = g.NewQueryStruct("ClientTypes").PredefinedQueryStructField("ClientType", g.KindOfT[ClientTypesOption](), g.KeywordOptions().SingleQuotes().Required())
 	SecurityIntegrationsOptionDef     = g.NewQueryStruct("SecurityIntegrationsOption").Identifier("Name", g.KindOfT[AccountObjectIdentifier](), g.IdentifierOptions().Required())
+	MfaPolicyAllowedMethodDef         = g.NewQueryStruct("MfaPolicyAllowedMethod").PredefinedQueryStructField("Method", g.KindOfT[MfaPolicyAllowedMethodsOption](), g.KeywordOptions().SingleQuotes().Required())
+	MfaPolicyDef                      = g.NewQueryStruct("AuthenticationPolicyMfaPolicy").
+						ListAssignment("ALLOWED_METHODS", "MfaPolicyAllowedMethod", g.ParameterOptions().Parentheses()).
+						PredefinedQueryStructField("EnforceMfaOnExternalAuthentication", g.KindOfTPointer[EnforceMfaOnExternalAuthenticationOption](), g.ParameterOptions().SingleQuotes().SQL("ENFORCE_MFA_ON_EXTERNAL_AUTHENTICATION")).
+						WithValidation(g.AtLeastOneValueSet, "AllowedMethods", "EnforceMfaOnExternalAuthentication")
 )
 
 var AuthenticationPoliciesDef = g.NewInterface(
@@ -87,6 +129,7 @@ var AuthenticationPoliciesDef = g.NewInterface(
 			ListAssignment("AUTHENTICATION_METHODS", "AuthenticationMethods", g.ParameterOptions().Parentheses()).
 			ListAssignment("MFA_AUTHENTICATION_METHODS", "MfaAuthenticationMethods", g.ParameterOptions().Parentheses()).
 			PredefinedQueryStructField("MfaEnrollment", g.KindOfTPointer[MfaEnrollmentOption](), g.ParameterOptions().SQL("MFA_ENROLLMENT")).
+			OptionalQueryStructField("MfaPolicy", MfaPolicyDef, g.ListOptions().Parentheses().NoComma().SQL("MFA_POLICY =")).
 			ListAssignment("CLIENT_TYPES", "ClientTypes", g.ParameterOptions().Parentheses()).
 			ListAssignment("SECURITY_INTEGRATIONS", "SecurityIntegrationsOption", g.ParameterOptions().Parentheses()).
 			OptionalTextAssignment("COMMENT", g.ParameterOptions().SingleQuotes()).
@@ -96,6 +139,7 @@ var AuthenticationPoliciesDef = g.NewInterface(
 		MfaAuthenticationMethodsOptionDef,
 		ClientTypesOptionDef,
 		SecurityIntegrationsOptionDef,
+		MfaPolicyAllowedMethodDef,
 	).
 	AlterOperation(
 		"https://docs.snowflake.com/en/sql-reference/sql/alter-authentication-policy",
@@ -110,10 +154,11 @@ var AuthenticationPoliciesDef = g.NewInterface(
 					ListAssignment("AUTHENTICATION_METHODS", "AuthenticationMethods", g.ParameterOptions().Parentheses()).
 					ListAssignment("MFA_AUTHENTICATION_METHODS", "MfaAuthenticationMethods", g.ParameterOptions().Parentheses()).
 					PredefinedQueryStructField("MfaEnrollment", g.KindOfTPointer[MfaEnrollmentOption](), g.ParameterOptions().SQL("MFA_ENROLLMENT")).
+					OptionalQueryStructField("MfaPolicy", MfaPolicyDef, g.ListOptions().Parentheses().NoComma().SQL("MFA_POLICY =")).
 					ListAssignment("CLIENT_TYPES", "ClientTypes", g.ParameterOptions().Parentheses()).
 					ListAssignment("SECURITY_INTEGRATIONS", "SecurityIntegrationsOption", g.ParameterOptions().Parentheses()).
 					OptionalTextAssignment("COMMENT", g.ParameterOptions().SingleQuotes()).
-					WithValidation(g.AtLeastOneValueSet, "AuthenticationMethods", "MfaAuthenticationMethods", "MfaEnrollment", "ClientTypes", "SecurityIntegrations", "Comment"),
+					WithValidation(g.AtLeastOneValueSet, "AuthenticationMethods", "MfaAuthenticationMethods", "MfaEnrollment", "MfaPolicy", "ClientTypes", "SecurityIntegrations", "Comment"),
 				g.KeywordOptions().SQL("SET"),
 			).
 			OptionalQueryStructField(
@@ -124,8 +169,9 @@ var AuthenticationPoliciesDef = g.NewInterface(
 					OptionalSQL("SECURITY_INTEGRATIONS").
 					OptionalSQL("MFA_AUTHENTICATION_METHODS").
 					OptionalSQL("MFA_ENROLLMENT").
+					OptionalSQL("MFA_POLICY").
 					OptionalSQL("COMMENT").
-					WithValidation(g.AtLeastOneValueSet, "ClientTypes", "AuthenticationMethods", "Comment", "SecurityIntegrations", "MfaAuthenticationMethods", "MfaEnrollment"),
+					WithValidation(g.AtLeastOneValueSet, "ClientTypes", "AuthenticationMethods", "Comment", "SecurityIntegrations", "MfaAuthenticationMethods", "MfaEnrollment", "MfaPolicy"),
 				g.ListOptions().NoParentheses().SQL("UNSET"),
 			).
 			Identifier("RenameTo", g.KindOfTPointer[SchemaObjectIdentifier](), g.IdentifierOptions().SQL("RENAME TO")).
@@ -221,6 +267,8 @@ func ToMfaAuthenticationMethodsOption(s string) (*MfaAuthenticationMethodsOption
 func ToMfaEnrollmentOption(s string) (*MfaEnrollmentOption, error) {
 	switch mfaEnrollmentOption := MfaEnrollmentOption(strings.ToUpper(s)); mfaEnrollmentOption {
 	case MfaEnrollmentRequired,
+		MfaEnrollmentRequiredPasswordOnly,
+		MfaEnrollmentRequiredSnowflakeUiPasswordOnly,
 		MfaEnrollmentOptional:
 		return &mfaEnrollmentOption, nil
 	default:
@@ -239,3 +287,25 @@ func ToClientTypesOption(s string) (*ClientTypesOption, error) {
 		return nil, fmt.Errorf("invalid client type: %s", s)
 	}
 }
+
+func ToMfaPolicyAllowedMethodsOption(s string) (*MfaPolicyAllowedMethodsOption, error) {
+	switch mfaPolicyAllowedMethodsOption := MfaPolicyAllowedMethodsOption(strings.ToUpper(s)); mfaPolicyAllowedMethodsOption {
+	case MfaPolicyAllowedMethodsAll,
+		MfaPolicyAllowedMethodsPasskey,
+		MfaPolicyAllowedMethodsTotp,
+		MfaPolicyAllowedMethodsDuo:
+		return &mfaPolicyAllowedMethodsOption, nil
+	default:
+		return nil, fmt.Errorf("invalid MFA policy allowed method: %s", s)
+	}
+}
+
+func ToEnforceMfaOnExternalAuthenticationOption(s string) (*EnforceMfaOnExternalAuthenticationOption, error) {
+	switch enforceMfaOnExternalAuthenticationOption := EnforceMfaOnExternalAuthenticationOption(strings.ToUpper(s)); enforceMfaOnExternalAuthenticationOption {
+	case EnforceMfaOnExternalAuthenticationAll,
+		EnforceMfaOnExternalAuthenticationNone:
+		return &enforceMfaOnExternalAuthenticationOption, nil
+	default:
+		return nil, fmt.Errorf("invalid enforce MFA on external authentication option: %s", s)
+	}
+}
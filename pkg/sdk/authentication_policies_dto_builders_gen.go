@@ -37,6 +37,11 @@ func (s *CreateAuthenticationPolicyRequest) WithMfaEnrollment(MfaEnrollment MfaE
 	return s
 }
 
+func (s *CreateAuthenticationPolicyRequest) WithMfaPolicy(MfaPolicy AuthenticationPolicyMfaPolicyRequest) *CreateAuthenticationPolicyRequest {
+	s.MfaPolicy = &MfaPolicy
+	return s
+}
+
 func (s *CreateAuthenticationPolicyRequest) WithClientTypes(ClientTypes []ClientTypes) *CreateAuthenticationPolicyRequest {
 	s.ClientTypes = ClientTypes
 	return s
@@ -52,6 +57,20 @@ func (s *CreateAuthenticationPolicyRequest) WithComment(Comment string) *CreateA
 	return s
 }
 
+func NewAuthenticationPolicyMfaPolicyRequest() *AuthenticationPolicyMfaPolicyRequest {
+	return &AuthenticationPolicyMfaPolicyRequest{}
+}
+
+func (s *AuthenticationPolicyMfaPolicyRequest) WithAllowedMethods(AllowedMethods []MfaPolicyAllowedMethod) *AuthenticationPolicyMfaPolicyRequest {
+	s.AllowedMethods = AllowedMethods
+	return s
+}
+
+func (s *AuthenticationPolicyMfaPolicyRequest) WithEnforceMfaOnExternalAuthentication(EnforceMfaOnExternalAuthentication EnforceMfaOnExternalAuthenticationOption) *AuthenticationPolicyMfaPolicyRequest {
+	s.EnforceMfaOnExternalAuthentication = &EnforceMfaOnExternalAuthentication
+	return s
+}
+
 func NewAlterAuthenticationPolicyRequest(
 	name SchemaObjectIdentifier,
 ) *AlterAuthenticationPolicyRequest {
@@ -99,6 +118,11 @@ func (s *AuthenticationPolicySetRequest) WithMfaEnrollment(MfaEnrollment MfaEnro
 	return s
 }
 
+func (s *AuthenticationPolicySetRequest) WithMfaPolicy(MfaPolicy AuthenticationPolicyMfaPolicyRequest) *AuthenticationPolicySetRequest {
+	s.MfaPolicy = &MfaPolicy
+	return s
+}
+
 func (s *AuthenticationPolicySetRequest) WithClientTypes(ClientTypes []ClientTypes) *AuthenticationPolicySetRequest {
 	s.ClientTypes = ClientTypes
 	return s
@@ -143,6 +167,11 @@ func (s *AuthenticationPolicyUnsetRequest) WithMfaEnrollment(MfaEnrollment bool)
 	return s
 }
 
+func (s *AuthenticationPolicyUnsetRequest) WithMfaPolicy(MfaPolicy bool) *AuthenticationPolicyUnsetRequest {
+	s.MfaPolicy = &MfaPolicy
+	return s
+}
+
 func (s *AuthenticationPolicyUnsetRequest) WithComment(Comment bool) *AuthenticationPolicyUnsetRequest {
 	s.Comment = &Comment
 	return s
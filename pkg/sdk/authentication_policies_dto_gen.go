@@ -17,11 +17,17 @@ type CreateAuthenticationPolicyRequest struct {
 	AuthenticationMethods    []AuthenticationMethods
 	MfaAuthenticationMethods []MfaAuthenticationMethods
 	MfaEnrollment            *MfaEnrollmentOption
+	MfaPolicy                *AuthenticationPolicyMfaPolicyRequest
 	ClientTypes              []ClientTypes
 	SecurityIntegrations     []SecurityIntegrationsOption
 	Comment                  *string
 }
 
+type AuthenticationPolicyMfaPolicyRequest struct {
+	AllowedMethods                     []MfaPolicyAllowedMethod
+	EnforceMfaOnExternalAuthentication *EnforceMfaOnExternalAuthenticationOption
+}
+
 type AlterAuthenticationPolicyRequest struct {
 	IfExists *bool
 	name     SchemaObjectIdentifier // required
@@ -34,6 +40,7 @@ type AuthenticationPolicySetRequest struct {
 	AuthenticationMethods    []AuthenticationMethods
 	MfaAuthenticationMethods []MfaAuthenticationMethods
 	MfaEnrollment            *MfaEnrollmentOption
+	MfaPolicy                *AuthenticationPolicyMfaPolicyRequest
 	ClientTypes              []ClientTypes
 	SecurityIntegrations     []SecurityIntegrationsOption
 	Comment                  *string
@@ -45,6 +52,7 @@ type AuthenticationPolicyUnsetRequest struct {
 	SecurityIntegrations     *bool
 	MfaAuthenticationMethods *bool
 	MfaEnrollment            *bool
+	MfaPolicy                *bool
 	Comment                  *bool
 }
 
@@ -0,0 +1,60 @@
+package sdk
+
+import (
+	"fmt"
+	"strings"
+)
+
+// AuthenticationPolicyMfaPolicyDetails is the parsed MFA_POLICY property returned by DESCRIBE AUTHENTICATION POLICY.
+type AuthenticationPolicyMfaPolicyDetails struct {
+	AllowedMethods                     []MfaPolicyAllowedMethodsOption
+	EnforceMfaOnExternalAuthentication EnforceMfaOnExternalAuthenticationOption
+}
+
+// ParseAuthenticationPolicyMfaPolicy parses the MFA_POLICY property value, e.g. {ALLOWED_METHODS=[PASSKEY, TOTP], ENFORCE_MFA_ON_EXTERNAL_AUTHENTICATION=ALL}.
+func ParseAuthenticationPolicyMfaPolicy(value string) (*AuthenticationPolicyMfaPolicyDetails, error) {
+	trimmed := strings.TrimSpace(value)
+	if !strings.HasPrefix(trimmed, "{") || !strings.HasSuffix(trimmed, "}") {
+		return nil, fmt.Errorf("invalid MFA policy: %s", value)
+	}
+	trimmed = strings.TrimSuffix(strings.TrimPrefix(trimmed, "{"), "}")
+
+	details := &AuthenticationPolicyMfaPolicyDetails{}
+	for trimmed != "" {
+		key, rest, found := strings.Cut(trimmed, "=")
+		if !found {
+			return nil, fmt.Errorf("invalid MFA policy: %s", value)
+		}
+		var propertyValue string
+		if strings.HasPrefix(rest, "[") {
+			end := strings.Index(rest, "]")
+			if end == -1 {
+				return nil, fmt.Errorf("invalid MFA policy: %s", value)
+			}
+			propertyValue, rest = rest[:end+1], strings.TrimPrefix(strings.TrimSpace(rest[end+1:]), ",")
+		} else {
+			propertyValue, rest, _ = strings.Cut(rest, ",")
+		}
+		trimmed = strings.TrimSpace(rest)
+
+		switch strings.TrimSpace(key) {
+		case "ALLOWED_METHODS":
+			methods := ParseCommaSeparatedStringArray(propertyValue, false)
+			details.AllowedMethods = make([]MfaPolicyAllowedMethodsOption, len(methods))
+			for i, method := range methods {
+				option, err := ToMfaPolicyAllowedMethodsOption(method)
+				if err != nil {
+					return nil, err
+				}
+				details.AllowedMethods[i] = *option
+			}
+		case "ENFORCE_MFA_ON_EXTERNAL_AUTHENTICATION":
+			option, err := ToEnforceMfaOnExternalAuthenticationOption(strings.TrimSpace(propertyValue))
+			if err != nil {
+				return nil, err
+			}
+			details.EnforceMfaOnExternalAuthentication = *option
+		}
+	}
+	return details, nil
+}
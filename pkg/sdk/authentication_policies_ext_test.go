@@ -0,0 +1,63 @@
+package sdk
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ParseAuthenticationPolicyMfaPolicy(t *testing.T) {
+	inputs := []struct {
+		rawInput string
+		expected AuthenticationPolicyMfaPolicyDetails
+	}{
+		{
+			"{ALLOWED_METHODS=[ALL], ENFORCE_MFA_ON_EXTERNAL_AUTHENTICATION=NONE}",
+			AuthenticationPolicyMfaPolicyDetails{AllowedMethods: []MfaPolicyAllowedMethodsOption{MfaPolicyAllowedMethodsAll}, EnforceMfaOnExternalAuthentication: EnforceMfaOnExternalAuthenticationNone},
+		},
+		{
+			"{ALLOWED_METHODS=[PASSKEY, TOTP], ENFORCE_MFA_ON_EXTERNAL_AUTHENTICATION=ALL}",
+			AuthenticationPolicyMfaPolicyDetails{AllowedMethods: []MfaPolicyAllowedMethodsOption{MfaPolicyAllowedMethodsPasskey, MfaPolicyAllowedMethodsTotp}, EnforceMfaOnExternalAuthentication: EnforceMfaOnExternalAuthenticationAll},
+		},
+		{
+			"{ENFORCE_MFA_ON_EXTERNAL_AUTHENTICATION=ALL, ALLOWED_METHODS=[DUO]}",
+			AuthenticationPolicyMfaPolicyDetails{AllowedMethods: []MfaPolicyAllowedMethodsOption{MfaPolicyAllowedMethodsDuo}, EnforceMfaOnExternalAuthentication: EnforceMfaOnExternalAuthenticationAll},
+		},
+		{
+			"{ALLOWED_METHODS=[]}",
+			AuthenticationPolicyMfaPolicyDetails{AllowedMethods: []MfaPolicyAllowedMethodsOption{}},
+		},
+		{
+			"{}",
+			AuthenticationPolicyMfaPolicyDetails{},
+		},
+	}
+
+	badInputs := []struct {
+		rawInput          string
+		expectedErrorPart string
+	}{
+		{"", "invalid MFA policy"},
+		{"ALLOWED_METHODS=[ALL]", "invalid MFA policy"},
+		{"{ALLOWED_METHODS}", "invalid MFA policy"},
+		{"{ALLOWED_METHODS=[ALL}", "invalid MFA policy"},
+		{"{ALLOWED_METHODS=[SMS]}", "invalid MFA policy allowed method: SMS"},
+		{"{ENFORCE_MFA_ON_EXTERNAL_AUTHENTICATION=SOME}", "invalid enforce MFA on external authentication option: SOME"},
+	}
+
+	for _, tc := range inputs {
+		t.Run(fmt.Sprintf("Snowflake raw MFA policy: %s", tc.rawInput), func(t *testing.T) {
+			result, err := ParseAuthenticationPolicyMfaPolicy(tc.rawInput)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, *result)
+		})
+	}
+
+	for _, tc := range badInputs {
+		t.Run(fmt.Sprintf("incorrect Snowflake input: %s, expecting error with: %s", tc.rawInput, tc.expectedErrorPart), func(t *testing.T) {
+			_, err := ParseAuthenticationPolicyMfaPolicy(tc.rawInput)
+			require.ErrorContains(t, err, tc.expectedErrorPart)
+		})
+	}
+}
@@ -13,17 +13,18 @@ type AuthenticationPolicies interface {
 
 // CreateAuthenticationPolicyOptions is based on https://docs.snowflake.com/en/sql-reference/sql/create-authentication-policy.
 type CreateAuthenticationPolicyOptions struct {
-	create                   bool                         `ddl:"static" sql:"CREATE"`
-	OrReplace                *bool                        `ddl:"keyword" sql:"OR REPLACE"`
-	authenticationPolicy     bool                         `ddl:"static" sql:"AUTHENTICATION POLICY"`
-	IfNotExists              *bool                        `ddl:"keyword" sql:"IF NOT EXISTS"`
-	name                     SchemaObjectIdentifier       `ddl:"identifier"`
-	AuthenticationMethods    []AuthenticationMethods      `ddl:"parameter,parentheses" sql:"AUTHENTICATION_METHODS"`
-	MfaAuthenticationMethods []MfaAuthenticationMethods   `ddl:"parameter,parentheses" sql:"MFA_AUTHENTICATION_METHODS"`
-	MfaEnrollment            *MfaEnrollmentOption         `ddl:"parameter" sql:"MFA_ENROLLMENT"`
-	ClientTypes              []ClientTypes                `ddl:"parameter,parentheses" sql:"CLIENT_TYPES"`
-	SecurityIntegrations     []SecurityIntegrationsOption `ddl:"parameter,parentheses" sql:"SECURITY_INTEGRATIONS"`
-	Comment                  *string                      `ddl:"parameter,single_quotes" sql:"COMMENT"`
+	create                   bool                           `ddl:"static" sql:"CREATE"`
+	OrReplace                *bool                          `ddl:"keyword" sql:"OR REPLACE"`
+	authenticationPolicy     bool                           `ddl:"static" sql:"AUTHENTICATION POLICY"`
+	IfNotExists              *bool                          `ddl:"keyword" sql:"IF NOT EXISTS"`
+	name                     SchemaObjectIdentifier         `ddl:"identifier"`
+	AuthenticationMethods    []AuthenticationMethods        `ddl:"parameter,parentheses" sql:"AUTHENTICATION_METHODS"`
+	MfaAuthenticationMethods []MfaAuthenticationMethods     `ddl:"parameter,parentheses" sql:"MFA_AUTHENTICATION_METHODS"`
+	MfaEnrollment            *MfaEnrollmentOption           `ddl:"parameter" sql:"MFA_ENROLLMENT"`
+	MfaPolicy                *AuthenticationPolicyMfaPolicy `ddl:"list,parentheses,no_comma" sql:"MFA_POLICY ="`
+	ClientTypes              []ClientTypes                  `ddl:"parameter,parentheses" sql:"CLIENT_TYPES"`
+	SecurityIntegrations     []SecurityIntegrationsOption   `ddl:"parameter,parentheses" sql:"SECURITY_INTEGRATIONS"`
+	Comment                  *string                        `ddl:"parameter,single_quotes" sql:"COMMENT"`
 }
 
 type AuthenticationMethods struct {
@@ -42,6 +43,15 @@ type SecurityIntegrationsOption struct {
 	Name AccountObjectIdentifier `ddl:"identifier"`
 }
 
+type MfaPolicyAllowedMethod struct {
+	Method MfaPolicyAllowedMethodsOption `ddl:"keyword,single_quotes"`
+}
+
+type AuthenticationPolicyMfaPolicy struct {
+	AllowedMethods                     []MfaPolicyAllowedMethod                  `ddl:"parameter,parentheses" sql:"ALLOWED_METHODS"`
+	EnforceMfaOnExternalAuthentication *EnforceMfaOnExternalAuthenticationOption `ddl:"parameter,single_quotes" sql:"ENFORCE_MFA_ON_EXTERNAL_AUTHENTICATION"`
+}
+
 // AlterAuthenticationPolicyOptions is based on https://docs.snowflake.com/en/sql-reference/sql/alter-authentication-policy.
 type AlterAuthenticationPolicyOptions struct {
 	alter                bool                       `ddl:"static" sql:"ALTER"`
@@ -54,12 +64,13 @@ type AlterAuthenticationPolicyOptions struct {
 }
 
 type AuthenticationPolicySet struct {
-	AuthenticationMethods    []AuthenticationMethods      `ddl:"parameter,parentheses" sql:"AUTHENTICATION_METHODS"`
-	MfaAuthenticationMethods []MfaAuthenticationMethods   `ddl:"parameter,parentheses" sql:"MFA_AUTHENTICATION_METHODS"`
-	MfaEnrollment            *MfaEnrollmentOption         `ddl:"parameter" sql:"MFA_ENROLLMENT"`
-	ClientTypes              []ClientTypes                `ddl:"parameter,parentheses" sql:"CLIENT_TYPES"`
-	SecurityIntegrations     []SecurityIntegrationsOption `ddl:"parameter,parentheses" sql:"SECURITY_INTEGRATIONS"`
-	Comment                  *string                      `ddl:"parameter,single_quotes" sql:"COMMENT"`
+	AuthenticationMethods    []AuthenticationMethods        `ddl:"parameter,parentheses" sql:"AUTHENTICATION_METHODS"`
+	MfaAuthenticationMethods []MfaAuthenticationMethods     `ddl:"parameter,parentheses" sql:"MFA_AUTHENTICATION_METHODS"`
+	MfaEnrollment            *MfaEnrollmentOption           `ddl:"parameter" sql:"MFA_ENROLLMENT"`
+	MfaPolicy                *AuthenticationPolicyMfaPolicy `ddl:"list,parentheses,no_comma" sql:"MFA_POLICY ="`
+	ClientTypes              []ClientTypes                  `ddl:"parameter,parentheses" sql:"CLIENT_TYPES"`
+	SecurityIntegrations     []SecurityIntegrationsOption   `ddl:"parameter,parentheses" sql:"SECURITY_INTEGRATIONS"`
+	Comment                  *string                        `ddl:"parameter,single_quotes" sql:"COMMENT"`
 }
 
 type AuthenticationPolicyUnset struct {
@@ -68,6 +79,7 @@ type AuthenticationPolicyUnset struct {
 	SecurityIntegrations     *bool `ddl:"keyword" sql:"SECURITY_INTEGRATIONS"`
 	MfaAuthenticationMethods *bool `ddl:"keyword" sql:"MFA_AUTHENTICATION_METHODS"`
 	MfaEnrollment            *bool `ddl:"keyword" sql:"MFA_ENROLLMENT"`
+	MfaPolicy                *bool `ddl:"keyword" sql:"MFA_POLICY"`
 	Comment                  *bool `ddl:"keyword" sql:"COMMENT"`
 }
 
@@ -29,6 +29,12 @@ func TestAuthenticationPolicies_Create(t *testing.T) {
 		assertOptsInvalidJoinedErrors(t, opts, errOneOf("CreateAuthenticationPolicyOptions", "IfNotExists", "OrReplace"))
 	})
 
+	t.Run("validation: at least one of the fields [opts.MfaPolicy.AllowedMethods opts.MfaPolicy.EnforceMfaOnExternalAuthentication] should be set", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.MfaPolicy = &AuthenticationPolicyMfaPolicy{}
+		assertOptsInvalidJoinedErrors(t, opts, errAtLeastOneOf("CreateAuthenticationPolicyOptions.MfaPolicy", "AllowedMethods", "EnforceMfaOnExternalAuthentication"))
+	})
+
 	t.Run("basic", func(t *testing.T) {
 		opts := defaultOpts()
 		opts.AuthenticationMethods = []AuthenticationMethods{
@@ -48,7 +54,14 @@ func TestAuthenticationPolicies_Create(t *testing.T) {
 		opts.MfaAuthenticationMethods = []MfaAuthenticationMethods{
 			{Method: MfaAuthenticationMethodsPassword},
 		}
-		opts.MfaEnrollment = Pointer(MfaEnrollmentOptional)
+		opts.MfaEnrollment = Pointer(MfaEnrollmentRequiredPasswordOnly)
+		opts.MfaPolicy = &AuthenticationPolicyMfaPolicy{
+			AllowedMethods: []MfaPolicyAllowedMethod{
+				{Method: MfaPolicyAllowedMethodsPasskey},
+				{Method: MfaPolicyAllowedMethodsTotp},
+			},
+			EnforceMfaOnExternalAuthentication: Pointer(EnforceMfaOnExternalAuthenticationAll),
+		}
 		opts.ClientTypes = []ClientTypes{
 			{ClientType: ClientTypesDrivers},
 			{ClientType: ClientTypesSnowSql},
@@ -57,7 +70,7 @@ func TestAuthenticationPolicies_Create(t *testing.T) {
 			{Name: NewAccountObjectIdentifier("security_integration")},
 		}
 		opts.Comment = String("some comment")
-		assertOptsValidAndSQLEquals(t, opts, "CREATE OR REPLACE AUTHENTICATION POLICY %s AUTHENTICATION_METHODS = ('SAML', 'PASSWORD') MFA_AUTHENTICATION_METHODS = ('PASSWORD') MFA_ENROLLMENT = OPTIONAL CLIENT_TYPES = ('DRIVERS', 'SNOWSQL') SECURITY_INTEGRATIONS = (\"security_integration\") COMMENT = 'some comment'", id.FullyQualifiedName())
+		assertOptsValidAndSQLEquals(t, opts, "CREATE OR REPLACE AUTHENTICATION POLICY %s AUTHENTICATION_METHODS = ('SAML', 'PASSWORD') MFA_AUTHENTICATION_METHODS = ('PASSWORD') MFA_ENROLLMENT = REQUIRED_PASSWORD_ONLY MFA_POLICY = (ALLOWED_METHODS = ('PASSKEY', 'TOTP') ENFORCE_MFA_ON_EXTERNAL_AUTHENTICATION = 'ALL') CLIENT_TYPES = ('DRIVERS', 'SNOWSQL') SECURITY_INTEGRATIONS = (\"security_integration\") COMMENT = 'some comment'", id.FullyQualifiedName())
 	})
 }
 
@@ -92,16 +105,24 @@ func TestAuthenticationPolicies_Alter(t *testing.T) {
 		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
 	})
 
-	t.Run("validation: at least one of the fields [opts.Set.AuthenticationMethods opts.Set.MfaAuthenticationMethods opts.Set.MfaEnrollment opts.Set.ClientTypes opts.Set.SecurityIntegrations opts.Set.Comment] should be set", func(t *testing.T) {
+	t.Run("validation: at least one of the fields [opts.Set.AuthenticationMethods opts.Set.MfaAuthenticationMethods opts.Set.MfaEnrollment opts.Set.MfaPolicy opts.Set.ClientTypes opts.Set.SecurityIntegrations opts.Set.Comment] should be set", func(t *testing.T) {
 		opts := defaultOpts()
 		opts.Set = &AuthenticationPolicySet{}
-		assertOptsInvalidJoinedErrors(t, opts, errAtLeastOneOf("AlterAuthenticationPolicyOptions.Set", "AuthenticationMethods", "MfaAuthenticationMethods", "MfaEnrollment", "ClientTypes", "SecurityIntegrations", "Comment"))
+		assertOptsInvalidJoinedErrors(t, opts, errAtLeastOneOf("AlterAuthenticationPolicyOptions.Set", "AuthenticationMethods", "MfaAuthenticationMethods", "MfaEnrollment", "MfaPolicy", "ClientTypes", "SecurityIntegrations", "Comment"))
 	})
 
-	t.Run("validation: at least one of the fields [opts.Unset.ClientTypes opts.Unset.AuthenticationMethods opts.Unset.Comment opts.Unset.SecurityIntegrations opts.Unset.MfaAuthenticationMethods opts.Unset.MfaEnrollment] should be set", func(t *testing.T) {
+	t.Run("validation: at least one of the fields [opts.Set.MfaPolicy.AllowedMethods opts.Set.MfaPolicy.EnforceMfaOnExternalAuthentication] should be set", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Set = &AuthenticationPolicySet{
+			MfaPolicy: &AuthenticationPolicyMfaPolicy{},
+		}
+		assertOptsInvalidJoinedErrors(t, opts, errAtLeastOneOf("AlterAuthenticationPolicyOptions.Set.MfaPolicy", "AllowedMethods", "EnforceMfaOnExternalAuthentication"))
+	})
+
+	t.Run("validation: at least one of the fields [opts.Unset.ClientTypes opts.Unset.AuthenticationMethods opts.Unset.Comment opts.Unset.SecurityIntegrations opts.Unset.MfaAuthenticationMethods opts.Unset.MfaEnrollment opts.Unset.MfaPolicy] should be set", func(t *testing.T) {
 		opts := defaultOpts()
 		opts.Unset = &AuthenticationPolicyUnset{}
-		assertOptsInvalidJoinedErrors(t, opts, errAtLeastOneOf("AlterAuthenticationPolicyOptions.Unset", "ClientTypes", "AuthenticationMethods", "Comment", "SecurityIntegrations", "MfaAuthenticationMethods", "MfaEnrollment"))
+		assertOptsInvalidJoinedErrors(t, opts, errAtLeastOneOf("AlterAuthenticationPolicyOptions.Unset", "ClientTypes", "AuthenticationMethods", "Comment", "SecurityIntegrations", "MfaAuthenticationMethods", "MfaEnrollment", "MfaPolicy"))
 	})
 
 	t.Run("alter: set basic", func(t *testing.T) {
@@ -114,6 +135,16 @@ func TestAuthenticationPolicies_Alter(t *testing.T) {
 		assertOptsValidAndSQLEquals(t, opts, "ALTER AUTHENTICATION POLICY %s SET AUTHENTICATION_METHODS = ('SAML')", id.FullyQualifiedName())
 	})
 
+	t.Run("alter: set mfa policy", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Set = &AuthenticationPolicySet{
+			MfaPolicy: &AuthenticationPolicyMfaPolicy{
+				EnforceMfaOnExternalAuthentication: Pointer(EnforceMfaOnExternalAuthenticationNone),
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER AUTHENTICATION POLICY %s SET MFA_POLICY = (ENFORCE_MFA_ON_EXTERNAL_AUTHENTICATION = 'NONE')", id.FullyQualifiedName())
+	})
+
 	t.Run("alter: set all options", func(t *testing.T) {
 		opts := defaultOpts()
 		opts.IfExists = Bool(true)
@@ -125,6 +156,11 @@ func TestAuthenticationPolicies_Alter(t *testing.T) {
 				{Method: MfaAuthenticationMethodsPassword},
 			},
 			MfaEnrollment: Pointer(MfaEnrollmentOptional),
+			MfaPolicy: &AuthenticationPolicyMfaPolicy{
+				AllowedMethods: []MfaPolicyAllowedMethod{
+					{Method: MfaPolicyAllowedMethodsAll},
+				},
+			},
 			ClientTypes: []ClientTypes{
 				{ClientType: ClientTypesDrivers},
 				{ClientType: ClientTypesSnowSql},
@@ -132,7 +168,7 @@ func TestAuthenticationPolicies_Alter(t *testing.T) {
 			SecurityIntegrations: []SecurityIntegrationsOption{{Name: NewAccountObjectIdentifier("security_integration")}},
 			Comment:              String("some comment"),
 		}
-		assertOptsValidAndSQLEquals(t, opts, "ALTER AUTHENTICATION POLICY IF EXISTS %s SET AUTHENTICATION_METHODS = ('SAML') MFA_AUTHENTICATION_METHODS = ('PASSWORD') MFA_ENROLLMENT = OPTIONAL CLIENT_TYPES = ('DRIVERS', 'SNOWSQL') SECURITY_INTEGRATIONS = (\"security_integration\") COMMENT = 'some comment'", id.FullyQualifiedName())
+		assertOptsValidAndSQLEquals(t, opts, "ALTER AUTHENTICATION POLICY IF EXISTS %s SET AUTHENTICATION_METHODS = ('SAML') MFA_AUTHENTICATION_METHODS = ('PASSWORD') MFA_ENROLLMENT = OPTIONAL MFA_POLICY = (ALLOWED_METHODS = ('ALL')) CLIENT_TYPES = ('DRIVERS', 'SNOWSQL') SECURITY_INTEGRATIONS = (\"security_integration\") COMMENT = 'some comment'", id.FullyQualifiedName())
 	})
 
 	t.Run("alter: unset basic", func(t *testing.T) {
@@ -152,9 +188,10 @@ func TestAuthenticationPolicies_Alter(t *testing.T) {
 			SecurityIntegrations:     Bool(true),
 			MfaAuthenticationMethods: Bool(true),
 			MfaEnrollment:            Bool(true),
+			MfaPolicy:                Bool(true),
 			Comment:                  Bool(true),
 		}
-		assertOptsValidAndSQLEquals(t, opts, "ALTER AUTHENTICATION POLICY IF EXISTS %s UNSET CLIENT_TYPES, AUTHENTICATION_METHODS, SECURITY_INTEGRATIONS, MFA_AUTHENTICATION_METHODS, MFA_ENROLLMENT, COMMENT", id.FullyQualifiedName())
+		assertOptsValidAndSQLEquals(t, opts, "ALTER AUTHENTICATION POLICY IF EXISTS %s UNSET CLIENT_TYPES, AUTHENTICATION_METHODS, SECURITY_INTEGRATIONS, MFA_AUTHENTICATION_METHODS, MFA_ENROLLMENT, MFA_POLICY, COMMENT", id.FullyQualifiedName())
 	})
 
 	t.Run("alter: renameTo", func(t *testing.T) {
@@ -71,6 +71,12 @@ func (r *CreateAuthenticationPolicyRequest) toOpts() *CreateAuthenticationPolicy
 		SecurityIntegrations:     r.SecurityIntegrations,
 		Comment:                  r.Comment,
 	}
+	if r.MfaPolicy != nil {
+		opts.MfaPolicy = &AuthenticationPolicyMfaPolicy{
+			AllowedMethods:                     r.MfaPolicy.AllowedMethods,
+			EnforceMfaOnExternalAuthentication: r.MfaPolicy.EnforceMfaOnExternalAuthentication,
+		}
+	}
 	return opts
 }
 
@@ -91,6 +97,12 @@ func (r *AlterAuthenticationPolicyRequest) toOpts() *AlterAuthenticationPolicyOp
 			SecurityIntegrations:     r.Set.SecurityIntegrations,
 			Comment:                  r.Set.Comment,
 		}
+		if r.Set.MfaPolicy != nil {
+			opts.Set.MfaPolicy = &AuthenticationPolicyMfaPolicy{
+				AllowedMethods:                     r.Set.MfaPolicy.AllowedMethods,
+				EnforceMfaOnExternalAuthentication: r.Set.MfaPolicy.EnforceMfaOnExternalAuthentication,
+			}
+		}
 	}
 
 	if r.Unset != nil {
@@ -100,6 +112,7 @@ func (r *AlterAuthenticationPolicyRequest) toOpts() *AlterAuthenticationPolicyOp
 			SecurityIntegrations:     r.Unset.SecurityIntegrations,
 			MfaAuthenticationMethods: r.Unset.MfaAuthenticationMethods,
 			MfaEnrollment:            r.Unset.MfaEnrollment,
+			MfaPolicy:                r.Unset.MfaPolicy,
 			Comment:                  r.Unset.Comment,
 		}
 	}
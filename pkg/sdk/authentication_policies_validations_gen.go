@@ -19,6 +19,11 @@ func (opts *CreateAuthenticationPolicyOptions) validate() error {
 	if everyValueSet(opts.IfNotExists, opts.OrReplace) {
 		errs = append(errs, errOneOf("CreateAuthenticationPolicyOptions", "IfNotExists", "OrReplace"))
 	}
+	if valueSet(opts.MfaPolicy) {
+		if !anyValueSet(opts.MfaPolicy.AllowedMethods, opts.MfaPolicy.EnforceMfaOnExternalAuthentication) {
+			errs = append(errs, errAtLeastOneOf("CreateAuthenticationPolicyOptions.MfaPolicy", "AllowedMethods", "EnforceMfaOnExternalAuthentication"))
+		}
+	}
 	return JoinErrors(errs...)
 }
 
@@ -37,13 +42,18 @@ func (opts *AlterAuthenticationPolicyOptions) validate() error {
 		errs = append(errs, ErrInvalidObjectIdentifier)
 	}
 	if valueSet(opts.Set) {
-		if !anyValueSet(opts.Set.AuthenticationMethods, opts.Set.MfaAuthenticationMethods, opts.Set.MfaEnrollment, opts.Set.ClientTypes, opts.Set.SecurityIntegrations, opts.Set.Comment) {
-			errs = append(errs, errAtLeastOneOf("AlterAuthenticationPolicyOptions.Set", "AuthenticationMethods", "MfaAuthenticationMethods", "MfaEnrollment", "ClientTypes", "SecurityIntegrations", "Comment"))
+		if !anyValueSet(opts.Set.AuthenticationMethods, opts.Set.MfaAuthenticationMethods, opts.Set.MfaEnrollment, opts.Set.MfaPolicy, opts.Set.ClientTypes, opts.Set.SecurityIntegrations, opts.Set.Comment) {
+			errs = append(errs, errAtLeastOneOf("AlterAuthenticationPolicyOptions.Set", "AuthenticationMethods", "MfaAuthenticationMethods", "MfaEnrollment", "MfaPolicy", "ClientTypes", "SecurityIntegrations", "Comment"))
+		}
+		if valueSet(opts.Set.MfaPolicy) {
+			if !anyValueSet(opts.Set.MfaPolicy.AllowedMethods, opts.Set.MfaPolicy.EnforceMfaOnExternalAuthentication) {
+				errs = append(errs, errAtLeastOneOf("AlterAuthenticationPolicyOptions.Set.MfaPolicy", "AllowedMethods", "EnforceMfaOnExternalAuthentication"))
+			}
 		}
 	}
 	if valueSet(opts.Unset) {
-		if !anyValueSet(opts.Unset.ClientTypes, opts.Unset.AuthenticationMethods, opts.Unset.Comment, opts.Unset.SecurityIntegrations, opts.Unset.MfaAuthenticationMethods, opts.Unset.MfaEnrollment) {
-			errs = append(errs, errAtLeastOneOf("AlterAuthenticationPolicyOptions.Unset", "ClientTypes", "AuthenticationMethods", "Comment", "SecurityIntegrations", "MfaAuthenticationMethods", "MfaEnrollment"))
+		if !anyValueSet(opts.Unset.ClientTypes, opts.Unset.AuthenticationMethods, opts.Unset.Comment, opts.Unset.SecurityIntegrations, opts.Unset.MfaAuthenticationMethods, opts.Unset.MfaEnrollment, opts.Unset.MfaPolicy) {
+			errs = append(errs, errAtLeastOneOf("AlterAuthenticationPolicyOptions.Unset", "ClientTypes", "AuthenticationMethods", "Comment", "SecurityIntegrations", "MfaAuthenticationMethods", "MfaEnrollment", "MfaPolicy"))
 		}
 	}
 	return JoinErrors(errs...)
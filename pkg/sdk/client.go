@@ -40,6 +40,8 @@ type Client struct {
 	Databases                    Databases
 	DataMetricFunctionReferences DataMetricFunctionReferences
 	DynamicTables                DynamicTables
+	ExternalAccessIntegrations   ExternalAccessIntegrations
+	OrganizationAccounts         OrganizationAccounts
 	ExternalFunctions            ExternalFunctions
 	ExternalVolumes              ExternalVolumes
 	ExternalTables               ExternalTables
@@ -48,6 +50,7 @@ type Client struct {
 	FileFormats                  FileFormats
 	Functions                    Functions
 	Grants                       Grants
+	HybridTables                 HybridTablesExtended
 	ManagedAccounts              ManagedAccounts
 	MaskingPolicies              MaskingPolicies
 	MaterializedViews            MaterializedViews
@@ -69,7 +72,7 @@ type Client struct {
 	SessionPolicies              SessionPolicies
 	Sessions                     Sessions
 	Shares                       Shares
-	Stages                       Stages
+	Stages                       StagesExtended
 	StorageIntegrations          StorageIntegrations
 	Streamlits                   Streamlits
 	Streams                      Streams
@@ -77,6 +80,7 @@ type Client struct {
 	Tags                         Tags
 	Tasks                        Tasks
 	Users                        Users
+	UserProgrammaticAccessTokens UserProgrammaticAccessTokensExtended
 	Views                        Views
 	Warehouses                   Warehouses
 }
@@ -168,6 +172,8 @@ func (c *Client) initialize() {
 	c.Databases = &databases{client: c}
 	c.DataMetricFunctionReferences = &dataMetricFunctionReferences{client: c}
 	c.DynamicTables = &dynamicTables{client: c}
+	c.ExternalAccessIntegrations = &externalAccessIntegrations{client: c}
+	c.OrganizationAccounts = &organizationAccounts{client: c}
 	c.ExternalFunctions = &externalFunctions{client: c}
 	c.ExternalVolumes = &externalVolumes{client: c}
 	c.ExternalTables = &externalTables{client: c}
@@ -176,6 +182,7 @@ func (c *Client) initialize() {
 	c.FileFormats = &fileFormats{client: c}
 	c.Functions = &functions{client: c}
 	c.Grants = &grants{client: c}
+	c.HybridTables = &hybridTables{client: c}
 	c.ManagedAccounts = &managedAccounts{client: c}
 	c.MaskingPolicies = &maskingPolicies{client: c}
 	c.MaterializedViews = &materializedViews{client: c}
@@ -207,6 +214,7 @@ func (c *Client) initialize() {
 	c.Tags = &tags{client: c}
 	c.Tasks = &tasks{client: c}
 	c.Users = &users{client: c}
+	c.UserProgrammaticAccessTokens = &userProgrammaticAccessTokens{client: c}
 	c.Views = &views{client: c}
 	c.Warehouses = &warehouses{client: c}
 }
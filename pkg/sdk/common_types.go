@@ -32,6 +32,8 @@ type Clone struct {
 	SourceObject ObjectIdentifier `ddl:"identifier" sql:"CLONE"`
 	At           *TimeTravel      `ddl:"list,parentheses,no_comma" sql:"AT"`
 	Before       *TimeTravel      `ddl:"list,parentheses,no_comma" sql:"BEFORE"`
+	// IgnoreTablesWithInsufficientDataRetention is applicable only when cloning databases and schemas.
+	IgnoreTablesWithInsufficientDataRetention *bool `ddl:"keyword" sql:"IGNORE TABLES WITH INSUFFICIENT DATA RETENTION"`
 }
 
 func (v *Clone) validate() error {
@@ -123,6 +123,12 @@ func MergeConfig(baseConfig *gosnowflake.Config, mergeConfig *gosnowflake.Config
 	if baseConfig.OktaURL == nil {
 		baseConfig.OktaURL = mergeConfig.OktaURL
 	}
+	if baseConfig.WorkloadIdentityProvider == "" {
+		baseConfig.WorkloadIdentityProvider = mergeConfig.WorkloadIdentityProvider
+	}
+	if baseConfig.WorkloadIdentityEntraResource == "" {
+		baseConfig.WorkloadIdentityEntraResource = mergeConfig.WorkloadIdentityEntraResource
+	}
 	if baseConfig.LoginTimeout == 0 {
 		baseConfig.LoginTimeout = mergeConfig.LoginTimeout
 	}
@@ -215,36 +221,38 @@ func GetConfigFileName() (string, error) {
 
 // TODO(SNOW-1787920): improve TOML parsing
 type ConfigDTO struct {
-	AccountName            *string             `toml:"accountname"`
-	OrganizationName       *string             `toml:"organizationname"`
-	User                   *string             `toml:"user"`
-	Username               *string             `toml:"username"`
-	Password               *string             `toml:"password"`
-	Host                   *string             `toml:"host"`
-	Warehouse              *string             `toml:"warehouse"`
-	Role                   *string             `toml:"role"`
-	Params                 *map[string]*string `toml:"params"`
-	ClientIp               *string             `toml:"clientip"`
-	Protocol               *string             `toml:"protocol"`
-	Passcode               *string             `toml:"passcode"`
-	Port                   *int                `toml:"port"`
-	PasscodeInPassword     *bool               `toml:"passcodeinpassword"`
-	OktaUrl                *string             `toml:"oktaurl"`
-	ClientTimeout          *int                `toml:"clienttimeout"`
-	JwtClientTimeout       *int                `toml:"jwtclienttimeout"`
-	LoginTimeout           *int                `toml:"logintimeout"`
-	RequestTimeout         *int                `toml:"requesttimeout"`
-	JwtExpireTimeout       *int                `toml:"jwtexpiretimeout"`
-	ExternalBrowserTimeout *int                `toml:"externalbrowsertimeout"`
-	MaxRetryCount          *int                `toml:"maxretrycount"`
-	Authenticator          *string             `toml:"authenticator"`
-	InsecureMode           *bool               `toml:"insecuremode"`
-	OcspFailOpen           *bool               `toml:"ocspfailopen"`
-	Token                  *string             `toml:"token"`
-	KeepSessionAlive       *bool               `toml:"keepsessionalive"`
-	PrivateKey             *string             `toml:"privatekey,multiline"`
-	PrivateKeyPassphrase   *string             `toml:"privatekeypassphrase"`
-	DisableTelemetry       *bool               `toml:"disabletelemetry"`
+	AccountName                   *string             `toml:"accountname"`
+	OrganizationName              *string             `toml:"organizationname"`
+	User                          *string             `toml:"user"`
+	Username                      *string             `toml:"username"`
+	Password                      *string             `toml:"password"`
+	Host                          *string             `toml:"host"`
+	Warehouse                     *string             `toml:"warehouse"`
+	Role                          *string             `toml:"role"`
+	Params                        *map[string]*string `toml:"params"`
+	ClientIp                      *string             `toml:"clientip"`
+	Protocol                      *string             `toml:"protocol"`
+	Passcode                      *string             `toml:"passcode"`
+	Port                          *int                `toml:"port"`
+	PasscodeInPassword            *bool               `toml:"passcodeinpassword"`
+	OktaUrl                       *string             `toml:"oktaurl"`
+	WorkloadIdentityProvider      *string             `toml:"workloadidentityprovider"`
+	WorkloadIdentityEntraResource *string             `toml:"workloadidentityentraresource"`
+	ClientTimeout                 *int                `toml:"clienttimeout"`
+	JwtClientTimeout              *int                `toml:"jwtclienttimeout"`
+	LoginTimeout                  *int                `toml:"logintimeout"`
+	RequestTimeout                *int                `toml:"requesttimeout"`
+	JwtExpireTimeout              *int                `toml:"jwtexpiretimeout"`
+	ExternalBrowserTimeout        *int                `toml:"externalbrowsertimeout"`
+	MaxRetryCount                 *int                `toml:"maxretrycount"`
+	Authenticator                 *string             `toml:"authenticator"`
+	InsecureMode                  *bool               `toml:"insecuremode"`
+	OcspFailOpen                  *bool               `toml:"ocspfailopen"`
+	Token                         *string             `toml:"token"`
+	KeepSessionAlive              *bool               `toml:"keepsessionalive"`
+	PrivateKey                    *string             `toml:"privatekey,multiline"`
+	PrivateKeyPassphrase          *string             `toml:"privatekeypassphrase"`
+	DisableTelemetry              *bool               `toml:"disabletelemetry"`
 	// TODO [SNOW-1827312]: handle and test 3-value booleans properly from TOML
 	ValidateDefaultParameters      *bool   `toml:"validatedefaultparameters"`
 	ClientRequestMfaToken          *bool   `toml:"clientrequestmfatoken"`
@@ -277,6 +285,8 @@ func (c *ConfigDTO) DriverConfig() (gosnowflake.Config, error) {
 	if err != nil {
 		return gosnowflake.Config{}, err
 	}
+	pointerAttributeSet(c.WorkloadIdentityProvider, &driverCfg.WorkloadIdentityProvider)
+	pointerAttributeSet(c.WorkloadIdentityEntraResource, &driverCfg.WorkloadIdentityEntraResource)
 	pointerTimeInSecondsAttributeSet(c.ClientTimeout, &driverCfg.ClientTimeout)
 	pointerTimeInSecondsAttributeSet(c.JwtClientTimeout, &driverCfg.JWTClientTimeout)
 	pointerTimeInSecondsAttributeSet(c.LoginTimeout, &driverCfg.LoginTimeout)
@@ -415,6 +425,7 @@ const (
 	AuthenticationTypeJwt                 AuthenticationType = "SNOWFLAKE_JWT"
 	AuthenticationTypeTokenAccessor       AuthenticationType = "TOKENACCESSOR"
 	AuthenticationTypeUsernamePasswordMfa AuthenticationType = "USERNAMEPASSWORDMFA"
+	AuthenticationTypeWorkloadIdentity    AuthenticationType = "WORKLOAD_IDENTITY"
 
 	AuthenticationTypeEmpty AuthenticationType = ""
 )
@@ -427,6 +438,7 @@ var AllAuthenticationTypes = []AuthenticationType{
 	AuthenticationTypeJwt,
 	AuthenticationTypeTokenAccessor,
 	AuthenticationTypeUsernamePasswordMfa,
+	AuthenticationTypeWorkloadIdentity,
 }
 
 func ToAuthenticatorType(s string) (gosnowflake.AuthType, error) {
@@ -445,6 +457,8 @@ func ToAuthenticatorType(s string) (gosnowflake.AuthType, error) {
 		return gosnowflake.AuthTypeTokenAccessor, nil
 	case string(AuthenticationTypeUsernamePasswordMfa):
 		return gosnowflake.AuthTypeUsernamePasswordMFA, nil
+	case string(AuthenticationTypeWorkloadIdentity):
+		return gosnowflake.AuthTypeWorkloadIdentityFederation, nil
 	default:
 		return gosnowflake.AuthType(0), fmt.Errorf("invalid authenticator type: %s", s)
 	}
@@ -461,6 +475,35 @@ func ToExtendedAuthenticatorType(s string) (gosnowflake.AuthType, error) {
 	}
 }
 
+type WorkloadIdentityProvider string
+
+const (
+	WorkloadIdentityProviderAws   WorkloadIdentityProvider = "AWS"
+	WorkloadIdentityProviderAzure WorkloadIdentityProvider = "AZURE"
+	WorkloadIdentityProviderGcp   WorkloadIdentityProvider = "GCP"
+	WorkloadIdentityProviderOidc  WorkloadIdentityProvider = "OIDC"
+)
+
+var AllWorkloadIdentityProviders = []WorkloadIdentityProvider{
+	WorkloadIdentityProviderAws,
+	WorkloadIdentityProviderAzure,
+	WorkloadIdentityProviderGcp,
+	WorkloadIdentityProviderOidc,
+}
+
+func ToWorkloadIdentityProvider(s string) (WorkloadIdentityProvider, error) {
+	upperCase := strings.ToUpper(s)
+	switch upperCase {
+	case string(WorkloadIdentityProviderAws),
+		string(WorkloadIdentityProviderAzure),
+		string(WorkloadIdentityProviderGcp),
+		string(WorkloadIdentityProviderOidc):
+		return WorkloadIdentityProvider(upperCase), nil
+	default:
+		return "", fmt.Errorf("invalid workload identity provider: %s", s)
+	}
+}
+
 type DriverLogLevel string
 
 const (
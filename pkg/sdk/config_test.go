@@ -87,6 +87,8 @@ func TestLoadConfigFileWithInvalidFieldTypeFails(t *testing.T) {
 		{name: "Passcode", fieldName: "passcode", wantType: "*string"},
 		{name: "PasscodeInPassword", fieldName: "passcodeinpassword", wantType: "*bool"},
 		{name: "OktaUrl", fieldName: "oktaurl", wantType: "*string"},
+		{name: "WorkloadIdentityProvider", fieldName: "workloadidentityprovider", wantType: "*string"},
+		{name: "WorkloadIdentityEntraResource", fieldName: "workloadidentityentraresource", wantType: "*string"},
 		{name: "Authenticator", fieldName: "authenticator", wantType: "*string"},
 		{name: "InsecureMode", fieldName: "insecuremode", wantType: "*bool"},
 		{name: "OcspFailOpen", fieldName: "ocspfailopen", wantType: "*bool"},
@@ -476,6 +478,7 @@ func Test_ToAuthenticationType(t *testing.T) {
 		{input: "SNOWFLAKE_JWT", want: gosnowflake.AuthTypeJwt},
 		{input: "TOKENACCESSOR", want: gosnowflake.AuthTypeTokenAccessor},
 		{input: "USERNAMEPASSWORDMFA", want: gosnowflake.AuthTypeUsernamePasswordMFA},
+		{input: "WORKLOAD_IDENTITY", want: gosnowflake.AuthTypeWorkloadIdentityFederation},
 	}
 
 	invalid := []test{
@@ -583,3 +586,41 @@ func Test_Provider_toDriverLogLevel(t *testing.T) {
 		})
 	}
 }
+
+func Test_Provider_toWorkloadIdentityProvider(t *testing.T) {
+	type test struct {
+		input string
+		want  WorkloadIdentityProvider
+	}
+
+	valid := []test{
+		// Case insensitive.
+		{input: "aws", want: WorkloadIdentityProviderAws},
+
+		// Supported Values.
+		{input: "AWS", want: WorkloadIdentityProviderAws},
+		{input: "AZURE", want: WorkloadIdentityProviderAzure},
+		{input: "GCP", want: WorkloadIdentityProviderGcp},
+		{input: "OIDC", want: WorkloadIdentityProviderOidc},
+	}
+
+	invalid := []test{
+		{input: ""},
+		{input: "foo"},
+	}
+
+	for _, tc := range valid {
+		t.Run(tc.input, func(t *testing.T) {
+			got, err := ToWorkloadIdentityProvider(tc.input)
+			require.NoError(t, err)
+			require.Equal(t, tc.want, got)
+		})
+	}
+
+	for _, tc := range invalid {
+		t.Run(tc.input, func(t *testing.T) {
+			_, err := ToWorkloadIdentityProvider(tc.input)
+			require.Error(t, err)
+		})
+	}
+}
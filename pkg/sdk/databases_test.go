@@ -64,6 +64,18 @@ func TestDatabasesCreate(t *testing.T) {
 		assertOptsValidAndSQLEquals(t, opts, `CREATE OR REPLACE DATABASE %s CLONE "db1" AT (TIMESTAMP => '2021-01-01 00:00:00 +0000 UTC')`, opts.name.FullyQualifiedName())
 	})
 
+	t.Run("clone ignoring tables with insufficient data retention", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Clone = &Clone{
+			SourceObject: NewAccountObjectIdentifier("db1"),
+			At: &TimeTravel{
+				Statement: String("8e5d0ca9-005e-44e6-b858-a8f5b37c5726"),
+			},
+			IgnoreTablesWithInsufficientDataRetention: Bool(true),
+		}
+		assertOptsValidAndSQLEquals(t, opts, `CREATE DATABASE %s CLONE "db1" AT (STATEMENT => '8e5d0ca9-005e-44e6-b858-a8f5b37c5726') IGNORE TABLES WITH INSUFFICIENT DATA RETENTION`, opts.name.FullyQualifiedName())
+	})
+
 	t.Run("complete", func(t *testing.T) {
 		externalVolumeId := randomAccountObjectIdentifier()
 		catalogId := randomAccountObjectIdentifier()
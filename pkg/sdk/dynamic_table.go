@@ -16,20 +16,35 @@ type DynamicTables interface {
 	Drop(ctx context.Context, request *DropDynamicTableRequest) error
 	Show(ctx context.Context, request *ShowDynamicTableRequest) ([]DynamicTable, error)
 	ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*DynamicTable, error)
+	ShowParameters(ctx context.Context, id SchemaObjectIdentifier) ([]*Parameter, error)
 }
 
 // createDynamicTableOptions is based on https://docs.snowflake.com/en/sql-reference/sql/create-dynamic-table
 type createDynamicTableOptions struct {
-	create       bool                     `ddl:"static" sql:"CREATE"`
-	OrReplace    *bool                    `ddl:"keyword" sql:"OR REPLACE"`
-	dynamicTable bool                     `ddl:"static" sql:"DYNAMIC TABLE"`
-	name         SchemaObjectIdentifier   `ddl:"identifier"`
-	targetLag    TargetLag                `ddl:"parameter,no_quotes" sql:"TARGET_LAG"`
-	Initialize   *DynamicTableInitialize  `ddl:"parameter,no_quotes" sql:"INITIALIZE"`
-	RefreshMode  *DynamicTableRefreshMode `ddl:"parameter,no_quotes" sql:"REFRESH_MODE"`
-	warehouse    AccountObjectIdentifier  `ddl:"identifier,equals" sql:"WAREHOUSE"`
-	Comment      *string                  `ddl:"parameter,single_quotes" sql:"COMMENT"`
-	query        string                   `ddl:"parameter,no_equals,no_quotes" sql:"AS"`
+	create    bool  `ddl:"static" sql:"CREATE"`
+	OrReplace *bool `ddl:"keyword" sql:"OR REPLACE"`
+	// OrAlter is based on https://docs.snowflake.com/en/sql-reference/sql/create-dynamic-table#create-or-alter-dynamic-table
+	OrAlter                    *bool                        `ddl:"keyword" sql:"OR ALTER"`
+	Transient                  *bool                        `ddl:"keyword" sql:"TRANSIENT"`
+	dynamicTable               bool                         `ddl:"static" sql:"DYNAMIC TABLE"`
+	name                       SchemaObjectIdentifier       `ddl:"identifier"`
+	targetLag                  TargetLag                    `ddl:"parameter,no_quotes" sql:"TARGET_LAG"`
+	Initialize                 *DynamicTableInitialize      `ddl:"parameter,no_quotes" sql:"INITIALIZE"`
+	RefreshMode                *DynamicTableRefreshMode     `ddl:"parameter,no_quotes" sql:"REFRESH_MODE"`
+	warehouse                  AccountObjectIdentifier      `ddl:"identifier,equals" sql:"WAREHOUSE"`
+	DataRetentionTimeInDays    *int                         `ddl:"parameter" sql:"DATA_RETENTION_TIME_IN_DAYS"`
+	MaxDataExtensionTimeInDays *int                         `ddl:"parameter" sql:"MAX_DATA_EXTENSION_TIME_IN_DAYS"`
+	Comment                    *string                      `ddl:"parameter,single_quotes" sql:"COMMENT"`
+	RowAccessPolicy            *DynamicTableRowAccessPolicy `ddl:"keyword"`
+	Tag                        []TagAssociation             `ddl:"keyword,parentheses" sql:"TAG"`
+	ImmutableWhere             []string                     `ddl:"parameter,no_equals,parentheses" sql:"IMMUTABLE WHERE"`
+	BackfillFrom               *SchemaObjectIdentifier      `ddl:"identifier" sql:"BACKFILL FROM"`
+	query                      string                       `ddl:"parameter,no_equals,no_quotes" sql:"AS"`
+}
+
+type DynamicTableRowAccessPolicy struct {
+	RowAccessPolicy SchemaObjectIdentifier `ddl:"identifier" sql:"ROW ACCESS POLICY"`
+	On              []Column               `ddl:"parameter,parentheses,no_equals" sql:"ON"`
 }
 
 type TargetLag struct {
@@ -38,8 +53,33 @@ type TargetLag struct {
 }
 
 type DynamicTableSet struct {
-	TargetLag *TargetLag               `ddl:"parameter,no_quotes" sql:"TARGET_LAG"`
-	Warehouse *AccountObjectIdentifier `ddl:"identifier,equals" sql:"WAREHOUSE"`
+	TargetLag                  *TargetLag               `ddl:"parameter,no_quotes" sql:"TARGET_LAG"`
+	Warehouse                  *AccountObjectIdentifier `ddl:"identifier,equals" sql:"WAREHOUSE"`
+	DataRetentionTimeInDays    *int                     `ddl:"parameter" sql:"DATA_RETENTION_TIME_IN_DAYS"`
+	MaxDataExtensionTimeInDays *int                     `ddl:"parameter" sql:"MAX_DATA_EXTENSION_TIME_IN_DAYS"`
+	Comment                    *string                  `ddl:"parameter,single_quotes" sql:"COMMENT"`
+}
+
+type DynamicTableUnset struct {
+	DataRetentionTimeInDays    *bool `ddl:"keyword" sql:"DATA_RETENTION_TIME_IN_DAYS"`
+	MaxDataExtensionTimeInDays *bool `ddl:"keyword" sql:"MAX_DATA_EXTENSION_TIME_IN_DAYS"`
+	Comment                    *bool `ddl:"keyword" sql:"COMMENT"`
+}
+
+type DynamicTableAddRowAccessPolicy struct {
+	add             bool                   `ddl:"static" sql:"ADD"`
+	RowAccessPolicy SchemaObjectIdentifier `ddl:"identifier" sql:"ROW ACCESS POLICY"`
+	On              []Column               `ddl:"parameter,parentheses,no_equals" sql:"ON"`
+}
+
+type DynamicTableDropRowAccessPolicy struct {
+	drop            bool                   `ddl:"static" sql:"DROP"`
+	RowAccessPolicy SchemaObjectIdentifier `ddl:"identifier" sql:"ROW ACCESS POLICY"`
+}
+
+type DynamicTableDropAndAddRowAccessPolicy struct {
+	Drop DynamicTableDropRowAccessPolicy `ddl:"keyword"`
+	Add  DynamicTableAddRowAccessPolicy  `ddl:"keyword"`
 }
 
 // alterDynamicTableOptions is based on https://docs.snowflake.com/en/sql-reference/sql/alter-dynamic-table
@@ -48,10 +88,20 @@ type alterDynamicTableOptions struct {
 	dynamicTable bool                   `ddl:"static" sql:"DYNAMIC TABLE"`
 	name         SchemaObjectIdentifier `ddl:"identifier"`
 
-	Suspend *bool            `ddl:"keyword" sql:"SUSPEND"`
-	Resume  *bool            `ddl:"keyword" sql:"RESUME"`
-	Refresh *bool            `ddl:"keyword" sql:"REFRESH"`
-	Set     *DynamicTableSet `ddl:"keyword" sql:"SET"`
+	Suspend                   *bool                                  `ddl:"keyword" sql:"SUSPEND"`
+	Resume                    *bool                                  `ddl:"keyword" sql:"RESUME"`
+	Refresh                   *bool                                  `ddl:"keyword" sql:"REFRESH"`
+	Set                       *DynamicTableSet                       `ddl:"keyword" sql:"SET"`
+	Unset                     *DynamicTableUnset                     `ddl:"list,no_parentheses" sql:"UNSET"`
+	RenameTo                  *SchemaObjectIdentifier                `ddl:"identifier" sql:"RENAME TO"`
+	SetImmutableWhere         []string                               `ddl:"parameter,no_equals,parentheses" sql:"SET IMMUTABLE WHERE"`
+	UnsetImmutable            *bool                                  `ddl:"keyword" sql:"UNSET IMMUTABLE"`
+	AddRowAccessPolicy        *DynamicTableAddRowAccessPolicy        `ddl:"keyword"`
+	DropRowAccessPolicy       *DynamicTableDropRowAccessPolicy       `ddl:"keyword"`
+	DropAndAddRowAccessPolicy *DynamicTableDropAndAddRowAccessPolicy `ddl:"list,no_parentheses"`
+	DropAllRowAccessPolicies  *bool                                  `ddl:"keyword" sql:"DROP ALL ROW ACCESS POLICIES"`
+	SetTags                   []TagAssociation                       `ddl:"keyword" sql:"SET TAG"`
+	UnsetTags                 []ObjectIdentifier                     `ddl:"keyword" sql:"UNSET TAG"`
 }
 
 // dropDynamicTableOptions is based on https://docs.snowflake.com/en/sql-reference/sql/drop-dynamic-table
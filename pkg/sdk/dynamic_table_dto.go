@@ -11,30 +11,67 @@ var (
 
 type CreateDynamicTableRequest struct {
 	orReplace bool
+	orAlter   bool
+	transient bool
 
 	name      SchemaObjectIdentifier  // required
 	warehouse AccountObjectIdentifier // required
 	targetLag TargetLag               // required
 	query     string                  // required
 
-	comment     *string
-	refreshMode *DynamicTableRefreshMode
-	initialize  *DynamicTableInitialize
+	comment                    *string
+	refreshMode                *DynamicTableRefreshMode
+	initialize                 *DynamicTableInitialize
+	dataRetentionTimeInDays    *int
+	maxDataExtensionTimeInDays *int
+	rowAccessPolicy            *DynamicTableRowAccessPolicyRequest
+	tag                        []TagAssociation
+	immutableWhere             *string
+	backfillFrom               *SchemaObjectIdentifier
+}
+
+type DynamicTableRowAccessPolicyRequest struct {
+	rowAccessPolicy SchemaObjectIdentifier // required
+	on              []Column               // required
 }
 
 type AlterDynamicTableRequest struct {
 	name SchemaObjectIdentifier // required
 
 	// One of
-	suspend *bool
-	resume  *bool
-	refresh *bool
-	set     *DynamicTableSetRequest
+	suspend                   *bool
+	resume                    *bool
+	refresh                   *bool
+	set                       *DynamicTableSetRequest
+	unset                     *DynamicTableUnsetRequest
+	renameTo                  *SchemaObjectIdentifier
+	setImmutableWhere         *string
+	unsetImmutable            *bool
+	addRowAccessPolicy        *DynamicTableRowAccessPolicyRequest
+	dropRowAccessPolicy       *SchemaObjectIdentifier
+	dropAndAddRowAccessPolicy *DynamicTableDropAndAddRowAccessPolicyRequest
+	dropAllRowAccessPolicies  *bool
+	setTags                   []TagAssociation
+	unsetTags                 []ObjectIdentifier
 }
 
 type DynamicTableSetRequest struct {
-	targetLag *TargetLag
-	warehouse *AccountObjectIdentifier
+	targetLag                  *TargetLag
+	warehouse                  *AccountObjectIdentifier
+	dataRetentionTimeInDays    *int
+	maxDataExtensionTimeInDays *int
+	comment                    *string
+}
+
+type DynamicTableUnsetRequest struct {
+	dataRetentionTimeInDays    *bool
+	maxDataExtensionTimeInDays *bool
+	comment                    *bool
+}
+
+type DynamicTableDropAndAddRowAccessPolicyRequest struct {
+	drop SchemaObjectIdentifier             // required
+	add  DynamicTableRowAccessPolicyRequest // required
 }
 
 type DropDynamicTableRequest struct {
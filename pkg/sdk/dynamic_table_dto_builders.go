@@ -19,6 +19,16 @@ func (s *CreateDynamicTableRequest) WithOrReplace(orReplace bool) *CreateDynamic
 	return s
 }
 
+func (s *CreateDynamicTableRequest) WithOrAlter(orAlter bool) *CreateDynamicTableRequest {
+	s.orAlter = orAlter
+	return s
+}
+
+func (s *CreateDynamicTableRequest) WithTransient(transient bool) *CreateDynamicTableRequest {
+	s.transient = transient
+	return s
+}
+
 func (s *CreateDynamicTableRequest) WithComment(comment *string) *CreateDynamicTableRequest {
 	s.comment = comment
 	return s
@@ -34,6 +44,46 @@ func (s *CreateDynamicTableRequest) WithInitialize(initialize DynamicTableInitia
 	return s
 }
 
+func (s *CreateDynamicTableRequest) WithDataRetentionTimeInDays(dataRetentionTimeInDays int) *CreateDynamicTableRequest {
+	s.dataRetentionTimeInDays = &dataRetentionTimeInDays
+	return s
+}
+
+func (s *CreateDynamicTableRequest) WithMaxDataExtensionTimeInDays(maxDataExtensionTimeInDays int) *CreateDynamicTableRequest {
+	s.maxDataExtensionTimeInDays = &maxDataExtensionTimeInDays
+	return s
+}
+
+func (s *CreateDynamicTableRequest) WithRowAccessPolicy(rowAccessPolicy DynamicTableRowAccessPolicyRequest) *CreateDynamicTableRequest {
+	s.rowAccessPolicy = &rowAccessPolicy
+	return s
+}
+
+func (s *CreateDynamicTableRequest) WithTag(tag []TagAssociation) *CreateDynamicTableRequest {
+	s.tag = tag
+	return s
+}
+
+func (s *CreateDynamicTableRequest) WithImmutableWhere(immutableWhere string) *CreateDynamicTableRequest {
+	s.immutableWhere = &immutableWhere
+	return s
+}
+
+func (s *CreateDynamicTableRequest) WithBackfillFrom(backfillFrom SchemaObjectIdentifier) *CreateDynamicTableRequest {
+	s.backfillFrom = &backfillFrom
+	return s
+}
+
+func NewDynamicTableRowAccessPolicyRequest(
+	rowAccessPolicy SchemaObjectIdentifier,
+	on []Column,
+) *DynamicTableRowAccessPolicyRequest {
+	s := DynamicTableRowAccessPolicyRequest{}
+	s.rowAccessPolicy = rowAccessPolicy
+	s.on = on
+	return &s
+}
+
 func NewAlterDynamicTableRequest(
 	name SchemaObjectIdentifier,
 ) *AlterDynamicTableRequest {
@@ -62,6 +112,56 @@ func (s *AlterDynamicTableRequest) WithSet(set *DynamicTableSetRequest) *AlterDy
 	return s
 }
 
+func (s *AlterDynamicTableRequest) WithUnset(unset *DynamicTableUnsetRequest) *AlterDynamicTableRequest {
+	s.unset = unset
+	return s
+}
+
+func (s *AlterDynamicTableRequest) WithRenameTo(renameTo SchemaObjectIdentifier) *AlterDynamicTableRequest {
+	s.renameTo = &renameTo
+	return s
+}
+
+func (s *AlterDynamicTableRequest) WithSetImmutableWhere(setImmutableWhere string) *AlterDynamicTableRequest {
+	s.setImmutableWhere = &setImmutableWhere
+	return s
+}
+
+func (s *AlterDynamicTableRequest) WithUnsetImmutable(unsetImmutable bool) *AlterDynamicTableRequest {
+	s.unsetImmutable = &unsetImmutable
+	return s
+}
+
+func (s *AlterDynamicTableRequest) WithAddRowAccessPolicy(addRowAccessPolicy DynamicTableRowAccessPolicyRequest) *AlterDynamicTableRequest {
+	s.addRowAccessPolicy = &addRowAccessPolicy
+	return s
+}
+
+func (s *AlterDynamicTableRequest) WithDropRowAccessPolicy(dropRowAccessPolicy SchemaObjectIdentifier) *AlterDynamicTableRequest {
+	s.dropRowAccessPolicy = &dropRowAccessPolicy
+	return s
+}
+
+func (s *AlterDynamicTableRequest) WithDropAndAddRowAccessPolicy(dropAndAddRowAccessPolicy DynamicTableDropAndAddRowAccessPolicyRequest) *AlterDynamicTableRequest {
+	s.dropAndAddRowAccessPolicy = &dropAndAddRowAccessPolicy
+	return s
+}
+
+func (s *AlterDynamicTableRequest) WithDropAllRowAccessPolicies(dropAllRowAccessPolicies bool) *AlterDynamicTableRequest {
+	s.dropAllRowAccessPolicies = &dropAllRowAccessPolicies
+	return s
+}
+
+func (s *AlterDynamicTableRequest) WithSetTags(setTags []TagAssociation) *AlterDynamicTableRequest {
+	s.setTags = setTags
+	return s
+}
+
+func (s *AlterDynamicTableRequest) WithUnsetTags(unsetTags []ObjectIdentifier) *AlterDynamicTableRequest {
+	s.unsetTags = unsetTags
+	return s
+}
+
 func NewDynamicTableSetRequest() *DynamicTableSetRequest {
 	return &DynamicTableSetRequest{}
 }
@@ -76,6 +176,50 @@ func (s *DynamicTableSetRequest) WithWarehouse(warehouse AccountObjectIdentifier
 	return s
 }
 
+func (s *DynamicTableSetRequest) WithDataRetentionTimeInDays(dataRetentionTimeInDays int) *DynamicTableSetRequest {
+	s.dataRetentionTimeInDays = &dataRetentionTimeInDays
+	return s
+}
+
+func (s *DynamicTableSetRequest) WithMaxDataExtensionTimeInDays(maxDataExtensionTimeInDays int) *DynamicTableSetRequest {
+	s.maxDataExtensionTimeInDays = &maxDataExtensionTimeInDays
+	return s
+}
+
+func (s *DynamicTableSetRequest) WithComment(comment string) *DynamicTableSetRequest {
+	s.comment = &comment
+	return s
+}
+
+func NewDynamicTableUnsetRequest() *DynamicTableUnsetRequest {
+	return &DynamicTableUnsetRequest{}
+}
+
+func (s *DynamicTableUnsetRequest) WithDataRetentionTimeInDays(dataRetentionTimeInDays bool) *DynamicTableUnsetRequest {
+	s.dataRetentionTimeInDays = &dataRetentionTimeInDays
+	return s
+}
+
+func (s *DynamicTableUnsetRequest) WithMaxDataExtensionTimeInDays(maxDataExtensionTimeInDays bool) *DynamicTableUnsetRequest {
+	s.maxDataExtensionTimeInDays = &maxDataExtensionTimeInDays
+	return s
+}
+
+func (s *DynamicTableUnsetRequest) WithComment(comment bool) *DynamicTableUnsetRequest {
+	s.comment = &comment
+	return s
+}
+
+func NewDynamicTableDropAndAddRowAccessPolicyRequest(
+	drop SchemaObjectIdentifier,
+	add DynamicTableRowAccessPolicyRequest,
+) *DynamicTableDropAndAddRowAccessPolicyRequest {
+	s := DynamicTableDropAndAddRowAccessPolicyRequest{}
+	s.drop = drop
+	s.add = add
+	return &s
+}
+
 func NewDropDynamicTableRequest(
 	name SchemaObjectIdentifier,
 ) *DropDynamicTableRequest {
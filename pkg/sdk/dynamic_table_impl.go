@@ -55,17 +55,41 @@ func (v *dynamicTables) ShowByID(ctx context.Context, id SchemaObjectIdentifier)
 	return collections.FindFirst(dynamicTables, func(r DynamicTable) bool { return r.Name == id.Name() })
 }
 
+func (v *dynamicTables) ShowParameters(ctx context.Context, id SchemaObjectIdentifier) ([]*Parameter, error) {
+	return v.client.Parameters.ShowParameters(ctx, &ShowParametersOptions{
+		In: &ParametersIn{
+			Table: id,
+		},
+	})
+}
+
 func (s *CreateDynamicTableRequest) toOpts() *createDynamicTableOptions {
-	return &createDynamicTableOptions{
-		OrReplace:   Bool(s.orReplace),
-		name:        s.name,
-		warehouse:   s.warehouse,
-		targetLag:   s.targetLag,
-		query:       s.query,
-		Comment:     s.comment,
-		RefreshMode: s.refreshMode,
-		Initialize:  s.initialize,
+	opts := &createDynamicTableOptions{
+		OrReplace:                  Bool(s.orReplace),
+		OrAlter:                    Bool(s.orAlter),
+		Transient:                  Bool(s.transient),
+		name:                       s.name,
+		warehouse:                  s.warehouse,
+		targetLag:                  s.targetLag,
+		query:                      s.query,
+		Comment:                    s.comment,
+		RefreshMode:                s.refreshMode,
+		Initialize:                 s.initialize,
+		DataRetentionTimeInDays:    s.dataRetentionTimeInDays,
+		MaxDataExtensionTimeInDays: s.maxDataExtensionTimeInDays,
+		Tag:                        s.tag,
+		BackfillFrom:               s.backfillFrom,
+	}
+	if s.immutableWhere != nil {
+		opts.ImmutableWhere = []string{*s.immutableWhere}
 	}
+	if s.rowAccessPolicy != nil {
+		opts.RowAccessPolicy = &DynamicTableRowAccessPolicy{
+			RowAccessPolicy: s.rowAccessPolicy.rowAccessPolicy,
+			On:              s.rowAccessPolicy.on,
+		}
+	}
+	return opts
 }
 
 func (s *AlterDynamicTableRequest) toOpts() *alterDynamicTableOptions {
@@ -82,8 +106,51 @@ func (s *AlterDynamicTableRequest) toOpts() *alterDynamicTableOptions {
 		opts.Refresh = s.refresh
 	}
 	if s.set != nil {
-		opts.Set = &DynamicTableSet{s.set.targetLag, s.set.warehouse}
+		opts.Set = &DynamicTableSet{
+			TargetLag:                  s.set.targetLag,
+			Warehouse:                  s.set.warehouse,
+			DataRetentionTimeInDays:    s.set.dataRetentionTimeInDays,
+			MaxDataExtensionTimeInDays: s.set.maxDataExtensionTimeInDays,
+			Comment:                    s.set.comment,
+		}
+	}
+	if s.unset != nil {
+		opts.Unset = &DynamicTableUnset{
+			DataRetentionTimeInDays:    s.unset.dataRetentionTimeInDays,
+			MaxDataExtensionTimeInDays: s.unset.maxDataExtensionTimeInDays,
+			Comment:                    s.unset.comment,
+		}
+	}
+	opts.RenameTo = s.renameTo
+	if s.setImmutableWhere != nil {
+		opts.SetImmutableWhere = []string{*s.setImmutableWhere}
+	}
+	opts.UnsetImmutable = s.unsetImmutable
+	if s.addRowAccessPolicy != nil {
+		opts.AddRowAccessPolicy = &DynamicTableAddRowAccessPolicy{
+			RowAccessPolicy: s.addRowAccessPolicy.rowAccessPolicy,
+			On:              s.addRowAccessPolicy.on,
+		}
+	}
+	if s.dropRowAccessPolicy != nil {
+		opts.DropRowAccessPolicy = &DynamicTableDropRowAccessPolicy{
+			RowAccessPolicy: *s.dropRowAccessPolicy,
+		}
+	}
+	if s.dropAndAddRowAccessPolicy != nil {
+		opts.DropAndAddRowAccessPolicy = &DynamicTableDropAndAddRowAccessPolicy{
+			Drop: DynamicTableDropRowAccessPolicy{
+				RowAccessPolicy: s.dropAndAddRowAccessPolicy.drop,
+			},
+			Add: DynamicTableAddRowAccessPolicy{
+				RowAccessPolicy: s.dropAndAddRowAccessPolicy.add.rowAccessPolicy,
+				On:              s.dropAndAddRowAccessPolicy.add.on,
+			},
+		}
 	}
+	opts.DropAllRowAccessPolicies = s.dropAllRowAccessPolicies
+	opts.SetTags = s.setTags
+	opts.UnsetTags = s.unsetTags
 	return &opts
 }
 
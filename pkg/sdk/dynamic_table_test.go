@@ -43,6 +43,48 @@ func TestDynamicTableCreate(t *testing.T) {
 		opts.Initialize = DynamicTableInitializeOnSchedule.ToPointer()
 		assertOptsValidAndSQLEquals(t, opts, `CREATE OR REPLACE DYNAMIC TABLE %s TARGET_LAG = '1 minutes' INITIALIZE = ON_SCHEDULE REFRESH_MODE = FULL WAREHOUSE = "warehouse_name" COMMENT = 'comment' AS SELECT product_id, product_name FROM staging_table`, id.FullyQualifiedName())
 	})
+
+	t.Run("validation: or replace and or alter", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.OrReplace = Bool(true)
+		opts.OrAlter = Bool(true)
+		assertOptsInvalidJoinedErrors(t, opts, errOneOf("createDynamicTableOptions", "OrReplace", "OrAlter"))
+	})
+
+	t.Run("validation: invalid row access policy and backfill identifiers", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.RowAccessPolicy = &DynamicTableRowAccessPolicy{
+			RowAccessPolicy: emptySchemaObjectIdentifier,
+			On:              []Column{{"a"}},
+		}
+		opts.BackfillFrom = &emptySchemaObjectIdentifier
+		assertOptsInvalidJoinedErrors(t, opts, errInvalidIdentifier("createDynamicTableOptions", "RowAccessPolicy"))
+		assertOptsInvalidJoinedErrors(t, opts, errInvalidIdentifier("createDynamicTableOptions", "BackfillFrom"))
+	})
+
+	t.Run("or alter", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.OrAlter = Bool(true)
+		assertOptsValidAndSQLEquals(t, opts, `CREATE OR ALTER DYNAMIC TABLE %s TARGET_LAG = '1 minutes' WAREHOUSE = "warehouse_name" AS SELECT product_id, product_name FROM staging_table`, id.FullyQualifiedName())
+	})
+
+	t.Run("with parameters, policy, tags, immutability and backfill", func(t *testing.T) {
+		policyId := randomSchemaObjectIdentifier()
+		tagId := randomSchemaObjectIdentifier()
+		backfillId := randomSchemaObjectIdentifier()
+		opts := defaultOpts()
+		opts.Transient = Bool(true)
+		opts.DataRetentionTimeInDays = Int(1)
+		opts.MaxDataExtensionTimeInDays = Int(2)
+		opts.RowAccessPolicy = &DynamicTableRowAccessPolicy{
+			RowAccessPolicy: policyId,
+			On:              []Column{{"a"}, {"b"}},
+		}
+		opts.Tag = []TagAssociation{{Name: tagId, Value: "v1"}}
+		opts.ImmutableWhere = []string{"ts < '2025-01-01'"}
+		opts.BackfillFrom = &backfillId
+		assertOptsValidAndSQLEquals(t, opts, `CREATE TRANSIENT DYNAMIC TABLE %s TARGET_LAG = '1 minutes' WAREHOUSE = "warehouse_name" DATA_RETENTION_TIME_IN_DAYS = 1 MAX_DATA_EXTENSION_TIME_IN_DAYS = 2 ROW ACCESS POLICY %s ON ("a", "b") TAG (%s = 'v1') IMMUTABLE WHERE (ts < '2025-01-01') BACKFILL FROM %s AS SELECT product_id, product_name FROM staging_table`, id.FullyQualifiedName(), policyId.FullyQualifiedName(), tagId.FullyQualifiedName(), backfillId.FullyQualifiedName())
+	})
 }
 
 func TestDynamicTableAlter(t *testing.T) {
@@ -66,19 +108,19 @@ func TestDynamicTableAlter(t *testing.T) {
 
 	t.Run("validation: no alter action", func(t *testing.T) {
 		opts := defaultOpts()
-		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("alterDynamicTableOptions", "Suspend", "Resume", "Refresh", "Set"))
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("alterDynamicTableOptions", "Suspend", "Resume", "Refresh", "Set", "Unset", "RenameTo", "SetImmutableWhere", "UnsetImmutable", "AddRowAccessPolicy", "DropRowAccessPolicy", "DropAndAddRowAccessPolicy", "DropAllRowAccessPolicies", "SetTags", "UnsetTags"))
 	})
 
 	t.Run("validation: multiple alter actions", func(t *testing.T) {
 		opts := defaultOpts()
 		opts.Resume = Bool(true)
 		opts.Suspend = Bool(true)
-		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("alterDynamicTableOptions", "Suspend", "Resume", "Refresh", "Set"))
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("alterDynamicTableOptions", "Suspend", "Resume", "Refresh", "Set", "Unset", "RenameTo", "SetImmutableWhere", "UnsetImmutable", "AddRowAccessPolicy", "DropRowAccessPolicy", "DropAndAddRowAccessPolicy", "DropAllRowAccessPolicies", "SetTags", "UnsetTags"))
 	})
 
 	t.Run("validation: no property to unset", func(t *testing.T) {
 		opts := defaultOpts()
-		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("alterDynamicTableOptions", "Suspend", "Resume", "Refresh", "Set"))
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("alterDynamicTableOptions", "Suspend", "Resume", "Refresh", "Set", "Unset", "RenameTo", "SetImmutableWhere", "UnsetImmutable", "AddRowAccessPolicy", "DropRowAccessPolicy", "DropAndAddRowAccessPolicy", "DropAllRowAccessPolicies", "SetTags", "UnsetTags"))
 	})
 
 	t.Run("suspend", func(t *testing.T) {
@@ -105,6 +147,110 @@ func TestDynamicTableAlter(t *testing.T) {
 		}
 		assertOptsValidAndSQLEquals(t, opts, `ALTER DYNAMIC TABLE %s SET TARGET_LAG = '1 minutes' WAREHOUSE = "warehouse_name"`, id.FullyQualifiedName())
 	})
+
+	t.Run("validation: empty set", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Set = &DynamicTableSet{}
+		assertOptsInvalidJoinedErrors(t, opts, errAtLeastOneOf("DynamicTableSet", "TargetLag", "Warehouse", "DataRetentionTimeInDays", "MaxDataExtensionTimeInDays", "Comment"))
+	})
+
+	t.Run("validation: empty unset", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Unset = &DynamicTableUnset{}
+		assertOptsInvalidJoinedErrors(t, opts, errAtLeastOneOf("DynamicTableUnset", "DataRetentionTimeInDays", "MaxDataExtensionTimeInDays", "Comment"))
+	})
+
+	t.Run("validation: invalid new name", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.RenameTo = &emptySchemaObjectIdentifier
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("set parameters and comment", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Set = &DynamicTableSet{
+			DataRetentionTimeInDays:    Int(1),
+			MaxDataExtensionTimeInDays: Int(2),
+			Comment:                    String("comment"),
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER DYNAMIC TABLE %s SET DATA_RETENTION_TIME_IN_DAYS = 1 MAX_DATA_EXTENSION_TIME_IN_DAYS = 2 COMMENT = 'comment'`, id.FullyQualifiedName())
+	})
+
+	t.Run("unset", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Unset = &DynamicTableUnset{
+			DataRetentionTimeInDays:    Bool(true),
+			MaxDataExtensionTimeInDays: Bool(true),
+			Comment:                    Bool(true),
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER DYNAMIC TABLE %s UNSET DATA_RETENTION_TIME_IN_DAYS, MAX_DATA_EXTENSION_TIME_IN_DAYS, COMMENT`, id.FullyQualifiedName())
+	})
+
+	t.Run("rename", func(t *testing.T) {
+		newId := randomSchemaObjectIdentifier()
+		opts := defaultOpts()
+		opts.RenameTo = &newId
+		assertOptsValidAndSQLEquals(t, opts, `ALTER DYNAMIC TABLE %s RENAME TO %s`, id.FullyQualifiedName(), newId.FullyQualifiedName())
+	})
+
+	t.Run("set immutable where", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.SetImmutableWhere = []string{"ts < '2025-01-01'"}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER DYNAMIC TABLE %s SET IMMUTABLE WHERE (ts < '2025-01-01')`, id.FullyQualifiedName())
+	})
+
+	t.Run("unset immutable", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.UnsetImmutable = Bool(true)
+		assertOptsValidAndSQLEquals(t, opts, `ALTER DYNAMIC TABLE %s UNSET IMMUTABLE`, id.FullyQualifiedName())
+	})
+
+	t.Run("add row access policy", func(t *testing.T) {
+		policyId := randomSchemaObjectIdentifier()
+		opts := defaultOpts()
+		opts.AddRowAccessPolicy = &DynamicTableAddRowAccessPolicy{
+			RowAccessPolicy: policyId,
+			On:              []Column{{"a"}},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER DYNAMIC TABLE %s ADD ROW ACCESS POLICY %s ON ("a")`, id.FullyQualifiedName(), policyId.FullyQualifiedName())
+	})
+
+	t.Run("drop row access policy", func(t *testing.T) {
+		policyId := randomSchemaObjectIdentifier()
+		opts := defaultOpts()
+		opts.DropRowAccessPolicy = &DynamicTableDropRowAccessPolicy{
+			RowAccessPolicy: policyId,
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER DYNAMIC TABLE %s DROP ROW ACCESS POLICY %s`, id.FullyQualifiedName(), policyId.FullyQualifiedName())
+	})
+
+	t.Run("drop and add row access policy", func(t *testing.T) {
+		oldPolicyId := randomSchemaObjectIdentifier()
+		newPolicyId := randomSchemaObjectIdentifier()
+		opts := defaultOpts()
+		opts.DropAndAddRowAccessPolicy = &DynamicTableDropAndAddRowAccessPolicy{
+			Drop: DynamicTableDropRowAccessPolicy{RowAccessPolicy: oldPolicyId},
+			Add:  DynamicTableAddRowAccessPolicy{RowAccessPolicy: newPolicyId, On: []Column{{"a"}}},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER DYNAMIC TABLE %s DROP ROW ACCESS POLICY %s, ADD ROW ACCESS POLICY %s ON ("a")`, id.FullyQualifiedName(), oldPolicyId.FullyQualifiedName(), newPolicyId.FullyQualifiedName())
+	})
+
+	t.Run("drop all row access policies", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.DropAllRowAccessPolicies = Bool(true)
+		assertOptsValidAndSQLEquals(t, opts, `ALTER DYNAMIC TABLE %s DROP ALL ROW ACCESS POLICIES`, id.FullyQualifiedName())
+	})
+
+	t.Run("set and unset tags", func(t *testing.T) {
+		tagId := randomSchemaObjectIdentifier()
+		opts := defaultOpts()
+		opts.SetTags = []TagAssociation{{Name: tagId, Value: "v1"}}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER DYNAMIC TABLE %s SET TAG %s = 'v1'`, id.FullyQualifiedName(), tagId.FullyQualifiedName())
+
+		opts = defaultOpts()
+		opts.UnsetTags = []ObjectIdentifier{tagId}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER DYNAMIC TABLE %s UNSET TAG %s`, id.FullyQualifiedName(), tagId.FullyQualifiedName())
+	})
 }
 
 func TestDynamicTableDrop(t *testing.T) {
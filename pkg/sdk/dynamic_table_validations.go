@@ -7,6 +7,7 @@ var (
 	_ validatable = new(showDynamicTableOptions)
 	_ validatable = new(describeDynamicTableOptions)
 	_ validatable = new(DynamicTableSet)
+	_ validatable = new(DynamicTableUnset)
 )
 
 func (tl *TargetLag) validate() error {
@@ -30,6 +31,15 @@ func (opts *createDynamicTableOptions) validate() error {
 	if !ValidObjectIdentifier(opts.warehouse) {
 		errs = append(errs, errInvalidIdentifier("createDynamicTableOptions", "warehouse"))
 	}
+	if everyValueSet(opts.OrReplace, opts.OrAlter) && *opts.OrReplace && *opts.OrAlter {
+		errs = append(errs, errOneOf("createDynamicTableOptions", "OrReplace", "OrAlter"))
+	}
+	if opts.RowAccessPolicy != nil && !ValidObjectIdentifier(opts.RowAccessPolicy.RowAccessPolicy) {
+		errs = append(errs, errInvalidIdentifier("createDynamicTableOptions", "RowAccessPolicy"))
+	}
+	if opts.BackfillFrom != nil && !ValidObjectIdentifier(opts.BackfillFrom) {
+		errs = append(errs, errInvalidIdentifier("createDynamicTableOptions", "BackfillFrom"))
+	}
 	return JoinErrors(errs...)
 }
 
@@ -41,9 +51,19 @@ func (dts *DynamicTableSet) validate() error {
 	if dts.Warehouse != nil && !ValidObjectIdentifier(*dts.Warehouse) {
 		errs = append(errs, errInvalidIdentifier("DynamicTableSet", "Warehouse"))
 	}
+	if !anyValueSet(dts.TargetLag, dts.Warehouse, dts.DataRetentionTimeInDays, dts.MaxDataExtensionTimeInDays, dts.Comment) {
+		errs = append(errs, errAtLeastOneOf("DynamicTableSet", "TargetLag", "Warehouse", "DataRetentionTimeInDays", "MaxDataExtensionTimeInDays", "Comment"))
+	}
 	return JoinErrors(errs...)
 }
 
+func (dtu *DynamicTableUnset) validate() error {
+	if !anyValueSet(dtu.DataRetentionTimeInDays, dtu.MaxDataExtensionTimeInDays, dtu.Comment) {
+		return errAtLeastOneOf("DynamicTableUnset", "DataRetentionTimeInDays", "MaxDataExtensionTimeInDays", "Comment")
+	}
+	return nil
+}
+
 func (opts *alterDynamicTableOptions) validate() error {
 	if opts == nil {
 		return ErrNilOptions
@@ -52,11 +72,17 @@ func (opts *alterDynamicTableOptions) validate() error {
 	if !ValidObjectIdentifier(opts.name) {
 		errs = append(errs, ErrInvalidObjectIdentifier)
 	}
-	if ok := exactlyOneValueSet(opts.Suspend, opts.Resume, opts.Refresh, opts.Set); !ok {
-		errs = append(errs, errExactlyOneOf("alterDynamicTableOptions", "Suspend", "Resume", "Refresh", "Set"))
+	if ok := exactlyOneValueSet(opts.Suspend, opts.Resume, opts.Refresh, opts.Set, opts.Unset, opts.RenameTo, opts.SetImmutableWhere, opts.UnsetImmutable, opts.AddRowAccessPolicy, opts.DropRowAccessPolicy, opts.DropAndAddRowAccessPolicy, opts.DropAllRowAccessPolicies, opts.SetTags, opts.UnsetTags); !ok {
+		errs = append(errs, errExactlyOneOf("alterDynamicTableOptions", "Suspend", "Resume", "Refresh", "Set", "Unset", "RenameTo", "SetImmutableWhere", "UnsetImmutable", "AddRowAccessPolicy", "DropRowAccessPolicy", "DropAndAddRowAccessPolicy", "DropAllRowAccessPolicies", "SetTags", "UnsetTags"))
+	}
+	if valueSet(opts.Set) {
+		errs = append(errs, opts.Set.validate())
 	}
-	if valueSet(opts.Set) && valueSet(opts.Set.TargetLag) {
-		errs = append(errs, opts.Set.TargetLag.validate())
+	if valueSet(opts.Unset) {
+		errs = append(errs, opts.Unset.validate())
+	}
+	if opts.RenameTo != nil && !ValidObjectIdentifier(opts.RenameTo) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
 	}
 	return JoinErrors(errs...)
 }
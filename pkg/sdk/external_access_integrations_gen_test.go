@@ -0,0 +1,265 @@
+package sdk
+
+import "testing"
+
+func TestExternalAccessIntegrations_Create(t *testing.T) {
+	id := randomAccountObjectIdentifier()
+	networkRuleId := randomSchemaObjectIdentifier()
+
+	// Minimal valid CreateExternalAccessIntegrationOptions
+	defaultOpts := func() *CreateExternalAccessIntegrationOptions {
+		return &CreateExternalAccessIntegrationOptions{
+			name:                id,
+			AllowedNetworkRules: []SchemaObjectIdentifier{networkRuleId},
+			Enabled:             true,
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *CreateExternalAccessIntegrationOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.name = emptyAccountObjectIdentifier
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("validation: conflicting fields for [opts.IfNotExists opts.OrReplace]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.IfNotExists = Bool(true)
+		opts.OrReplace = Bool(true)
+		assertOptsInvalidJoinedErrors(t, opts, errOneOf("CreateExternalAccessIntegrationOptions", "IfNotExists", "OrReplace"))
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "CREATE EXTERNAL ACCESS INTEGRATION %s ALLOWED_NETWORK_RULES = (%s) ENABLED = true", id.FullyQualifiedName(), networkRuleId.FullyQualifiedName())
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		networkRuleId2 := randomSchemaObjectIdentifier()
+		integrationId := randomAccountObjectIdentifier()
+		secretId := randomSchemaObjectIdentifier()
+
+		opts := defaultOpts()
+		opts.OrReplace = Bool(true)
+		opts.AllowedNetworkRules = []SchemaObjectIdentifier{networkRuleId, networkRuleId2}
+		opts.AllowedApiAuthenticationIntegrations = []AccountObjectIdentifier{integrationId}
+		opts.AllowedAuthenticationSecrets = []SchemaObjectIdentifier{secretId}
+		opts.Enabled = false
+		opts.Comment = String("some comment")
+		assertOptsValidAndSQLEquals(t, opts, "CREATE OR REPLACE EXTERNAL ACCESS INTEGRATION %s ALLOWED_NETWORK_RULES = (%s, %s) ALLOWED_API_AUTHENTICATION_INTEGRATIONS = (%s) ALLOWED_AUTHENTICATION_SECRETS = (%s) ENABLED = false COMMENT = 'some comment'",
+			id.FullyQualifiedName(), networkRuleId.FullyQualifiedName(), networkRuleId2.FullyQualifiedName(), integrationId.FullyQualifiedName(), secretId.FullyQualifiedName())
+	})
+}
+
+func TestExternalAccessIntegrations_Alter(t *testing.T) {
+	id := randomAccountObjectIdentifier()
+
+	// Minimal valid AlterExternalAccessIntegrationOptions
+	defaultOpts := func() *AlterExternalAccessIntegrationOptions {
+		return &AlterExternalAccessIntegrationOptions{
+			name: id,
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *AlterExternalAccessIntegrationOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.name = emptyAccountObjectIdentifier
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("validation: conflicting fields for [opts.IfExists opts.SetTags]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.IfExists = Bool(true)
+		opts.SetTags = []TagAssociation{
+			{
+				Name:  NewAccountObjectIdentifier("name"),
+				Value: "value",
+			},
+		}
+		assertOptsInvalidJoinedErrors(t, opts, errOneOf("AlterExternalAccessIntegrationOptions", "IfExists", "SetTags"))
+	})
+
+	t.Run("validation: conflicting fields for [opts.IfExists opts.UnsetTags]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.IfExists = Bool(true)
+		opts.UnsetTags = []ObjectIdentifier{
+			NewAccountObjectIdentifier("one"),
+		}
+		assertOptsInvalidJoinedErrors(t, opts, errOneOf("AlterExternalAccessIntegrationOptions", "IfExists", "UnsetTags"))
+	})
+
+	t.Run("validation: exactly one field from [opts.Set opts.Unset opts.SetTags opts.UnsetTags] should be present", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("AlterExternalAccessIntegrationOptions", "Set", "Unset", "SetTags", "UnsetTags"))
+	})
+
+	t.Run("validation: exactly one field from [opts.Set opts.Unset opts.SetTags opts.UnsetTags] should be present - more present", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Set = &ExternalAccessIntegrationSet{
+			Enabled: Bool(true),
+		}
+		opts.Unset = &ExternalAccessIntegrationUnset{
+			Comment: Bool(true),
+		}
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("AlterExternalAccessIntegrationOptions", "Set", "Unset", "SetTags", "UnsetTags"))
+	})
+
+	t.Run("validation: at least one of the fields [opts.Set.AllowedNetworkRules opts.Set.AllowedApiAuthenticationIntegrations opts.Set.AllowedAuthenticationSecrets opts.Set.Enabled opts.Set.Comment] should be set", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Set = &ExternalAccessIntegrationSet{}
+		assertOptsInvalidJoinedErrors(t, opts, errAtLeastOneOf("AlterExternalAccessIntegrationOptions.Set", "AllowedNetworkRules", "AllowedApiAuthenticationIntegrations", "AllowedAuthenticationSecrets", "Enabled", "Comment"))
+	})
+
+	t.Run("validation: at least one of the fields [opts.Unset.AllowedApiAuthenticationIntegrations opts.Unset.AllowedAuthenticationSecrets opts.Unset.Comment] should be set", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Unset = &ExternalAccessIntegrationUnset{}
+		assertOptsInvalidJoinedErrors(t, opts, errAtLeastOneOf("AlterExternalAccessIntegrationOptions.Unset", "AllowedApiAuthenticationIntegrations", "AllowedAuthenticationSecrets", "Comment"))
+	})
+
+	t.Run("set", func(t *testing.T) {
+		networkRuleId := randomSchemaObjectIdentifier()
+		integrationId := randomAccountObjectIdentifier()
+		secretId := randomSchemaObjectIdentifier()
+
+		opts := defaultOpts()
+		opts.Set = &ExternalAccessIntegrationSet{
+			AllowedNetworkRules:                  []SchemaObjectIdentifier{networkRuleId},
+			AllowedApiAuthenticationIntegrations: []AccountObjectIdentifier{integrationId},
+			AllowedAuthenticationSecrets:         []SchemaObjectIdentifier{secretId},
+			Enabled:                              Bool(true),
+			Comment:                              String("some comment"),
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER EXTERNAL ACCESS INTEGRATION %s SET ALLOWED_NETWORK_RULES = (%s) ALLOWED_API_AUTHENTICATION_INTEGRATIONS = (%s) ALLOWED_AUTHENTICATION_SECRETS = (%s) ENABLED = true COMMENT = 'some comment'",
+			id.FullyQualifiedName(), networkRuleId.FullyQualifiedName(), integrationId.FullyQualifiedName(), secretId.FullyQualifiedName())
+	})
+
+	t.Run("unset", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Unset = &ExternalAccessIntegrationUnset{
+			AllowedApiAuthenticationIntegrations: Bool(true),
+			AllowedAuthenticationSecrets:         Bool(true),
+			Comment:                              Bool(true),
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER EXTERNAL ACCESS INTEGRATION %s UNSET ALLOWED_API_AUTHENTICATION_INTEGRATIONS, ALLOWED_AUTHENTICATION_SECRETS, COMMENT", id.FullyQualifiedName())
+	})
+
+	t.Run("set tags", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.SetTags = []TagAssociation{
+			{
+				Name:  NewAccountObjectIdentifier("name"),
+				Value: "value",
+			},
+			{
+				Name:  NewAccountObjectIdentifier("second-name"),
+				Value: "second-value",
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER EXTERNAL ACCESS INTEGRATION %s SET TAG "name" = 'value', "second-name" = 'second-value'`, id.FullyQualifiedName())
+	})
+
+	t.Run("unset tags", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.UnsetTags = []ObjectIdentifier{
+			NewAccountObjectIdentifier("name"),
+			NewAccountObjectIdentifier("second-name"),
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER EXTERNAL ACCESS INTEGRATION %s UNSET TAG "name", "second-name"`, id.FullyQualifiedName())
+	})
+}
+
+func TestExternalAccessIntegrations_Drop(t *testing.T) {
+	id := randomAccountObjectIdentifier()
+
+	// Minimal valid DropExternalAccessIntegrationOptions
+	defaultOpts := func() *DropExternalAccessIntegrationOptions {
+		return &DropExternalAccessIntegrationOptions{
+			name: id,
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *DropExternalAccessIntegrationOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.name = emptyAccountObjectIdentifier
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "DROP EXTERNAL ACCESS INTEGRATION %s", id.FullyQualifiedName())
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.IfExists = Bool(true)
+		assertOptsValidAndSQLEquals(t, opts, "DROP EXTERNAL ACCESS INTEGRATION IF EXISTS %s", id.FullyQualifiedName())
+	})
+}
+
+func TestExternalAccessIntegrations_Show(t *testing.T) {
+	id := randomAccountObjectIdentifier()
+
+	// Minimal valid ShowExternalAccessIntegrationOptions
+	defaultOpts := func() *ShowExternalAccessIntegrationOptions {
+		return &ShowExternalAccessIntegrationOptions{}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *ShowExternalAccessIntegrationOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "SHOW EXTERNAL ACCESS INTEGRATIONS")
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Like = &Like{
+			Pattern: String(id.Name()),
+		}
+		assertOptsValidAndSQLEquals(t, opts, "SHOW EXTERNAL ACCESS INTEGRATIONS LIKE '%s'", id.Name())
+	})
+}
+
+func TestExternalAccessIntegrations_Describe(t *testing.T) {
+	id := randomAccountObjectIdentifier()
+
+	// Minimal valid DescribeExternalAccessIntegrationOptions
+	defaultOpts := func() *DescribeExternalAccessIntegrationOptions {
+		return &DescribeExternalAccessIntegrationOptions{
+			name: id,
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *DescribeExternalAccessIntegrationOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.name = emptyAccountObjectIdentifier
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "DESCRIBE EXTERNAL ACCESS INTEGRATION %s", id.FullyQualifiedName())
+	})
+}
@@ -0,0 +1,145 @@
+package sdk
+
+import (
+	"context"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+)
+
+var _ ExternalAccessIntegrations = (*externalAccessIntegrations)(nil)
+
+type externalAccessIntegrations struct {
+	client *Client
+}
+
+func (v *externalAccessIntegrations) Create(ctx context.Context, request *CreateExternalAccessIntegrationRequest) error {
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *externalAccessIntegrations) Alter(ctx context.Context, request *AlterExternalAccessIntegrationRequest) error {
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *externalAccessIntegrations) Drop(ctx context.Context, request *DropExternalAccessIntegrationRequest) error {
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *externalAccessIntegrations) Show(ctx context.Context, request *ShowExternalAccessIntegrationRequest) ([]ExternalAccessIntegration, error) {
+	opts := request.toOpts()
+	dbRows, err := validateAndQuery[showExternalAccessIntegrationsDbRow](v.client, ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	resultList := convertRows[showExternalAccessIntegrationsDbRow, ExternalAccessIntegration](dbRows)
+	return resultList, nil
+}
+
+func (v *externalAccessIntegrations) ShowByID(ctx context.Context, id AccountObjectIdentifier) (*ExternalAccessIntegration, error) {
+	request := NewShowExternalAccessIntegrationRequest().
+		WithLike(Like{Pattern: String(id.Name())})
+	externalAccessIntegrations, err := v.Show(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return collections.FindFirst(externalAccessIntegrations, func(r ExternalAccessIntegration) bool { return r.Name == id.Name() })
+}
+
+func (v *externalAccessIntegrations) Describe(ctx context.Context, id AccountObjectIdentifier) ([]ExternalAccessIntegrationProperty, error) {
+	opts := &DescribeExternalAccessIntegrationOptions{
+		name: id,
+	}
+	rows, err := validateAndQuery[descExternalAccessIntegrationsDbRow](v.client, ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return convertRows[descExternalAccessIntegrationsDbRow, ExternalAccessIntegrationProperty](rows), nil
+}
+
+func (r *CreateExternalAccessIntegrationRequest) toOpts() *CreateExternalAccessIntegrationOptions {
+	opts := &CreateExternalAccessIntegrationOptions{
+		OrReplace:                            r.OrReplace,
+		IfNotExists:                          r.IfNotExists,
+		name:                                 r.name,
+		AllowedNetworkRules:                  r.AllowedNetworkRules,
+		AllowedApiAuthenticationIntegrations: r.AllowedApiAuthenticationIntegrations,
+		AllowedAuthenticationSecrets:         r.AllowedAuthenticationSecrets,
+		Enabled:                              r.Enabled,
+		Comment:                              r.Comment,
+	}
+	return opts
+}
+
+func (r *AlterExternalAccessIntegrationRequest) toOpts() *AlterExternalAccessIntegrationOptions {
+	opts := &AlterExternalAccessIntegrationOptions{
+		IfExists: r.IfExists,
+		name:     r.name,
+
+		SetTags:   r.SetTags,
+		UnsetTags: r.UnsetTags,
+	}
+	if r.Set != nil {
+		opts.Set = &ExternalAccessIntegrationSet{
+			AllowedNetworkRules:                  r.Set.AllowedNetworkRules,
+			AllowedApiAuthenticationIntegrations: r.Set.AllowedApiAuthenticationIntegrations,
+			AllowedAuthenticationSecrets:         r.Set.AllowedAuthenticationSecrets,
+			Enabled:                              r.Set.Enabled,
+			Comment:                              r.Set.Comment,
+		}
+	}
+	if r.Unset != nil {
+		opts.Unset = &ExternalAccessIntegrationUnset{
+			AllowedApiAuthenticationIntegrations: r.Unset.AllowedApiAuthenticationIntegrations,
+			AllowedAuthenticationSecrets:         r.Unset.AllowedAuthenticationSecrets,
+			Comment:                              r.Unset.Comment,
+		}
+	}
+	return opts
+}
+
+func (r *DropExternalAccessIntegrationRequest) toOpts() *DropExternalAccessIntegrationOptions {
+	opts := &DropExternalAccessIntegrationOptions{
+		IfExists: r.IfExists,
+		name:     r.name,
+	}
+	return opts
+}
+
+func (r *ShowExternalAccessIntegrationRequest) toOpts() *ShowExternalAccessIntegrationOptions {
+	opts := &ShowExternalAccessIntegrationOptions{
+		Like: r.Like,
+	}
+	return opts
+}
+
+func (r showExternalAccessIntegrationsDbRow) convert() *ExternalAccessIntegration {
+	s := &ExternalAccessIntegration{
+		Name:            r.Name,
+		IntegrationType: r.Type,
+		Category:        r.Category,
+		Enabled:         r.Enabled,
+		CreatedOn:       r.CreatedOn,
+	}
+	if r.Comment.Valid {
+		s.Comment = r.Comment.String
+	}
+	return s
+}
+
+func (r *DescribeExternalAccessIntegrationRequest) toOpts() *DescribeExternalAccessIntegrationOptions {
+	opts := &DescribeExternalAccessIntegrationOptions{
+		name: r.name,
+	}
+	return opts
+}
+
+func (r descExternalAccessIntegrationsDbRow) convert() *ExternalAccessIntegrationProperty {
+	return &ExternalAccessIntegrationProperty{
+		Name:    r.Property,
+		Type:    r.PropertyType,
+		Value:   r.PropertyValue,
+		Default: r.PropertyDefault,
+	}
+}
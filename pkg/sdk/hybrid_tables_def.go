@@ -0,0 +1,117 @@
+package sdk
+
+import g "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/poc/generator"
+
+//go:generate go run ./poc/main.go
+
+var hybridTableDbRow = g.DbStruct("hybridTableRow").
+	Time("created_on").
+	Text("name").
+	Text("database_name").
+	Text("schema_name").
+	OptionalText("owner").
+	OptionalNumber("rows").
+	OptionalNumber("bytes").
+	OptionalText("comment").
+	OptionalText("owner_role_type")
+
+var hybridTable = g.PlainStruct("HybridTable").
+	Time("CreatedOn").
+	Text("Name").
+	Text("DatabaseName").
+	Text("SchemaName").
+	Text("Owner").
+	OptionalNumber("Rows").
+	OptionalNumber("Bytes").
+	Text("Comment").
+	Text("OwnerRoleType")
+
+var HybridTablesDef = g.NewInterface(
+	"HybridTables",
+	"HybridTable",
+	g.KindOfT[SchemaObjectIdentifier](),
+).
+	CreateOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/create-hybrid-table",
+		g.NewQueryStruct("CreateHybridTable").
+			Create().
+			OrReplace().
+			SQL("HYBRID TABLE").
+			IfNotExists().
+			Name().
+			PredefinedQueryStructField("ColumnsAndConstraints", "HybridTableColumnsConstraintsAndIndexes", g.ListOptions().Parentheses().Required()).
+			OptionalComment().
+			WithValidation(g.ValidIdentifier, "name").
+			WithValidation(g.ConflictingFields, "OrReplace", "IfNotExists").
+			WithValidation(g.ValidateValue, "ColumnsAndConstraints"),
+	).
+	AlterOperation(
+		// Hybrid tables are altered with the regular ALTER TABLE command.
+		"https://docs.snowflake.com/en/sql-reference/sql/alter-table",
+		g.NewQueryStruct("AlterHybridTable").
+			Alter().
+			SQL("TABLE").
+			IfExists().
+			Name().
+			OptionalIdentifier("RenameTo", g.KindOfT[SchemaObjectIdentifier](), g.IdentifierOptions().SQL("RENAME TO")).
+			OptionalTextAssignment("SET COMMENT", g.ParameterOptions().SingleQuotes()).
+			OptionalSQL("UNSET COMMENT").
+			WithValidation(g.ValidIdentifier, "name").
+			WithValidation(g.ValidIdentifierIfSet, "RenameTo").
+			WithValidation(g.ExactlyOneValueSet, "RenameTo", "SetComment", "UnsetComment"),
+	).
+	DropOperation(
+		// Hybrid tables are dropped with the regular DROP TABLE command.
+		"https://docs.snowflake.com/en/sql-reference/sql/drop-table",
+		g.NewQueryStruct("DropHybridTable").
+			Drop().
+			SQL("TABLE").
+			IfExists().
+			Name().
+			WithValidation(g.ValidIdentifier, "name"),
+	).
+	ShowOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/show-hybrid-tables",
+		hybridTableDbRow,
+		hybridTable,
+		g.NewQueryStruct("ShowHybridTables").
+			Show().
+			Terse().
+			SQL("HYBRID TABLES").
+			OptionalLike().
+			OptionalIn().
+			OptionalStartsWith().
+			OptionalLimit(),
+	).
+	ShowByIdOperationWithFiltering(
+		g.ShowByIDInFiltering,
+		g.ShowByIDLikeFiltering,
+	).
+	CustomOperation(
+		"CreateIndex",
+		"https://docs.snowflake.com/en/sql-reference/sql/create-index",
+		g.NewQueryStruct("CreateHybridTableIndex").
+			Create().
+			OrReplace().
+			SQL("INDEX").
+			IfNotExists().
+			Text("Name", g.KeywordOptions().Required().DoubleQuotes()).
+			Identifier("TableName", g.KindOfT[SchemaObjectIdentifier](), g.IdentifierOptions().SQL("ON").Required()).
+			PredefinedQueryStructField("Columns", "[]string", g.KeywordOptions().Parentheses().Required()).
+			PredefinedQueryStructField("IncludeColumns", "[]string", g.KeywordOptions().Parentheses().SQL("INCLUDE")).
+			WithValidation(g.ValidIdentifier, "TableName").
+			WithValidation(g.ConflictingFields, "OrReplace", "IfNotExists").
+			WithValidation(g.ValidateValueSet, "Name").
+			WithValidation(g.ValidateValueSet, "Columns"),
+	).
+	CustomOperation(
+		"DropIndex",
+		"https://docs.snowflake.com/en/sql-reference/sql/drop-index",
+		g.NewQueryStruct("DropHybridTableIndex").
+			Drop().
+			SQL("INDEX").
+			IfExists().
+			// Index is referenced by <table_name>.<index_name>, so the fully qualified name has the same shape as the table column identifier.
+			Identifier("Index", g.KindOfT[TableColumnIdentifier](), g.IdentifierOptions().Required()).
+			WithValidation(g.ValidIdentifier, "Index"),
+	)
@@ -0,0 +1,55 @@
+package sdk
+
+//go:generate go run ./dto-builder-generator/main.go
+
+var (
+	_ optionsProvider[CreateHybridTableOptions]      = new(CreateHybridTableRequest)
+	_ optionsProvider[AlterHybridTableOptions]       = new(AlterHybridTableRequest)
+	_ optionsProvider[DropHybridTableOptions]        = new(DropHybridTableRequest)
+	_ optionsProvider[ShowHybridTableOptions]        = new(ShowHybridTableRequest)
+	_ optionsProvider[CreateIndexHybridTableOptions] = new(CreateIndexHybridTableRequest)
+	_ optionsProvider[DropIndexHybridTableOptions]   = new(DropIndexHybridTableRequest)
+)
+
+type CreateHybridTableRequest struct {
+	OrReplace             *bool
+	IfNotExists           *bool
+	name                  SchemaObjectIdentifier                  // required
+	ColumnsAndConstraints HybridTableColumnsConstraintsAndIndexes // required
+	Comment               *string
+}
+
+type AlterHybridTableRequest struct {
+	IfExists     *bool
+	name         SchemaObjectIdentifier // required
+	RenameTo     *SchemaObjectIdentifier
+	SetComment   *string
+	UnsetComment *bool
+}
+
+type DropHybridTableRequest struct {
+	IfExists *bool
+	name     SchemaObjectIdentifier // required
+}
+
+type ShowHybridTableRequest struct {
+	Terse      *bool
+	Like       *Like
+	In         *In
+	StartsWith *string
+	Limit      *LimitFrom
+}
+
+type CreateIndexHybridTableRequest struct {
+	OrReplace      *bool
+	IfNotExists    *bool
+	Name           string                 // required
+	TableName      SchemaObjectIdentifier // required
+	Columns        []string               // required
+	IncludeColumns []string
+}
+
+type DropIndexHybridTableRequest struct {
+	IfExists *bool
+	Index    TableColumnIdentifier // required
+}
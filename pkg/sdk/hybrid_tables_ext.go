@@ -0,0 +1,166 @@
+package sdk
+
+import (
+	"context"
+	"database/sql"
+	"time"
+)
+
+// HybridTablesExtended extends the generated HybridTables with SHOW INDEXES, which is not supported by the generator.
+type HybridTablesExtended interface {
+	HybridTables
+	ShowIndexes(ctx context.Context, request *ShowHybridTableIndexesRequest) ([]HybridTableIndex, error)
+}
+
+var (
+	_ HybridTablesExtended                           = new(hybridTables)
+	_ validatable                                    = new(ShowHybridTableIndexesOptions)
+	_ validatable                                    = new(HybridTableColumnsConstraintsAndIndexes)
+	_ optionsProvider[ShowHybridTableIndexesOptions] = new(ShowHybridTableIndexesRequest)
+	_ convertibleRow[HybridTableIndex]               = new(hybridTableIndexRow)
+)
+
+// HybridTableColumnsConstraintsAndIndexes describes the body of CREATE HYBRID TABLE.
+// Hybrid tables require a primary key (either inline or out-of-line) and support secondary indexes defined next to the columns.
+type HybridTableColumnsConstraintsAndIndexes struct {
+	Columns             []TableColumn               `ddl:"keyword"`
+	OutOfLineConstraint []OutOfLineConstraint       `ddl:"list,no_parentheses"`
+	OutOfLineIndex      []HybridTableOutOfLineIndex `ddl:"list,no_parentheses"`
+}
+
+func (v *HybridTableColumnsConstraintsAndIndexes) validate() error {
+	if len(v.Columns) == 0 {
+		return errNotSet("CreateHybridTableOptions", "Columns")
+	}
+	return nil
+}
+
+func (r *CreateHybridTableRequest) GetName() SchemaObjectIdentifier {
+	return r.name
+}
+
+type HybridTableOutOfLineIndex struct {
+	Name           string   `ddl:"parameter,no_equals,double_quotes" sql:"INDEX"`
+	Columns        []string `ddl:"keyword,parentheses"`
+	IncludeColumns []string `ddl:"keyword,parentheses" sql:"INCLUDE"`
+}
+
+func NewHybridTableIndexIdentifier(tableId SchemaObjectIdentifier, indexName string) TableColumnIdentifier {
+	return NewTableColumnIdentifier(tableId.DatabaseName(), tableId.SchemaName(), tableId.Name(), indexName)
+}
+
+// ShowHybridTableIndexesOptions is based on https://docs.snowflake.com/en/sql-reference/sql/show-indexes.
+type ShowHybridTableIndexesOptions struct {
+	show    bool                  `ddl:"static" sql:"SHOW"`
+	indexes bool                  `ddl:"static" sql:"INDEXES"`
+	In      *HybridTableIndexesIn `ddl:"keyword" sql:"IN"`
+}
+
+type HybridTableIndexesIn struct {
+	In
+	Table SchemaObjectIdentifier `ddl:"identifier" sql:"TABLE"`
+}
+
+func (opts *ShowHybridTableIndexesOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if opts.In != nil {
+		if !exactlyOneValueSet(opts.In.Account, opts.In.Database, opts.In.Schema, opts.In.Table) {
+			errs = append(errs, errExactlyOneOf("ShowHybridTableIndexesOptions.In", "Account", "Database", "Schema", "Table"))
+		}
+		if valueSet(opts.In.Table) && !ValidObjectIdentifier(opts.In.Table) {
+			errs = append(errs, ErrInvalidObjectIdentifier)
+		}
+	}
+	return JoinErrors(errs...)
+}
+
+type ShowHybridTableIndexesRequest struct {
+	In *HybridTableIndexesIn
+}
+
+func NewShowHybridTableIndexesRequest() *ShowHybridTableIndexesRequest {
+	return &ShowHybridTableIndexesRequest{}
+}
+
+func (s *ShowHybridTableIndexesRequest) WithIn(in HybridTableIndexesIn) *ShowHybridTableIndexesRequest {
+	s.In = &in
+	return s
+}
+
+func (r *ShowHybridTableIndexesRequest) toOpts() *ShowHybridTableIndexesOptions {
+	return &ShowHybridTableIndexesOptions{
+		In: r.In,
+	}
+}
+
+type hybridTableIndexRow struct {
+	CreatedOn       time.Time      `db:"created_on"`
+	Name            string         `db:"name"`
+	IsUnique        sql.NullString `db:"is_unique"`
+	Columns         sql.NullString `db:"columns"`
+	IncludedColumns sql.NullString `db:"included_columns"`
+	Table           string         `db:"table"`
+	DatabaseName    string         `db:"database_name"`
+	SchemaName      string         `db:"schema_name"`
+	Owner           sql.NullString `db:"owner"`
+	OwnerRoleType   sql.NullString `db:"owner_role_type"`
+}
+
+type HybridTableIndex struct {
+	CreatedOn       time.Time
+	Name            string
+	IsUnique        bool
+	Columns         []string
+	IncludedColumns []string
+	Table           string
+	DatabaseName    string
+	SchemaName      string
+	Owner           string
+	OwnerRoleType   string
+}
+
+func (v *HybridTableIndex) TableId() SchemaObjectIdentifier {
+	return NewSchemaObjectIdentifier(v.DatabaseName, v.SchemaName, v.Table)
+}
+
+func (v *HybridTableIndex) ID() TableColumnIdentifier {
+	return NewHybridTableIndexIdentifier(v.TableId(), v.Name)
+}
+
+func (r hybridTableIndexRow) convert() *HybridTableIndex {
+	index := &HybridTableIndex{
+		CreatedOn:    r.CreatedOn,
+		Name:         r.Name,
+		Table:        r.Table,
+		DatabaseName: r.DatabaseName,
+		SchemaName:   r.SchemaName,
+	}
+	if r.IsUnique.Valid {
+		index.IsUnique = r.IsUnique.String == "Y" || r.IsUnique.String == "true"
+	}
+	if r.Columns.Valid {
+		index.Columns = ParseCommaSeparatedStringArray(r.Columns.String, true)
+	}
+	if r.IncludedColumns.Valid {
+		index.IncludedColumns = ParseCommaSeparatedStringArray(r.IncludedColumns.String, true)
+	}
+	if r.Owner.Valid {
+		index.Owner = r.Owner.String
+	}
+	if r.OwnerRoleType.Valid {
+		index.OwnerRoleType = r.OwnerRoleType.String
+	}
+	return index
+}
+
+func (v *hybridTables) ShowIndexes(ctx context.Context, request *ShowHybridTableIndexesRequest) ([]HybridTableIndex, error) {
+	opts := request.toOpts()
+	dbRows, err := validateAndQuery[hybridTableIndexRow](v.client, ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return convertRows[hybridTableIndexRow, HybridTableIndex](dbRows), nil
+}
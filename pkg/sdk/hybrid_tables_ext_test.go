@@ -0,0 +1,39 @@
+package sdk
+
+import "testing"
+
+func TestHybridTables_ShowIndexes(t *testing.T) {
+	defaultOpts := func() *ShowHybridTableIndexesOptions {
+		return &ShowHybridTableIndexesOptions{}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *ShowHybridTableIndexesOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: exactly one in", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.In = &HybridTableIndexesIn{}
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("ShowHybridTableIndexesOptions.In", "Account", "Database", "Schema", "Table"))
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, `SHOW INDEXES`)
+	})
+
+	t.Run("in table", func(t *testing.T) {
+		tableId := randomSchemaObjectIdentifier()
+		opts := defaultOpts()
+		opts.In = &HybridTableIndexesIn{Table: tableId}
+		assertOptsValidAndSQLEquals(t, opts, `SHOW INDEXES IN TABLE %s`, tableId.FullyQualifiedName())
+	})
+
+	t.Run("in schema", func(t *testing.T) {
+		schemaId := randomDatabaseObjectIdentifier()
+		opts := defaultOpts()
+		opts.In = &HybridTableIndexesIn{In: In{Schema: schemaId}}
+		assertOptsValidAndSQLEquals(t, opts, `SHOW INDEXES IN SCHEMA %s`, schemaId.FullyQualifiedName())
+	})
+}
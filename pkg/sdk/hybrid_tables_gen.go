@@ -0,0 +1,109 @@
+package sdk
+
+import (
+	"context"
+	"database/sql"
+	"time"
+)
+
+type HybridTables interface {
+	Create(ctx context.Context, request *CreateHybridTableRequest) error
+	Alter(ctx context.Context, request *AlterHybridTableRequest) error
+	Drop(ctx context.Context, request *DropHybridTableRequest) error
+	Show(ctx context.Context, request *ShowHybridTableRequest) ([]HybridTable, error)
+	ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*HybridTable, error)
+	CreateIndex(ctx context.Context, request *CreateIndexHybridTableRequest) error
+	DropIndex(ctx context.Context, request *DropIndexHybridTableRequest) error
+}
+
+// CreateHybridTableOptions is based on https://docs.snowflake.com/en/sql-reference/sql/create-hybrid-table.
+type CreateHybridTableOptions struct {
+	create                bool                                    `ddl:"static" sql:"CREATE"`
+	OrReplace             *bool                                   `ddl:"keyword" sql:"OR REPLACE"`
+	hybridTable           bool                                    `ddl:"static" sql:"HYBRID TABLE"`
+	IfNotExists           *bool                                   `ddl:"keyword" sql:"IF NOT EXISTS"`
+	name                  SchemaObjectIdentifier                  `ddl:"identifier"`
+	ColumnsAndConstraints HybridTableColumnsConstraintsAndIndexes `ddl:"list,parentheses"`
+	Comment               *string                                 `ddl:"parameter,single_quotes" sql:"COMMENT"`
+}
+
+// AlterHybridTableOptions is based on https://docs.snowflake.com/en/sql-reference/sql/alter-table.
+type AlterHybridTableOptions struct {
+	alter        bool                    `ddl:"static" sql:"ALTER"`
+	table        bool                    `ddl:"static" sql:"TABLE"`
+	IfExists     *bool                   `ddl:"keyword" sql:"IF EXISTS"`
+	name         SchemaObjectIdentifier  `ddl:"identifier"`
+	RenameTo     *SchemaObjectIdentifier `ddl:"identifier" sql:"RENAME TO"`
+	SetComment   *string                 `ddl:"parameter,single_quotes" sql:"SET COMMENT"`
+	UnsetComment *bool                   `ddl:"keyword" sql:"UNSET COMMENT"`
+}
+
+// DropHybridTableOptions is based on https://docs.snowflake.com/en/sql-reference/sql/drop-table.
+type DropHybridTableOptions struct {
+	drop     bool                   `ddl:"static" sql:"DROP"`
+	table    bool                   `ddl:"static" sql:"TABLE"`
+	IfExists *bool                  `ddl:"keyword" sql:"IF EXISTS"`
+	name     SchemaObjectIdentifier `ddl:"identifier"`
+}
+
+// ShowHybridTableOptions is based on https://docs.snowflake.com/en/sql-reference/sql/show-hybrid-tables.
+type ShowHybridTableOptions struct {
+	show         bool       `ddl:"static" sql:"SHOW"`
+	Terse        *bool      `ddl:"keyword" sql:"TERSE"`
+	hybridTables bool       `ddl:"static" sql:"HYBRID TABLES"`
+	Like         *Like      `ddl:"keyword" sql:"LIKE"`
+	In           *In        `ddl:"keyword" sql:"IN"`
+	StartsWith   *string    `ddl:"parameter,single_quotes,no_equals" sql:"STARTS WITH"`
+	Limit        *LimitFrom `ddl:"keyword" sql:"LIMIT"`
+}
+
+type hybridTableRow struct {
+	CreatedOn     time.Time      `db:"created_on"`
+	Name          string         `db:"name"`
+	DatabaseName  string         `db:"database_name"`
+	SchemaName    string         `db:"schema_name"`
+	Owner         sql.NullString `db:"owner"`
+	Rows          sql.NullInt64  `db:"rows"`
+	Bytes         sql.NullInt64  `db:"bytes"`
+	Comment       sql.NullString `db:"comment"`
+	OwnerRoleType sql.NullString `db:"owner_role_type"`
+}
+
+type HybridTable struct {
+	CreatedOn     time.Time
+	Name          string
+	DatabaseName  string
+	SchemaName    string
+	Owner         string
+	Rows          *int
+	Bytes         *int
+	Comment       string
+	OwnerRoleType string
+}
+
+func (v *HybridTable) ID() SchemaObjectIdentifier {
+	return NewSchemaObjectIdentifier(v.DatabaseName, v.SchemaName, v.Name)
+}
+func (v *HybridTable) ObjectType() ObjectType {
+	return ObjectTypeHybridTable
+}
+
+// CreateIndexHybridTableOptions is based on https://docs.snowflake.com/en/sql-reference/sql/create-index.
+type CreateIndexHybridTableOptions struct {
+	create         bool                   `ddl:"static" sql:"CREATE"`
+	OrReplace      *bool                  `ddl:"keyword" sql:"OR REPLACE"`
+	index          bool                   `ddl:"static" sql:"INDEX"`
+	IfNotExists    *bool                  `ddl:"keyword" sql:"IF NOT EXISTS"`
+	Name           string                 `ddl:"keyword,double_quotes"`
+	TableName      SchemaObjectIdentifier `ddl:"identifier" sql:"ON"`
+	Columns        []string               `ddl:"keyword,parentheses"`
+	IncludeColumns []string               `ddl:"keyword,parentheses" sql:"INCLUDE"`
+}
+
+// DropIndexHybridTableOptions is based on https://docs.snowflake.com/en/sql-reference/sql/drop-index.
+type DropIndexHybridTableOptions struct {
+	drop     bool                  `ddl:"static" sql:"DROP"`
+	index    bool                  `ddl:"static" sql:"INDEX"`
+	IfExists *bool                 `ddl:"keyword" sql:"IF EXISTS"`
+	Index    TableColumnIdentifier `ddl:"identifier"`
+}
@@ -0,0 +1,302 @@
+package sdk
+
+import (
+	"testing"
+)
+
+func TestHybridTables_Create(t *testing.T) {
+	id := randomSchemaObjectIdentifier()
+
+	defaultOpts := func() *CreateHybridTableOptions {
+		return &CreateHybridTableOptions{
+			name: id,
+			ColumnsAndConstraints: HybridTableColumnsConstraintsAndIndexes{
+				Columns: []TableColumn{
+					{
+						Name:             `"ID"`,
+						Type:             DataTypeNumber,
+						InlineConstraint: &ColumnInlineConstraint{Type: ColumnConstraintTypePrimaryKey},
+					},
+				},
+			},
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *CreateHybridTableOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: incorrect identifier", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.name = emptySchemaObjectIdentifier
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("validation: conflicting fields for [opts.OrReplace opts.IfNotExists]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.OrReplace = Bool(true)
+		opts.IfNotExists = Bool(true)
+		assertOptsInvalidJoinedErrors(t, opts, errOneOf("CreateHybridTableOptions", "OrReplace", "IfNotExists"))
+	})
+
+	t.Run("validation: no columns", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.ColumnsAndConstraints = HybridTableColumnsConstraintsAndIndexes{}
+		assertOptsInvalidJoinedErrors(t, opts, errNotSet("CreateHybridTableOptions", "Columns"))
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, `CREATE HYBRID TABLE %s ("ID" NUMBER PRIMARY KEY)`, id.FullyQualifiedName())
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.OrReplace = Bool(true)
+		opts.ColumnsAndConstraints = HybridTableColumnsConstraintsAndIndexes{
+			Columns: []TableColumn{
+				{
+					Name:    `"ID"`,
+					Type:    DataTypeNumber,
+					NotNull: Bool(true),
+				},
+				{
+					Name:    `"EMAIL"`,
+					Type:    DataTypeVARCHAR,
+					Comment: String("email"),
+				},
+				{
+					Name: `"NAME"`,
+					Type: DataTypeVARCHAR,
+				},
+			},
+			OutOfLineConstraint: []OutOfLineConstraint{
+				{
+					Name:    String("PK"),
+					Type:    ColumnConstraintTypePrimaryKey,
+					Columns: []string{`"ID"`},
+				},
+				{
+					Type:    ColumnConstraintTypeUnique,
+					Columns: []string{`"EMAIL"`},
+				},
+			},
+			OutOfLineIndex: []HybridTableOutOfLineIndex{
+				{
+					Name:           "IDX_NAME",
+					Columns:        []string{`"NAME"`},
+					IncludeColumns: []string{`"EMAIL"`},
+				},
+			},
+		}
+		opts.Comment = String("comment")
+		assertOptsValidAndSQLEquals(t, opts, `CREATE OR REPLACE HYBRID TABLE %s ("ID" NUMBER NOT NULL, "EMAIL" VARCHAR COMMENT 'email', "NAME" VARCHAR, CONSTRAINT PK PRIMARY KEY ("ID"), UNIQUE ("EMAIL"), INDEX "IDX_NAME" ("NAME") INCLUDE ("EMAIL")) COMMENT = 'comment'`, id.FullyQualifiedName())
+	})
+}
+
+func TestHybridTables_Alter(t *testing.T) {
+	id := randomSchemaObjectIdentifier()
+
+	defaultOpts := func() *AlterHybridTableOptions {
+		return &AlterHybridTableOptions{
+			name: id,
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *AlterHybridTableOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: incorrect identifier", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.name = emptySchemaObjectIdentifier
+		opts.UnsetComment = Bool(true)
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("validation: incorrect identifier for [opts.RenameTo]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.RenameTo = &emptySchemaObjectIdentifier
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("validation: exactly one field from [opts.RenameTo opts.SetComment opts.UnsetComment] should be present", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("AlterHybridTableOptions", "RenameTo", "SetComment", "UnsetComment"))
+	})
+
+	t.Run("validation: exactly one field from [opts.RenameTo opts.SetComment opts.UnsetComment] should be present - more present", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.SetComment = String("comment")
+		opts.UnsetComment = Bool(true)
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("AlterHybridTableOptions", "RenameTo", "SetComment", "UnsetComment"))
+	})
+
+	t.Run("rename", func(t *testing.T) {
+		newId := randomSchemaObjectIdentifier()
+		opts := defaultOpts()
+		opts.IfExists = Bool(true)
+		opts.RenameTo = &newId
+		assertOptsValidAndSQLEquals(t, opts, `ALTER TABLE IF EXISTS %s RENAME TO %s`, id.FullyQualifiedName(), newId.FullyQualifiedName())
+	})
+
+	t.Run("set comment", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.SetComment = String("comment")
+		assertOptsValidAndSQLEquals(t, opts, `ALTER TABLE %s SET COMMENT = 'comment'`, id.FullyQualifiedName())
+	})
+
+	t.Run("unset comment", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.UnsetComment = Bool(true)
+		assertOptsValidAndSQLEquals(t, opts, `ALTER TABLE %s UNSET COMMENT`, id.FullyQualifiedName())
+	})
+}
+
+func TestHybridTables_Drop(t *testing.T) {
+	id := randomSchemaObjectIdentifier()
+
+	defaultOpts := func() *DropHybridTableOptions {
+		return &DropHybridTableOptions{
+			name: id,
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *DropHybridTableOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: incorrect identifier", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.name = emptySchemaObjectIdentifier
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, `DROP TABLE %s`, id.FullyQualifiedName())
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.IfExists = Bool(true)
+		assertOptsValidAndSQLEquals(t, opts, `DROP TABLE IF EXISTS %s`, id.FullyQualifiedName())
+	})
+}
+
+func TestHybridTables_Show(t *testing.T) {
+	defaultOpts := func() *ShowHybridTableOptions {
+		return &ShowHybridTableOptions{}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *ShowHybridTableOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, `SHOW HYBRID TABLES`)
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		schemaId := randomDatabaseObjectIdentifier()
+		opts := defaultOpts()
+		opts.Terse = Bool(true)
+		opts.Like = &Like{Pattern: String("pattern")}
+		opts.In = &In{Schema: schemaId}
+		opts.StartsWith = String("abc")
+		opts.Limit = &LimitFrom{Rows: Int(10), From: String("xyz")}
+		assertOptsValidAndSQLEquals(t, opts, `SHOW TERSE HYBRID TABLES LIKE 'pattern' IN SCHEMA %s STARTS WITH 'abc' LIMIT 10 FROM 'xyz'`, schemaId.FullyQualifiedName())
+	})
+}
+
+func TestHybridTables_CreateIndex(t *testing.T) {
+	tableId := randomSchemaObjectIdentifier()
+
+	defaultOpts := func() *CreateIndexHybridTableOptions {
+		return &CreateIndexHybridTableOptions{
+			Name:      "IDX",
+			TableName: tableId,
+			Columns:   []string{`"NAME"`},
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *CreateIndexHybridTableOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: incorrect identifier for [opts.TableName]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.TableName = emptySchemaObjectIdentifier
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("validation: conflicting fields for [opts.OrReplace opts.IfNotExists]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.OrReplace = Bool(true)
+		opts.IfNotExists = Bool(true)
+		assertOptsInvalidJoinedErrors(t, opts, errOneOf("CreateIndexHybridTableOptions", "OrReplace", "IfNotExists"))
+	})
+
+	t.Run("validation: name not set", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Name = ""
+		assertOptsInvalidJoinedErrors(t, opts, errNotSet("CreateIndexHybridTableOptions", "Name"))
+	})
+
+	t.Run("validation: columns not set", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Columns = nil
+		assertOptsInvalidJoinedErrors(t, opts, errNotSet("CreateIndexHybridTableOptions", "Columns"))
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, `CREATE INDEX "IDX" ON %s ("NAME")`, tableId.FullyQualifiedName())
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.IfNotExists = Bool(true)
+		opts.Columns = []string{`"NAME"`, `"SURNAME"`}
+		opts.IncludeColumns = []string{`"EMAIL"`}
+		assertOptsValidAndSQLEquals(t, opts, `CREATE INDEX IF NOT EXISTS "IDX" ON %s ("NAME", "SURNAME") INCLUDE ("EMAIL")`, tableId.FullyQualifiedName())
+	})
+}
+
+func TestHybridTables_DropIndex(t *testing.T) {
+	indexId := randomTableColumnIdentifier()
+
+	defaultOpts := func() *DropIndexHybridTableOptions {
+		return &DropIndexHybridTableOptions{
+			Index: indexId,
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *DropIndexHybridTableOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: incorrect identifier for [opts.Index]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Index = NewTableColumnIdentifier("", "", "", "")
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, `DROP INDEX %s`, indexId.FullyQualifiedName())
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.IfExists = Bool(true)
+		assertOptsValidAndSQLEquals(t, opts, `DROP INDEX IF EXISTS %s`, indexId.FullyQualifiedName())
+	})
+}
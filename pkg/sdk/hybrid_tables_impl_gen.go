@@ -0,0 +1,145 @@
+package sdk
+
+import (
+	"context"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+)
+
+var _ HybridTables = (*hybridTables)(nil)
+
+type hybridTables struct {
+	client *Client
+}
+
+func (v *hybridTables) Create(ctx context.Context, request *CreateHybridTableRequest) error {
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *hybridTables) Alter(ctx context.Context, request *AlterHybridTableRequest) error {
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *hybridTables) Drop(ctx context.Context, request *DropHybridTableRequest) error {
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *hybridTables) Show(ctx context.Context, request *ShowHybridTableRequest) ([]HybridTable, error) {
+	opts := request.toOpts()
+	dbRows, err := validateAndQuery[hybridTableRow](v.client, ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	resultList := convertRows[hybridTableRow, HybridTable](dbRows)
+	return resultList, nil
+}
+
+func (v *hybridTables) ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*HybridTable, error) {
+	request := NewShowHybridTableRequest().
+		WithLike(Like{Pattern: String(id.Name())}).
+		WithIn(In{Schema: id.SchemaId()})
+	hybridTables, err := v.Show(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return collections.FindFirst(hybridTables, func(r HybridTable) bool { return r.Name == id.Name() })
+}
+
+func (v *hybridTables) CreateIndex(ctx context.Context, request *CreateIndexHybridTableRequest) error {
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *hybridTables) DropIndex(ctx context.Context, request *DropIndexHybridTableRequest) error {
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (r *CreateHybridTableRequest) toOpts() *CreateHybridTableOptions {
+	opts := &CreateHybridTableOptions{
+		OrReplace:             r.OrReplace,
+		IfNotExists:           r.IfNotExists,
+		name:                  r.name,
+		ColumnsAndConstraints: r.ColumnsAndConstraints,
+		Comment:               r.Comment,
+	}
+	return opts
+}
+
+func (r *AlterHybridTableRequest) toOpts() *AlterHybridTableOptions {
+	opts := &AlterHybridTableOptions{
+		IfExists:     r.IfExists,
+		name:         r.name,
+		RenameTo:     r.RenameTo,
+		SetComment:   r.SetComment,
+		UnsetComment: r.UnsetComment,
+	}
+	return opts
+}
+
+func (r *DropHybridTableRequest) toOpts() *DropHybridTableOptions {
+	opts := &DropHybridTableOptions{
+		IfExists: r.IfExists,
+		name:     r.name,
+	}
+	return opts
+}
+
+func (r *ShowHybridTableRequest) toOpts() *ShowHybridTableOptions {
+	opts := &ShowHybridTableOptions{
+		Terse:      r.Terse,
+		Like:       r.Like,
+		In:         r.In,
+		StartsWith: r.StartsWith,
+		Limit:      r.Limit,
+	}
+	return opts
+}
+
+func (r hybridTableRow) convert() *HybridTable {
+	hybridTable := &HybridTable{
+		CreatedOn:    r.CreatedOn,
+		Name:         r.Name,
+		DatabaseName: r.DatabaseName,
+		SchemaName:   r.SchemaName,
+	}
+	if r.Owner.Valid {
+		hybridTable.Owner = r.Owner.String
+	}
+	if r.Rows.Valid {
+		hybridTable.Rows = Int(int(r.Rows.Int64))
+	}
+	if r.Bytes.Valid {
+		hybridTable.Bytes = Int(int(r.Bytes.Int64))
+	}
+	if r.Comment.Valid {
+		hybridTable.Comment = r.Comment.String
+	}
+	if r.OwnerRoleType.Valid {
+		hybridTable.OwnerRoleType = r.OwnerRoleType.String
+	}
+	return hybridTable
+}
+
+func (r *CreateIndexHybridTableRequest) toOpts() *CreateIndexHybridTableOptions {
+	opts := &CreateIndexHybridTableOptions{
+		OrReplace:      r.OrReplace,
+		IfNotExists:    r.IfNotExists,
+		Name:           r.Name,
+		TableName:      r.TableName,
+		Columns:        r.Columns,
+		IncludeColumns: r.IncludeColumns,
+	}
+	return opts
+}
+
+func (r *DropIndexHybridTableRequest) toOpts() *DropIndexHybridTableOptions {
+	opts := &DropIndexHybridTableOptions{
+		IfExists: r.IfExists,
+		Index:    r.Index,
+	}
+	return opts
+}
@@ -0,0 +1,94 @@
+package sdk
+
+var (
+	_ validatable = new(CreateHybridTableOptions)
+	_ validatable = new(AlterHybridTableOptions)
+	_ validatable = new(DropHybridTableOptions)
+	_ validatable = new(ShowHybridTableOptions)
+	_ validatable = new(CreateIndexHybridTableOptions)
+	_ validatable = new(DropIndexHybridTableOptions)
+)
+
+func (opts *CreateHybridTableOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if everyValueSet(opts.OrReplace, opts.IfNotExists) {
+		errs = append(errs, errOneOf("CreateHybridTableOptions", "OrReplace", "IfNotExists"))
+	}
+	if err := opts.ColumnsAndConstraints.validate(); err != nil {
+		errs = append(errs, err)
+	}
+	return JoinErrors(errs...)
+}
+
+func (opts *AlterHybridTableOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if opts.RenameTo != nil && !ValidObjectIdentifier(opts.RenameTo) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if !exactlyOneValueSet(opts.RenameTo, opts.SetComment, opts.UnsetComment) {
+		errs = append(errs, errExactlyOneOf("AlterHybridTableOptions", "RenameTo", "SetComment", "UnsetComment"))
+	}
+	return JoinErrors(errs...)
+}
+
+func (opts *DropHybridTableOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	return JoinErrors(errs...)
+}
+
+func (opts *ShowHybridTableOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	return JoinErrors(errs...)
+}
+
+func (opts *CreateIndexHybridTableOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.TableName) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if everyValueSet(opts.OrReplace, opts.IfNotExists) {
+		errs = append(errs, errOneOf("CreateIndexHybridTableOptions", "OrReplace", "IfNotExists"))
+	}
+	if !valueSet(opts.Name) {
+		errs = append(errs, errNotSet("CreateIndexHybridTableOptions", "Name"))
+	}
+	if !valueSet(opts.Columns) {
+		errs = append(errs, errNotSet("CreateIndexHybridTableOptions", "Columns"))
+	}
+	return JoinErrors(errs...)
+}
+
+func (opts *DropIndexHybridTableOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.Index) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	return JoinErrors(errs...)
+}
@@ -1,6 +1,11 @@
 package sdk
 
-import g "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/poc/generator"
+import (
+	"fmt"
+	"strings"
+
+	g "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/poc/generator"
+)
 
 //go:generate go run ./poc/main.go
 
@@ -10,9 +15,37 @@ const (
 	NetworkRuleTypeIpv4             NetworkRuleType = "IPV4"
 	NetworkRuleTypeAwsVpcEndpointId NetworkRuleType = "AWSVPCEID"
 	NetworkRuleTypeAzureLinkId      NetworkRuleType = "AZURELINKID"
+	NetworkRuleTypeGcpPscId         NetworkRuleType = "GCPPSCID"
 	NetworkRuleTypeHostPort         NetworkRuleType = "HOST_PORT"
+	NetworkRuleTypePrivateHostPort  NetworkRuleType = "PRIVATE_HOST_PORT"
+	NetworkRuleTypeIdentifier       NetworkRuleType = "IDENTIFIER"
 )
 
+var AllNetworkRuleTypes = []NetworkRuleType{
+	NetworkRuleTypeIpv4,
+	NetworkRuleTypeAwsVpcEndpointId,
+	NetworkRuleTypeAzureLinkId,
+	NetworkRuleTypeGcpPscId,
+	NetworkRuleTypeHostPort,
+	NetworkRuleTypePrivateHostPort,
+	NetworkRuleTypeIdentifier,
+}
+
+func ToNetworkRuleType(s string) (NetworkRuleType, error) {
+	switch networkRuleType := NetworkRuleType(strings.ToUpper(s)); networkRuleType {
+	case NetworkRuleTypeIpv4,
+		NetworkRuleTypeAwsVpcEndpointId,
+		NetworkRuleTypeAzureLinkId,
+		NetworkRuleTypeGcpPscId,
+		NetworkRuleTypeHostPort,
+		NetworkRuleTypePrivateHostPort,
+		NetworkRuleTypeIdentifier:
+		return networkRuleType, nil
+	default:
+		return "", fmt.Errorf("invalid network rule type: %s", s)
+	}
+}
+
 type NetworkRuleMode string
 
 const (
@@ -21,6 +54,23 @@ const (
 	NetworkRuleModeEgress        NetworkRuleMode = "EGRESS"
 )
 
+var AllNetworkRuleModes = []NetworkRuleMode{
+	NetworkRuleModeIngress,
+	NetworkRuleModeInternalStage,
+	NetworkRuleModeEgress,
+}
+
+func ToNetworkRuleMode(s string) (NetworkRuleMode, error) {
+	switch networkRuleMode := NetworkRuleMode(strings.ToUpper(s)); networkRuleMode {
+	case NetworkRuleModeIngress,
+		NetworkRuleModeInternalStage,
+		NetworkRuleModeEgress:
+		return networkRuleMode, nil
+	default:
+		return "", fmt.Errorf("invalid network rule mode: %s", s)
+	}
+}
+
 var NetworkRuleDef = g.NewInterface(
 	"NetworkRules",
 	"NetworkRule",
@@ -1,6 +1,10 @@
 package sdk
 
-import "testing"
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
 
 func TestNetworkRules_Create(t *testing.T) {
 	id := randomSchemaObjectIdentifier()
@@ -193,3 +197,59 @@ func TestNetworkRules_Describe(t *testing.T) {
 		assertOptsValidAndSQLEquals(t, opts, `DESCRIBE NETWORK RULE %s`, id.FullyQualifiedName())
 	})
 }
+
+func TestToNetworkRuleType(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    NetworkRuleType
+		wantErr string
+	}{
+		{input: "IPV4", want: NetworkRuleTypeIpv4},
+		{input: "AWSVPCEID", want: NetworkRuleTypeAwsVpcEndpointId},
+		{input: "AZURELINKID", want: NetworkRuleTypeAzureLinkId},
+		{input: "GCPPSCID", want: NetworkRuleTypeGcpPscId},
+		{input: "HOST_PORT", want: NetworkRuleTypeHostPort},
+		{input: "PRIVATE_HOST_PORT", want: NetworkRuleTypePrivateHostPort},
+		{input: "IDENTIFIER", want: NetworkRuleTypeIdentifier},
+		{input: "host_port", want: NetworkRuleTypeHostPort},
+		{input: "", wantErr: "invalid network rule type"},
+		{input: "foo", wantErr: "invalid network rule type"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := ToNetworkRuleType(tt.input)
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestToNetworkRuleMode(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    NetworkRuleMode
+		wantErr string
+	}{
+		{input: "INGRESS", want: NetworkRuleModeIngress},
+		{input: "INTERNAL_STAGE", want: NetworkRuleModeInternalStage},
+		{input: "EGRESS", want: NetworkRuleModeEgress},
+		{input: "egress", want: NetworkRuleModeEgress},
+		{input: "", wantErr: "invalid network rule mode"},
+		{input: "foo", wantErr: "invalid network rule mode"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := ToNetworkRuleMode(tt.input)
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
@@ -7,6 +7,10 @@ import g "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/poc/gen
 var NotificationIntegrationAllowedRecipientDef = g.NewQueryStruct("NotificationIntegrationAllowedRecipient").
 	Text("Email", g.KeywordOptions().SingleQuotes().Required())
 
+var NotificationIntegrationWebhookHeaderDef = g.NewQueryStruct("NotificationIntegrationWebhookHeader").
+	Text("Name", g.KeywordOptions().SingleQuotes().Required()).
+	PredefinedQueryStructField("Value", g.KindOfT[string](), g.ParameterOptions().SingleQuotes().Required())
+
 // TODO [SNOW-1016561]: all integrations reuse almost the same show, drop, and describe. For now we are copying it. Consider reusing in linked issue.
 var NotificationIntegrationsDef = g.NewInterface(
 	"NotificationIntegrations",
@@ -82,11 +86,23 @@ var NotificationIntegrationsDef = g.NewInterface(
 					ListAssignment("ALLOWED_RECIPIENTS", "NotificationIntegrationAllowedRecipient", g.ParameterOptions().Parentheses()),
 				g.KeywordOptions(),
 			).
+			OptionalQueryStructField(
+				"WebhookParams",
+				g.NewQueryStruct("WebhookParams").
+					PredefinedQueryStructField("notificationType", "string", g.StaticOptions().SQL("TYPE = WEBHOOK")).
+					TextAssignment("WEBHOOK_URL", g.ParameterOptions().SingleQuotes().Required()).
+					OptionalIdentifier("WebhookSecret", g.KindOfTPointer[SchemaObjectIdentifier](), g.IdentifierOptions().Equals().SQL("WEBHOOK_SECRET")).
+					OptionalTextAssignment("WEBHOOK_BODY_TEMPLATE", g.ParameterOptions().SingleQuotes()).
+					ListAssignment("WEBHOOK_HEADERS", "NotificationIntegrationWebhookHeader", g.ParameterOptions().Parentheses()).
+					WithValidation(g.ValidIdentifierIfSet, "WebhookSecret"),
+				g.KeywordOptions(),
+			).
 			OptionalComment().
 			WithValidation(g.ValidIdentifier, "name").
 			WithValidation(g.ConflictingFields, "IfNotExists", "OrReplace").
-			WithValidation(g.ExactlyOneValueSet, "AutomatedDataLoadsParams", "PushNotificationParams", "EmailParams"),
+			WithValidation(g.ExactlyOneValueSet, "AutomatedDataLoadsParams", "PushNotificationParams", "EmailParams", "WebhookParams"),
 		NotificationIntegrationAllowedRecipientDef,
+		NotificationIntegrationWebhookHeaderDef,
 	).
 	AlterOperation(
 		"https://docs.snowflake.com/en/sql-reference/sql/alter-notification-integration",
@@ -132,12 +148,25 @@ var NotificationIntegrationsDef = g.NewInterface(
 							WithValidation(g.ValidateValueSet, "AllowedRecipients"),
 						g.KeywordOptions(),
 					).
+					OptionalQueryStructField(
+						"SetWebhookParams",
+						g.NewQueryStruct("SetWebhookParams").
+							OptionalTextAssignment("WEBHOOK_URL", g.ParameterOptions().SingleQuotes()).
+							OptionalIdentifier("WebhookSecret", g.KindOfTPointer[SchemaObjectIdentifier](), g.IdentifierOptions().Equals().SQL("WEBHOOK_SECRET")).
+							OptionalTextAssignment("WEBHOOK_BODY_TEMPLATE", g.ParameterOptions().SingleQuotes()).
+							ListAssignment("WEBHOOK_HEADERS", "NotificationIntegrationWebhookHeader", g.ParameterOptions().Parentheses()).
+							WithValidation(g.ValidIdentifierIfSet, "WebhookSecret").
+							WithValidation(g.AtLeastOneValueSet, "WebhookUrl", "WebhookSecret", "WebhookBodyTemplate", "WebhookHeaders"),
+						g.KeywordOptions(),
+					).
 					OptionalComment().
 					WithValidation(g.ConflictingFields, "SetPushParams", "SetEmailParams").
-					WithValidation(g.AtLeastOneValueSet, "Enabled", "SetPushParams", "SetEmailParams", "Comment"),
+					WithValidation(g.ConflictingFields, "SetPushParams", "SetWebhookParams").
+					WithValidation(g.ConflictingFields, "SetEmailParams", "SetWebhookParams").
+					WithValidation(g.AtLeastOneValueSet, "Enabled", "SetPushParams", "SetEmailParams", "SetWebhookParams", "Comment"),
 				g.KeywordOptions().SQL("SET"),
 			).
-			// UNSET is supported only for the email notifications
+			// UNSET is supported only for the email and webhook notifications
 			OptionalQueryStructField(
 				"UnsetEmailParams",
 				g.NewQueryStruct("NotificationIntegrationUnsetEmailParams").
@@ -146,10 +175,20 @@ var NotificationIntegrationsDef = g.NewInterface(
 					WithValidation(g.AtLeastOneValueSet, "AllowedRecipients", "Comment"),
 				g.ListOptions().NoParentheses().SQL("UNSET"),
 			).
+			OptionalQueryStructField(
+				"UnsetWebhookParams",
+				g.NewQueryStruct("NotificationIntegrationUnsetWebhookParams").
+					OptionalSQL("WEBHOOK_SECRET").
+					OptionalSQL("WEBHOOK_BODY_TEMPLATE").
+					OptionalSQL("WEBHOOK_HEADERS").
+					OptionalSQL("COMMENT").
+					WithValidation(g.AtLeastOneValueSet, "WebhookSecret", "WebhookBodyTemplate", "WebhookHeaders", "Comment"),
+				g.ListOptions().NoParentheses().SQL("UNSET"),
+			).
 			OptionalSetTags().
 			OptionalUnsetTags().
 			WithValidation(g.ValidIdentifier, "name").
-			WithValidation(g.ExactlyOneValueSet, "Set", "UnsetEmailParams", "SetTags", "UnsetTags"),
+			WithValidation(g.ExactlyOneValueSet, "Set", "UnsetEmailParams", "UnsetWebhookParams", "SetTags", "UnsetTags"),
 	).
 	DropOperation(
 		"https://docs.snowflake.com/en/sql-reference/sql/drop-integration",
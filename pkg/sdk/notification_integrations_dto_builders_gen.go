@@ -37,6 +37,11 @@ func (s *CreateNotificationIntegrationRequest) WithEmailParams(EmailParams *Emai
 	return s
 }
 
+func (s *CreateNotificationIntegrationRequest) WithWebhookParams(WebhookParams *WebhookParamsRequest) *CreateNotificationIntegrationRequest {
+	s.WebhookParams = WebhookParams
+	return s
+}
+
 func (s *CreateNotificationIntegrationRequest) WithComment(Comment *string) *CreateNotificationIntegrationRequest {
 	s.Comment = Comment
 	return s
@@ -130,6 +135,29 @@ func (s *EmailParamsRequest) WithAllowedRecipients(AllowedRecipients []Notificat
 	return s
 }
 
+func NewWebhookParamsRequest(
+	WebhookUrl string,
+) *WebhookParamsRequest {
+	s := WebhookParamsRequest{}
+	s.WebhookUrl = WebhookUrl
+	return &s
+}
+
+func (s *WebhookParamsRequest) WithWebhookSecret(WebhookSecret *SchemaObjectIdentifier) *WebhookParamsRequest {
+	s.WebhookSecret = WebhookSecret
+	return s
+}
+
+func (s *WebhookParamsRequest) WithWebhookBodyTemplate(WebhookBodyTemplate *string) *WebhookParamsRequest {
+	s.WebhookBodyTemplate = WebhookBodyTemplate
+	return s
+}
+
+func (s *WebhookParamsRequest) WithWebhookHeaders(WebhookHeaders []NotificationIntegrationWebhookHeader) *WebhookParamsRequest {
+	s.WebhookHeaders = WebhookHeaders
+	return s
+}
+
 func NewAlterNotificationIntegrationRequest(
 	name AccountObjectIdentifier,
 ) *AlterNotificationIntegrationRequest {
@@ -153,6 +181,11 @@ func (s *AlterNotificationIntegrationRequest) WithUnsetEmailParams(UnsetEmailPar
 	return s
 }
 
+func (s *AlterNotificationIntegrationRequest) WithUnsetWebhookParams(UnsetWebhookParams *NotificationIntegrationUnsetWebhookParamsRequest) *AlterNotificationIntegrationRequest {
+	s.UnsetWebhookParams = UnsetWebhookParams
+	return s
+}
+
 func (s *AlterNotificationIntegrationRequest) WithSetTags(SetTags []TagAssociation) *AlterNotificationIntegrationRequest {
 	s.SetTags = SetTags
 	return s
@@ -182,6 +215,11 @@ func (s *NotificationIntegrationSetRequest) WithSetEmailParams(SetEmailParams *S
 	return s
 }
 
+func (s *NotificationIntegrationSetRequest) WithSetWebhookParams(SetWebhookParams *SetWebhookParamsRequest) *NotificationIntegrationSetRequest {
+	s.SetWebhookParams = SetWebhookParams
+	return s
+}
+
 func (s *NotificationIntegrationSetRequest) WithComment(Comment *string) *NotificationIntegrationSetRequest {
 	s.Comment = Comment
 	return s
@@ -242,6 +280,30 @@ func NewSetEmailParamsRequest(
 	return &s
 }
 
+func NewSetWebhookParamsRequest() *SetWebhookParamsRequest {
+	return &SetWebhookParamsRequest{}
+}
+
+func (s *SetWebhookParamsRequest) WithWebhookUrl(WebhookUrl *string) *SetWebhookParamsRequest {
+	s.WebhookUrl = WebhookUrl
+	return s
+}
+
+func (s *SetWebhookParamsRequest) WithWebhookSecret(WebhookSecret *SchemaObjectIdentifier) *SetWebhookParamsRequest {
+	s.WebhookSecret = WebhookSecret
+	return s
+}
+
+func (s *SetWebhookParamsRequest) WithWebhookBodyTemplate(WebhookBodyTemplate *string) *SetWebhookParamsRequest {
+	s.WebhookBodyTemplate = WebhookBodyTemplate
+	return s
+}
+
+func (s *SetWebhookParamsRequest) WithWebhookHeaders(WebhookHeaders []NotificationIntegrationWebhookHeader) *SetWebhookParamsRequest {
+	s.WebhookHeaders = WebhookHeaders
+	return s
+}
+
 func NewNotificationIntegrationUnsetEmailParamsRequest() *NotificationIntegrationUnsetEmailParamsRequest {
 	return &NotificationIntegrationUnsetEmailParamsRequest{}
 }
@@ -256,6 +318,30 @@ func (s *NotificationIntegrationUnsetEmailParamsRequest) WithComment(Comment *bo
 	return s
 }
 
+func NewNotificationIntegrationUnsetWebhookParamsRequest() *NotificationIntegrationUnsetWebhookParamsRequest {
+	return &NotificationIntegrationUnsetWebhookParamsRequest{}
+}
+
+func (s *NotificationIntegrationUnsetWebhookParamsRequest) WithWebhookSecret(WebhookSecret *bool) *NotificationIntegrationUnsetWebhookParamsRequest {
+	s.WebhookSecret = WebhookSecret
+	return s
+}
+
+func (s *NotificationIntegrationUnsetWebhookParamsRequest) WithWebhookBodyTemplate(WebhookBodyTemplate *bool) *NotificationIntegrationUnsetWebhookParamsRequest {
+	s.WebhookBodyTemplate = WebhookBodyTemplate
+	return s
+}
+
+func (s *NotificationIntegrationUnsetWebhookParamsRequest) WithWebhookHeaders(WebhookHeaders *bool) *NotificationIntegrationUnsetWebhookParamsRequest {
+	s.WebhookHeaders = WebhookHeaders
+	return s
+}
+
+func (s *NotificationIntegrationUnsetWebhookParamsRequest) WithComment(Comment *bool) *NotificationIntegrationUnsetWebhookParamsRequest {
+	s.Comment = Comment
+	return s
+}
+
 func NewDropNotificationIntegrationRequest(
 	name AccountObjectIdentifier,
 ) *DropNotificationIntegrationRequest {
@@ -106,6 +106,14 @@ func (r *CreateNotificationIntegrationRequest) toOpts() *CreateNotificationInteg
 			AllowedRecipients: r.EmailParams.AllowedRecipients,
 		}
 	}
+	if r.WebhookParams != nil {
+		opts.WebhookParams = &WebhookParams{
+			WebhookUrl:          r.WebhookParams.WebhookUrl,
+			WebhookSecret:       r.WebhookParams.WebhookSecret,
+			WebhookBodyTemplate: r.WebhookParams.WebhookBodyTemplate,
+			WebhookHeaders:      r.WebhookParams.WebhookHeaders,
+		}
+	}
 	return opts
 }
 
@@ -148,6 +156,14 @@ func (r *AlterNotificationIntegrationRequest) toOpts() *AlterNotificationIntegra
 				AllowedRecipients: r.Set.SetEmailParams.AllowedRecipients,
 			}
 		}
+		if r.Set.SetWebhookParams != nil {
+			opts.Set.SetWebhookParams = &SetWebhookParams{
+				WebhookUrl:          r.Set.SetWebhookParams.WebhookUrl,
+				WebhookSecret:       r.Set.SetWebhookParams.WebhookSecret,
+				WebhookBodyTemplate: r.Set.SetWebhookParams.WebhookBodyTemplate,
+				WebhookHeaders:      r.Set.SetWebhookParams.WebhookHeaders,
+			}
+		}
 	}
 	if r.UnsetEmailParams != nil {
 		opts.UnsetEmailParams = &NotificationIntegrationUnsetEmailParams{
@@ -155,6 +171,14 @@ func (r *AlterNotificationIntegrationRequest) toOpts() *AlterNotificationIntegra
 			Comment:           r.UnsetEmailParams.Comment,
 		}
 	}
+	if r.UnsetWebhookParams != nil {
+		opts.UnsetWebhookParams = &NotificationIntegrationUnsetWebhookParams{
+			WebhookSecret:       r.UnsetWebhookParams.WebhookSecret,
+			WebhookBodyTemplate: r.UnsetWebhookParams.WebhookBodyTemplate,
+			WebhookHeaders:      r.UnsetWebhookParams.WebhookHeaders,
+			Comment:             r.UnsetWebhookParams.Comment,
+		}
+	}
 	return opts
 }
 
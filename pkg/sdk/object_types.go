@@ -16,70 +16,71 @@ type Object struct {
 type ObjectType string
 
 const (
-	ObjectTypeAccount              ObjectType = "ACCOUNT"
-	ObjectTypeManagedAccount       ObjectType = "MANAGED ACCOUNT"
-	ObjectTypeUser                 ObjectType = "USER"
-	ObjectTypeDatabaseRole         ObjectType = "DATABASE ROLE"
-	ObjectTypeDataset              ObjectType = "DATASET"
-	ObjectTypeRole                 ObjectType = "ROLE"
-	ObjectTypeIntegration          ObjectType = "INTEGRATION"
-	ObjectTypeNetworkPolicy        ObjectType = "NETWORK POLICY"
-	ObjectTypePasswordPolicy       ObjectType = "PASSWORD POLICY"
-	ObjectTypeSessionPolicy        ObjectType = "SESSION POLICY"
-	ObjectTypePrivacyPolicy        ObjectType = "PRIVACY POLICY"
-	ObjectTypeReplicationGroup     ObjectType = "REPLICATION GROUP"
-	ObjectTypeFailoverGroup        ObjectType = "FAILOVER GROUP"
-	ObjectTypeConnection           ObjectType = "CONNECTION"
-	ObjectTypeParameter            ObjectType = "PARAMETER"
-	ObjectTypeWarehouse            ObjectType = "WAREHOUSE"
-	ObjectTypeResourceMonitor      ObjectType = "RESOURCE MONITOR"
-	ObjectTypeDatabase             ObjectType = "DATABASE"
-	ObjectTypeSchema               ObjectType = "SCHEMA"
-	ObjectTypeShare                ObjectType = "SHARE"
-	ObjectTypeTable                ObjectType = "TABLE"
-	ObjectTypeDynamicTable         ObjectType = "DYNAMIC TABLE"
-	ObjectTypeCortexSearchService  ObjectType = "CORTEX SEARCH SERVICE"
-	ObjectTypeExternalTable        ObjectType = "EXTERNAL TABLE"
-	ObjectTypeEventTable           ObjectType = "EVENT TABLE"
-	ObjectTypeView                 ObjectType = "VIEW"
-	ObjectTypeMaterializedView     ObjectType = "MATERIALIZED VIEW"
-	ObjectTypeSequence             ObjectType = "SEQUENCE"
-	ObjectTypeSnapshot             ObjectType = "SNAPSHOT"
-	ObjectTypeFunction             ObjectType = "FUNCTION"
-	ObjectTypeExternalFunction     ObjectType = "EXTERNAL FUNCTION"
-	ObjectTypeProcedure            ObjectType = "PROCEDURE"
-	ObjectTypeStream               ObjectType = "STREAM"
-	ObjectTypeTask                 ObjectType = "TASK"
-	ObjectTypeMaskingPolicy        ObjectType = "MASKING POLICY"
-	ObjectTypeRowAccessPolicy      ObjectType = "ROW ACCESS POLICY"
-	ObjectTypeTag                  ObjectType = "TAG"
-	ObjectTypeSecret               ObjectType = "SECRET"
-	ObjectTypeStage                ObjectType = "STAGE"
-	ObjectTypeFileFormat           ObjectType = "FILE FORMAT"
-	ObjectTypePipe                 ObjectType = "PIPE"
-	ObjectTypeAlert                ObjectType = "ALERT"
-	ObjectTypeBudget               ObjectType = "SNOWFLAKE.CORE.BUDGET"
-	ObjectTypeClassification       ObjectType = "SNOWFLAKE.ML.CLASSIFICATION"
-	ObjectTypeApplication          ObjectType = "APPLICATION"
-	ObjectTypeApplicationPackage   ObjectType = "APPLICATION PACKAGE"
-	ObjectTypeApplicationRole      ObjectType = "APPLICATION ROLE"
-	ObjectTypeStreamlit            ObjectType = "STREAMLIT"
-	ObjectTypeColumn               ObjectType = "COLUMN"
-	ObjectTypeIcebergTable         ObjectType = "ICEBERG TABLE"
-	ObjectTypeExternalVolume       ObjectType = "EXTERNAL VOLUME"
-	ObjectTypeNetworkRule          ObjectType = "NETWORK RULE"
-	ObjectTypeNotebook             ObjectType = "NOTEBOOK"
-	ObjectTypePackagesPolicy       ObjectType = "PACKAGES POLICY"
-	ObjectTypeComputePool          ObjectType = "COMPUTE POOL"
-	ObjectTypeAggregationPolicy    ObjectType = "AGGREGATION POLICY"
-	ObjectTypeAuthenticationPolicy ObjectType = "AUTHENTICATION POLICY"
-	ObjectTypeHybridTable          ObjectType = "HYBRID TABLE"
-	ObjectTypeImageRepository      ObjectType = "IMAGE REPOSITORY"
-	ObjectTypeProjectionPolicy     ObjectType = "PROJECTION POLICY"
-	ObjectTypeDataMetricFunction   ObjectType = "DATA METRIC FUNCTION"
-	ObjectTypeGitRepository        ObjectType = "GIT REPOSITORY"
-	ObjectTypeModel                ObjectType = "MODEL"
-	ObjectTypeService              ObjectType = "SERVICE"
+	ObjectTypeAccount                 ObjectType = "ACCOUNT"
+	ObjectTypeManagedAccount          ObjectType = "MANAGED ACCOUNT"
+	ObjectTypeUser                    ObjectType = "USER"
+	ObjectTypeDatabaseRole            ObjectType = "DATABASE ROLE"
+	ObjectTypeDataset                 ObjectType = "DATASET"
+	ObjectTypeRole                    ObjectType = "ROLE"
+	ObjectTypeIntegration             ObjectType = "INTEGRATION"
+	ObjectTypeNetworkPolicy           ObjectType = "NETWORK POLICY"
+	ObjectTypePasswordPolicy          ObjectType = "PASSWORD POLICY"
+	ObjectTypeSessionPolicy           ObjectType = "SESSION POLICY"
+	ObjectTypePrivacyPolicy           ObjectType = "PRIVACY POLICY"
+	ObjectTypeReplicationGroup        ObjectType = "REPLICATION GROUP"
+	ObjectTypeFailoverGroup           ObjectType = "FAILOVER GROUP"
+	ObjectTypeConnection              ObjectType = "CONNECTION"
+	ObjectTypeParameter               ObjectType = "PARAMETER"
+	ObjectTypeWarehouse               ObjectType = "WAREHOUSE"
+	ObjectTypeResourceMonitor         ObjectType = "RESOURCE MONITOR"
+	ObjectTypeDatabase                ObjectType = "DATABASE"
+	ObjectTypeSchema                  ObjectType = "SCHEMA"
+	ObjectTypeShare                   ObjectType = "SHARE"
+	ObjectTypeTable                   ObjectType = "TABLE"
+	ObjectTypeDynamicTable            ObjectType = "DYNAMIC TABLE"
+	ObjectTypeCortexSearchService     ObjectType = "CORTEX SEARCH SERVICE"
+	ObjectTypeExternalTable           ObjectType = "EXTERNAL TABLE"
+	ObjectTypeEventTable              ObjectType = "EVENT TABLE"
+	ObjectTypeView                    ObjectType = "VIEW"
+	ObjectTypeMaterializedView        ObjectType = "MATERIALIZED VIEW"
+	ObjectTypeSequence                ObjectType = "SEQUENCE"
+	ObjectTypeSnapshot                ObjectType = "SNAPSHOT"
+	ObjectTypeFunction                ObjectType = "FUNCTION"
+	ObjectTypeExternalFunction        ObjectType = "EXTERNAL FUNCTION"
+	ObjectTypeProcedure               ObjectType = "PROCEDURE"
+	ObjectTypeStream                  ObjectType = "STREAM"
+	ObjectTypeTask                    ObjectType = "TASK"
+	ObjectTypeMaskingPolicy           ObjectType = "MASKING POLICY"
+	ObjectTypeRowAccessPolicy         ObjectType = "ROW ACCESS POLICY"
+	ObjectTypeTag                     ObjectType = "TAG"
+	ObjectTypeSecret                  ObjectType = "SECRET"
+	ObjectTypeStage                   ObjectType = "STAGE"
+	ObjectTypeFileFormat              ObjectType = "FILE FORMAT"
+	ObjectTypePipe                    ObjectType = "PIPE"
+	ObjectTypeAlert                   ObjectType = "ALERT"
+	ObjectTypeBudget                  ObjectType = "SNOWFLAKE.CORE.BUDGET"
+	ObjectTypeClassification          ObjectType = "SNOWFLAKE.ML.CLASSIFICATION"
+	ObjectTypeApplication             ObjectType = "APPLICATION"
+	ObjectTypeApplicationPackage      ObjectType = "APPLICATION PACKAGE"
+	ObjectTypeApplicationRole         ObjectType = "APPLICATION ROLE"
+	ObjectTypeStreamlit               ObjectType = "STREAMLIT"
+	ObjectTypeColumn                  ObjectType = "COLUMN"
+	ObjectTypeIcebergTable            ObjectType = "ICEBERG TABLE"
+	ObjectTypeExternalVolume          ObjectType = "EXTERNAL VOLUME"
+	ObjectTypeNetworkRule             ObjectType = "NETWORK RULE"
+	ObjectTypeNotebook                ObjectType = "NOTEBOOK"
+	ObjectTypePackagesPolicy          ObjectType = "PACKAGES POLICY"
+	ObjectTypeComputePool             ObjectType = "COMPUTE POOL"
+	ObjectTypeAggregationPolicy       ObjectType = "AGGREGATION POLICY"
+	ObjectTypeAuthenticationPolicy    ObjectType = "AUTHENTICATION POLICY"
+	ObjectTypeHybridTable             ObjectType = "HYBRID TABLE"
+	ObjectTypeImageRepository         ObjectType = "IMAGE REPOSITORY"
+	ObjectTypeProjectionPolicy        ObjectType = "PROJECTION POLICY"
+	ObjectTypeDataMetricFunction      ObjectType = "DATA METRIC FUNCTION"
+	ObjectTypeGitRepository           ObjectType = "GIT REPOSITORY"
+	ObjectTypeModel                   ObjectType = "MODEL"
+	ObjectTypeService                 ObjectType = "SERVICE"
+	ObjectTypeProgrammaticAccessToken ObjectType = "PROGRAMMATIC ACCESS TOKEN"
 )
 
 func (o ObjectType) String() string {
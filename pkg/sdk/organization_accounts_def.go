@@ -0,0 +1,161 @@
+package sdk
+
+import (
+	"fmt"
+	"strings"
+
+	g "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/poc/generator"
+)
+
+//go:generate go run ./poc/main.go
+
+type OrganizationAccountEdition string
+
+const (
+	OrganizationAccountEditionEnterprise       OrganizationAccountEdition = "ENTERPRISE"
+	OrganizationAccountEditionBusinessCritical OrganizationAccountEdition = "BUSINESS_CRITICAL"
+)
+
+var AllOrganizationAccountEditions = []OrganizationAccountEdition{
+	OrganizationAccountEditionEnterprise,
+	OrganizationAccountEditionBusinessCritical,
+}
+
+func ToOrganizationAccountEdition(s string) (OrganizationAccountEdition, error) {
+	switch edition := OrganizationAccountEdition(strings.ToUpper(s)); edition {
+	case OrganizationAccountEditionEnterprise, OrganizationAccountEditionBusinessCritical:
+		return edition, nil
+	default:
+		return "", fmt.Errorf("invalid organization account edition: %s", s)
+	}
+}
+
+var organizationAccountDbRow = g.DbStruct("organizationAccountDBRow").
+	Text("organization_name").
+	Text("account_name").
+	Text("snowflake_region").
+	OptionalText("edition").
+	OptionalText("account_url").
+	Time("created_on").
+	OptionalText("comment").
+	Text("account_locator").
+	OptionalText("account_locator_url").
+	OptionalNumber("managed_accounts").
+	OptionalText("consumption_billing_entity_name").
+	OptionalText("marketplace_consumer_billing_entity_name").
+	OptionalText("marketplace_provider_billing_entity_name").
+	OptionalText("old_account_url").
+	OptionalBool("is_org_admin").
+	Field("account_old_url_saved_on", "sql.NullTime").
+	Field("account_old_url_last_used", "sql.NullTime").
+	OptionalText("organization_old_url").
+	Field("organization_old_url_saved_on", "sql.NullTime").
+	Field("organization_old_url_last_used", "sql.NullTime").
+	OptionalBool("is_events_account").
+	Bool("is_organization_account")
+
+var organizationAccount = g.PlainStruct("OrganizationAccount").
+	Text("OrganizationName").
+	Text("AccountName").
+	Text("SnowflakeRegion").
+	Field("Edition", "*OrganizationAccountEdition").
+	OptionalText("AccountUrl").
+	Time("CreatedOn").
+	OptionalText("Comment").
+	Text("AccountLocator").
+	OptionalText("AccountLocatorUrl").
+	OptionalNumber("ManagedAccounts").
+	OptionalText("ConsumptionBillingEntityName").
+	OptionalText("MarketplaceConsumerBillingEntityName").
+	OptionalText("MarketplaceProviderBillingEntityName").
+	OptionalText("OldAccountUrl").
+	OptionalBool("IsOrgAdmin").
+	Field("AccountOldUrlSavedOn", "*time.Time").
+	Field("AccountOldUrlLastUsed", "*time.Time").
+	OptionalText("OrganizationOldUrl").
+	Field("OrganizationOldUrlSavedOn", "*time.Time").
+	Field("OrganizationOldUrlLastUsed", "*time.Time").
+	OptionalBool("IsEventsAccount").
+	Bool("IsOrganizationAccount")
+
+var OrganizationAccountsDef = g.NewInterface(
+	"OrganizationAccounts",
+	"OrganizationAccount",
+	g.KindOfT[AccountObjectIdentifier](),
+).
+	CreateOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/create-organization-account",
+		g.NewQueryStruct("CreateOrganizationAccount").
+			Create().
+			SQL("ORGANIZATION ACCOUNT").
+			Name().
+			TextAssignment("ADMIN_NAME", g.ParameterOptions().SingleQuotes().Required()).
+			OptionalTextAssignment("ADMIN_PASSWORD", g.ParameterOptions().SingleQuotes()).
+			OptionalTextAssignment("ADMIN_RSA_PUBLIC_KEY", g.ParameterOptions().SingleQuotes()).
+			OptionalAssignment("ADMIN_USER_TYPE", g.KindOfTPointer[UserType](), g.ParameterOptions()).
+			OptionalTextAssignment("FIRST_NAME", g.ParameterOptions().SingleQuotes()).
+			OptionalTextAssignment("LAST_NAME", g.ParameterOptions().SingleQuotes()).
+			TextAssignment("EMAIL", g.ParameterOptions().SingleQuotes().Required()).
+			OptionalBooleanAssignment("MUST_CHANGE_PASSWORD", g.ParameterOptions()).
+			Assignment("EDITION", g.KindOfT[OrganizationAccountEdition](), g.ParameterOptions().Required()).
+			OptionalTextAssignment("REGION_GROUP", g.ParameterOptions()).
+			OptionalTextAssignment("REGION", g.ParameterOptions()).
+			OptionalComment().
+			WithValidation(g.ValidIdentifier, "name").
+			WithValidation(g.ValidateValueSet, "AdminName").
+			WithValidation(g.ValidateValueSet, "Email").
+			WithValidation(g.ValidateValueSet, "Edition").
+			WithValidation(g.AtLeastOneValueSet, "AdminPassword", "AdminRsaPublicKey"),
+	).
+	AlterOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/alter-organization-account",
+		g.NewQueryStruct("AlterOrganizationAccount").
+			Alter().
+			SQL("ORGANIZATION ACCOUNT").
+			Name().
+			OptionalQueryStructField(
+				"Set",
+				g.NewQueryStruct("OrganizationAccountSet").
+					OptionalIdentifier("ResourceMonitor", g.KindOfTPointer[AccountObjectIdentifier](), g.IdentifierOptions().Equals().SQL("RESOURCE_MONITOR")).
+					OptionalIdentifier("PasswordPolicy", g.KindOfTPointer[SchemaObjectIdentifier](), g.IdentifierOptions().SQL("PASSWORD POLICY")).
+					OptionalIdentifier("SessionPolicy", g.KindOfTPointer[SchemaObjectIdentifier](), g.IdentifierOptions().SQL("SESSION POLICY")).
+					WithValidation(g.ExactlyOneValueSet, "ResourceMonitor", "PasswordPolicy", "SessionPolicy").
+					WithValidation(g.ValidIdentifierIfSet, "PasswordPolicy").
+					WithValidation(g.ValidIdentifierIfSet, "SessionPolicy"),
+				g.KeywordOptions().SQL("SET"),
+			).
+			OptionalQueryStructField(
+				"Unset",
+				g.NewQueryStruct("OrganizationAccountUnset").
+					OptionalSQL("RESOURCE_MONITOR").
+					OptionalSQL("PASSWORD POLICY").
+					OptionalSQL("SESSION POLICY").
+					WithValidation(g.ExactlyOneValueSet, "ResourceMonitor", "PasswordPolicy", "SessionPolicy"),
+				g.KeywordOptions().SQL("UNSET"),
+			).
+			OptionalSetTags().
+			OptionalUnsetTags().
+			OptionalQueryStructField(
+				"RenameTo",
+				g.NewQueryStruct("OrganizationAccountRename").
+					Identifier("NewName", g.KindOfT[AccountObjectIdentifier](), g.IdentifierOptions().Required()).
+					OptionalBooleanAssignment("SAVE_OLD_URL", g.ParameterOptions()).
+					WithValidation(g.ValidIdentifier, "NewName"),
+				g.KeywordOptions().SQL("RENAME TO"),
+			).
+			OptionalSQL("DROP OLD URL").
+			WithValidation(g.ValidIdentifier, "name").
+			WithValidation(g.ExactlyOneValueSet, "Set", "Unset", "SetTags", "UnsetTags", "RenameTo", "DropOldUrl"),
+	).
+	ShowOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/show-organization-accounts",
+		organizationAccountDbRow,
+		organizationAccount,
+		g.NewQueryStruct("ShowOrganizationAccounts").
+			Show().
+			SQL("ORGANIZATION ACCOUNTS").
+			OptionalLike(),
+	).
+	ShowByIdOperationWithFiltering(
+		g.ShowByIDLikeFiltering,
+	)
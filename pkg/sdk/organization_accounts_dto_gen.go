@@ -0,0 +1,56 @@
+package sdk
+
+//go:generate go run ./dto-builder-generator/main.go
+
+var (
+	_ optionsProvider[CreateOrganizationAccountOptions] = new(CreateOrganizationAccountRequest)
+	_ optionsProvider[AlterOrganizationAccountOptions]  = new(AlterOrganizationAccountRequest)
+	_ optionsProvider[ShowOrganizationAccountOptions]   = new(ShowOrganizationAccountRequest)
+)
+
+type CreateOrganizationAccountRequest struct {
+	name               AccountObjectIdentifier // required
+	AdminName          string                  // required
+	AdminPassword      *string
+	AdminRsaPublicKey  *string
+	AdminUserType      *UserType
+	FirstName          *string
+	LastName           *string
+	Email              string // required
+	MustChangePassword *bool
+	Edition            OrganizationAccountEdition // required
+	RegionGroup        *string
+	Region             *string
+	Comment            *string
+}
+
+type AlterOrganizationAccountRequest struct {
+	name       AccountObjectIdentifier // required
+	Set        *OrganizationAccountSetRequest
+	Unset      *OrganizationAccountUnsetRequest
+	SetTags    []TagAssociation
+	UnsetTags  []ObjectIdentifier
+	RenameTo   *OrganizationAccountRenameRequest
+	DropOldUrl *bool
+}
+
+type OrganizationAccountSetRequest struct {
+	ResourceMonitor *AccountObjectIdentifier
+	PasswordPolicy  *SchemaObjectIdentifier
+	SessionPolicy   *SchemaObjectIdentifier
+}
+
+type OrganizationAccountUnsetRequest struct {
+	ResourceMonitor *bool
+	PasswordPolicy  *bool
+	SessionPolicy   *bool
+}
+
+type OrganizationAccountRenameRequest struct {
+	NewName    AccountObjectIdentifier // required
+	SaveOldUrl *bool
+}
+
+type ShowOrganizationAccountRequest struct {
+	Like *Like
+}
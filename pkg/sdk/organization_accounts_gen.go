@@ -0,0 +1,124 @@
+package sdk
+
+import (
+	"context"
+	"database/sql"
+	"time"
+)
+
+type OrganizationAccounts interface {
+	Create(ctx context.Context, request *CreateOrganizationAccountRequest) error
+	Alter(ctx context.Context, request *AlterOrganizationAccountRequest) error
+	Show(ctx context.Context, request *ShowOrganizationAccountRequest) ([]OrganizationAccount, error)
+	ShowByID(ctx context.Context, id AccountObjectIdentifier) (*OrganizationAccount, error)
+}
+
+// CreateOrganizationAccountOptions is based on https://docs.snowflake.com/en/sql-reference/sql/create-organization-account.
+type CreateOrganizationAccountOptions struct {
+	create              bool                       `ddl:"static" sql:"CREATE"`
+	organizationAccount bool                       `ddl:"static" sql:"ORGANIZATION ACCOUNT"`
+	name                AccountObjectIdentifier    `ddl:"identifier"`
+	AdminName           string                     `ddl:"parameter,single_quotes" sql:"ADMIN_NAME"`
+	AdminPassword       *string                    `ddl:"parameter,single_quotes" sql:"ADMIN_PASSWORD"`
+	AdminRsaPublicKey   *string                    `ddl:"parameter,single_quotes" sql:"ADMIN_RSA_PUBLIC_KEY"`
+	AdminUserType       *UserType                  `ddl:"parameter" sql:"ADMIN_USER_TYPE"`
+	FirstName           *string                    `ddl:"parameter,single_quotes" sql:"FIRST_NAME"`
+	LastName            *string                    `ddl:"parameter,single_quotes" sql:"LAST_NAME"`
+	Email               string                     `ddl:"parameter,single_quotes" sql:"EMAIL"`
+	MustChangePassword  *bool                      `ddl:"parameter" sql:"MUST_CHANGE_PASSWORD"`
+	Edition             OrganizationAccountEdition `ddl:"parameter" sql:"EDITION"`
+	RegionGroup         *string                    `ddl:"parameter" sql:"REGION_GROUP"`
+	Region              *string                    `ddl:"parameter" sql:"REGION"`
+	Comment             *string                    `ddl:"parameter,single_quotes" sql:"COMMENT"`
+}
+
+// AlterOrganizationAccountOptions is based on https://docs.snowflake.com/en/sql-reference/sql/alter-organization-account.
+type AlterOrganizationAccountOptions struct {
+	alter               bool                       `ddl:"static" sql:"ALTER"`
+	organizationAccount bool                       `ddl:"static" sql:"ORGANIZATION ACCOUNT"`
+	name                AccountObjectIdentifier    `ddl:"identifier"`
+	Set                 *OrganizationAccountSet    `ddl:"keyword" sql:"SET"`
+	Unset               *OrganizationAccountUnset  `ddl:"keyword" sql:"UNSET"`
+	SetTags             []TagAssociation           `ddl:"keyword" sql:"SET TAG"`
+	UnsetTags           []ObjectIdentifier         `ddl:"keyword" sql:"UNSET TAG"`
+	RenameTo            *OrganizationAccountRename `ddl:"keyword" sql:"RENAME TO"`
+	DropOldUrl          *bool                      `ddl:"keyword" sql:"DROP OLD URL"`
+}
+
+type OrganizationAccountSet struct {
+	ResourceMonitor *AccountObjectIdentifier `ddl:"identifier,equals" sql:"RESOURCE_MONITOR"`
+	PasswordPolicy  *SchemaObjectIdentifier  `ddl:"identifier" sql:"PASSWORD POLICY"`
+	SessionPolicy   *SchemaObjectIdentifier  `ddl:"identifier" sql:"SESSION POLICY"`
+}
+
+type OrganizationAccountUnset struct {
+	ResourceMonitor *bool `ddl:"keyword" sql:"RESOURCE_MONITOR"`
+	PasswordPolicy  *bool `ddl:"keyword" sql:"PASSWORD POLICY"`
+	SessionPolicy   *bool `ddl:"keyword" sql:"SESSION POLICY"`
+}
+
+type OrganizationAccountRename struct {
+	NewName    AccountObjectIdentifier `ddl:"identifier"`
+	SaveOldUrl *bool                   `ddl:"parameter" sql:"SAVE_OLD_URL"`
+}
+
+// ShowOrganizationAccountOptions is based on https://docs.snowflake.com/en/sql-reference/sql/show-organization-accounts.
+type ShowOrganizationAccountOptions struct {
+	show                 bool  `ddl:"static" sql:"SHOW"`
+	organizationAccounts bool  `ddl:"static" sql:"ORGANIZATION ACCOUNTS"`
+	Like                 *Like `ddl:"keyword" sql:"LIKE"`
+}
+
+type organizationAccountDBRow struct {
+	OrganizationName                     string         `db:"organization_name"`
+	AccountName                          string         `db:"account_name"`
+	SnowflakeRegion                      string         `db:"snowflake_region"`
+	Edition                              sql.NullString `db:"edition"`
+	AccountUrl                           sql.NullString `db:"account_url"`
+	CreatedOn                            time.Time      `db:"created_on"`
+	Comment                              sql.NullString `db:"comment"`
+	AccountLocator                       string         `db:"account_locator"`
+	AccountLocatorUrl                    sql.NullString `db:"account_locator_url"`
+	ManagedAccounts                      sql.NullInt64  `db:"managed_accounts"`
+	ConsumptionBillingEntityName         sql.NullString `db:"consumption_billing_entity_name"`
+	MarketplaceConsumerBillingEntityName sql.NullString `db:"marketplace_consumer_billing_entity_name"`
+	MarketplaceProviderBillingEntityName sql.NullString `db:"marketplace_provider_billing_entity_name"`
+	OldAccountUrl                        sql.NullString `db:"old_account_url"`
+	IsOrgAdmin                           sql.NullBool   `db:"is_org_admin"`
+	AccountOldUrlSavedOn                 sql.NullTime   `db:"account_old_url_saved_on"`
+	AccountOldUrlLastUsed                sql.NullTime   `db:"account_old_url_last_used"`
+	OrganizationOldUrl                   sql.NullString `db:"organization_old_url"`
+	OrganizationOldUrlSavedOn            sql.NullTime   `db:"organization_old_url_saved_on"`
+	OrganizationOldUrlLastUsed           sql.NullTime   `db:"organization_old_url_last_used"`
+	IsEventsAccount                      sql.NullBool   `db:"is_events_account"`
+	IsOrganizationAccount                bool           `db:"is_organization_account"`
+}
+
+type OrganizationAccount struct {
+	OrganizationName                     string
+	AccountName                          string
+	SnowflakeRegion                      string
+	Edition                              *OrganizationAccountEdition
+	AccountUrl                           *string
+	CreatedOn                            time.Time
+	Comment                              *string
+	AccountLocator                       string
+	AccountLocatorUrl                    *string
+	ManagedAccounts                      *int
+	ConsumptionBillingEntityName         *string
+	MarketplaceConsumerBillingEntityName *string
+	MarketplaceProviderBillingEntityName *string
+	OldAccountUrl                        *string
+	IsOrgAdmin                           *bool
+	AccountOldUrlSavedOn                 *time.Time
+	AccountOldUrlLastUsed                *time.Time
+	OrganizationOldUrl                   *string
+	OrganizationOldUrlSavedOn            *time.Time
+	OrganizationOldUrlLastUsed           *time.Time
+	IsEventsAccount                      *bool
+	IsOrganizationAccount                bool
+}
+
+func (v *OrganizationAccount) ID() AccountObjectIdentifier {
+	return NewAccountObjectIdentifier(v.AccountName)
+}
@@ -0,0 +1,226 @@
+package sdk
+
+import "testing"
+
+func TestOrganizationAccounts_Create(t *testing.T) {
+	id := randomAccountObjectIdentifier()
+
+	// Minimal valid CreateOrganizationAccountOptions
+	defaultOpts := func() *CreateOrganizationAccountOptions {
+		return &CreateOrganizationAccountOptions{
+			name:          id,
+			AdminName:     "admin",
+			AdminPassword: String("password"),
+			Email:         "admin@example.com",
+			Edition:       OrganizationAccountEditionEnterprise,
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *CreateOrganizationAccountOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.name = emptyAccountObjectIdentifier
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("validation: [opts.AdminName] should be set", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.AdminName = ""
+		assertOptsInvalidJoinedErrors(t, opts, errNotSet("CreateOrganizationAccountOptions", "AdminName"))
+	})
+
+	t.Run("validation: [opts.Email] should be set", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Email = ""
+		assertOptsInvalidJoinedErrors(t, opts, errNotSet("CreateOrganizationAccountOptions", "Email"))
+	})
+
+	t.Run("validation: [opts.Edition] should be set", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Edition = ""
+		assertOptsInvalidJoinedErrors(t, opts, errNotSet("CreateOrganizationAccountOptions", "Edition"))
+	})
+
+	t.Run("validation: at least one of the fields [opts.AdminPassword opts.AdminRsaPublicKey] should be set", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.AdminPassword = nil
+		assertOptsInvalidJoinedErrors(t, opts, errAtLeastOneOf("CreateOrganizationAccountOptions", "AdminPassword", "AdminRsaPublicKey"))
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "CREATE ORGANIZATION ACCOUNT %s ADMIN_NAME = 'admin' ADMIN_PASSWORD = 'password' EMAIL = 'admin@example.com' EDITION = ENTERPRISE", id.FullyQualifiedName())
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.AdminPassword = nil
+		opts.AdminRsaPublicKey = String("key")
+		opts.AdminUserType = Pointer(UserTypeService)
+		opts.FirstName = String("first")
+		opts.LastName = String("last")
+		opts.MustChangePassword = Bool(true)
+		opts.Edition = OrganizationAccountEditionBusinessCritical
+		opts.RegionGroup = String("PUBLIC")
+		opts.Region = String("AWS_US_WEST_2")
+		opts.Comment = String("some comment")
+		assertOptsValidAndSQLEquals(t, opts, "CREATE ORGANIZATION ACCOUNT %s ADMIN_NAME = 'admin' ADMIN_RSA_PUBLIC_KEY = 'key' ADMIN_USER_TYPE = SERVICE FIRST_NAME = 'first' LAST_NAME = 'last' EMAIL = 'admin@example.com' MUST_CHANGE_PASSWORD = true EDITION = BUSINESS_CRITICAL REGION_GROUP = PUBLIC REGION = AWS_US_WEST_2 COMMENT = 'some comment'", id.FullyQualifiedName())
+	})
+}
+
+func TestOrganizationAccounts_Alter(t *testing.T) {
+	id := randomAccountObjectIdentifier()
+
+	// Minimal valid AlterOrganizationAccountOptions
+	defaultOpts := func() *AlterOrganizationAccountOptions {
+		return &AlterOrganizationAccountOptions{
+			name:       id,
+			DropOldUrl: Bool(true),
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *AlterOrganizationAccountOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.name = emptyAccountObjectIdentifier
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("validation: exactly one field from [opts.Set opts.Unset opts.SetTags opts.UnsetTags opts.RenameTo opts.DropOldUrl] should be present", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.DropOldUrl = nil
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("AlterOrganizationAccountOptions", "Set", "Unset", "SetTags", "UnsetTags", "RenameTo", "DropOldUrl"))
+	})
+
+	t.Run("validation: exactly one field from [opts.Set opts.Unset opts.SetTags opts.UnsetTags opts.RenameTo opts.DropOldUrl] should be present - more present", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Unset = &OrganizationAccountUnset{ResourceMonitor: Bool(true)}
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("AlterOrganizationAccountOptions", "Set", "Unset", "SetTags", "UnsetTags", "RenameTo", "DropOldUrl"))
+	})
+
+	t.Run("validation: exactly one field from [opts.Set.ResourceMonitor opts.Set.PasswordPolicy opts.Set.SessionPolicy] should be present", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.DropOldUrl = nil
+		opts.Set = &OrganizationAccountSet{}
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("AlterOrganizationAccountOptions.Set", "ResourceMonitor", "PasswordPolicy", "SessionPolicy"))
+	})
+
+	t.Run("validation: valid identifier for [opts.Set.PasswordPolicy] if set", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.DropOldUrl = nil
+		opts.Set = &OrganizationAccountSet{PasswordPolicy: &emptySchemaObjectIdentifier}
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("validation: valid identifier for [opts.Set.SessionPolicy] if set", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.DropOldUrl = nil
+		opts.Set = &OrganizationAccountSet{SessionPolicy: &emptySchemaObjectIdentifier}
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("validation: exactly one field from [opts.Unset.ResourceMonitor opts.Unset.PasswordPolicy opts.Unset.SessionPolicy] should be present", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.DropOldUrl = nil
+		opts.Unset = &OrganizationAccountUnset{ResourceMonitor: Bool(true), PasswordPolicy: Bool(true)}
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("AlterOrganizationAccountOptions.Unset", "ResourceMonitor", "PasswordPolicy", "SessionPolicy"))
+	})
+
+	t.Run("validation: valid identifier for [opts.RenameTo.NewName]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.DropOldUrl = nil
+		opts.RenameTo = &OrganizationAccountRename{NewName: emptyAccountObjectIdentifier}
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("drop old url", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "ALTER ORGANIZATION ACCOUNT %s DROP OLD URL", id.FullyQualifiedName())
+	})
+
+	t.Run("rename", func(t *testing.T) {
+		newId := randomAccountObjectIdentifier()
+		opts := defaultOpts()
+		opts.DropOldUrl = nil
+		opts.RenameTo = &OrganizationAccountRename{NewName: newId}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER ORGANIZATION ACCOUNT %s RENAME TO %s", id.FullyQualifiedName(), newId.FullyQualifiedName())
+	})
+
+	t.Run("rename with save old url", func(t *testing.T) {
+		newId := randomAccountObjectIdentifier()
+		opts := defaultOpts()
+		opts.DropOldUrl = nil
+		opts.RenameTo = &OrganizationAccountRename{NewName: newId, SaveOldUrl: Bool(false)}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER ORGANIZATION ACCOUNT %s RENAME TO %s SAVE_OLD_URL = false", id.FullyQualifiedName(), newId.FullyQualifiedName())
+	})
+
+	t.Run("set resource monitor", func(t *testing.T) {
+		resourceMonitorId := randomAccountObjectIdentifier()
+		opts := defaultOpts()
+		opts.DropOldUrl = nil
+		opts.Set = &OrganizationAccountSet{ResourceMonitor: &resourceMonitorId}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER ORGANIZATION ACCOUNT %s SET RESOURCE_MONITOR = %s", id.FullyQualifiedName(), resourceMonitorId.FullyQualifiedName())
+	})
+
+	t.Run("set password policy", func(t *testing.T) {
+		policyId := randomSchemaObjectIdentifier()
+		opts := defaultOpts()
+		opts.DropOldUrl = nil
+		opts.Set = &OrganizationAccountSet{PasswordPolicy: &policyId}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER ORGANIZATION ACCOUNT %s SET PASSWORD POLICY %s", id.FullyQualifiedName(), policyId.FullyQualifiedName())
+	})
+
+	t.Run("unset session policy", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.DropOldUrl = nil
+		opts.Unset = &OrganizationAccountUnset{SessionPolicy: Bool(true)}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER ORGANIZATION ACCOUNT %s UNSET SESSION POLICY", id.FullyQualifiedName())
+	})
+
+	t.Run("set tags", func(t *testing.T) {
+		tagId := randomSchemaObjectIdentifier()
+		opts := defaultOpts()
+		opts.DropOldUrl = nil
+		opts.SetTags = []TagAssociation{{Name: tagId, Value: "value"}}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER ORGANIZATION ACCOUNT %s SET TAG %s = 'value'", id.FullyQualifiedName(), tagId.FullyQualifiedName())
+	})
+
+	t.Run("unset tags", func(t *testing.T) {
+		tagId := randomSchemaObjectIdentifier()
+		opts := defaultOpts()
+		opts.DropOldUrl = nil
+		opts.UnsetTags = []ObjectIdentifier{tagId}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER ORGANIZATION ACCOUNT %s UNSET TAG %s", id.FullyQualifiedName(), tagId.FullyQualifiedName())
+	})
+}
+
+func TestOrganizationAccounts_Show(t *testing.T) {
+	// Minimal valid ShowOrganizationAccountOptions
+	defaultOpts := func() *ShowOrganizationAccountOptions {
+		return &ShowOrganizationAccountOptions{}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *ShowOrganizationAccountOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "SHOW ORGANIZATION ACCOUNTS")
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Like = &Like{Pattern: String("pattern")}
+		assertOptsValidAndSQLEquals(t, opts, "SHOW ORGANIZATION ACCOUNTS LIKE 'pattern'")
+	})
+}
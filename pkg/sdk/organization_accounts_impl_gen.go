@@ -0,0 +1,161 @@
+package sdk
+
+import (
+	"context"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+)
+
+var _ OrganizationAccounts = (*organizationAccounts)(nil)
+
+type organizationAccounts struct {
+	client *Client
+}
+
+func (v *organizationAccounts) Create(ctx context.Context, request *CreateOrganizationAccountRequest) error {
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *organizationAccounts) Alter(ctx context.Context, request *AlterOrganizationAccountRequest) error {
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *organizationAccounts) Show(ctx context.Context, request *ShowOrganizationAccountRequest) ([]OrganizationAccount, error) {
+	opts := request.toOpts()
+	dbRows, err := validateAndQuery[organizationAccountDBRow](v.client, ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	resultList := convertRows[organizationAccountDBRow, OrganizationAccount](dbRows)
+	return resultList, nil
+}
+
+func (v *organizationAccounts) ShowByID(ctx context.Context, id AccountObjectIdentifier) (*OrganizationAccount, error) {
+	request := NewShowOrganizationAccountRequest().
+		WithLike(Like{Pattern: String(id.Name())})
+	organizationAccounts, err := v.Show(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return collections.FindFirst(organizationAccounts, func(r OrganizationAccount) bool { return r.AccountName == id.Name() })
+}
+
+func (r *CreateOrganizationAccountRequest) toOpts() *CreateOrganizationAccountOptions {
+	opts := &CreateOrganizationAccountOptions{
+		name:               r.name,
+		AdminName:          r.AdminName,
+		AdminPassword:      r.AdminPassword,
+		AdminRsaPublicKey:  r.AdminRsaPublicKey,
+		AdminUserType:      r.AdminUserType,
+		FirstName:          r.FirstName,
+		LastName:           r.LastName,
+		Email:              r.Email,
+		MustChangePassword: r.MustChangePassword,
+		Edition:            r.Edition,
+		RegionGroup:        r.RegionGroup,
+		Region:             r.Region,
+		Comment:            r.Comment,
+	}
+	return opts
+}
+
+func (r *AlterOrganizationAccountRequest) toOpts() *AlterOrganizationAccountOptions {
+	opts := &AlterOrganizationAccountOptions{
+		name: r.name,
+
+		SetTags:   r.SetTags,
+		UnsetTags: r.UnsetTags,
+
+		DropOldUrl: r.DropOldUrl,
+	}
+	if r.Set != nil {
+		opts.Set = &OrganizationAccountSet{
+			ResourceMonitor: r.Set.ResourceMonitor,
+			PasswordPolicy:  r.Set.PasswordPolicy,
+			SessionPolicy:   r.Set.SessionPolicy,
+		}
+	}
+	if r.Unset != nil {
+		opts.Unset = &OrganizationAccountUnset{
+			ResourceMonitor: r.Unset.ResourceMonitor,
+			PasswordPolicy:  r.Unset.PasswordPolicy,
+			SessionPolicy:   r.Unset.SessionPolicy,
+		}
+	}
+	if r.RenameTo != nil {
+		opts.RenameTo = &OrganizationAccountRename{
+			NewName:    r.RenameTo.NewName,
+			SaveOldUrl: r.RenameTo.SaveOldUrl,
+		}
+	}
+	return opts
+}
+
+func (r *ShowOrganizationAccountRequest) toOpts() *ShowOrganizationAccountOptions {
+	opts := &ShowOrganizationAccountOptions{
+		Like: r.Like,
+	}
+	return opts
+}
+
+func (r organizationAccountDBRow) convert() *OrganizationAccount {
+	organizationAccount := &OrganizationAccount{
+		OrganizationName:      r.OrganizationName,
+		AccountName:           r.AccountName,
+		SnowflakeRegion:       r.SnowflakeRegion,
+		CreatedOn:             r.CreatedOn,
+		AccountLocator:        r.AccountLocator,
+		IsOrganizationAccount: r.IsOrganizationAccount,
+	}
+	if r.Edition.Valid {
+		organizationAccount.Edition = Pointer(OrganizationAccountEdition(r.Edition.String))
+	}
+	if r.AccountUrl.Valid {
+		organizationAccount.AccountUrl = String(r.AccountUrl.String)
+	}
+	if r.Comment.Valid {
+		organizationAccount.Comment = String(r.Comment.String)
+	}
+	if r.AccountLocatorUrl.Valid {
+		organizationAccount.AccountLocatorUrl = String(r.AccountLocatorUrl.String)
+	}
+	if r.ManagedAccounts.Valid {
+		organizationAccount.ManagedAccounts = Int(int(r.ManagedAccounts.Int64))
+	}
+	if r.ConsumptionBillingEntityName.Valid {
+		organizationAccount.ConsumptionBillingEntityName = String(r.ConsumptionBillingEntityName.String)
+	}
+	if r.MarketplaceConsumerBillingEntityName.Valid {
+		organizationAccount.MarketplaceConsumerBillingEntityName = String(r.MarketplaceConsumerBillingEntityName.String)
+	}
+	if r.MarketplaceProviderBillingEntityName.Valid {
+		organizationAccount.MarketplaceProviderBillingEntityName = String(r.MarketplaceProviderBillingEntityName.String)
+	}
+	if r.OldAccountUrl.Valid {
+		organizationAccount.OldAccountUrl = String(r.OldAccountUrl.String)
+	}
+	if r.IsOrgAdmin.Valid {
+		organizationAccount.IsOrgAdmin = Bool(r.IsOrgAdmin.Bool)
+	}
+	if r.AccountOldUrlSavedOn.Valid {
+		organizationAccount.AccountOldUrlSavedOn = &r.AccountOldUrlSavedOn.Time
+	}
+	if r.AccountOldUrlLastUsed.Valid {
+		organizationAccount.AccountOldUrlLastUsed = &r.AccountOldUrlLastUsed.Time
+	}
+	if r.OrganizationOldUrl.Valid {
+		organizationAccount.OrganizationOldUrl = String(r.OrganizationOldUrl.String)
+	}
+	if r.OrganizationOldUrlSavedOn.Valid {
+		organizationAccount.OrganizationOldUrlSavedOn = &r.OrganizationOldUrlSavedOn.Time
+	}
+	if r.OrganizationOldUrlLastUsed.Valid {
+		organizationAccount.OrganizationOldUrlLastUsed = &r.OrganizationOldUrlLastUsed.Time
+	}
+	if r.IsEventsAccount.Valid {
+		organizationAccount.IsEventsAccount = Bool(r.IsEventsAccount.Bool)
+	}
+	return organizationAccount
+}
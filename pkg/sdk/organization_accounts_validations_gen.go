@@ -0,0 +1,73 @@
+package sdk
+
+var (
+	_ validatable = new(CreateOrganizationAccountOptions)
+	_ validatable = new(AlterOrganizationAccountOptions)
+	_ validatable = new(ShowOrganizationAccountOptions)
+)
+
+func (opts *CreateOrganizationAccountOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if !valueSet(opts.AdminName) {
+		errs = append(errs, errNotSet("CreateOrganizationAccountOptions", "AdminName"))
+	}
+	if !valueSet(opts.Email) {
+		errs = append(errs, errNotSet("CreateOrganizationAccountOptions", "Email"))
+	}
+	if !valueSet(opts.Edition) {
+		errs = append(errs, errNotSet("CreateOrganizationAccountOptions", "Edition"))
+	}
+	if !anyValueSet(opts.AdminPassword, opts.AdminRsaPublicKey) {
+		errs = append(errs, errAtLeastOneOf("CreateOrganizationAccountOptions", "AdminPassword", "AdminRsaPublicKey"))
+	}
+	return JoinErrors(errs...)
+}
+
+func (opts *AlterOrganizationAccountOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if !exactlyOneValueSet(opts.Set, opts.Unset, opts.SetTags, opts.UnsetTags, opts.RenameTo, opts.DropOldUrl) {
+		errs = append(errs, errExactlyOneOf("AlterOrganizationAccountOptions", "Set", "Unset", "SetTags", "UnsetTags", "RenameTo", "DropOldUrl"))
+	}
+	if valueSet(opts.Set) {
+		if !exactlyOneValueSet(opts.Set.ResourceMonitor, opts.Set.PasswordPolicy, opts.Set.SessionPolicy) {
+			errs = append(errs, errExactlyOneOf("AlterOrganizationAccountOptions.Set", "ResourceMonitor", "PasswordPolicy", "SessionPolicy"))
+		}
+		if opts.Set.PasswordPolicy != nil && !ValidObjectIdentifier(opts.Set.PasswordPolicy) {
+			errs = append(errs, ErrInvalidObjectIdentifier)
+		}
+		if opts.Set.SessionPolicy != nil && !ValidObjectIdentifier(opts.Set.SessionPolicy) {
+			errs = append(errs, ErrInvalidObjectIdentifier)
+		}
+	}
+	if valueSet(opts.Unset) {
+		if !exactlyOneValueSet(opts.Unset.ResourceMonitor, opts.Unset.PasswordPolicy, opts.Unset.SessionPolicy) {
+			errs = append(errs, errExactlyOneOf("AlterOrganizationAccountOptions.Unset", "ResourceMonitor", "PasswordPolicy", "SessionPolicy"))
+		}
+	}
+	if valueSet(opts.RenameTo) {
+		if !ValidObjectIdentifier(opts.RenameTo.NewName) {
+			errs = append(errs, ErrInvalidObjectIdentifier)
+		}
+	}
+	return JoinErrors(errs...)
+}
+
+func (opts *ShowOrganizationAccountOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	return JoinErrors(errs...)
+}
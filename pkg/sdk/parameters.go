@@ -1094,6 +1094,30 @@ var AllTaskParameters = []TaskParameter{
 	TaskParameterWeekStart,
 }
 
+type AlertParameter string
+
+const (
+	AlertParameterSuspendTaskAfterNumFailures AlertParameter = "SUSPEND_TASK_AFTER_NUM_FAILURES"
+	AlertParameterUserTaskTimeoutMs           AlertParameter = "USER_TASK_TIMEOUT_MS"
+)
+
+var AllAlertParameters = []AlertParameter{
+	AlertParameterSuspendTaskAfterNumFailures,
+	AlertParameterUserTaskTimeoutMs,
+}
+
+type DynamicTableParameter string
+
+const (
+	DynamicTableParameterDataRetentionTimeInDays    DynamicTableParameter = "DATA_RETENTION_TIME_IN_DAYS"
+	DynamicTableParameterMaxDataExtensionTimeInDays DynamicTableParameter = "MAX_DATA_EXTENSION_TIME_IN_DAYS"
+)
+
+var AllDynamicTableParameters = []DynamicTableParameter{
+	DynamicTableParameterDataRetentionTimeInDays,
+	DynamicTableParameterMaxDataExtensionTimeInDays,
+}
+
 type WarehouseParameter string
 
 const (
@@ -1787,14 +1811,15 @@ type ParametersIn struct {
 	Database  AccountObjectIdentifier             `ddl:"identifier" sql:"DATABASE"`
 	Schema    DatabaseObjectIdentifier            `ddl:"identifier" sql:"SCHEMA"`
 	Task      SchemaObjectIdentifier              `ddl:"identifier" sql:"TASK"`
+	Alert     SchemaObjectIdentifier              `ddl:"identifier" sql:"ALERT"`
 	Table     SchemaObjectIdentifier              `ddl:"identifier" sql:"TABLE"`
 	Function  SchemaObjectIdentifierWithArguments `ddl:"identifier" sql:"FUNCTION"`
 	Procedure SchemaObjectIdentifierWithArguments `ddl:"identifier" sql:"PROCEDURE"`
 }
 
 func (v *ParametersIn) validate() error {
-	if !anyValueSet(v.Session, v.Account, v.User, v.Warehouse, v.Database, v.Schema, v.Task, v.Table, v.Function, v.Procedure) {
-		return errors.Join(errAtLeastOneOf("Session", "Account", "User", "Warehouse", "Database", "Schema", "Task", "Table", "Function", "Procedure"))
+	if !anyValueSet(v.Session, v.Account, v.User, v.Warehouse, v.Database, v.Schema, v.Task, v.Alert, v.Table, v.Function, v.Procedure) {
+		return errors.Join(errAtLeastOneOf("Session", "Account", "User", "Warehouse", "Database", "Schema", "Task", "Alert", "Table", "Function", "Procedure"))
 	}
 	return nil
 }
@@ -1811,6 +1836,8 @@ const (
 	ParameterTypeDatabase         ParameterType = "DATABASE"
 	ParameterTypeSchema           ParameterType = "SCHEMA"
 	ParameterTypeTask             ParameterType = "TASK"
+	ParameterTypeAlert            ParameterType = "ALERT"
+	ParameterTypeTable            ParameterType = "TABLE"
 	ParameterTypeFunction         ParameterType = "FUNCTION"
 	ParameterTypeProcedure        ParameterType = "PROCEDURE"
 )
@@ -1937,6 +1964,8 @@ func (v *parameters) ShowObjectParameter(ctx context.Context, parameter ObjectPa
 		opts.In.Schema = object.Name.(DatabaseObjectIdentifier)
 	case ObjectTypeTask:
 		opts.In.Task = object.Name.(SchemaObjectIdentifier)
+	case ObjectTypeAlert:
+		opts.In.Alert = object.Name.(SchemaObjectIdentifier)
 	case ObjectTypeTable:
 		opts.In.Table = object.Name.(SchemaObjectIdentifier)
 	case ObjectTypeUser:
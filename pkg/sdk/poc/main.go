@@ -48,6 +48,10 @@ var definitionMapping = map[string]*generator.Interface{
 	"authentication_policies_def.go":         sdk.AuthenticationPoliciesDef,
 	"secrets_def.go":                         sdk.SecretsDef,
 	"connections_def.go":                     sdk.ConnectionDef,
+	"hybrid_tables_def.go":                   sdk.HybridTablesDef,
+	"user_programmatic_access_tokens_def.go": sdk.UserProgrammaticAccessTokensDef,
+	"external_access_integrations_def.go":    sdk.ExternalAccessIntegrationsDef,
+	"organization_accounts_def.go":           sdk.OrganizationAccountsDef,
 }
 
 func main() {
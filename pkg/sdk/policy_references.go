@@ -77,6 +77,9 @@ const (
 	PolicyKindMaskingPolicy        PolicyKind = "MASKING_POLICY"
 	PolicyKindProjectionPolicy     PolicyKind = "PROJECTION_POLICY"
 	PolicyKindAuthenticationPolicy PolicyKind = "AUTHENTICATION_POLICY"
+	PolicyKindSessionPolicy        PolicyKind = "SESSION_POLICY"
+	PolicyKindPackagesPolicy       PolicyKind = "PACKAGES_POLICY"
+	PolicyKindFeaturePolicy        PolicyKind = "FEATURE_POLICY"
 )
 
 type PolicyReference struct {
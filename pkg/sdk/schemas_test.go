@@ -55,6 +55,20 @@ func TestSchemasCreate(t *testing.T) {
 		assertOptsValidAndSQLEquals(t, opts, `CREATE OR REPLACE SCHEMA %s CLONE "sch1" AT (TIMESTAMP => '2021-01-01 00:00:00 +0000 UTC')`, id.FullyQualifiedName())
 	})
 
+	t.Run("clone ignoring tables with insufficient data retention", func(t *testing.T) {
+		opts := &CreateSchemaOptions{
+			name: id,
+			Clone: &Clone{
+				SourceObject: NewAccountObjectIdentifier("sch1"),
+				Before: &TimeTravel{
+					Offset: Int(-60),
+				},
+				IgnoreTablesWithInsufficientDataRetention: Bool(true),
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `CREATE SCHEMA %s CLONE "sch1" BEFORE (OFFSET => -60) IGNORE TABLES WITH INSUFFICIENT DATA RETENTION`, id.FullyQualifiedName())
+	})
+
 	t.Run("complete", func(t *testing.T) {
 		tagId := randomSchemaObjectIdentifier()
 		externalVolumeId := randomAccountObjectIdentifier()
@@ -323,6 +323,22 @@ func ToScimSecurityIntegrationRunAsRoleOption(s string) (ScimSecurityIntegration
 	}
 }
 
+// ToScimSecurityIntegrationRunAsRoleOptionForClient returns the run as role for the given SCIM client.
+// GENERIC SCIM clients can be mapped to any custom role, other clients are limited to the predefined provisioner roles.
+func ToScimSecurityIntegrationRunAsRoleOptionForClient(scimClient ScimSecurityIntegrationScimClientOption, s string) (ScimSecurityIntegrationRunAsRoleOption, error) {
+	runAsRole, err := ToScimSecurityIntegrationRunAsRoleOption(s)
+	if err == nil {
+		return runAsRole, nil
+	}
+	if scimClient != ScimSecurityIntegrationScimClientGeneric {
+		return "", err
+	}
+	if strings.TrimSpace(s) == "" {
+		return "", fmt.Errorf("run as role for %s SCIM client cannot be empty", scimClient)
+	}
+	return ScimSecurityIntegrationRunAsRoleOption(s), nil
+}
+
 var (
 	allowedScopeDef           = g.NewQueryStruct("AllowedScope").Text("Scope", g.KeywordOptions().SingleQuotes().Required())
 	userDomainDef             = g.NewQueryStruct("UserDomain").Text("Domain", g.KeywordOptions().SingleQuotes().Required())
@@ -437,6 +453,17 @@ var apiAuthJwtBearerFlowIntegrationUnsetDef = g.NewQueryStruct("ApiAuthenticatio
 	OptionalSQL("COMMENT").
 	WithValidation(g.AtLeastOneValueSet, "Enabled", "Comment")
 
+var apiAuthAwsIamIntegrationSetDef = g.NewQueryStruct("ApiAuthenticationWithAwsIamIntegrationSet").
+	OptionalBooleanAssignment("ENABLED", g.ParameterOptions()).
+	OptionalTextAssignment("AWS_ROLE_ARN", g.ParameterOptions().SingleQuotes()).
+	OptionalComment().
+	WithValidation(g.AtLeastOneValueSet, "Enabled", "AwsRoleArn", "Comment")
+
+var apiAuthAwsIamIntegrationUnsetDef = g.NewQueryStruct("ApiAuthenticationWithAwsIamIntegrationUnset").
+	OptionalSQL("ENABLED").
+	OptionalSQL("COMMENT").
+	WithValidation(g.AtLeastOneValueSet, "Enabled", "Comment")
+
 var externalOauthIntegrationSetDef = g.NewQueryStruct("ExternalOauthIntegrationSet").
 	OptionalBooleanAssignment("ENABLED", g.ParameterOptions()).
 	OptionalAssignment(
@@ -653,6 +680,17 @@ var SecurityIntegrationsDef = g.NewInterface(
 				OptionalNumberAssignment("OAUTH_REFRESH_TOKEN_VALIDITY", g.ParameterOptions())
 		}),
 	).
+	CustomOperation(
+		"CreateApiAuthenticationWithAwsIam",
+		"https://docs.snowflake.com/en/sql-reference/sql/create-security-integration-api-auth",
+		createSecurityIntegrationOperation("CreateApiAuthenticationWithAwsIam", func(qs *g.QueryStruct) *g.QueryStruct {
+			return qs.
+				PredefinedQueryStructField("integrationType", "string", g.StaticOptions().SQL("TYPE = API_AUTHENTICATION")).
+				PredefinedQueryStructField("authType", "string", g.StaticOptions().SQL("AUTH_TYPE = AWS_IAM")).
+				BooleanAssignment("ENABLED", g.ParameterOptions().Required()).
+				TextAssignment("AWS_ROLE_ARN", g.ParameterOptions().Required().SingleQuotes())
+		}),
+	).
 	CustomOperation(
 		"CreateExternalOauth",
 		"https://docs.snowflake.com/en/sql-reference/sql/create-security-integration-oauth-external",
@@ -851,6 +889,21 @@ var SecurityIntegrationsDef = g.NewInterface(
 			).WithValidation(g.ExactlyOneValueSet, "Set", "Unset", "SetTags", "UnsetTags")
 		}),
 	).
+	CustomOperation(
+		"AlterApiAuthenticationWithAwsIam",
+		"https://docs.snowflake.com/en/sql-reference/sql/alter-security-integration-api-auth",
+		alterSecurityIntegrationOperation("AlterApiAuthenticationWithAwsIam", func(qs *g.QueryStruct) *g.QueryStruct {
+			return qs.OptionalQueryStructField(
+				"Set",
+				apiAuthAwsIamIntegrationSetDef,
+				g.ListOptions().NoParentheses().SQL("SET"),
+			).OptionalQueryStructField(
+				"Unset",
+				apiAuthAwsIamIntegrationUnsetDef,
+				g.ListOptions().NoParentheses().SQL("UNSET"),
+			).WithValidation(g.ExactlyOneValueSet, "Set", "Unset", "SetTags", "UnsetTags")
+		}),
+	).
 	CustomOperation(
 		"AlterExternalOauth",
 		"https://docs.snowflake.com/en/sql-reference/sql/alter-security-integration-oauth-external",
@@ -188,6 +188,33 @@ func (s *CreateApiAuthenticationWithJwtBearerFlowSecurityIntegrationRequest) Wit
 	return s
 }
 
+func NewCreateApiAuthenticationWithAwsIamSecurityIntegrationRequest(
+	name AccountObjectIdentifier,
+	Enabled bool,
+	AwsRoleArn string,
+) *CreateApiAuthenticationWithAwsIamSecurityIntegrationRequest {
+	s := CreateApiAuthenticationWithAwsIamSecurityIntegrationRequest{}
+	s.name = name
+	s.Enabled = Enabled
+	s.AwsRoleArn = AwsRoleArn
+	return &s
+}
+
+func (s *CreateApiAuthenticationWithAwsIamSecurityIntegrationRequest) WithOrReplace(OrReplace bool) *CreateApiAuthenticationWithAwsIamSecurityIntegrationRequest {
+	s.OrReplace = &OrReplace
+	return s
+}
+
+func (s *CreateApiAuthenticationWithAwsIamSecurityIntegrationRequest) WithIfNotExists(IfNotExists bool) *CreateApiAuthenticationWithAwsIamSecurityIntegrationRequest {
+	s.IfNotExists = &IfNotExists
+	return s
+}
+
+func (s *CreateApiAuthenticationWithAwsIamSecurityIntegrationRequest) WithComment(Comment string) *CreateApiAuthenticationWithAwsIamSecurityIntegrationRequest {
+	s.Comment = &Comment
+	return s
+}
+
 func NewCreateExternalOauthSecurityIntegrationRequest(
 	name AccountObjectIdentifier,
 	Enabled bool,
@@ -877,6 +904,72 @@ func (s *ApiAuthenticationWithJwtBearerFlowIntegrationUnsetRequest) WithComment(
 	return s
 }
 
+func NewAlterApiAuthenticationWithAwsIamSecurityIntegrationRequest(
+	name AccountObjectIdentifier,
+) *AlterApiAuthenticationWithAwsIamSecurityIntegrationRequest {
+	s := AlterApiAuthenticationWithAwsIamSecurityIntegrationRequest{}
+	s.name = name
+	return &s
+}
+
+func (s *AlterApiAuthenticationWithAwsIamSecurityIntegrationRequest) WithIfExists(IfExists bool) *AlterApiAuthenticationWithAwsIamSecurityIntegrationRequest {
+	s.IfExists = &IfExists
+	return s
+}
+
+func (s *AlterApiAuthenticationWithAwsIamSecurityIntegrationRequest) WithSetTags(SetTags []TagAssociation) *AlterApiAuthenticationWithAwsIamSecurityIntegrationRequest {
+	s.SetTags = SetTags
+	return s
+}
+
+func (s *AlterApiAuthenticationWithAwsIamSecurityIntegrationRequest) WithUnsetTags(UnsetTags []ObjectIdentifier) *AlterApiAuthenticationWithAwsIamSecurityIntegrationRequest {
+	s.UnsetTags = UnsetTags
+	return s
+}
+
+func (s *AlterApiAuthenticationWithAwsIamSecurityIntegrationRequest) WithSet(Set ApiAuthenticationWithAwsIamIntegrationSetRequest) *AlterApiAuthenticationWithAwsIamSecurityIntegrationRequest {
+	s.Set = &Set
+	return s
+}
+
+func (s *AlterApiAuthenticationWithAwsIamSecurityIntegrationRequest) WithUnset(Unset ApiAuthenticationWithAwsIamIntegrationUnsetRequest) *AlterApiAuthenticationWithAwsIamSecurityIntegrationRequest {
+	s.Unset = &Unset
+	return s
+}
+
+func NewApiAuthenticationWithAwsIamIntegrationSetRequest() *ApiAuthenticationWithAwsIamIntegrationSetRequest {
+	return &ApiAuthenticationWithAwsIamIntegrationSetRequest{}
+}
+
+func (s *ApiAuthenticationWithAwsIamIntegrationSetRequest) WithEnabled(Enabled bool) *ApiAuthenticationWithAwsIamIntegrationSetRequest {
+	s.Enabled = &Enabled
+	return s
+}
+
+func (s *ApiAuthenticationWithAwsIamIntegrationSetRequest) WithAwsRoleArn(AwsRoleArn string) *ApiAuthenticationWithAwsIamIntegrationSetRequest {
+	s.AwsRoleArn = &AwsRoleArn
+	return s
+}
+
+func (s *ApiAuthenticationWithAwsIamIntegrationSetRequest) WithComment(Comment string) *ApiAuthenticationWithAwsIamIntegrationSetRequest {
+	s.Comment = &Comment
+	return s
+}
+
+func NewApiAuthenticationWithAwsIamIntegrationUnsetRequest() *ApiAuthenticationWithAwsIamIntegrationUnsetRequest {
+	return &ApiAuthenticationWithAwsIamIntegrationUnsetRequest{}
+}
+
+func (s *ApiAuthenticationWithAwsIamIntegrationUnsetRequest) WithEnabled(Enabled bool) *ApiAuthenticationWithAwsIamIntegrationUnsetRequest {
+	s.Enabled = &Enabled
+	return s
+}
+
+func (s *ApiAuthenticationWithAwsIamIntegrationUnsetRequest) WithComment(Comment bool) *ApiAuthenticationWithAwsIamIntegrationUnsetRequest {
+	s.Comment = &Comment
+	return s
+}
+
 func NewAlterExternalOauthSecurityIntegrationRequest(
 	name AccountObjectIdentifier,
 ) *AlterExternalOauthSecurityIntegrationRequest {
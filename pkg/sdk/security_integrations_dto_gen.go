@@ -6,6 +6,7 @@ var (
 	_ optionsProvider[CreateApiAuthenticationWithClientCredentialsFlowSecurityIntegrationOptions]      = new(CreateApiAuthenticationWithClientCredentialsFlowSecurityIntegrationRequest)
 	_ optionsProvider[CreateApiAuthenticationWithAuthorizationCodeGrantFlowSecurityIntegrationOptions] = new(CreateApiAuthenticationWithAuthorizationCodeGrantFlowSecurityIntegrationRequest)
 	_ optionsProvider[CreateApiAuthenticationWithJwtBearerFlowSecurityIntegrationOptions]              = new(CreateApiAuthenticationWithJwtBearerFlowSecurityIntegrationRequest)
+	_ optionsProvider[CreateApiAuthenticationWithAwsIamSecurityIntegrationOptions]                     = new(CreateApiAuthenticationWithAwsIamSecurityIntegrationRequest)
 	_ optionsProvider[CreateExternalOauthSecurityIntegrationOptions]                                   = new(CreateExternalOauthSecurityIntegrationRequest)
 	_ optionsProvider[CreateOauthForPartnerApplicationsSecurityIntegrationOptions]                     = new(CreateOauthForPartnerApplicationsSecurityIntegrationRequest)
 	_ optionsProvider[CreateOauthForCustomClientsSecurityIntegrationOptions]                           = new(CreateOauthForCustomClientsSecurityIntegrationRequest)
@@ -14,6 +15,7 @@ var (
 	_ optionsProvider[AlterApiAuthenticationWithClientCredentialsFlowSecurityIntegrationOptions]       = new(AlterApiAuthenticationWithClientCredentialsFlowSecurityIntegrationRequest)
 	_ optionsProvider[AlterApiAuthenticationWithAuthorizationCodeGrantFlowSecurityIntegrationOptions]  = new(AlterApiAuthenticationWithAuthorizationCodeGrantFlowSecurityIntegrationRequest)
 	_ optionsProvider[AlterApiAuthenticationWithJwtBearerFlowSecurityIntegrationOptions]               = new(AlterApiAuthenticationWithJwtBearerFlowSecurityIntegrationRequest)
+	_ optionsProvider[AlterApiAuthenticationWithAwsIamSecurityIntegrationOptions]                      = new(AlterApiAuthenticationWithAwsIamSecurityIntegrationRequest)
 	_ optionsProvider[AlterExternalOauthSecurityIntegrationOptions]                                    = new(AlterExternalOauthSecurityIntegrationRequest)
 	_ optionsProvider[AlterOauthForPartnerApplicationsSecurityIntegrationOptions]                      = new(AlterOauthForPartnerApplicationsSecurityIntegrationRequest)
 	_ optionsProvider[AlterOauthForCustomClientsSecurityIntegrationOptions]                            = new(AlterOauthForCustomClientsSecurityIntegrationRequest)
@@ -86,6 +88,19 @@ func (r *CreateApiAuthenticationWithJwtBearerFlowSecurityIntegrationRequest) Get
 	return r.name
 }
 
+type CreateApiAuthenticationWithAwsIamSecurityIntegrationRequest struct {
+	OrReplace   *bool
+	IfNotExists *bool
+	name        AccountObjectIdentifier // required
+	Enabled     bool                    // required
+	AwsRoleArn  string                  // required
+	Comment     *string
+}
+
+func (r *CreateApiAuthenticationWithAwsIamSecurityIntegrationRequest) GetName() AccountObjectIdentifier {
+	return r.name
+}
+
 type CreateExternalOauthSecurityIntegrationRequest struct {
 	OrReplace                                  *bool
 	IfNotExists                                *bool
@@ -294,6 +309,26 @@ type ApiAuthenticationWithJwtBearerFlowIntegrationUnsetRequest struct {
 	Comment *bool
 }
 
+type AlterApiAuthenticationWithAwsIamSecurityIntegrationRequest struct {
+	IfExists  *bool
+	name      AccountObjectIdentifier // required
+	SetTags   []TagAssociation
+	UnsetTags []ObjectIdentifier
+	Set       *ApiAuthenticationWithAwsIamIntegrationSetRequest
+	Unset     *ApiAuthenticationWithAwsIamIntegrationUnsetRequest
+}
+
+type ApiAuthenticationWithAwsIamIntegrationSetRequest struct {
+	Enabled    *bool
+	AwsRoleArn *string
+	Comment    *string
+}
+
+type ApiAuthenticationWithAwsIamIntegrationUnsetRequest struct {
+	Enabled *bool
+	Comment *bool
+}
+
 type AlterExternalOauthSecurityIntegrationRequest struct {
 	IfExists  *bool
 	name      AccountObjectIdentifier // required
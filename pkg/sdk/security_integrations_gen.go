@@ -12,6 +12,7 @@ type SecurityIntegrations interface {
 	CreateApiAuthenticationWithClientCredentialsFlow(ctx context.Context, request *CreateApiAuthenticationWithClientCredentialsFlowSecurityIntegrationRequest) error
 	CreateApiAuthenticationWithAuthorizationCodeGrantFlow(ctx context.Context, request *CreateApiAuthenticationWithAuthorizationCodeGrantFlowSecurityIntegrationRequest) error
 	CreateApiAuthenticationWithJwtBearerFlow(ctx context.Context, request *CreateApiAuthenticationWithJwtBearerFlowSecurityIntegrationRequest) error
+	CreateApiAuthenticationWithAwsIam(ctx context.Context, request *CreateApiAuthenticationWithAwsIamSecurityIntegrationRequest) error
 	CreateExternalOauth(ctx context.Context, request *CreateExternalOauthSecurityIntegrationRequest) error
 	CreateOauthForPartnerApplications(ctx context.Context, request *CreateOauthForPartnerApplicationsSecurityIntegrationRequest) error
 	CreateOauthForCustomClients(ctx context.Context, request *CreateOauthForCustomClientsSecurityIntegrationRequest) error
@@ -20,6 +21,7 @@ type SecurityIntegrations interface {
 	AlterApiAuthenticationWithClientCredentialsFlow(ctx context.Context, request *AlterApiAuthenticationWithClientCredentialsFlowSecurityIntegrationRequest) error
 	AlterApiAuthenticationWithAuthorizationCodeGrantFlow(ctx context.Context, request *AlterApiAuthenticationWithAuthorizationCodeGrantFlowSecurityIntegrationRequest) error
 	AlterApiAuthenticationWithJwtBearerFlow(ctx context.Context, request *AlterApiAuthenticationWithJwtBearerFlowSecurityIntegrationRequest) error
+	AlterApiAuthenticationWithAwsIam(ctx context.Context, request *AlterApiAuthenticationWithAwsIamSecurityIntegrationRequest) error
 	AlterExternalOauth(ctx context.Context, request *AlterExternalOauthSecurityIntegrationRequest) error
 	AlterOauthForPartnerApplications(ctx context.Context, request *AlterOauthForPartnerApplicationsSecurityIntegrationRequest) error
 	AlterOauthForCustomClients(ctx context.Context, request *AlterOauthForCustomClientsSecurityIntegrationRequest) error
@@ -99,6 +101,20 @@ type CreateApiAuthenticationWithJwtBearerFlowSecurityIntegrationOptions struct {
 	Comment                    *string                                                          `ddl:"parameter,single_quotes" sql:"COMMENT"`
 }
 
+// CreateApiAuthenticationWithAwsIamSecurityIntegrationOptions is based on https://docs.snowflake.com/en/sql-reference/sql/create-security-integration-api-auth.
+type CreateApiAuthenticationWithAwsIamSecurityIntegrationOptions struct {
+	create              bool                    `ddl:"static" sql:"CREATE"`
+	OrReplace           *bool                   `ddl:"keyword" sql:"OR REPLACE"`
+	securityIntegration bool                    `ddl:"static" sql:"SECURITY INTEGRATION"`
+	IfNotExists         *bool                   `ddl:"keyword" sql:"IF NOT EXISTS"`
+	name                AccountObjectIdentifier `ddl:"identifier"`
+	integrationType     string                  `ddl:"static" sql:"TYPE = API_AUTHENTICATION"`
+	authType            string                  `ddl:"static" sql:"AUTH_TYPE = AWS_IAM"`
+	Enabled             bool                    `ddl:"parameter" sql:"ENABLED"`
+	AwsRoleArn          string                  `ddl:"parameter,single_quotes" sql:"AWS_ROLE_ARN"`
+	Comment             *string                 `ddl:"parameter,single_quotes" sql:"COMMENT"`
+}
+
 // CreateExternalOauthSecurityIntegrationOptions is based on https://docs.snowflake.com/en/sql-reference/sql/create-security-integration-oauth-external.
 type CreateExternalOauthSecurityIntegrationOptions struct {
 	create                                     bool                                                                `ddl:"static" sql:"CREATE"`
@@ -322,6 +338,27 @@ type ApiAuthenticationWithJwtBearerFlowIntegrationUnset struct {
 	Comment *bool `ddl:"keyword" sql:"COMMENT"`
 }
 
+// AlterApiAuthenticationWithAwsIamSecurityIntegrationOptions is based on https://docs.snowflake.com/en/sql-reference/sql/alter-security-integration-api-auth.
+type AlterApiAuthenticationWithAwsIamSecurityIntegrationOptions struct {
+	alter               bool                                         `ddl:"static" sql:"ALTER"`
+	securityIntegration bool                                         `ddl:"static" sql:"SECURITY INTEGRATION"`
+	IfExists            *bool                                        `ddl:"keyword" sql:"IF EXISTS"`
+	name                AccountObjectIdentifier                      `ddl:"identifier"`
+	SetTags             []TagAssociation                             `ddl:"keyword" sql:"SET TAG"`
+	UnsetTags           []ObjectIdentifier                           `ddl:"keyword" sql:"UNSET TAG"`
+	Set                 *ApiAuthenticationWithAwsIamIntegrationSet   `ddl:"list,no_parentheses" sql:"SET"`
+	Unset               *ApiAuthenticationWithAwsIamIntegrationUnset `ddl:"list,no_parentheses" sql:"UNSET"`
+}
+type ApiAuthenticationWithAwsIamIntegrationSet struct {
+	Enabled    *bool   `ddl:"parameter" sql:"ENABLED"`
+	AwsRoleArn *string `ddl:"parameter,single_quotes" sql:"AWS_ROLE_ARN"`
+	Comment    *string `ddl:"parameter,single_quotes" sql:"COMMENT"`
+}
+type ApiAuthenticationWithAwsIamIntegrationUnset struct {
+	Enabled *bool `ddl:"keyword" sql:"ENABLED"`
+	Comment *bool `ddl:"keyword" sql:"COMMENT"`
+}
+
 // AlterExternalOauthSecurityIntegrationOptions is based on https://docs.snowflake.com/en/sql-reference/sql/alter-security-integration-oauth-external.
 type AlterExternalOauthSecurityIntegrationOptions struct {
 	alter               bool                           `ddl:"static" sql:"ALTER"`
@@ -151,6 +151,51 @@ func TestSecurityIntegrations_CreateApiAuthenticationWithJwtBearerFlow(t *testin
 	})
 }
 
+func TestSecurityIntegrations_CreateApiAuthenticationWithAwsIam(t *testing.T) {
+	id := randomAccountObjectIdentifier()
+
+	// Minimal valid CreateApiAuthenticationWithAwsIamSecurityIntegrationOptions
+	defaultOpts := func() *CreateApiAuthenticationWithAwsIamSecurityIntegrationOptions {
+		return &CreateApiAuthenticationWithAwsIamSecurityIntegrationOptions{
+			name:       id,
+			Enabled:    true,
+			AwsRoleArn: "arn:aws:iam::123456789012:role/foo",
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *CreateApiAuthenticationWithAwsIamSecurityIntegrationOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.name = emptyAccountObjectIdentifier
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("validation: conflicting fields for [opts.OrReplace opts.IfNotExists]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.OrReplace = Bool(true)
+		opts.IfNotExists = Bool(true)
+		assertOptsInvalidJoinedErrors(t, opts, errOneOf("CreateApiAuthenticationWithAwsIamSecurityIntegrationOptions", "OrReplace", "IfNotExists"))
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.OrReplace = Bool(true)
+		assertOptsValidAndSQLEquals(t, opts, "CREATE OR REPLACE SECURITY INTEGRATION %s TYPE = API_AUTHENTICATION AUTH_TYPE = AWS_IAM ENABLED = true AWS_ROLE_ARN = 'arn:aws:iam::123456789012:role/foo'", id.FullyQualifiedName())
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.IfNotExists = Bool(true)
+		opts.Comment = Pointer("foo")
+		assertOptsValidAndSQLEquals(t, opts, "CREATE SECURITY INTEGRATION IF NOT EXISTS %s TYPE = API_AUTHENTICATION AUTH_TYPE = AWS_IAM ENABLED = true AWS_ROLE_ARN = 'arn:aws:iam::123456789012:role/foo'"+
+			" COMMENT = 'foo'", id.FullyQualifiedName())
+	})
+}
+
 func TestSecurityIntegrations_CreateExternalOauth(t *testing.T) {
 	id := randomAccountObjectIdentifier()
 
@@ -749,6 +794,93 @@ func TestSecurityIntegrations_AlterApiAuthenticationWithJwtBearerFlow(t *testing
 	})
 }
 
+func TestSecurityIntegrations_AlterApiAuthenticationWithAwsIam(t *testing.T) {
+	id := randomAccountObjectIdentifier()
+
+	// Minimal valid AlterApiAuthenticationWithAwsIamSecurityIntegrationOptions
+	defaultOpts := func() *AlterApiAuthenticationWithAwsIamSecurityIntegrationOptions {
+		return &AlterApiAuthenticationWithAwsIamSecurityIntegrationOptions{
+			name: id,
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *AlterApiAuthenticationWithAwsIamSecurityIntegrationOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Set = &ApiAuthenticationWithAwsIamIntegrationSet{
+			Enabled: Pointer(true),
+		}
+		opts.name = NewAccountObjectIdentifier("")
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("validation: exactly of the fields [opts.*] should be set", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("AlterApiAuthenticationWithAwsIamSecurityIntegrationOptions", "Set", "Unset", "SetTags", "UnsetTags"))
+	})
+
+	t.Run("validation: at least one of the fields [opts.Set.*] should be set", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Set = &ApiAuthenticationWithAwsIamIntegrationSet{}
+		assertOptsInvalidJoinedErrors(t, opts, errAtLeastOneOf("AlterApiAuthenticationWithAwsIamSecurityIntegrationOptions.Set", "Enabled", "AwsRoleArn", "Comment"))
+	})
+
+	t.Run("validation: at least one of the fields [opts.Unset.*] should be set", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Unset = &ApiAuthenticationWithAwsIamIntegrationUnset{}
+		assertOptsInvalidJoinedErrors(t, opts, errAtLeastOneOf("AlterApiAuthenticationWithAwsIamSecurityIntegrationOptions.Unset", "Enabled", "Comment"))
+	})
+
+	t.Run("validation: exactly one of the fields [opts.*] should be set", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Set = &ApiAuthenticationWithAwsIamIntegrationSet{}
+		opts.Unset = &ApiAuthenticationWithAwsIamIntegrationUnset{}
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("AlterApiAuthenticationWithAwsIamSecurityIntegrationOptions", "Set", "Unset", "SetTags", "UnsetTags"))
+	})
+
+	t.Run("all options - set", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Set = &ApiAuthenticationWithAwsIamIntegrationSet{
+			Enabled:    Pointer(true),
+			AwsRoleArn: Pointer("arn:aws:iam::123456789012:role/foo"),
+			Comment:    Pointer("foo"),
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER SECURITY INTEGRATION %s SET ENABLED = true, AWS_ROLE_ARN = 'arn:aws:iam::123456789012:role/foo', COMMENT = 'foo'", id.FullyQualifiedName())
+	})
+
+	t.Run("all options - unset", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Unset = &ApiAuthenticationWithAwsIamIntegrationUnset{
+			Enabled: Pointer(true),
+			Comment: Pointer(true),
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER SECURITY INTEGRATION %s UNSET ENABLED, COMMENT", id.FullyQualifiedName())
+	})
+
+	t.Run("set tags", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.SetTags = []TagAssociation{
+			{
+				Name:  NewAccountObjectIdentifier("name"),
+				Value: "value",
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER SECURITY INTEGRATION %s SET TAG "name" = 'value'`, id.FullyQualifiedName())
+	})
+
+	t.Run("unset tags", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.UnsetTags = []ObjectIdentifier{
+			NewAccountObjectIdentifier("name"),
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER SECURITY INTEGRATION %s UNSET TAG "name"`, id.FullyQualifiedName())
+	})
+}
+
 func TestSecurityIntegrations_AlterExternalOauth(t *testing.T) {
 	id := randomAccountObjectIdentifier()
 
@@ -27,6 +27,11 @@ func (v *securityIntegrations) CreateApiAuthenticationWithJwtBearerFlow(ctx cont
 	return validateAndExec(v.client, ctx, opts)
 }
 
+func (v *securityIntegrations) CreateApiAuthenticationWithAwsIam(ctx context.Context, request *CreateApiAuthenticationWithAwsIamSecurityIntegrationRequest) error {
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
 func (v *securityIntegrations) CreateExternalOauth(ctx context.Context, request *CreateExternalOauthSecurityIntegrationRequest) error {
 	opts := request.toOpts()
 	return validateAndExec(v.client, ctx, opts)
@@ -67,6 +72,11 @@ func (v *securityIntegrations) AlterApiAuthenticationWithJwtBearerFlow(ctx conte
 	return validateAndExec(v.client, ctx, opts)
 }
 
+func (v *securityIntegrations) AlterApiAuthenticationWithAwsIam(ctx context.Context, request *AlterApiAuthenticationWithAwsIamSecurityIntegrationRequest) error {
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
 func (v *securityIntegrations) AlterExternalOauth(ctx context.Context, request *AlterExternalOauthSecurityIntegrationRequest) error {
 	opts := request.toOpts()
 	return validateAndExec(v.client, ctx, opts)
@@ -187,6 +197,18 @@ func (r *CreateApiAuthenticationWithJwtBearerFlowSecurityIntegrationRequest) toO
 	return opts
 }
 
+func (r *CreateApiAuthenticationWithAwsIamSecurityIntegrationRequest) toOpts() *CreateApiAuthenticationWithAwsIamSecurityIntegrationOptions {
+	opts := &CreateApiAuthenticationWithAwsIamSecurityIntegrationOptions{
+		OrReplace:   r.OrReplace,
+		IfNotExists: r.IfNotExists,
+		name:        r.name,
+		Enabled:     r.Enabled,
+		AwsRoleArn:  r.AwsRoleArn,
+		Comment:     r.Comment,
+	}
+	return opts
+}
+
 func (r *CreateExternalOauthSecurityIntegrationRequest) toOpts() *CreateExternalOauthSecurityIntegrationOptions {
 	opts := &CreateExternalOauthSecurityIntegrationOptions{
 		OrReplace:                          r.OrReplace,
@@ -429,6 +451,29 @@ func (r *AlterApiAuthenticationWithJwtBearerFlowSecurityIntegrationRequest) toOp
 	return opts
 }
 
+func (r *AlterApiAuthenticationWithAwsIamSecurityIntegrationRequest) toOpts() *AlterApiAuthenticationWithAwsIamSecurityIntegrationOptions {
+	opts := &AlterApiAuthenticationWithAwsIamSecurityIntegrationOptions{
+		IfExists:  r.IfExists,
+		name:      r.name,
+		SetTags:   r.SetTags,
+		UnsetTags: r.UnsetTags,
+	}
+	if r.Set != nil {
+		opts.Set = &ApiAuthenticationWithAwsIamIntegrationSet{
+			Enabled:    r.Set.Enabled,
+			AwsRoleArn: r.Set.AwsRoleArn,
+			Comment:    r.Set.Comment,
+		}
+	}
+	if r.Unset != nil {
+		opts.Unset = &ApiAuthenticationWithAwsIamIntegrationUnset{
+			Enabled: r.Unset.Enabled,
+			Comment: r.Unset.Comment,
+		}
+	}
+	return opts
+}
+
 func (r *AlterExternalOauthSecurityIntegrationRequest) toOpts() *AlterExternalOauthSecurityIntegrationOptions {
 	opts := &AlterExternalOauthSecurityIntegrationOptions{
 		IfExists:  r.IfExists,
@@ -0,0 +1,51 @@
+package sdk
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_SecurityIntegration_ToScimSecurityIntegrationRunAsRoleOptionForClient(t *testing.T) {
+	type test struct {
+		scimClient ScimSecurityIntegrationScimClientOption
+		input      string
+		want       ScimSecurityIntegrationRunAsRoleOption
+	}
+
+	valid := []test{
+		// case insensitive.
+		{scimClient: ScimSecurityIntegrationScimClientOkta, input: "okta_provisioner", want: ScimSecurityIntegrationRunAsRoleOktaProvisioner},
+
+		// predefined roles
+		{scimClient: ScimSecurityIntegrationScimClientOkta, input: "OKTA_PROVISIONER", want: ScimSecurityIntegrationRunAsRoleOktaProvisioner},
+		{scimClient: ScimSecurityIntegrationScimClientAzure, input: "AAD_PROVISIONER", want: ScimSecurityIntegrationRunAsRoleAadProvisioner},
+		{scimClient: ScimSecurityIntegrationScimClientGeneric, input: "GENERIC_SCIM_PROVISIONER", want: ScimSecurityIntegrationRunAsRoleGenericScimProvisioner},
+
+		// custom roles for generic clients
+		{scimClient: ScimSecurityIntegrationScimClientGeneric, input: "CUSTOM_PROVISIONER", want: ScimSecurityIntegrationRunAsRoleOption("CUSTOM_PROVISIONER")},
+		{scimClient: ScimSecurityIntegrationScimClientGeneric, input: "custom_provisioner", want: ScimSecurityIntegrationRunAsRoleOption("custom_provisioner")},
+	}
+
+	invalid := []test{
+		{scimClient: ScimSecurityIntegrationScimClientGeneric, input: ""},
+		{scimClient: ScimSecurityIntegrationScimClientGeneric, input: " "},
+		{scimClient: ScimSecurityIntegrationScimClientOkta, input: "CUSTOM_PROVISIONER"},
+		{scimClient: ScimSecurityIntegrationScimClientAzure, input: "CUSTOM_PROVISIONER"},
+	}
+
+	for _, tc := range valid {
+		t.Run(string(tc.scimClient)+"_"+tc.input, func(t *testing.T) {
+			got, err := ToScimSecurityIntegrationRunAsRoleOptionForClient(tc.scimClient, tc.input)
+			require.NoError(t, err)
+			require.Equal(t, tc.want, got)
+		})
+	}
+
+	for _, tc := range invalid {
+		t.Run(string(tc.scimClient)+"_"+tc.input, func(t *testing.T) {
+			_, err := ToScimSecurityIntegrationRunAsRoleOptionForClient(tc.scimClient, tc.input)
+			require.Error(t, err)
+		})
+	}
+}
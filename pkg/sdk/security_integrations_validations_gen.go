@@ -4,6 +4,7 @@ var (
 	_ validatable = new(CreateApiAuthenticationWithClientCredentialsFlowSecurityIntegrationOptions)
 	_ validatable = new(CreateApiAuthenticationWithAuthorizationCodeGrantFlowSecurityIntegrationOptions)
 	_ validatable = new(CreateApiAuthenticationWithJwtBearerFlowSecurityIntegrationOptions)
+	_ validatable = new(CreateApiAuthenticationWithAwsIamSecurityIntegrationOptions)
 	_ validatable = new(CreateExternalOauthSecurityIntegrationOptions)
 	_ validatable = new(CreateOauthForPartnerApplicationsSecurityIntegrationOptions)
 	_ validatable = new(CreateOauthForCustomClientsSecurityIntegrationOptions)
@@ -12,6 +13,7 @@ var (
 	_ validatable = new(AlterApiAuthenticationWithClientCredentialsFlowSecurityIntegrationOptions)
 	_ validatable = new(AlterApiAuthenticationWithAuthorizationCodeGrantFlowSecurityIntegrationOptions)
 	_ validatable = new(AlterApiAuthenticationWithJwtBearerFlowSecurityIntegrationOptions)
+	_ validatable = new(AlterApiAuthenticationWithAwsIamSecurityIntegrationOptions)
 	_ validatable = new(AlterExternalOauthSecurityIntegrationOptions)
 	_ validatable = new(AlterOauthForPartnerApplicationsSecurityIntegrationOptions)
 	_ validatable = new(AlterOauthForCustomClientsSecurityIntegrationOptions)
@@ -64,6 +66,20 @@ func (opts *CreateApiAuthenticationWithJwtBearerFlowSecurityIntegrationOptions)
 	return JoinErrors(errs...)
 }
 
+func (opts *CreateApiAuthenticationWithAwsIamSecurityIntegrationOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if everyValueSet(opts.OrReplace, opts.IfNotExists) {
+		errs = append(errs, errOneOf("CreateApiAuthenticationWithAwsIamSecurityIntegrationOptions", "OrReplace", "IfNotExists"))
+	}
+	return JoinErrors(errs...)
+}
+
 func (opts *CreateExternalOauthSecurityIntegrationOptions) validate() error {
 	if opts == nil {
 		return ErrNilOptions
@@ -221,6 +237,30 @@ func (opts *AlterApiAuthenticationWithJwtBearerFlowSecurityIntegrationOptions) v
 	return JoinErrors(errs...)
 }
 
+func (opts *AlterApiAuthenticationWithAwsIamSecurityIntegrationOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if !exactlyOneValueSet(opts.Set, opts.Unset, opts.SetTags, opts.UnsetTags) {
+		errs = append(errs, errExactlyOneOf("AlterApiAuthenticationWithAwsIamSecurityIntegrationOptions", "Set", "Unset", "SetTags", "UnsetTags"))
+	}
+	if valueSet(opts.Set) {
+		if !anyValueSet(opts.Set.Enabled, opts.Set.AwsRoleArn, opts.Set.Comment) {
+			errs = append(errs, errAtLeastOneOf("AlterApiAuthenticationWithAwsIamSecurityIntegrationOptions.Set", "Enabled", "AwsRoleArn", "Comment"))
+		}
+	}
+	if valueSet(opts.Unset) {
+		if !anyValueSet(opts.Unset.Enabled, opts.Unset.Comment) {
+			errs = append(errs, errAtLeastOneOf("AlterApiAuthenticationWithAwsIamSecurityIntegrationOptions.Unset", "Enabled", "Comment"))
+		}
+	}
+	return JoinErrors(errs...)
+}
+
 func (opts *AlterExternalOauthSecurityIntegrationOptions) validate() error {
 	if opts == nil {
 		return ErrNilOptions
@@ -0,0 +1,443 @@
+package sdk
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+func ToInternalStageEncryptionOption(s string) (InternalStageEncryptionOption, error) {
+	switch strings.ToUpper(s) {
+	case string(InternalStageEncryptionFull):
+		return InternalStageEncryptionFull, nil
+	case string(InternalStageEncryptionSSE):
+		return InternalStageEncryptionSSE, nil
+	default:
+		return "", fmt.Errorf("invalid internal stage encryption option: %s", s)
+	}
+}
+
+var AllInternalStageEncryptionOptions = []InternalStageEncryptionOption{
+	InternalStageEncryptionFull,
+	InternalStageEncryptionSSE,
+}
+
+func ToExternalStageS3EncryptionOption(s string) (ExternalStageS3EncryptionOption, error) {
+	switch strings.ToUpper(s) {
+	case string(ExternalStageS3EncryptionCSE):
+		return ExternalStageS3EncryptionCSE, nil
+	case string(ExternalStageS3EncryptionSSES3):
+		return ExternalStageS3EncryptionSSES3, nil
+	case string(ExternalStageS3EncryptionSSEKMS):
+		return ExternalStageS3EncryptionSSEKMS, nil
+	case string(ExternalStageS3EncryptionNone):
+		return ExternalStageS3EncryptionNone, nil
+	default:
+		return "", fmt.Errorf("invalid external stage S3 encryption option: %s", s)
+	}
+}
+
+var AllExternalStageS3EncryptionOptions = []ExternalStageS3EncryptionOption{
+	ExternalStageS3EncryptionCSE,
+	ExternalStageS3EncryptionSSES3,
+	ExternalStageS3EncryptionSSEKMS,
+	ExternalStageS3EncryptionNone,
+}
+
+func ToExternalStageGCSEncryptionOption(s string) (ExternalStageGCSEncryptionOption, error) {
+	switch strings.ToUpper(s) {
+	case string(ExternalStageGCSEncryptionSSEKMS):
+		return ExternalStageGCSEncryptionSSEKMS, nil
+	case string(ExternalStageGCSEncryptionNone):
+		return ExternalStageGCSEncryptionNone, nil
+	default:
+		return "", fmt.Errorf("invalid external stage GCS encryption option: %s", s)
+	}
+}
+
+var AllExternalStageGCSEncryptionOptions = []ExternalStageGCSEncryptionOption{
+	ExternalStageGCSEncryptionSSEKMS,
+	ExternalStageGCSEncryptionNone,
+}
+
+func ToExternalStageAzureEncryptionOption(s string) (ExternalStageAzureEncryptionOption, error) {
+	switch strings.ToUpper(s) {
+	case string(ExternalStageAzureEncryptionCSE):
+		return ExternalStageAzureEncryptionCSE, nil
+	case string(ExternalStageAzureEncryptionNone):
+		return ExternalStageAzureEncryptionNone, nil
+	default:
+		return "", fmt.Errorf("invalid external stage Azure encryption option: %s", s)
+	}
+}
+
+var AllExternalStageAzureEncryptionOptions = []ExternalStageAzureEncryptionOption{
+	ExternalStageAzureEncryptionCSE,
+	ExternalStageAzureEncryptionNone,
+}
+
+// StageDetails contains the properties returned by DESCRIBE STAGE that can be compared with the stage configuration.
+// Credentials and encryption settings are not returned by Snowflake.
+type StageDetails struct {
+	FileFormatName               *string
+	FileFormatType               *FileFormatType
+	Url                          string
+	AwsAccessPointArn            string
+	StorageIntegration           *AccountObjectIdentifier
+	AwsExternalId                string
+	SnowflakeIamUser             string
+	DirectoryEnable              bool
+	DirectoryAutoRefresh         bool
+	DirectoryNotificationChannel string
+	DirectoryLastRefreshedOn     string
+}
+
+// ParseStageDetails converts the DESCRIBE STAGE output to StageDetails.
+func ParseStageDetails(properties []StageProperty) (*StageDetails, error) {
+	details := &StageDetails{}
+	for _, property := range properties {
+		switch {
+		case property.Parent == "STAGE_FILE_FORMAT" && property.Name == "FORMAT_NAME":
+			details.FileFormatName = String(property.Value)
+		case property.Parent == "STAGE_FILE_FORMAT" && property.Name == "TYPE":
+			fileFormatType, err := ToFileFormatType(property.Value)
+			if err != nil {
+				return nil, err
+			}
+			details.FileFormatType = &fileFormatType
+		case property.Parent == "STAGE_LOCATION" && property.Name == "URL":
+			details.Url = strings.Trim(property.Value, `["]`)
+		case property.Parent == "STAGE_LOCATION" && property.Name == "AWS_ACCESS_POINT_ARN":
+			details.AwsAccessPointArn = property.Value
+		case property.Parent == "STAGE_INTEGRATION" && property.Name == "STORAGE_INTEGRATION":
+			if property.Value != "" {
+				details.StorageIntegration = Pointer(NewAccountObjectIdentifier(property.Value))
+			}
+		case property.Name == "AWS_EXTERNAL_ID":
+			details.AwsExternalId = property.Value
+		case property.Name == "SNOWFLAKE_IAM_USER":
+			details.SnowflakeIamUser = property.Value
+		case property.Parent == "DIRECTORY" && property.Name == "ENABLE":
+			enable, err := strconv.ParseBool(property.Value)
+			if err != nil {
+				return nil, err
+			}
+			details.DirectoryEnable = enable
+		case property.Parent == "DIRECTORY" && property.Name == "AUTO_REFRESH":
+			autoRefresh, err := strconv.ParseBool(property.Value)
+			if err != nil {
+				return nil, err
+			}
+			details.DirectoryAutoRefresh = autoRefresh
+		case property.Parent == "DIRECTORY" && property.Name == "NOTIFICATION_CHANNEL":
+			details.DirectoryNotificationChannel = property.Value
+		case property.Parent == "DIRECTORY" && property.Name == "LAST_REFRESHED_ON":
+			details.DirectoryLastRefreshedOn = property.Value
+		}
+	}
+	return details, nil
+}
+
+// StagesExtended extends the generated Stages with the commands operating on the files in the stages.
+type StagesExtended interface {
+	Stages
+	List(ctx context.Context, request *ListStageRequest) ([]StageFile, error)
+	Put(ctx context.Context, request *PutStageRequest) error
+	Remove(ctx context.Context, request *RemoveStageRequest) error
+	CopyFiles(ctx context.Context, request *CopyFilesStageRequest) error
+}
+
+var (
+	_ validatable                            = new(listStageOptions)
+	_ validatable                            = new(putStageOptions)
+	_ validatable                            = new(removeStageOptions)
+	_ validatable                            = new(copyFilesStageOptions)
+	_ optionsProvider[listStageOptions]      = new(ListStageRequest)
+	_ optionsProvider[putStageOptions]       = new(PutStageRequest)
+	_ optionsProvider[removeStageOptions]    = new(RemoveStageRequest)
+	_ optionsProvider[copyFilesStageOptions] = new(CopyFilesStageRequest)
+	_ convertibleRow[StageFile]              = new(stageFileRow)
+)
+
+// listStageOptions is based on https://docs.snowflake.com/en/sql-reference/sql/list.
+type listStageOptions struct {
+	list     bool    `ddl:"static" sql:"LIST"`
+	Location string  `ddl:"keyword,single_quotes"`
+	Pattern  *string `ddl:"parameter,single_quotes" sql:"PATTERN"`
+}
+
+func (opts *listStageOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	// the location is only set for a valid stage identifier
+	if opts.Location == "" {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	return errors.Join(errs...)
+}
+
+type ListStageRequest struct {
+	stageId SchemaObjectIdentifier // required
+	Path    *string
+	Pattern *string
+}
+
+func NewListStageRequest(stageId SchemaObjectIdentifier) *ListStageRequest {
+	return &ListStageRequest{stageId: stageId}
+}
+
+func (s *ListStageRequest) WithPath(path string) *ListStageRequest {
+	s.Path = &path
+	return s
+}
+
+func (s *ListStageRequest) WithPattern(pattern string) *ListStageRequest {
+	s.Pattern = &pattern
+	return s
+}
+
+func (r *ListStageRequest) toOpts() *listStageOptions {
+	return &listStageOptions{
+		Location: stageLocation(r.stageId, r.Path),
+		Pattern:  r.Pattern,
+	}
+}
+
+// stageLocation returns the location of the given path on the stage, e.g. @"db"."schema"."stage"/path.
+// An empty location is returned for an invalid stage identifier. The location is rendered as a single-quoted string literal,
+// so that the paths containing spaces or quotes can be used.
+func stageLocation(stageId SchemaObjectIdentifier, path *string) string {
+	if !ValidObjectIdentifier(stageId) {
+		return ""
+	}
+	location := "@" + stageId.FullyQualifiedName()
+	if path != nil && *path != "" {
+		location += "/" + strings.TrimPrefix(*path, "/")
+	}
+	return location
+}
+
+type stageFileRow struct {
+	Name         string         `db:"name"`
+	Size         int64          `db:"size"`
+	Md5          sql.NullString `db:"md5"`
+	LastModified string         `db:"last_modified"`
+}
+
+// StageFile is a single file returned by LIST. For internal stages, the name is prefixed with the lowercase stage name;
+// for external stages, it is the full URL of the file.
+type StageFile struct {
+	Name         string
+	Size         int64
+	Md5          string
+	LastModified string
+}
+
+func (r stageFileRow) convert() *StageFile {
+	stageFile := &StageFile{
+		Name:         r.Name,
+		Size:         r.Size,
+		LastModified: r.LastModified,
+	}
+	if r.Md5.Valid {
+		stageFile.Md5 = r.Md5.String
+	}
+	return stageFile
+}
+
+func (v *stages) List(ctx context.Context, request *ListStageRequest) ([]StageFile, error) {
+	opts := request.toOpts()
+	rows, err := validateAndQuery[stageFileRow](v.client, ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return convertRows[stageFileRow, StageFile](rows), nil
+}
+
+// putStageOptions is based on https://docs.snowflake.com/en/sql-reference/sql/put.
+type putStageOptions struct {
+	put          bool   `ddl:"static" sql:"PUT"`
+	File         string `ddl:"keyword,single_quotes"`
+	Location     string `ddl:"keyword,single_quotes"`
+	Parallel     *int   `ddl:"parameter" sql:"PARALLEL"`
+	AutoCompress *bool  `ddl:"parameter" sql:"AUTO_COMPRESS"`
+	Overwrite    *bool  `ddl:"parameter" sql:"OVERWRITE"`
+}
+
+func (opts *putStageOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !strings.HasPrefix(opts.File, "file://") || opts.File == "file://" {
+		errs = append(errs, errNotSet("putStageOptions", "File"))
+	}
+	// the location is only set for a valid stage identifier
+	if opts.Location == "" {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if opts.Parallel != nil && !validateIntInRangeInclusive(*opts.Parallel, 1, 99) {
+		errs = append(errs, errIntBetween("putStageOptions", "Parallel", 1, 99))
+	}
+	return errors.Join(errs...)
+}
+
+// PutStageRequest uploads a local file to an internal stage. The file is uploaded under its own name,
+// optionally with the .gz extension added when AutoCompress is set.
+type PutStageRequest struct {
+	filePath     string                 // required
+	stageId      SchemaObjectIdentifier // required
+	Path         *string
+	Parallel     *int
+	AutoCompress *bool
+	Overwrite    *bool
+}
+
+func NewPutStageRequest(filePath string, stageId SchemaObjectIdentifier) *PutStageRequest {
+	return &PutStageRequest{filePath: filePath, stageId: stageId}
+}
+
+func (s *PutStageRequest) WithPath(path string) *PutStageRequest {
+	s.Path = &path
+	return s
+}
+
+func (s *PutStageRequest) WithParallel(parallel int) *PutStageRequest {
+	s.Parallel = &parallel
+	return s
+}
+
+func (s *PutStageRequest) WithAutoCompress(autoCompress bool) *PutStageRequest {
+	s.AutoCompress = &autoCompress
+	return s
+}
+
+func (s *PutStageRequest) WithOverwrite(overwrite bool) *PutStageRequest {
+	s.Overwrite = &overwrite
+	return s
+}
+
+func (r *PutStageRequest) toOpts() *putStageOptions {
+	return &putStageOptions{
+		File:         "file://" + r.filePath,
+		Location:     stageLocation(r.stageId, r.Path),
+		Parallel:     r.Parallel,
+		AutoCompress: r.AutoCompress,
+		Overwrite:    r.Overwrite,
+	}
+}
+
+// Put runs PUT through the file transfer of the driver, so the file has to be accessible from the machine running the client.
+func (v *stages) Put(ctx context.Context, request *PutStageRequest) error {
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+// removeStageOptions is based on https://docs.snowflake.com/en/sql-reference/sql/remove.
+type removeStageOptions struct {
+	remove   bool    `ddl:"static" sql:"REMOVE"`
+	Location string  `ddl:"keyword,single_quotes"`
+	Pattern  *string `ddl:"parameter,single_quotes" sql:"PATTERN"`
+}
+
+func (opts *removeStageOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	// the location is only set for a valid stage identifier
+	if opts.Location == "" {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	return errors.Join(errs...)
+}
+
+type RemoveStageRequest struct {
+	stageId SchemaObjectIdentifier // required
+	Path    *string
+	Pattern *string
+}
+
+func NewRemoveStageRequest(stageId SchemaObjectIdentifier) *RemoveStageRequest {
+	return &RemoveStageRequest{stageId: stageId}
+}
+
+func (s *RemoveStageRequest) WithPath(path string) *RemoveStageRequest {
+	s.Path = &path
+	return s
+}
+
+func (s *RemoveStageRequest) WithPattern(pattern string) *RemoveStageRequest {
+	s.Pattern = &pattern
+	return s
+}
+
+func (r *RemoveStageRequest) toOpts() *removeStageOptions {
+	return &removeStageOptions{
+		Location: stageLocation(r.stageId, r.Path),
+		Pattern:  r.Pattern,
+	}
+}
+
+// Remove removes all the files matching the given path prefix (and pattern) from the stage.
+func (v *stages) Remove(ctx context.Context, request *RemoveStageRequest) error {
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+// copyFilesStageOptions is based on https://docs.snowflake.com/en/sql-reference/sql/copy-files.
+type copyFilesStageOptions struct {
+	copyFiles bool    `ddl:"static" sql:"COPY FILES"`
+	Into      string  `ddl:"parameter,single_quotes,no_equals" sql:"INTO"`
+	From      string  `ddl:"parameter,no_quotes,no_equals" sql:"FROM"`
+	Pattern   *string `ddl:"parameter,single_quotes" sql:"PATTERN"`
+}
+
+func (opts *copyFilesStageOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if opts.Into == "" {
+		errs = append(errs, errNotSet("copyFilesStageOptions", "Into"))
+	}
+	if opts.From == "" {
+		errs = append(errs, errNotSet("copyFilesStageOptions", "From"))
+	}
+	return errors.Join(errs...)
+}
+
+// CopyFilesStageRequest copies files between two locations. The locations are passed as-is, so besides stage locations
+// like @"db"."schema"."stage"/path, they can also be, e.g., the locations of Streamlit versions like snow://streamlit/db.schema.app/versions/live/.
+type CopyFilesStageRequest struct {
+	into    string // required
+	from    string // required
+	Pattern *string
+}
+
+func NewCopyFilesStageRequest(into string, from string) *CopyFilesStageRequest {
+	return &CopyFilesStageRequest{into: into, from: from}
+}
+
+func (s *CopyFilesStageRequest) WithPattern(pattern string) *CopyFilesStageRequest {
+	s.Pattern = &pattern
+	return s
+}
+
+func (r *CopyFilesStageRequest) toOpts() *copyFilesStageOptions {
+	return &copyFilesStageOptions{
+		Into:    r.into,
+		From:    r.from,
+		Pattern: r.Pattern,
+	}
+}
+
+func (v *stages) CopyFiles(ctx context.Context, request *CopyFilesStageRequest) error {
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
@@ -0,0 +1,201 @@
+package sdk
+
+import (
+	"database/sql"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ParseStageDetails(t *testing.T) {
+	t.Run("external stage", func(t *testing.T) {
+		details, err := ParseStageDetails([]StageProperty{
+			{Parent: "STAGE_FILE_FORMAT", Name: "TYPE", Type: "String", Value: "JSON", Default: "CSV"},
+			{Parent: "STAGE_LOCATION", Name: "URL", Type: "String", Value: `["s3://bucket/path/"]`},
+			{Parent: "STAGE_INTEGRATION", Name: "STORAGE_INTEGRATION", Type: "String", Value: "MY_INTEGRATION"},
+			{Parent: "STAGE_CREDENTIALS", Name: "AWS_EXTERNAL_ID", Type: "String", Value: "external_id"},
+			{Parent: "STAGE_CREDENTIALS", Name: "SNOWFLAKE_IAM_USER", Type: "String", Value: "arn:aws:iam::123:user/abc"},
+			{Parent: "DIRECTORY", Name: "ENABLE", Type: "Boolean", Value: "true", Default: "false"},
+			{Parent: "DIRECTORY", Name: "AUTO_REFRESH", Type: "Boolean", Value: "false", Default: "false"},
+			{Parent: "DIRECTORY", Name: "NOTIFICATION_CHANNEL", Type: "String", Value: "arn:aws:sqs:us-west-2:123:sf-snowpipe"},
+			{Parent: "DIRECTORY", Name: "LAST_REFRESHED_ON", Type: "Timestamp", Value: "2024-01-01 10:00:00.000 -0800"},
+		})
+		require.NoError(t, err)
+
+		assert.Nil(t, details.FileFormatName)
+		assert.Equal(t, FileFormatTypeJSON, *details.FileFormatType)
+		assert.Equal(t, "s3://bucket/path/", details.Url)
+		assert.Equal(t, NewAccountObjectIdentifier("MY_INTEGRATION"), *details.StorageIntegration)
+		assert.Equal(t, "external_id", details.AwsExternalId)
+		assert.Equal(t, "arn:aws:iam::123:user/abc", details.SnowflakeIamUser)
+		assert.True(t, details.DirectoryEnable)
+		assert.False(t, details.DirectoryAutoRefresh)
+		assert.Equal(t, "arn:aws:sqs:us-west-2:123:sf-snowpipe", details.DirectoryNotificationChannel)
+		assert.Equal(t, "2024-01-01 10:00:00.000 -0800", details.DirectoryLastRefreshedOn)
+	})
+
+	t.Run("internal stage with named file format", func(t *testing.T) {
+		details, err := ParseStageDetails([]StageProperty{
+			{Parent: "STAGE_FILE_FORMAT", Name: "FORMAT_NAME", Type: "String", Value: `"DB"."SCHEMA"."FORMAT"`},
+			{Parent: "STAGE_LOCATION", Name: "URL", Type: "String", Value: ""},
+			{Parent: "STAGE_INTEGRATION", Name: "STORAGE_INTEGRATION", Type: "String", Value: ""},
+			{Parent: "DIRECTORY", Name: "ENABLE", Type: "Boolean", Value: "false", Default: "false"},
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, `"DB"."SCHEMA"."FORMAT"`, *details.FileFormatName)
+		assert.Nil(t, details.FileFormatType)
+		assert.Empty(t, details.Url)
+		assert.Nil(t, details.StorageIntegration)
+		assert.False(t, details.DirectoryEnable)
+	})
+
+	t.Run("invalid file format type", func(t *testing.T) {
+		_, err := ParseStageDetails([]StageProperty{
+			{Parent: "STAGE_FILE_FORMAT", Name: "TYPE", Type: "String", Value: "UNKNOWN"},
+		})
+		require.ErrorContains(t, err, "invalid file format type: UNKNOWN")
+	})
+}
+
+func TestStages_List(t *testing.T) {
+	id := randomSchemaObjectIdentifier()
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *listStageOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [stageId]", func(t *testing.T) {
+		opts := NewListStageRequest(emptySchemaObjectIdentifier).toOpts()
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		opts := NewListStageRequest(id).toOpts()
+		assertOptsValidAndSQLEquals(t, opts, `LIST '@%s'`, escapedStageLocation(id))
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		opts := NewListStageRequest(id).WithPath("/data/2024").WithPattern(".*[.]csv").toOpts()
+		assertOptsValidAndSQLEquals(t, opts, `LIST '@%s/data/2024' PATTERN = '.*[.]csv'`, escapedStageLocation(id))
+	})
+
+	t.Run("path with spaces and quotes", func(t *testing.T) {
+		opts := NewListStageRequest(id).WithPath("my data/it's \"quoted\"").toOpts()
+		assertOptsValidAndSQLEquals(t, opts, `LIST '@%s/my data/it\'s \"quoted\"'`, escapedStageLocation(id))
+	})
+
+}
+
+// escapedStageLocation returns the stage location as it is escaped inside a single-quoted string literal.
+func escapedStageLocation(id SchemaObjectIdentifier) string {
+	return strings.ReplaceAll(id.FullyQualifiedName(), `"`, `\"`)
+}
+
+func Test_stageFileRow_convert(t *testing.T) {
+	row := stageFileRow{Name: "stage/data.csv", Size: 16, Md5: sql.NullString{String: "abc", Valid: true}, LastModified: "Mon, 19 Oct 2026 08:00:00 GMT"}
+	assert.Equal(t, &StageFile{Name: "stage/data.csv", Size: 16, Md5: "abc", LastModified: "Mon, 19 Oct 2026 08:00:00 GMT"}, row.convert())
+
+	row.Md5 = sql.NullString{}
+	assert.Empty(t, row.convert().Md5)
+}
+
+func TestStages_Put(t *testing.T) {
+	id := randomSchemaObjectIdentifier()
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *putStageOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: file not set", func(t *testing.T) {
+		opts := NewPutStageRequest("", id).toOpts()
+		assertOptsInvalidJoinedErrors(t, opts, errNotSet("putStageOptions", "File"))
+	})
+
+	t.Run("validation: valid identifier for [stageId]", func(t *testing.T) {
+		opts := NewPutStageRequest("/tmp/data.csv", emptySchemaObjectIdentifier).toOpts()
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("validation: parallel out of range", func(t *testing.T) {
+		opts := NewPutStageRequest("/tmp/data.csv", id).WithParallel(100).toOpts()
+		assertOptsInvalidJoinedErrors(t, opts, errIntBetween("putStageOptions", "Parallel", 1, 99))
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		opts := NewPutStageRequest("/tmp/data.csv", id).toOpts()
+		assertOptsValidAndSQLEquals(t, opts, `PUT 'file:///tmp/data.csv' '@%s'`, escapedStageLocation(id))
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		opts := NewPutStageRequest("/tmp/data.csv", id).WithPath("data/").WithParallel(4).WithAutoCompress(false).WithOverwrite(true).toOpts()
+		assertOptsValidAndSQLEquals(t, opts, `PUT 'file:///tmp/data.csv' '@%s/data/' PARALLEL = 4 AUTO_COMPRESS = false OVERWRITE = true`, escapedStageLocation(id))
+	})
+
+	t.Run("paths with spaces and quotes", func(t *testing.T) {
+		opts := NewPutStageRequest("/tmp/my data/it's \"quoted\".csv", id).WithPath("my data/it's/").toOpts()
+		assertOptsValidAndSQLEquals(t, opts, `PUT 'file:///tmp/my data/it\'s \"quoted\".csv' '@%s/my data/it\'s/'`, escapedStageLocation(id))
+	})
+
+	t.Run("windows path", func(t *testing.T) {
+		opts := NewPutStageRequest(`C:\Users\me\data.csv`, id).toOpts()
+		assertOptsValidAndSQLEquals(t, opts, `PUT 'file://C:\\Users\\me\\data.csv' '@%s'`, escapedStageLocation(id))
+	})
+}
+
+func TestStages_Remove(t *testing.T) {
+	id := randomSchemaObjectIdentifier()
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *removeStageOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [stageId]", func(t *testing.T) {
+		opts := NewRemoveStageRequest(emptySchemaObjectIdentifier).toOpts()
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		opts := NewRemoveStageRequest(id).toOpts()
+		assertOptsValidAndSQLEquals(t, opts, `REMOVE '@%s'`, escapedStageLocation(id))
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		opts := NewRemoveStageRequest(id).WithPath("data/data.csv").WithPattern(".*/data[.]csv").toOpts()
+		assertOptsValidAndSQLEquals(t, opts, `REMOVE '@%s/data/data.csv' PATTERN = '.*/data[.]csv'`, escapedStageLocation(id))
+	})
+
+	t.Run("path with spaces and quotes", func(t *testing.T) {
+		opts := NewRemoveStageRequest(id).WithPath("my data/it's \"quoted\".csv").WithPattern(`.*/it's\.csv`).toOpts()
+		assertOptsValidAndSQLEquals(t, opts, `REMOVE '@%s/my data/it\'s \"quoted\".csv' PATTERN = '.*/it\'s\\.csv'`, escapedStageLocation(id))
+	})
+}
+
+func TestStages_CopyFiles(t *testing.T) {
+	id := randomSchemaObjectIdentifier()
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *copyFilesStageOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: locations not set", func(t *testing.T) {
+		opts := NewCopyFilesStageRequest("", "").toOpts()
+		assertOptsInvalidJoinedErrors(t, opts, errNotSet("copyFilesStageOptions", "Into"), errNotSet("copyFilesStageOptions", "From"))
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		opts := NewCopyFilesStageRequest("snow://streamlit/db.schema.app/versions/live/", "@"+id.FullyQualifiedName()+"/app/").toOpts()
+		assertOptsValidAndSQLEquals(t, opts, `COPY FILES INTO 'snow://streamlit/db.schema.app/versions/live/' FROM @%s/app/`, id.FullyQualifiedName())
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		opts := NewCopyFilesStageRequest("snow://streamlit/db.schema.app/versions/live/", "@"+id.FullyQualifiedName()).WithPattern(".*[.]py").toOpts()
+		assertOptsValidAndSQLEquals(t, opts, `COPY FILES INTO 'snow://streamlit/db.schema.app/versions/live/' FROM @%s PATTERN = '.*[.]py'`, id.FullyQualifiedName())
+	})
+}
@@ -3,18 +3,22 @@ package sdk
 import g "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/poc/generator"
 
 //go:generate go run ./poc/main.go
-var externalAccessIntegrations = g.NewQueryStruct("ExternalAccessIntegrations").
+var streamlitExternalAccessIntegrations = g.NewQueryStruct("StreamlitExternalAccessIntegrations").
 	List("ExternalAccessIntegrations", "AccountObjectIdentifier", g.ListOptions().Required().MustParentheses())
 
+var streamlitImport = g.NewQueryStruct("StreamlitImport").
+	Text("Import", g.KeywordOptions().SingleQuotes())
+
 var streamlitSet = g.NewQueryStruct("StreamlitSet").
 	OptionalTextAssignment("ROOT_LOCATION", g.ParameterOptions().SingleQuotes()).
 	OptionalTextAssignment("MAIN_FILE", g.ParameterOptions().SingleQuotes()).
 	OptionalIdentifier("QueryWarehouse", g.KindOfT[AccountObjectIdentifier](), g.IdentifierOptions().Equals().SQL("QUERY_WAREHOUSE")).
-	OptionalQueryStructField("ExternalAccessIntegrations", externalAccessIntegrations, g.ParameterOptions().SQL("EXTERNAL_ACCESS_INTEGRATIONS").Parentheses()).
+	ListQueryStructField("Imports", streamlitImport, g.ParameterOptions().Parentheses().SQL("IMPORTS")).
+	OptionalQueryStructField("ExternalAccessIntegrations", streamlitExternalAccessIntegrations, g.ParameterOptions().SQL("EXTERNAL_ACCESS_INTEGRATIONS").Parentheses()).
 	OptionalTextAssignment("COMMENT", g.ParameterOptions().SingleQuotes()).
 	OptionalTextAssignment("TITLE", g.ParameterOptions().SingleQuotes()).
 	WithValidation(g.ValidIdentifierIfSet, "QueryWarehouse").
-	WithValidation(g.AtLeastOneValueSet, "RootLocation", "MainFile", "QueryWarehouse", "ExternalAccessIntegrations", "Comment", "Title")
+	WithValidation(g.AtLeastOneValueSet, "RootLocation", "MainFile", "QueryWarehouse", "Imports", "ExternalAccessIntegrations", "Comment", "Title")
 
 var streamlitUnset = g.NewQueryStruct("StreamlitUnset").
 	OptionalSQL("QUERY_WAREHOUSE").
@@ -34,15 +38,20 @@ var StreamlitsDef = g.NewInterface(
 		SQL("STREAMLIT").
 		IfNotExists().
 		Name().
-		TextAssignment("ROOT_LOCATION", g.ParameterOptions().SingleQuotes().Required()).
+		OptionalTextAssignment("FROM", g.ParameterOptions().NoEquals().NoQuotes()).
+		OptionalTextAssignment("VERSION", g.ParameterOptions().NoEquals().NoQuotes()).
+		OptionalTextAssignment("ROOT_LOCATION", g.ParameterOptions().SingleQuotes()).
 		TextAssignment("MAIN_FILE", g.ParameterOptions().SingleQuotes().Required()).
 		OptionalIdentifier("QueryWarehouse", g.KindOfT[AccountObjectIdentifier](), g.IdentifierOptions().Equals().SQL("QUERY_WAREHOUSE")).
-		OptionalQueryStructField("ExternalAccessIntegrations", externalAccessIntegrations, g.ParameterOptions().SQL("EXTERNAL_ACCESS_INTEGRATIONS").Parentheses()).
+		ListQueryStructField("Imports", streamlitImport, g.ParameterOptions().Parentheses().SQL("IMPORTS")).
+		OptionalQueryStructField("ExternalAccessIntegrations", streamlitExternalAccessIntegrations, g.ParameterOptions().SQL("EXTERNAL_ACCESS_INTEGRATIONS").Parentheses()).
 		OptionalTextAssignment("TITLE", g.ParameterOptions().SingleQuotes()).
 		OptionalTextAssignment("COMMENT", g.ParameterOptions().SingleQuotes()).
 		WithValidation(g.ValidIdentifier, "name").
 		WithValidation(g.ValidIdentifierIfSet, "QueryWarehouse").
-		WithValidation(g.ConflictingFields, "IfNotExists", "OrReplace"),
+		WithValidation(g.ConflictingFields, "IfNotExists", "OrReplace").
+		WithValidation(g.ExactlyOneValueSet, "From", "RootLocation").
+		WithValidation(g.ConflictingFields, "Version", "RootLocation"),
 ).AlterOperation(
 	"https://docs.snowflake.com/en/sql-reference/sql/alter-streamlit",
 	g.NewQueryStruct("AlterStreamlit").
@@ -61,9 +70,11 @@ var StreamlitsDef = g.NewInterface(
 			g.ListOptions().NoParentheses().SQL("UNSET"),
 		).
 		Identifier("RenameTo", g.KindOfTPointer[SchemaObjectIdentifier](), g.IdentifierOptions().SQL("RENAME TO")).
+		OptionalSQL("ADD LIVE VERSION FROM LAST").
+		OptionalSQL("COMMIT").
 		WithValidation(g.ValidIdentifier, "name").
 		WithValidation(g.ValidIdentifierIfSet, "RenameTo").
-		WithValidation(g.ExactlyOneValueSet, "RenameTo", "Set", "Unset"),
+		WithValidation(g.ExactlyOneValueSet, "RenameTo", "Set", "Unset", "AddLiveVersionFromLast", "Commit"),
 ).DropOperation(
 	"https://docs.snowflake.com/en/sql-reference/sql/drop-streamlit",
 	g.NewQueryStruct("DropStreamlit").
@@ -112,7 +123,7 @@ var StreamlitsDef = g.NewInterface(
 	g.DbStruct("streamlitsDetailRow").
 		Field("name", "string").
 		Field("title", "sql.NullString").
-		Field("root_location", "string").
+		Field("root_location", "sql.NullString").
 		Field("main_file", "string").
 		Field("query_warehouse", "sql.NullString").
 		Field("url_id", "string").
@@ -120,7 +131,12 @@ var StreamlitsDef = g.NewInterface(
 		Field("user_packages", "string").
 		Field("import_urls", "string").
 		Field("external_access_integrations", "string").
-		Field("external_access_secrets", "string"),
+		Field("external_access_secrets", "string").
+		Field("default_version", "sql.NullString").
+		Field("default_version_name", "sql.NullString").
+		Field("default_version_source_location_uri", "sql.NullString").
+		Field("last_version_name", "sql.NullString").
+		Field("live_version_location_uri", "sql.NullString"),
 	g.PlainStruct("StreamlitDetail").
 		Field("Name", "string").
 		Field("Title", "string").
@@ -132,7 +148,12 @@ var StreamlitsDef = g.NewInterface(
 		Field("UserPackages", "[]string").
 		Field("ImportUrls", "[]string").
 		Field("ExternalAccessIntegrations", "[]string").
-		Field("ExternalAccessSecrets", "string"),
+		Field("ExternalAccessSecrets", "string").
+		Field("DefaultVersion", "string").
+		Field("DefaultVersionName", "string").
+		Field("DefaultVersionSourceLocationUri", "string").
+		Field("LastVersionName", "string").
+		Field("LiveVersionLocationUri", "string"),
 	g.NewQueryStruct("DescribeStreamlit").
 		Describe().
 		SQL("STREAMLIT").
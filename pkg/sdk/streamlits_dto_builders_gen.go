@@ -6,12 +6,10 @@ import ()
 
 func NewCreateStreamlitRequest(
 	name SchemaObjectIdentifier,
-	RootLocation string,
 	MainFile string,
 ) *CreateStreamlitRequest {
 	s := CreateStreamlitRequest{}
 	s.name = name
-	s.RootLocation = RootLocation
 	s.MainFile = MainFile
 	return &s
 }
@@ -26,12 +24,32 @@ func (s *CreateStreamlitRequest) WithIfNotExists(IfNotExists bool) *CreateStream
 	return s
 }
 
+func (s *CreateStreamlitRequest) WithFrom(From string) *CreateStreamlitRequest {
+	s.From = &From
+	return s
+}
+
+func (s *CreateStreamlitRequest) WithVersion(Version string) *CreateStreamlitRequest {
+	s.Version = &Version
+	return s
+}
+
+func (s *CreateStreamlitRequest) WithRootLocation(RootLocation string) *CreateStreamlitRequest {
+	s.RootLocation = &RootLocation
+	return s
+}
+
 func (s *CreateStreamlitRequest) WithQueryWarehouse(QueryWarehouse AccountObjectIdentifier) *CreateStreamlitRequest {
 	s.QueryWarehouse = &QueryWarehouse
 	return s
 }
 
-func (s *CreateStreamlitRequest) WithExternalAccessIntegrations(ExternalAccessIntegrations ExternalAccessIntegrationsRequest) *CreateStreamlitRequest {
+func (s *CreateStreamlitRequest) WithImports(Imports []StreamlitImportRequest) *CreateStreamlitRequest {
+	s.Imports = Imports
+	return s
+}
+
+func (s *CreateStreamlitRequest) WithExternalAccessIntegrations(ExternalAccessIntegrations StreamlitExternalAccessIntegrationsRequest) *CreateStreamlitRequest {
 	s.ExternalAccessIntegrations = &ExternalAccessIntegrations
 	return s
 }
@@ -46,10 +64,19 @@ func (s *CreateStreamlitRequest) WithComment(Comment string) *CreateStreamlitReq
 	return s
 }
 
-func NewExternalAccessIntegrationsRequest(
+func NewStreamlitImportRequest() *StreamlitImportRequest {
+	return &StreamlitImportRequest{}
+}
+
+func (s *StreamlitImportRequest) WithImport(Import string) *StreamlitImportRequest {
+	s.Import = Import
+	return s
+}
+
+func NewStreamlitExternalAccessIntegrationsRequest(
 	ExternalAccessIntegrations []AccountObjectIdentifier,
-) *ExternalAccessIntegrationsRequest {
-	s := ExternalAccessIntegrationsRequest{}
+) *StreamlitExternalAccessIntegrationsRequest {
+	s := StreamlitExternalAccessIntegrationsRequest{}
 	s.ExternalAccessIntegrations = ExternalAccessIntegrations
 	return &s
 }
@@ -82,6 +109,16 @@ func (s *AlterStreamlitRequest) WithRenameTo(RenameTo SchemaObjectIdentifier) *A
 	return s
 }
 
+func (s *AlterStreamlitRequest) WithAddLiveVersionFromLast(AddLiveVersionFromLast bool) *AlterStreamlitRequest {
+	s.AddLiveVersionFromLast = &AddLiveVersionFromLast
+	return s
+}
+
+func (s *AlterStreamlitRequest) WithCommit(Commit bool) *AlterStreamlitRequest {
+	s.Commit = &Commit
+	return s
+}
+
 func NewStreamlitSetRequest() *StreamlitSetRequest {
 	return &StreamlitSetRequest{}
 }
@@ -101,7 +138,12 @@ func (s *StreamlitSetRequest) WithQueryWarehouse(QueryWarehouse AccountObjectIde
 	return s
 }
 
-func (s *StreamlitSetRequest) WithExternalAccessIntegrations(ExternalAccessIntegrations ExternalAccessIntegrationsRequest) *StreamlitSetRequest {
+func (s *StreamlitSetRequest) WithImports(Imports []StreamlitImportRequest) *StreamlitSetRequest {
+	s.Imports = Imports
+	return s
+}
+
+func (s *StreamlitSetRequest) WithExternalAccessIntegrations(ExternalAccessIntegrations StreamlitExternalAccessIntegrationsRequest) *StreamlitSetRequest {
 	s.ExternalAccessIntegrations = &ExternalAccessIntegrations
 	return s
 }
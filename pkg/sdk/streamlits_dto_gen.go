@@ -14,31 +14,41 @@ type CreateStreamlitRequest struct {
 	OrReplace                  *bool
 	IfNotExists                *bool
 	name                       SchemaObjectIdentifier // required
-	RootLocation               string                 // required
-	MainFile                   string                 // required
+	From                       *string
+	Version                    *string
+	RootLocation               *string
+	MainFile                   string // required
 	QueryWarehouse             *AccountObjectIdentifier
-	ExternalAccessIntegrations *ExternalAccessIntegrationsRequest
+	Imports                    []StreamlitImportRequest
+	ExternalAccessIntegrations *StreamlitExternalAccessIntegrationsRequest
 	Title                      *string
 	Comment                    *string
 }
 
-type ExternalAccessIntegrationsRequest struct {
+type StreamlitImportRequest struct {
+	Import string
+}
+
+type StreamlitExternalAccessIntegrationsRequest struct {
 	ExternalAccessIntegrations []AccountObjectIdentifier // required
 }
 
 type AlterStreamlitRequest struct {
-	IfExists *bool
-	name     SchemaObjectIdentifier // required
-	Set      *StreamlitSetRequest
-	Unset    *StreamlitUnsetRequest
-	RenameTo *SchemaObjectIdentifier
+	IfExists               *bool
+	name                   SchemaObjectIdentifier // required
+	Set                    *StreamlitSetRequest
+	Unset                  *StreamlitUnsetRequest
+	RenameTo               *SchemaObjectIdentifier
+	AddLiveVersionFromLast *bool
+	Commit                 *bool
 }
 
 type StreamlitSetRequest struct {
 	RootLocation               *string
 	MainFile                   *string
 	QueryWarehouse             *AccountObjectIdentifier
-	ExternalAccessIntegrations *ExternalAccessIntegrationsRequest
+	Imports                    []StreamlitImportRequest
+	ExternalAccessIntegrations *StreamlitExternalAccessIntegrationsRequest
 	Comment                    *string
 	Title                      *string
 }
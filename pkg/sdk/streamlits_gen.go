@@ -16,41 +16,51 @@ type Streamlits interface {
 
 // CreateStreamlitOptions is based on https://docs.snowflake.com/en/sql-reference/sql/create-streamlit.
 type CreateStreamlitOptions struct {
-	create                     bool                        `ddl:"static" sql:"CREATE"`
-	OrReplace                  *bool                       `ddl:"keyword" sql:"OR REPLACE"`
-	streamlit                  bool                        `ddl:"static" sql:"STREAMLIT"`
-	IfNotExists                *bool                       `ddl:"keyword" sql:"IF NOT EXISTS"`
-	name                       SchemaObjectIdentifier      `ddl:"identifier"`
-	RootLocation               string                      `ddl:"parameter,single_quotes" sql:"ROOT_LOCATION"`
-	MainFile                   string                      `ddl:"parameter,single_quotes" sql:"MAIN_FILE"`
-	QueryWarehouse             *AccountObjectIdentifier    `ddl:"identifier,equals" sql:"QUERY_WAREHOUSE"`
-	ExternalAccessIntegrations *ExternalAccessIntegrations `ddl:"parameter,parentheses" sql:"EXTERNAL_ACCESS_INTEGRATIONS"`
-	Title                      *string                     `ddl:"parameter,single_quotes" sql:"TITLE"`
-	Comment                    *string                     `ddl:"parameter,single_quotes" sql:"COMMENT"`
+	create                     bool                                 `ddl:"static" sql:"CREATE"`
+	OrReplace                  *bool                                `ddl:"keyword" sql:"OR REPLACE"`
+	streamlit                  bool                                 `ddl:"static" sql:"STREAMLIT"`
+	IfNotExists                *bool                                `ddl:"keyword" sql:"IF NOT EXISTS"`
+	name                       SchemaObjectIdentifier               `ddl:"identifier"`
+	From                       *string                              `ddl:"parameter,no_quotes,no_equals" sql:"FROM"`
+	Version                    *string                              `ddl:"parameter,no_quotes,no_equals" sql:"VERSION"`
+	RootLocation               *string                              `ddl:"parameter,single_quotes" sql:"ROOT_LOCATION"`
+	MainFile                   string                               `ddl:"parameter,single_quotes" sql:"MAIN_FILE"`
+	QueryWarehouse             *AccountObjectIdentifier             `ddl:"identifier,equals" sql:"QUERY_WAREHOUSE"`
+	Imports                    []StreamlitImport                    `ddl:"parameter,parentheses" sql:"IMPORTS"`
+	ExternalAccessIntegrations *StreamlitExternalAccessIntegrations `ddl:"parameter,parentheses" sql:"EXTERNAL_ACCESS_INTEGRATIONS"`
+	Title                      *string                              `ddl:"parameter,single_quotes" sql:"TITLE"`
+	Comment                    *string                              `ddl:"parameter,single_quotes" sql:"COMMENT"`
 }
 
-type ExternalAccessIntegrations struct {
+type StreamlitImport struct {
+	Import string `ddl:"keyword,single_quotes"`
+}
+
+type StreamlitExternalAccessIntegrations struct {
 	ExternalAccessIntegrations []AccountObjectIdentifier `ddl:"list,must_parentheses"`
 }
 
 // AlterStreamlitOptions is based on https://docs.snowflake.com/en/sql-reference/sql/alter-streamlit.
 type AlterStreamlitOptions struct {
-	alter     bool                    `ddl:"static" sql:"ALTER"`
-	streamlit bool                    `ddl:"static" sql:"STREAMLIT"`
-	IfExists  *bool                   `ddl:"keyword" sql:"IF EXISTS"`
-	name      SchemaObjectIdentifier  `ddl:"identifier"`
-	Set       *StreamlitSet           `ddl:"keyword" sql:"SET"`
-	Unset     *StreamlitUnset         `ddl:"list,no_parentheses" sql:"UNSET"`
-	RenameTo  *SchemaObjectIdentifier `ddl:"identifier" sql:"RENAME TO"`
+	alter                  bool                    `ddl:"static" sql:"ALTER"`
+	streamlit              bool                    `ddl:"static" sql:"STREAMLIT"`
+	IfExists               *bool                   `ddl:"keyword" sql:"IF EXISTS"`
+	name                   SchemaObjectIdentifier  `ddl:"identifier"`
+	Set                    *StreamlitSet           `ddl:"keyword" sql:"SET"`
+	Unset                  *StreamlitUnset         `ddl:"list,no_parentheses" sql:"UNSET"`
+	RenameTo               *SchemaObjectIdentifier `ddl:"identifier" sql:"RENAME TO"`
+	AddLiveVersionFromLast *bool                   `ddl:"keyword" sql:"ADD LIVE VERSION FROM LAST"`
+	Commit                 *bool                   `ddl:"keyword" sql:"COMMIT"`
 }
 
 type StreamlitSet struct {
-	RootLocation               *string                     `ddl:"parameter,single_quotes" sql:"ROOT_LOCATION"`
-	MainFile                   *string                     `ddl:"parameter,single_quotes" sql:"MAIN_FILE"`
-	QueryWarehouse             *AccountObjectIdentifier    `ddl:"identifier,equals" sql:"QUERY_WAREHOUSE"`
-	ExternalAccessIntegrations *ExternalAccessIntegrations `ddl:"parameter,parentheses" sql:"EXTERNAL_ACCESS_INTEGRATIONS"`
-	Comment                    *string                     `ddl:"parameter,single_quotes" sql:"COMMENT"`
-	Title                      *string                     `ddl:"parameter,single_quotes" sql:"TITLE"`
+	RootLocation               *string                              `ddl:"parameter,single_quotes" sql:"ROOT_LOCATION"`
+	MainFile                   *string                              `ddl:"parameter,single_quotes" sql:"MAIN_FILE"`
+	QueryWarehouse             *AccountObjectIdentifier             `ddl:"identifier,equals" sql:"QUERY_WAREHOUSE"`
+	Imports                    []StreamlitImport                    `ddl:"parameter,parentheses" sql:"IMPORTS"`
+	ExternalAccessIntegrations *StreamlitExternalAccessIntegrations `ddl:"parameter,parentheses" sql:"EXTERNAL_ACCESS_INTEGRATIONS"`
+	Comment                    *string                              `ddl:"parameter,single_quotes" sql:"COMMENT"`
+	Title                      *string                              `ddl:"parameter,single_quotes" sql:"TITLE"`
 }
 
 type StreamlitUnset struct {
@@ -115,29 +125,39 @@ type DescribeStreamlitOptions struct {
 }
 
 type streamlitsDetailRow struct {
-	Name                       string         `db:"name"`
-	Title                      sql.NullString `db:"title"`
-	RootLocation               string         `db:"root_location"`
-	MainFile                   string         `db:"main_file"`
-	QueryWarehouse             sql.NullString `db:"query_warehouse"`
-	UrlId                      string         `db:"url_id"`
-	DefaultPackages            string         `db:"default_packages"`
-	UserPackages               string         `db:"user_packages"`
-	ImportUrls                 string         `db:"import_urls"`
-	ExternalAccessIntegrations string         `db:"external_access_integrations"`
-	ExternalAccessSecrets      string         `db:"external_access_secrets"`
+	Name                            string         `db:"name"`
+	Title                           sql.NullString `db:"title"`
+	RootLocation                    sql.NullString `db:"root_location"`
+	MainFile                        string         `db:"main_file"`
+	QueryWarehouse                  sql.NullString `db:"query_warehouse"`
+	UrlId                           string         `db:"url_id"`
+	DefaultPackages                 string         `db:"default_packages"`
+	UserPackages                    string         `db:"user_packages"`
+	ImportUrls                      string         `db:"import_urls"`
+	ExternalAccessIntegrations      string         `db:"external_access_integrations"`
+	ExternalAccessSecrets           string         `db:"external_access_secrets"`
+	DefaultVersion                  sql.NullString `db:"default_version"`
+	DefaultVersionName              sql.NullString `db:"default_version_name"`
+	DefaultVersionSourceLocationUri sql.NullString `db:"default_version_source_location_uri"`
+	LastVersionName                 sql.NullString `db:"last_version_name"`
+	LiveVersionLocationUri          sql.NullString `db:"live_version_location_uri"`
 }
 
 type StreamlitDetail struct {
-	Name                       string
-	Title                      string
-	RootLocation               string
-	MainFile                   string
-	QueryWarehouse             string
-	UrlId                      string
-	DefaultPackages            string
-	UserPackages               []string
-	ImportUrls                 []string
-	ExternalAccessIntegrations []string
-	ExternalAccessSecrets      string
+	Name                            string
+	Title                           string
+	RootLocation                    string
+	MainFile                        string
+	QueryWarehouse                  string
+	UrlId                           string
+	DefaultPackages                 string
+	UserPackages                    []string
+	ImportUrls                      []string
+	ExternalAccessIntegrations      []string
+	ExternalAccessSecrets           string
+	DefaultVersion                  string
+	DefaultVersionName              string
+	DefaultVersionSourceLocationUri string
+	LastVersionName                 string
+	LiveVersionLocationUri          string
 }
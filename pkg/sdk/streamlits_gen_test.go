@@ -29,11 +29,39 @@ func TestStreamlits_Create(t *testing.T) {
 		assertOptsInvalidJoinedErrors(t, opts, errOneOf("CreateStreamlitOptions", "IfNotExists", "OrReplace"))
 	})
 
+	t.Run("validation: exactly one field from [opts.From opts.RootLocation] should be present", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("CreateStreamlitOptions", "From", "RootLocation"))
+	})
+
+	t.Run("validation: exactly one field from [opts.From opts.RootLocation] should be present - both present", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.From = String("@test")
+		opts.RootLocation = String("@test")
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("CreateStreamlitOptions", "From", "RootLocation"))
+	})
+
+	t.Run("validation: conflicting fields for [opts.Version opts.RootLocation]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Version = String("V1")
+		opts.RootLocation = String("@test")
+		assertOptsInvalidJoinedErrors(t, opts, errOneOf("CreateStreamlitOptions", "Version", "RootLocation"))
+	})
+
+	t.Run("from source location", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.From = String("@test/app")
+		opts.Version = String("V1")
+		opts.MainFile = "streamlit_app.py"
+		opts.Imports = []StreamlitImport{{Import: "@test/libs/a.zip"}, {Import: "@test/libs/b.py"}}
+		assertOptsValidAndSQLEquals(t, opts, `CREATE STREAMLIT %s FROM @test/app VERSION V1 MAIN_FILE = 'streamlit_app.py' IMPORTS = ('@test/libs/a.zip', '@test/libs/b.py')`, id.FullyQualifiedName())
+	})
+
 	t.Run("all options", func(t *testing.T) {
 		warehouse := NewAccountObjectIdentifier("test_warehouse")
 		opts := defaultOpts()
 		opts.IfNotExists = Bool(true)
-		opts.RootLocation = "@test"
+		opts.RootLocation = String("@test")
 		opts.MainFile = "manifest.yml"
 		opts.QueryWarehouse = &warehouse
 		opts.Comment = String("test")
@@ -64,7 +92,7 @@ func TestStreamlits_Alter(t *testing.T) {
 
 	t.Run("validation: exactly one field should be present", func(t *testing.T) {
 		opts := defaultOpts()
-		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("AlterStreamlitOptions", "RenameTo", "Set", "Unset"))
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("AlterStreamlitOptions", "RenameTo", "Set", "Unset", "AddLiveVersionFromLast", "Commit"))
 	})
 
 	t.Run("alter: set options", func(t *testing.T) {
@@ -76,11 +104,12 @@ func TestStreamlits_Alter(t *testing.T) {
 			RootLocation:               String("@test"),
 			MainFile:                   String("manifest.yml"),
 			QueryWarehouse:             &warehouse,
-			ExternalAccessIntegrations: &ExternalAccessIntegrations{[]AccountObjectIdentifier{integration}},
+			Imports:                    []StreamlitImport{{Import: "@test/libs/a.zip"}},
+			ExternalAccessIntegrations: &StreamlitExternalAccessIntegrations{[]AccountObjectIdentifier{integration}},
 			Comment:                    String("test"),
 			Title:                      String("foo"),
 		}
-		assertOptsValidAndSQLEquals(t, opts, `ALTER STREAMLIT IF EXISTS %s SET ROOT_LOCATION = '@test' MAIN_FILE = 'manifest.yml' QUERY_WAREHOUSE = %s EXTERNAL_ACCESS_INTEGRATIONS = ("integration") COMMENT = 'test' TITLE = 'foo'`, id.FullyQualifiedName(), warehouse.FullyQualifiedName())
+		assertOptsValidAndSQLEquals(t, opts, `ALTER STREAMLIT IF EXISTS %s SET ROOT_LOCATION = '@test' MAIN_FILE = 'manifest.yml' QUERY_WAREHOUSE = %s IMPORTS = ('@test/libs/a.zip') EXTERNAL_ACCESS_INTEGRATIONS = ("integration") COMMENT = 'test' TITLE = 'foo'`, id.FullyQualifiedName(), warehouse.FullyQualifiedName())
 	})
 
 	t.Run("alter: unset options", func(t *testing.T) {
@@ -92,6 +121,18 @@ func TestStreamlits_Alter(t *testing.T) {
 		}
 		assertOptsValidAndSQLEquals(t, opts, `ALTER STREAMLIT IF EXISTS %s UNSET QUERY_WAREHOUSE, COMMENT, TITLE`, id.FullyQualifiedName())
 	})
+
+	t.Run("alter: add live version from last", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.AddLiveVersionFromLast = Bool(true)
+		assertOptsValidAndSQLEquals(t, opts, `ALTER STREAMLIT IF EXISTS %s ADD LIVE VERSION FROM LAST`, id.FullyQualifiedName())
+	})
+
+	t.Run("alter: commit", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Commit = Bool(true)
+		assertOptsValidAndSQLEquals(t, opts, `ALTER STREAMLIT IF EXISTS %s COMMIT`, id.FullyQualifiedName())
+	})
 }
 
 func TestStreamlits_Drop(t *testing.T) {
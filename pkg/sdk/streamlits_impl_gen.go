@@ -64,6 +64,8 @@ func (r *CreateStreamlitRequest) toOpts() *CreateStreamlitOptions {
 		OrReplace:      r.OrReplace,
 		IfNotExists:    r.IfNotExists,
 		name:           r.name,
+		From:           r.From,
+		Version:        r.Version,
 		RootLocation:   r.RootLocation,
 		MainFile:       r.MainFile,
 		QueryWarehouse: r.QueryWarehouse,
@@ -72,8 +74,18 @@ func (r *CreateStreamlitRequest) toOpts() *CreateStreamlitOptions {
 		Comment: r.Comment,
 	}
 
+	if r.Imports != nil {
+		s := make([]StreamlitImport, len(r.Imports))
+		for i, v := range r.Imports {
+			s[i] = StreamlitImport{
+				Import: v.Import,
+			}
+		}
+		opts.Imports = s
+	}
+
 	if r.ExternalAccessIntegrations != nil {
-		opts.ExternalAccessIntegrations = &ExternalAccessIntegrations{
+		opts.ExternalAccessIntegrations = &StreamlitExternalAccessIntegrations{
 			ExternalAccessIntegrations: r.ExternalAccessIntegrations.ExternalAccessIntegrations,
 		}
 	}
@@ -86,7 +98,9 @@ func (r *AlterStreamlitRequest) toOpts() *AlterStreamlitOptions {
 		IfExists: r.IfExists,
 		name:     r.name,
 
-		RenameTo: r.RenameTo,
+		RenameTo:               r.RenameTo,
+		AddLiveVersionFromLast: r.AddLiveVersionFromLast,
+		Commit:                 r.Commit,
 	}
 
 	if r.Set != nil {
@@ -98,8 +112,18 @@ func (r *AlterStreamlitRequest) toOpts() *AlterStreamlitOptions {
 			Title:          r.Set.Title,
 		}
 
+		if r.Set.Imports != nil {
+			s := make([]StreamlitImport, len(r.Set.Imports))
+			for i, v := range r.Set.Imports {
+				s[i] = StreamlitImport{
+					Import: v.Import,
+				}
+			}
+			opts.Set.Imports = s
+		}
+
 		if r.Set.ExternalAccessIntegrations != nil {
-			opts.Set.ExternalAccessIntegrations = &ExternalAccessIntegrations{
+			opts.Set.ExternalAccessIntegrations = &StreamlitExternalAccessIntegrations{
 				ExternalAccessIntegrations: r.Set.ExternalAccessIntegrations.ExternalAccessIntegrations,
 			}
 		}
@@ -166,7 +190,6 @@ func (r *DescribeStreamlitRequest) toOpts() *DescribeStreamlitOptions {
 func (r streamlitsDetailRow) convert() *StreamlitDetail {
 	e := &StreamlitDetail{
 		Name:                       r.Name,
-		RootLocation:               r.RootLocation,
 		MainFile:                   r.MainFile,
 		UrlId:                      r.UrlId,
 		DefaultPackages:            r.DefaultPackages,
@@ -178,9 +201,27 @@ func (r streamlitsDetailRow) convert() *StreamlitDetail {
 	if r.Title.Valid {
 		e.Title = r.Title.String
 	}
+	if r.RootLocation.Valid {
+		e.RootLocation = r.RootLocation.String
+	}
 	if r.QueryWarehouse.Valid {
 		e.QueryWarehouse = r.QueryWarehouse.String
 	}
+	if r.DefaultVersion.Valid {
+		e.DefaultVersion = r.DefaultVersion.String
+	}
+	if r.DefaultVersionName.Valid {
+		e.DefaultVersionName = r.DefaultVersionName.String
+	}
+	if r.DefaultVersionSourceLocationUri.Valid {
+		e.DefaultVersionSourceLocationUri = r.DefaultVersionSourceLocationUri.String
+	}
+	if r.LastVersionName.Valid {
+		e.LastVersionName = r.LastVersionName.String
+	}
+	if r.LiveVersionLocationUri.Valid {
+		e.LiveVersionLocationUri = r.LiveVersionLocationUri.String
+	}
 	integrationsRaw := ParseCommaSeparatedStringArray(r.ExternalAccessIntegrations, false)
 	externalAccessIntegrations := make([]string, len(integrationsRaw))
 	for i, v := range integrationsRaw {
@@ -22,6 +22,12 @@ func (opts *CreateStreamlitOptions) validate() error {
 	if everyValueSet(opts.IfNotExists, opts.OrReplace) {
 		errs = append(errs, errOneOf("CreateStreamlitOptions", "IfNotExists", "OrReplace"))
 	}
+	if !exactlyOneValueSet(opts.From, opts.RootLocation) {
+		errs = append(errs, errExactlyOneOf("CreateStreamlitOptions", "From", "RootLocation"))
+	}
+	if everyValueSet(opts.Version, opts.RootLocation) {
+		errs = append(errs, errOneOf("CreateStreamlitOptions", "Version", "RootLocation"))
+	}
 	return JoinErrors(errs...)
 }
 
@@ -36,15 +42,15 @@ func (opts *AlterStreamlitOptions) validate() error {
 	if opts.RenameTo != nil && !ValidObjectIdentifier(opts.RenameTo) {
 		errs = append(errs, ErrInvalidObjectIdentifier)
 	}
-	if !exactlyOneValueSet(opts.RenameTo, opts.Set, opts.Unset) {
-		errs = append(errs, errExactlyOneOf("AlterStreamlitOptions", "RenameTo", "Set", "Unset"))
+	if !exactlyOneValueSet(opts.RenameTo, opts.Set, opts.Unset, opts.AddLiveVersionFromLast, opts.Commit) {
+		errs = append(errs, errExactlyOneOf("AlterStreamlitOptions", "RenameTo", "Set", "Unset", "AddLiveVersionFromLast", "Commit"))
 	}
 	if valueSet(opts.Set) {
 		if opts.Set.QueryWarehouse != nil && !ValidObjectIdentifier(opts.Set.QueryWarehouse) {
 			errs = append(errs, ErrInvalidObjectIdentifier)
 		}
-		if !anyValueSet(opts.Set.RootLocation, opts.Set.MainFile, opts.Set.QueryWarehouse, opts.Set.ExternalAccessIntegrations, opts.Set.Comment, opts.Set.Title) {
-			errs = append(errs, errAtLeastOneOf("AlterStreamlitOptions.Set", "RootLocation", "MainFile", "QueryWarehouse", "ExternalAccessIntegrations", "Comment", "Title"))
+		if !anyValueSet(opts.Set.RootLocation, opts.Set.MainFile, opts.Set.QueryWarehouse, opts.Set.Imports, opts.Set.ExternalAccessIntegrations, opts.Set.Comment, opts.Set.Title) {
+			errs = append(errs, errAtLeastOneOf("AlterStreamlitOptions.Set", "RootLocation", "MainFile", "QueryWarehouse", "Imports", "ExternalAccessIntegrations", "Comment", "Title"))
 		}
 	}
 	if valueSet(opts.Unset) {
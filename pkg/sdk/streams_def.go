@@ -16,6 +16,9 @@ const (
 	StreamSourceTypeExternalTable StreamSourceType = "EXTERNAL TABLE"
 	StreamSourceTypeView          StreamSourceType = "VIEW"
 	StreamSourceTypeStage         StreamSourceType = "STAGE"
+	StreamSourceTypeDynamicTable  StreamSourceType = "DYNAMIC TABLE"
+	StreamSourceTypeEventTable    StreamSourceType = "EVENT TABLE"
+	StreamSourceTypeIcebergTable  StreamSourceType = "ICEBERG TABLE"
 )
 
 func ToStreamSourceType(s string) (StreamSourceType, error) {
@@ -23,7 +26,10 @@ func ToStreamSourceType(s string) (StreamSourceType, error) {
 	case StreamSourceTypeTable,
 		StreamSourceTypeExternalTable,
 		StreamSourceTypeView,
-		StreamSourceTypeStage:
+		StreamSourceTypeStage,
+		StreamSourceTypeDynamicTable,
+		StreamSourceTypeEventTable,
+		StreamSourceTypeIcebergTable:
 		return streamSourceType, nil
 	default:
 		return "", fmt.Errorf("invalid stream source type: %s", s)
@@ -184,6 +190,70 @@ var (
 				WithValidation(g.ValidIdentifier, "ViewId").
 				WithValidation(g.ConflictingFields, "IfNotExists", "OrReplace"),
 		).
+		CustomOperation(
+			"CreateOnDynamicTable",
+			"https://docs.snowflake.com/en/sql-reference/sql/create-stream",
+			g.NewQueryStruct("CreateStreamOnDynamicTable").
+				Create().
+				OrReplace().
+				SQL("STREAM").
+				IfNotExists().
+				Name().
+				OptionalTags().
+				OptionalCopyGrants().
+				SQL("ON DYNAMIC TABLE").
+				Identifier("DynamicTableId", g.KindOfT[SchemaObjectIdentifier](), g.IdentifierOptions().Required()).
+				OptionalQueryStructField("On", onStreamDef, g.KeywordOptions()).
+				OptionalBooleanAssignment("SHOW_INITIAL_ROWS", nil).
+				OptionalComment().
+				WithValidation(g.ValidIdentifier, "name").
+				WithValidation(g.ValidIdentifier, "DynamicTableId").
+				WithValidation(g.ConflictingFields, "IfNotExists", "OrReplace"),
+		).
+		CustomOperation(
+			"CreateOnEventTable",
+			"https://docs.snowflake.com/en/sql-reference/sql/create-stream",
+			g.NewQueryStruct("CreateStreamOnEventTable").
+				Create().
+				OrReplace().
+				SQL("STREAM").
+				IfNotExists().
+				Name().
+				OptionalTags().
+				OptionalCopyGrants().
+				SQL("ON EVENT TABLE").
+				Identifier("EventTableId", g.KindOfT[SchemaObjectIdentifier](), g.IdentifierOptions().Required()).
+				OptionalQueryStructField("On", onStreamDef, g.KeywordOptions()).
+				OptionalBooleanAssignment("APPEND_ONLY", nil).
+				OptionalBooleanAssignment("SHOW_INITIAL_ROWS", nil).
+				OptionalComment().
+				WithValidation(g.ValidIdentifier, "name").
+				WithValidation(g.ValidIdentifier, "EventTableId").
+				WithValidation(g.ConflictingFields, "IfNotExists", "OrReplace"),
+		).
+		CustomOperation(
+			"CreateOnIcebergTable",
+			"https://docs.snowflake.com/en/sql-reference/sql/create-stream",
+			g.NewQueryStruct("CreateStreamOnIcebergTable").
+				Create().
+				OrReplace().
+				SQL("STREAM").
+				IfNotExists().
+				Name().
+				OptionalTags().
+				OptionalCopyGrants().
+				SQL("ON TABLE").
+				Identifier("IcebergTableId", g.KindOfT[SchemaObjectIdentifier](), g.IdentifierOptions().Required()).
+				OptionalQueryStructField("On", onStreamDef, g.KeywordOptions()).
+				OptionalBooleanAssignment("APPEND_ONLY", nil).
+				OptionalBooleanAssignment("INSERT_ONLY", nil).
+				OptionalBooleanAssignment("SHOW_INITIAL_ROWS", nil).
+				OptionalComment().
+				WithValidation(g.ValidIdentifier, "name").
+				WithValidation(g.ValidIdentifier, "IcebergTableId").
+				WithValidation(g.ConflictingFields, "IfNotExists", "OrReplace").
+				WithValidation(g.ConflictingFields, "AppendOnly", "InsertOnly"),
+		).
 		CustomOperation(
 			"Clone",
 			"https://docs.snowflake.com/en/sql-reference/sql/create-stream#variant-syntax",
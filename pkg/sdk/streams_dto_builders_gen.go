@@ -227,6 +227,156 @@ func (s *CreateOnViewStreamRequest) WithComment(Comment string) *CreateOnViewStr
 	return s
 }
 
+func NewCreateOnDynamicTableStreamRequest(
+	name SchemaObjectIdentifier,
+	DynamicTableId SchemaObjectIdentifier,
+) *CreateOnDynamicTableStreamRequest {
+	s := CreateOnDynamicTableStreamRequest{}
+	s.name = name
+	s.DynamicTableId = DynamicTableId
+	return &s
+}
+
+func (s *CreateOnDynamicTableStreamRequest) WithOrReplace(OrReplace bool) *CreateOnDynamicTableStreamRequest {
+	s.OrReplace = &OrReplace
+	return s
+}
+
+func (s *CreateOnDynamicTableStreamRequest) WithIfNotExists(IfNotExists bool) *CreateOnDynamicTableStreamRequest {
+	s.IfNotExists = &IfNotExists
+	return s
+}
+
+func (s *CreateOnDynamicTableStreamRequest) WithTag(Tag []TagAssociation) *CreateOnDynamicTableStreamRequest {
+	s.Tag = Tag
+	return s
+}
+
+func (s *CreateOnDynamicTableStreamRequest) WithCopyGrants(CopyGrants bool) *CreateOnDynamicTableStreamRequest {
+	s.CopyGrants = &CopyGrants
+	return s
+}
+
+func (s *CreateOnDynamicTableStreamRequest) WithOn(On OnStreamRequest) *CreateOnDynamicTableStreamRequest {
+	s.On = &On
+	return s
+}
+
+func (s *CreateOnDynamicTableStreamRequest) WithShowInitialRows(ShowInitialRows bool) *CreateOnDynamicTableStreamRequest {
+	s.ShowInitialRows = &ShowInitialRows
+	return s
+}
+
+func (s *CreateOnDynamicTableStreamRequest) WithComment(Comment string) *CreateOnDynamicTableStreamRequest {
+	s.Comment = &Comment
+	return s
+}
+
+func NewCreateOnEventTableStreamRequest(
+	name SchemaObjectIdentifier,
+	EventTableId SchemaObjectIdentifier,
+) *CreateOnEventTableStreamRequest {
+	s := CreateOnEventTableStreamRequest{}
+	s.name = name
+	s.EventTableId = EventTableId
+	return &s
+}
+
+func (s *CreateOnEventTableStreamRequest) WithOrReplace(OrReplace bool) *CreateOnEventTableStreamRequest {
+	s.OrReplace = &OrReplace
+	return s
+}
+
+func (s *CreateOnEventTableStreamRequest) WithIfNotExists(IfNotExists bool) *CreateOnEventTableStreamRequest {
+	s.IfNotExists = &IfNotExists
+	return s
+}
+
+func (s *CreateOnEventTableStreamRequest) WithTag(Tag []TagAssociation) *CreateOnEventTableStreamRequest {
+	s.Tag = Tag
+	return s
+}
+
+func (s *CreateOnEventTableStreamRequest) WithCopyGrants(CopyGrants bool) *CreateOnEventTableStreamRequest {
+	s.CopyGrants = &CopyGrants
+	return s
+}
+
+func (s *CreateOnEventTableStreamRequest) WithOn(On OnStreamRequest) *CreateOnEventTableStreamRequest {
+	s.On = &On
+	return s
+}
+
+func (s *CreateOnEventTableStreamRequest) WithAppendOnly(AppendOnly bool) *CreateOnEventTableStreamRequest {
+	s.AppendOnly = &AppendOnly
+	return s
+}
+
+func (s *CreateOnEventTableStreamRequest) WithShowInitialRows(ShowInitialRows bool) *CreateOnEventTableStreamRequest {
+	s.ShowInitialRows = &ShowInitialRows
+	return s
+}
+
+func (s *CreateOnEventTableStreamRequest) WithComment(Comment string) *CreateOnEventTableStreamRequest {
+	s.Comment = &Comment
+	return s
+}
+
+func NewCreateOnIcebergTableStreamRequest(
+	name SchemaObjectIdentifier,
+	IcebergTableId SchemaObjectIdentifier,
+) *CreateOnIcebergTableStreamRequest {
+	s := CreateOnIcebergTableStreamRequest{}
+	s.name = name
+	s.IcebergTableId = IcebergTableId
+	return &s
+}
+
+func (s *CreateOnIcebergTableStreamRequest) WithOrReplace(OrReplace bool) *CreateOnIcebergTableStreamRequest {
+	s.OrReplace = &OrReplace
+	return s
+}
+
+func (s *CreateOnIcebergTableStreamRequest) WithIfNotExists(IfNotExists bool) *CreateOnIcebergTableStreamRequest {
+	s.IfNotExists = &IfNotExists
+	return s
+}
+
+func (s *CreateOnIcebergTableStreamRequest) WithTag(Tag []TagAssociation) *CreateOnIcebergTableStreamRequest {
+	s.Tag = Tag
+	return s
+}
+
+func (s *CreateOnIcebergTableStreamRequest) WithCopyGrants(CopyGrants bool) *CreateOnIcebergTableStreamRequest {
+	s.CopyGrants = &CopyGrants
+	return s
+}
+
+func (s *CreateOnIcebergTableStreamRequest) WithOn(On OnStreamRequest) *CreateOnIcebergTableStreamRequest {
+	s.On = &On
+	return s
+}
+
+func (s *CreateOnIcebergTableStreamRequest) WithAppendOnly(AppendOnly bool) *CreateOnIcebergTableStreamRequest {
+	s.AppendOnly = &AppendOnly
+	return s
+}
+
+func (s *CreateOnIcebergTableStreamRequest) WithInsertOnly(InsertOnly bool) *CreateOnIcebergTableStreamRequest {
+	s.InsertOnly = &InsertOnly
+	return s
+}
+
+func (s *CreateOnIcebergTableStreamRequest) WithShowInitialRows(ShowInitialRows bool) *CreateOnIcebergTableStreamRequest {
+	s.ShowInitialRows = &ShowInitialRows
+	return s
+}
+
+func (s *CreateOnIcebergTableStreamRequest) WithComment(Comment string) *CreateOnIcebergTableStreamRequest {
+	s.Comment = &Comment
+	return s
+}
+
 func NewCloneStreamRequest(
 	name SchemaObjectIdentifier,
 	sourceStream SchemaObjectIdentifier,
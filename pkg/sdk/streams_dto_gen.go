@@ -7,6 +7,9 @@ var (
 	_ optionsProvider[CreateOnExternalTableStreamOptions]  = new(CreateOnExternalTableStreamRequest)
 	_ optionsProvider[CreateOnDirectoryTableStreamOptions] = new(CreateOnDirectoryTableStreamRequest)
 	_ optionsProvider[CreateOnViewStreamOptions]           = new(CreateOnViewStreamRequest)
+	_ optionsProvider[CreateOnDynamicTableStreamOptions]   = new(CreateOnDynamicTableStreamRequest)
+	_ optionsProvider[CreateOnEventTableStreamOptions]     = new(CreateOnEventTableStreamRequest)
+	_ optionsProvider[CreateOnIcebergTableStreamOptions]   = new(CreateOnIcebergTableStreamRequest)
 	_ optionsProvider[CloneStreamOptions]                  = new(CloneStreamRequest)
 	_ optionsProvider[AlterStreamOptions]                  = new(AlterStreamRequest)
 	_ optionsProvider[DropStreamOptions]                   = new(DropStreamRequest)
@@ -83,6 +86,57 @@ func (r *CreateOnViewStreamRequest) GetName() SchemaObjectIdentifier {
 	return r.name
 }
 
+type CreateOnDynamicTableStreamRequest struct {
+	OrReplace       *bool
+	IfNotExists     *bool
+	name            SchemaObjectIdentifier // required
+	Tag             []TagAssociation
+	CopyGrants      *bool
+	DynamicTableId  SchemaObjectIdentifier // required
+	On              *OnStreamRequest
+	ShowInitialRows *bool
+	Comment         *string
+}
+
+func (r *CreateOnDynamicTableStreamRequest) GetName() SchemaObjectIdentifier {
+	return r.name
+}
+
+type CreateOnEventTableStreamRequest struct {
+	OrReplace       *bool
+	IfNotExists     *bool
+	name            SchemaObjectIdentifier // required
+	Tag             []TagAssociation
+	CopyGrants      *bool
+	EventTableId    SchemaObjectIdentifier // required
+	On              *OnStreamRequest
+	AppendOnly      *bool
+	ShowInitialRows *bool
+	Comment         *string
+}
+
+func (r *CreateOnEventTableStreamRequest) GetName() SchemaObjectIdentifier {
+	return r.name
+}
+
+type CreateOnIcebergTableStreamRequest struct {
+	OrReplace       *bool
+	IfNotExists     *bool
+	name            SchemaObjectIdentifier // required
+	Tag             []TagAssociation
+	CopyGrants      *bool
+	IcebergTableId  SchemaObjectIdentifier // required
+	On              *OnStreamRequest
+	AppendOnly      *bool
+	InsertOnly      *bool
+	ShowInitialRows *bool
+	Comment         *string
+}
+
+func (r *CreateOnIcebergTableStreamRequest) GetName() SchemaObjectIdentifier {
+	return r.name
+}
+
 type CloneStreamRequest struct {
 	OrReplace    *bool
 	name         SchemaObjectIdentifier // required
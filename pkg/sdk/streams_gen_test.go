@@ -348,6 +348,246 @@ func TestStreams_CreateOnView(t *testing.T) {
 	})
 }
 
+func TestStreams_CreateOnDynamicTable(t *testing.T) {
+	id := randomSchemaObjectIdentifier()
+	dynamicTableId := randomSchemaObjectIdentifier()
+
+	// Minimal valid CreateOnDynamicTableStreamOptions
+	defaultOpts := func() *CreateOnDynamicTableStreamOptions {
+		return &CreateOnDynamicTableStreamOptions{
+			name:           id,
+			DynamicTableId: dynamicTableId,
+			On: &OnStream{
+				At: Bool(true),
+				Statement: OnStreamStatement{
+					Stream: String("123"),
+				},
+			},
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *CreateOnDynamicTableStreamOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.name = emptySchemaObjectIdentifier
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("validation: valid identifier for [opts.DynamicTableId]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.DynamicTableId = emptySchemaObjectIdentifier
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("validation: conflicting fields for [opts.IfNotExists opts.OrReplace]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.IfNotExists = Bool(true)
+		opts.OrReplace = Bool(true)
+		assertOptsInvalidJoinedErrors(t, opts, errOneOf("CreateOnDynamicTableStreamOptions", "IfNotExists", "OrReplace"))
+	})
+
+	t.Run("validation: exactly one field from [opts.On.At opts.On.Before] should be present", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.On.At = Bool(true)
+		opts.On.Before = Bool(true)
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("CreateOnDynamicTableStreamOptions.On", "At", "Before"))
+	})
+
+	t.Run("validation: exactly one field from [opts.On.Statement.Timestamp opts.On.Statement.Offset opts.On.Statement.Statement opts.On.Statement.Stream] should be present", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.On.Statement = OnStreamStatement{}
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("CreateOnDynamicTableStreamOptions.On.Statement", "Timestamp", "Offset", "Statement", "Stream"))
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.On = nil
+		assertOptsValidAndSQLEquals(t, opts, "CREATE STREAM %s ON DYNAMIC TABLE %s", id.FullyQualifiedName(), dynamicTableId.FullyQualifiedName())
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.OrReplace = Bool(true)
+		opts.CopyGrants = Bool(true)
+		opts.On = &OnStream{
+			At: Bool(true),
+			Statement: OnStreamStatement{
+				Offset: String("-60"),
+			},
+		}
+		opts.ShowInitialRows = Bool(true)
+		opts.Comment = String("some comment")
+		assertOptsValidAndSQLEquals(t, opts, `CREATE OR REPLACE STREAM %s COPY GRANTS ON DYNAMIC TABLE %s AT (OFFSET => -60) SHOW_INITIAL_ROWS = true COMMENT = 'some comment'`, id.FullyQualifiedName(), dynamicTableId.FullyQualifiedName())
+	})
+}
+
+func TestStreams_CreateOnEventTable(t *testing.T) {
+	id := randomSchemaObjectIdentifier()
+	eventTableId := randomSchemaObjectIdentifier()
+
+	// Minimal valid CreateOnEventTableStreamOptions
+	defaultOpts := func() *CreateOnEventTableStreamOptions {
+		return &CreateOnEventTableStreamOptions{
+			name:         id,
+			EventTableId: eventTableId,
+			On: &OnStream{
+				At: Bool(true),
+				Statement: OnStreamStatement{
+					Stream: String("123"),
+				},
+			},
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *CreateOnEventTableStreamOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.name = emptySchemaObjectIdentifier
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("validation: valid identifier for [opts.EventTableId]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.EventTableId = emptySchemaObjectIdentifier
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("validation: conflicting fields for [opts.IfNotExists opts.OrReplace]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.IfNotExists = Bool(true)
+		opts.OrReplace = Bool(true)
+		assertOptsInvalidJoinedErrors(t, opts, errOneOf("CreateOnEventTableStreamOptions", "IfNotExists", "OrReplace"))
+	})
+
+	t.Run("validation: exactly one field from [opts.On.At opts.On.Before] should be present", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.On.At = Bool(true)
+		opts.On.Before = Bool(true)
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("CreateOnEventTableStreamOptions.On", "At", "Before"))
+	})
+
+	t.Run("validation: exactly one field from [opts.On.Statement.Timestamp opts.On.Statement.Offset opts.On.Statement.Statement opts.On.Statement.Stream] should be present", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.On.Statement = OnStreamStatement{}
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("CreateOnEventTableStreamOptions.On.Statement", "Timestamp", "Offset", "Statement", "Stream"))
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.On = nil
+		assertOptsValidAndSQLEquals(t, opts, "CREATE STREAM %s ON EVENT TABLE %s", id.FullyQualifiedName(), eventTableId.FullyQualifiedName())
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.OrReplace = Bool(true)
+		opts.CopyGrants = Bool(true)
+		opts.On = &OnStream{
+			Before: Bool(true),
+			Statement: OnStreamStatement{
+				Statement: String("123"),
+			},
+		}
+		opts.AppendOnly = Bool(true)
+		opts.ShowInitialRows = Bool(true)
+		opts.Comment = String("some comment")
+		assertOptsValidAndSQLEquals(t, opts, `CREATE OR REPLACE STREAM %s COPY GRANTS ON EVENT TABLE %s BEFORE (STATEMENT => '123') APPEND_ONLY = true SHOW_INITIAL_ROWS = true COMMENT = 'some comment'`, id.FullyQualifiedName(), eventTableId.FullyQualifiedName())
+	})
+}
+
+func TestStreams_CreateOnIcebergTable(t *testing.T) {
+	id := randomSchemaObjectIdentifier()
+	icebergTableId := randomSchemaObjectIdentifier()
+
+	// Minimal valid CreateOnIcebergTableStreamOptions
+	defaultOpts := func() *CreateOnIcebergTableStreamOptions {
+		return &CreateOnIcebergTableStreamOptions{
+			name:           id,
+			IcebergTableId: icebergTableId,
+			On: &OnStream{
+				At: Bool(true),
+				Statement: OnStreamStatement{
+					Stream: String("123"),
+				},
+			},
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *CreateOnIcebergTableStreamOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.name = emptySchemaObjectIdentifier
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("validation: valid identifier for [opts.IcebergTableId]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.IcebergTableId = emptySchemaObjectIdentifier
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("validation: conflicting fields for [opts.IfNotExists opts.OrReplace]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.IfNotExists = Bool(true)
+		opts.OrReplace = Bool(true)
+		assertOptsInvalidJoinedErrors(t, opts, errOneOf("CreateOnIcebergTableStreamOptions", "IfNotExists", "OrReplace"))
+	})
+
+	t.Run("validation: conflicting fields for [opts.AppendOnly opts.InsertOnly]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.AppendOnly = Bool(true)
+		opts.InsertOnly = Bool(true)
+		assertOptsInvalidJoinedErrors(t, opts, errOneOf("CreateOnIcebergTableStreamOptions", "AppendOnly", "InsertOnly"))
+	})
+
+	t.Run("validation: exactly one field from [opts.On.At opts.On.Before] should be present", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.On.At = Bool(true)
+		opts.On.Before = Bool(true)
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("CreateOnIcebergTableStreamOptions.On", "At", "Before"))
+	})
+
+	t.Run("validation: exactly one field from [opts.On.Statement.Timestamp opts.On.Statement.Offset opts.On.Statement.Statement opts.On.Statement.Stream] should be present", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.On.Statement = OnStreamStatement{}
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("CreateOnIcebergTableStreamOptions.On.Statement", "Timestamp", "Offset", "Statement", "Stream"))
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.On = nil
+		assertOptsValidAndSQLEquals(t, opts, "CREATE STREAM %s ON TABLE %s", id.FullyQualifiedName(), icebergTableId.FullyQualifiedName())
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.OrReplace = Bool(true)
+		opts.CopyGrants = Bool(true)
+		opts.On = &OnStream{
+			At: Bool(true),
+			Statement: OnStreamStatement{
+				Timestamp: String("2024-01-01 00:00:00"),
+			},
+		}
+		opts.InsertOnly = Bool(true)
+		opts.ShowInitialRows = Bool(true)
+		opts.Comment = String("some comment")
+		assertOptsValidAndSQLEquals(t, opts, `CREATE OR REPLACE STREAM %s COPY GRANTS ON TABLE %s AT (TIMESTAMP => '2024-01-01 00:00:00') INSERT_ONLY = true SHOW_INITIAL_ROWS = true COMMENT = 'some comment'`, id.FullyQualifiedName(), icebergTableId.FullyQualifiedName())
+	})
+}
+
 func TestStreams_Clone(t *testing.T) {
 	id := randomSchemaObjectIdentifier()
 	sourceId := randomSchemaObjectIdentifier()
@@ -33,6 +33,21 @@ func (v *streams) CreateOnView(ctx context.Context, request *CreateOnViewStreamR
 	return validateAndExec(v.client, ctx, opts)
 }
 
+func (v *streams) CreateOnDynamicTable(ctx context.Context, request *CreateOnDynamicTableStreamRequest) error {
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *streams) CreateOnEventTable(ctx context.Context, request *CreateOnEventTableStreamRequest) error {
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *streams) CreateOnIcebergTable(ctx context.Context, request *CreateOnIcebergTableStreamRequest) error {
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
 func (v *streams) Clone(ctx context.Context, request *CloneStreamRequest) error {
 	opts := request.toOpts()
 	return validateAndExec(v.client, ctx, opts)
@@ -179,6 +194,93 @@ func (r *CreateOnViewStreamRequest) toOpts() *CreateOnViewStreamOptions {
 	return opts
 }
 
+func (r *CreateOnDynamicTableStreamRequest) toOpts() *CreateOnDynamicTableStreamOptions {
+	opts := &CreateOnDynamicTableStreamOptions{
+		OrReplace:      r.OrReplace,
+		IfNotExists:    r.IfNotExists,
+		name:           r.name,
+		Tag:            r.Tag,
+		CopyGrants:     r.CopyGrants,
+		DynamicTableId: r.DynamicTableId,
+
+		ShowInitialRows: r.ShowInitialRows,
+		Comment:         r.Comment,
+	}
+	if r.On != nil {
+		opts.On = &OnStream{
+			At:     r.On.At,
+			Before: r.On.Before,
+		}
+
+		opts.On.Statement = OnStreamStatement{
+			Timestamp: r.On.Statement.Timestamp,
+			Offset:    r.On.Statement.Offset,
+			Statement: r.On.Statement.Statement,
+			Stream:    r.On.Statement.Stream,
+		}
+	}
+	return opts
+}
+
+func (r *CreateOnEventTableStreamRequest) toOpts() *CreateOnEventTableStreamOptions {
+	opts := &CreateOnEventTableStreamOptions{
+		OrReplace:    r.OrReplace,
+		IfNotExists:  r.IfNotExists,
+		name:         r.name,
+		Tag:          r.Tag,
+		CopyGrants:   r.CopyGrants,
+		EventTableId: r.EventTableId,
+
+		AppendOnly:      r.AppendOnly,
+		ShowInitialRows: r.ShowInitialRows,
+		Comment:         r.Comment,
+	}
+	if r.On != nil {
+		opts.On = &OnStream{
+			At:     r.On.At,
+			Before: r.On.Before,
+		}
+
+		opts.On.Statement = OnStreamStatement{
+			Timestamp: r.On.Statement.Timestamp,
+			Offset:    r.On.Statement.Offset,
+			Statement: r.On.Statement.Statement,
+			Stream:    r.On.Statement.Stream,
+		}
+	}
+	return opts
+}
+
+func (r *CreateOnIcebergTableStreamRequest) toOpts() *CreateOnIcebergTableStreamOptions {
+	opts := &CreateOnIcebergTableStreamOptions{
+		OrReplace:      r.OrReplace,
+		IfNotExists:    r.IfNotExists,
+		name:           r.name,
+		Tag:            r.Tag,
+		CopyGrants:     r.CopyGrants,
+		IcebergTableId: r.IcebergTableId,
+
+		AppendOnly:      r.AppendOnly,
+		InsertOnly:      r.InsertOnly,
+		ShowInitialRows: r.ShowInitialRows,
+		Comment:         r.Comment,
+	}
+	if r.On != nil {
+		opts.On = &OnStream{
+			At:     r.On.At,
+			Before: r.On.Before,
+		}
+
+		opts.On.Statement = OnStreamStatement{
+			Timestamp: r.On.Statement.Timestamp,
+			Offset:    r.On.Statement.Offset,
+			Statement: r.On.Statement.Statement,
+			Stream:    r.On.Statement.Stream,
+		}
+	}
+	return opts
+}
+
 func (r *CloneStreamRequest) toOpts() *CloneStreamOptions {
 	opts := &CloneStreamOptions{
 		OrReplace:    r.OrReplace,
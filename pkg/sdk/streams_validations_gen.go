@@ -5,6 +5,9 @@ var (
 	_ validatable = new(CreateOnExternalTableStreamOptions)
 	_ validatable = new(CreateOnDirectoryTableStreamOptions)
 	_ validatable = new(CreateOnViewStreamOptions)
+	_ validatable = new(CreateOnDynamicTableStreamOptions)
+	_ validatable = new(CreateOnEventTableStreamOptions)
+	_ validatable = new(CreateOnIcebergTableStreamOptions)
 	_ validatable = new(CloneStreamOptions)
 	_ validatable = new(AlterStreamOptions)
 	_ validatable = new(DropStreamOptions)
@@ -110,6 +113,90 @@ func (opts *CreateOnViewStreamOptions) validate() error {
 	return JoinErrors(errs...)
 }
 
+func (opts *CreateOnDynamicTableStreamOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if !ValidObjectIdentifier(opts.DynamicTableId) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if everyValueSet(opts.IfNotExists, opts.OrReplace) {
+		errs = append(errs, errOneOf("CreateOnDynamicTableStreamOptions", "IfNotExists", "OrReplace"))
+	}
+	if valueSet(opts.On) {
+		if !exactlyOneValueSet(opts.On.At, opts.On.Before) {
+			errs = append(errs, errExactlyOneOf("CreateOnDynamicTableStreamOptions.On", "At", "Before"))
+		}
+		if valueSet(opts.On.Statement) {
+			if !exactlyOneValueSet(opts.On.Statement.Timestamp, opts.On.Statement.Offset, opts.On.Statement.Statement, opts.On.Statement.Stream) {
+				errs = append(errs, errExactlyOneOf("CreateOnDynamicTableStreamOptions.On.Statement", "Timestamp", "Offset", "Statement", "Stream"))
+			}
+		}
+	}
+	return JoinErrors(errs...)
+}
+
+func (opts *CreateOnEventTableStreamOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if !ValidObjectIdentifier(opts.EventTableId) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if everyValueSet(opts.IfNotExists, opts.OrReplace) {
+		errs = append(errs, errOneOf("CreateOnEventTableStreamOptions", "IfNotExists", "OrReplace"))
+	}
+	if valueSet(opts.On) {
+		if !exactlyOneValueSet(opts.On.At, opts.On.Before) {
+			errs = append(errs, errExactlyOneOf("CreateOnEventTableStreamOptions.On", "At", "Before"))
+		}
+		if valueSet(opts.On.Statement) {
+			if !exactlyOneValueSet(opts.On.Statement.Timestamp, opts.On.Statement.Offset, opts.On.Statement.Statement, opts.On.Statement.Stream) {
+				errs = append(errs, errExactlyOneOf("CreateOnEventTableStreamOptions.On.Statement", "Timestamp", "Offset", "Statement", "Stream"))
+			}
+		}
+	}
+	return JoinErrors(errs...)
+}
+
+func (opts *CreateOnIcebergTableStreamOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if !ValidObjectIdentifier(opts.IcebergTableId) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if everyValueSet(opts.IfNotExists, opts.OrReplace) {
+		errs = append(errs, errOneOf("CreateOnIcebergTableStreamOptions", "IfNotExists", "OrReplace"))
+	}
+	if everyValueSet(opts.AppendOnly, opts.InsertOnly) {
+		errs = append(errs, errOneOf("CreateOnIcebergTableStreamOptions", "AppendOnly", "InsertOnly"))
+	}
+	if valueSet(opts.On) {
+		if !exactlyOneValueSet(opts.On.At, opts.On.Before) {
+			errs = append(errs, errExactlyOneOf("CreateOnIcebergTableStreamOptions.On", "At", "Before"))
+		}
+		if valueSet(opts.On.Statement) {
+			if !exactlyOneValueSet(opts.On.Statement.Timestamp, opts.On.Statement.Offset, opts.On.Statement.Statement, opts.On.Statement.Stream) {
+				errs = append(errs, errExactlyOneOf("CreateOnIcebergTableStreamOptions.On.Statement", "Timestamp", "Offset", "Statement", "Stream"))
+			}
+		}
+	}
+	return JoinErrors(errs...)
+}
+
 func (opts *CloneStreamOptions) validate() error {
 	if opts == nil {
 		return ErrNilOptions
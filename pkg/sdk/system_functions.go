@@ -14,6 +14,7 @@ import (
 type SystemFunctions interface {
 	GetTag(ctx context.Context, tagID ObjectIdentifier, objectID ObjectIdentifier, objectType ObjectType) (*string, error)
 	PipeStatus(pipeId SchemaObjectIdentifier) (PipeExecutionState, error)
+	PipeStatusDetails(ctx context.Context, pipeId SchemaObjectIdentifier) (*PipeStatus, error)
 	// PipeForceResume unpauses a pipe after ownership transfer. Snowflake will throw an error whenever a pipe changes its owner,
 	// and someone tries to unpause it. To unpause a pipe after ownership transfer, this system function has to be called instead of ALTER PIPE.
 	PipeForceResume(pipeId SchemaObjectIdentifier, options []ForceResumePipeOption) error
@@ -88,28 +89,51 @@ const (
 )
 
 func (c *systemFunctions) PipeStatus(pipeId SchemaObjectIdentifier) (PipeExecutionState, error) {
+	pipeStatus, err := c.PipeStatusDetails(context.Background(), pipeId)
+	if err != nil {
+		return "", err
+	}
+	return pipeStatus.ExecutionState, nil
+}
+
+// PipeStatus is based on https://docs.snowflake.com/en/sql-reference/functions/system_pipe_status#returns.
+// Only the executionState is always returned; the other keys are present depending on the pipe type and its history.
+type PipeStatus struct {
+	ExecutionState                  PipeExecutionState `json:"executionState"`
+	PendingFileCount                int                `json:"pendingFileCount"`
+	OldestFileTimestamp             *string            `json:"oldestFileTimestamp"`
+	LastIngestedTimestamp           *string            `json:"lastIngestedTimestamp"`
+	LastIngestedFilePath            *string            `json:"lastIngestedFilePath"`
+	NotificationChannelName         *string            `json:"notificationChannelName"`
+	NumOutstandingMessagesOnChannel *int               `json:"numOutstandingMessagesOnChannel"`
+	LastReceivedMessageTimestamp    *string            `json:"lastReceivedMessageTimestamp"`
+	LastForwardedMessageTimestamp   *string            `json:"lastForwardedMessageTimestamp"`
+	Error                           *string            `json:"error"`
+	Fault                           *string            `json:"fault"`
+}
+
+func (c *systemFunctions) PipeStatusDetails(ctx context.Context, pipeId SchemaObjectIdentifier) (*PipeStatus, error) {
 	row := &struct {
 		PipeStatus string `db:"PIPE_STATUS"`
 	}{}
 	sql := fmt.Sprintf(`SELECT SYSTEM$PIPE_STATUS('%s') AS "PIPE_STATUS"`, pipeId.FullyQualifiedName())
-	ctx := context.Background()
 
 	err := c.client.queryOne(ctx, row, sql)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
+	return parsePipeStatus(row.PipeStatus)
+}
 
-	var pipeStatus map[string]any
-	err = json.Unmarshal([]byte(row.PipeStatus), &pipeStatus)
-	if err != nil {
-		return "", err
+func parsePipeStatus(rawPipeStatus string) (*PipeStatus, error) {
+	var pipeStatus PipeStatus
+	if err := json.Unmarshal([]byte(rawPipeStatus), &pipeStatus); err != nil {
+		return nil, err
 	}
-
-	if _, ok := pipeStatus["executionState"]; !ok {
-		return "", NewError(fmt.Sprintf("executionState key not found in: %s", pipeStatus))
+	if pipeStatus.ExecutionState == "" {
+		return nil, NewError(fmt.Sprintf("executionState key not found in: %s", rawPipeStatus))
 	}
-
-	return PipeExecutionState(pipeStatus["executionState"].(string)), nil
+	return &pipeStatus, nil
 }
 
 type ForceResumePipeOption string
@@ -0,0 +1,43 @@
+package sdk
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_parsePipeStatus(t *testing.T) {
+	t.Run("auto-ingest pipe", func(t *testing.T) {
+		pipeStatus, err := parsePipeStatus(`{"executionState":"RUNNING","pendingFileCount":2,"lastIngestedTimestamp":"2024-01-01T10:00:00.000Z","lastIngestedFilePath":"data/file.csv","notificationChannelName":"arn:aws:sqs:us-west-2:123:sf-snowpipe","numOutstandingMessagesOnChannel":1,"lastReceivedMessageTimestamp":"2024-01-01T10:00:01.000Z"}`)
+		require.NoError(t, err)
+
+		assert.Equal(t, RunningPipeExecutionState, pipeStatus.ExecutionState)
+		assert.Equal(t, 2, pipeStatus.PendingFileCount)
+		assert.Equal(t, "2024-01-01T10:00:00.000Z", *pipeStatus.LastIngestedTimestamp)
+		assert.Equal(t, "data/file.csv", *pipeStatus.LastIngestedFilePath)
+		assert.Equal(t, "arn:aws:sqs:us-west-2:123:sf-snowpipe", *pipeStatus.NotificationChannelName)
+		assert.Equal(t, 1, *pipeStatus.NumOutstandingMessagesOnChannel)
+		assert.Nil(t, pipeStatus.OldestFileTimestamp)
+		assert.Nil(t, pipeStatus.Error)
+	})
+
+	t.Run("stalled pipe with error", func(t *testing.T) {
+		pipeStatus, err := parsePipeStatus(`{"executionState":"STALLED_COMPILATION_ERROR","pendingFileCount":0,"error":"SQL compilation error","fault":"compilation"}`)
+		require.NoError(t, err)
+
+		assert.Equal(t, StalledCompilationErrorPipeExecutionState, pipeStatus.ExecutionState)
+		assert.Equal(t, "SQL compilation error", *pipeStatus.Error)
+		assert.Equal(t, "compilation", *pipeStatus.Fault)
+	})
+
+	t.Run("missing execution state", func(t *testing.T) {
+		_, err := parsePipeStatus(`{"pendingFileCount":0}`)
+		require.ErrorContains(t, err, "executionState key not found")
+	})
+
+	t.Run("invalid json", func(t *testing.T) {
+		_, err := parsePipeStatus(`not a json`)
+		require.Error(t, err)
+	})
+}
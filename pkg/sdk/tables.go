@@ -6,14 +6,13 @@ import (
 	"fmt"
 	"regexp"
 	"strings"
+	"time"
 )
 
 var _ convertibleRow[Table] = new(tableDBRow)
 
 // TODO [SNOW-1007542]: add missing features:
 // - show columns (https://docs.snowflake.com/en/sql-reference/sql/show-columns)
-// - show primary keys (https://docs.snowflake.com/en/sql-reference/sql/show-primary-keys)
-// - describe search optimization (https://docs.snowflake.com/en/sql-reference/sql/desc-search-optimization)
 // - truncate table (https://docs.snowflake.com/en/sql-reference/sql/truncate-table)
 // - undrop table (https://docs.snowflake.com/en/sql-reference/sql/undrop-table)
 type Tables interface {
@@ -29,6 +28,8 @@ type Tables interface {
 	ShowByIDSafely(ctx context.Context, id SchemaObjectIdentifier) (*Table, error)
 	DescribeColumns(ctx context.Context, req *DescribeTableColumnsRequest) ([]TableColumnDetails, error)
 	DescribeStage(ctx context.Context, req *DescribeTableStageRequest) ([]TableStageDetails, error)
+	DescribeSearchOptimization(ctx context.Context, req *DescribeSearchOptimizationRequest) ([]TableSearchOptimizationDetails, error)
+	ShowPrimaryKeys(ctx context.Context, req *ShowPrimaryKeysRequest) ([]TablePrimaryKeyColumn, error)
 }
 
 // TODO: check if [...] in the docs (like in https://docs.snowflake.com/en/sql-reference/sql/create-table#create-table-using-template) mean that we can reuse all parameters from "normal" createTableOptions
@@ -777,3 +778,113 @@ func (r tableStageDetailsRow) convert() *TableStageDetails {
 		PropertyDefault: r.PropertyDefault,
 	}
 }
+
+// describeSearchOptimizationOptions based on https://docs.snowflake.com/en/sql-reference/sql/desc-search-optimization
+type describeSearchOptimizationOptions struct {
+	describeSearchOptimization bool                   `ddl:"static" sql:"DESCRIBE SEARCH OPTIMIZATION ON"`
+	name                       SchemaObjectIdentifier `ddl:"identifier"`
+}
+
+type SearchOptimizationMethod string
+
+const (
+	SearchOptimizationMethodEquality  SearchOptimizationMethod = "EQUALITY"
+	SearchOptimizationMethodSubstring SearchOptimizationMethod = "SUBSTRING"
+	SearchOptimizationMethodGeo       SearchOptimizationMethod = "GEO"
+)
+
+var AllSearchOptimizationMethods = []SearchOptimizationMethod{
+	SearchOptimizationMethodEquality,
+	SearchOptimizationMethodSubstring,
+	SearchOptimizationMethodGeo,
+}
+
+func ToSearchOptimizationMethod(s string) (SearchOptimizationMethod, error) {
+	switch strings.ToUpper(s) {
+	case string(SearchOptimizationMethodEquality):
+		return SearchOptimizationMethodEquality, nil
+	case string(SearchOptimizationMethodSubstring):
+		return SearchOptimizationMethodSubstring, nil
+	case string(SearchOptimizationMethodGeo):
+		return SearchOptimizationMethodGeo, nil
+	default:
+		return "", fmt.Errorf("invalid search optimization method: %s", s)
+	}
+}
+
+type TableSearchOptimizationDetails struct {
+	ExpressionId   int
+	Method         SearchOptimizationMethod
+	Target         string
+	TargetDataType string
+	Active         bool
+}
+
+// tableSearchOptimizationDetailsRow based on https://docs.snowflake.com/en/sql-reference/sql/desc-search-optimization
+type tableSearchOptimizationDetailsRow struct {
+	ExpressionId   int    `db:"expression_id"`
+	Method         string `db:"method"`
+	Target         string `db:"target"`
+	TargetDataType string `db:"target_data_type"`
+	Active         bool   `db:"active"`
+}
+
+func (r tableSearchOptimizationDetailsRow) convert() *TableSearchOptimizationDetails {
+	return &TableSearchOptimizationDetails{
+		ExpressionId:   r.ExpressionId,
+		Method:         SearchOptimizationMethod(r.Method),
+		Target:         r.Target,
+		TargetDataType: r.TargetDataType,
+		Active:         r.Active,
+	}
+}
+
+// showPrimaryKeysOptions based on https://docs.snowflake.com/en/sql-reference/sql/show-primary-keys
+type showPrimaryKeysOptions struct {
+	showPrimaryKeys bool                   `ddl:"static" sql:"SHOW PRIMARY KEYS IN TABLE"`
+	name            SchemaObjectIdentifier `ddl:"identifier"`
+}
+
+// TablePrimaryKeyColumn is one column of the primary key. SHOW PRIMARY KEYS returns one row per column of the key.
+type TablePrimaryKeyColumn struct {
+	CreatedOn      time.Time
+	DatabaseName   string
+	SchemaName     string
+	TableName      string
+	ColumnName     string
+	KeySequence    int
+	ConstraintName string
+	Rely           bool
+	Comment        string
+}
+
+type tablePrimaryKeyColumnRow struct {
+	CreatedOn      time.Time      `db:"created_on"`
+	DatabaseName   string         `db:"database_name"`
+	SchemaName     string         `db:"schema_name"`
+	TableName      string         `db:"table_name"`
+	ColumnName     string         `db:"column_name"`
+	KeySequence    int            `db:"key_sequence"`
+	ConstraintName string         `db:"constraint_name"`
+	Rely           sql.NullString `db:"rely"`
+	Comment        sql.NullString `db:"comment"`
+}
+
+func (r tablePrimaryKeyColumnRow) convert() *TablePrimaryKeyColumn {
+	column := &TablePrimaryKeyColumn{
+		CreatedOn:      r.CreatedOn,
+		DatabaseName:   r.DatabaseName,
+		SchemaName:     r.SchemaName,
+		TableName:      r.TableName,
+		ColumnName:     r.ColumnName,
+		KeySequence:    r.KeySequence,
+		ConstraintName: r.ConstraintName,
+	}
+	if r.Rely.Valid {
+		column.Rely = strings.EqualFold(r.Rely.String, "true")
+	}
+	if r.Comment.Valid {
+		column.Comment = r.Comment.String
+	}
+	return column
+}
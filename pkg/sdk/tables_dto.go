@@ -485,7 +485,9 @@ type TableExternalTableActionRequest struct {
 
 type TableSearchOptimizationActionRequest struct {
 	// One of
+	AddSearchOptimization    *bool
 	AddSearchOptimizationOn  []string
+	DropSearchOptimization   *bool
 	DropSearchOptimizationOn []string
 }
 
@@ -545,3 +547,11 @@ type DescribeTableColumnsRequest struct {
 type DescribeTableStageRequest struct {
 	id SchemaObjectIdentifier // required
 }
+
+type DescribeSearchOptimizationRequest struct {
+	id SchemaObjectIdentifier // required
+}
+
+type ShowPrimaryKeysRequest struct {
+	id SchemaObjectIdentifier // required
+}
@@ -675,6 +675,16 @@ func (s *DropTableRequest) WithRestrict(restrict *bool) *DropTableRequest {
 	return s
 }
 
+func NewRowAccessPolicyRequest(
+	name SchemaObjectIdentifier,
+	on []string,
+) *RowAccessPolicyRequest {
+	s := RowAccessPolicyRequest{}
+	s.Name = name
+	s.On = on
+	return &s
+}
+
 func NewTableAddRowAccessPolicyRequest(
 	rowAccessPolicy SchemaObjectIdentifier,
 	on []string,
@@ -1506,6 +1516,16 @@ func NewTableSearchOptimizationActionRequest() *TableSearchOptimizationActionReq
 	return &TableSearchOptimizationActionRequest{}
 }
 
+func (s *TableSearchOptimizationActionRequest) WithAddSearchOptimization(addSearchOptimization *bool) *TableSearchOptimizationActionRequest {
+	s.AddSearchOptimization = addSearchOptimization
+	return s
+}
+
+func (s *TableSearchOptimizationActionRequest) WithDropSearchOptimization(dropSearchOptimization *bool) *TableSearchOptimizationActionRequest {
+	s.DropSearchOptimization = dropSearchOptimization
+	return s
+}
+
 func (s *TableSearchOptimizationActionRequest) WithAddSearchOptimizationOn(addSearchOptimizationOn []string) *TableSearchOptimizationActionRequest {
 	s.AddSearchOptimizationOn = addSearchOptimizationOn
 	return s
@@ -1677,3 +1697,19 @@ func NewDescribeTableStageRequest(
 	s.id = id
 	return &s
 }
+
+func NewDescribeSearchOptimizationRequest(
+	id SchemaObjectIdentifier,
+) *DescribeSearchOptimizationRequest {
+	s := DescribeSearchOptimizationRequest{}
+	s.id = id
+	return &s
+}
+
+func NewShowPrimaryKeysRequest(
+	id SchemaObjectIdentifier,
+) *ShowPrimaryKeysRequest {
+	s := ShowPrimaryKeysRequest{}
+	s.id = id
+	return &s
+}
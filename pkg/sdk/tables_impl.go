@@ -9,21 +9,23 @@ import (
 var _ Tables = (*tables)(nil)
 
 var (
-	_ optionsProvider[createTableOptions]              = new(CreateTableRequest)
-	_ optionsProvider[createTableAsSelectOptions]      = new(CreateTableAsSelectRequest)
-	_ optionsProvider[createTableUsingTemplateOptions] = new(CreateTableUsingTemplateRequest)
-	_ optionsProvider[createTableLikeOptions]          = new(CreateTableLikeRequest)
-	_ optionsProvider[createTableCloneOptions]         = new(CreateTableCloneRequest)
-	_ optionsProvider[alterTableOptions]               = new(AlterTableRequest)
-	_ optionsProvider[dropTableOptions]                = new(DropTableRequest)
-	_ optionsProvider[showTableOptions]                = new(ShowTableRequest)
-	_ optionsProvider[describeTableColumnsOptions]     = new(DescribeTableColumnsRequest)
-	_ optionsProvider[describeTableStageOptions]       = new(DescribeTableStageRequest)
-	_ optionsProvider[TableColumnAction]               = new(TableColumnActionRequest)
-	_ optionsProvider[TableConstraintAction]           = new(TableConstraintActionRequest)
-	_ optionsProvider[TableExternalTableAction]        = new(TableExternalTableActionRequest)
-	_ optionsProvider[TableSearchOptimizationAction]   = new(TableSearchOptimizationActionRequest)
-	_ optionsProvider[TableSet]                        = new(TableSetRequest)
+	_ optionsProvider[createTableOptions]                = new(CreateTableRequest)
+	_ optionsProvider[createTableAsSelectOptions]        = new(CreateTableAsSelectRequest)
+	_ optionsProvider[createTableUsingTemplateOptions]   = new(CreateTableUsingTemplateRequest)
+	_ optionsProvider[createTableLikeOptions]            = new(CreateTableLikeRequest)
+	_ optionsProvider[createTableCloneOptions]           = new(CreateTableCloneRequest)
+	_ optionsProvider[alterTableOptions]                 = new(AlterTableRequest)
+	_ optionsProvider[dropTableOptions]                  = new(DropTableRequest)
+	_ optionsProvider[showTableOptions]                  = new(ShowTableRequest)
+	_ optionsProvider[describeTableColumnsOptions]       = new(DescribeTableColumnsRequest)
+	_ optionsProvider[describeTableStageOptions]         = new(DescribeTableStageRequest)
+	_ optionsProvider[describeSearchOptimizationOptions] = new(DescribeSearchOptimizationRequest)
+	_ optionsProvider[showPrimaryKeysOptions]            = new(ShowPrimaryKeysRequest)
+	_ optionsProvider[TableColumnAction]                 = new(TableColumnActionRequest)
+	_ optionsProvider[TableConstraintAction]             = new(TableConstraintActionRequest)
+	_ optionsProvider[TableExternalTableAction]          = new(TableExternalTableActionRequest)
+	_ optionsProvider[TableSearchOptimizationAction]     = new(TableSearchOptimizationActionRequest)
+	_ optionsProvider[TableSet]                          = new(TableSetRequest)
 )
 
 type tables struct {
@@ -106,6 +108,22 @@ func (v *tables) DescribeStage(ctx context.Context, req *DescribeTableStageReque
 	return convertRows[tableStageDetailsRow, TableStageDetails](rows), nil
 }
 
+func (v *tables) DescribeSearchOptimization(ctx context.Context, req *DescribeSearchOptimizationRequest) ([]TableSearchOptimizationDetails, error) {
+	rows, err := validateAndQuery[tableSearchOptimizationDetailsRow](v.client, ctx, req.toOpts())
+	if err != nil {
+		return nil, err
+	}
+	return convertRows[tableSearchOptimizationDetailsRow, TableSearchOptimizationDetails](rows), nil
+}
+
+func (v *tables) ShowPrimaryKeys(ctx context.Context, req *ShowPrimaryKeysRequest) ([]TablePrimaryKeyColumn, error) {
+	rows, err := validateAndQuery[tablePrimaryKeyColumnRow](v.client, ctx, req.toOpts())
+	if err != nil {
+		return nil, err
+	}
+	return convertRows[tablePrimaryKeyColumnRow, TablePrimaryKeyColumn](rows), nil
+}
+
 func (s *AlterTableRequest) toOpts() *alterTableOptions {
 	var clusteringAction *TableClusteringAction
 	if s.ClusteringAction != nil {
@@ -233,6 +251,16 @@ func (s *TableSetRequest) toOpts() *TableSet {
 }
 
 func (s *TableSearchOptimizationActionRequest) toOpts() *TableSearchOptimizationAction {
+	if s.AddSearchOptimization != nil && *s.AddSearchOptimization {
+		return &TableSearchOptimizationAction{
+			Add: &AddSearchOptimization{},
+		}
+	}
+	if s.DropSearchOptimization != nil && *s.DropSearchOptimization {
+		return &TableSearchOptimizationAction{
+			Drop: &DropSearchOptimization{},
+		}
+	}
 	if len(s.AddSearchOptimizationOn) > 0 {
 		return &TableSearchOptimizationAction{
 			Add: &AddSearchOptimization{
@@ -823,3 +851,15 @@ func (v *DescribeTableStageRequest) toOpts() *describeTableStageOptions {
 		name: v.id,
 	}
 }
+
+func (v *DescribeSearchOptimizationRequest) toOpts() *describeSearchOptimizationOptions {
+	return &describeSearchOptimizationOptions{
+		name: v.id,
+	}
+}
+
+func (v *ShowPrimaryKeysRequest) toOpts() *showPrimaryKeysOptions {
+	return &showPrimaryKeysOptions{
+		name: v.id,
+	}
+}
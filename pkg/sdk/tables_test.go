@@ -1,6 +1,7 @@
 package sdk
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/helpers/random"
@@ -539,10 +540,11 @@ func TestTableCreateAsSelect(t *testing.T) {
 		assertOptsInvalidJoinedErrors(t, opts, errInvalidIdentifier("createTableAsSelectOptions", "name"))
 	})
 
-	t.Run("validation: no columns", func(t *testing.T) {
+	t.Run("without columns", func(t *testing.T) {
 		opts := defaultOpts()
 		opts.Columns = []TableAsSelectColumn{}
-		assertOptsInvalidJoinedErrors(t, opts, errNotSet("createTableAsSelectOptions", "Columns"))
+		opts.Query = "SELECT * FROM ANOTHER_TABLE"
+		assertOptsValidAndSQLEquals(t, opts, "CREATE TABLE %s AS SELECT * FROM ANOTHER_TABLE", id.FullyQualifiedName())
 	})
 
 	t.Run("validation: no query", func(t *testing.T) {
@@ -1330,6 +1332,26 @@ func TestTableAlter(t *testing.T) {
 		assertOptsValidAndSQLEquals(t, opts, "ALTER TABLE %s DROP SEARCH OPTIMIZATION ON SUBSTRING(*), FOO", id.FullyQualifiedName())
 	})
 
+	t.Run("add search optimization: whole table", func(t *testing.T) {
+		opts := &alterTableOptions{
+			name: id,
+			SearchOptimizationAction: &TableSearchOptimizationAction{
+				Add: &AddSearchOptimization{},
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER TABLE %s ADD SEARCH OPTIMIZATION", id.FullyQualifiedName())
+	})
+
+	t.Run("drop search optimization: whole table", func(t *testing.T) {
+		opts := &alterTableOptions{
+			name: id,
+			SearchOptimizationAction: &TableSearchOptimizationAction{
+				Drop: &DropSearchOptimization{},
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER TABLE %s DROP SEARCH OPTIMIZATION", id.FullyQualifiedName())
+	})
+
 	t.Run("set: with complete options", func(t *testing.T) {
 		comment := random.Comment()
 		opts := &alterTableOptions{
@@ -1625,6 +1647,87 @@ func TestTableDescribeStage(t *testing.T) {
 	})
 }
 
+func TestTableDescribeSearchOptimization(t *testing.T) {
+	id := randomSchemaObjectIdentifier()
+	defaultOpts := func() *describeSearchOptimizationOptions {
+		return &describeSearchOptimizationOptions{
+			name: id,
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *describeSearchOptimizationOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: incorrect identifier", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.name = emptySchemaObjectIdentifier
+		assertOptsInvalidJoinedErrors(t, opts, errInvalidIdentifier("describeSearchOptimizationOptions", "name"))
+	})
+
+	t.Run("describe", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, `DESCRIBE SEARCH OPTIMIZATION ON %s`, id.FullyQualifiedName())
+	})
+}
+
+func TestTableShowPrimaryKeys(t *testing.T) {
+	id := randomSchemaObjectIdentifier()
+	defaultOpts := func() *showPrimaryKeysOptions {
+		return &showPrimaryKeysOptions{
+			name: id,
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *showPrimaryKeysOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: incorrect identifier", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.name = emptySchemaObjectIdentifier
+		assertOptsInvalidJoinedErrors(t, opts, errInvalidIdentifier("showPrimaryKeysOptions", "name"))
+	})
+
+	t.Run("show", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, `SHOW PRIMARY KEYS IN TABLE %s`, id.FullyQualifiedName())
+	})
+}
+
+func TestTableSearchOptimizationActionRequest_toOpts(t *testing.T) {
+	t.Run("whole table", func(t *testing.T) {
+		assert.Equal(t, &TableSearchOptimizationAction{Add: &AddSearchOptimization{}}, NewTableSearchOptimizationActionRequest().WithAddSearchOptimization(Bool(true)).toOpts())
+		assert.Equal(t, &TableSearchOptimizationAction{Drop: &DropSearchOptimization{}}, NewTableSearchOptimizationActionRequest().WithDropSearchOptimization(Bool(true)).toOpts())
+	})
+
+	t.Run("on expressions", func(t *testing.T) {
+		assert.Equal(t, &TableSearchOptimizationAction{Add: &AddSearchOptimization{On: []string{"EQUALITY(a)"}}}, NewTableSearchOptimizationActionRequest().WithAddSearchOptimizationOn([]string{"EQUALITY(a)"}).toOpts())
+		assert.Equal(t, &TableSearchOptimizationAction{Drop: &DropSearchOptimization{On: []string{"GEO(b)"}}}, NewTableSearchOptimizationActionRequest().WithDropSearchOptimizationOn([]string{"GEO(b)"}).toOpts())
+	})
+
+	t.Run("nothing set", func(t *testing.T) {
+		assert.Nil(t, NewTableSearchOptimizationActionRequest().toOpts())
+	})
+}
+
+func TestToSearchOptimizationMethod(t *testing.T) {
+	for _, method := range AllSearchOptimizationMethods {
+		t.Run(string(method), func(t *testing.T) {
+			got, err := ToSearchOptimizationMethod(strings.ToLower(string(method)))
+			require.NoError(t, err)
+			assert.Equal(t, method, got)
+		})
+	}
+
+	t.Run("invalid", func(t *testing.T) {
+		_, err := ToSearchOptimizationMethod("FULL_TEXT")
+		require.Error(t, err)
+	})
+}
+
 func TestTable_GetClusterByKeys(t *testing.T) {
 	t.Run("empty", func(t *testing.T) {
 		table := Table{ClusterBy: ""}
@@ -13,6 +13,8 @@ var (
 	_ validatable = new(showTableOptions)
 	_ validatable = new(describeTableColumnsOptions)
 	_ validatable = new(describeTableStageOptions)
+	_ validatable = new(describeSearchOptimizationOptions)
+	_ validatable = new(showPrimaryKeysOptions)
 )
 
 func (opts *createTableOptions) validate() error {
@@ -87,9 +89,6 @@ func (opts *createTableAsSelectOptions) validate() error {
 	if !ValidObjectIdentifier(opts.name) {
 		errs = append(errs, errInvalidIdentifier("createTableAsSelectOptions", "name"))
 	}
-	if len(opts.Columns) == 0 {
-		errs = append(errs, errNotSet("createTableAsSelectOptions", "Columns"))
-	}
 	if !valueSet(opts.Query) {
 		errs = append(errs, errNotSet("createTableAsSelectOptions", "Query"))
 	}
@@ -315,6 +314,28 @@ func (opts *describeTableStageOptions) validate() error {
 	return errors.Join(errs...)
 }
 
+func (opts *describeSearchOptimizationOptions) validate() error {
+	if opts == nil {
+		return errors.Join(ErrNilOptions)
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, errInvalidIdentifier("describeSearchOptimizationOptions", "name"))
+	}
+	return errors.Join(errs...)
+}
+
+func (opts *showPrimaryKeysOptions) validate() error {
+	if opts == nil {
+		return errors.Join(ErrNilOptions)
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, errInvalidIdentifier("showPrimaryKeysOptions", "name"))
+	}
+	return errors.Join(errs...)
+}
+
 func (v *OutOfLineConstraint) validate() error {
 	var errs []error
 	switch v.Type {
@@ -0,0 +1,226 @@
+package sdk
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"slices"
+	"time"
+)
+
+var (
+	_ validatable                         = new(taskHistoryOptions)
+	_ optionsProvider[taskHistoryOptions] = new(TaskHistoryRequest)
+	_ convertibleRow[TaskRun]             = new(taskRunRow)
+)
+
+// taskHistoryOptions is based on https://docs.snowflake.com/en/sql-reference/functions/task_history.
+type taskHistoryOptions struct {
+	selectEverythingFrom bool                 `ddl:"static" sql:"SELECT * FROM TABLE"`
+	function             *taskHistoryFunction `ddl:"list,parentheses,no_comma"`
+}
+
+type taskHistoryFunction struct {
+	// Name is the TASK_HISTORY function from the INFORMATION_SCHEMA of the task's database.
+	Name      SchemaObjectIdentifier `ddl:"identifier"`
+	Arguments *taskHistoryArguments  `ddl:"list,parentheses"`
+}
+
+type taskHistoryArguments struct {
+	TaskName    *string `ddl:"parameter,single_quotes,arrow_equals" sql:"TASK_NAME"`
+	ResultLimit *int    `ddl:"parameter,arrow_equals" sql:"RESULT_LIMIT"`
+}
+
+func (opts *taskHistoryOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !valueSet(opts.function) {
+		errs = append(errs, errNotSet("taskHistoryOptions", "function"))
+	} else {
+		if !ValidObjectIdentifier(opts.function.Name) || opts.function.Name.DatabaseName() == "" {
+			errs = append(errs, ErrInvalidObjectIdentifier)
+		}
+		if !valueSet(opts.function.Arguments) || opts.function.Arguments.TaskName == nil {
+			errs = append(errs, errNotSet("taskHistoryArguments", "TaskName"))
+		} else {
+			if !ValidObjectIdentifier(NewAccountObjectIdentifier(*opts.function.Arguments.TaskName)) {
+				errs = append(errs, ErrInvalidObjectIdentifier)
+			}
+			if opts.function.Arguments.ResultLimit != nil && !validateIntInRangeInclusive(*opts.function.Arguments.ResultLimit, 1, 10000) {
+				errs = append(errs, errIntBetween("taskHistoryArguments", "ResultLimit", 1, 10000))
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+type TaskHistoryRequest struct {
+	taskId      SchemaObjectIdentifier // required
+	ResultLimit *int
+}
+
+func NewTaskHistoryRequest(taskId SchemaObjectIdentifier) *TaskHistoryRequest {
+	return &TaskHistoryRequest{taskId: taskId}
+}
+
+func (s *TaskHistoryRequest) WithResultLimit(resultLimit int) *TaskHistoryRequest {
+	s.ResultLimit = &resultLimit
+	return s
+}
+
+func (r *TaskHistoryRequest) toOpts() *taskHistoryOptions {
+	return &taskHistoryOptions{
+		function: &taskHistoryFunction{
+			Name: NewSchemaObjectIdentifier(r.taskId.DatabaseName(), "INFORMATION_SCHEMA", "TASK_HISTORY"),
+			Arguments: &taskHistoryArguments{
+				TaskName:    String(r.taskId.Name()),
+				ResultLimit: r.ResultLimit,
+			},
+		},
+	}
+}
+
+type TaskRunState string
+
+const (
+	TaskRunStateScheduled              TaskRunState = "SCHEDULED"
+	TaskRunStateExecuting              TaskRunState = "EXECUTING"
+	TaskRunStateSucceeded              TaskRunState = "SUCCEEDED"
+	TaskRunStateFailed                 TaskRunState = "FAILED"
+	TaskRunStateFailedAndAutoSuspended TaskRunState = "FAILED_AND_AUTO_SUSPENDED"
+	TaskRunStateCancelled              TaskRunState = "CANCELLED"
+	TaskRunStateSkipped                TaskRunState = "SKIPPED"
+)
+
+type TaskRunScheduledFrom string
+
+const (
+	TaskRunScheduledFromSchedule    TaskRunScheduledFrom = "SCHEDULE"
+	TaskRunScheduledFromExecuteTask TaskRunScheduledFrom = "EXECUTE TASK"
+	TaskRunScheduledFromTrigger     TaskRunScheduledFrom = "TRIGGER"
+)
+
+// IsFinished returns true when the task run reached one of the terminal states.
+func (s TaskRunState) IsFinished() bool {
+	return s != TaskRunStateScheduled && s != TaskRunStateExecuting
+}
+
+// IsFailed returns true when the task run finished without succeeding (skipped runs are not considered failed).
+func (s TaskRunState) IsFailed() bool {
+	return s == TaskRunStateFailed || s == TaskRunStateFailedAndAutoSuspended || s == TaskRunStateCancelled
+}
+
+type taskRunRow struct {
+	QueryId        sql.NullString `db:"QUERY_ID"`
+	Name           string         `db:"NAME"`
+	DatabaseName   string         `db:"DATABASE_NAME"`
+	SchemaName     string         `db:"SCHEMA_NAME"`
+	QueryText      sql.NullString `db:"QUERY_TEXT"`
+	ConditionText  sql.NullString `db:"CONDITION_TEXT"`
+	State          string         `db:"STATE"`
+	ErrorCode      sql.NullString `db:"ERROR_CODE"`
+	ErrorMessage   sql.NullString `db:"ERROR_MESSAGE"`
+	ScheduledTime  time.Time      `db:"SCHEDULED_TIME"`
+	QueryStartTime sql.NullTime   `db:"QUERY_START_TIME"`
+	CompletedTime  sql.NullTime   `db:"COMPLETED_TIME"`
+	RootTaskId     sql.NullString `db:"ROOT_TASK_ID"`
+	RunId          sql.NullInt64  `db:"RUN_ID"`
+	ReturnValue    sql.NullString `db:"RETURN_VALUE"`
+	ScheduledFrom  sql.NullString `db:"SCHEDULED_FROM"`
+	AttemptNumber  sql.NullInt64  `db:"ATTEMPT_NUMBER"`
+}
+
+// TaskRun is a single row returned by the TASK_HISTORY table function.
+type TaskRun struct {
+	QueryId        *string
+	Name           string
+	DatabaseName   string
+	SchemaName     string
+	QueryText      string
+	ConditionText  string
+	State          TaskRunState
+	ErrorCode      *string
+	ErrorMessage   *string
+	ScheduledTime  time.Time
+	QueryStartTime *time.Time
+	CompletedTime  *time.Time
+	RootTaskId     string
+	RunId          int64
+	ReturnValue    *string
+	ScheduledFrom  TaskRunScheduledFrom
+	AttemptNumber  int
+}
+
+func (v *TaskRun) TaskId() SchemaObjectIdentifier {
+	return NewSchemaObjectIdentifier(v.DatabaseName, v.SchemaName, v.Name)
+}
+
+func (r taskRunRow) convert() *TaskRun {
+	taskRun := &TaskRun{
+		Name:          r.Name,
+		DatabaseName:  r.DatabaseName,
+		SchemaName:    r.SchemaName,
+		State:         TaskRunState(r.State),
+		ScheduledTime: r.ScheduledTime,
+	}
+	if r.QueryId.Valid {
+		taskRun.QueryId = &r.QueryId.String
+	}
+	if r.QueryText.Valid {
+		taskRun.QueryText = r.QueryText.String
+	}
+	if r.ConditionText.Valid {
+		taskRun.ConditionText = r.ConditionText.String
+	}
+	if r.ErrorCode.Valid {
+		taskRun.ErrorCode = &r.ErrorCode.String
+	}
+	if r.ErrorMessage.Valid {
+		taskRun.ErrorMessage = &r.ErrorMessage.String
+	}
+	if r.QueryStartTime.Valid {
+		taskRun.QueryStartTime = &r.QueryStartTime.Time
+	}
+	if r.CompletedTime.Valid {
+		taskRun.CompletedTime = &r.CompletedTime.Time
+	}
+	if r.RootTaskId.Valid {
+		taskRun.RootTaskId = r.RootTaskId.String
+	}
+	if r.RunId.Valid {
+		taskRun.RunId = r.RunId.Int64
+	}
+	if r.ReturnValue.Valid {
+		taskRun.ReturnValue = &r.ReturnValue.String
+	}
+	if r.ScheduledFrom.Valid {
+		taskRun.ScheduledFrom = TaskRunScheduledFrom(r.ScheduledFrom.String)
+	}
+	if r.AttemptNumber.Valid {
+		taskRun.AttemptNumber = int(r.AttemptNumber.Int64)
+	}
+	return taskRun
+}
+
+// History returns the runs of the given task, the latest ones first.
+// TASK_HISTORY matches TASK_NAME case-insensitively within the whole database, so the results are narrowed down to the given task.
+func (v *tasks) History(ctx context.Context, request *TaskHistoryRequest) ([]TaskRun, error) {
+	opts := request.toOpts()
+	rows, err := validateAndQuery[taskRunRow](v.client, ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	taskRuns := convertRows[taskRunRow, TaskRun](rows)
+	result := make([]TaskRun, 0, len(taskRuns))
+	for _, taskRun := range taskRuns {
+		if taskRun.SchemaName == request.taskId.SchemaName() && taskRun.Name == request.taskId.Name() {
+			result = append(result, taskRun)
+		}
+	}
+	slices.SortStableFunc(result, func(a, b TaskRun) int {
+		return b.ScheduledTime.Compare(a.ScheduledTime)
+	})
+	return result, nil
+}
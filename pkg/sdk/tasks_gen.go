@@ -19,6 +19,7 @@ type Tasks interface {
 	ShowParameters(ctx context.Context, id SchemaObjectIdentifier) ([]*Parameter, error)
 	Describe(ctx context.Context, id SchemaObjectIdentifier) (*Task, error)
 	Execute(ctx context.Context, request *ExecuteTaskRequest) error
+	History(ctx context.Context, request *TaskHistoryRequest) ([]TaskRun, error)
 	SuspendRootTasks(ctx context.Context, taskId SchemaObjectIdentifier, id SchemaObjectIdentifier) ([]SchemaObjectIdentifier, error)
 	ResumeTasks(ctx context.Context, ids []SchemaObjectIdentifier) error
 }
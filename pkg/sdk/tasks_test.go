@@ -114,3 +114,60 @@ func Test_getPredecessors(t *testing.T) {
 		require.ErrorContains(t, err, "invalid character ']'")
 	})
 }
+
+func TestTasks_History(t *testing.T) {
+	id := randomSchemaObjectIdentifier()
+	functionId := NewSchemaObjectIdentifier(id.DatabaseName(), "INFORMATION_SCHEMA", "TASK_HISTORY")
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *taskHistoryOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.function.Name]", func(t *testing.T) {
+		opts := NewTaskHistoryRequest(NewSchemaObjectIdentifier("", "schema", "task")).toOpts()
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("validation: valid identifier for [opts.function.Arguments.TaskName]", func(t *testing.T) {
+		opts := NewTaskHistoryRequest(emptySchemaObjectIdentifier).toOpts()
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("validation: result limit out of range", func(t *testing.T) {
+		opts := NewTaskHistoryRequest(id).WithResultLimit(10001).toOpts()
+		assertOptsInvalidJoinedErrors(t, opts, errIntBetween("taskHistoryArguments", "ResultLimit", 1, 10000))
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		opts := NewTaskHistoryRequest(id).toOpts()
+		assertOptsValidAndSQLEquals(t, opts, `SELECT * FROM TABLE (%s (TASK_NAME => '%s'))`, functionId.FullyQualifiedName(), id.Name())
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		opts := NewTaskHistoryRequest(id).WithResultLimit(10).toOpts()
+		assertOptsValidAndSQLEquals(t, opts, `SELECT * FROM TABLE (%s (TASK_NAME => '%s', RESULT_LIMIT => 10))`, functionId.FullyQualifiedName(), id.Name())
+	})
+}
+
+func TestTaskRunState(t *testing.T) {
+	testCases := []struct {
+		state    TaskRunState
+		finished bool
+		failed   bool
+	}{
+		{state: TaskRunStateScheduled},
+		{state: TaskRunStateExecuting},
+		{state: TaskRunStateSucceeded, finished: true},
+		{state: TaskRunStateSkipped, finished: true},
+		{state: TaskRunStateFailed, finished: true, failed: true},
+		{state: TaskRunStateFailedAndAutoSuspended, finished: true, failed: true},
+		{state: TaskRunStateCancelled, finished: true, failed: true},
+	}
+	for _, tc := range testCases {
+		t.Run(string(tc.state), func(t *testing.T) {
+			assert.Equal(t, tc.finished, tc.state.IsFinished())
+			assert.Equal(t, tc.failed, tc.state.IsFailed())
+		})
+	}
+}
@@ -712,4 +712,38 @@ func TestInt_Account_SelfAlter(t *testing.T) {
 		require.NoError(t, err)
 		assertPolicySet(t, newPackagesPolicyId)
 	})
+
+	t.Run("force new password policy", func(t *testing.T) {
+		passwordPolicy, passwordPolicyCleanup := testClientHelper().PasswordPolicy.CreatePasswordPolicy(t)
+		t.Cleanup(passwordPolicyCleanup)
+
+		newPasswordPolicy, newPasswordPolicyCleanup := testClientHelper().PasswordPolicy.CreatePasswordPolicy(t)
+		t.Cleanup(newPasswordPolicyCleanup)
+
+		err := client.Accounts.Alter(ctx, &sdk.AlterAccountOptions{
+			Set: &sdk.AccountSet{
+				PasswordPolicy: passwordPolicy.ID(),
+			},
+		})
+		require.NoError(t, err)
+		assertPolicySet(t, passwordPolicy.ID())
+		t.Cleanup(func() {
+			err = client.Accounts.Alter(ctx, &sdk.AlterAccountOptions{
+				Unset: &sdk.AccountUnset{
+					PasswordPolicy: sdk.Bool(true),
+				},
+			})
+			require.NoError(t, err)
+			assertPolicyNotSet(t)
+		})
+
+		err = client.Accounts.Alter(ctx, &sdk.AlterAccountOptions{
+			Set: &sdk.AccountSet{
+				PasswordPolicy: newPasswordPolicy.ID(),
+				Force:          sdk.Bool(true),
+			},
+		})
+		require.NoError(t, err)
+		assertPolicySet(t, newPasswordPolicy.ID())
+	})
 }
@@ -2,10 +2,12 @@ package testint
 
 import (
 	"errors"
+	"fmt"
 	"strings"
 	"testing"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/helpers/random"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -90,7 +92,9 @@ func TestInt_AlertCreate(t *testing.T) {
 		condition := "SELECT 1"
 		action := "SELECT 1"
 		comment := random.Comment()
-		err := client.Alerts.Create(ctx, id, testClientHelper().Ids.WarehouseId(), schedule, condition, action, &sdk.CreateAlertOptions{
+		err := client.Alerts.Create(ctx, id, condition, action, &sdk.CreateAlertOptions{
+			Warehouse:   sdk.Pointer(testClientHelper().Ids.WarehouseId()),
+			Schedule:    sdk.String(schedule),
 			OrReplace:   sdk.Bool(true),
 			IfNotExists: sdk.Bool(false),
 			Comment:     sdk.String(comment),
@@ -126,7 +130,9 @@ func TestInt_AlertCreate(t *testing.T) {
 		condition := "SELECT 1"
 		action := "SELECT 1"
 		comment := random.Comment()
-		err := client.Alerts.Create(ctx, id, testClientHelper().Ids.WarehouseId(), schedule, condition, action, &sdk.CreateAlertOptions{
+		err := client.Alerts.Create(ctx, id, condition, action, &sdk.CreateAlertOptions{
+			Warehouse:   sdk.Pointer(testClientHelper().Ids.WarehouseId()),
+			Schedule:    sdk.String(schedule),
 			OrReplace:   sdk.Bool(false),
 			IfNotExists: sdk.Bool(true),
 			Comment:     sdk.String(comment),
@@ -161,7 +167,10 @@ func TestInt_AlertCreate(t *testing.T) {
 		schedule := "USING CRON * * * * TUE,THU UTC"
 		condition := "SELECT 1"
 		action := "SELECT 1"
-		err := client.Alerts.Create(ctx, id, testClientHelper().Ids.WarehouseId(), schedule, condition, action, nil)
+		err := client.Alerts.Create(ctx, id, condition, action, &sdk.CreateAlertOptions{
+			Warehouse: sdk.Pointer(testClientHelper().Ids.WarehouseId()),
+			Schedule:  sdk.String(schedule),
+		})
 		require.NoError(t, err)
 		alertDetails, err := client.Alerts.Describe(ctx, id)
 		require.NoError(t, err)
@@ -199,7 +208,10 @@ func TestInt_AlertCreate(t *testing.T) {
 						2
 				end
 		`
-		err := client.Alerts.Create(ctx, id, testClientHelper().Ids.WarehouseId(), schedule, condition, action, nil)
+		err := client.Alerts.Create(ctx, id, condition, action, &sdk.CreateAlertOptions{
+			Warehouse: sdk.Pointer(testClientHelper().Ids.WarehouseId()),
+			Schedule:  sdk.String(schedule),
+		})
 		require.NoError(t, err)
 		alertDetails, err := client.Alerts.Describe(ctx, id)
 		require.NoError(t, err)
@@ -398,7 +410,10 @@ func TestInt_AlertsShowByID(t *testing.T) {
 		t.Helper()
 
 		schedule, condition, action := "USING CRON * * * * * UTC", "SELECT 1", "SELECT 1"
-		err := client.Alerts.Create(ctx, id, warehouseId, schedule, condition, action, &sdk.CreateAlertOptions{})
+		err := client.Alerts.Create(ctx, id, condition, action, &sdk.CreateAlertOptions{
+			Warehouse: sdk.Pointer(warehouseId),
+			Schedule:  sdk.String(schedule),
+		})
 		require.NoError(t, err)
 		t.Cleanup(cleanupAlertHandle(t, id))
 	}
@@ -431,3 +446,64 @@ func TestInt_AlertsShowByID(t *testing.T) {
 		assert.Equal(t, "ROLE", alert.OwnerRoleType)
 	})
 }
+
+func TestInt_AlertServerlessOnNewData(t *testing.T) {
+	client := testClient(t)
+	ctx := testContext(t)
+
+	table, tableCleanup := testClientHelper().Table.CreateWithChangeTracking(t)
+	t.Cleanup(tableCleanup)
+
+	id := testClientHelper().Ids.RandomSchemaObjectIdentifier()
+	condition := fmt.Sprintf("SELECT * FROM %s", table.ID().FullyQualifiedName())
+	err := client.Alerts.Create(ctx, id, condition, "SELECT 1", &sdk.CreateAlertOptions{
+		SuspendTaskAfterNumFailures: sdk.Int(5),
+		UserTaskTimeoutMs:           sdk.Int(1000),
+	})
+	require.NoError(t, err)
+	t.Cleanup(testClientHelper().Alert.DropAlertFunc(t, id))
+
+	alert, err := client.Alerts.ShowByID(ctx, id)
+	require.NoError(t, err)
+	assert.Empty(t, alert.Warehouse)
+	assert.Empty(t, alert.Schedule)
+	assert.Equal(t, condition, alert.Condition)
+
+	t.Run("show parameters", func(t *testing.T) {
+		parameters, err := client.Alerts.ShowParameters(ctx, id)
+		require.NoError(t, err)
+
+		suspendAfterFailures, err := collections.FindFirst(parameters, func(p *sdk.Parameter) bool {
+			return p.Key == string(sdk.AlertParameterSuspendTaskAfterNumFailures)
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "5", (*suspendAfterFailures).Value)
+		assert.Equal(t, sdk.ParameterTypeAlert, (*suspendAfterFailures).Level)
+	})
+
+	t.Run("unset parameters", func(t *testing.T) {
+		err := client.Alerts.Alter(ctx, id, &sdk.AlterAlertOptions{
+			Unset: &sdk.AlertUnset{
+				SuspendTaskAfterNumFailures: sdk.Bool(true),
+				UserTaskTimeoutMs:           sdk.Bool(true),
+			},
+		})
+		require.NoError(t, err)
+
+		parameters, err := client.Alerts.ShowParameters(ctx, id)
+		require.NoError(t, err)
+		for _, parameter := range parameters {
+			assert.NotEqual(t, sdk.ParameterTypeAlert, parameter.Level)
+		}
+	})
+
+	t.Run("execute", func(t *testing.T) {
+		err := client.Alerts.Execute(ctx, id)
+		require.NoError(t, err)
+	})
+
+	t.Run("show by id - missing alert", func(t *testing.T) {
+		_, err := client.Alerts.ShowByID(ctx, testClientHelper().Ids.RandomSchemaObjectIdentifier())
+		assert.ErrorIs(t, err, sdk.ErrObjectNotFound)
+	})
+}
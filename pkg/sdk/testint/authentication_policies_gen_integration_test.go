@@ -159,6 +159,40 @@ func TestInt_AuthenticationPolicies(t *testing.T) {
 		assertProperty(t, desc, "AUTHENTICATION_METHODS", "[ALL]")
 	})
 
+	t.Run("Alter - set and unset mfa policy", func(t *testing.T) {
+		authenticationPolicy, cleanupAuthPolicy := testClientHelper().AuthenticationPolicy.Create(t)
+		t.Cleanup(cleanupAuthPolicy)
+
+		err := client.AuthenticationPolicies.Alter(ctx, sdk.NewAlterAuthenticationPolicyRequest(authenticationPolicy.ID()).
+			WithSet(*sdk.NewAuthenticationPolicySetRequest().
+				WithMfaEnrollment(sdk.MfaEnrollmentRequiredPasswordOnly).
+				WithMfaPolicy(*sdk.NewAuthenticationPolicyMfaPolicyRequest().
+					WithAllowedMethods([]sdk.MfaPolicyAllowedMethod{
+						{Method: sdk.MfaPolicyAllowedMethodsPasskey},
+						{Method: sdk.MfaPolicyAllowedMethodsTotp},
+					}).
+					WithEnforceMfaOnExternalAuthentication(sdk.EnforceMfaOnExternalAuthenticationAll))))
+		require.NoError(t, err)
+
+		desc, err := client.AuthenticationPolicies.Describe(ctx, authenticationPolicy.ID())
+		require.NoError(t, err)
+
+		assertProperty(t, desc, "MFA_ENROLLMENT", "REQUIRED_PASSWORD_ONLY")
+		assertProperty(t, desc, "MFA_POLICY", "{ALLOWED_METHODS=[PASSKEY, TOTP], ENFORCE_MFA_ON_EXTERNAL_AUTHENTICATION=ALL}")
+
+		err = client.AuthenticationPolicies.Alter(ctx, sdk.NewAlterAuthenticationPolicyRequest(authenticationPolicy.ID()).
+			WithUnset(*sdk.NewAuthenticationPolicyUnsetRequest().
+				WithMfaEnrollment(true).
+				WithMfaPolicy(true)))
+		require.NoError(t, err)
+
+		desc, err = client.AuthenticationPolicies.Describe(ctx, authenticationPolicy.ID())
+		require.NoError(t, err)
+
+		assertProperty(t, desc, "MFA_ENROLLMENT", "OPTIONAL")
+		assertProperty(t, desc, "MFA_POLICY", "{ALLOWED_METHODS=[ALL], ENFORCE_MFA_ON_EXTERNAL_AUTHENTICATION=NONE}")
+	})
+
 	t.Run("Alter - rename", func(t *testing.T) {
 		newId := testClientHelper().Ids.RandomSchemaObjectIdentifier()
 
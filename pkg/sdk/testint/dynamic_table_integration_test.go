@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/tracking"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
 
@@ -197,7 +198,7 @@ func TestInt_DynamicTableAlter(t *testing.T) {
 
 		err := client.DynamicTables.Alter(ctx, sdk.NewAlterDynamicTableRequest(dynamicTable.ID()).WithSuspend(sdk.Bool(true)).WithResume(sdk.Bool(true)))
 		require.Error(t, err)
-		sdk.ErrorsEqual(t, sdk.JoinErrors(sdk.ErrExactlyOneOf("alterDynamicTableOptions", "Suspend", "Resume", "Refresh", "Set")), err)
+		sdk.ErrorsEqual(t, sdk.JoinErrors(sdk.ErrExactlyOneOf("alterDynamicTableOptions", "Suspend", "Resume", "Refresh", "Set", "Unset", "RenameTo", "SetImmutableWhere", "UnsetImmutable", "AddRowAccessPolicy", "DropRowAccessPolicy", "DropAndAddRowAccessPolicy", "DropAllRowAccessPolicies", "SetTags", "UnsetTags")), err)
 	})
 
 	t.Run("alter with set", func(t *testing.T) {
@@ -214,6 +215,148 @@ func TestInt_DynamicTableAlter(t *testing.T) {
 			require.Equal(t, value, entities[0].TargetLag)
 		}
 	})
+
+	t.Run("alter with set and unset parameters and comment", func(t *testing.T) {
+		dynamicTable, dynamicTableCleanup := testClientHelper().DynamicTable.CreateDynamicTable(t, table.ID())
+		t.Cleanup(dynamicTableCleanup)
+
+		err := client.DynamicTables.Alter(ctx, sdk.NewAlterDynamicTableRequest(dynamicTable.ID()).WithSet(sdk.NewDynamicTableSetRequest().
+			WithDataRetentionTimeInDays(2).
+			WithMaxDataExtensionTimeInDays(3).
+			WithComment("new comment"),
+		))
+		require.NoError(t, err)
+
+		parameters, err := client.DynamicTables.ShowParameters(ctx, dynamicTable.ID())
+		require.NoError(t, err)
+		dataRetentionTimeInDays, err := collections.FindFirst(parameters, func(p *sdk.Parameter) bool {
+			return p.Key == string(sdk.DynamicTableParameterDataRetentionTimeInDays)
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "2", (*dataRetentionTimeInDays).Value)
+		assert.Equal(t, sdk.ParameterTypeTable, (*dataRetentionTimeInDays).Level)
+		maxDataExtensionTimeInDays, err := collections.FindFirst(parameters, func(p *sdk.Parameter) bool {
+			return p.Key == string(sdk.DynamicTableParameterMaxDataExtensionTimeInDays)
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "3", (*maxDataExtensionTimeInDays).Value)
+		assert.Equal(t, sdk.ParameterTypeTable, (*maxDataExtensionTimeInDays).Level)
+
+		entity, err := client.DynamicTables.ShowByID(ctx, dynamicTable.ID())
+		require.NoError(t, err)
+		require.Equal(t, "new comment", entity.Comment)
+
+		err = client.DynamicTables.Alter(ctx, sdk.NewAlterDynamicTableRequest(dynamicTable.ID()).WithUnset(sdk.NewDynamicTableUnsetRequest().
+			WithDataRetentionTimeInDays(true).
+			WithMaxDataExtensionTimeInDays(true).
+			WithComment(true),
+		))
+		require.NoError(t, err)
+
+		parameters, err = client.DynamicTables.ShowParameters(ctx, dynamicTable.ID())
+		require.NoError(t, err)
+		for _, parameter := range parameters {
+			if parameter.Key == string(sdk.DynamicTableParameterDataRetentionTimeInDays) || parameter.Key == string(sdk.DynamicTableParameterMaxDataExtensionTimeInDays) {
+				assert.NotEqual(t, sdk.ParameterTypeTable, parameter.Level)
+			}
+		}
+
+		entity, err = client.DynamicTables.ShowByID(ctx, dynamicTable.ID())
+		require.NoError(t, err)
+		require.Empty(t, entity.Comment)
+	})
+
+	t.Run("alter with rename", func(t *testing.T) {
+		dynamicTable, dynamicTableCleanup := testClientHelper().DynamicTable.CreateDynamicTable(t, table.ID())
+		t.Cleanup(dynamicTableCleanup)
+		newId := testClientHelper().Ids.RandomSchemaObjectIdentifier()
+
+		err := client.DynamicTables.Alter(ctx, sdk.NewAlterDynamicTableRequest(dynamicTable.ID()).WithRenameTo(newId))
+		require.NoError(t, err)
+		t.Cleanup(testClientHelper().DynamicTable.DropDynamicTableFunc(t, newId))
+
+		_, err = client.DynamicTables.ShowByID(ctx, dynamicTable.ID())
+		require.ErrorIs(t, err, sdk.ErrObjectNotFound)
+		_, err = client.DynamicTables.ShowByID(ctx, newId)
+		require.NoError(t, err)
+	})
+
+	t.Run("alter with immutable where", func(t *testing.T) {
+		dynamicTable, dynamicTableCleanup := testClientHelper().DynamicTable.CreateDynamicTable(t, table.ID())
+		t.Cleanup(dynamicTableCleanup)
+
+		err := client.DynamicTables.Alter(ctx, sdk.NewAlterDynamicTableRequest(dynamicTable.ID()).WithSetImmutableWhere(`"ID" < 10`))
+		require.NoError(t, err)
+
+		err = client.DynamicTables.Alter(ctx, sdk.NewAlterDynamicTableRequest(dynamicTable.ID()).WithUnsetImmutable(true))
+		require.NoError(t, err)
+	})
+
+	t.Run("alter with row access policy", func(t *testing.T) {
+		dynamicTable, dynamicTableCleanup := testClientHelper().DynamicTable.CreateDynamicTable(t, table.ID())
+		t.Cleanup(dynamicTableCleanup)
+		rowAccessPolicy, rowAccessPolicyCleanup := testClientHelper().RowAccessPolicy.CreateRowAccessPolicy(t)
+		t.Cleanup(rowAccessPolicyCleanup)
+		rowAccessPolicy2, rowAccessPolicy2Cleanup := testClientHelper().RowAccessPolicy.CreateRowAccessPolicy(t)
+		t.Cleanup(rowAccessPolicy2Cleanup)
+
+		err := client.DynamicTables.Alter(ctx, sdk.NewAlterDynamicTableRequest(dynamicTable.ID()).WithAddRowAccessPolicy(*sdk.NewDynamicTableRowAccessPolicyRequest(rowAccessPolicy.ID(), []sdk.Column{{Value: "ID"}})))
+		require.NoError(t, err)
+
+		policyReference, err := testClientHelper().PolicyReferences.GetPolicyReference(t, dynamicTable.ID(), sdk.PolicyEntityDomainTable)
+		require.NoError(t, err)
+		assert.Equal(t, rowAccessPolicy.ID().Name(), policyReference.PolicyName)
+		assert.Equal(t, sdk.PolicyKindRowAccessPolicy, policyReference.PolicyKind)
+
+		err = client.DynamicTables.Alter(ctx, sdk.NewAlterDynamicTableRequest(dynamicTable.ID()).WithDropAndAddRowAccessPolicy(*sdk.NewDynamicTableDropAndAddRowAccessPolicyRequest(
+			rowAccessPolicy.ID(),
+			*sdk.NewDynamicTableRowAccessPolicyRequest(rowAccessPolicy2.ID(), []sdk.Column{{Value: "ID"}}),
+		)))
+		require.NoError(t, err)
+
+		policyReference, err = testClientHelper().PolicyReferences.GetPolicyReference(t, dynamicTable.ID(), sdk.PolicyEntityDomainTable)
+		require.NoError(t, err)
+		assert.Equal(t, rowAccessPolicy2.ID().Name(), policyReference.PolicyName)
+
+		err = client.DynamicTables.Alter(ctx, sdk.NewAlterDynamicTableRequest(dynamicTable.ID()).WithDropRowAccessPolicy(rowAccessPolicy2.ID()))
+		require.NoError(t, err)
+
+		policyReferences, err := testClientHelper().PolicyReferences.GetPolicyReferences(t, dynamicTable.ID(), sdk.PolicyEntityDomainTable)
+		require.NoError(t, err)
+		require.Empty(t, policyReferences)
+	})
+}
+
+func TestInt_DynamicTableCreateOrAlter(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	table, tableCleanup := testClientHelper().Table.Create(t)
+	t.Cleanup(tableCleanup)
+
+	id := testClientHelper().Ids.RandomSchemaObjectIdentifier()
+	targetLag := sdk.TargetLag{
+		MaximumDuration: sdk.String("2 minutes"),
+	}
+
+	err := client.DynamicTables.Create(ctx, sdk.NewCreateDynamicTableRequest(id, testClientHelper().Ids.WarehouseId(), targetLag, fmt.Sprintf(`select "ID" from %s`, table.ID().FullyQualifiedName())).
+		WithTransient(true).
+		WithDataRetentionTimeInDays(1).
+		WithImmutableWhere(`"ID" < 10`))
+	require.NoError(t, err)
+	t.Cleanup(testClientHelper().DynamicTable.DropDynamicTableFunc(t, id))
+
+	newQuery := fmt.Sprintf(`select "ID" from %s where "ID" > 0`, table.ID().FullyQualifiedName())
+	err = client.DynamicTables.Create(ctx, sdk.NewCreateDynamicTableRequest(id, testClientHelper().Ids.WarehouseId(), targetLag, newQuery).
+		WithOrAlter(true).
+		WithTransient(true).
+		WithDataRetentionTimeInDays(1).
+		WithImmutableWhere(`"ID" < 10`))
+	require.NoError(t, err)
+
+	dynamicTable, err := client.DynamicTables.ShowByID(ctx, id)
+	require.NoError(t, err)
+	assert.Contains(t, dynamicTable.Text, `where "ID" > 0`)
 }
 
 func TestInt_DynamicTablesShowByID(t *testing.T) {
@@ -0,0 +1,173 @@
+package testint
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt_ExternalAccessIntegrations(t *testing.T) {
+	client := testClient(t)
+	ctx := testContext(t)
+
+	networkRule, networkRuleCleanup := testClientHelper().NetworkRule.Create(t)
+	t.Cleanup(networkRuleCleanup)
+
+	networkRule2, networkRule2Cleanup := testClientHelper().NetworkRule.Create(t)
+	t.Cleanup(networkRule2Cleanup)
+
+	secret, secretCleanup := testClientHelper().Secret.CreateWithGenericString(t, testClientHelper().Ids.RandomSchemaObjectIdentifier(), "test_secret_string")
+	t.Cleanup(secretCleanup)
+
+	apiAuthIntegration, apiAuthIntegrationCleanup := testClientHelper().SecurityIntegration.CreateApiAuthenticationWithClientCredentialsFlow(t)
+	t.Cleanup(apiAuthIntegrationCleanup)
+
+	findProperty := func(t *testing.T, properties []sdk.ExternalAccessIntegrationProperty, name string) sdk.ExternalAccessIntegrationProperty {
+		t.Helper()
+		property, err := collections.FindFirst(properties, func(property sdk.ExternalAccessIntegrationProperty) bool { return property.Name == name })
+		require.NoError(t, err)
+		return *property
+	}
+
+	assertIdentifiersProperty := func(t *testing.T, properties []sdk.ExternalAccessIntegrationProperty, name string, expected ...sdk.SchemaObjectIdentifier) {
+		t.Helper()
+		ids, err := sdk.ParseCommaSeparatedSchemaObjectIdentifierArray(findProperty(t, properties, name).Value)
+		require.NoError(t, err)
+		assert.ElementsMatch(t, expected, ids)
+	}
+
+	t.Run("create: basic", func(t *testing.T) {
+		id := testClientHelper().Ids.RandomAccountObjectIdentifier()
+		request := sdk.NewCreateExternalAccessIntegrationRequest(id, []sdk.SchemaObjectIdentifier{networkRule.ID()}, true)
+
+		err := client.ExternalAccessIntegrations.Create(ctx, request)
+		require.NoError(t, err)
+		t.Cleanup(testClientHelper().ExternalAccessIntegration.DropExternalAccessIntegrationFunc(t, id))
+
+		integration, err := client.ExternalAccessIntegrations.ShowByID(ctx, id)
+		require.NoError(t, err)
+
+		assert.Equal(t, id.Name(), integration.Name)
+		assert.Equal(t, "EXTERNAL_ACCESS", integration.IntegrationType)
+		assert.Equal(t, "SECURITY", integration.Category)
+		assert.True(t, integration.Enabled)
+		assert.Empty(t, integration.Comment)
+		assert.False(t, integration.CreatedOn.IsZero())
+	})
+
+	t.Run("create: complete", func(t *testing.T) {
+		id := testClientHelper().Ids.RandomAccountObjectIdentifier()
+		request := sdk.NewCreateExternalAccessIntegrationRequest(id, []sdk.SchemaObjectIdentifier{networkRule.ID(), networkRule2.ID()}, false).
+			WithAllowedApiAuthenticationIntegrations([]sdk.AccountObjectIdentifier{apiAuthIntegration.ID()}).
+			WithAllowedAuthenticationSecrets([]sdk.SchemaObjectIdentifier{secret.ID()}).
+			WithComment("some comment")
+
+		err := client.ExternalAccessIntegrations.Create(ctx, request)
+		require.NoError(t, err)
+		t.Cleanup(testClientHelper().ExternalAccessIntegration.DropExternalAccessIntegrationFunc(t, id))
+
+		integration, err := client.ExternalAccessIntegrations.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.False(t, integration.Enabled)
+		assert.Equal(t, "some comment", integration.Comment)
+
+		properties, err := client.ExternalAccessIntegrations.Describe(ctx, id)
+		require.NoError(t, err)
+		assertIdentifiersProperty(t, properties, "ALLOWED_NETWORK_RULES", networkRule.ID(), networkRule2.ID())
+		assertIdentifiersProperty(t, properties, "ALLOWED_AUTHENTICATION_SECRETS", secret.ID())
+		assert.Contains(t, findProperty(t, properties, "ALLOWED_API_AUTHENTICATION_INTEGRATIONS").Value, apiAuthIntegration.ID().Name())
+		assert.Equal(t, "false", findProperty(t, properties, "ENABLED").Value)
+		assert.Equal(t, "some comment", findProperty(t, properties, "COMMENT").Value)
+	})
+
+	t.Run("alter: set and unset", func(t *testing.T) {
+		integration := testClientHelper().ExternalAccessIntegration.CreateWithRequest(t, sdk.NewCreateExternalAccessIntegrationRequest(testClientHelper().Ids.RandomAccountObjectIdentifier(), []sdk.SchemaObjectIdentifier{networkRule.ID()}, true))
+		id := integration.ID()
+		t.Cleanup(testClientHelper().ExternalAccessIntegration.DropExternalAccessIntegrationFunc(t, id))
+
+		err := client.ExternalAccessIntegrations.Alter(ctx, sdk.NewAlterExternalAccessIntegrationRequest(id).WithSet(
+			*sdk.NewExternalAccessIntegrationSetRequest().
+				WithAllowedNetworkRules([]sdk.SchemaObjectIdentifier{networkRule2.ID()}).
+				WithAllowedApiAuthenticationIntegrations([]sdk.AccountObjectIdentifier{apiAuthIntegration.ID()}).
+				WithAllowedAuthenticationSecrets([]sdk.SchemaObjectIdentifier{secret.ID()}).
+				WithEnabled(false).
+				WithComment("altered comment"),
+		))
+		require.NoError(t, err)
+
+		properties, err := client.ExternalAccessIntegrations.Describe(ctx, id)
+		require.NoError(t, err)
+		assertIdentifiersProperty(t, properties, "ALLOWED_NETWORK_RULES", networkRule2.ID())
+		assertIdentifiersProperty(t, properties, "ALLOWED_AUTHENTICATION_SECRETS", secret.ID())
+		assert.Equal(t, "false", findProperty(t, properties, "ENABLED").Value)
+		assert.Equal(t, "altered comment", findProperty(t, properties, "COMMENT").Value)
+
+		err = client.ExternalAccessIntegrations.Alter(ctx, sdk.NewAlterExternalAccessIntegrationRequest(id).WithUnset(
+			*sdk.NewExternalAccessIntegrationUnsetRequest().
+				WithAllowedApiAuthenticationIntegrations(true).
+				WithAllowedAuthenticationSecrets(true).
+				WithComment(true),
+		))
+		require.NoError(t, err)
+
+		properties, err = client.ExternalAccessIntegrations.Describe(ctx, id)
+		require.NoError(t, err)
+		assertIdentifiersProperty(t, properties, "ALLOWED_AUTHENTICATION_SECRETS")
+		assert.NotContains(t, findProperty(t, properties, "ALLOWED_API_AUTHENTICATION_INTEGRATIONS").Value, apiAuthIntegration.ID().Name())
+		assert.Empty(t, findProperty(t, properties, "COMMENT").Value)
+	})
+
+	t.Run("alter: set and unset tags", func(t *testing.T) {
+		tag, tagCleanup := testClientHelper().Tag.CreateTag(t)
+		t.Cleanup(tagCleanup)
+
+		integration := testClientHelper().ExternalAccessIntegration.CreateWithRequest(t, sdk.NewCreateExternalAccessIntegrationRequest(testClientHelper().Ids.RandomAccountObjectIdentifier(), []sdk.SchemaObjectIdentifier{networkRule.ID()}, true))
+		id := integration.ID()
+		t.Cleanup(testClientHelper().ExternalAccessIntegration.DropExternalAccessIntegrationFunc(t, id))
+
+		err := client.ExternalAccessIntegrations.Alter(ctx, sdk.NewAlterExternalAccessIntegrationRequest(id).WithSetTags([]sdk.TagAssociation{
+			{
+				Name:  tag.ID(),
+				Value: "v1",
+			},
+		}))
+		require.NoError(t, err)
+
+		returnedTagValue, err := client.SystemFunctions.GetTag(ctx, tag.ID(), id, sdk.ObjectTypeIntegration)
+		require.NoError(t, err)
+		assert.Equal(t, sdk.Pointer("v1"), returnedTagValue)
+
+		err = client.ExternalAccessIntegrations.Alter(ctx, sdk.NewAlterExternalAccessIntegrationRequest(id).WithUnsetTags([]sdk.ObjectIdentifier{tag.ID()}))
+		require.NoError(t, err)
+
+		returnedTagValue, err = client.SystemFunctions.GetTag(ctx, tag.ID(), id, sdk.ObjectTypeIntegration)
+		require.NoError(t, err)
+		assert.Nil(t, returnedTagValue)
+	})
+
+	t.Run("show: with like", func(t *testing.T) {
+		integration := testClientHelper().ExternalAccessIntegration.CreateWithRequest(t, sdk.NewCreateExternalAccessIntegrationRequest(testClientHelper().Ids.RandomAccountObjectIdentifier(), []sdk.SchemaObjectIdentifier{networkRule.ID()}, true))
+		t.Cleanup(testClientHelper().ExternalAccessIntegration.DropExternalAccessIntegrationFunc(t, integration.ID()))
+
+		integrations, err := client.ExternalAccessIntegrations.Show(ctx, sdk.NewShowExternalAccessIntegrationRequest().WithLike(sdk.Like{
+			Pattern: sdk.String(integration.Name),
+		}))
+		require.NoError(t, err)
+		require.Len(t, integrations, 1)
+		assert.Equal(t, *integration, integrations[0])
+	})
+
+	t.Run("drop", func(t *testing.T) {
+		integration := testClientHelper().ExternalAccessIntegration.CreateWithRequest(t, sdk.NewCreateExternalAccessIntegrationRequest(testClientHelper().Ids.RandomAccountObjectIdentifier(), []sdk.SchemaObjectIdentifier{networkRule.ID()}, true))
+		id := integration.ID()
+
+		err := client.ExternalAccessIntegrations.Drop(ctx, sdk.NewDropExternalAccessIntegrationRequest(id))
+		require.NoError(t, err)
+
+		_, err = client.ExternalAccessIntegrations.ShowByID(ctx, id)
+		require.ErrorIs(t, err, sdk.ErrObjectNotFound)
+	})
+}
@@ -0,0 +1,149 @@
+package testint
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/helpers/random"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt_HybridTables(t *testing.T) {
+	client := testClient(t)
+	ctx := testContext(t)
+
+	assertHybridTable := func(t *testing.T, id sdk.SchemaObjectIdentifier, comment string) {
+		t.Helper()
+
+		hybridTable, err := client.HybridTables.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.NotEmpty(t, hybridTable.CreatedOn)
+		assert.Equal(t, id.Name(), hybridTable.Name)
+		assert.Equal(t, id.DatabaseName(), hybridTable.DatabaseName)
+		assert.Equal(t, id.SchemaName(), hybridTable.SchemaName)
+		assert.Equal(t, "ACCOUNTADMIN", hybridTable.Owner)
+		assert.Equal(t, "ROLE", hybridTable.OwnerRoleType)
+		assert.Equal(t, comment, hybridTable.Comment)
+	}
+
+	t.Run("create hybrid table: basic", func(t *testing.T) {
+		id := testClientHelper().Ids.RandomSchemaObjectIdentifier()
+
+		err := client.HybridTables.Create(ctx, sdk.NewCreateHybridTableRequest(id, testClientHelper().HybridTable.DefaultColumnsAndConstraints()))
+		require.NoError(t, err)
+		t.Cleanup(testClientHelper().HybridTable.DropFunc(t, id))
+
+		assertHybridTable(t, id, "")
+	})
+
+	t.Run("create hybrid table: complete", func(t *testing.T) {
+		id := testClientHelper().Ids.RandomSchemaObjectIdentifier()
+		comment := random.Comment()
+
+		columnsAndConstraints := sdk.HybridTableColumnsConstraintsAndIndexes{
+			Columns: []sdk.TableColumn{
+				{Name: `"id"`, Type: sdk.DataTypeNumber, NotNull: sdk.Bool(true)},
+				{Name: `"email"`, Type: sdk.DataTypeVARCHAR, Comment: sdk.String("email column")},
+				{Name: `"name"`, Type: sdk.DataTypeVARCHAR},
+			},
+			OutOfLineConstraint: []sdk.OutOfLineConstraint{
+				{Name: sdk.String("pk"), Type: sdk.ColumnConstraintTypePrimaryKey, Columns: []string{`"id"`}},
+				{Type: sdk.ColumnConstraintTypeUnique, Columns: []string{`"email"`}},
+			},
+			OutOfLineIndex: []sdk.HybridTableOutOfLineIndex{
+				{Name: "idx_name", Columns: []string{`"name"`}, IncludeColumns: []string{`"email"`}},
+			},
+		}
+		err := client.HybridTables.Create(ctx, sdk.NewCreateHybridTableRequest(id, columnsAndConstraints).WithComment(comment))
+		require.NoError(t, err)
+		t.Cleanup(testClientHelper().HybridTable.DropFunc(t, id))
+
+		assertHybridTable(t, id, comment)
+
+		columns, err := client.Tables.DescribeColumns(ctx, sdk.NewDescribeTableColumnsRequest(id))
+		require.NoError(t, err)
+		require.Len(t, columns, 3)
+
+		indexes := testClientHelper().HybridTable.ShowIndexes(t, id)
+		index, err := collections.FindFirst(indexes, func(index sdk.HybridTableIndex) bool { return index.Name == "idx_name" })
+		require.NoError(t, err)
+		assert.False(t, index.IsUnique)
+		assert.Equal(t, []string{"name"}, index.Columns)
+		assert.Equal(t, []string{"email"}, index.IncludedColumns)
+		assert.Equal(t, id, index.TableId())
+	})
+
+	t.Run("alter hybrid table: set and unset comment", func(t *testing.T) {
+		hybridTable, cleanup := testClientHelper().HybridTable.CreateWithRequest(t, sdk.NewCreateHybridTableRequest(testClientHelper().Ids.RandomSchemaObjectIdentifier(), testClientHelper().HybridTable.DefaultColumnsAndConstraints()))
+		t.Cleanup(cleanup)
+		id := hybridTable.ID()
+		comment := random.Comment()
+
+		err := client.HybridTables.Alter(ctx, sdk.NewAlterHybridTableRequest(id).WithSetComment(comment))
+		require.NoError(t, err)
+		assertHybridTable(t, id, comment)
+
+		err = client.HybridTables.Alter(ctx, sdk.NewAlterHybridTableRequest(id).WithUnsetComment(true))
+		require.NoError(t, err)
+		assertHybridTable(t, id, "")
+	})
+
+	t.Run("alter hybrid table: rename", func(t *testing.T) {
+		hybridTable, cleanup := testClientHelper().HybridTable.CreateWithRequest(t, sdk.NewCreateHybridTableRequest(testClientHelper().Ids.RandomSchemaObjectIdentifier(), testClientHelper().HybridTable.DefaultColumnsAndConstraints()))
+		t.Cleanup(cleanup)
+		id := hybridTable.ID()
+		newId := testClientHelper().Ids.RandomSchemaObjectIdentifier()
+
+		err := client.HybridTables.Alter(ctx, sdk.NewAlterHybridTableRequest(id).WithRenameTo(newId))
+		require.NoError(t, err)
+		t.Cleanup(testClientHelper().HybridTable.DropFunc(t, newId))
+
+		_, err = client.HybridTables.ShowByID(ctx, id)
+		require.ErrorIs(t, err, sdk.ErrObjectNotFound)
+		assertHybridTable(t, newId, "")
+	})
+
+	t.Run("create and drop index", func(t *testing.T) {
+		hybridTable, cleanup := testClientHelper().HybridTable.CreateWithRequest(t, sdk.NewCreateHybridTableRequest(testClientHelper().Ids.RandomSchemaObjectIdentifier(), testClientHelper().HybridTable.DefaultColumnsAndConstraints()))
+		t.Cleanup(cleanup)
+		id := hybridTable.ID()
+
+		err := client.HybridTables.CreateIndex(ctx, sdk.NewCreateIndexHybridTableRequest("idx", id, []string{`"name"`}).WithIfNotExists(true))
+		require.NoError(t, err)
+
+		indexes := testClientHelper().HybridTable.ShowIndexes(t, id)
+		index, err := collections.FindFirst(indexes, func(index sdk.HybridTableIndex) bool { return index.Name == "idx" })
+		require.NoError(t, err)
+		assert.Equal(t, []string{"name"}, index.Columns)
+		assert.Empty(t, index.IncludedColumns)
+
+		err = client.HybridTables.DropIndex(ctx, sdk.NewDropIndexHybridTableRequest(sdk.NewHybridTableIndexIdentifier(id, "idx")))
+		require.NoError(t, err)
+
+		indexes = testClientHelper().HybridTable.ShowIndexes(t, id)
+		_, err = collections.FindFirst(indexes, func(index sdk.HybridTableIndex) bool { return index.Name == "idx" })
+		require.ErrorIs(t, err, collections.ErrObjectNotFound)
+	})
+
+	t.Run("show hybrid tables: with like", func(t *testing.T) {
+		hybridTable1, cleanup1 := testClientHelper().HybridTable.CreateWithRequest(t, sdk.NewCreateHybridTableRequest(testClientHelper().Ids.RandomSchemaObjectIdentifier(), testClientHelper().HybridTable.DefaultColumnsAndConstraints()))
+		t.Cleanup(cleanup1)
+		hybridTable2, cleanup2 := testClientHelper().HybridTable.CreateWithRequest(t, sdk.NewCreateHybridTableRequest(testClientHelper().Ids.RandomSchemaObjectIdentifier(), testClientHelper().HybridTable.DefaultColumnsAndConstraints()))
+		t.Cleanup(cleanup2)
+
+		hybridTables, err := client.HybridTables.Show(ctx, sdk.NewShowHybridTableRequest().
+			WithLike(sdk.Like{Pattern: sdk.String(hybridTable1.Name)}).
+			WithIn(sdk.In{Schema: hybridTable1.ID().SchemaId()}))
+		require.NoError(t, err)
+		require.Len(t, hybridTables, 1)
+		assert.Equal(t, hybridTable1.ID(), hybridTables[0].ID())
+		assert.NotEqual(t, hybridTable2.ID(), hybridTables[0].ID())
+	})
+
+	t.Run("show by id: not existing", func(t *testing.T) {
+		_, err := client.HybridTables.ShowByID(ctx, NonExistingSchemaObjectIdentifier)
+		require.ErrorIs(t, err, collections.ErrObjectNotFound)
+	})
+}
@@ -0,0 +1,67 @@
+package testint
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/testenvs"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestInt_OrganizationAccounts is a manual test, because an organization can have only one organization account
+// and Snowflake does not support dropping it. It requires the GLOBALORGADMIN role to be in the session
+// and an already existing organization account.
+func TestInt_OrganizationAccounts(t *testing.T) {
+	testenvs.GetOrSkipTest(t, testenvs.EnableManual)
+
+	client := testClient(t)
+	ctx := testContext(t)
+
+	t.Run("show", func(t *testing.T) {
+		organizationAccounts, err := client.OrganizationAccounts.Show(ctx, sdk.NewShowOrganizationAccountRequest())
+		require.NoError(t, err)
+		require.Len(t, organizationAccounts, 1)
+
+		organizationAccount := organizationAccounts[0]
+		assert.NotEmpty(t, organizationAccount.OrganizationName)
+		assert.NotEmpty(t, organizationAccount.AccountName)
+		assert.NotEmpty(t, organizationAccount.SnowflakeRegion)
+		assert.NotEmpty(t, organizationAccount.AccountLocator)
+		assert.NotEmpty(t, organizationAccount.CreatedOn)
+		assert.True(t, organizationAccount.IsOrganizationAccount)
+	})
+
+	t.Run("show by id", func(t *testing.T) {
+		organizationAccounts, err := client.OrganizationAccounts.Show(ctx, sdk.NewShowOrganizationAccountRequest())
+		require.NoError(t, err)
+		require.Len(t, organizationAccounts, 1)
+
+		organizationAccount, err := client.OrganizationAccounts.ShowByID(ctx, organizationAccounts[0].ID())
+		require.NoError(t, err)
+		assert.Equal(t, organizationAccounts[0].AccountName, organizationAccount.AccountName)
+	})
+
+	t.Run("rename with save old url and drop old url", func(t *testing.T) {
+		organizationAccounts, err := client.OrganizationAccounts.Show(ctx, sdk.NewShowOrganizationAccountRequest())
+		require.NoError(t, err)
+		require.Len(t, organizationAccounts, 1)
+
+		id := organizationAccounts[0].ID()
+		newId := testClientHelper().Ids.RandomAccountObjectIdentifier()
+
+		err = client.OrganizationAccounts.Alter(ctx, sdk.NewAlterOrganizationAccountRequest(id).WithRenameTo(*sdk.NewOrganizationAccountRenameRequest(newId).WithSaveOldUrl(true)))
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			err := client.OrganizationAccounts.Alter(ctx, sdk.NewAlterOrganizationAccountRequest(newId).WithRenameTo(*sdk.NewOrganizationAccountRenameRequest(id).WithSaveOldUrl(false)))
+			require.NoError(t, err)
+		})
+
+		renamed, err := client.OrganizationAccounts.ShowByID(ctx, newId)
+		require.NoError(t, err)
+		assert.NotNil(t, renamed.OldAccountUrl)
+
+		err = client.OrganizationAccounts.Alter(ctx, sdk.NewAlterOrganizationAccountRequest(newId).WithDropOldUrl(true))
+		require.NoError(t, err)
+	})
+}
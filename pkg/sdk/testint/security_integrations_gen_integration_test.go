@@ -24,6 +24,8 @@ func TestInt_SecurityIntegrations(t *testing.T) {
 	revertParameter := testClientHelper().Parameter.UpdateAccountParameterTemporarily(t, sdk.AccountParameterEnableIdentifierFirstLogin, "true")
 	t.Cleanup(revertParameter)
 
+	awsRoleArn := "arn:aws:iam::123456789012:role/terraform-provider-snowflake"
+
 	cleanupSecurityIntegration := func(t *testing.T, id sdk.AccountObjectIdentifier) {
 		t.Helper()
 		t.Cleanup(func() {
@@ -76,6 +78,21 @@ func TestInt_SecurityIntegrations(t *testing.T) {
 
 		return integration, id
 	}
+	createApiAuthAwsIam := func(t *testing.T, with func(*sdk.CreateApiAuthenticationWithAwsIamSecurityIntegrationRequest)) (*sdk.SecurityIntegration, sdk.AccountObjectIdentifier) {
+		t.Helper()
+		id := testClientHelper().Ids.RandomAccountObjectIdentifier()
+		req := sdk.NewCreateApiAuthenticationWithAwsIamSecurityIntegrationRequest(id, false, awsRoleArn)
+		if with != nil {
+			with(req)
+		}
+		err := client.SecurityIntegrations.CreateApiAuthenticationWithAwsIam(ctx, req)
+		require.NoError(t, err)
+		cleanupSecurityIntegration(t, id)
+		integration, err := client.SecurityIntegrations.ShowByID(ctx, id)
+		require.NoError(t, err)
+
+		return integration, id
+	}
 	createExternalOauth := func(t *testing.T, with func(*sdk.CreateExternalOauthSecurityIntegrationRequest)) (*sdk.SecurityIntegration, sdk.AccountObjectIdentifier, string) {
 		t.Helper()
 		id := testClientHelper().Ids.RandomAccountObjectIdentifier()
@@ -284,6 +301,18 @@ func TestInt_SecurityIntegrations(t *testing.T) {
 		assert.Contains(t, details, sdk.SecurityIntegrationProperty{Name: "COMMENT", Type: "String", Value: comment, Default: ""})
 	}
 
+	assertApiAuthAwsIamDescribe := func(details []sdk.SecurityIntegrationProperty, enabled, roleArn, comment string) {
+		assert.Contains(t, details, sdk.SecurityIntegrationProperty{Name: "ENABLED", Type: "Boolean", Value: enabled, Default: "false"})
+		assert.Contains(t, details, sdk.SecurityIntegrationProperty{Name: "AUTH_TYPE", Type: "String", Value: "AWS_IAM", Default: ""})
+		assert.Contains(t, details, sdk.SecurityIntegrationProperty{Name: "AWS_ROLE_ARN", Type: "String", Value: roleArn, Default: ""})
+		assert.Contains(t, details, sdk.SecurityIntegrationProperty{Name: "COMMENT", Type: "String", Value: comment, Default: ""})
+		for _, name := range []string{"API_AWS_IAM_USER_ARN", "API_AWS_EXTERNAL_ID"} {
+			property, err := collections.FindFirst(details, func(d sdk.SecurityIntegrationProperty) bool { return d.Name == name })
+			require.NoError(t, err)
+			assert.NotEmpty(t, property.Value)
+		}
+	}
+
 	type saml2Details struct {
 		provider                  string
 		enableSPInitiated         string
@@ -412,6 +441,18 @@ func TestInt_SecurityIntegrations(t *testing.T) {
 		assertSecurityIntegration(t, integration, id, "API_AUTHENTICATION", false, "a")
 	})
 
+	t.Run("CreateApiAuthenticationWithAwsIam", func(t *testing.T) {
+		integration, id := createApiAuthAwsIam(t, func(r *sdk.CreateApiAuthenticationWithAwsIamSecurityIntegrationRequest) {
+			r.WithComment("a")
+		})
+		details, err := client.SecurityIntegrations.Describe(ctx, id)
+		require.NoError(t, err)
+
+		assertApiAuthAwsIamDescribe(details, "false", awsRoleArn, "a")
+
+		assertSecurityIntegration(t, integration, id, "API_AUTHENTICATION", false, "a")
+	})
+
 	t.Run("CreateExternalOauth with allowed list and jws keys url", func(t *testing.T) {
 		role1, role1Cleanup := testClientHelper().Role.CreateRole(t)
 		t.Cleanup(role1Cleanup)
@@ -603,6 +644,23 @@ func TestInt_SecurityIntegrations(t *testing.T) {
 		assertSecurityIntegration(t, si, id, "SCIM - GENERIC", true, "a")
 	})
 
+	t.Run("CreateScim with custom run as role for generic client", func(t *testing.T) {
+		role, roleCleanup := testClientHelper().Role.CreateRole(t)
+		t.Cleanup(roleCleanup)
+		testClientHelper().Role.GrantRoleToCurrentRole(t, role.ID())
+
+		runAsRole, err := sdk.ToScimSecurityIntegrationRunAsRoleOptionForClient(sdk.ScimSecurityIntegrationScimClientGeneric, role.ID().Name())
+		require.NoError(t, err)
+
+		_, id := createSCIMIntegration(t, func(r *sdk.CreateScimSecurityIntegrationRequest) {
+			r.RunAsRole = runAsRole
+		})
+		details, err := client.SecurityIntegrations.Describe(ctx, id)
+		require.NoError(t, err)
+
+		assertSCIMDescribe(details, "false", "", role.ID().Name(), "false", "")
+	})
+
 	t.Run("AlterApiAuthenticationWithClientCredentialsFlow", func(t *testing.T) {
 		_, id := createApiAuthClientCred(t, nil)
 		setRequest := sdk.NewAlterApiAuthenticationWithClientCredentialsFlowSecurityIntegrationRequest(id).
@@ -759,6 +817,40 @@ func TestInt_SecurityIntegrations(t *testing.T) {
 		assert.Contains(t, details, sdk.SecurityIntegrationProperty{Name: "COMMENT", Type: "String", Value: "", Default: ""})
 	})
 
+	t.Run("AlterApiAuthenticationWithAwsIam", func(t *testing.T) {
+		_, id := createApiAuthAwsIam(t, nil)
+		otherAwsRoleArn := "arn:aws:iam::123456789012:role/terraform-provider-snowflake-other"
+		setRequest := sdk.NewAlterApiAuthenticationWithAwsIamSecurityIntegrationRequest(id).
+			WithSet(
+				*sdk.NewApiAuthenticationWithAwsIamIntegrationSetRequest().
+					WithComment("a").
+					WithEnabled(true).
+					WithAwsRoleArn(otherAwsRoleArn),
+			)
+		err := client.SecurityIntegrations.AlterApiAuthenticationWithAwsIam(ctx, setRequest)
+		require.NoError(t, err)
+
+		details, err := client.SecurityIntegrations.Describe(ctx, id)
+		require.NoError(t, err)
+
+		assertApiAuthAwsIamDescribe(details, "true", otherAwsRoleArn, "a")
+
+		unsetRequest := sdk.NewAlterApiAuthenticationWithAwsIamSecurityIntegrationRequest(id).
+			WithUnset(
+				*sdk.NewApiAuthenticationWithAwsIamIntegrationUnsetRequest().
+					WithEnabled(true).
+					WithComment(true),
+			)
+		err = client.SecurityIntegrations.AlterApiAuthenticationWithAwsIam(ctx, unsetRequest)
+		require.NoError(t, err)
+
+		details, err = client.SecurityIntegrations.Describe(ctx, id)
+		require.NoError(t, err)
+
+		assert.Contains(t, details, sdk.SecurityIntegrationProperty{Name: "ENABLED", Type: "Boolean", Value: "false", Default: "false"})
+		assert.Contains(t, details, sdk.SecurityIntegrationProperty{Name: "COMMENT", Type: "String", Value: "", Default: ""})
+	})
+
 	t.Run("AlterExternalOauth with other options", func(t *testing.T) {
 		_, id, _ := createExternalOauth(t, func(r *sdk.CreateExternalOauthSecurityIntegrationRequest) {
 			r.WithExternalOauthRsaPublicKey(rsaKey).
@@ -3,11 +3,13 @@ package testint
 import (
 	"errors"
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/ids"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/testenvs"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/testhelpers"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -519,6 +521,52 @@ func TestInt_Stages(t *testing.T) {
 		assert.True(t, stage.DirectoryEnabled)
 		assert.Equal(t, "ROLE", *stage.OwnerRoleType)
 	})
+
+	t.Run("List", func(t *testing.T) {
+		stage, stageCleanup := testClientHelper().Stage.CreateStage(t)
+		t.Cleanup(stageCleanup)
+
+		testClientHelper().Stage.PutOnStageWithContent(t, stage.ID(), "data.csv", "1,2,3")
+		testClientHelper().Stage.PutOnStageWithContent(t, stage.ID(), "data.json", `{"a": 1}`)
+
+		files, err := client.Stages.List(ctx, sdk.NewListStageRequest(stage.ID()))
+		require.NoError(t, err)
+		require.Len(t, files, 2)
+
+		files, err = client.Stages.List(ctx, sdk.NewListStageRequest(stage.ID()).WithPattern(".*[.]csv"))
+		require.NoError(t, err)
+		require.Len(t, files, 1)
+		assert.Equal(t, strings.ToLower(stage.Name)+"/data.csv", files[0].Name)
+		assert.Equal(t, int64(5), files[0].Size)
+		assert.NotEmpty(t, files[0].Md5)
+		assert.NotEmpty(t, files[0].LastModified)
+
+		files, err = client.Stages.List(ctx, sdk.NewListStageRequest(stage.ID()).WithPath("non_existing"))
+		require.NoError(t, err)
+		assert.Empty(t, files)
+	})
+
+	t.Run("Put and Remove", func(t *testing.T) {
+		stage, stageCleanup := testClientHelper().Stage.CreateStage(t)
+		t.Cleanup(stageCleanup)
+
+		filePath := testhelpers.TestFile(t, "data.csv", []byte("1,2,3"))
+
+		err := client.Stages.Put(ctx, sdk.NewPutStageRequest(filePath, stage.ID()).WithPath("data/").WithAutoCompress(false).WithOverwrite(true))
+		require.NoError(t, err)
+
+		files, err := client.Stages.List(ctx, sdk.NewListStageRequest(stage.ID()).WithPath("data/"))
+		require.NoError(t, err)
+		require.Len(t, files, 1)
+		assert.Equal(t, strings.ToLower(stage.Name)+"/data/data.csv", files[0].Name)
+
+		err = client.Stages.Remove(ctx, sdk.NewRemoveStageRequest(stage.ID()).WithPath("data/data.csv"))
+		require.NoError(t, err)
+
+		files, err = client.Stages.List(ctx, sdk.NewListStageRequest(stage.ID()))
+		require.NoError(t, err)
+		assert.Empty(t, files)
+	})
 }
 
 func TestInt_StagesShowByID(t *testing.T) {
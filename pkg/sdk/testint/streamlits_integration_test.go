@@ -28,7 +28,7 @@ func TestInt_Streamlits(t *testing.T) {
 		t.Helper()
 
 		id := testClientHelper().Ids.RandomSchemaObjectIdentifier()
-		request := sdk.NewCreateStreamlitRequest(id, stage.Location(), mainFile)
+		request := sdk.NewCreateStreamlitRequest(id, mainFile).WithRootLocation(stage.Location())
 		if opts != nil {
 			opts(request)
 		}
@@ -59,6 +59,49 @@ func TestInt_Streamlits(t *testing.T) {
 		require.Equal(t, "ROLE", e.OwnerRoleType)
 	}
 
+	t.Run("create streamlit from source location and commit a new version", func(t *testing.T) {
+		stage, cleanupStage := testClientHelper().Stage.CreateStage(t)
+		t.Cleanup(cleanupStage)
+
+		id := testClientHelper().Ids.RandomSchemaObjectIdentifier()
+		request := sdk.NewCreateStreamlitRequest(id, "streamlit_app.py").
+			WithFrom(stage.Location()).
+			WithImports([]sdk.StreamlitImportRequest{*sdk.NewStreamlitImportRequest().WithImport(stage.Location() + "/libs/lib.zip")})
+		err := client.Streamlits.Create(ctx, request)
+		require.NoError(t, err)
+		t.Cleanup(cleanupStreamlitHandle(id))
+
+		streamlit, err := client.Streamlits.ShowByID(ctx, id)
+		require.NoError(t, err)
+
+		details, err := client.Streamlits.Describe(ctx, id)
+		require.NoError(t, err)
+		require.Empty(t, details.RootLocation)
+		require.Equal(t, "streamlit_app.py", details.MainFile)
+		require.Empty(t, details.LiveVersionLocationUri)
+
+		err = client.Streamlits.Alter(ctx, sdk.NewAlterStreamlitRequest(id).WithAddLiveVersionFromLast(true))
+		require.NoError(t, err)
+
+		details, err = client.Streamlits.Describe(ctx, id)
+		require.NoError(t, err)
+		require.NotEmpty(t, details.LiveVersionLocationUri)
+
+		err = client.Stages.CopyFiles(ctx, sdk.NewCopyFilesStageRequest(details.LiveVersionLocationUri, stage.Location()))
+		require.NoError(t, err)
+
+		err = client.Streamlits.Alter(ctx, sdk.NewAlterStreamlitRequest(id).WithCommit(true))
+		require.NoError(t, err)
+
+		details, err = client.Streamlits.Describe(ctx, id)
+		require.NoError(t, err)
+		require.NotEmpty(t, details.LastVersionName)
+
+		streamlitAfterCommit, err := client.Streamlits.ShowByID(ctx, id)
+		require.NoError(t, err)
+		require.Equal(t, streamlit.UrlId, streamlitAfterCommit.UrlId)
+	})
+
 	t.Run("create streamlit", func(t *testing.T) {
 		stage, cleanupStage := testClientHelper().Stage.CreateStage(t)
 		t.Cleanup(cleanupStage)
@@ -66,7 +109,7 @@ func TestInt_Streamlits(t *testing.T) {
 		comment := random.Comment()
 		id := testClientHelper().Ids.RandomSchemaObjectIdentifier()
 		mainFile := "manifest.yml"
-		request := sdk.NewCreateStreamlitRequest(id, stage.Location(), mainFile).WithComment(comment)
+		request := sdk.NewCreateStreamlitRequest(id, mainFile).WithRootLocation(stage.Location()).WithComment(comment)
 		err := client.Streamlits.Create(ctx, request)
 		require.NoError(t, err)
 		t.Cleanup(cleanupStreamlitHandle(id))
@@ -84,7 +127,7 @@ func TestInt_Streamlits(t *testing.T) {
 
 		id := testClientHelper().Ids.RandomSchemaObjectIdentifier()
 		mainFile := "manifest.yml"
-		request := sdk.NewCreateStreamlitRequest(id, stage.Location(), mainFile).WithQueryWarehouse(warehouse.ID())
+		request := sdk.NewCreateStreamlitRequest(id, mainFile).WithRootLocation(stage.Location()).WithQueryWarehouse(warehouse.ID())
 		err := client.Streamlits.Create(ctx, request)
 		require.ErrorContains(t, err, fmt.Sprintf("The specified warehouse %s does not exist", strings.ToUpper(warehouse.ID().Name())))
 	})
@@ -99,7 +142,7 @@ func TestInt_Streamlits(t *testing.T) {
 		comment := random.Comment()
 		id := testClientHelper().Ids.RandomSchemaObjectIdentifier()
 		mainFile := "manifest.yml"
-		request := sdk.NewCreateStreamlitRequest(id, stage.Location(), mainFile).WithComment(comment)
+		request := sdk.NewCreateStreamlitRequest(id, mainFile).WithRootLocation(stage.Location()).WithComment(comment)
 		err := client.Streamlits.Create(ctx, request)
 		require.NoError(t, err)
 		t.Cleanup(cleanupStreamlitHandle(id))
@@ -165,7 +208,7 @@ func TestInt_Streamlits(t *testing.T) {
 		comment := random.Comment()
 		id := testClientHelper().Ids.RandomSchemaObjectIdentifier()
 		mainFile := "manifest.yml"
-		request := sdk.NewCreateStreamlitRequest(id, stage.Location(), mainFile).WithComment(comment)
+		request := sdk.NewCreateStreamlitRequest(id, mainFile).WithRootLocation(stage.Location()).WithComment(comment)
 		err := client.Streamlits.Create(ctx, request)
 		require.NoError(t, err)
 		t.Cleanup(cleanupStreamlitHandle(id))
@@ -350,7 +393,7 @@ func TestInt_StreamlitsShowByID(t *testing.T) {
 	createStreamlitHandle := func(t *testing.T, id sdk.SchemaObjectIdentifier, stage *sdk.Stage, mainFile string) {
 		t.Helper()
 
-		request := sdk.NewCreateStreamlitRequest(id, stage.Location(), mainFile)
+		request := sdk.NewCreateStreamlitRequest(id, mainFile).WithRootLocation(stage.Location())
 		err := client.Streamlits.Create(ctx, request)
 		require.NoError(t, err)
 		t.Cleanup(cleanupStreamlitHandle(t, id))
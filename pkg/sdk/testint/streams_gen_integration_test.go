@@ -182,6 +182,55 @@ func TestInt_Streams(t *testing.T) {
 		)
 	})
 
+	t.Run("CreateOnDynamicTable", func(t *testing.T) {
+		table, cleanupTable := testClientHelper().Table.CreateWithChangeTracking(t)
+		t.Cleanup(cleanupTable)
+
+		dynamicTable, cleanupDynamicTable := testClientHelper().DynamicTable.CreateDynamicTable(t, table.ID())
+		t.Cleanup(cleanupDynamicTable)
+
+		id := testClientHelper().Ids.RandomSchemaObjectIdentifier()
+		req := sdk.NewCreateOnDynamicTableStreamRequest(id, dynamicTable.ID()).
+			WithShowInitialRows(true).
+			WithComment("some comment")
+		err := client.Streams.CreateOnDynamicTable(ctx, req)
+		require.NoError(t, err)
+		t.Cleanup(testClientHelper().Stream.DropFunc(t, id))
+
+		assertThatObject(t, objectassert.Stream(t, id).
+			HasName(id.Name()).
+			HasDatabaseName(id.DatabaseName()).
+			HasSchemaName(id.SchemaName()).
+			HasComment("some comment").
+			HasSourceType(sdk.StreamSourceTypeDynamicTable).
+			HasMode(sdk.StreamModeDefault).
+			HasTableId(dynamicTable.ID()),
+		)
+	})
+
+	t.Run("CreateOnEventTable", func(t *testing.T) {
+		eventTable, cleanupEventTable := testClientHelper().EventTable.Create(t)
+		t.Cleanup(cleanupEventTable)
+
+		id := testClientHelper().Ids.RandomSchemaObjectIdentifier()
+		req := sdk.NewCreateOnEventTableStreamRequest(id, eventTable.ID()).
+			WithAppendOnly(true).
+			WithComment("some comment")
+		err := client.Streams.CreateOnEventTable(ctx, req)
+		require.NoError(t, err)
+		t.Cleanup(testClientHelper().Stream.DropFunc(t, id))
+
+		assertThatObject(t, objectassert.Stream(t, id).
+			HasName(id.Name()).
+			HasDatabaseName(id.DatabaseName()).
+			HasSchemaName(id.SchemaName()).
+			HasComment("some comment").
+			HasSourceType(sdk.StreamSourceTypeEventTable).
+			HasMode(sdk.StreamModeAppendOnly).
+			HasTableId(eventTable.ID()),
+		)
+	})
+
 	t.Run("Clone", func(t *testing.T) {
 		table, cleanupTable := testClientHelper().Table.CreateInSchema(t, schemaId)
 		t.Cleanup(cleanupTable)
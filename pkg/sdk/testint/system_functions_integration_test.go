@@ -97,6 +97,36 @@ func TestInt_PipeStatus(t *testing.T) {
 	require.Equal(t, sdk.RunningPipeExecutionState, pipeExecutionState)
 }
 
+func TestInt_PipeStatusDetails(t *testing.T) {
+	client := testClient(t)
+	ctx := testContext(t)
+
+	schema, schemaCleanup := testClientHelper().Schema.CreateSchema(t)
+	t.Cleanup(schemaCleanup)
+
+	table, tableCleanup := testClientHelper().Table.CreateInSchema(t, schema.ID())
+	t.Cleanup(tableCleanup)
+
+	stage, stageCleanup := testClientHelper().Stage.CreateStageInSchema(t, schema.ID())
+	t.Cleanup(stageCleanup)
+
+	copyStatement := createPipeCopyStatement(t, table, stage)
+	pipe, pipeCleanup := testClientHelper().Pipe.CreatePipe(t, copyStatement)
+	t.Cleanup(pipeCleanup)
+
+	pipeStatus, err := client.SystemFunctions.PipeStatusDetails(ctx, pipe.ID())
+	require.NoError(t, err)
+	assert.Equal(t, sdk.RunningPipeExecutionState, pipeStatus.ExecutionState)
+	assert.Equal(t, 0, pipeStatus.PendingFileCount)
+	assert.Nil(t, pipeStatus.LastIngestedTimestamp)
+	assert.Nil(t, pipeStatus.Error)
+
+	t.Run("non-existing pipe", func(t *testing.T) {
+		_, err := client.SystemFunctions.PipeStatusDetails(ctx, testClientHelper().Ids.RandomSchemaObjectIdentifier())
+		require.Error(t, err)
+	})
+}
+
 func TestInt_PipeForceResume(t *testing.T) {
 	client := testClient(t)
 
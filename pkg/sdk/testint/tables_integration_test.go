@@ -199,6 +199,22 @@ func TestInt_Table(t *testing.T) {
 		assertColumns(t, expectedColumns, tableColumns)
 	})
 
+	t.Run("create table as select: without columns", func(t *testing.T) {
+		id := testClientHelper().Ids.RandomSchemaObjectIdentifier()
+		request := sdk.NewCreateTableAsSelectRequest(id, nil, `SELECT 'a' AS "COLUMN_1", 'b' AS "COLUMN_2"`)
+
+		err := client.Tables.CreateAsSelect(ctx, request)
+		require.NoError(t, err)
+		t.Cleanup(cleanupTableProvider(id))
+
+		tableColumns := testClientHelper().Table.GetTableColumnsFor(t, id)
+		expectedColumns := []expectedColumn{
+			{"COLUMN_1", sdk.DataTypeVARCHAR},
+			{"COLUMN_2", sdk.DataTypeVARCHAR},
+		}
+		assertColumns(t, expectedColumns, tableColumns)
+	})
+
 	// TODO [SNOW-1007542]: fix this test, it should create two integer column but is creating 3 text ones instead
 	t.Run("create table using template", func(t *testing.T) {
 		fileFormat, fileFormatCleanup := testClientHelper().FileFormat.CreateFileFormat(t)
@@ -690,6 +706,37 @@ func TestInt_Table(t *testing.T) {
 		require.NoError(t, err)
 	})
 
+	t.Run("show primary keys", func(t *testing.T) {
+		id := testClientHelper().Ids.RandomSchemaObjectIdentifier()
+		columns := []sdk.TableColumnRequest{
+			*sdk.NewTableColumnRequest("COLUMN_1", sdk.DataTypeVARCHAR),
+			*sdk.NewTableColumnRequest("COLUMN_2", sdk.DataTypeVARCHAR),
+		}
+		outOfLineConstraint := sdk.NewOutOfLineConstraintRequest(sdk.ColumnConstraintTypePrimaryKey).WithName(sdk.String("PK_NAME")).WithColumns([]string{"COLUMN_2", "COLUMN_1"})
+
+		err := client.Tables.Create(ctx, sdk.NewCreateTableRequest(id, columns).WithOutOfLineConstraint(*outOfLineConstraint))
+		require.NoError(t, err)
+		t.Cleanup(cleanupTableProvider(id))
+
+		primaryKeyColumns, err := client.Tables.ShowPrimaryKeys(ctx, sdk.NewShowPrimaryKeysRequest(id))
+		require.NoError(t, err)
+		require.Len(t, primaryKeyColumns, 2)
+		for _, column := range primaryKeyColumns {
+			assert.Equal(t, id.Name(), column.TableName)
+			assert.Equal(t, "PK_NAME", column.ConstraintName)
+		}
+		assert.ElementsMatch(t, []string{"COLUMN_1", "COLUMN_2"}, []string{primaryKeyColumns[0].ColumnName, primaryKeyColumns[1].ColumnName})
+	})
+
+	t.Run("show primary keys: no primary key", func(t *testing.T) {
+		table, tableCleanup := testClientHelper().Table.Create(t)
+		t.Cleanup(tableCleanup)
+
+		primaryKeyColumns, err := client.Tables.ShowPrimaryKeys(ctx, sdk.NewShowPrimaryKeysRequest(table.ID()))
+		require.NoError(t, err)
+		assert.Empty(t, primaryKeyColumns)
+	})
+
 	t.Run("external table: add column", func(t *testing.T) {
 		id := testClientHelper().Ids.RandomSchemaObjectIdentifier()
 		columns := []sdk.TableColumnRequest{
@@ -777,7 +824,6 @@ func TestInt_Table(t *testing.T) {
 		assertColumns(t, expectedColumns, currentColumns)
 	})
 
-	// TODO [SNOW-1007542]: check search optimization - after adding https://docs.snowflake.com/en/sql-reference/sql/desc-search-optimization
 	t.Run("add search optimization", func(t *testing.T) {
 		id := testClientHelper().Ids.RandomSchemaObjectIdentifier()
 		columns := []sdk.TableColumnRequest{
@@ -794,6 +840,43 @@ func TestInt_Table(t *testing.T) {
 
 		err = client.Tables.Alter(ctx, alterRequest)
 		require.NoError(t, err)
+
+		details, err := client.Tables.DescribeSearchOptimization(ctx, sdk.NewDescribeSearchOptimizationRequest(id))
+		require.NoError(t, err)
+		require.Len(t, details, 2)
+		for _, detail := range details {
+			assert.Equal(t, sdk.SearchOptimizationMethodSubstring, detail.Method)
+		}
+	})
+
+	t.Run("add and drop search optimization for the whole table", func(t *testing.T) {
+		id := testClientHelper().Ids.RandomSchemaObjectIdentifier()
+		columns := []sdk.TableColumnRequest{
+			*sdk.NewTableColumnRequest("COLUMN_1", sdk.DataTypeVARCHAR),
+			*sdk.NewTableColumnRequest("COLUMN_2", sdk.DataTypeNumber),
+		}
+
+		err := client.Tables.Create(ctx, sdk.NewCreateTableRequest(id, columns))
+		require.NoError(t, err)
+		t.Cleanup(cleanupTableProvider(id))
+
+		err = client.Tables.Alter(ctx, sdk.NewAlterTableRequest(id).WithSearchOptimizationAction(sdk.NewTableSearchOptimizationActionRequest().WithAddSearchOptimization(sdk.Bool(true))))
+		require.NoError(t, err)
+
+		table, err := client.Tables.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.True(t, table.SearchOptimization)
+
+		details, err := client.Tables.DescribeSearchOptimization(ctx, sdk.NewDescribeSearchOptimizationRequest(id))
+		require.NoError(t, err)
+		assert.Len(t, details, 2)
+
+		err = client.Tables.Alter(ctx, sdk.NewAlterTableRequest(id).WithSearchOptimizationAction(sdk.NewTableSearchOptimizationActionRequest().WithDropSearchOptimization(sdk.Bool(true))))
+		require.NoError(t, err)
+
+		table, err = client.Tables.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.False(t, table.SearchOptimization)
 	})
 
 	// TODO [SNOW-1007542]: try to check more sets (ddl collation, max data extension time in days, etc.)
@@ -898,6 +898,32 @@ func TestInt_Tasks(t *testing.T) {
 		require.NoError(t, err)
 	})
 
+	t.Run("task history: executed task", func(t *testing.T) {
+		task, taskCleanup := testClientHelper().Task.Create(t)
+		t.Cleanup(taskCleanup)
+
+		history, err := client.Tasks.History(ctx, sdk.NewTaskHistoryRequest(task.ID()))
+		require.NoError(t, err)
+		require.Empty(t, history)
+
+		err = client.Tasks.Execute(ctx, sdk.NewExecuteTaskRequest(task.ID()))
+		require.NoError(t, err)
+
+		require.Eventually(t, func() bool {
+			history, err = client.Tasks.History(ctx, sdk.NewTaskHistoryRequest(task.ID()).WithResultLimit(10))
+			return err == nil && len(history) == 1 && history[0].State.IsFinished()
+		}, time.Minute, time.Second*5)
+
+		taskRun := history[0]
+		assert.Equal(t, task.ID().FullyQualifiedName(), taskRun.TaskId().FullyQualifiedName())
+		assert.Equal(t, sdk.TaskRunStateSucceeded, taskRun.State)
+		assert.Equal(t, sdk.TaskRunScheduledFromExecuteTask, taskRun.ScheduledFrom)
+		assert.Equal(t, 1, taskRun.AttemptNumber)
+		assert.NotNil(t, taskRun.QueryId)
+		assert.NotNil(t, taskRun.CompletedTime)
+		assert.Nil(t, taskRun.ErrorMessage)
+	})
+
 	t.Run("execute task: retry last after successful last task", func(t *testing.T) {
 		task, taskCleanup := testClientHelper().Task.Create(t)
 		t.Cleanup(taskCleanup)
@@ -0,0 +1,160 @@
+package testint
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/helpers/random"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt_UserProgrammaticAccessTokens(t *testing.T) {
+	client := testClient(t)
+	ctx := testContext(t)
+
+	user, userCleanup := testClientHelper().User.CreateUser(t)
+	t.Cleanup(userCleanup)
+
+	role, roleCleanup := testClientHelper().Role.CreateRole(t)
+	t.Cleanup(roleCleanup)
+	testClientHelper().Role.GrantRoleToUser(t, role.ID(), user.ID())
+
+	assertToken := func(t *testing.T, token *sdk.ProgrammaticAccessToken, id sdk.AccountObjectIdentifier) {
+		t.Helper()
+		assert.Equal(t, id.Name(), token.Name)
+		assert.Equal(t, user.ID().Name(), token.UserName)
+		assert.Equal(t, sdk.ProgrammaticAccessTokenStatusActive, token.Status)
+		assert.NotEmpty(t, token.CreatedOn)
+		assert.NotEmpty(t, token.CreatedBy)
+		assert.True(t, token.ExpiresAt.After(time.Now()))
+		assert.Nil(t, token.RotatedTo)
+	}
+
+	t.Run("add: basic", func(t *testing.T) {
+		id := testClientHelper().Ids.RandomAccountObjectIdentifier()
+
+		result, err := client.UserProgrammaticAccessTokens.Add(ctx, sdk.NewAddProgrammaticAccessTokenRequest(user.ID(), id))
+		require.NoError(t, err)
+		t.Cleanup(testClientHelper().UserProgrammaticAccessToken.RemoveFunc(t, user.ID(), id))
+
+		assert.Equal(t, id.Name(), result.TokenName)
+		assert.NotEmpty(t, result.TokenSecret)
+
+		token, err := client.UserProgrammaticAccessTokens.ShowByID(ctx, user.ID(), id)
+		require.NoError(t, err)
+		assertToken(t, token, id)
+		assert.Nil(t, token.RoleRestriction)
+		assert.Nil(t, token.Comment)
+	})
+
+	t.Run("add: complete", func(t *testing.T) {
+		id := testClientHelper().Ids.RandomAccountObjectIdentifier()
+		comment := random.Comment()
+
+		request := sdk.NewAddProgrammaticAccessTokenRequest(user.ID(), id).
+			WithRoleRestriction(role.ID().Name()).
+			WithDaysToExpiry(30).
+			WithMinsToBypassNetworkPolicyRequirement(10).
+			WithComment(comment)
+		result, err := client.UserProgrammaticAccessTokens.Add(ctx, request)
+		require.NoError(t, err)
+		t.Cleanup(testClientHelper().UserProgrammaticAccessToken.RemoveFunc(t, user.ID(), id))
+
+		assert.Equal(t, id.Name(), result.TokenName)
+		assert.NotEmpty(t, result.TokenSecret)
+
+		token, err := client.UserProgrammaticAccessTokens.ShowByID(ctx, user.ID(), id)
+		require.NoError(t, err)
+		assertToken(t, token, id)
+		require.NotNil(t, token.RoleRestriction)
+		assert.Equal(t, role.ID().Name(), token.RoleRestriction.Name())
+		assert.Equal(t, sdk.Pointer(comment), token.Comment)
+		assert.True(t, token.ExpiresAt.Before(time.Now().Add(31*24*time.Hour)))
+		assert.NotNil(t, token.MinsToBypassNetworkPolicyRequirement)
+	})
+
+	t.Run("modify: set, unset and rename", func(t *testing.T) {
+		result, cleanup := testClientHelper().UserProgrammaticAccessToken.Add(t, user.ID())
+		t.Cleanup(cleanup)
+		id := sdk.NewAccountObjectIdentifier(result.TokenName)
+		comment := random.Comment()
+
+		err := client.UserProgrammaticAccessTokens.Modify(ctx, sdk.NewModifyProgrammaticAccessTokenRequest(user.ID(), id).
+			WithSet(*sdk.NewModifyProgrammaticAccessTokenSetRequest().WithDisabled(true).WithComment(comment)))
+		require.NoError(t, err)
+
+		token, err := client.UserProgrammaticAccessTokens.ShowByID(ctx, user.ID(), id)
+		require.NoError(t, err)
+		assert.Equal(t, sdk.ProgrammaticAccessTokenStatusDisabled, token.Status)
+		assert.Equal(t, sdk.Pointer(comment), token.Comment)
+
+		err = client.UserProgrammaticAccessTokens.Modify(ctx, sdk.NewModifyProgrammaticAccessTokenRequest(user.ID(), id).
+			WithUnset(*sdk.NewModifyProgrammaticAccessTokenUnsetRequest().WithDisabled(true).WithComment(true)))
+		require.NoError(t, err)
+
+		token, err = client.UserProgrammaticAccessTokens.ShowByID(ctx, user.ID(), id)
+		require.NoError(t, err)
+		assert.Equal(t, sdk.ProgrammaticAccessTokenStatusActive, token.Status)
+		assert.Nil(t, token.Comment)
+
+		newId := testClientHelper().Ids.RandomAccountObjectIdentifier()
+		err = client.UserProgrammaticAccessTokens.Modify(ctx, sdk.NewModifyProgrammaticAccessTokenRequest(user.ID(), id).WithRenameTo(newId))
+		require.NoError(t, err)
+		t.Cleanup(testClientHelper().UserProgrammaticAccessToken.RemoveFunc(t, user.ID(), newId))
+
+		_, err = client.UserProgrammaticAccessTokens.ShowByID(ctx, user.ID(), id)
+		require.ErrorIs(t, err, collections.ErrObjectNotFound)
+
+		token, err = client.UserProgrammaticAccessTokens.ShowByID(ctx, user.ID(), newId)
+		require.NoError(t, err)
+		assertToken(t, token, newId)
+	})
+
+	t.Run("rotate", func(t *testing.T) {
+		added, cleanup := testClientHelper().UserProgrammaticAccessToken.Add(t, user.ID())
+		t.Cleanup(cleanup)
+		id := sdk.NewAccountObjectIdentifier(added.TokenName)
+
+		rotated, err := client.UserProgrammaticAccessTokens.Rotate(ctx, sdk.NewRotateProgrammaticAccessTokenRequest(user.ID(), id).WithExpireRotatedTokenAfterHours(1))
+		require.NoError(t, err)
+		t.Cleanup(testClientHelper().UserProgrammaticAccessToken.RemoveFunc(t, user.ID(), sdk.NewAccountObjectIdentifier(rotated.RotatedTokenName)))
+
+		assert.Equal(t, id.Name(), rotated.TokenName)
+		assert.NotEmpty(t, rotated.TokenSecret)
+		assert.NotEqual(t, added.TokenSecret, rotated.TokenSecret)
+		assert.NotEmpty(t, rotated.RotatedTokenName)
+
+		oldToken, err := client.UserProgrammaticAccessTokens.ShowByID(ctx, user.ID(), sdk.NewAccountObjectIdentifier(rotated.RotatedTokenName))
+		require.NoError(t, err)
+		assert.Equal(t, sdk.Pointer(id.Name()), oldToken.RotatedTo)
+	})
+
+	t.Run("remove", func(t *testing.T) {
+		added, cleanup := testClientHelper().UserProgrammaticAccessToken.Add(t, user.ID())
+		t.Cleanup(cleanup)
+		id := sdk.NewAccountObjectIdentifier(added.TokenName)
+
+		err := client.UserProgrammaticAccessTokens.Remove(ctx, sdk.NewRemoveProgrammaticAccessTokenRequest(user.ID(), id))
+		require.NoError(t, err)
+
+		_, err = client.UserProgrammaticAccessTokens.ShowByID(ctx, user.ID(), id)
+		require.ErrorIs(t, err, collections.ErrObjectNotFound)
+	})
+
+	t.Run("show: for user", func(t *testing.T) {
+		first, firstCleanup := testClientHelper().UserProgrammaticAccessToken.Add(t, user.ID())
+		t.Cleanup(firstCleanup)
+		second, secondCleanup := testClientHelper().UserProgrammaticAccessToken.Add(t, user.ID())
+		t.Cleanup(secondCleanup)
+
+		tokens, err := client.UserProgrammaticAccessTokens.Show(ctx, sdk.NewShowProgrammaticAccessTokenRequest().WithUserName(user.ID()))
+		require.NoError(t, err)
+
+		names := collections.Map(tokens, func(token sdk.ProgrammaticAccessToken) string { return token.Name })
+		assert.Contains(t, names, first.TokenName)
+		assert.Contains(t, names, second.TokenName)
+	})
+}
@@ -1185,6 +1185,58 @@ func TestInt_Users(t *testing.T) {
 		)
 	})
 
+	t.Run("create and alter: workload identity - type service", func(t *testing.T) {
+		id := testClientHelper().Ids.RandomAccountObjectIdentifier()
+
+		err := client.Users.Create(ctx, id, &sdk.CreateUserOptions{
+			ObjectProperties: &sdk.UserObjectProperties{
+				Type: sdk.Pointer(sdk.UserTypeService),
+				WorkloadIdentity: &sdk.UserWorkloadIdentity{
+					AwsType: &sdk.UserWorkloadIdentityAws{Arn: "arn:aws:iam::123456789012:role/" + random.AlphaN(10)},
+				},
+			},
+		})
+		require.NoError(t, err)
+		t.Cleanup(testClientHelper().User.DropUserFunc(t, id))
+
+		userDetails, err := client.Users.Describe(ctx, id)
+		require.NoError(t, err)
+		require.NotNil(t, userDetails.HasWorkloadIdentity)
+		assert.True(t, userDetails.HasWorkloadIdentity.Value)
+		require.NotNil(t, userDetails.HasPat)
+		assert.False(t, userDetails.HasPat.Value)
+
+		err = client.Users.Alter(ctx, id, &sdk.AlterUserOptions{
+			Set: &sdk.UserSet{
+				ObjectProperties: &sdk.UserAlterObjectProperties{UserObjectProperties: sdk.UserObjectProperties{
+					WorkloadIdentity: &sdk.UserWorkloadIdentity{
+						OidcType: &sdk.UserWorkloadIdentityOidc{
+							Issuer:           "https://oidc.example.com",
+							Subject:          "system:serviceaccount:default:" + random.AlphaLowerN(10),
+							OidcAudienceList: []sdk.OidcAudienceListItem{{Item: "snowflakecomputing.com"}},
+						},
+					},
+				}},
+			},
+		})
+		require.NoError(t, err)
+
+		userDetails, err = client.Users.Describe(ctx, id)
+		require.NoError(t, err)
+		assert.True(t, userDetails.HasWorkloadIdentity.Value)
+
+		err = client.Users.Alter(ctx, id, &sdk.AlterUserOptions{
+			Unset: &sdk.UserUnset{
+				ObjectProperties: &sdk.UserObjectPropertiesUnset{WorkloadIdentity: sdk.Bool(true)},
+			},
+		})
+		require.NoError(t, err)
+
+		userDetails, err = client.Users.Describe(ctx, id)
+		require.NoError(t, err)
+		assert.False(t, userDetails.HasWorkloadIdentity.Value)
+	})
+
 	incorrectAlterForServiceType := []struct {
 		property           string
 		alterSet           *sdk.UserAlterObjectProperties
@@ -1590,6 +1642,25 @@ func TestInt_Users(t *testing.T) {
 		assert.ErrorIs(t, err, sdk.ErrObjectNotExistOrAuthorized)
 	})
 
+	t.Run("show mfa methods: user without enrolled methods", func(t *testing.T) {
+		user, userCleanup := testClientHelper().User.CreateUser(t)
+		t.Cleanup(userCleanup)
+
+		mfaMethods, err := client.Users.ShowMfaMethods(ctx, user.ID())
+		require.NoError(t, err)
+		assert.Empty(t, mfaMethods)
+	})
+
+	t.Run("remove mfa method: not existing method", func(t *testing.T) {
+		user, userCleanup := testClientHelper().User.CreateUser(t)
+		t.Cleanup(userCleanup)
+
+		err := client.Users.Alter(ctx, user.ID(), &sdk.AlterUserOptions{
+			RemoveMfaMethod: sdk.String("PASSKEY_1"),
+		})
+		require.Error(t, err)
+	})
+
 	t.Run("drop: when user exists", func(t *testing.T) {
 		user, userCleanup := testClientHelper().User.CreateUser(t)
 		t.Cleanup(userCleanup)
@@ -0,0 +1,111 @@
+package sdk
+
+import (
+	"fmt"
+	"strings"
+
+	g "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/poc/generator"
+)
+
+//go:generate go run ./poc/main.go
+
+type ProgrammaticAccessTokenStatus string
+
+const (
+	ProgrammaticAccessTokenStatusActive   ProgrammaticAccessTokenStatus = "ACTIVE"
+	ProgrammaticAccessTokenStatusExpired  ProgrammaticAccessTokenStatus = "EXPIRED"
+	ProgrammaticAccessTokenStatusDisabled ProgrammaticAccessTokenStatus = "DISABLED"
+)
+
+var AllProgrammaticAccessTokenStatuses = []ProgrammaticAccessTokenStatus{
+	ProgrammaticAccessTokenStatusActive,
+	ProgrammaticAccessTokenStatusExpired,
+	ProgrammaticAccessTokenStatusDisabled,
+}
+
+func ToProgrammaticAccessTokenStatus(s string) (ProgrammaticAccessTokenStatus, error) {
+	switch status := ProgrammaticAccessTokenStatus(strings.ToUpper(s)); status {
+	case ProgrammaticAccessTokenStatusActive,
+		ProgrammaticAccessTokenStatusExpired,
+		ProgrammaticAccessTokenStatusDisabled:
+		return status, nil
+	default:
+		return "", fmt.Errorf("invalid programmatic access token status: %s", s)
+	}
+}
+
+var modifyProgrammaticAccessTokenSet = g.NewQueryStruct("ModifyProgrammaticAccessTokenSet").
+	OptionalBooleanAssignment("DISABLED", g.ParameterOptions()).
+	OptionalNumberAssignment("MINS_TO_BYPASS_NETWORK_POLICY_REQUIREMENT", g.ParameterOptions()).
+	OptionalComment().
+	WithValidation(g.AtLeastOneValueSet, "Disabled", "MinsToBypassNetworkPolicyRequirement", "Comment")
+
+var modifyProgrammaticAccessTokenUnset = g.NewQueryStruct("ModifyProgrammaticAccessTokenUnset").
+	OptionalSQL("DISABLED").
+	OptionalSQL("MINS_TO_BYPASS_NETWORK_POLICY_REQUIREMENT").
+	OptionalSQL("COMMENT").
+	WithValidation(g.AtLeastOneValueSet, "Disabled", "MinsToBypassNetworkPolicyRequirement", "Comment")
+
+var UserProgrammaticAccessTokensDef = g.NewInterface(
+	"UserProgrammaticAccessTokens",
+	"ProgrammaticAccessToken",
+	g.KindOfT[AccountObjectIdentifier](),
+).CustomOperation(
+	"Modify",
+	"https://docs.snowflake.com/en/sql-reference/sql/alter-user-modify-programmatic-access-token",
+	g.NewQueryStruct("ModifyUserProgrammaticAccessToken").
+		Alter().
+		SQL("USER").
+		IfExists().
+		Identifier("UserName", g.KindOfT[AccountObjectIdentifier](), g.IdentifierOptions().Required()).
+		SQL("MODIFY PROGRAMMATIC ACCESS TOKEN").
+		Name().
+		OptionalQueryStructField("Set", modifyProgrammaticAccessTokenSet, g.KeywordOptions().SQL("SET")).
+		OptionalQueryStructField("Unset", modifyProgrammaticAccessTokenUnset, g.ListOptions().NoParentheses().SQL("UNSET")).
+		Identifier("RenameTo", g.KindOfTPointer[AccountObjectIdentifier](), g.IdentifierOptions().SQL("RENAME TO")).
+		WithValidation(g.ValidIdentifier, "UserName").
+		WithValidation(g.ValidIdentifier, "name").
+		WithValidation(g.ValidIdentifierIfSet, "RenameTo").
+		WithValidation(g.ExactlyOneValueSet, "Set", "Unset", "RenameTo"),
+).CustomOperation(
+	"Remove",
+	"https://docs.snowflake.com/en/sql-reference/sql/alter-user-remove-programmatic-access-token",
+	g.NewQueryStruct("RemoveUserProgrammaticAccessToken").
+		Alter().
+		SQL("USER").
+		IfExists().
+		Identifier("UserName", g.KindOfT[AccountObjectIdentifier](), g.IdentifierOptions().Required()).
+		SQL("REMOVE PROGRAMMATIC ACCESS TOKEN").
+		Name().
+		WithValidation(g.ValidIdentifier, "UserName").
+		WithValidation(g.ValidIdentifier, "name"),
+).ShowOperation(
+	"https://docs.snowflake.com/en/sql-reference/sql/show-user-programmatic-access-tokens",
+	g.DbStruct("programmaticAccessTokenRow").
+		Field("name", "string").
+		Field("user_name", "string").
+		Field("role_restriction", "sql.NullString").
+		Field("expires_at", "time.Time").
+		Field("status", "string").
+		Field("comment", "sql.NullString").
+		Field("created_on", "time.Time").
+		Field("created_by", "string").
+		Field("mins_to_bypass_network_policy_requirement", "sql.NullInt64").
+		Field("rotated_to", "sql.NullString"),
+	g.PlainStruct("ProgrammaticAccessToken").
+		Field("Name", "string").
+		Field("UserName", "string").
+		Field("RoleRestriction", "*AccountObjectIdentifier").
+		Field("ExpiresAt", "time.Time").
+		Field("Status", "ProgrammaticAccessTokenStatus").
+		Field("Comment", "*string").
+		Field("CreatedOn", "time.Time").
+		Field("CreatedBy", "string").
+		Field("MinsToBypassNetworkPolicyRequirement", "*int").
+		Field("RotatedTo", "*string"),
+	g.NewQueryStruct("ShowUserProgrammaticAccessTokens").
+		Show().
+		SQL("USER PROGRAMMATIC ACCESS TOKENS").
+		Identifier("UserName", g.KindOfTPointer[AccountObjectIdentifier](), g.IdentifierOptions().SQL("FOR USER")).
+		WithValidation(g.ValidIdentifierIfSet, "UserName"),
+)
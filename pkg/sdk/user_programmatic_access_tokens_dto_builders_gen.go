@@ -0,0 +1,97 @@
+// Code generated by dto builder generator; DO NOT EDIT.
+
+package sdk
+
+import ()
+
+func NewModifyProgrammaticAccessTokenRequest(
+	UserName AccountObjectIdentifier,
+	name AccountObjectIdentifier,
+) *ModifyProgrammaticAccessTokenRequest {
+	s := ModifyProgrammaticAccessTokenRequest{}
+	s.UserName = UserName
+	s.name = name
+	return &s
+}
+
+func (s *ModifyProgrammaticAccessTokenRequest) WithIfExists(IfExists bool) *ModifyProgrammaticAccessTokenRequest {
+	s.IfExists = &IfExists
+	return s
+}
+
+func (s *ModifyProgrammaticAccessTokenRequest) WithSet(Set ModifyProgrammaticAccessTokenSetRequest) *ModifyProgrammaticAccessTokenRequest {
+	s.Set = &Set
+	return s
+}
+
+func (s *ModifyProgrammaticAccessTokenRequest) WithUnset(Unset ModifyProgrammaticAccessTokenUnsetRequest) *ModifyProgrammaticAccessTokenRequest {
+	s.Unset = &Unset
+	return s
+}
+
+func (s *ModifyProgrammaticAccessTokenRequest) WithRenameTo(RenameTo AccountObjectIdentifier) *ModifyProgrammaticAccessTokenRequest {
+	s.RenameTo = &RenameTo
+	return s
+}
+
+func NewModifyProgrammaticAccessTokenSetRequest() *ModifyProgrammaticAccessTokenSetRequest {
+	return &ModifyProgrammaticAccessTokenSetRequest{}
+}
+
+func (s *ModifyProgrammaticAccessTokenSetRequest) WithDisabled(Disabled bool) *ModifyProgrammaticAccessTokenSetRequest {
+	s.Disabled = &Disabled
+	return s
+}
+
+func (s *ModifyProgrammaticAccessTokenSetRequest) WithMinsToBypassNetworkPolicyRequirement(MinsToBypassNetworkPolicyRequirement int) *ModifyProgrammaticAccessTokenSetRequest {
+	s.MinsToBypassNetworkPolicyRequirement = &MinsToBypassNetworkPolicyRequirement
+	return s
+}
+
+func (s *ModifyProgrammaticAccessTokenSetRequest) WithComment(Comment string) *ModifyProgrammaticAccessTokenSetRequest {
+	s.Comment = &Comment
+	return s
+}
+
+func NewModifyProgrammaticAccessTokenUnsetRequest() *ModifyProgrammaticAccessTokenUnsetRequest {
+	return &ModifyProgrammaticAccessTokenUnsetRequest{}
+}
+
+func (s *ModifyProgrammaticAccessTokenUnsetRequest) WithDisabled(Disabled bool) *ModifyProgrammaticAccessTokenUnsetRequest {
+	s.Disabled = &Disabled
+	return s
+}
+
+func (s *ModifyProgrammaticAccessTokenUnsetRequest) WithMinsToBypassNetworkPolicyRequirement(MinsToBypassNetworkPolicyRequirement bool) *ModifyProgrammaticAccessTokenUnsetRequest {
+	s.MinsToBypassNetworkPolicyRequirement = &MinsToBypassNetworkPolicyRequirement
+	return s
+}
+
+func (s *ModifyProgrammaticAccessTokenUnsetRequest) WithComment(Comment bool) *ModifyProgrammaticAccessTokenUnsetRequest {
+	s.Comment = &Comment
+	return s
+}
+
+func NewRemoveProgrammaticAccessTokenRequest(
+	UserName AccountObjectIdentifier,
+	name AccountObjectIdentifier,
+) *RemoveProgrammaticAccessTokenRequest {
+	s := RemoveProgrammaticAccessTokenRequest{}
+	s.UserName = UserName
+	s.name = name
+	return &s
+}
+
+func (s *RemoveProgrammaticAccessTokenRequest) WithIfExists(IfExists bool) *RemoveProgrammaticAccessTokenRequest {
+	s.IfExists = &IfExists
+	return s
+}
+
+func NewShowProgrammaticAccessTokenRequest() *ShowProgrammaticAccessTokenRequest {
+	return &ShowProgrammaticAccessTokenRequest{}
+}
+
+func (s *ShowProgrammaticAccessTokenRequest) WithUserName(UserName AccountObjectIdentifier) *ShowProgrammaticAccessTokenRequest {
+	s.UserName = &UserName
+	return s
+}
@@ -0,0 +1,40 @@
+package sdk
+
+//go:generate go run ./dto-builder-generator/main.go
+
+var (
+	_ optionsProvider[ModifyProgrammaticAccessTokenOptions] = new(ModifyProgrammaticAccessTokenRequest)
+	_ optionsProvider[RemoveProgrammaticAccessTokenOptions] = new(RemoveProgrammaticAccessTokenRequest)
+	_ optionsProvider[ShowProgrammaticAccessTokenOptions]   = new(ShowProgrammaticAccessTokenRequest)
+)
+
+type ModifyProgrammaticAccessTokenRequest struct {
+	IfExists *bool
+	UserName AccountObjectIdentifier // required
+	name     AccountObjectIdentifier // required
+	Set      *ModifyProgrammaticAccessTokenSetRequest
+	Unset    *ModifyProgrammaticAccessTokenUnsetRequest
+	RenameTo *AccountObjectIdentifier
+}
+
+type ModifyProgrammaticAccessTokenSetRequest struct {
+	Disabled                             *bool
+	MinsToBypassNetworkPolicyRequirement *int
+	Comment                              *string
+}
+
+type ModifyProgrammaticAccessTokenUnsetRequest struct {
+	Disabled                             *bool
+	MinsToBypassNetworkPolicyRequirement *bool
+	Comment                              *bool
+}
+
+type RemoveProgrammaticAccessTokenRequest struct {
+	IfExists *bool
+	UserName AccountObjectIdentifier // required
+	name     AccountObjectIdentifier // required
+}
+
+type ShowProgrammaticAccessTokenRequest struct {
+	UserName *AccountObjectIdentifier
+}
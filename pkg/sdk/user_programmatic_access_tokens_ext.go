@@ -0,0 +1,178 @@
+package sdk
+
+import "context"
+
+// UserProgrammaticAccessTokensExtended extends the generated UserProgrammaticAccessTokens with the commands returning
+// the token secrets (the generator does not support custom operations returning rows) and ShowByID taking both the user and the token identifiers.
+type UserProgrammaticAccessTokensExtended interface {
+	UserProgrammaticAccessTokens
+	Add(ctx context.Context, request *AddProgrammaticAccessTokenRequest) (*AddProgrammaticAccessTokenResult, error)
+	Rotate(ctx context.Context, request *RotateProgrammaticAccessTokenRequest) (*RotateProgrammaticAccessTokenResult, error)
+	ShowByID(ctx context.Context, userId AccountObjectIdentifier, tokenName AccountObjectIdentifier) (*ProgrammaticAccessToken, error)
+}
+
+var (
+	_ validatable                                           = new(AddProgrammaticAccessTokenOptions)
+	_ validatable                                           = new(RotateProgrammaticAccessTokenOptions)
+	_ optionsProvider[AddProgrammaticAccessTokenOptions]    = new(AddProgrammaticAccessTokenRequest)
+	_ optionsProvider[RotateProgrammaticAccessTokenOptions] = new(RotateProgrammaticAccessTokenRequest)
+)
+
+// AddProgrammaticAccessTokenOptions is based on https://docs.snowflake.com/en/sql-reference/sql/alter-user-add-programmatic-access-token.
+type AddProgrammaticAccessTokenOptions struct {
+	alter                                bool                    `ddl:"static" sql:"ALTER"`
+	user                                 bool                    `ddl:"static" sql:"USER"`
+	IfExists                             *bool                   `ddl:"keyword" sql:"IF EXISTS"`
+	UserName                             AccountObjectIdentifier `ddl:"identifier"`
+	addProgrammaticAccessToken           bool                    `ddl:"static" sql:"ADD PROGRAMMATIC ACCESS TOKEN"`
+	name                                 AccountObjectIdentifier `ddl:"identifier"`
+	RoleRestriction                      *string                 `ddl:"parameter,single_quotes" sql:"ROLE_RESTRICTION"`
+	DaysToExpiry                         *int                    `ddl:"parameter" sql:"DAYS_TO_EXPIRY"`
+	MinsToBypassNetworkPolicyRequirement *int                    `ddl:"parameter" sql:"MINS_TO_BYPASS_NETWORK_POLICY_REQUIREMENT"`
+	Comment                              *string                 `ddl:"parameter,single_quotes" sql:"COMMENT"`
+}
+
+func (opts *AddProgrammaticAccessTokenOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.UserName) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	return JoinErrors(errs...)
+}
+
+type AddProgrammaticAccessTokenRequest struct {
+	IfExists                             *bool
+	UserName                             AccountObjectIdentifier // required
+	name                                 AccountObjectIdentifier // required
+	RoleRestriction                      *string
+	DaysToExpiry                         *int
+	MinsToBypassNetworkPolicyRequirement *int
+	Comment                              *string
+}
+
+func NewAddProgrammaticAccessTokenRequest(userName AccountObjectIdentifier, name AccountObjectIdentifier) *AddProgrammaticAccessTokenRequest {
+	return &AddProgrammaticAccessTokenRequest{UserName: userName, name: name}
+}
+
+func (s *AddProgrammaticAccessTokenRequest) WithIfExists(ifExists bool) *AddProgrammaticAccessTokenRequest {
+	s.IfExists = &ifExists
+	return s
+}
+
+func (s *AddProgrammaticAccessTokenRequest) WithRoleRestriction(roleRestriction string) *AddProgrammaticAccessTokenRequest {
+	s.RoleRestriction = &roleRestriction
+	return s
+}
+
+func (s *AddProgrammaticAccessTokenRequest) WithDaysToExpiry(daysToExpiry int) *AddProgrammaticAccessTokenRequest {
+	s.DaysToExpiry = &daysToExpiry
+	return s
+}
+
+func (s *AddProgrammaticAccessTokenRequest) WithMinsToBypassNetworkPolicyRequirement(minsToBypassNetworkPolicyRequirement int) *AddProgrammaticAccessTokenRequest {
+	s.MinsToBypassNetworkPolicyRequirement = &minsToBypassNetworkPolicyRequirement
+	return s
+}
+
+func (s *AddProgrammaticAccessTokenRequest) WithComment(comment string) *AddProgrammaticAccessTokenRequest {
+	s.Comment = &comment
+	return s
+}
+
+func (r *AddProgrammaticAccessTokenRequest) toOpts() *AddProgrammaticAccessTokenOptions {
+	return &AddProgrammaticAccessTokenOptions{
+		IfExists:                             r.IfExists,
+		UserName:                             r.UserName,
+		name:                                 r.name,
+		RoleRestriction:                      r.RoleRestriction,
+		DaysToExpiry:                         r.DaysToExpiry,
+		MinsToBypassNetworkPolicyRequirement: r.MinsToBypassNetworkPolicyRequirement,
+		Comment:                              r.Comment,
+	}
+}
+
+// RotateProgrammaticAccessTokenOptions is based on https://docs.snowflake.com/en/sql-reference/sql/alter-user-rotate-programmatic-access-token.
+type RotateProgrammaticAccessTokenOptions struct {
+	alter                         bool                    `ddl:"static" sql:"ALTER"`
+	user                          bool                    `ddl:"static" sql:"USER"`
+	IfExists                      *bool                   `ddl:"keyword" sql:"IF EXISTS"`
+	UserName                      AccountObjectIdentifier `ddl:"identifier"`
+	rotateProgrammaticAccessToken bool                    `ddl:"static" sql:"ROTATE PROGRAMMATIC ACCESS TOKEN"`
+	name                          AccountObjectIdentifier `ddl:"identifier"`
+	ExpireRotatedTokenAfterHours  *int                    `ddl:"parameter" sql:"EXPIRE_ROTATED_TOKEN_AFTER_HOURS"`
+}
+
+func (opts *RotateProgrammaticAccessTokenOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.UserName) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	return JoinErrors(errs...)
+}
+
+type RotateProgrammaticAccessTokenRequest struct {
+	IfExists                     *bool
+	UserName                     AccountObjectIdentifier // required
+	name                         AccountObjectIdentifier // required
+	ExpireRotatedTokenAfterHours *int
+}
+
+func NewRotateProgrammaticAccessTokenRequest(userName AccountObjectIdentifier, name AccountObjectIdentifier) *RotateProgrammaticAccessTokenRequest {
+	return &RotateProgrammaticAccessTokenRequest{UserName: userName, name: name}
+}
+
+func (s *RotateProgrammaticAccessTokenRequest) WithIfExists(ifExists bool) *RotateProgrammaticAccessTokenRequest {
+	s.IfExists = &ifExists
+	return s
+}
+
+func (s *RotateProgrammaticAccessTokenRequest) WithExpireRotatedTokenAfterHours(expireRotatedTokenAfterHours int) *RotateProgrammaticAccessTokenRequest {
+	s.ExpireRotatedTokenAfterHours = &expireRotatedTokenAfterHours
+	return s
+}
+
+func (r *RotateProgrammaticAccessTokenRequest) toOpts() *RotateProgrammaticAccessTokenOptions {
+	return &RotateProgrammaticAccessTokenOptions{
+		IfExists:                     r.IfExists,
+		UserName:                     r.UserName,
+		name:                         r.name,
+		ExpireRotatedTokenAfterHours: r.ExpireRotatedTokenAfterHours,
+	}
+}
+
+type addProgrammaticAccessTokenResultRow struct {
+	TokenName   string `db:"token_name"`
+	TokenSecret string `db:"token_secret"`
+}
+
+// AddProgrammaticAccessTokenResult contains the secret of the added token. It is returned only once by Snowflake.
+type AddProgrammaticAccessTokenResult struct {
+	TokenName   string
+	TokenSecret string
+}
+
+type rotateProgrammaticAccessTokenResultRow struct {
+	TokenName        string `db:"token_name"`
+	TokenSecret      string `db:"token_secret"`
+	RotatedTokenName string `db:"rotated_token_name"`
+}
+
+// RotateProgrammaticAccessTokenResult contains the secret of the new token and the name of the rotated (old) token.
+// The rotated token stays valid until it expires after EXPIRE_ROTATED_TOKEN_AFTER_HOURS.
+type RotateProgrammaticAccessTokenResult struct {
+	TokenName        string
+	TokenSecret      string
+	RotatedTokenName string
+}
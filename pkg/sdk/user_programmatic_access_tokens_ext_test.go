@@ -0,0 +1,130 @@
+package sdk
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserProgrammaticAccessTokens_Add(t *testing.T) {
+	userId := randomAccountObjectIdentifier()
+	id := randomAccountObjectIdentifier()
+
+	defaultOpts := func() *AddProgrammaticAccessTokenOptions {
+		return &AddProgrammaticAccessTokenOptions{
+			UserName: userId,
+			name:     id,
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *AddProgrammaticAccessTokenOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.UserName]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.UserName = emptyAccountObjectIdentifier
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.name = emptyAccountObjectIdentifier
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "ALTER USER %s ADD PROGRAMMATIC ACCESS TOKEN %s", userId.FullyQualifiedName(), id.FullyQualifiedName())
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.IfExists = Bool(true)
+		opts.RoleRestriction = String("PUBLIC")
+		opts.DaysToExpiry = Int(30)
+		opts.MinsToBypassNetworkPolicyRequirement = Int(10)
+		opts.Comment = String("some comment")
+		assertOptsValidAndSQLEquals(t, opts, "ALTER USER IF EXISTS %s ADD PROGRAMMATIC ACCESS TOKEN %s ROLE_RESTRICTION = 'PUBLIC' DAYS_TO_EXPIRY = 30 MINS_TO_BYPASS_NETWORK_POLICY_REQUIREMENT = 10 COMMENT = 'some comment'", userId.FullyQualifiedName(), id.FullyQualifiedName())
+	})
+}
+
+func TestUserProgrammaticAccessTokens_Rotate(t *testing.T) {
+	userId := randomAccountObjectIdentifier()
+	id := randomAccountObjectIdentifier()
+
+	defaultOpts := func() *RotateProgrammaticAccessTokenOptions {
+		return &RotateProgrammaticAccessTokenOptions{
+			UserName: userId,
+			name:     id,
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *RotateProgrammaticAccessTokenOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.UserName]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.UserName = emptyAccountObjectIdentifier
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.name = emptyAccountObjectIdentifier
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "ALTER USER %s ROTATE PROGRAMMATIC ACCESS TOKEN %s", userId.FullyQualifiedName(), id.FullyQualifiedName())
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.IfExists = Bool(true)
+		opts.ExpireRotatedTokenAfterHours = Int(0)
+		assertOptsValidAndSQLEquals(t, opts, "ALTER USER IF EXISTS %s ROTATE PROGRAMMATIC ACCESS TOKEN %s EXPIRE_ROTATED_TOKEN_AFTER_HOURS = 0", userId.FullyQualifiedName(), id.FullyQualifiedName())
+	})
+}
+
+func TestToProgrammaticAccessTokenStatus(t *testing.T) {
+	type test struct {
+		input string
+		want  ProgrammaticAccessTokenStatus
+	}
+
+	valid := []test{
+		// case insensitive.
+		{input: "active", want: ProgrammaticAccessTokenStatusActive},
+
+		// Supported Values
+		{input: "ACTIVE", want: ProgrammaticAccessTokenStatusActive},
+		{input: "EXPIRED", want: ProgrammaticAccessTokenStatusExpired},
+		{input: "DISABLED", want: ProgrammaticAccessTokenStatusDisabled},
+	}
+
+	invalid := []test{
+		// bad values
+		{input: ""},
+		{input: "foo"},
+	}
+
+	for _, tc := range valid {
+		t.Run(tc.input, func(t *testing.T) {
+			got, err := ToProgrammaticAccessTokenStatus(tc.input)
+			require.NoError(t, err)
+			require.Equal(t, tc.want, got)
+		})
+	}
+
+	for _, tc := range invalid {
+		t.Run(tc.input, func(t *testing.T) {
+			_, err := ToProgrammaticAccessTokenStatus(tc.input)
+			require.Error(t, err)
+		})
+	}
+}
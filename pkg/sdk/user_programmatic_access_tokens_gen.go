@@ -0,0 +1,88 @@
+package sdk
+
+import (
+	"context"
+	"database/sql"
+	"time"
+)
+
+type UserProgrammaticAccessTokens interface {
+	Modify(ctx context.Context, request *ModifyProgrammaticAccessTokenRequest) error
+	Remove(ctx context.Context, request *RemoveProgrammaticAccessTokenRequest) error
+	Show(ctx context.Context, request *ShowProgrammaticAccessTokenRequest) ([]ProgrammaticAccessToken, error)
+}
+
+// ModifyProgrammaticAccessTokenOptions is based on https://docs.snowflake.com/en/sql-reference/sql/alter-user-modify-programmatic-access-token.
+type ModifyProgrammaticAccessTokenOptions struct {
+	alter                         bool                                `ddl:"static" sql:"ALTER"`
+	user                          bool                                `ddl:"static" sql:"USER"`
+	IfExists                      *bool                               `ddl:"keyword" sql:"IF EXISTS"`
+	UserName                      AccountObjectIdentifier             `ddl:"identifier"`
+	modifyProgrammaticAccessToken bool                                `ddl:"static" sql:"MODIFY PROGRAMMATIC ACCESS TOKEN"`
+	name                          AccountObjectIdentifier             `ddl:"identifier"`
+	Set                           *ModifyProgrammaticAccessTokenSet   `ddl:"keyword" sql:"SET"`
+	Unset                         *ModifyProgrammaticAccessTokenUnset `ddl:"list,no_parentheses" sql:"UNSET"`
+	RenameTo                      *AccountObjectIdentifier            `ddl:"identifier" sql:"RENAME TO"`
+}
+
+type ModifyProgrammaticAccessTokenSet struct {
+	Disabled                             *bool   `ddl:"parameter" sql:"DISABLED"`
+	MinsToBypassNetworkPolicyRequirement *int    `ddl:"parameter" sql:"MINS_TO_BYPASS_NETWORK_POLICY_REQUIREMENT"`
+	Comment                              *string `ddl:"parameter,single_quotes" sql:"COMMENT"`
+}
+
+type ModifyProgrammaticAccessTokenUnset struct {
+	Disabled                             *bool `ddl:"keyword" sql:"DISABLED"`
+	MinsToBypassNetworkPolicyRequirement *bool `ddl:"keyword" sql:"MINS_TO_BYPASS_NETWORK_POLICY_REQUIREMENT"`
+	Comment                              *bool `ddl:"keyword" sql:"COMMENT"`
+}
+
+// RemoveProgrammaticAccessTokenOptions is based on https://docs.snowflake.com/en/sql-reference/sql/alter-user-remove-programmatic-access-token.
+type RemoveProgrammaticAccessTokenOptions struct {
+	alter                         bool                    `ddl:"static" sql:"ALTER"`
+	user                          bool                    `ddl:"static" sql:"USER"`
+	IfExists                      *bool                   `ddl:"keyword" sql:"IF EXISTS"`
+	UserName                      AccountObjectIdentifier `ddl:"identifier"`
+	removeProgrammaticAccessToken bool                    `ddl:"static" sql:"REMOVE PROGRAMMATIC ACCESS TOKEN"`
+	name                          AccountObjectIdentifier `ddl:"identifier"`
+}
+
+// ShowProgrammaticAccessTokenOptions is based on https://docs.snowflake.com/en/sql-reference/sql/show-user-programmatic-access-tokens.
+type ShowProgrammaticAccessTokenOptions struct {
+	show                         bool                     `ddl:"static" sql:"SHOW"`
+	userProgrammaticAccessTokens bool                     `ddl:"static" sql:"USER PROGRAMMATIC ACCESS TOKENS"`
+	UserName                     *AccountObjectIdentifier `ddl:"identifier" sql:"FOR USER"`
+}
+
+type programmaticAccessTokenRow struct {
+	Name                                 string         `db:"name"`
+	UserName                             string         `db:"user_name"`
+	RoleRestriction                      sql.NullString `db:"role_restriction"`
+	ExpiresAt                            time.Time      `db:"expires_at"`
+	Status                               string         `db:"status"`
+	Comment                              sql.NullString `db:"comment"`
+	CreatedOn                            time.Time      `db:"created_on"`
+	CreatedBy                            string         `db:"created_by"`
+	MinsToBypassNetworkPolicyRequirement sql.NullInt64  `db:"mins_to_bypass_network_policy_requirement"`
+	RotatedTo                            sql.NullString `db:"rotated_to"`
+}
+
+type ProgrammaticAccessToken struct {
+	Name                                 string
+	UserName                             string
+	RoleRestriction                      *AccountObjectIdentifier
+	ExpiresAt                            time.Time
+	Status                               ProgrammaticAccessTokenStatus
+	Comment                              *string
+	CreatedOn                            time.Time
+	CreatedBy                            string
+	MinsToBypassNetworkPolicyRequirement *int
+	RotatedTo                            *string
+}
+
+func (v *ProgrammaticAccessToken) ID() AccountObjectIdentifier {
+	return NewAccountObjectIdentifier(v.Name)
+}
+func (v *ProgrammaticAccessToken) ObjectType() ObjectType {
+	return ObjectTypeProgrammaticAccessToken
+}
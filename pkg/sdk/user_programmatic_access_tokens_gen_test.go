@@ -0,0 +1,170 @@
+package sdk
+
+import "testing"
+
+func TestUserProgrammaticAccessTokens_Modify(t *testing.T) {
+	userId := randomAccountObjectIdentifier()
+	id := randomAccountObjectIdentifier()
+
+	// Minimal valid ModifyProgrammaticAccessTokenOptions
+	defaultOpts := func() *ModifyProgrammaticAccessTokenOptions {
+		return &ModifyProgrammaticAccessTokenOptions{
+			UserName: userId,
+			name:     id,
+			Set: &ModifyProgrammaticAccessTokenSet{
+				Disabled: Bool(true),
+			},
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *ModifyProgrammaticAccessTokenOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.UserName]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.UserName = emptyAccountObjectIdentifier
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.name = emptyAccountObjectIdentifier
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("validation: valid identifier for [opts.RenameTo] if set", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Set = nil
+		opts.RenameTo = &emptyAccountObjectIdentifier
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("validation: exactly one field from [opts.Set opts.Unset opts.RenameTo] should be present", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Unset = &ModifyProgrammaticAccessTokenUnset{
+			Comment: Bool(true),
+		}
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("ModifyProgrammaticAccessTokenOptions", "Set", "Unset", "RenameTo"))
+	})
+
+	t.Run("validation: at least one of the fields [opts.Set.Disabled opts.Set.MinsToBypassNetworkPolicyRequirement opts.Set.Comment] should be set", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Set = &ModifyProgrammaticAccessTokenSet{}
+		assertOptsInvalidJoinedErrors(t, opts, errAtLeastOneOf("ModifyProgrammaticAccessTokenOptions.Set", "Disabled", "MinsToBypassNetworkPolicyRequirement", "Comment"))
+	})
+
+	t.Run("validation: at least one of the fields [opts.Unset.Disabled opts.Unset.MinsToBypassNetworkPolicyRequirement opts.Unset.Comment] should be set", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Set = nil
+		opts.Unset = &ModifyProgrammaticAccessTokenUnset{}
+		assertOptsInvalidJoinedErrors(t, opts, errAtLeastOneOf("ModifyProgrammaticAccessTokenOptions.Unset", "Disabled", "MinsToBypassNetworkPolicyRequirement", "Comment"))
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "ALTER USER %s MODIFY PROGRAMMATIC ACCESS TOKEN %s SET DISABLED = true", userId.FullyQualifiedName(), id.FullyQualifiedName())
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.IfExists = Bool(true)
+		opts.Set = &ModifyProgrammaticAccessTokenSet{
+			Disabled:                             Bool(true),
+			MinsToBypassNetworkPolicyRequirement: Int(15),
+			Comment:                              String("some comment"),
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER USER IF EXISTS %s MODIFY PROGRAMMATIC ACCESS TOKEN %s SET DISABLED = true MINS_TO_BYPASS_NETWORK_POLICY_REQUIREMENT = 15 COMMENT = 'some comment'", userId.FullyQualifiedName(), id.FullyQualifiedName())
+	})
+
+	t.Run("unset", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Set = nil
+		opts.Unset = &ModifyProgrammaticAccessTokenUnset{
+			Disabled:                             Bool(true),
+			MinsToBypassNetworkPolicyRequirement: Bool(true),
+			Comment:                              Bool(true),
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER USER %s MODIFY PROGRAMMATIC ACCESS TOKEN %s UNSET DISABLED, MINS_TO_BYPASS_NETWORK_POLICY_REQUIREMENT, COMMENT", userId.FullyQualifiedName(), id.FullyQualifiedName())
+	})
+
+	t.Run("rename", func(t *testing.T) {
+		newId := randomAccountObjectIdentifier()
+		opts := defaultOpts()
+		opts.Set = nil
+		opts.RenameTo = &newId
+		assertOptsValidAndSQLEquals(t, opts, "ALTER USER %s MODIFY PROGRAMMATIC ACCESS TOKEN %s RENAME TO %s", userId.FullyQualifiedName(), id.FullyQualifiedName(), newId.FullyQualifiedName())
+	})
+}
+
+func TestUserProgrammaticAccessTokens_Remove(t *testing.T) {
+	userId := randomAccountObjectIdentifier()
+	id := randomAccountObjectIdentifier()
+
+	// Minimal valid RemoveProgrammaticAccessTokenOptions
+	defaultOpts := func() *RemoveProgrammaticAccessTokenOptions {
+		return &RemoveProgrammaticAccessTokenOptions{
+			UserName: userId,
+			name:     id,
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *RemoveProgrammaticAccessTokenOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.UserName]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.UserName = emptyAccountObjectIdentifier
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.name = emptyAccountObjectIdentifier
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "ALTER USER %s REMOVE PROGRAMMATIC ACCESS TOKEN %s", userId.FullyQualifiedName(), id.FullyQualifiedName())
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.IfExists = Bool(true)
+		assertOptsValidAndSQLEquals(t, opts, "ALTER USER IF EXISTS %s REMOVE PROGRAMMATIC ACCESS TOKEN %s", userId.FullyQualifiedName(), id.FullyQualifiedName())
+	})
+}
+
+func TestUserProgrammaticAccessTokens_Show(t *testing.T) {
+	// Minimal valid ShowProgrammaticAccessTokenOptions
+	defaultOpts := func() *ShowProgrammaticAccessTokenOptions {
+		return &ShowProgrammaticAccessTokenOptions{}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *ShowProgrammaticAccessTokenOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.UserName] if set", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.UserName = &emptyAccountObjectIdentifier
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "SHOW USER PROGRAMMATIC ACCESS TOKENS")
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		userId := randomAccountObjectIdentifier()
+		opts := defaultOpts()
+		opts.UserName = &userId
+		assertOptsValidAndSQLEquals(t, opts, "SHOW USER PROGRAMMATIC ACCESS TOKENS FOR USER %s", userId.FullyQualifiedName())
+	})
+}
@@ -0,0 +1,51 @@
+package sdk
+
+import (
+	"context"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+)
+
+var _ UserProgrammaticAccessTokensExtended = (*userProgrammaticAccessTokens)(nil)
+
+func (v *userProgrammaticAccessTokens) Add(ctx context.Context, request *AddProgrammaticAccessTokenRequest) (*AddProgrammaticAccessTokenResult, error) {
+	opts := request.toOpts()
+	result, err := validateAndQueryOne[addProgrammaticAccessTokenResultRow](v.client, ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return result.convert(), nil
+}
+
+func (v *userProgrammaticAccessTokens) Rotate(ctx context.Context, request *RotateProgrammaticAccessTokenRequest) (*RotateProgrammaticAccessTokenResult, error) {
+	opts := request.toOpts()
+	result, err := validateAndQueryOne[rotateProgrammaticAccessTokenResultRow](v.client, ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return result.convert(), nil
+}
+
+func (v *userProgrammaticAccessTokens) ShowByID(ctx context.Context, userId AccountObjectIdentifier, tokenName AccountObjectIdentifier) (*ProgrammaticAccessToken, error) {
+	request := NewShowProgrammaticAccessTokenRequest().WithUserName(userId)
+	tokens, err := v.Show(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return collections.FindFirst(tokens, func(r ProgrammaticAccessToken) bool { return r.Name == tokenName.Name() })
+}
+
+func (r addProgrammaticAccessTokenResultRow) convert() *AddProgrammaticAccessTokenResult {
+	return &AddProgrammaticAccessTokenResult{
+		TokenName:   r.TokenName,
+		TokenSecret: r.TokenSecret,
+	}
+}
+
+func (r rotateProgrammaticAccessTokenResultRow) convert() *RotateProgrammaticAccessTokenResult {
+	return &RotateProgrammaticAccessTokenResult{
+		TokenName:        r.TokenName,
+		TokenSecret:      r.TokenSecret,
+		RotatedTokenName: r.RotatedTokenName,
+	}
+}
@@ -0,0 +1,101 @@
+package sdk
+
+import (
+	"context"
+	"log"
+)
+
+var _ UserProgrammaticAccessTokens = (*userProgrammaticAccessTokens)(nil)
+
+type userProgrammaticAccessTokens struct {
+	client *Client
+}
+
+func (v *userProgrammaticAccessTokens) Modify(ctx context.Context, request *ModifyProgrammaticAccessTokenRequest) error {
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *userProgrammaticAccessTokens) Remove(ctx context.Context, request *RemoveProgrammaticAccessTokenRequest) error {
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *userProgrammaticAccessTokens) Show(ctx context.Context, request *ShowProgrammaticAccessTokenRequest) ([]ProgrammaticAccessToken, error) {
+	opts := request.toOpts()
+	dbRows, err := validateAndQuery[programmaticAccessTokenRow](v.client, ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	resultList := convertRows[programmaticAccessTokenRow, ProgrammaticAccessToken](dbRows)
+	return resultList, nil
+}
+
+func (r *ModifyProgrammaticAccessTokenRequest) toOpts() *ModifyProgrammaticAccessTokenOptions {
+	opts := &ModifyProgrammaticAccessTokenOptions{
+		IfExists: r.IfExists,
+		UserName: r.UserName,
+		name:     r.name,
+
+		RenameTo: r.RenameTo,
+	}
+	if r.Set != nil {
+		opts.Set = &ModifyProgrammaticAccessTokenSet{
+			Disabled:                             r.Set.Disabled,
+			MinsToBypassNetworkPolicyRequirement: r.Set.MinsToBypassNetworkPolicyRequirement,
+			Comment:                              r.Set.Comment,
+		}
+	}
+	if r.Unset != nil {
+		opts.Unset = &ModifyProgrammaticAccessTokenUnset{
+			Disabled:                             r.Unset.Disabled,
+			MinsToBypassNetworkPolicyRequirement: r.Unset.MinsToBypassNetworkPolicyRequirement,
+			Comment:                              r.Unset.Comment,
+		}
+	}
+	return opts
+}
+
+func (r *RemoveProgrammaticAccessTokenRequest) toOpts() *RemoveProgrammaticAccessTokenOptions {
+	opts := &RemoveProgrammaticAccessTokenOptions{
+		IfExists: r.IfExists,
+		UserName: r.UserName,
+		name:     r.name,
+	}
+	return opts
+}
+
+func (r *ShowProgrammaticAccessTokenRequest) toOpts() *ShowProgrammaticAccessTokenOptions {
+	opts := &ShowProgrammaticAccessTokenOptions{
+		UserName: r.UserName,
+	}
+	return opts
+}
+
+func (r programmaticAccessTokenRow) convert() *ProgrammaticAccessToken {
+	e := &ProgrammaticAccessToken{
+		Name:      r.Name,
+		UserName:  r.UserName,
+		ExpiresAt: r.ExpiresAt,
+		CreatedOn: r.CreatedOn,
+		CreatedBy: r.CreatedBy,
+	}
+	if r.RoleRestriction.Valid && r.RoleRestriction.String != "" {
+		e.RoleRestriction = Pointer(NewAccountObjectIdentifier(r.RoleRestriction.String))
+	}
+	if status, err := ToProgrammaticAccessTokenStatus(r.Status); err != nil {
+		log.Printf("[DEBUG] error converting programmatic access token status: %v", err)
+	} else {
+		e.Status = status
+	}
+	if r.Comment.Valid {
+		e.Comment = String(r.Comment.String)
+	}
+	if r.MinsToBypassNetworkPolicyRequirement.Valid {
+		e.MinsToBypassNetworkPolicyRequirement = Int(int(r.MinsToBypassNetworkPolicyRequirement.Int64))
+	}
+	if r.RotatedTo.Valid && r.RotatedTo.String != "" {
+		e.RotatedTo = String(r.RotatedTo.String)
+	}
+	return e
+}
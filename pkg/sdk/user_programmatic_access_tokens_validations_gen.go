@@ -0,0 +1,62 @@
+package sdk
+
+var (
+	_ validatable = new(ModifyProgrammaticAccessTokenOptions)
+	_ validatable = new(RemoveProgrammaticAccessTokenOptions)
+	_ validatable = new(ShowProgrammaticAccessTokenOptions)
+)
+
+func (opts *ModifyProgrammaticAccessTokenOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.UserName) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if opts.RenameTo != nil && !ValidObjectIdentifier(opts.RenameTo) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if !exactlyOneValueSet(opts.Set, opts.Unset, opts.RenameTo) {
+		errs = append(errs, errExactlyOneOf("ModifyProgrammaticAccessTokenOptions", "Set", "Unset", "RenameTo"))
+	}
+	if valueSet(opts.Set) {
+		if !anyValueSet(opts.Set.Disabled, opts.Set.MinsToBypassNetworkPolicyRequirement, opts.Set.Comment) {
+			errs = append(errs, errAtLeastOneOf("ModifyProgrammaticAccessTokenOptions.Set", "Disabled", "MinsToBypassNetworkPolicyRequirement", "Comment"))
+		}
+	}
+	if valueSet(opts.Unset) {
+		if !anyValueSet(opts.Unset.Disabled, opts.Unset.MinsToBypassNetworkPolicyRequirement, opts.Unset.Comment) {
+			errs = append(errs, errAtLeastOneOf("ModifyProgrammaticAccessTokenOptions.Unset", "Disabled", "MinsToBypassNetworkPolicyRequirement", "Comment"))
+		}
+	}
+	return JoinErrors(errs...)
+}
+
+func (opts *RemoveProgrammaticAccessTokenOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.UserName) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	return JoinErrors(errs...)
+}
+
+func (opts *ShowProgrammaticAccessTokenOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if opts.UserName != nil && !ValidObjectIdentifier(opts.UserName) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	return JoinErrors(errs...)
+}
@@ -15,6 +15,7 @@ var (
 	_ validatable = new(DropUserOptions)
 	_ validatable = new(describeUserOptions)
 	_ validatable = new(ShowUserOptions)
+	_ validatable = new(showUserMfaMethodsOptions)
 )
 
 type Users interface {
@@ -25,6 +26,7 @@ type Users interface {
 	Show(ctx context.Context, opts *ShowUserOptions) ([]User, error)
 	ShowByID(ctx context.Context, id AccountObjectIdentifier) (*User, error)
 	ShowParameters(ctx context.Context, id AccountObjectIdentifier) ([]*Parameter, error)
+	ShowMfaMethods(ctx context.Context, id AccountObjectIdentifier) ([]UserMfaMethod, error)
 }
 
 var _ Users = (*users)(nil)
@@ -226,6 +228,11 @@ func (opts *CreateUserOptions) validate() error {
 			return err
 		}
 	}
+	if valueSet(opts.ObjectProperties) && valueSet(opts.ObjectProperties.WorkloadIdentity) {
+		if err := opts.ObjectProperties.WorkloadIdentity.validate(); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -266,10 +273,53 @@ type UserObjectProperties struct {
 	RSAPublicKeyFp        *string                  `ddl:"parameter,single_quotes" sql:"RSA_PUBLIC_KEY_FP"`
 	RSAPublicKey2         *string                  `ddl:"parameter,single_quotes" sql:"RSA_PUBLIC_KEY_2"`
 	RSAPublicKey2Fp       *string                  `ddl:"parameter,single_quotes" sql:"RSA_PUBLIC_KEY_2_FP"`
+	WorkloadIdentity      *UserWorkloadIdentity    `ddl:"list,parentheses,no_comma" sql:"WORKLOAD_IDENTITY ="`
 	Type                  *UserType                `ddl:"parameter,no_quotes" sql:"TYPE"`
 	Comment               *string                  `ddl:"parameter,single_quotes" sql:"COMMENT"`
 }
 
+// UserWorkloadIdentity is based on https://docs.snowflake.com/en/user-guide/workload-identity-federation.
+type UserWorkloadIdentity struct {
+	AwsType   *UserWorkloadIdentityAws   `ddl:"keyword"`
+	AzureType *UserWorkloadIdentityAzure `ddl:"keyword"`
+	GcpType   *UserWorkloadIdentityGcp   `ddl:"keyword"`
+	OidcType  *UserWorkloadIdentityOidc  `ddl:"keyword"`
+}
+
+type UserWorkloadIdentityAws struct {
+	workloadIdentityType string `ddl:"static" sql:"TYPE = AWS"`
+	Arn                  string `ddl:"parameter,single_quotes" sql:"ARN"`
+}
+
+type UserWorkloadIdentityAzure struct {
+	workloadIdentityType string `ddl:"static" sql:"TYPE = AZURE"`
+	Issuer               string `ddl:"parameter,single_quotes" sql:"ISSUER"`
+	Subject              string `ddl:"parameter,single_quotes" sql:"SUBJECT"`
+}
+
+type UserWorkloadIdentityGcp struct {
+	workloadIdentityType string `ddl:"static" sql:"TYPE = GCP"`
+	Subject              string `ddl:"parameter,single_quotes" sql:"SUBJECT"`
+}
+
+type UserWorkloadIdentityOidc struct {
+	workloadIdentityType string                 `ddl:"static" sql:"TYPE = OIDC"`
+	Issuer               string                 `ddl:"parameter,single_quotes" sql:"ISSUER"`
+	Subject              string                 `ddl:"parameter,single_quotes" sql:"SUBJECT"`
+	OidcAudienceList     []OidcAudienceListItem `ddl:"parameter,parentheses" sql:"OIDC_AUDIENCE_LIST"`
+}
+
+type OidcAudienceListItem struct {
+	Item string `ddl:"keyword,single_quotes"`
+}
+
+func (opts *UserWorkloadIdentity) validate() error {
+	if !exactlyOneValueSet(opts.AwsType, opts.AzureType, opts.GcpType, opts.OidcType) {
+		return errExactlyOneOf("UserWorkloadIdentity", "AwsType", "AzureType", "GcpType", "OidcType")
+	}
+	return nil
+}
+
 type UserAlterObjectProperties struct {
 	UserObjectProperties
 	DisableMfa *bool `ddl:"parameter,no_quotes" sql:"DISABLE_MFA"`
@@ -303,6 +353,7 @@ type UserObjectPropertiesUnset struct {
 	DisableMfa            *bool `ddl:"keyword" sql:"DISABLE_MFA"`
 	RSAPublicKey          *bool `ddl:"keyword" sql:"RSA_PUBLIC_KEY"`
 	RSAPublicKey2         *bool `ddl:"keyword" sql:"RSA_PUBLIC_KEY_2"`
+	WorkloadIdentity      *bool `ddl:"keyword" sql:"WORKLOAD_IDENTITY"`
 	Type                  *bool `ddl:"keyword" sql:"TYPE"`
 	Comment               *bool `ddl:"keyword" sql:"COMMENT"`
 }
@@ -331,6 +382,8 @@ type AlterUserOptions struct {
 	AbortAllQueries              *bool                         `ddl:"keyword" sql:"ABORT ALL QUERIES"`
 	AddDelegatedAuthorization    *AddDelegatedAuthorization    `ddl:"keyword"`
 	RemoveDelegatedAuthorization *RemoveDelegatedAuthorization `ddl:"keyword"`
+	RemoveMfaMethod              *string                       `ddl:"parameter,no_equals" sql:"REMOVE MFA METHOD"`
+	ModifyMfaMethod              *ModifyMfaMethod              `ddl:"keyword"`
 	Set                          *UserSet                      `ddl:"keyword" sql:"SET"`
 	Unset                        *UserUnset                    `ddl:"list" sql:"UNSET"`
 	SetTag                       []TagAssociation              `ddl:"keyword" sql:"SET TAG"`
@@ -345,8 +398,8 @@ func (opts *AlterUserOptions) validate() error {
 	if !ValidObjectIdentifier(opts.name) {
 		errs = append(errs, ErrInvalidObjectIdentifier)
 	}
-	if !exactlyOneValueSet(opts.NewName, opts.ResetPassword, opts.AbortAllQueries, opts.AddDelegatedAuthorization, opts.RemoveDelegatedAuthorization, opts.Set, opts.Unset, opts.SetTag, opts.UnsetTag) {
-		errs = append(errs, errExactlyOneOf("AlterUserOptions", "NewName", "ResetPassword", "AbortAllQueries", "AddDelegatedAuthorization", "RemoveDelegatedAuthorization", "Set", "Unset", "SetTag", "UnsetTag"))
+	if !exactlyOneValueSet(opts.NewName, opts.ResetPassword, opts.AbortAllQueries, opts.AddDelegatedAuthorization, opts.RemoveDelegatedAuthorization, opts.RemoveMfaMethod, opts.ModifyMfaMethod, opts.Set, opts.Unset, opts.SetTag, opts.UnsetTag) {
+		errs = append(errs, errExactlyOneOf("AlterUserOptions", "NewName", "ResetPassword", "AbortAllQueries", "AddDelegatedAuthorization", "RemoveDelegatedAuthorization", "RemoveMfaMethod", "ModifyMfaMethod", "Set", "Unset", "SetTag", "UnsetTag"))
 	}
 	if valueSet(opts.RemoveDelegatedAuthorization) {
 		if err := opts.RemoveDelegatedAuthorization.validate(); err != nil {
@@ -406,6 +459,11 @@ func (opts *RemoveDelegatedAuthorization) validate() error {
 	return errors.Join(errs...)
 }
 
+type ModifyMfaMethod struct {
+	Name    string `ddl:"parameter,no_equals" sql:"MODIFY MFA METHOD"`
+	Comment string `ddl:"parameter,single_quotes" sql:"SET COMMENT"`
+}
+
 type UserSet struct {
 	PasswordPolicy       *SchemaObjectIdentifier    `ddl:"identifier" sql:"PASSWORD POLICY"`
 	SessionPolicy        *string                    `ddl:"parameter" sql:"SESSION POLICY"`
@@ -430,6 +488,11 @@ func (opts *UserSet) validate() error {
 			return err
 		}
 	}
+	if valueSet(opts.ObjectProperties) && valueSet(opts.ObjectProperties.WorkloadIdentity) {
+		if err := opts.ObjectProperties.WorkloadIdentity.validate(); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -536,6 +599,8 @@ type UserDetails struct {
 	CustomLandingPageUrl                *StringProperty
 	CustomLandingPageUrlFlushNextUiLoad *BoolProperty
 	HasMfa                              *BoolProperty
+	HasPat                              *BoolProperty
+	HasWorkloadIdentity                 *BoolProperty
 }
 
 func userDetailsFromRows(rows []propertyRow) *UserDetails {
@@ -588,6 +653,10 @@ func userDetailsFromRows(rows []propertyRow) *UserDetails {
 			v.ExtAuthnUid = row.toStringProperty()
 		case "HAS_MFA":
 			v.HasMfa = row.toBoolProperty()
+		case "HAS_PAT":
+			v.HasPat = row.toBoolProperty()
+		case "HAS_WORKLOAD_IDENTITY":
+			v.HasWorkloadIdentity = row.toBoolProperty()
 		case "MINS_TO_BYPASS_MFA":
 			v.MinsToBypassMfa = row.toIntProperty()
 		case "MINS_TO_BYPASS_NETWORK_POLICY":
@@ -766,3 +835,96 @@ var AcceptableUserTypes = map[UserType][]string{
 	UserTypeService:       {string(UserTypeService)},
 	UserTypeLegacyService: {string(UserTypeLegacyService)},
 }
+
+type UserMfaMethodType string
+
+const (
+	UserMfaMethodTypePasskey UserMfaMethodType = "PASSKEY"
+	UserMfaMethodTypeTotp    UserMfaMethodType = "TOTP"
+	UserMfaMethodTypeDuo     UserMfaMethodType = "DUO"
+)
+
+var AllUserMfaMethodTypes = []UserMfaMethodType{
+	UserMfaMethodTypePasskey,
+	UserMfaMethodTypeTotp,
+	UserMfaMethodTypeDuo,
+}
+
+func ToUserMfaMethodType(s string) (UserMfaMethodType, error) {
+	switch methodType := UserMfaMethodType(strings.ToUpper(s)); methodType {
+	case UserMfaMethodTypePasskey,
+		UserMfaMethodTypeTotp,
+		UserMfaMethodTypeDuo:
+		return methodType, nil
+	default:
+		return "", fmt.Errorf("invalid user MFA method type: %s", s)
+	}
+}
+
+type UserMfaMethod struct {
+	Name           string
+	Type           UserMfaMethodType
+	Comment        *string
+	LastUsed       *time.Time
+	CreatedOn      time.Time
+	AdditionalInfo *string
+}
+
+type userMfaMethodDBRow struct {
+	Name           string         `db:"name"`
+	Type           string         `db:"type"`
+	Comment        sql.NullString `db:"comment"`
+	LastUsed       sql.NullTime   `db:"last_used"`
+	CreatedOn      time.Time      `db:"created_on"`
+	AdditionalInfo sql.NullString `db:"additional_info"`
+}
+
+func (row userMfaMethodDBRow) convert() *UserMfaMethod {
+	mfaMethod := &UserMfaMethod{
+		Name:      row.Name,
+		CreatedOn: row.CreatedOn,
+	}
+	if methodType, err := ToUserMfaMethodType(row.Type); err == nil {
+		mfaMethod.Type = methodType
+	} else {
+		mfaMethod.Type = UserMfaMethodType(row.Type)
+	}
+	if row.Comment.Valid {
+		mfaMethod.Comment = &row.Comment.String
+	}
+	if row.LastUsed.Valid {
+		mfaMethod.LastUsed = &row.LastUsed.Time
+	}
+	if row.AdditionalInfo.Valid {
+		mfaMethod.AdditionalInfo = &row.AdditionalInfo.String
+	}
+	return mfaMethod
+}
+
+// showUserMfaMethodsOptions is based on https://docs.snowflake.com/en/sql-reference/sql/show-mfa-methods.
+type showUserMfaMethodsOptions struct {
+	show       bool                    `ddl:"static" sql:"SHOW"`
+	mfaMethods bool                    `ddl:"static" sql:"MFA METHODS"`
+	forUser    AccountObjectIdentifier `ddl:"identifier" sql:"FOR USER"`
+}
+
+func (opts *showUserMfaMethodsOptions) validate() error {
+	if opts == nil {
+		return errors.Join(ErrNilOptions)
+	}
+	if !ValidObjectIdentifier(opts.forUser) {
+		return errors.Join(ErrInvalidObjectIdentifier)
+	}
+	return nil
+}
+
+func (v *users) ShowMfaMethods(ctx context.Context, id AccountObjectIdentifier) ([]UserMfaMethod, error) {
+	opts := &showUserMfaMethodsOptions{
+		forUser: id,
+	}
+	dbRows, err := validateAndQuery[userMfaMethodDBRow](v.client, ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return convertRows[userMfaMethodDBRow, UserMfaMethod](dbRows), nil
+}
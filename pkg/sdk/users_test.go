@@ -67,6 +67,82 @@ func TestUserCreate(t *testing.T) {
 		assertOptsValidAndSQLEquals(t, opts, `CREATE USER %s TYPE = LEGACY_SERVICE`, id.FullyQualifiedName())
 	})
 
+	t.Run("validation: workload identity without type", func(t *testing.T) {
+		opts := &CreateUserOptions{
+			name: id,
+			ObjectProperties: &UserObjectProperties{
+				WorkloadIdentity: &UserWorkloadIdentity{},
+			},
+		}
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("UserWorkloadIdentity", "AwsType", "AzureType", "GcpType", "OidcType"))
+	})
+
+	t.Run("validation: workload identity with more than one type", func(t *testing.T) {
+		opts := &CreateUserOptions{
+			name: id,
+			ObjectProperties: &UserObjectProperties{
+				WorkloadIdentity: &UserWorkloadIdentity{
+					AwsType: &UserWorkloadIdentityAws{Arn: "arn:aws:iam::123456789012:role/test"},
+					GcpType: &UserWorkloadIdentityGcp{Subject: "123456789"},
+				},
+			},
+		}
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("UserWorkloadIdentity", "AwsType", "AzureType", "GcpType", "OidcType"))
+	})
+
+	t.Run("with aws workload identity", func(t *testing.T) {
+		opts := &CreateUserOptions{
+			name: id,
+			ObjectProperties: &UserObjectProperties{
+				WorkloadIdentity: &UserWorkloadIdentity{
+					AwsType: &UserWorkloadIdentityAws{Arn: "arn:aws:iam::123456789012:role/test"},
+				},
+				Type: Pointer(UserTypeService),
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `CREATE USER %s WORKLOAD_IDENTITY = (TYPE = AWS ARN = 'arn:aws:iam::123456789012:role/test') TYPE = SERVICE`, id.FullyQualifiedName())
+	})
+
+	t.Run("with azure workload identity", func(t *testing.T) {
+		opts := &CreateUserOptions{
+			name: id,
+			ObjectProperties: &UserObjectProperties{
+				WorkloadIdentity: &UserWorkloadIdentity{
+					AzureType: &UserWorkloadIdentityAzure{Issuer: "https://login.microsoftonline.com/tenant/v2.0", Subject: "subject"},
+				},
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `CREATE USER %s WORKLOAD_IDENTITY = (TYPE = AZURE ISSUER = 'https://login.microsoftonline.com/tenant/v2.0' SUBJECT = 'subject')`, id.FullyQualifiedName())
+	})
+
+	t.Run("with gcp workload identity", func(t *testing.T) {
+		opts := &CreateUserOptions{
+			name: id,
+			ObjectProperties: &UserObjectProperties{
+				WorkloadIdentity: &UserWorkloadIdentity{
+					GcpType: &UserWorkloadIdentityGcp{Subject: "123456789"},
+				},
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `CREATE USER %s WORKLOAD_IDENTITY = (TYPE = GCP SUBJECT = '123456789')`, id.FullyQualifiedName())
+	})
+
+	t.Run("with oidc workload identity", func(t *testing.T) {
+		opts := &CreateUserOptions{
+			name: id,
+			ObjectProperties: &UserObjectProperties{
+				WorkloadIdentity: &UserWorkloadIdentity{
+					OidcType: &UserWorkloadIdentityOidc{
+						Issuer:           "https://example.com",
+						Subject:          "system:serviceaccount:default:test",
+						OidcAudienceList: []OidcAudienceListItem{{Item: "snowflakecomputing.com"}, {Item: "other"}},
+					},
+				},
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `CREATE USER %s WORKLOAD_IDENTITY = (TYPE = OIDC ISSUER = 'https://example.com' SUBJECT = 'system:serviceaccount:default:test' OIDC_AUDIENCE_LIST = ('snowflakecomputing.com', 'other'))`, id.FullyQualifiedName())
+	})
+
 	t.Run("with complete options - no type", func(t *testing.T) {
 		tagId := randomSchemaObjectIdentifier()
 		tags := []TagAssociation{
@@ -119,7 +195,7 @@ func TestUserAlter(t *testing.T) {
 		opts := &AlterUserOptions{
 			name: id,
 		}
-		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("AlterUserOptions", "NewName", "ResetPassword", "AbortAllQueries", "AddDelegatedAuthorization", "RemoveDelegatedAuthorization", "Set", "Unset", "SetTag", "UnsetTag"))
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("AlterUserOptions", "NewName", "ResetPassword", "AbortAllQueries", "AddDelegatedAuthorization", "RemoveDelegatedAuthorization", "RemoveMfaMethod", "ModifyMfaMethod", "Set", "Unset", "SetTag", "UnsetTag"))
 	})
 
 	t.Run("validation: no set", func(t *testing.T) {
@@ -339,6 +415,61 @@ func TestUserAlter(t *testing.T) {
 		assertOptsValidAndSQLEquals(t, opts, "ALTER USER %s SET DISABLE_MFA = true", id.FullyQualifiedName())
 	})
 
+	t.Run("alter: set workload identity", func(t *testing.T) {
+		opts := &AlterUserOptions{
+			name: id,
+			Set: &UserSet{
+				ObjectProperties: &UserAlterObjectProperties{UserObjectProperties: UserObjectProperties{
+					WorkloadIdentity: &UserWorkloadIdentity{
+						GcpType: &UserWorkloadIdentityGcp{Subject: "123456789"},
+					},
+				}},
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER USER %s SET WORKLOAD_IDENTITY = (TYPE = GCP SUBJECT = '123456789')", id.FullyQualifiedName())
+	})
+
+	t.Run("validation: set workload identity without type", func(t *testing.T) {
+		opts := &AlterUserOptions{
+			name: id,
+			Set: &UserSet{
+				ObjectProperties: &UserAlterObjectProperties{UserObjectProperties: UserObjectProperties{
+					WorkloadIdentity: &UserWorkloadIdentity{},
+				}},
+			},
+		}
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("UserWorkloadIdentity", "AwsType", "AzureType", "GcpType", "OidcType"))
+	})
+
+	t.Run("alter: unset workload identity", func(t *testing.T) {
+		opts := &AlterUserOptions{
+			name: id,
+			Unset: &UserUnset{
+				ObjectProperties: &UserObjectPropertiesUnset{WorkloadIdentity: Bool(true)},
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER USER %s UNSET WORKLOAD_IDENTITY", id.FullyQualifiedName())
+	})
+
+	t.Run("remove mfa method", func(t *testing.T) {
+		opts := &AlterUserOptions{
+			name:            id,
+			RemoveMfaMethod: String("PASSKEY_1"),
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER USER %s REMOVE MFA METHOD PASSKEY_1", id.FullyQualifiedName())
+	})
+
+	t.Run("modify mfa method", func(t *testing.T) {
+		opts := &AlterUserOptions{
+			name: id,
+			ModifyMfaMethod: &ModifyMfaMethod{
+				Name:    "TOTP_1",
+				Comment: "phone",
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER USER %s MODIFY MFA METHOD TOTP_1 SET COMMENT = 'phone'", id.FullyQualifiedName())
+	})
+
 	t.Run("reset password", func(t *testing.T) {
 		id := randomAccountObjectIdentifier()
 		opts := &AlterUserOptions{
@@ -550,6 +681,59 @@ func TestUserDescribe(t *testing.T) {
 	})
 }
 
+func TestUserShowMfaMethods(t *testing.T) {
+	id := randomAccountObjectIdentifier()
+
+	t.Run("validation: empty options", func(t *testing.T) {
+		opts := &showUserMfaMethodsOptions{}
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("for user", func(t *testing.T) {
+		opts := &showUserMfaMethodsOptions{
+			forUser: id,
+		}
+		assertOptsValidAndSQLEquals(t, opts, "SHOW MFA METHODS FOR USER %s", id.FullyQualifiedName())
+	})
+}
+
+func Test_User_ToUserMfaMethodType(t *testing.T) {
+	type test struct {
+		input string
+		want  UserMfaMethodType
+	}
+
+	valid := []test{
+		// case insensitive.
+		{input: "passkey", want: UserMfaMethodTypePasskey},
+
+		// supported values
+		{input: "PASSKEY", want: UserMfaMethodTypePasskey},
+		{input: "TOTP", want: UserMfaMethodTypeTotp},
+		{input: "DUO", want: UserMfaMethodTypeDuo},
+	}
+
+	invalid := []test{
+		{input: ""},
+		{input: "foo"},
+	}
+
+	for _, tc := range valid {
+		t.Run(tc.input, func(t *testing.T) {
+			got, err := ToUserMfaMethodType(tc.input)
+			require.NoError(t, err)
+			require.Equal(t, tc.want, got)
+		})
+	}
+
+	for _, tc := range invalid {
+		t.Run(tc.input, func(t *testing.T) {
+			_, err := ToUserMfaMethodType(tc.input)
+			require.Error(t, err)
+		})
+	}
+}
+
 func Test_User_ToGeographyOutputFormat(t *testing.T) {
 	type test struct {
 		input string
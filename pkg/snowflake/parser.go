@@ -207,6 +207,10 @@ func (e *ViewSelectStatementExtractor) ExtractDynamicTable() (string, error) {
 	e.consumeSpace()
 	e.consumeToken("or replace")
 	e.consumeSpace()
+	e.consumeToken("or alter")
+	e.consumeSpace()
+	e.consumeToken("transient")
+	e.consumeSpace()
 	e.consumeToken("dynamic table")
 	e.consumeSpace()
 	e.consumeID()
@@ -224,8 +228,22 @@ func (e *ViewSelectStatementExtractor) ExtractDynamicTable() (string, error) {
 	e.consumeSpace()
 	e.consumeTokenParameter("warehouse")
 	e.consumeSpace()
+	e.consumeTokenParameter("data_retention_time_in_days")
+	e.consumeSpace()
+	e.consumeTokenParameter("max_data_extension_time_in_days")
+	e.consumeSpace()
 	e.consumeComment()
 	e.consumeSpace()
+	if e.consumeToken("immutable where") {
+		e.consumeSpace()
+		e.consumeParenthesized()
+		e.consumeSpace()
+	}
+	if e.consumeToken("backfill from") {
+		e.consumeSpace()
+		e.consumeID()
+		e.consumeSpace()
+	}
 	e.consumeToken("as")
 	e.consumeSpace()
 
@@ -339,6 +357,31 @@ func (e *ViewSelectStatementExtractor) consumeTokenParameter(param string) {
 	e.consumeNonSpace()
 }
 
+// consumeParenthesized moves e.pos after the closing parenthesis matching the one at the current position.
+// Parentheses inside single-quoted strings are skipped.
+func (e *ViewSelectStatementExtractor) consumeParenthesized() {
+	if e.pos > len(e.input)-1 || e.input[e.pos] != '(' {
+		return
+	}
+	depth := 0
+	quoted := false
+	for found := 0; e.pos+found < len(e.input); found++ {
+		switch r := e.input[e.pos+found]; {
+		case r == '\'':
+			quoted = !quoted
+		case quoted:
+		case r == '(':
+			depth++
+		case r == ')':
+			depth--
+			if depth == 0 {
+				e.pos += found + 1
+				return
+			}
+		}
+	}
+}
+
 func (e *ViewSelectStatementExtractor) consumeClusterBy() {
 	if e.input[e.pos] != '(' {
 		return
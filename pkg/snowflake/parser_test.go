@@ -188,6 +188,9 @@ from bar;`
 	// the comment before other parameters, even though this is inconsistent
 	// with the order they are specified in CREATE DYNAMIC TABLE
 	commentBeforeOtherParams := `create dynamic table foo comment = 'asdf\'s are fun' lag = 'DOWNSTREAM' refresh_mode = 'AUTO' initialize = 'ON_CREATE' warehouse = COMPUTE_WH as select * from bar;`
+	orAlterTransient := `create or alter transient dynamic table foo lag = 'DOWNSTREAM' refresh_mode = 'AUTO' initialize = 'ON_CREATE' warehouse = COMPUTE_WH as select * from bar;`
+	immutableWhere := `create dynamic table foo lag = 'DOWNSTREAM' refresh_mode = 'AUTO' initialize = 'ON_CREATE' warehouse = COMPUTE_WH immutable where (ts < '2025-01-01 (utc)' and (id > 1)) as select * from bar;`
+	backfillFrom := `create dynamic table foo lag = 'DOWNSTREAM' refresh_mode = 'AUTO' initialize = 'ON_CREATE' warehouse = COMPUTE_WH immutable where (id < 10) backfill from "db"."schema"."backup" as select * from bar;`
 
 	type args struct {
 		input string
@@ -208,6 +211,9 @@ from bar;`
 		{"orReplace", args{orReplace}, "select * from bar;", false},
 		{"identifier", args{identifier}, "select * from bar;", false},
 		{"commentBeforeOtherParams", args{commentBeforeOtherParams}, "select * from bar;", false},
+		{"orAlterTransient", args{orAlterTransient}, "select * from bar;", false},
+		{"immutableWhere", args{immutableWhere}, "select * from bar;", false},
+		{"backfillFrom", args{backfillFrom}, "select * from bar;", false},
 	}
 	for _, tt := range tests {
 		tt := tt